@@ -46,8 +46,15 @@ import (
 	v4 "github.com/osmosis-labs/osmosis/v7/app/upgrades/v4"
 	v5 "github.com/osmosis-labs/osmosis/v7/app/upgrades/v5"
 	v7 "github.com/osmosis-labs/osmosis/v7/app/upgrades/v7"
+	v8 "github.com/osmosis-labs/osmosis/v7/app/upgrades/v8"
 	_ "github.com/osmosis-labs/osmosis/v7/client/docs/statik"
+	concentratedliquiditytypes "github.com/osmosis-labs/osmosis/v7/x/concentrated-liquidity/types"
+	incentivestypes "github.com/osmosis-labs/osmosis/v7/x/incentives/types"
+	poolincentivestypes "github.com/osmosis-labs/osmosis/v7/x/pool-incentives/types"
 	superfluidtypes "github.com/osmosis-labs/osmosis/v7/x/superfluid/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v7/x/swaprouter/types"
+	twammtypes "github.com/osmosis-labs/osmosis/v7/x/twamm/types"
+	twaptypes "github.com/osmosis-labs/osmosis/v7/x/twap/types"
 )
 
 const appName = "OsmosisApp"
@@ -169,7 +176,7 @@ func NewOsmosisApp(
 	keys := sdk.NewKVStoreKeys(KVStoreKeys()...)
 
 	// Define transient store keys
-	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
+	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey, twaptypes.TransientStoreKey)
 
 	// MemKeys are for information that is stored only in RAM.
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
@@ -430,6 +437,15 @@ func (app *OsmosisApp) setupUpgradeStoreLoaders() {
 		// configure store loader that checks if version == upgradeHeight and applies store upgrades
 		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
 	}
+
+	if upgradeInfo.Name == v8.UpgradeName && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		storeUpgrades := store.StoreUpgrades{
+			Added: []string{swaproutertypes.StoreKey, twaptypes.StoreKey, concentratedliquiditytypes.StoreKey, twammtypes.StoreKey},
+		}
+
+		// configure store loader that checks if version == upgradeHeight and applies store upgrades
+		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
+	}
 }
 
 func (app *OsmosisApp) setupUpgradeHandlers() {
@@ -471,6 +487,21 @@ func (app *OsmosisApp) setupUpgradeHandlers() {
 			app.AccountKeeper,
 		),
 	)
+
+	app.UpgradeKeeper.SetUpgradeHandler(
+		v8.UpgradeName,
+		v8.CreateUpgradeHandler(
+			app.mm,
+			app.configurator,
+			app.GAMMKeeper,
+			app.SwapRouterKeeper,
+			app.TwapKeeper,
+			app.LockupKeeper,
+			app.IncentivesKeeper,
+			app.GetSubspace(incentivestypes.ModuleName),
+			app.GetSubspace(poolincentivestypes.ModuleName),
+		),
+	)
 }
 
 // RegisterSwaggerAPI registers swagger route with API Server.
@@ -21,6 +21,7 @@ import (
 	gammtypes "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 	lockupkeeper "github.com/osmosis-labs/osmosis/v7/x/lockup/keeper"
 	lockuptypes "github.com/osmosis-labs/osmosis/v7/x/lockup/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v7/x/swaprouter/types"
 )
 
 type KeeperTestHelper struct {
@@ -129,9 +130,7 @@ func (keeperTestHelper *KeeperTestHelper) AllocateRewardsToValidator(valAddr sdk
 
 // SetupGammPoolsWithBondDenomMultiplier uses given multipliers to set initial pool supply of bond denom.
 func (keeperTestHelper *KeeperTestHelper) SetupGammPoolsWithBondDenomMultiplier(multipliers []sdk.Dec) []gammtypes.PoolI {
-	keeperTestHelper.App.GAMMKeeper.SetParams(keeperTestHelper.Ctx, gammtypes.Params{
-		PoolCreationFee: sdk.Coins{},
-	})
+	keeperTestHelper.App.GAMMKeeper.SetParams(keeperTestHelper.Ctx, gammtypes.NewParams(sdk.Coins{}))
 
 	bondDenom := keeperTestHelper.App.StakingKeeper.BondDenom(keeperTestHelper.Ctx)
 	// TODO: use sdk crypto instead of tendermint to generate address
@@ -195,9 +194,10 @@ func (keeperTestHelper *KeeperTestHelper) SwapAndSetSpotPrice(poolId uint64, fro
 	err := simapp.FundAccount(keeperTestHelper.App.BankKeeper, keeperTestHelper.Ctx, acc1, coins)
 	keeperTestHelper.Require().NoError(err)
 
-	_, err = keeperTestHelper.App.GAMMKeeper.SwapExactAmountOut(
+	routes := []swaproutertypes.SwapAmountOutRoute{{PoolId: poolId, TokenInDenom: fromAsset.Denom}}
+	_, err = keeperTestHelper.App.SwapRouterKeeper.RouteExactAmountOut(
 		keeperTestHelper.Ctx, acc1,
-		poolId, fromAsset.Denom, fromAsset.Amount,
+		routes, fromAsset.Amount,
 		sdk.NewCoin(toAsset.Denom, toAsset.Amount.Quo(sdk.NewInt(4))))
 	keeperTestHelper.Require().NoError(err)
 
@@ -49,8 +49,11 @@ import (
 	_ "github.com/osmosis-labs/osmosis/v7/client/docs/statik"
 	claimkeeper "github.com/osmosis-labs/osmosis/v7/x/claim/keeper"
 	claimtypes "github.com/osmosis-labs/osmosis/v7/x/claim/types"
+	concentratedliquiditykeeper "github.com/osmosis-labs/osmosis/v7/x/concentrated-liquidity/keeper"
+	concentratedliquiditytypes "github.com/osmosis-labs/osmosis/v7/x/concentrated-liquidity/types"
 	epochskeeper "github.com/osmosis-labs/osmosis/v7/x/epochs/keeper"
 	epochstypes "github.com/osmosis-labs/osmosis/v7/x/epochs/types"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm"
 	gammkeeper "github.com/osmosis-labs/osmosis/v7/x/gamm/keeper"
 	gammtypes "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 	incentiveskeeper "github.com/osmosis-labs/osmosis/v7/x/incentives/keeper"
@@ -65,6 +68,12 @@ import (
 	"github.com/osmosis-labs/osmosis/v7/x/superfluid"
 	superfluidkeeper "github.com/osmosis-labs/osmosis/v7/x/superfluid/keeper"
 	superfluidtypes "github.com/osmosis-labs/osmosis/v7/x/superfluid/types"
+	swaprouterkeeper "github.com/osmosis-labs/osmosis/v7/x/swaprouter/keeper"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v7/x/swaprouter/types"
+	twammkeeper "github.com/osmosis-labs/osmosis/v7/x/twamm/keeper"
+	twammtypes "github.com/osmosis-labs/osmosis/v7/x/twamm/types"
+	twapkeeper "github.com/osmosis-labs/osmosis/v7/x/twap/keeper"
+	twaptypes "github.com/osmosis-labs/osmosis/v7/x/twap/types"
 	"github.com/osmosis-labs/osmosis/v7/x/txfees"
 	txfeeskeeper "github.com/osmosis-labs/osmosis/v7/x/txfees/keeper"
 	txfeestypes "github.com/osmosis-labs/osmosis/v7/x/txfees/types"
@@ -84,28 +93,32 @@ type appKeepers struct {
 	ScopedWasmKeeper     capabilitykeeper.ScopedKeeper
 
 	// "Normal" keepers
-	AccountKeeper        *authkeeper.AccountKeeper
-	BankKeeper           *bankkeeper.BaseKeeper
-	AuthzKeeper          *authzkeeper.Keeper
-	StakingKeeper        *stakingkeeper.Keeper
-	DistrKeeper          *distrkeeper.Keeper
-	SlashingKeeper       *slashingkeeper.Keeper
-	IBCKeeper            *ibckeeper.Keeper
-	TransferKeeper       *ibctransferkeeper.Keeper
-	Bech32IBCKeeper      *bech32ibckeeper.Keeper
-	Bech32ICS20Keeper    *bech32ics20keeper.Keeper
-	EvidenceKeeper       *evidencekeeper.Keeper
-	ClaimKeeper          *claimkeeper.Keeper
-	GAMMKeeper           *gammkeeper.Keeper
-	LockupKeeper         *lockupkeeper.Keeper
-	EpochsKeeper         *epochskeeper.Keeper
-	IncentivesKeeper     *incentiveskeeper.Keeper
-	MintKeeper           *mintkeeper.Keeper
-	PoolIncentivesKeeper *poolincentiveskeeper.Keeper
-	TxFeesKeeper         *txfeeskeeper.Keeper
-	SuperfluidKeeper     *superfluidkeeper.Keeper
-	GovKeeper            *govkeeper.Keeper
-	WasmKeeper           *wasm.Keeper
+	AccountKeeper               *authkeeper.AccountKeeper
+	BankKeeper                  *bankkeeper.BaseKeeper
+	AuthzKeeper                 *authzkeeper.Keeper
+	StakingKeeper               *stakingkeeper.Keeper
+	DistrKeeper                 *distrkeeper.Keeper
+	SlashingKeeper              *slashingkeeper.Keeper
+	IBCKeeper                   *ibckeeper.Keeper
+	TransferKeeper              *ibctransferkeeper.Keeper
+	Bech32IBCKeeper             *bech32ibckeeper.Keeper
+	Bech32ICS20Keeper           *bech32ics20keeper.Keeper
+	EvidenceKeeper              *evidencekeeper.Keeper
+	ClaimKeeper                 *claimkeeper.Keeper
+	GAMMKeeper                  *gammkeeper.Keeper
+	SwapRouterKeeper            *swaprouterkeeper.Keeper
+	ConcentratedLiquidityKeeper *concentratedliquiditykeeper.Keeper
+	TwapKeeper                  *twapkeeper.Keeper
+	TwammKeeper                 *twammkeeper.Keeper
+	LockupKeeper                *lockupkeeper.Keeper
+	EpochsKeeper                *epochskeeper.Keeper
+	IncentivesKeeper            *incentiveskeeper.Keeper
+	MintKeeper                  *mintkeeper.Keeper
+	PoolIncentivesKeeper        *poolincentiveskeeper.Keeper
+	TxFeesKeeper                *txfeeskeeper.Keeper
+	SuperfluidKeeper            *superfluidkeeper.Keeper
+	GovKeeper                   *govkeeper.Keeper
+	WasmKeeper                  *wasm.Keeper
 }
 
 func (app *OsmosisApp) InitSpecialKeepers(
@@ -279,13 +292,41 @@ func (app *OsmosisApp) InitNormalKeepers(
 		app.AccountKeeper, app.BankKeeper, app.DistrKeeper)
 	app.GAMMKeeper = &gammKeeper
 
+	concentratedLiquidityKeeper := concentratedliquiditykeeper.NewKeeper(
+		appCodec, keys[concentratedliquiditytypes.StoreKey],
+		app.GetSubspace(concentratedliquiditytypes.ModuleName),
+		app.AccountKeeper, app.BankKeeper, app.DistrKeeper)
+	app.ConcentratedLiquidityKeeper = &concentratedLiquidityKeeper
+
+	app.SwapRouterKeeper = swaprouterkeeper.NewKeeper(
+		keys[swaproutertypes.StoreKey],
+		app.GAMMKeeper,
+		app.ConcentratedLiquidityKeeper,
+		app.GAMMKeeper)
+	app.GAMMKeeper.SetPoolManager(app.SwapRouterKeeper)
+	app.ConcentratedLiquidityKeeper.SetPoolManager(app.SwapRouterKeeper)
+
+	app.TwapKeeper = twapkeeper.NewKeeper(
+		keys[twaptypes.StoreKey],
+		app.tkeys[twaptypes.TransientStoreKey],
+		app.GetSubspace(twaptypes.ModuleName),
+		app.GAMMKeeper)
+
+	twammKeeper := twammkeeper.NewKeeper(
+		appCodec, keys[twammtypes.StoreKey],
+		app.GetSubspace(twammtypes.ModuleName),
+		app.AccountKeeper, app.BankKeeper, app.GAMMKeeper, app.SwapRouterKeeper)
+	app.TwammKeeper = &twammKeeper
+
 	app.LockupKeeper = lockupkeeper.NewKeeper(
 		appCodec,
 		keys[lockuptypes.StoreKey],
+		app.GetSubspace(lockuptypes.ModuleName),
 		// TODO: Visit why this needs to be deref'd
 		*app.AccountKeeper,
 		app.BankKeeper,
 		app.DistrKeeper)
+	app.GAMMKeeper.SetLockupKeeper(app.LockupKeeper)
 
 	app.EpochsKeeper = epochskeeper.NewKeeper(appCodec, keys[epochstypes.StoreKey])
 
@@ -296,6 +337,8 @@ func (app *OsmosisApp) InitNormalKeepers(
 		app.BankKeeper,
 		app.LockupKeeper,
 		app.EpochsKeeper,
+		app.GAMMKeeper,
+		app.TwapKeeper,
 	)
 
 	app.SuperfluidKeeper = superfluidkeeper.NewKeeper(
@@ -312,6 +355,7 @@ func (app *OsmosisApp) InitNormalKeepers(
 		app.IncentivesKeeper,
 		lockupkeeper.NewMsgServerImpl(app.LockupKeeper),
 	)
+	app.GAMMKeeper.SetSuperfluidKeeper(app.SuperfluidKeeper)
 
 	mintKeeper := mintkeeper.NewKeeper(
 		appCodec,
@@ -325,6 +369,14 @@ func (app *OsmosisApp) InitNormalKeepers(
 	)
 	app.MintKeeper = &mintKeeper
 
+	txFeesKeeper := txfeeskeeper.NewKeeper(
+		appCodec,
+		keys[txfeestypes.StoreKey],
+		app.GAMMKeeper,
+		app.TwapKeeper,
+	)
+	app.TxFeesKeeper = &txFeesKeeper
+
 	poolIncentivesKeeper := poolincentiveskeeper.NewKeeper(
 		appCodec,
 		keys[poolincentivestypes.StoreKey],
@@ -333,23 +385,17 @@ func (app *OsmosisApp) InitNormalKeepers(
 		app.BankKeeper,
 		app.IncentivesKeeper,
 		app.DistrKeeper,
+		app.TxFeesKeeper,
 		distrtypes.ModuleName,
 		authtypes.FeeCollectorName,
 	)
 	app.PoolIncentivesKeeper = &poolIncentivesKeeper
 
-	txFeesKeeper := txfeeskeeper.NewKeeper(
-		appCodec,
-		keys[txfeestypes.StoreKey],
-		app.GAMMKeeper,
-	)
-	app.TxFeesKeeper = &txFeesKeeper
-
 	// The last arguments can contain custom message handlers, and custom query handlers,
 	// if we want to allow any custom callbacks
 	supportedFeatures := "iterator,staking,stargate,osmosis"
 
-	wasmOpts = append(owasm.RegisterCustomPlugins(app.GAMMKeeper, app.BankKeeper), wasmOpts...)
+	wasmOpts = append(owasm.RegisterCustomPlugins(app.GAMMKeeper, app.SwapRouterKeeper, app.BankKeeper), wasmOpts...)
 
 	wasmKeeper := wasm.NewKeeper(
 		appCodec,
@@ -371,6 +417,7 @@ func (app *OsmosisApp) InitNormalKeepers(
 		wasmOpts...,
 	)
 	app.WasmKeeper = &wasmKeeper
+	app.LockupKeeper.SetWasmKeeper(app.WasmKeeper)
 
 	// wire up x/wasm to IBC
 	ibcRouter.AddRoute(wasm.ModuleName, wasm.NewIBCHandler(app.WasmKeeper, app.IBCKeeper.ChannelKeeper))
@@ -388,7 +435,8 @@ func (app *OsmosisApp) InitNormalKeepers(
 		AddRoute(poolincentivestypes.RouterKey, poolincentives.NewPoolIncentivesProposalHandler(*app.PoolIncentivesKeeper)).
 		AddRoute(bech32ibctypes.RouterKey, bech32ibc.NewBech32IBCProposalHandler(*app.Bech32IBCKeeper)).
 		AddRoute(txfeestypes.RouterKey, txfees.NewUpdateFeeTokenProposalHandler(*app.TxFeesKeeper)).
-		AddRoute(superfluidtypes.RouterKey, superfluid.NewSuperfluidProposalHandler(*app.SuperfluidKeeper, *app.EpochsKeeper))
+		AddRoute(superfluidtypes.RouterKey, superfluid.NewSuperfluidProposalHandler(*app.SuperfluidKeeper, *app.EpochsKeeper)).
+		AddRoute(gammtypes.RouterKey, gamm.NewGammProposalHandler(app.GAMMKeeper))
 
 	// The gov proposal types can be individually enabled
 	if len(wasmEnabledProposals) != 0 {
@@ -422,13 +470,14 @@ func (app *OsmosisApp) SetupHooks() {
 			// insert gamm hooks receivers here
 			app.PoolIncentivesKeeper.Hooks(),
 			app.ClaimKeeper.Hooks(),
+			app.TwapKeeper.Hooks(),
 		),
 	)
 
-	app.LockupKeeper.SetHooks(
-		lockuptypes.NewMultiLockupHooks(
-			// insert lockup hooks receivers here
-			app.SuperfluidKeeper.Hooks(),
+	app.SwapRouterKeeper.SetHooks(
+		swaproutertypes.NewMultiSwapRouterHooks(
+			// insert swap router hooks receivers here
+			app.PoolIncentivesKeeper.SwapRouterHooks(),
 		),
 	)
 
@@ -438,6 +487,15 @@ func (app *OsmosisApp) SetupHooks() {
 		),
 	)
 
+	app.LockupKeeper.SetHooks(
+		lockuptypes.NewMultiLockupHooks(
+			// insert lockup hooks receivers here
+			app.SuperfluidKeeper.Hooks(),
+			app.IncentivesKeeper.Hooks(),
+			app.LockupKeeper.CallbackContractHooks(),
+		),
+	)
+
 	app.MintKeeper.SetHooks(
 		minttypes.NewMultiMintHooks(
 			// insert mint hooks receivers here
@@ -451,6 +509,8 @@ func (app *OsmosisApp) SetupHooks() {
 			app.SuperfluidKeeper.Hooks(),
 			app.IncentivesKeeper.Hooks(),
 			app.MintKeeper.Hooks(),
+			app.TwapKeeper.Hooks(),
+			app.LockupKeeper.Hooks(),
 		),
 	)
 
@@ -476,10 +536,14 @@ func (app *OsmosisApp) initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino
 	paramsKeeper.Subspace(crisistypes.ModuleName)
 	paramsKeeper.Subspace(ibctransfertypes.ModuleName)
 	paramsKeeper.Subspace(ibchost.ModuleName)
+	paramsKeeper.Subspace(lockuptypes.ModuleName)
 	paramsKeeper.Subspace(incentivestypes.ModuleName)
 	paramsKeeper.Subspace(poolincentivestypes.ModuleName)
 	paramsKeeper.Subspace(superfluidtypes.ModuleName)
 	paramsKeeper.Subspace(gammtypes.ModuleName)
+	paramsKeeper.Subspace(concentratedliquiditytypes.ModuleName)
+	paramsKeeper.Subspace(twaptypes.ModuleName)
+	paramsKeeper.Subspace(twammtypes.ModuleName)
 	paramsKeeper.Subspace(wasm.ModuleName)
 
 	return paramsKeeper
@@ -501,6 +565,10 @@ func KVStoreKeys() []string {
 		ibctransfertypes.StoreKey,
 		capabilitytypes.StoreKey,
 		gammtypes.StoreKey,
+		concentratedliquiditytypes.StoreKey,
+		swaproutertypes.StoreKey,
+		twaptypes.StoreKey,
+		twammtypes.StoreKey,
 		lockuptypes.StoreKey,
 		claimtypes.StoreKey,
 		incentivestypes.StoreKey,
@@ -50,9 +50,12 @@ import (
 	_ "github.com/osmosis-labs/osmosis/v7/client/docs/statik"
 	"github.com/osmosis-labs/osmosis/v7/x/claim"
 	claimtypes "github.com/osmosis-labs/osmosis/v7/x/claim/types"
+	concentratedliquidity "github.com/osmosis-labs/osmosis/v7/x/concentrated-liquidity"
+	concentratedliquiditytypes "github.com/osmosis-labs/osmosis/v7/x/concentrated-liquidity/types"
 	"github.com/osmosis-labs/osmosis/v7/x/epochs"
 	epochstypes "github.com/osmosis-labs/osmosis/v7/x/epochs/types"
 	"github.com/osmosis-labs/osmosis/v7/x/gamm"
+	gammclient "github.com/osmosis-labs/osmosis/v7/x/gamm/client"
 	gammtypes "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/v7/x/incentives"
 	incentivestypes "github.com/osmosis-labs/osmosis/v7/x/incentives/types"
@@ -66,6 +69,12 @@ import (
 	superfluid "github.com/osmosis-labs/osmosis/v7/x/superfluid"
 	superfluidclient "github.com/osmosis-labs/osmosis/v7/x/superfluid/client"
 	superfluidtypes "github.com/osmosis-labs/osmosis/v7/x/superfluid/types"
+	"github.com/osmosis-labs/osmosis/v7/x/swaprouter"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v7/x/swaprouter/types"
+	"github.com/osmosis-labs/osmosis/v7/x/twamm"
+	twammtypes "github.com/osmosis-labs/osmosis/v7/x/twamm/types"
+	"github.com/osmosis-labs/osmosis/v7/x/twap"
+	twaptypes "github.com/osmosis-labs/osmosis/v7/x/twap/types"
 	"github.com/osmosis-labs/osmosis/v7/x/txfees"
 	txfeestypes "github.com/osmosis-labs/osmosis/v7/x/txfees/types"
 )
@@ -91,6 +100,7 @@ var appModuleBasics = []module.AppModuleBasic{
 			ibcclientclient.UpgradeProposalHandler,
 			superfluidclient.SetSuperfluidAssetsProposalHandler,
 			superfluidclient.RemoveSuperfluidAssetsProposalHandler,
+			gammclient.CleanupPoolsProposalHandler,
 		)...,
 	),
 	params.AppModuleBasic{},
@@ -103,6 +113,10 @@ var appModuleBasics = []module.AppModuleBasic{
 	transfer.AppModuleBasic{},
 	vesting.AppModuleBasic{},
 	gamm.AppModuleBasic{},
+	concentratedliquidity.AppModuleBasic{},
+	swaprouter.AppModuleBasic{},
+	twap.AppModuleBasic{},
+	twamm.AppModuleBasic{},
 	txfees.AppModuleBasic{},
 	incentives.AppModuleBasic{},
 	lockup.AppModuleBasic{},
@@ -128,9 +142,11 @@ var moduleAccountPermissions = map[string][]string{
 	gammtypes.ModuleName:                     {authtypes.Minter, authtypes.Burner},
 	incentivestypes.ModuleName:               {authtypes.Minter, authtypes.Burner},
 	lockuptypes.ModuleName:                   {authtypes.Minter, authtypes.Burner},
+	lockuptypes.ReceiptRewardsModuleAcctName: nil,
 	poolincentivestypes.ModuleName:           nil,
 	superfluidtypes.ModuleName:               {authtypes.Minter, authtypes.Burner},
 	txfeestypes.ModuleName:                   nil,
+	twammtypes.ModuleName:                    nil,
 	wasm.ModuleName:                          {authtypes.Burner},
 }
 
@@ -168,6 +184,10 @@ func appModules(
 		app.transferModule,
 		claim.NewAppModule(appCodec, *app.ClaimKeeper),
 		gamm.NewAppModule(appCodec, *app.GAMMKeeper, app.AccountKeeper, app.BankKeeper),
+		concentratedliquidity.NewAppModule(*app.ConcentratedLiquidityKeeper),
+		swaprouter.NewAppModule(*app.SwapRouterKeeper),
+		twap.NewAppModule(*app.TwapKeeper),
+		twamm.NewAppModule(*app.TwammKeeper),
 		txfees.NewAppModule(appCodec, *app.TxFeesKeeper),
 		incentives.NewAppModule(appCodec, *app.IncentivesKeeper, app.AccountKeeper, app.BankKeeper, app.EpochsKeeper),
 		lockup.NewAppModule(appCodec, *app.LockupKeeper, app.AccountKeeper, app.BankKeeper),
@@ -213,6 +233,10 @@ func orderBeginBlockers() []string {
 		paramstypes.ModuleName,
 		vestingtypes.ModuleName,
 		gammtypes.ModuleName,
+		concentratedliquiditytypes.ModuleName,
+		swaproutertypes.ModuleName,
+		twaptypes.ModuleName,
+		twammtypes.ModuleName,
 		incentivestypes.ModuleName,
 		lockuptypes.ModuleName,
 		claimtypes.ModuleName,
@@ -248,6 +272,10 @@ var orderEndBlockers = []string{
 	ibchost.ModuleName,
 	ibctransfertypes.ModuleName,
 	gammtypes.ModuleName,
+	concentratedliquiditytypes.ModuleName,
+	swaproutertypes.ModuleName,
+	twaptypes.ModuleName,
+	twammtypes.ModuleName,
 	incentivestypes.ModuleName,
 	lockuptypes.ModuleName,
 	poolincentivestypes.ModuleName,
@@ -271,7 +299,11 @@ var modulesOrderInitGenesis = []string{
 	minttypes.ModuleName,
 	crisistypes.ModuleName,
 	ibchost.ModuleName,
+	swaproutertypes.ModuleName,
 	gammtypes.ModuleName,
+	concentratedliquiditytypes.ModuleName,
+	twaptypes.ModuleName,
+	twammtypes.ModuleName,
 	txfeestypes.ModuleName,
 	genutiltypes.ModuleName,
 	evidencetypes.ModuleName,
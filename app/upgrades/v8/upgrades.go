@@ -0,0 +1,96 @@
+package v8
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	gammkeeper "github.com/osmosis-labs/osmosis/v7/x/gamm/keeper"
+	gammtypes "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+	incentiveskeeper "github.com/osmosis-labs/osmosis/v7/x/incentives/keeper"
+	incentivestypes "github.com/osmosis-labs/osmosis/v7/x/incentives/types"
+	lockupkeeper "github.com/osmosis-labs/osmosis/v7/x/lockup/keeper"
+	lockuptypes "github.com/osmosis-labs/osmosis/v7/x/lockup/types"
+	poolincentivestypes "github.com/osmosis-labs/osmosis/v7/x/pool-incentives/types"
+	swaprouterkeeper "github.com/osmosis-labs/osmosis/v7/x/swaprouter/keeper"
+	twapkeeper "github.com/osmosis-labs/osmosis/v7/x/twap/keeper"
+)
+
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	gammKeeper *gammkeeper.Keeper,
+	swapRouterKeeper *swaprouterkeeper.Keeper,
+	twapKeeper *twapkeeper.Keeper,
+	lockupKeeper *lockupkeeper.Keeper,
+	incentivesKeeper *incentiveskeeper.Keeper,
+	incentivesParamSpace paramstypes.Subspace,
+	poolIncentivesParamSpace paramstypes.Subspace,
+) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, plan upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		// RunMigrations runs the InitGenesis of every module added in this
+		// upgrade with its default genesis.
+		newVM, err := mm.RunMigrations(ctx, configurator, vm)
+		if err != nil {
+			return newVM, err
+		}
+
+		// Move the pool ID counter from gamm to the swap router, and route
+		// swaps in the existing pools to gamm.
+		ctx.Logger().Info("Migrating gamm pools to the swap router")
+		if err := migratePoolRoutes(ctx, gammKeeper, swapRouterKeeper); err != nil {
+			return newVM, err
+		}
+
+		// Gamm params gain the taker fee, which is not charged until
+		// governance sets one.
+		gammKeeper.SetTakerFeeParams(ctx, gammtypes.DefaultTakerFeeParams())
+
+		// Lockup gains params. No address may force-unlock until governance
+		// allows one.
+		lockupKeeper.SetParams(ctx, lockuptypes.DefaultParams())
+
+		// Incentives params gain the per-block distribution limit, reward
+		// accrual and the auto-compound slippage bound. Gauges keep being
+		// distributed in full at the epoch until governance changes them.
+		defaultIncentivesParams := incentivestypes.DefaultParams()
+		incentivesParamSpace.Set(ctx, incentivestypes.KeyMaxDistributionLocksPerBlock, uint64(0))
+		incentivesParamSpace.Set(ctx, incentivestypes.KeyAccrueRewards, false)
+		incentivesParamSpace.Set(ctx, incentivestypes.KeyAutoCompoundMaxSlippage, defaultIncentivesParams.AutoCompoundMaxSlippage)
+
+		// Pool incentives params gain volume weighting, which stays off until
+		// governance sets a volume weighted share.
+		defaultPoolIncentivesParams := poolincentivestypes.DefaultParams()
+		poolIncentivesParamSpace.Set(ctx, poolincentivestypes.KeyVolumeWeightedShare, defaultPoolIncentivesParams.VolumeWeightedShare)
+		poolIncentivesParamSpace.Set(ctx, poolincentivestypes.KeyVolumeWindowEpochs, defaultPoolIncentivesParams.VolumeWindowEpochs)
+		poolIncentivesParamSpace.Set(ctx, poolincentivestypes.KeyVolumeWeightBySwapFee, defaultPoolIncentivesParams.VolumeWeightBySwapFee)
+
+		// Existing locks start accruing rewards from the upgrade.
+		ctx.Logger().Info("Initializing lock rewards for existing locks")
+		if err := incentivesKeeper.InitializeLockRewardsForExistingLocks(ctx); err != nil {
+			return newVM, err
+		}
+
+		// Start tracking TWAPs for the pools that already exist.
+		ctx.Logger().Info("Creating twap records for existing pools")
+		if err := twapKeeper.InitializeRecordsForExistingPools(ctx); err != nil {
+			return newVM, err
+		}
+
+		return newVM, nil
+	}
+}
+
+func migratePoolRoutes(ctx sdk.Context, gammKeeper *gammkeeper.Keeper, swapRouterKeeper *swaprouterkeeper.Keeper) error {
+	swapRouterKeeper.SetNextPoolId(ctx, gammKeeper.GetNextPoolNumber(ctx))
+
+	pools, err := gammKeeper.GetPoolsAndPoke(ctx)
+	if err != nil {
+		return err
+	}
+	for _, pool := range pools {
+		swapRouterKeeper.SetPoolRoute(ctx, pool.GetId(), pool.GetType())
+	}
+	return nil
+}
@@ -10,24 +10,24 @@ import (
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 
 	wasmbindings "github.com/osmosis-labs/osmosis/v7/app/wasm/bindings"
-	gammkeeper "github.com/osmosis-labs/osmosis/v7/x/gamm/keeper"
-	gammtypes "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+	swaprouterkeeper "github.com/osmosis-labs/osmosis/v7/x/swaprouter/keeper"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v7/x/swaprouter/types"
 )
 
-func CustomMessageDecorator(gammKeeper *gammkeeper.Keeper, bank *bankkeeper.BaseKeeper) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
+func CustomMessageDecorator(swapRouterKeeper *swaprouterkeeper.Keeper, bank *bankkeeper.BaseKeeper) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
 	return func(old wasmkeeper.Messenger) wasmkeeper.Messenger {
 		return &CustomMessenger{
-			wrapped:    old,
-			bank:       bank,
-			gammKeeper: gammKeeper,
+			wrapped:          old,
+			bank:             bank,
+			swapRouterKeeper: swapRouterKeeper,
 		}
 	}
 }
 
 type CustomMessenger struct {
-	wrapped    wasmkeeper.Messenger
-	bank       *bankkeeper.BaseKeeper
-	gammKeeper *gammkeeper.Keeper
+	wrapped          wasmkeeper.Messenger
+	bank             *bankkeeper.BaseKeeper
+	swapRouterKeeper *swaprouterkeeper.Keeper
 }
 
 var _ wasmkeeper.Messenger = (*CustomMessenger)(nil)
@@ -88,7 +88,7 @@ func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddre
 // }
 
 func (m *CustomMessenger) swapTokens(ctx sdk.Context, contractAddr sdk.AccAddress, swap *wasmbindings.SwapMsg) ([]sdk.Event, [][]byte, error) {
-	_, err := PerformSwap(m.gammKeeper, ctx, contractAddr, swap)
+	_, err := PerformSwap(m.swapRouterKeeper, ctx, contractAddr, swap)
 	if err != nil {
 		return nil, nil, sdkerrors.Wrap(err, "perform swap")
 	}
@@ -96,17 +96,17 @@ func (m *CustomMessenger) swapTokens(ctx sdk.Context, contractAddr sdk.AccAddres
 }
 
 // PerformSwap can be used both for the real swap, and the EstimateSwap query
-func PerformSwap(keeper *gammkeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, swap *wasmbindings.SwapMsg) (*wasmbindings.SwapAmount, error) {
+func PerformSwap(keeper *swaprouterkeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, swap *wasmbindings.SwapMsg) (*wasmbindings.SwapAmount, error) {
 	if swap == nil {
 		return nil, wasmvmtypes.InvalidRequest{Err: "gamm perform swap null swap"}
 	}
 	if swap.Amount.ExactIn != nil {
-		routes := []gammtypes.SwapAmountInRoute{{
+		routes := []swaproutertypes.SwapAmountInRoute{{
 			PoolId:        swap.First.PoolId,
 			TokenOutDenom: swap.First.DenomOut,
 		}}
 		for _, step := range swap.Route {
-			routes = append(routes, gammtypes.SwapAmountInRoute{
+			routes = append(routes, swaproutertypes.SwapAmountInRoute{
 				PoolId:        step.PoolId,
 				TokenOutDenom: step.DenomOut,
 			})
@@ -119,19 +119,19 @@ func PerformSwap(keeper *gammkeeper.Keeper, ctx sdk.Context, contractAddr sdk.Ac
 			Amount: swap.Amount.ExactIn.Input,
 		}
 		tokenOutMinAmount := swap.Amount.ExactIn.MinOutput
-		tokenOutAmount, err := keeper.MultihopSwapExactAmountIn(ctx, contractAddr, routes, tokenIn, tokenOutMinAmount)
+		tokenOutAmount, err := keeper.RouteExactAmountIn(ctx, contractAddr, routes, tokenIn, tokenOutMinAmount)
 		if err != nil {
 			return nil, sdkerrors.Wrap(err, "gamm perform swap exact amount in")
 		}
 		return &wasmbindings.SwapAmount{Out: &tokenOutAmount}, nil
 	} else if swap.Amount.ExactOut != nil {
-		routes := []gammtypes.SwapAmountOutRoute{{
+		routes := []swaproutertypes.SwapAmountOutRoute{{
 			PoolId:       swap.First.PoolId,
 			TokenInDenom: swap.First.DenomIn,
 		}}
 		output := swap.First.DenomOut
 		for _, step := range swap.Route {
-			routes = append(routes, gammtypes.SwapAmountOutRoute{
+			routes = append(routes, swaproutertypes.SwapAmountOutRoute{
 				PoolId:       step.PoolId,
 				TokenInDenom: output,
 			})
@@ -145,7 +145,7 @@ func PerformSwap(keeper *gammkeeper.Keeper, ctx sdk.Context, contractAddr sdk.Ac
 			Denom:  output,
 			Amount: swap.Amount.ExactOut.Output,
 		}
-		tokenInAmount, err := keeper.MultihopSwapExactAmountOut(ctx, contractAddr, routes, tokenInMaxAmount, tokenOut)
+		tokenInAmount, err := keeper.RouteExactAmountOut(ctx, contractAddr, routes, tokenInMaxAmount, tokenOut)
 		if err != nil {
 			return nil, sdkerrors.Wrap(err, "gamm perform swap exact amount out")
 		}
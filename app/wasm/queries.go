@@ -8,18 +8,22 @@ import (
 	wasmbindings "github.com/osmosis-labs/osmosis/v7/app/wasm/bindings"
 	gammkeeper "github.com/osmosis-labs/osmosis/v7/x/gamm/keeper"
 	gammtypes "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+	swaprouterkeeper "github.com/osmosis-labs/osmosis/v7/x/swaprouter/keeper"
 )
 
 type QueryPlugin struct {
-	gammKeeper *gammkeeper.Keeper
+	gammKeeper       *gammkeeper.Keeper
+	swapRouterKeeper *swaprouterkeeper.Keeper
 }
 
 // NewQueryPlugin constructor
 func NewQueryPlugin(
 	gammK *gammkeeper.Keeper,
+	swapRouterK *swaprouterkeeper.Keeper,
 ) *QueryPlugin {
 	return &QueryPlugin{
-		gammKeeper: gammK,
+		gammKeeper:       gammK,
+		swapRouterKeeper: swapRouterK,
 	}
 }
 
@@ -78,6 +82,6 @@ func (qp QueryPlugin) EstimateSwap(ctx sdk.Context, estimateSwap *wasmbindings.E
 		return nil, wasmvmtypes.InvalidRequest{Err: "gamm estimate swap empty swap"}
 	}
 
-	estimate, err := PerformSwap(qp.gammKeeper, ctx, senderAddr, estimateSwap.ToSwapMsg())
+	estimate, err := PerformSwap(qp.swapRouterKeeper, ctx, senderAddr, estimateSwap.ToSwapMsg())
 	return estimate, err
 }
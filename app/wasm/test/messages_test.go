@@ -325,7 +325,7 @@ func TestSwap(t *testing.T) {
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
 			// when
-			gotAmount, gotErr := wasm.PerformSwap(osmosis.GAMMKeeper, ctx, actor, spec.swap)
+			gotAmount, gotErr := wasm.PerformSwap(osmosis.SwapRouterKeeper, ctx, actor, spec.swap)
 			// then
 			if spec.expErr {
 				require.Error(t, gotErr)
@@ -507,7 +507,7 @@ func TestSwapMultiHop(t *testing.T) {
 			// use scratch context to avoid interference between tests
 			subCtx, _ := ctx.CacheContext()
 			// when
-			gotAmount, gotErr := wasm.PerformSwap(osmosis.GAMMKeeper, subCtx, actor, spec.swap)
+			gotAmount, gotErr := wasm.PerformSwap(osmosis.SwapRouterKeeper, subCtx, actor, spec.swap)
 			// then
 			if spec.expErr {
 				require.Error(t, gotErr)
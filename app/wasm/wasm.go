@@ -7,19 +7,21 @@ import (
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 
 	gammkeeper "github.com/osmosis-labs/osmosis/v7/x/gamm/keeper"
+	swaprouterkeeper "github.com/osmosis-labs/osmosis/v7/x/swaprouter/keeper"
 )
 
 func RegisterCustomPlugins(
 	gammKeeper *gammkeeper.Keeper,
+	swapRouterKeeper *swaprouterkeeper.Keeper,
 	bank *bankkeeper.BaseKeeper,
 ) []wasmkeeper.Option {
-	wasmQueryPlugin := NewQueryPlugin(gammKeeper)
+	wasmQueryPlugin := NewQueryPlugin(gammKeeper, swapRouterKeeper)
 
 	queryPluginOpt := wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
 		Custom: CustomQuerier(wasmQueryPlugin),
 	})
 	messengerDecoratorOpt := wasmkeeper.WithMessageHandlerDecorator(
-		CustomMessageDecorator(gammKeeper, bank),
+		CustomMessageDecorator(swapRouterKeeper, bank),
 	)
 
 	return []wasm.Option{
@@ -200,6 +200,44 @@ func (t Tree) ReverseIterator(begin, end []byte) store.Iterator {
 	return t.ptrReverseIterator(0, begin, end)
 }
 
+// IterateLeaves calls cb with the key and accumulation value of each leaf in
+// the tree, in key order, until cb returns true. The empty leaf every tree is
+// created with is skipped.
+func (t Tree) IterateLeaves(cb func(key []byte, acc sdk.Int) (stop bool)) {
+	iter := t.ptrIterator(0, nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		leaf := new(Leaf)
+		if err := proto.Unmarshal(iter.Value(), leaf); err != nil {
+			panic(err)
+		}
+		if len(leaf.Leaf.Index) == 0 {
+			continue
+		}
+		if cb(leaf.Leaf.Index, leaf.Leaf.Accumulation) {
+			return
+		}
+	}
+}
+
+// IterateTrees calls cb with the prefix of each tree stored in parent under
+// its own prefix, in key order, until cb returns true. Trees are found by the
+// empty leaf they are created with.
+func IterateTrees(parent store.KVStore, cb func(prefix []byte) (stop bool)) {
+	rootLeafKey := Tree{}.leafKey(nil)
+	iter := parent.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		if !bytes.HasSuffix(key, rootLeafKey) {
+			continue
+		}
+		if cb(key[:len(key)-len(rootLeafKey)]) {
+			return
+		}
+	}
+}
+
 // accumulationSplit returns the accumulated value for all of the following:
 // left: all leaves under nodePointer with key < provided key
 // exact: leaf with key = provided key
@@ -13,6 +13,7 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	iavlstore "github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/v7/store"
@@ -134,3 +135,49 @@ func (suite *TreeTestSuite) TestTreeInvariants() {
 		}
 	}
 }
+
+func (suite *TreeTestSuite) TestIterateLeaves() {
+	suite.SetupTest()
+
+	suite.tree.Set([]byte("b"), sdk.NewInt(2))
+	suite.tree.Set([]byte("a"), sdk.NewInt(1))
+	suite.tree.Set([]byte("c"), sdk.NewInt(3))
+
+	// leaves come in key order, without the empty leaf the tree is created with
+	keys := []string{}
+	total := sdk.ZeroInt()
+	suite.tree.IterateLeaves(func(key []byte, acc sdk.Int) bool {
+		keys = append(keys, string(key))
+		total = total.Add(acc)
+		return false
+	})
+	suite.Require().Equal([]string{"a", "b", "c"}, keys)
+	suite.Require().Equal(sdk.NewInt(6), total)
+
+	// iteration stops once the callback returns true
+	keys = []string{}
+	suite.tree.IterateLeaves(func(key []byte, acc sdk.Int) bool {
+		keys = append(keys, string(key))
+		return len(keys) == 2
+	})
+	suite.Require().Equal([]string{"a", "b"}, keys)
+}
+
+func (suite *TreeTestSuite) TestIterateTrees() {
+	db := dbm.NewMemDB()
+	tree, err := iavl.NewMutableTree(db, 100)
+	suite.Require().NoError(err)
+	kvstore := iavlstore.UnsafeNewStore(tree)
+
+	for _, treePrefix := range []string{"foo/", "bar/"} {
+		store.NewTree(prefix.NewStore(kvstore, []byte(treePrefix)), 10).Set([]byte{1}, sdk.NewInt(1))
+	}
+	kvstore.Set([]byte("other"), []byte{1})
+
+	prefixes := []string{}
+	store.IterateTrees(kvstore, func(treePrefix []byte) bool {
+		prefixes = append(prefixes, string(treePrefix))
+		return false
+	})
+	suite.Require().Equal([]string{"bar/", "foo/"}, prefixes)
+}
@@ -0,0 +1,20 @@
+package cli
+
+import (
+	flag "github.com/spf13/pflag"
+)
+
+const (
+	// Will be parsed to sdk.Int.
+	FlagTokenMinAmount0 = "token-min-amount0"
+	// Will be parsed to sdk.Int.
+	FlagTokenMinAmount1 = "token-min-amount1"
+)
+
+func FlagSetTokenMinAmounts() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagTokenMinAmount0, "0", "minimum amount of token0 to deposit")
+	fs.String(FlagTokenMinAmount1, "0", "minimum amount of token1 to deposit")
+	return fs
+}
@@ -0,0 +1,263 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/osmosis-labs/osmosis/v7/x/concentrated-liquidity/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module.
+func GetQueryCmd() *cobra.Command {
+	// Group concentrated-liquidity queries under a subcommand
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetCmdParams(),
+		GetCmdPools(),
+		GetCmdPool(),
+		GetCmdPosition(),
+		GetCmdUserPositions(),
+		GetCmdClaimableFees(),
+	)
+
+	return cmd
+}
+
+// GetCmdParams returns the module's params.
+func GetCmdParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the module's params",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the module's params.
+Example:
+$ %s query concentratedliquidity params
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdPools returns all concentrated-liquidity pools.
+func GetCmdPools() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pools",
+		Short: "Query all concentrated-liquidity pools",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all concentrated-liquidity pools.
+Example:
+$ %s query concentratedliquidity pools
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Pools(cmd.Context(), &types.QueryPoolsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pools")
+	return cmd
+}
+
+// GetCmdPool returns a concentrated-liquidity pool.
+func GetCmdPool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool <poolID>",
+		Short: "Query a concentrated-liquidity pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a concentrated-liquidity pool.
+Example:
+$ %s query concentratedliquidity pool 1
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Pool(cmd.Context(), &types.QueryPoolRequest{PoolId: poolID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdPosition returns a position.
+func GetCmdPosition() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "position <positionID>",
+		Short: "Query a position",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a position.
+Example:
+$ %s query concentratedliquidity position 1
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			positionID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Position(cmd.Context(), &types.QueryPositionRequest{PositionId: positionID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdUserPositions returns the positions owned by an address.
+func GetCmdUserPositions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "user-positions <address>",
+		Short: "Query the positions owned by an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the positions owned by an address, in every pool.
+Example:
+$ %s query concentratedliquidity user-positions osmo1...
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.UserPositions(cmd.Context(), &types.QueryUserPositionsRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdClaimableFees returns the fees a position would collect now.
+func GetCmdClaimableFees() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claimable-fees <positionID>",
+		Short: "Query the fees a position would collect now",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the fees a position would collect now.
+Example:
+$ %s query concentratedliquidity claimable-fees 1
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			positionID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ClaimableFees(cmd.Context(), &types.QueryClaimableFeesRequest{PositionId: positionID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
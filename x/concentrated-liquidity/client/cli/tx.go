@@ -0,0 +1,318 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/osmosis-labs/osmosis/v7/osmoutils"
+	"github.com/osmosis-labs/osmosis/v7/x/concentrated-liquidity/types"
+)
+
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Concentrated liquidity transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewCreateConcentratedPoolCmd(),
+		NewCreatePositionCmd(),
+		NewAddToPositionCmd(),
+		NewWithdrawPositionCmd(),
+		NewCollectFeesCmd(),
+	)
+
+	return txCmd
+}
+
+func NewCreateConcentratedPoolCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-concentrated-pool [denom0] [denom1] [tick-spacing] [swap-fee]",
+		Short: "create a concentrated-liquidity pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`create a concentrated-liquidity pool of two tokens.
+Prices are of denom1 in denom0. The pool's price is set by its first position.
+
+Example:
+$ %s tx concentratedliquidity create-concentrated-pool uatom uosmo 10 0.003 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tickSpacing, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			swapFee, err := sdk.NewDecFromStr(args[3])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCreateConcentratedPool{
+				Sender:      clientCtx.GetFromAddress().String(),
+				Denom0:      args[0],
+				Denom1:      args[1],
+				TickSpacing: tickSpacing,
+				SwapFee:     swapFee,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCreatePositionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-position [pool-id] [lower-tick] [upper-tick] [token-desired0] [token-desired1]",
+		Short: "provide liquidity to a pool over a range of ticks",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`provide liquidity to a concentrated-liquidity pool over a range of ticks.
+At most the desired amounts are deposited. Negative ticks are written in brackets.
+
+Example:
+$ %s tx concentratedliquidity create-position 1 [-1000] 1000 1000000 1000000 --token-min-amount0=900000 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			lowerTick, err := parseTick(args[1])
+			if err != nil {
+				return err
+			}
+
+			upperTick, err := parseTick(args[2])
+			if err != nil {
+				return err
+			}
+
+			tokenDesired0, tokenDesired1, err := parseAmounts(args[3], args[4])
+			if err != nil {
+				return err
+			}
+
+			tokenMinAmount0, tokenMinAmount1, err := tokenMinAmounts(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCreatePosition{
+				Sender:          clientCtx.GetFromAddress().String(),
+				PoolId:          poolId,
+				LowerTick:       lowerTick,
+				UpperTick:       upperTick,
+				TokenDesired0:   tokenDesired0,
+				TokenDesired1:   tokenDesired1,
+				TokenMinAmount0: tokenMinAmount0,
+				TokenMinAmount1: tokenMinAmount1,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetTokenMinAmounts())
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewAddToPositionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-to-position [position-id] [token-desired0] [token-desired1]",
+		Short: "add liquidity to a position",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`add liquidity to a position, over its range of ticks.
+
+Example:
+$ %s tx concentratedliquidity add-to-position 1 1000000 1000000 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			positionId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			tokenDesired0, tokenDesired1, err := parseAmounts(args[1], args[2])
+			if err != nil {
+				return err
+			}
+
+			tokenMinAmount0, tokenMinAmount1, err := tokenMinAmounts(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgAddToPosition{
+				Sender:          clientCtx.GetFromAddress().String(),
+				PositionId:      positionId,
+				TokenDesired0:   tokenDesired0,
+				TokenDesired1:   tokenDesired1,
+				TokenMinAmount0: tokenMinAmount0,
+				TokenMinAmount1: tokenMinAmount1,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetTokenMinAmounts())
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewWithdrawPositionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-position [position-id] [liquidity]",
+		Short: "withdraw liquidity from a position",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`withdraw liquidity from a position, along with its fees.
+The position is deleted once all of its liquidity is withdrawn.
+
+Example:
+$ %s tx concentratedliquidity withdraw-position 1 1000.5 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			positionId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			liquidity, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgWithdrawPosition{
+				Sender:          clientCtx.GetFromAddress().String(),
+				PositionId:      positionId,
+				LiquidityAmount: liquidity,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCollectFeesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "collect-fees [position-ids]",
+		Short: "collect the fees earned by positions",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`collect the fees earned by comma-separated positions.
+
+Example:
+$ %s tx concentratedliquidity collect-fees 1,2 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			positionIds, err := osmoutils.ParseUint64SliceFromString(args[0], ",")
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCollectFees{
+				Sender:      clientCtx.GetFromAddress().String(),
+				PositionIds: positionIds,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseTick parses a tick, which is written in brackets when negative so that
+// it isn't mistaken for a flag.
+func parseTick(arg string) (int64, error) {
+	return strconv.ParseInt(strings.TrimSuffix(strings.TrimPrefix(arg, "["), "]"), 10, 64)
+}
+
+func parseAmounts(arg0, arg1 string) (sdk.Int, sdk.Int, error) {
+	amount0, ok := sdk.NewIntFromString(arg0)
+	if !ok {
+		return sdk.Int{}, sdk.Int{}, fmt.Errorf("invalid token amount: %s", arg0)
+	}
+	amount1, ok := sdk.NewIntFromString(arg1)
+	if !ok {
+		return sdk.Int{}, sdk.Int{}, fmt.Errorf("invalid token amount: %s", arg1)
+	}
+	return amount0, amount1, nil
+}
+
+func tokenMinAmounts(fs *flag.FlagSet) (sdk.Int, sdk.Int, error) {
+	minAmount0, err := fs.GetString(FlagTokenMinAmount0)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+	minAmount1, err := fs.GetString(FlagTokenMinAmount1)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+	return parseAmounts(minAmount0, minAmount1)
+}
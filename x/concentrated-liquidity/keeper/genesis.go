@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/concentrated-liquidity/types"
+)
+
+// InitGenesis initializes the concentrated-liquidity module's state from a
+// provided genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	if err := genState.Validate(); err != nil {
+		panic(err)
+	}
+
+	k.SetParams(ctx, genState.Params)
+	k.SetNextPositionId(ctx, genState.NextPositionId)
+
+	for _, pool := range genState.Pools {
+		k.setPool(ctx, pool)
+	}
+	for _, tick := range genState.Ticks {
+		k.setTickInfo(ctx, tick.PoolId, tick.TickIndex, tick.Info)
+	}
+	for _, position := range genState.Positions {
+		k.setPosition(ctx, position)
+	}
+}
+
+// ExportGenesis returns the concentrated-liquidity module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return &types.GenesisState{
+		Params:         k.GetParams(ctx),
+		Pools:          k.GetAllPools(ctx),
+		Ticks:          k.GetAllTicks(ctx),
+		Positions:      k.GetAllPositions(ctx),
+		NextPositionId: k.GetNextPositionId(ctx),
+	}
+}
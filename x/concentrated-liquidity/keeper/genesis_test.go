@@ -0,0 +1,29 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/app"
+)
+
+func (suite *KeeperTestSuite) TestGenesisRoundTrip() {
+	poolId := suite.prepareConcentratedPool()
+	suite.preparePosition(poolId, -1000, 1000)
+	suite.preparePosition(poolId, -100, 100)
+	poolI, err := suite.App.ConcentratedLiquidityKeeper.GetPool(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	_, err = suite.App.ConcentratedLiquidityKeeper.SwapExactAmountIn(suite.Ctx, acc2, poolI, sdk.NewInt64Coin("bar", 200000), "foo", sdk.OneInt(), poolI.GetSwapFee(suite.Ctx))
+	suite.Require().NoError(err)
+
+	genesis := suite.App.ConcentratedLiquidityKeeper.ExportGenesis(suite.Ctx)
+	suite.Require().NoError(genesis.Validate())
+	suite.Require().Len(genesis.Pools, 1)
+	suite.Require().Len(genesis.Ticks, 4)
+	suite.Require().Len(genesis.Positions, 2)
+	suite.Require().Equal(uint64(3), genesis.NextPositionId)
+
+	newApp := app.Setup(false)
+	ctx := newApp.BaseApp.NewContext(false, suite.Ctx.BlockHeader())
+	newApp.ConcentratedLiquidityKeeper.InitGenesis(ctx, genesis)
+	suite.Require().Equal(genesis, newApp.ConcentratedLiquidityKeeper.ExportGenesis(ctx))
+}
@@ -0,0 +1,140 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/app"
+	"github.com/osmosis-labs/osmosis/v7/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v7/x/concentrated-liquidity/keeper"
+	"github.com/osmosis-labs/osmosis/v7/x/concentrated-liquidity/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v7/x/swaprouter/types"
+)
+
+var (
+	acc1 = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address().Bytes())
+	acc2 = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address().Bytes())
+)
+
+type KeeperTestSuite struct {
+	apptesting.KeeperTestHelper
+
+	queryClient types.QueryClient
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.App = app.Setup(false)
+	suite.Ctx = suite.App.BaseApp.NewContext(false, tmproto.Header{})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.Ctx, suite.App.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, keeper.NewQuerier(*suite.App.ConcentratedLiquidityKeeper))
+	suite.queryClient = types.NewQueryClient(queryHelper)
+
+	params := types.DefaultParams()
+	params.PoolCreationFee = sdk.Coins{}
+	suite.App.ConcentratedLiquidityKeeper.SetParams(suite.Ctx, params)
+	for _, acc := range []sdk.AccAddress{acc1, acc2} {
+		err := simapp.FundAccount(suite.App.BankKeeper, suite.Ctx, acc, sdk.NewCoins(
+			sdk.NewInt64Coin("bar", 100000000),
+			sdk.NewInt64Coin("foo", 100000000),
+		))
+		suite.Require().NoError(err)
+	}
+}
+
+// prepareConcentratedPool creates a pool of bar and foo, with a tick spacing
+// of 10 and a 1% swap fee.
+func (suite *KeeperTestSuite) prepareConcentratedPool() uint64 {
+	poolId, err := suite.App.ConcentratedLiquidityKeeper.CreateConcentratedPool(suite.Ctx, acc1, "bar", "foo", 10, sdk.NewDecWithPrec(1, 2))
+	suite.Require().NoError(err)
+	return poolId
+}
+
+// preparePosition creates a position of acc1 over [lowerTick, upperTick],
+// depositing up to 1000000 of each token.
+func (suite *KeeperTestSuite) preparePosition(poolId uint64, lowerTick, upperTick int64) uint64 {
+	positionId, _, _, _, err := suite.App.ConcentratedLiquidityKeeper.CreatePosition(
+		suite.Ctx, acc1, poolId, lowerTick, upperTick,
+		sdk.NewInt(1000000), sdk.NewInt(1000000), sdk.ZeroInt(), sdk.ZeroInt())
+	suite.Require().NoError(err)
+	return positionId
+}
+
+func (suite *KeeperTestSuite) TestCreateConcentratedPool() {
+	tests := []struct {
+		name          string
+		tickSpacing   uint64
+		creationFee   sdk.Coins
+		expectedErr   error
+		expectSuccess bool
+	}{
+		{"authorized tick spacing", 10, sdk.Coins{}, nil, true},
+		{"unauthorized tick spacing", 5, sdk.Coins{}, types.ErrInvalidTickSpacing, false},
+		{"pays the pool creation fee", 100, sdk.NewCoins(sdk.NewInt64Coin("foo", 1000)), nil, true},
+	}
+
+	for _, test := range tests {
+		suite.Run(test.name, func() {
+			suite.SetupTest()
+			params := suite.App.ConcentratedLiquidityKeeper.GetParams(suite.Ctx)
+			params.PoolCreationFee = test.creationFee
+			suite.App.ConcentratedLiquidityKeeper.SetParams(suite.Ctx, params)
+			balanceBefore := suite.App.BankKeeper.GetAllBalances(suite.Ctx, acc1)
+
+			poolId, err := suite.App.ConcentratedLiquidityKeeper.CreateConcentratedPool(suite.Ctx, acc1, "bar", "foo", test.tickSpacing, sdk.NewDecWithPrec(1, 2))
+			if !test.expectSuccess {
+				suite.Require().ErrorIs(err, test.expectedErr)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(balanceBefore.Sub(test.creationFee), suite.App.BankKeeper.GetAllBalances(suite.Ctx, acc1))
+
+			// the pool takes its ID from the router, and has no price yet.
+			suite.Require().Equal(uint64(1), poolId)
+			suite.Require().Equal(uint64(2), suite.App.SwapRouterKeeper.GetNextPoolId(suite.Ctx))
+			poolType, err := suite.App.SwapRouterKeeper.GetPoolType(suite.Ctx, poolId)
+			suite.Require().NoError(err)
+			suite.Require().Equal(swaproutertypes.Concentrated, poolType)
+
+			pool, err := suite.App.ConcentratedLiquidityKeeper.GetConcentratedPool(suite.Ctx, poolId)
+			suite.Require().NoError(err)
+			suite.Require().False(pool.IsActive(suite.Ctx))
+			suite.Require().NotNil(suite.App.AccountKeeper.GetAccount(suite.Ctx, pool.GetAddress()))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueries() {
+	poolId := suite.prepareConcentratedPool()
+	positionId := suite.preparePosition(poolId, -1000, 1000)
+
+	poolsRes, err := suite.queryClient.Pools(sdk.WrapSDKContext(suite.Ctx), &types.QueryPoolsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(poolsRes.Pools, 1)
+	suite.Require().Equal(poolId, poolsRes.Pools[0].Id)
+
+	positionRes, err := suite.queryClient.Position(sdk.WrapSDKContext(suite.Ctx), &types.QueryPositionRequest{PositionId: positionId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(acc1.String(), positionRes.Position.Owner)
+
+	userRes, err := suite.queryClient.UserPositions(sdk.WrapSDKContext(suite.Ctx), &types.QueryUserPositionsRequest{Address: acc1.String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(userRes.Positions, 1)
+	userRes, err = suite.queryClient.UserPositions(sdk.WrapSDKContext(suite.Ctx), &types.QueryUserPositionsRequest{Address: acc2.String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(userRes.Positions, 0)
+
+	_, err = suite.queryClient.Position(sdk.WrapSDKContext(suite.Ctx), &types.QueryPositionRequest{PositionId: positionId + 1})
+	suite.Require().Error(err)
+}
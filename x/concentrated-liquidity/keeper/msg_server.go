@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/concentrated-liquidity/types"
+)
+
+type msgServer struct {
+	keeper *Keeper
+}
+
+func NewMsgServerImpl(keeper *Keeper) types.MsgServer {
+	return &msgServer{
+		keeper: keeper,
+	}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (server msgServer) CreateConcentratedPool(goCtx context.Context, msg *types.MsgCreateConcentratedPool) (*types.MsgCreateConcentratedPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	poolId, err := server.keeper.CreateConcentratedPool(ctx, sender, msg.Denom0, msg.Denom1, msg.TickSpacing, msg.SwapFee)
+	if err != nil {
+		return nil, err
+	}
+
+	server.emitMessageEvent(ctx, msg.Sender)
+
+	return &types.MsgCreateConcentratedPoolResponse{PoolID: poolId}, nil
+}
+
+func (server msgServer) CreatePosition(goCtx context.Context, msg *types.MsgCreatePosition) (*types.MsgCreatePositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	positionId, amount0, amount1, liquidity, err := server.keeper.CreatePosition(
+		ctx, sender, msg.PoolId, msg.LowerTick, msg.UpperTick,
+		msg.TokenDesired0, msg.TokenDesired1, msg.TokenMinAmount0, msg.TokenMinAmount1)
+	if err != nil {
+		return nil, err
+	}
+
+	server.emitMessageEvent(ctx, msg.Sender)
+
+	return &types.MsgCreatePositionResponse{
+		PositionId:       positionId,
+		Amount0:          amount0,
+		Amount1:          amount1,
+		LiquidityCreated: liquidity,
+	}, nil
+}
+
+func (server msgServer) AddToPosition(goCtx context.Context, msg *types.MsgAddToPosition) (*types.MsgAddToPositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	amount0, amount1, liquidity, err := server.keeper.AddToPosition(
+		ctx, sender, msg.PositionId,
+		msg.TokenDesired0, msg.TokenDesired1, msg.TokenMinAmount0, msg.TokenMinAmount1)
+	if err != nil {
+		return nil, err
+	}
+
+	server.emitMessageEvent(ctx, msg.Sender)
+
+	return &types.MsgAddToPositionResponse{
+		Amount0:          amount0,
+		Amount1:          amount1,
+		LiquidityCreated: liquidity,
+	}, nil
+}
+
+func (server msgServer) WithdrawPosition(goCtx context.Context, msg *types.MsgWithdrawPosition) (*types.MsgWithdrawPositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	amount0, amount1, err := server.keeper.WithdrawPosition(ctx, sender, msg.PositionId, msg.LiquidityAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	server.emitMessageEvent(ctx, msg.Sender)
+
+	return &types.MsgWithdrawPositionResponse{Amount0: amount0, Amount1: amount1}, nil
+}
+
+func (server msgServer) CollectFees(goCtx context.Context, msg *types.MsgCollectFees) (*types.MsgCollectFeesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	collected, err := server.keeper.CollectFees(ctx, sender, msg.PositionIds)
+	if err != nil {
+		return nil, err
+	}
+
+	server.emitMessageEvent(ctx, msg.Sender)
+
+	return &types.MsgCollectFeesResponse{CollectedFees: collected}, nil
+}
+
+func (server msgServer) emitMessageEvent(ctx sdk.Context, sender string) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender),
+		),
+	})
+}
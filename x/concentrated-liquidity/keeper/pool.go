@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/concentrated-liquidity/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v7/x/swaprouter/types"
+)
+
+// CreateConcentratedPool creates a pool of denom0 and denom1, charging the
+// sender the pool creation fee. The pool has no price until its first
+// position is created.
+func (k Keeper) CreateConcentratedPool(ctx sdk.Context, sender sdk.AccAddress, denom0, denom1 string, tickSpacing uint64, swapFee sdk.Dec) (uint64, error) {
+	params := k.GetParams(ctx)
+	if !params.IsAuthorizedTickSpacing(tickSpacing) {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidTickSpacing, "tick spacing %d is not one of %v", tickSpacing, params.AuthorizedTickSpacing)
+	}
+
+	// send pool creation fee to community pool
+	if err := k.distrKeeper.FundCommunityPool(ctx, params.PoolCreationFee, sender); err != nil {
+		return 0, err
+	}
+
+	poolId := k.poolManager.GetNextPoolIdAndIncrement(ctx)
+	pool := types.NewConcentratedPool(poolId, denom0, denom1, tickSpacing, swapFee)
+
+	// create and save the pool's module account to the account keeper
+	acc := k.accountKeeper.NewAccount(
+		ctx,
+		authtypes.NewModuleAccount(
+			authtypes.NewBaseAccountWithAddress(pool.GetAddress()),
+			pool.GetAddress().String(),
+		),
+	)
+	k.accountKeeper.SetAccount(ctx, acc)
+
+	k.setPool(ctx, pool)
+	k.poolManager.SetPoolRoute(ctx, poolId, swaproutertypes.Concentrated)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtPoolCreated,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+	))
+
+	return poolId, nil
+}
+
+// GetConcentratedPool returns the concentrated-liquidity pool with the given
+// ID.
+func (k Keeper) GetConcentratedPool(ctx sdk.Context, poolId uint64) (types.Pool, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetKeyPool(poolId))
+	if bz == nil {
+		return types.Pool{}, sdkerrors.Wrapf(types.ErrPoolNotFound, "pool %d", poolId)
+	}
+
+	var pool types.Pool
+	k.cdc.MustUnmarshal(bz, &pool)
+	return pool, nil
+}
+
+// GetPool returns the pool with the given ID, for the swap router.
+func (k Keeper) GetPool(ctx sdk.Context, poolId uint64) (swaproutertypes.PoolI, error) {
+	pool, err := k.GetConcentratedPool(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+	return &pool, nil
+}
+
+// GetAllPools returns every concentrated-liquidity pool, by increasing ID.
+func (k Keeper) GetAllPools(ctx sdk.Context) []types.Pool {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.KeyPrefixPools)
+	defer iter.Close()
+
+	pools := []types.Pool{}
+	for ; iter.Valid(); iter.Next() {
+		var pool types.Pool
+		k.cdc.MustUnmarshal(iter.Value(), &pool)
+		pools = append(pools, pool)
+	}
+	return pools
+}
+
+func (k Keeper) setPool(ctx sdk.Context, pool types.Pool) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetKeyPool(pool.Id), k.cdc.MustMarshal(&pool))
+}
+
+// asConcentratedPool returns a pool the swap router passes back to this
+// module as a concentrated-liquidity pool.
+func asConcentratedPool(pool swaproutertypes.PoolI) (types.Pool, error) {
+	clPool, ok := pool.(*types.Pool)
+	if !ok {
+		return types.Pool{}, sdkerrors.Wrapf(types.ErrNotConcentratedPool, "pool %d", pool.GetId())
+	}
+	return *clPool, nil
+}
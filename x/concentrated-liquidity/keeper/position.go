@@ -0,0 +1,433 @@
+package keeper
+
+import (
+	"strconv"
+
+	gogotypes "github.com/gogo/protobuf/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v7/x/concentrated-liquidity/types"
+)
+
+// CreatePosition provides liquidity to a pool over [lowerTick, upperTick],
+// taking at most tokenDesired0 and tokenDesired1 from the owner, and failing
+// if less than the min amounts would be taken. The first position of a pool
+// sets its price to tokenDesired1 / tokenDesired0.
+func (k Keeper) CreatePosition(
+	ctx sdk.Context,
+	owner sdk.AccAddress,
+	poolId uint64,
+	lowerTick, upperTick int64,
+	tokenDesired0, tokenDesired1 sdk.Int,
+	tokenMinAmount0, tokenMinAmount1 sdk.Int,
+) (positionId uint64, amount0, amount1 sdk.Int, liquidity sdk.Dec, err error) {
+	pool, err := k.GetConcentratedPool(ctx, poolId)
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+	}
+	if err := validatePositionTicks(pool, lowerTick, upperTick); err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+	}
+
+	if !pool.HasPrice() {
+		pool, err = initializePoolPrice(pool, lowerTick, upperTick, tokenDesired0, tokenDesired1)
+		if err != nil {
+			return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+		}
+	}
+
+	position := types.Position{
+		PositionId:           k.getNextPositionIdAndIncrement(ctx),
+		PoolId:               poolId,
+		Owner:                owner.String(),
+		LowerTick:            lowerTick,
+		UpperTick:            upperTick,
+		Liquidity:            sdk.ZeroDec(),
+		FeeGrowthInsideLast0: sdk.ZeroDec(),
+		FeeGrowthInsideLast1: sdk.ZeroDec(),
+		UncollectedFees0:     sdk.ZeroDec(),
+		UncollectedFees1:     sdk.ZeroDec(),
+	}
+	amount0, amount1, liquidity, err = k.depositToPosition(ctx, pool, &position, tokenDesired0, tokenDesired1, tokenMinAmount0, tokenMinAmount1)
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtPositionCreated,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(position.PositionId, 10)),
+		sdk.NewAttribute(types.AttributeKeyLiquidity, liquidity.String()),
+		sdk.NewAttribute(types.AttributeKeyTokensIn, depositCoins(pool, amount0, amount1).String()),
+	))
+
+	return position.PositionId, amount0, amount1, liquidity, nil
+}
+
+// AddToPosition adds liquidity to an existing position of the sender, the
+// same way CreatePosition does.
+func (k Keeper) AddToPosition(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	positionId uint64,
+	tokenDesired0, tokenDesired1 sdk.Int,
+	tokenMinAmount0, tokenMinAmount1 sdk.Int,
+) (amount0, amount1 sdk.Int, liquidity sdk.Dec, err error) {
+	position, err := k.getOwnedPosition(ctx, sender, positionId)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+	}
+	pool, err := k.GetConcentratedPool(ctx, position.PoolId)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+	}
+
+	amount0, amount1, liquidity, err = k.depositToPosition(ctx, pool, &position, tokenDesired0, tokenDesired1, tokenMinAmount0, tokenMinAmount1)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+	}
+
+	k.emitPositionModifiedEvent(ctx, sender, position, liquidity, types.AttributeKeyTokensIn, depositCoins(pool, amount0, amount1))
+	return amount0, amount1, liquidity, nil
+}
+
+// WithdrawPosition removes liquidityAmount of the liquidity of a position of
+// the sender, and sends the tokens it held, along with the position's fees, to
+// the sender. The position is deleted once all of its liquidity is withdrawn.
+func (k Keeper) WithdrawPosition(ctx sdk.Context, sender sdk.AccAddress, positionId uint64, liquidityAmount sdk.Dec) (amount0, amount1 sdk.Int, err error) {
+	position, err := k.getOwnedPosition(ctx, sender, positionId)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+	if liquidityAmount.GT(position.Liquidity) {
+		return sdk.Int{}, sdk.Int{}, sdkerrors.Wrapf(types.ErrInsufficientLiquidity, "position %d has liquidity %s, less than %s", positionId, position.Liquidity, liquidityAmount)
+	}
+	pool, err := k.GetConcentratedPool(ctx, position.PoolId)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	amount0Dec, amount1Dec, err := k.modifyPosition(ctx, pool, &position, liquidityAmount.Neg())
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+	amount0, amount1 = amount0Dec.TruncateInt(), amount1Dec.TruncateInt()
+	fees := takeFees(pool, &position)
+
+	if position.Liquidity.IsZero() {
+		k.deletePosition(ctx, position)
+	} else {
+		k.setPosition(ctx, position)
+	}
+
+	withdrawn := depositCoins(pool, amount0, amount1).Add(fees...)
+	if err := k.bankKeeper.SendCoins(ctx, pool.GetAddress(), sender, withdrawn); err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	k.emitPositionModifiedEvent(ctx, sender, position, liquidityAmount.Neg(), types.AttributeKeyTokensOut, withdrawn)
+	return amount0, amount1, nil
+}
+
+// CollectFees sends the fees the sender's positions have earned to the
+// sender.
+func (k Keeper) CollectFees(ctx sdk.Context, sender sdk.AccAddress, positionIds []uint64) (sdk.Coins, error) {
+	collected := sdk.Coins{}
+	for _, positionId := range positionIds {
+		position, err := k.getOwnedPosition(ctx, sender, positionId)
+		if err != nil {
+			return nil, err
+		}
+		pool, err := k.GetConcentratedPool(ctx, position.PoolId)
+		if err != nil {
+			return nil, err
+		}
+
+		k.accrueFees(ctx, pool, &position)
+		fees := takeFees(pool, &position)
+		k.setPosition(ctx, position)
+
+		if err := k.bankKeeper.SendCoins(ctx, pool.GetAddress(), sender, fees); err != nil {
+			return nil, err
+		}
+		collected = collected.Add(fees...)
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.TypeEvtFeesCollected,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(pool.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+			sdk.NewAttribute(types.AttributeKeyFees, fees.String()),
+		))
+	}
+	return collected, nil
+}
+
+// GetClaimableFees returns the fees CollectFees would send for a position
+// now.
+func (k Keeper) GetClaimableFees(ctx sdk.Context, positionId uint64) (sdk.Coins, error) {
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return nil, err
+	}
+	pool, err := k.GetConcentratedPool(ctx, position.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	k.accrueFees(ctx, pool, &position)
+	return takeFees(pool, &position), nil
+}
+
+// depositToPosition adds the most liquidity the desired amounts provide to a
+// position, and takes the tokens for it from the position's owner.
+func (k Keeper) depositToPosition(
+	ctx sdk.Context,
+	pool types.Pool,
+	position *types.Position,
+	tokenDesired0, tokenDesired1 sdk.Int,
+	tokenMinAmount0, tokenMinAmount1 sdk.Int,
+) (amount0, amount1 sdk.Int, liquidity sdk.Dec, err error) {
+	sqrtPriceLower, sqrtPriceUpper, err := tickRangeSqrtPrices(position.LowerTick, position.UpperTick)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+	}
+	liquidity = types.GetLiquidityFromAmounts(pool.CurrentSqrtPrice, sqrtPriceLower, sqrtPriceUpper, tokenDesired0, tokenDesired1)
+	if !liquidity.IsPositive() {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, types.ErrZeroLiquidity
+	}
+
+	amount0Dec, amount1Dec, err := k.modifyPosition(ctx, pool, position, liquidity)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+	}
+	// rounding up may take a unit more than the liquidity was computed from.
+	amount0 = sdk.MinInt(amount0Dec.Ceil().TruncateInt(), tokenDesired0)
+	amount1 = sdk.MinInt(amount1Dec.Ceil().TruncateInt(), tokenDesired1)
+	if amount0.LT(tokenMinAmount0) || amount1.LT(tokenMinAmount1) {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, sdkerrors.Wrapf(types.ErrLimitMinAmount, "amounts (%s, %s) are less than min amounts (%s, %s)", amount0, amount1, tokenMinAmount0, tokenMinAmount1)
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, position.GetOwnerAddress(), pool.GetAddress(), depositCoins(pool, amount0, amount1)); err != nil {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+	}
+	k.setPosition(ctx, *position)
+	return amount0, amount1, liquidity, nil
+}
+
+// modifyPosition adds liquidityDelta, which may be negative, to a position and
+// to its pool. It returns the amounts of tokens the liquidity delta holds,
+// rounded up when adding liquidity and down when removing it.
+func (k Keeper) modifyPosition(ctx sdk.Context, pool types.Pool, position *types.Position, liquidityDelta sdk.Dec) (amount0, amount1 sdk.Dec, err error) {
+	sqrtPriceLower, sqrtPriceUpper, err := tickRangeSqrtPrices(position.LowerTick, position.UpperTick)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+
+	// fees are accrued before the ticks are updated, as removing the last of
+	// a tick's liquidity deletes it.
+	k.accrueFees(ctx, pool, position)
+	k.addTickLiquidity(ctx, pool, position.LowerTick, liquidityDelta, false)
+	k.addTickLiquidity(ctx, pool, position.UpperTick, liquidityDelta, true)
+	position.Liquidity = position.Liquidity.Add(liquidityDelta)
+
+	if position.LowerTick <= pool.CurrentTick && pool.CurrentTick < position.UpperTick {
+		pool.CurrentTickLiquidity = pool.CurrentTickLiquidity.Add(liquidityDelta)
+	}
+	k.setPool(ctx, pool)
+
+	amount0, amount1 = types.GetAmountsForLiquidity(pool.CurrentSqrtPrice, sqrtPriceLower, sqrtPriceUpper, liquidityDelta.Abs(), liquidityDelta.IsPositive())
+	return amount0, amount1, nil
+}
+
+// accrueFees adds the fees a position earned since they were last accrued to
+// its uncollected fees.
+func (k Keeper) accrueFees(ctx sdk.Context, pool types.Pool, position *types.Position) {
+	feeGrowthInside0, feeGrowthInside1 := k.getFeeGrowthInside(ctx, pool, position.LowerTick, position.UpperTick)
+	position.UncollectedFees0 = position.UncollectedFees0.Add(position.Liquidity.MulTruncate(feeGrowthInside0.Sub(position.FeeGrowthInsideLast0)))
+	position.UncollectedFees1 = position.UncollectedFees1.Add(position.Liquidity.MulTruncate(feeGrowthInside1.Sub(position.FeeGrowthInsideLast1)))
+	position.FeeGrowthInsideLast0 = feeGrowthInside0
+	position.FeeGrowthInsideLast1 = feeGrowthInside1
+}
+
+// takeFees removes the whole tokens of a position's uncollected fees from it,
+// and returns them.
+func takeFees(pool types.Pool, position *types.Position) sdk.Coins {
+	fees0 := position.UncollectedFees0.TruncateInt()
+	fees1 := position.UncollectedFees1.TruncateInt()
+	position.UncollectedFees0 = position.UncollectedFees0.Sub(fees0.ToDec())
+	position.UncollectedFees1 = position.UncollectedFees1.Sub(fees1.ToDec())
+	return depositCoins(pool, fees0, fees1)
+}
+
+// GetPosition returns the position with the given ID.
+func (k Keeper) GetPosition(ctx sdk.Context, positionId uint64) (types.Position, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetKeyPosition(positionId))
+	if bz == nil {
+		return types.Position{}, sdkerrors.Wrapf(types.ErrPositionNotFound, "position %d", positionId)
+	}
+
+	var position types.Position
+	k.cdc.MustUnmarshal(bz, &position)
+	return position, nil
+}
+
+// GetUserPositions returns the positions owned by an address, by increasing
+// ID.
+func (k Keeper) GetUserPositions(ctx sdk.Context, owner sdk.AccAddress) ([]types.Position, error) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetKeyPrefixOwnerPositions(owner)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+
+	positions := []types.Position{}
+	for ; iter.Valid(); iter.Next() {
+		position, err := k.GetPosition(ctx, sdk.BigEndianToUint64(iter.Key()[len(prefix):]))
+		if err != nil {
+			return nil, err
+		}
+		positions = append(positions, position)
+	}
+	return positions, nil
+}
+
+// GetAllPositions returns every position, by increasing ID.
+func (k Keeper) GetAllPositions(ctx sdk.Context) []types.Position {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.KeyPrefixPositions)
+	defer iter.Close()
+
+	positions := []types.Position{}
+	for ; iter.Valid(); iter.Next() {
+		var position types.Position
+		k.cdc.MustUnmarshal(iter.Value(), &position)
+		positions = append(positions, position)
+	}
+	return positions
+}
+
+// GetNextPositionId returns the ID the next position will have.
+func (k Keeper) GetNextPositionId(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyNextPositionId)
+	if bz == nil {
+		panic("next position id has not been set")
+	}
+
+	nextPositionId := gogotypes.UInt64Value{}
+	k.cdc.MustUnmarshal(bz, &nextPositionId)
+	return nextPositionId.Value
+}
+
+// SetNextPositionId sets the ID the next position will have.
+func (k Keeper) SetNextPositionId(ctx sdk.Context, positionId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: positionId})
+	store.Set(types.KeyNextPositionId, bz)
+}
+
+func (k Keeper) getNextPositionIdAndIncrement(ctx sdk.Context) uint64 {
+	nextPositionId := k.GetNextPositionId(ctx)
+	k.SetNextPositionId(ctx, nextPositionId+1)
+	return nextPositionId
+}
+
+func (k Keeper) setPosition(ctx sdk.Context, position types.Position) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetKeyPosition(position.PositionId), k.cdc.MustMarshal(&position))
+	store.Set(types.GetKeyOwnerPosition(position.GetOwnerAddress(), position.PositionId), []byte{})
+}
+
+func (k Keeper) deletePosition(ctx sdk.Context, position types.Position) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetKeyPosition(position.PositionId))
+	store.Delete(types.GetKeyOwnerPosition(position.GetOwnerAddress(), position.PositionId))
+}
+
+// getOwnedPosition returns a position, checking that sender owns it.
+func (k Keeper) getOwnedPosition(ctx sdk.Context, sender sdk.AccAddress, positionId uint64) (types.Position, error) {
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return types.Position{}, err
+	}
+	if position.Owner != sender.String() {
+		return types.Position{}, sdkerrors.Wrapf(types.ErrNotPositionOwner, "position %d is owned by %s", positionId, position.Owner)
+	}
+	return position, nil
+}
+
+func (k Keeper) emitPositionModifiedEvent(ctx sdk.Context, sender sdk.AccAddress, position types.Position, liquidityDelta sdk.Dec, tokensKey string, tokens sdk.Coins) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtPositionModified,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(position.PoolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(position.PositionId, 10)),
+		sdk.NewAttribute(types.AttributeKeyLiquidity, liquidityDelta.String()),
+		sdk.NewAttribute(tokensKey, tokens.String()),
+	))
+}
+
+// initializePoolPrice sets the price of a pool without one to that of the
+// amounts of its first position, which must both be positive and in the
+// position's range.
+func initializePoolPrice(pool types.Pool, lowerTick, upperTick int64, amount0, amount1 sdk.Int) (types.Pool, error) {
+	if !amount0.IsPositive() || !amount1.IsPositive() {
+		return types.Pool{}, types.ErrInvalidInitialLiquidity
+	}
+
+	sqrtPrice, err := amount1.ToDec().Quo(amount0.ToDec()).ApproxSqrt()
+	if err != nil {
+		return types.Pool{}, err
+	}
+	sqrtPriceLower, sqrtPriceUpper, err := tickRangeSqrtPrices(lowerTick, upperTick)
+	if err != nil {
+		return types.Pool{}, err
+	}
+	if sqrtPrice.LTE(sqrtPriceLower) || sqrtPrice.GTE(sqrtPriceUpper) {
+		return types.Pool{}, sdkerrors.Wrapf(types.ErrInitialPriceOutOfRange, "price %s is outside ticks [%d, %d]", sqrtPrice.Power(2), lowerTick, upperTick)
+	}
+	currentTick, err := types.SqrtPriceToTick(sqrtPrice)
+	if err != nil {
+		return types.Pool{}, err
+	}
+
+	pool.CurrentSqrtPrice = sqrtPrice
+	pool.CurrentTick = currentTick
+	return pool, nil
+}
+
+// validatePositionTicks checks that a position's ticks are a valid range of
+// the pool's tick spacing.
+func validatePositionTicks(pool types.Pool, lowerTick, upperTick int64) error {
+	if err := types.ValidateTickRange(lowerTick, upperTick); err != nil {
+		return err
+	}
+	tickSpacing := int64(pool.TickSpacing)
+	if lowerTick%tickSpacing != 0 || upperTick%tickSpacing != 0 {
+		return sdkerrors.Wrapf(types.ErrInvalidTickRange, "ticks [%d, %d] are not multiples of the pool's tick spacing %d", lowerTick, upperTick, tickSpacing)
+	}
+	return nil
+}
+
+func tickRangeSqrtPrices(lowerTick, upperTick int64) (sdk.Dec, sdk.Dec, error) {
+	sqrtPriceLower, err := types.TickToSqrtPrice(lowerTick)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+	sqrtPriceUpper, err := types.TickToSqrtPrice(upperTick)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+	return sqrtPriceLower, sqrtPriceUpper, nil
+}
+
+func depositCoins(pool types.Pool, amount0, amount1 sdk.Int) sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(pool.Token0, amount0), sdk.NewCoin(pool.Token1, amount1))
+}
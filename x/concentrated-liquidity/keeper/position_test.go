@@ -0,0 +1,184 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/concentrated-liquidity/types"
+)
+
+func (suite *KeeperTestSuite) TestCreatePosition() {
+	tests := []struct {
+		name                 string
+		lowerTick, upperTick int64
+		desired0, desired1   sdk.Int
+		minAmount0           sdk.Int
+		expectedErr          error
+	}{
+		{
+			name:      "range around the initial price",
+			lowerTick: -1000, upperTick: 1000,
+			desired0: sdk.NewInt(1000000), desired1: sdk.NewInt(1000000),
+			minAmount0: sdk.ZeroInt(),
+		},
+		{
+			name:      "ticks not a multiple of the tick spacing",
+			lowerTick: -1005, upperTick: 1000,
+			desired0: sdk.NewInt(1000000), desired1: sdk.NewInt(1000000),
+			minAmount0:  sdk.ZeroInt(),
+			expectedErr: types.ErrInvalidTickRange,
+		},
+		{
+			name:      "lower tick above upper tick",
+			lowerTick: 1000, upperTick: -1000,
+			desired0: sdk.NewInt(1000000), desired1: sdk.NewInt(1000000),
+			minAmount0:  sdk.ZeroInt(),
+			expectedErr: types.ErrInvalidTickRange,
+		},
+		{
+			name:      "initial price out of the range",
+			lowerTick: 100, upperTick: 1000,
+			desired0: sdk.NewInt(1000000), desired1: sdk.NewInt(1000000),
+			minAmount0:  sdk.ZeroInt(),
+			expectedErr: types.ErrInitialPriceOutOfRange,
+		},
+		{
+			name:      "initial deposit of a single token",
+			lowerTick: -1000, upperTick: 1000,
+			desired0: sdk.ZeroInt(), desired1: sdk.NewInt(1000000),
+			minAmount0:  sdk.ZeroInt(),
+			expectedErr: types.ErrInvalidInitialLiquidity,
+		},
+		{
+			name:      "less than the min amount deposited",
+			lowerTick: -1000, upperTick: 1000,
+			desired0: sdk.NewInt(1000000), desired1: sdk.NewInt(1000000),
+			minAmount0:  sdk.NewInt(1000001),
+			expectedErr: types.ErrLimitMinAmount,
+		},
+	}
+
+	for _, test := range tests {
+		suite.Run(test.name, func() {
+			suite.SetupTest()
+			poolId := suite.prepareConcentratedPool()
+			balanceBefore := suite.App.BankKeeper.GetAllBalances(suite.Ctx, acc1)
+
+			positionId, amount0, amount1, liquidity, err := suite.App.ConcentratedLiquidityKeeper.CreatePosition(
+				suite.Ctx, acc1, poolId, test.lowerTick, test.upperTick, test.desired0, test.desired1, test.minAmount0, sdk.ZeroInt())
+			if test.expectedErr != nil {
+				suite.Require().ErrorIs(err, test.expectedErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			// the first position sets the price to desired1 / desired0, and
+			// takes at most the desired amounts.
+			pool, err := suite.App.ConcentratedLiquidityKeeper.GetConcentratedPool(suite.Ctx, poolId)
+			suite.Require().NoError(err)
+			suite.Require().True(pool.IsActive(suite.Ctx))
+			suite.Require().Equal(sdk.OneDec(), pool.CurrentSqrtPrice)
+			suite.Require().Equal(int64(0), pool.CurrentTick)
+			suite.Require().Equal(liquidity, pool.CurrentTickLiquidity)
+			suite.Require().True(amount0.LTE(test.desired0) && amount1.LTE(test.desired1))
+			suite.Require().True(amount0.Equal(test.desired0) || amount1.Equal(test.desired1))
+
+			deposited := sdk.NewCoins(sdk.NewCoin("bar", amount0), sdk.NewCoin("foo", amount1))
+			suite.Require().Equal(balanceBefore.Sub(deposited), suite.App.BankKeeper.GetAllBalances(suite.Ctx, acc1))
+			suite.Require().Equal(deposited, suite.App.BankKeeper.GetAllBalances(suite.Ctx, pool.GetAddress()))
+
+			position, err := suite.App.ConcentratedLiquidityKeeper.GetPosition(suite.Ctx, positionId)
+			suite.Require().NoError(err)
+			suite.Require().Equal(liquidity, position.Liquidity)
+
+			ticks := suite.App.ConcentratedLiquidityKeeper.GetAllTicks(suite.Ctx)
+			suite.Require().Len(ticks, 2)
+			suite.Require().Equal(test.lowerTick, ticks[0].TickIndex)
+			suite.Require().Equal(liquidity, ticks[0].Info.LiquidityNet)
+			suite.Require().Equal(test.upperTick, ticks[1].TickIndex)
+			suite.Require().Equal(liquidity.Neg(), ticks[1].Info.LiquidityNet)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPositionOutOfRange() {
+	poolId := suite.prepareConcentratedPool()
+	suite.preparePosition(poolId, -1000, 1000)
+
+	// a range above the price only takes token0, and does not change the
+	// liquidity at the current tick.
+	positionId, amount0, amount1, liquidity, err := suite.App.ConcentratedLiquidityKeeper.CreatePosition(
+		suite.Ctx, acc2, poolId, 100, 200, sdk.NewInt(1000000), sdk.NewInt(1000000), sdk.ZeroInt(), sdk.ZeroInt())
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(1000000), amount0)
+	suite.Require().True(amount1.IsZero())
+	suite.Require().True(liquidity.IsPositive())
+
+	pool, err := suite.App.ConcentratedLiquidityKeeper.GetConcentratedPool(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	position, err := suite.App.ConcentratedLiquidityKeeper.GetPosition(suite.Ctx, positionId-1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(position.Liquidity, pool.CurrentTickLiquidity)
+}
+
+func (suite *KeeperTestSuite) TestAddToPosition() {
+	poolId := suite.prepareConcentratedPool()
+	positionId := suite.preparePosition(poolId, -1000, 1000)
+	position, err := suite.App.ConcentratedLiquidityKeeper.GetPosition(suite.Ctx, positionId)
+	suite.Require().NoError(err)
+
+	// only the owner can add to a position.
+	_, _, _, err = suite.App.ConcentratedLiquidityKeeper.AddToPosition(
+		suite.Ctx, acc2, positionId, sdk.NewInt(1000000), sdk.NewInt(1000000), sdk.ZeroInt(), sdk.ZeroInt())
+	suite.Require().ErrorIs(err, types.ErrNotPositionOwner)
+
+	_, _, liquidity, err := suite.App.ConcentratedLiquidityKeeper.AddToPosition(
+		suite.Ctx, acc1, positionId, sdk.NewInt(1000000), sdk.NewInt(1000000), sdk.ZeroInt(), sdk.ZeroInt())
+	suite.Require().NoError(err)
+	suite.Require().Equal(position.Liquidity, liquidity)
+
+	added, err := suite.App.ConcentratedLiquidityKeeper.GetPosition(suite.Ctx, positionId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(position.Liquidity.MulInt64(2), added.Liquidity)
+	pool, err := suite.App.ConcentratedLiquidityKeeper.GetConcentratedPool(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(added.Liquidity, pool.CurrentTickLiquidity)
+}
+
+func (suite *KeeperTestSuite) TestWithdrawPosition() {
+	poolId := suite.prepareConcentratedPool()
+	balanceBefore := suite.App.BankKeeper.GetAllBalances(suite.Ctx, acc1)
+	positionId := suite.preparePosition(poolId, -1000, 1000)
+	position, err := suite.App.ConcentratedLiquidityKeeper.GetPosition(suite.Ctx, positionId)
+	suite.Require().NoError(err)
+
+	_, _, err = suite.App.ConcentratedLiquidityKeeper.WithdrawPosition(suite.Ctx, acc2, positionId, position.Liquidity)
+	suite.Require().ErrorIs(err, types.ErrNotPositionOwner)
+	_, _, err = suite.App.ConcentratedLiquidityKeeper.WithdrawPosition(suite.Ctx, acc1, positionId, position.Liquidity.Add(sdk.OneDec()))
+	suite.Require().ErrorIs(err, types.ErrInsufficientLiquidity)
+
+	// withdrawing half keeps the position.
+	half := position.Liquidity.QuoInt64(2)
+	_, _, err = suite.App.ConcentratedLiquidityKeeper.WithdrawPosition(suite.Ctx, acc1, positionId, half)
+	suite.Require().NoError(err)
+	position, err = suite.App.ConcentratedLiquidityKeeper.GetPosition(suite.Ctx, positionId)
+	suite.Require().NoError(err)
+
+	// withdrawing the rest deletes the position and its ticks, and returns
+	// everything deposited, but for rounding.
+	_, _, err = suite.App.ConcentratedLiquidityKeeper.WithdrawPosition(suite.Ctx, acc1, positionId, position.Liquidity)
+	suite.Require().NoError(err)
+	_, err = suite.App.ConcentratedLiquidityKeeper.GetPosition(suite.Ctx, positionId)
+	suite.Require().ErrorIs(err, types.ErrPositionNotFound)
+	suite.Require().Len(suite.App.ConcentratedLiquidityKeeper.GetAllTicks(suite.Ctx), 0)
+
+	pool, err := suite.App.ConcentratedLiquidityKeeper.GetConcentratedPool(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	suite.Require().True(pool.CurrentTickLiquidity.IsZero())
+	for _, coin := range suite.App.BankKeeper.GetAllBalances(suite.Ctx, pool.GetAddress()) {
+		suite.Require().True(coin.Amount.LTE(sdk.NewInt(2)), "pool kept %s", coin)
+	}
+	balanceAfter := suite.App.BankKeeper.GetAllBalances(suite.Ctx, acc1)
+	for _, coin := range balanceBefore {
+		suite.Require().True(coin.Amount.Sub(balanceAfter.AmountOf(coin.Denom)).LTE(sdk.NewInt(2)))
+	}
+}
@@ -0,0 +1,254 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v7/x/concentrated-liquidity/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v7/x/swaprouter/types"
+)
+
+// SwapExactAmountIn swaps tokenIn in a pool for tokenOutDenom, charging
+// swapFee, and fails if less than tokenOutMinAmount comes out.
+func (k Keeper) SwapExactAmountIn(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolI swaproutertypes.PoolI,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	tokenOutMinAmount sdk.Int,
+	swapFee sdk.Dec,
+) (tokenOutAmount sdk.Int, err error) {
+	pool, err := asConcentratedPool(poolI)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	tokenOut, err := k.swapOutGivenIn(cacheCtx, pool, tokenIn, tokenOutDenom, swapFee)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	if !tokenOut.Amount.IsPositive() {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrLimitMinAmount, "token amount must be positive")
+	}
+	if tokenOut.Amount.LT(tokenOutMinAmount) {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrLimitMinAmount, "%s token is lesser than min amount", tokenOutDenom)
+	}
+	write()
+
+	if err := k.settleSwap(ctx, sender, pool, tokenIn, tokenOut); err != nil {
+		return sdk.Int{}, err
+	}
+	return tokenOut.Amount, nil
+}
+
+// CalcOutAmtGivenIn returns how many tokens SwapExactAmountIn would return on
+// these arguments, without changing the pool.
+func (k Keeper) CalcOutAmtGivenIn(
+	ctx sdk.Context,
+	poolI swaproutertypes.PoolI,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	swapFee sdk.Dec,
+) (tokenOut sdk.Coin, err error) {
+	pool, err := asConcentratedPool(poolI)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	return k.swapOutGivenIn(cacheCtx, pool, tokenIn, tokenOutDenom, swapFee)
+}
+
+// SwapExactAmountOut swaps tokenInDenom in a pool for tokenOut, charging
+// swapFee, and fails if more than tokenInMaxAmount goes in.
+func (k Keeper) SwapExactAmountOut(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolI swaproutertypes.PoolI,
+	tokenInDenom string,
+	tokenInMaxAmount sdk.Int,
+	tokenOut sdk.Coin,
+	swapFee sdk.Dec,
+) (tokenInAmount sdk.Int, err error) {
+	pool, err := asConcentratedPool(poolI)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	tokenIn, err := k.swapInGivenOut(cacheCtx, pool, tokenOut, tokenInDenom, swapFee)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	if tokenIn.Amount.GT(tokenInMaxAmount) {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrLimitMaxAmount, "swap requires %s, which is greater than the amount %s", tokenIn, tokenInMaxAmount)
+	}
+	write()
+
+	if err := k.settleSwap(ctx, sender, pool, tokenIn, tokenOut); err != nil {
+		return sdk.Int{}, err
+	}
+	return tokenIn.Amount, nil
+}
+
+// CalcInAmtGivenOut returns how many tokens SwapExactAmountOut would take on
+// these arguments, without changing the pool.
+func (k Keeper) CalcInAmtGivenOut(
+	ctx sdk.Context,
+	poolI swaproutertypes.PoolI,
+	tokenOut sdk.Coin,
+	tokenInDenom string,
+	swapFee sdk.Dec,
+) (tokenIn sdk.Coin, err error) {
+	pool, err := asConcentratedPool(poolI)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	return k.swapInGivenOut(cacheCtx, pool, tokenOut, tokenInDenom, swapFee)
+}
+
+// swapOutGivenIn swaps tokenIn in a pool, crossing as many ticks as it takes,
+// and stores the pool's new state. It does not move any tokens.
+func (k Keeper) swapOutGivenIn(ctx sdk.Context, pool types.Pool, tokenIn sdk.Coin, tokenOutDenom string, swapFee sdk.Dec) (sdk.Coin, error) {
+	zeroForOne, err := swapDirection(pool, tokenIn.Denom, tokenOutDenom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	amountRemainingIn := tokenIn.Amount.ToDec()
+	amountOut := sdk.ZeroDec()
+	for amountRemainingIn.IsPositive() {
+		nextTick, sqrtPriceTarget, err := k.nextSwapTarget(ctx, pool, zeroForOne)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+
+		sqrtPriceNext, stepIn, stepOut, stepFee := types.ComputeSwapStepOutGivenIn(
+			pool.CurrentSqrtPrice, sqrtPriceTarget, pool.CurrentTickLiquidity, amountRemainingIn, swapFee)
+		amountRemainingIn = amountRemainingIn.Sub(stepIn).Sub(stepFee)
+		amountOut = amountOut.Add(stepOut)
+
+		pool, err = k.advanceSwap(ctx, pool, stepFee, sqrtPriceNext, sqrtPriceTarget, nextTick, zeroForOne)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	k.setPool(ctx, pool)
+	return sdk.NewCoin(tokenOutDenom, amountOut.TruncateInt()), nil
+}
+
+// swapInGivenOut swaps for tokenOut in a pool, crossing as many ticks as it
+// takes, and stores the pool's new state. It does not move any tokens.
+func (k Keeper) swapInGivenOut(ctx sdk.Context, pool types.Pool, tokenOut sdk.Coin, tokenInDenom string, swapFee sdk.Dec) (sdk.Coin, error) {
+	zeroForOne, err := swapDirection(pool, tokenInDenom, tokenOut.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	amountRemainingOut := tokenOut.Amount.ToDec()
+	amountIn := sdk.ZeroDec()
+	for amountRemainingOut.IsPositive() {
+		nextTick, sqrtPriceTarget, err := k.nextSwapTarget(ctx, pool, zeroForOne)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+
+		sqrtPriceNext, stepIn, stepOut, stepFee := types.ComputeSwapStepInGivenOut(
+			pool.CurrentSqrtPrice, sqrtPriceTarget, pool.CurrentTickLiquidity, amountRemainingOut, swapFee)
+		amountRemainingOut = amountRemainingOut.Sub(stepOut)
+		amountIn = amountIn.Add(stepIn).Add(stepFee)
+
+		pool, err = k.advanceSwap(ctx, pool, stepFee, sqrtPriceNext, sqrtPriceTarget, nextTick, zeroForOne)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	k.setPool(ctx, pool)
+	return sdk.NewCoin(tokenInDenom, amountIn.Ceil().TruncateInt()), nil
+}
+
+// nextSwapTarget returns the next tick a swap can cross, and the square root
+// of its price.
+func (k Keeper) nextSwapTarget(ctx sdk.Context, pool types.Pool, zeroForOne bool) (int64, sdk.Dec, error) {
+	nextTick, ok := k.nextInitializedTick(ctx, pool, zeroForOne)
+	// there is no liquidity past the last initialized tick.
+	if !ok {
+		return 0, sdk.Dec{}, sdkerrors.Wrapf(types.ErrNotEnoughLiquidity, "pool %d", pool.Id)
+	}
+	sqrtPriceTarget, err := types.TickToSqrtPrice(nextTick)
+	if err != nil {
+		return 0, sdk.Dec{}, err
+	}
+	return nextTick, sqrtPriceTarget, nil
+}
+
+// advanceSwap charges a swap step's fee to the liquidity it swapped against,
+// and moves the pool's price to where the step stopped, crossing the target
+// tick if the step reached it.
+func (k Keeper) advanceSwap(ctx sdk.Context, pool types.Pool, fee, sqrtPriceNext, sqrtPriceTarget sdk.Dec, targetTick int64, zeroForOne bool) (types.Pool, error) {
+	if pool.CurrentTickLiquidity.IsPositive() {
+		feeGrowth := fee.QuoTruncate(pool.CurrentTickLiquidity)
+		if zeroForOne {
+			pool.FeeGrowthGlobal0 = pool.FeeGrowthGlobal0.Add(feeGrowth)
+		} else {
+			pool.FeeGrowthGlobal1 = pool.FeeGrowthGlobal1.Add(feeGrowth)
+		}
+	}
+
+	pool.CurrentSqrtPrice = sqrtPriceNext
+	if !sqrtPriceNext.Equal(sqrtPriceTarget) {
+		currentTick, err := types.SqrtPriceToTick(sqrtPriceNext)
+		if err != nil {
+			return types.Pool{}, err
+		}
+		pool.CurrentTick = currentTick
+		return pool, nil
+	}
+
+	liquidityNet := k.crossTick(ctx, pool, targetTick)
+	if zeroForOne {
+		pool.CurrentTickLiquidity = pool.CurrentTickLiquidity.Sub(liquidityNet)
+		pool.CurrentTick = targetTick - 1
+	} else {
+		pool.CurrentTickLiquidity = pool.CurrentTickLiquidity.Add(liquidityNet)
+		pool.CurrentTick = targetTick
+	}
+	return pool, nil
+}
+
+// settleSwap moves the tokens of a swap between the sender and the pool.
+func (k Keeper) settleSwap(ctx sdk.Context, sender sdk.AccAddress, pool types.Pool, tokenIn, tokenOut sdk.Coin) error {
+	if err := k.bankKeeper.SendCoins(ctx, sender, pool.GetAddress(), sdk.NewCoins(tokenIn)); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoins(ctx, pool.GetAddress(), sender, sdk.NewCoins(tokenOut)); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtTokenSwapped,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(pool.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyTokensIn, tokenIn.String()),
+		sdk.NewAttribute(types.AttributeKeyTokensOut, tokenOut.String()),
+	))
+	return nil
+}
+
+// swapDirection returns whether a swap between the given denoms takes token0
+// in and token1 out of the pool, lowering its price.
+func swapDirection(pool types.Pool, tokenInDenom, tokenOutDenom string) (zeroForOne bool, err error) {
+	if !pool.HasDenom(tokenInDenom) || !pool.HasDenom(tokenOutDenom) || tokenInDenom == tokenOutDenom {
+		return false, sdkerrors.Wrapf(types.ErrInvalidDenom, "cannot swap %s for %s in pool %d of %s and %s", tokenInDenom, tokenOutDenom, pool.Id, pool.Token0, pool.Token1)
+	}
+	return tokenInDenom == pool.Token0, nil
+}
@@ -0,0 +1,234 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/concentrated-liquidity/types"
+	gammtypes "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+	swaprouterkeeper "github.com/osmosis-labs/osmosis/v7/x/swaprouter/keeper"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v7/x/swaprouter/types"
+)
+
+func (suite *KeeperTestSuite) TestSwapCrossingTicks() {
+	tests := []struct {
+		name          string
+		tokenIn       sdk.Coin
+		tokenOutDenom string
+		// whether the swap leaves the narrow range.
+		crossesTick bool
+	}{
+		{"bar for foo, within the narrow range", sdk.NewInt64Coin("bar", 100000), "foo", false},
+		{"foo for bar, within the narrow range", sdk.NewInt64Coin("foo", 100000), "bar", false},
+		{"bar for foo, out of the narrow range", sdk.NewInt64Coin("bar", 1500000), "foo", true},
+		{"foo for bar, out of the narrow range", sdk.NewInt64Coin("foo", 1500000), "bar", true},
+	}
+
+	for _, test := range tests {
+		suite.Run(test.name, func() {
+			suite.SetupTest()
+			poolId := suite.prepareConcentratedPool()
+			wideId := suite.preparePosition(poolId, -10000, 10000)
+			narrowId := suite.preparePosition(poolId, -100, 100)
+			wide, err := suite.App.ConcentratedLiquidityKeeper.GetPosition(suite.Ctx, wideId)
+			suite.Require().NoError(err)
+			narrow, err := suite.App.ConcentratedLiquidityKeeper.GetPosition(suite.Ctx, narrowId)
+			suite.Require().NoError(err)
+
+			poolI, err := suite.App.ConcentratedLiquidityKeeper.GetPool(suite.Ctx, poolId)
+			suite.Require().NoError(err)
+			swapFee := poolI.GetSwapFee(suite.Ctx)
+			estimate, err := suite.App.ConcentratedLiquidityKeeper.CalcOutAmtGivenIn(suite.Ctx, poolI, test.tokenIn, test.tokenOutDenom, swapFee)
+			suite.Require().NoError(err)
+
+			tokenOutAmount, err := suite.App.ConcentratedLiquidityKeeper.SwapExactAmountIn(suite.Ctx, acc2, poolI, test.tokenIn, test.tokenOutDenom, sdk.OneInt(), swapFee)
+			suite.Require().NoError(err)
+			suite.Require().Equal(estimate.Amount, tokenOutAmount)
+			suite.Require().True(tokenOutAmount.LT(test.tokenIn.Amount))
+
+			pool, err := suite.App.ConcentratedLiquidityKeeper.GetConcentratedPool(suite.Ctx, poolId)
+			suite.Require().NoError(err)
+			if test.crossesTick {
+				suite.Require().True(pool.CurrentTick < -100 || pool.CurrentTick >= 100, "tick %d", pool.CurrentTick)
+				suite.Require().Equal(wide.Liquidity, pool.CurrentTickLiquidity)
+			} else {
+				suite.Require().True(pool.CurrentTick >= -100 && pool.CurrentTick < 100, "tick %d", pool.CurrentTick)
+				suite.Require().Equal(wide.Liquidity.Add(narrow.Liquidity), pool.CurrentTickLiquidity)
+			}
+
+			// both positions earned fees in the token swapped in, the narrow
+			// one at a higher rate while in range.
+			wideFees, err := suite.App.ConcentratedLiquidityKeeper.GetClaimableFees(suite.Ctx, wideId)
+			suite.Require().NoError(err)
+			narrowFees, err := suite.App.ConcentratedLiquidityKeeper.GetClaimableFees(suite.Ctx, narrowId)
+			suite.Require().NoError(err)
+			suite.Require().True(wideFees.AmountOf(test.tokenIn.Denom).IsPositive())
+			suite.Require().True(narrowFees.AmountOf(test.tokenIn.Denom).IsPositive())
+			suite.Require().True(wideFees.AmountOf(test.tokenOutDenom).IsZero())
+			totalFees := wideFees.Add(narrowFees...).AmountOf(test.tokenIn.Denom)
+			suite.Require().True(totalFees.LTE(swapFee.MulInt(test.tokenIn.Amount).Ceil().TruncateInt()))
+
+			// collecting sends the fees, after which none are left to claim.
+			balanceBefore := suite.App.BankKeeper.GetAllBalances(suite.Ctx, acc1)
+			collected, err := suite.App.ConcentratedLiquidityKeeper.CollectFees(suite.Ctx, acc1, []uint64{wideId, narrowId})
+			suite.Require().NoError(err)
+			suite.Require().Equal(wideFees.Add(narrowFees...), collected)
+			suite.Require().Equal(balanceBefore.Add(collected...), suite.App.BankKeeper.GetAllBalances(suite.Ctx, acc1))
+			wideFees, err = suite.App.ConcentratedLiquidityKeeper.GetClaimableFees(suite.Ctx, wideId)
+			suite.Require().NoError(err)
+			suite.Require().True(wideFees.Empty())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSwapExactAmountOut() {
+	poolId := suite.prepareConcentratedPool()
+	suite.preparePosition(poolId, -10000, 10000)
+	suite.preparePosition(poolId, -100, 100)
+	poolI, err := suite.App.ConcentratedLiquidityKeeper.GetPool(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	swapFee := poolI.GetSwapFee(suite.Ctx)
+	tokenOut := sdk.NewInt64Coin("foo", 300000)
+
+	estimate, err := suite.App.ConcentratedLiquidityKeeper.CalcInAmtGivenOut(suite.Ctx, poolI, tokenOut, "bar", swapFee)
+	suite.Require().NoError(err)
+	suite.Require().True(estimate.Amount.GT(tokenOut.Amount))
+
+	_, err = suite.App.ConcentratedLiquidityKeeper.SwapExactAmountOut(suite.Ctx, acc2, poolI, "bar", estimate.Amount.SubRaw(1), tokenOut, swapFee)
+	suite.Require().ErrorIs(err, types.ErrLimitMaxAmount)
+
+	balanceBefore := suite.App.BankKeeper.GetAllBalances(suite.Ctx, acc2)
+	tokenInAmount, err := suite.App.ConcentratedLiquidityKeeper.SwapExactAmountOut(suite.Ctx, acc2, poolI, "bar", estimate.Amount, tokenOut, swapFee)
+	suite.Require().NoError(err)
+	suite.Require().Equal(estimate.Amount, tokenInAmount)
+	expectedBalance := balanceBefore.Sub(sdk.NewCoins(estimate)).Add(tokenOut)
+	suite.Require().Equal(expectedBalance, suite.App.BankKeeper.GetAllBalances(suite.Ctx, acc2))
+}
+
+func (suite *KeeperTestSuite) TestSwapErrors() {
+	poolId := suite.prepareConcentratedPool()
+	poolI, err := suite.App.ConcentratedLiquidityKeeper.GetPool(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	swapFee := poolI.GetSwapFee(suite.Ctx)
+
+	// a pool without positions has nothing to swap against.
+	_, err = suite.App.ConcentratedLiquidityKeeper.CalcOutAmtGivenIn(suite.Ctx, poolI, sdk.NewInt64Coin("bar", 1000), "foo", swapFee)
+	suite.Require().ErrorIs(err, types.ErrNotEnoughLiquidity)
+
+	suite.preparePosition(poolId, -100, 100)
+	poolI, err = suite.App.ConcentratedLiquidityKeeper.GetPool(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	poolBefore, err := suite.App.ConcentratedLiquidityKeeper.GetConcentratedPool(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+
+	_, err = suite.App.ConcentratedLiquidityKeeper.SwapExactAmountIn(suite.Ctx, acc2, poolI, sdk.NewInt64Coin("bar", 1000), "baz", sdk.OneInt(), swapFee)
+	suite.Require().ErrorIs(err, types.ErrInvalidDenom)
+	// swapping past the last tick runs out of liquidity.
+	_, err = suite.App.ConcentratedLiquidityKeeper.SwapExactAmountIn(suite.Ctx, acc2, poolI, sdk.NewInt64Coin("bar", 10000000), "foo", sdk.OneInt(), swapFee)
+	suite.Require().ErrorIs(err, types.ErrNotEnoughLiquidity)
+	_, err = suite.App.ConcentratedLiquidityKeeper.SwapExactAmountIn(suite.Ctx, acc2, poolI, sdk.NewInt64Coin("bar", 1000), "foo", sdk.NewInt(1000), swapFee)
+	suite.Require().ErrorIs(err, types.ErrLimitMinAmount)
+
+	// failed swaps leave the pool as it was.
+	poolAfter, err := suite.App.ConcentratedLiquidityKeeper.GetConcentratedPool(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(poolBefore, poolAfter)
+}
+
+func (suite *KeeperTestSuite) TestRouteThroughSwapRouter() {
+	poolId := suite.prepareConcentratedPool()
+	suite.preparePosition(poolId, -10000, 10000)
+	tokenIn := sdk.NewInt64Coin("bar", 100000)
+	routes := []swaproutertypes.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: "foo"}}
+	querier := swaprouterkeeper.NewQuerier(*suite.App.SwapRouterKeeper)
+	// the estimate queries swap, so they run on a copy of the state.
+	queryCtx, _ := suite.Ctx.CacheContext()
+
+	estimateRes, err := querier.EstimateSwapExactAmountIn(sdk.WrapSDKContext(queryCtx), &swaproutertypes.QueryEstimateSwapExactAmountInRequest{
+		Sender:  acc2.String(),
+		TokenIn: tokenIn.String(),
+		Routes:  routes,
+	})
+	suite.Require().NoError(err)
+
+	tokenOutAmount, err := suite.App.SwapRouterKeeper.RouteExactAmountIn(suite.Ctx, acc2, routes, tokenIn, sdk.OneInt())
+	suite.Require().NoError(err)
+	suite.Require().Equal(estimateRes.TokenOutAmount, tokenOutAmount)
+
+	queryCtx, _ = suite.Ctx.CacheContext()
+	tokenOut := sdk.NewInt64Coin("bar", 50000)
+	outRoutes := []swaproutertypes.SwapAmountOutRoute{{PoolId: poolId, TokenInDenom: "foo"}}
+	estimateOutRes, err := querier.EstimateSwapExactAmountOut(sdk.WrapSDKContext(queryCtx), &swaproutertypes.QueryEstimateSwapExactAmountOutRequest{
+		Sender:   acc2.String(),
+		Routes:   outRoutes,
+		TokenOut: tokenOut.String(),
+	})
+	suite.Require().NoError(err)
+
+	tokenInAmount, err := suite.App.SwapRouterKeeper.RouteExactAmountOut(suite.Ctx, acc2, outRoutes, sdk.NewInt(1000000), tokenOut)
+	suite.Require().NoError(err)
+	suite.Require().Equal(estimateOutRes.TokenInAmount, tokenInAmount)
+}
+
+func (suite *KeeperTestSuite) TestTakerFeeThroughSwapRouter() {
+	poolId := suite.prepareConcentratedPool()
+	suite.preparePosition(poolId, -10000, 10000)
+	suite.App.GAMMKeeper.SetTakerFeeParams(suite.Ctx, gammtypes.TakerFeeParams{
+		TakerFee:    sdk.NewDecWithPrec(1, 2),
+		Overrides:   []gammtypes.TakerFeeOverride{},
+		Destination: gammtypes.CommunityPool,
+	})
+	communityPoolBefore := suite.App.DistrKeeper.GetFeePoolCommunityCoins(suite.Ctx)
+
+	// the swap router takes the taker fee out of what goes into a
+	// concentrated-liquidity pool, as it does for gamm pools.
+	tokenIn := sdk.NewInt64Coin("bar", 100000)
+	poolI, err := suite.App.ConcentratedLiquidityKeeper.GetPool(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	takerFee := sdk.NewInt64Coin("bar", 1000)
+	withoutTakerFee, err := suite.App.ConcentratedLiquidityKeeper.CalcOutAmtGivenIn(suite.Ctx, poolI, tokenIn.Sub(takerFee), "foo", poolI.GetSwapFee(suite.Ctx))
+	suite.Require().NoError(err)
+	estimate, err := suite.App.SwapRouterKeeper.CalcOutAmtGivenIn(suite.Ctx, poolId, tokenIn, "foo")
+	suite.Require().NoError(err)
+	suite.Require().Equal(withoutTakerFee, estimate)
+
+	routes := []swaproutertypes.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: "foo"}}
+	tokenOutAmount, err := suite.App.SwapRouterKeeper.RouteExactAmountIn(suite.Ctx, acc2, routes, tokenIn, sdk.OneInt())
+	suite.Require().NoError(err)
+	suite.Require().Equal(estimate.Amount, tokenOutAmount)
+	suite.Require().Equal(takerFee.Amount, suite.App.GAMMKeeper.GetTakerFeeCollected(suite.Ctx, "bar"))
+	communityPool := suite.App.DistrKeeper.GetFeePoolCommunityCoins(suite.Ctx)
+	suite.Require().Equal(communityPoolBefore.Add(sdk.NewDecCoinFromCoin(takerFee)), communityPool)
+
+	// exact amount out swaps pay the taker fee on top of what the pool takes.
+	tokenOut := sdk.NewInt64Coin("bar", 50000)
+	poolI, err = suite.App.ConcentratedLiquidityKeeper.GetPool(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	poolTokenIn, err := suite.App.ConcentratedLiquidityKeeper.CalcInAmtGivenOut(suite.Ctx, poolI, tokenOut, "foo", poolI.GetSwapFee(suite.Ctx))
+	suite.Require().NoError(err)
+	outRoutes := []swaproutertypes.SwapAmountOutRoute{{PoolId: poolId, TokenInDenom: "foo"}}
+	tokenInAmount, err := suite.App.SwapRouterKeeper.RouteExactAmountOut(suite.Ctx, acc2, outRoutes, sdk.NewInt(1000000), tokenOut)
+	suite.Require().NoError(err)
+	fooTakerFee := suite.App.GAMMKeeper.GetTakerFeeCollected(suite.Ctx, "foo")
+	suite.Require().True(fooTakerFee.IsPositive())
+	suite.Require().Equal(poolTokenIn.Amount.Add(fooTakerFee), tokenInAmount)
+}
+
+func (suite *KeeperTestSuite) TestFindRouteThroughConcentratedPool() {
+	poolId := suite.prepareConcentratedPool()
+	suite.preparePosition(poolId, -10000, 10000)
+	tokenIn := sdk.NewInt64Coin("foo", 1000)
+
+	routes, err := suite.App.SwapRouterKeeper.FindBestRoutes(suite.Ctx, tokenIn, "bar", 3, 3)
+	suite.Require().NoError(err)
+	suite.Require().Len(routes, 1)
+	suite.Require().Equal(poolId, routes[0].Hops[0].PoolId)
+
+	// the pool's price is 1, and a small swap costs about its 1% swap fee.
+	pool, err := suite.App.ConcentratedLiquidityKeeper.GetConcentratedPool(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	spotPrice, err := pool.SpotPrice(suite.Ctx, "foo", "bar")
+	suite.Require().NoError(err)
+	suite.Require().Equal(spotPrice, routes[0].SpotPrice)
+	suite.Require().True(routes[0].PriceImpact.GTE(sdk.NewDecWithPrec(99, 4)), "price impact %s", routes[0].PriceImpact)
+	suite.Require().True(routes[0].PriceImpact.LTE(sdk.NewDecWithPrec(15, 3)), "price impact %s", routes[0].PriceImpact)
+}
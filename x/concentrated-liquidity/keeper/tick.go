@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/concentrated-liquidity/types"
+)
+
+// getTickInfo returns the state of a tick of a pool, which is that of a new
+// tick if no position starts or ends at it.
+func (k Keeper) getTickInfo(ctx sdk.Context, pool types.Pool, tickIndex int64) types.TickInfo {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetKeyTick(pool.Id, tickIndex))
+	if bz == nil {
+		return types.NewTickInfo(pool, tickIndex)
+	}
+
+	var info types.TickInfo
+	k.cdc.MustUnmarshal(bz, &info)
+	return info
+}
+
+// setTickInfo stores the state of a tick of a pool, deleting it once no
+// position starts or ends at it.
+func (k Keeper) setTickInfo(ctx sdk.Context, poolId uint64, tickIndex int64, info types.TickInfo) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetKeyTick(poolId, tickIndex)
+	if info.LiquidityGross.IsZero() {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, k.cdc.MustMarshal(&info))
+}
+
+// GetAllTicks returns the initialized ticks of every pool.
+func (k Keeper) GetAllTicks(ctx sdk.Context) []types.FullTick {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.KeyPrefixTicks)
+	defer iter.Close()
+
+	ticks := []types.FullTick{}
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()[len(types.KeyPrefixTicks):]
+		tick := types.FullTick{
+			PoolId:    sdk.BigEndianToUint64(key[:8]),
+			TickIndex: types.TickIndexFromBytes(key[8:]),
+		}
+		k.cdc.MustUnmarshal(iter.Value(), &tick.Info)
+		ticks = append(ticks, tick)
+	}
+	return ticks
+}
+
+// addTickLiquidity adds liquidityDelta, which may be negative, to the
+// liquidity of a position's lower or upper tick.
+func (k Keeper) addTickLiquidity(ctx sdk.Context, pool types.Pool, tickIndex int64, liquidityDelta sdk.Dec, upper bool) {
+	info := k.getTickInfo(ctx, pool, tickIndex)
+	info.LiquidityGross = info.LiquidityGross.Add(liquidityDelta)
+	// the position's liquidity becomes active when the price crosses its
+	// lower tick upwards, and inactive when it crosses its upper one.
+	if upper {
+		info.LiquidityNet = info.LiquidityNet.Sub(liquidityDelta)
+	} else {
+		info.LiquidityNet = info.LiquidityNet.Add(liquidityDelta)
+	}
+	k.setTickInfo(ctx, pool.Id, tickIndex, info)
+}
+
+// crossTick flips the fee growth outside a tick the price crosses, and
+// returns its net liquidity.
+func (k Keeper) crossTick(ctx sdk.Context, pool types.Pool, tickIndex int64) sdk.Dec {
+	info := k.getTickInfo(ctx, pool, tickIndex)
+	info.FeeGrowthOutside0 = pool.FeeGrowthGlobal0.Sub(info.FeeGrowthOutside0)
+	info.FeeGrowthOutside1 = pool.FeeGrowthGlobal1.Sub(info.FeeGrowthOutside1)
+	k.setTickInfo(ctx, pool.Id, tickIndex, info)
+	return info.LiquidityNet
+}
+
+// nextInitializedTick returns the closest tick some position starts or ends
+// at, in the direction of a swap: at or below the current tick for swaps
+// lowering the price, above it otherwise. It returns false if there is none.
+func (k Keeper) nextInitializedTick(ctx sdk.Context, pool types.Pool, zeroForOne bool) (int64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetKeyPrefixPoolTicks(pool.Id)
+
+	var iter sdk.Iterator
+	if zeroForOne {
+		iter = store.ReverseIterator(prefix, types.GetKeyTick(pool.Id, pool.CurrentTick+1))
+	} else {
+		iter = store.Iterator(types.GetKeyTick(pool.Id, pool.CurrentTick+1), sdk.PrefixEndBytes(prefix))
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return 0, false
+	}
+	return types.TickIndexFromBytes(iter.Key()[len(prefix):]), true
+}
+
+// getFeeGrowthInside returns the fee growth per unit of liquidity inside
+// [lowerTick, upperTick], relative to when the range's ticks were created.
+func (k Keeper) getFeeGrowthInside(ctx sdk.Context, pool types.Pool, lowerTick, upperTick int64) (sdk.Dec, sdk.Dec) {
+	lower := k.getTickInfo(ctx, pool, lowerTick)
+	upper := k.getTickInfo(ctx, pool, upperTick)
+
+	feeGrowthInside := func(global, lowerOutside, upperOutside sdk.Dec) sdk.Dec {
+		below := lowerOutside
+		if pool.CurrentTick < lowerTick {
+			below = global.Sub(lowerOutside)
+		}
+		above := upperOutside
+		if pool.CurrentTick >= upperTick {
+			above = global.Sub(upperOutside)
+		}
+		return global.Sub(below).Sub(above)
+	}
+
+	return feeGrowthInside(pool.FeeGrowthGlobal0, lower.FeeGrowthOutside0, upper.FeeGrowthOutside0),
+		feeGrowthInside(pool.FeeGrowthGlobal1, lower.FeeGrowthOutside1, upper.FeeGrowthOutside1)
+}
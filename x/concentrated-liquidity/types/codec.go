@@ -0,0 +1,44 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers the necessary x/concentrated-liquidity interfaces and concrete types
+// on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgCreateConcentratedPool{}, "osmosis/concentratedliquidity/create-concentrated-pool", nil)
+	cdc.RegisterConcrete(&MsgCreatePosition{}, "osmosis/concentratedliquidity/create-position", nil)
+	cdc.RegisterConcrete(&MsgAddToPosition{}, "osmosis/concentratedliquidity/add-to-position", nil)
+	cdc.RegisterConcrete(&MsgWithdrawPosition{}, "osmosis/concentratedliquidity/withdraw-position", nil)
+	cdc.RegisterConcrete(&MsgCollectFees{}, "osmosis/concentratedliquidity/collect-fees", nil)
+}
+
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgCreateConcentratedPool{},
+		&MsgCreatePosition{},
+		&MsgAddToPosition{},
+		&MsgWithdrawPosition{},
+		&MsgCollectFees{},
+	)
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var (
+	amino = codec.NewLegacyAmino()
+
+	// ModuleCdc references the global x/concentrated-liquidity module codec. Note, the codec should
+	// ONLY be used in certain instances of tests and for JSON encoding as Amino is
+	// still used for that purpose.
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	amino.Seal()
+}
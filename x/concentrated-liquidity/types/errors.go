@@ -0,0 +1,21 @@
+package types
+
+import sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+// x/concentrated-liquidity module sentinel errors.
+var (
+	ErrPoolNotFound            = sdkerrors.Register(ModuleName, 2, "pool not found")
+	ErrPositionNotFound        = sdkerrors.Register(ModuleName, 3, "position not found")
+	ErrInvalidTickSpacing      = sdkerrors.Register(ModuleName, 4, "tick spacing is not authorized")
+	ErrInvalidTickRange        = sdkerrors.Register(ModuleName, 5, "invalid tick range")
+	ErrInvalidDenom            = sdkerrors.Register(ModuleName, 6, "denom is not one of the pool's tokens")
+	ErrNotEnoughLiquidity      = sdkerrors.Register(ModuleName, 7, "not enough liquidity in the pool")
+	ErrZeroLiquidity           = sdkerrors.Register(ModuleName, 8, "position would have zero liquidity")
+	ErrInsufficientLiquidity   = sdkerrors.Register(ModuleName, 9, "position does not have enough liquidity")
+	ErrLimitMinAmount          = sdkerrors.Register(ModuleName, 10, "calculated amount is less than min amount")
+	ErrLimitMaxAmount          = sdkerrors.Register(ModuleName, 11, "calculated amount is larger than max amount")
+	ErrNotPositionOwner        = sdkerrors.Register(ModuleName, 12, "sender does not own the position")
+	ErrInitialPriceOutOfRange  = sdkerrors.Register(ModuleName, 13, "initial price is outside the tick range")
+	ErrInvalidInitialLiquidity = sdkerrors.Register(ModuleName, 14, "first position of a pool must deposit both tokens")
+	ErrNotConcentratedPool     = sdkerrors.Register(ModuleName, 15, "pool is not a concentrated-liquidity pool")
+)
@@ -0,0 +1,17 @@
+package types
+
+const (
+	TypeEvtPoolCreated      = "concentrated_pool_created"
+	TypeEvtPositionCreated  = "position_created"
+	TypeEvtPositionModified = "position_modified"
+	TypeEvtFeesCollected    = "fees_collected"
+	TypeEvtTokenSwapped     = "token_swapped"
+
+	AttributeValueCategory = ModuleName
+	AttributeKeyPoolId     = "pool_id"
+	AttributeKeyPositionId = "position_id"
+	AttributeKeyLiquidity  = "liquidity"
+	AttributeKeyTokensIn   = "tokens_in"
+	AttributeKeyTokensOut  = "tokens_out"
+	AttributeKeyFees       = "fees"
+)
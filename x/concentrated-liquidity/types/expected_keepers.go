@@ -0,0 +1,33 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	swaproutertypes "github.com/osmosis-labs/osmosis/v7/x/swaprouter/types"
+)
+
+// AccountKeeper defines the account contract that must be fulfilled when
+// creating a x/concentrated-liquidity keeper.
+type AccountKeeper interface {
+	NewAccount(sdk.Context, authtypes.AccountI) authtypes.AccountI
+	SetAccount(ctx sdk.Context, acc authtypes.AccountI)
+}
+
+// BankKeeper defines the banking contract that must be fulfilled when
+// creating a x/concentrated-liquidity keeper.
+type BankKeeper interface {
+	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// DistrKeeper defines the contract needed to be fulfilled for distribution keeper.
+type DistrKeeper interface {
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+// PoolManager defines the contract needed to be fulfilled for the swap router,
+// which assigns pool IDs.
+type PoolManager interface {
+	GetNextPoolIdAndIncrement(ctx sdk.Context) uint64
+	SetPoolRoute(ctx sdk.Context, poolId uint64, poolType swaproutertypes.PoolType)
+}
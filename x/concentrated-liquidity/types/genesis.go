@@ -0,0 +1,62 @@
+package types
+
+import "fmt"
+
+// DefaultGenesis returns the default concentrated-liquidity genesis state.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params:         DefaultParams(),
+		Pools:          []Pool{},
+		Ticks:          []FullTick{},
+		Positions:      []Position{},
+		NextPositionId: 1,
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure. It checks that ticks and positions belong to pools in the genesis
+// state, but not that ticks and positions are consistent with each other.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	if gs.NextPositionId == 0 {
+		return fmt.Errorf("next position id must be positive")
+	}
+
+	pools := make(map[uint64]bool, len(gs.Pools))
+	for _, pool := range gs.Pools {
+		if pools[pool.Id] {
+			return fmt.Errorf("duplicate pool %d", pool.Id)
+		}
+		if err := pool.Validate(); err != nil {
+			return fmt.Errorf("invalid pool %d: %w", pool.Id, err)
+		}
+		pools[pool.Id] = true
+	}
+	for _, tick := range gs.Ticks {
+		if !pools[tick.PoolId] {
+			return fmt.Errorf("tick %d of unknown pool %d", tick.TickIndex, tick.PoolId)
+		}
+		if err := tick.Validate(); err != nil {
+			return err
+		}
+	}
+	positions := make(map[uint64]bool, len(gs.Positions))
+	for _, position := range gs.Positions {
+		if positions[position.PositionId] {
+			return fmt.Errorf("duplicate position %d", position.PositionId)
+		}
+		if position.PositionId >= gs.NextPositionId {
+			return fmt.Errorf("position %d is not below the next position id %d", position.PositionId, gs.NextPositionId)
+		}
+		if !pools[position.PoolId] {
+			return fmt.Errorf("position %d in unknown pool %d", position.PositionId, position.PoolId)
+		}
+		if err := position.Validate(); err != nil {
+			return fmt.Errorf("invalid position %d: %w", position.PositionId, err)
+		}
+		positions[position.PositionId] = true
+	}
+	return nil
+}
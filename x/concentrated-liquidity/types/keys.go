@@ -0,0 +1,80 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	ModuleName = "concentratedliquidity"
+
+	StoreKey = ModuleName
+
+	RouterKey = ModuleName
+
+	QuerierRoute = ModuleName
+)
+
+var (
+	// KeyPrefixPools defines the key prefix of pools.
+	KeyPrefixPools = []byte{0x01}
+
+	// KeyPrefixTicks defines the key prefix of the initialized ticks of pools.
+	KeyPrefixTicks = []byte{0x02}
+
+	// KeyPrefixPositions defines the key prefix of positions.
+	KeyPrefixPositions = []byte{0x03}
+
+	// KeyPrefixOwnerPositions defines the key prefix of the index of
+	// positions by owner.
+	KeyPrefixOwnerPositions = []byte{0x04}
+
+	// KeyNextPositionId defines the key to store the next position ID to be
+	// used.
+	KeyNextPositionId = []byte{0x05}
+)
+
+// GetKeyPool returns the key of a pool.
+func GetKeyPool(poolId uint64) []byte {
+	return append(KeyPrefixPools, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// GetKeyPrefixPoolTicks returns the key prefix of the ticks of a pool.
+func GetKeyPrefixPoolTicks(poolId uint64) []byte {
+	return append(KeyPrefixTicks, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// GetKeyTick returns the key of a tick of a pool. Ticks are stored with their
+// sign bit flipped, so that iterating over a pool's ticks visits them in
+// increasing order.
+func GetKeyTick(poolId uint64, tickIndex int64) []byte {
+	return append(GetKeyPrefixPoolTicks(poolId), TickIndexToBytes(tickIndex)...)
+}
+
+// TickIndexToBytes encodes a tick index so that byte order matches numeric
+// order.
+func TickIndexToBytes(tickIndex int64) []byte {
+	return sdk.Uint64ToBigEndian(uint64(tickIndex) ^ (1 << 63))
+}
+
+// TickIndexFromBytes decodes a tick index encoded by TickIndexToBytes.
+func TickIndexFromBytes(bz []byte) int64 {
+	return int64(sdk.BigEndianToUint64(bz) ^ (1 << 63))
+}
+
+// GetKeyPosition returns the key of a position.
+func GetKeyPosition(positionId uint64) []byte {
+	return append(KeyPrefixPositions, sdk.Uint64ToBigEndian(positionId)...)
+}
+
+// GetKeyPrefixOwnerPositions returns the key prefix of the index of the
+// positions of an owner.
+func GetKeyPrefixOwnerPositions(owner sdk.AccAddress) []byte {
+	return append(KeyPrefixOwnerPositions, address.MustLengthPrefix(owner)...)
+}
+
+// GetKeyOwnerPosition returns the key of a position in the index of the
+// positions of its owner.
+func GetKeyOwnerPosition(owner sdk.AccAddress, positionId uint64) []byte {
+	return append(GetKeyPrefixOwnerPositions(owner), sdk.Uint64ToBigEndian(positionId)...)
+}
@@ -0,0 +1,244 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Prices are of token1 in token0, and the price of tick i is 1.0001^i. Pool
+// state and the math below work with square roots of prices, which keeps the
+// amounts of a position linear in its liquidity.
+const (
+	// MinTick and MaxTick bound the ticks positions can use, keeping the
+	// square roots of prices within what sdk.Dec represents precisely.
+	MinTick int64 = -342000
+	MaxTick int64 = 342000
+)
+
+var (
+	// sqrtTickBase is the ratio between the square roots of the prices of
+	// consecutive ticks, sqrt(1.0001).
+	sqrtTickBase = sdk.MustNewDecFromStr("1.000049998750062496")
+
+	// MinSqrtPrice and MaxSqrtPrice are the square roots of the prices of
+	// MinTick and MaxTick.
+	MinSqrtPrice = mustTickToSqrtPrice(MinTick)
+	MaxSqrtPrice = mustTickToSqrtPrice(MaxTick)
+)
+
+// ValidateTick returns an error if the tick is outside [MinTick, MaxTick].
+func ValidateTick(tickIndex int64) error {
+	if tickIndex < MinTick || tickIndex > MaxTick {
+		return sdkerrors.Wrapf(ErrInvalidTickRange, "tick %d is outside [%d, %d]", tickIndex, MinTick, MaxTick)
+	}
+	return nil
+}
+
+// TickToSqrtPrice returns the square root of the price of a tick.
+func TickToSqrtPrice(tickIndex int64) (sdk.Dec, error) {
+	if err := ValidateTick(tickIndex); err != nil {
+		return sdk.Dec{}, err
+	}
+	if tickIndex >= 0 {
+		return sqrtTickBase.Power(uint64(tickIndex)), nil
+	}
+	return sdk.OneDec().Quo(sqrtTickBase.Power(uint64(-tickIndex))), nil
+}
+
+func mustTickToSqrtPrice(tickIndex int64) sdk.Dec {
+	sqrtPrice, err := TickToSqrtPrice(tickIndex)
+	if err != nil {
+		panic(err)
+	}
+	return sqrtPrice
+}
+
+// SqrtPriceToTick returns the largest tick whose price is at most the price
+// whose square root is given.
+func SqrtPriceToTick(sqrtPrice sdk.Dec) (int64, error) {
+	if sqrtPrice.LT(MinSqrtPrice) || sqrtPrice.GT(MaxSqrtPrice) {
+		return 0, sdkerrors.Wrapf(ErrInvalidTickRange, "square root price %s is outside [%s, %s]", sqrtPrice, MinSqrtPrice, MaxSqrtPrice)
+	}
+
+	// binary search for the last tick at or below the price.
+	low, high := MinTick, MaxTick
+	for low < high {
+		mid := low + (high-low+1)/2
+		if mustTickToSqrtPrice(mid).LTE(sqrtPrice) {
+			low = mid
+		} else {
+			high = mid - 1
+		}
+	}
+	return low, nil
+}
+
+// Liquidity0 returns the liquidity that an amount of token0 provides over the
+// range between two square root prices.
+func Liquidity0(amount sdk.Int, sqrtPriceA, sqrtPriceB sdk.Dec) sdk.Dec {
+	if sqrtPriceA.GT(sqrtPriceB) {
+		sqrtPriceA, sqrtPriceB = sqrtPriceB, sqrtPriceA
+	}
+	return amount.ToDec().Mul(sqrtPriceA).Quo(sqrtPriceB.Sub(sqrtPriceA)).Mul(sqrtPriceB)
+}
+
+// Liquidity1 returns the liquidity that an amount of token1 provides over the
+// range between two square root prices.
+func Liquidity1(amount sdk.Int, sqrtPriceA, sqrtPriceB sdk.Dec) sdk.Dec {
+	if sqrtPriceA.GT(sqrtPriceB) {
+		sqrtPriceA, sqrtPriceB = sqrtPriceB, sqrtPriceA
+	}
+	return amount.ToDec().Quo(sqrtPriceB.Sub(sqrtPriceA))
+}
+
+// CalcAmount0Delta returns the amount of token0 that liquidity holds over the
+// range between two square root prices, rounded up or down.
+func CalcAmount0Delta(liquidity, sqrtPriceA, sqrtPriceB sdk.Dec, roundUp bool) sdk.Dec {
+	if sqrtPriceA.GT(sqrtPriceB) {
+		sqrtPriceA, sqrtPriceB = sqrtPriceB, sqrtPriceA
+	}
+	diff := sqrtPriceB.Sub(sqrtPriceA)
+	if roundUp {
+		return liquidity.Mul(diff).QuoRoundUp(sqrtPriceB).QuoRoundUp(sqrtPriceA)
+	}
+	return liquidity.MulTruncate(diff).QuoTruncate(sqrtPriceB).QuoTruncate(sqrtPriceA)
+}
+
+// CalcAmount1Delta returns the amount of token1 that liquidity holds over the
+// range between two square root prices, rounded up or down.
+func CalcAmount1Delta(liquidity, sqrtPriceA, sqrtPriceB sdk.Dec, roundUp bool) sdk.Dec {
+	if sqrtPriceA.GT(sqrtPriceB) {
+		sqrtPriceA, sqrtPriceB = sqrtPriceB, sqrtPriceA
+	}
+	diff := sqrtPriceB.Sub(sqrtPriceA)
+	if roundUp {
+		return liquidity.Mul(diff)
+	}
+	return liquidity.MulTruncate(diff)
+}
+
+// GetLiquidityFromAmounts returns the most liquidity that amount0 and amount1
+// can provide over [sqrtPriceLower, sqrtPriceUpper], at the current square
+// root price. Below the range only token0 is used, above it only token1.
+func GetLiquidityFromAmounts(sqrtPrice, sqrtPriceLower, sqrtPriceUpper sdk.Dec, amount0, amount1 sdk.Int) sdk.Dec {
+	switch {
+	case sqrtPrice.LTE(sqrtPriceLower):
+		return Liquidity0(amount0, sqrtPriceLower, sqrtPriceUpper)
+	case sqrtPrice.GTE(sqrtPriceUpper):
+		return Liquidity1(amount1, sqrtPriceLower, sqrtPriceUpper)
+	default:
+		return sdk.MinDec(
+			Liquidity0(amount0, sqrtPrice, sqrtPriceUpper),
+			Liquidity1(amount1, sqrtPriceLower, sqrtPrice),
+		)
+	}
+}
+
+// GetAmountsForLiquidity returns the amounts of token0 and token1 that
+// liquidity holds over [sqrtPriceLower, sqrtPriceUpper], at the current square
+// root price.
+func GetAmountsForLiquidity(sqrtPrice, sqrtPriceLower, sqrtPriceUpper, liquidity sdk.Dec, roundUp bool) (amount0, amount1 sdk.Dec) {
+	switch {
+	case sqrtPrice.LTE(sqrtPriceLower):
+		return CalcAmount0Delta(liquidity, sqrtPriceLower, sqrtPriceUpper, roundUp), sdk.ZeroDec()
+	case sqrtPrice.GTE(sqrtPriceUpper):
+		return sdk.ZeroDec(), CalcAmount1Delta(liquidity, sqrtPriceLower, sqrtPriceUpper, roundUp)
+	default:
+		return CalcAmount0Delta(liquidity, sqrtPrice, sqrtPriceUpper, roundUp),
+			CalcAmount1Delta(liquidity, sqrtPriceLower, sqrtPrice, roundUp)
+	}
+}
+
+// ComputeSwapStepOutGivenIn swaps at most amountRemainingIn, charging swapFee
+// on it, within a range of constant liquidity, from the current square root
+// price towards the target one. Swaps to a lower price take token0 in and
+// token1 out, swaps to a higher price the opposite.
+//
+// It returns the square root price the swap stops at, which is the target if
+// amountRemainingIn reaches it, the amounts swapped and the fee charged.
+func ComputeSwapStepOutGivenIn(sqrtPriceCurrent, sqrtPriceTarget, liquidity, amountRemainingIn, swapFee sdk.Dec) (sqrtPriceNext, amountIn, amountOut, feeAmount sdk.Dec) {
+	zeroForOne := sqrtPriceTarget.LT(sqrtPriceCurrent)
+	amountRemainingLessFee := amountRemainingIn.MulTruncate(sdk.OneDec().Sub(swapFee))
+
+	var amountInToTarget sdk.Dec
+	if zeroForOne {
+		amountInToTarget = CalcAmount0Delta(liquidity, sqrtPriceTarget, sqrtPriceCurrent, true)
+	} else {
+		amountInToTarget = CalcAmount1Delta(liquidity, sqrtPriceCurrent, sqrtPriceTarget, true)
+	}
+
+	if amountRemainingLessFee.GTE(amountInToTarget) {
+		sqrtPriceNext = sqrtPriceTarget
+		amountIn = amountInToTarget
+	} else {
+		sqrtPriceNext = getNextSqrtPriceFromAmountIn(sqrtPriceCurrent, liquidity, amountRemainingLessFee, zeroForOne)
+		amountIn = amountRemainingLessFee
+	}
+
+	if zeroForOne {
+		amountOut = CalcAmount1Delta(liquidity, sqrtPriceNext, sqrtPriceCurrent, false)
+	} else {
+		amountOut = CalcAmount0Delta(liquidity, sqrtPriceCurrent, sqrtPriceNext, false)
+	}
+
+	// the fee is whatever the swap doesn't use, if it stops before the target.
+	if !sqrtPriceNext.Equal(sqrtPriceTarget) {
+		feeAmount = amountRemainingIn.Sub(amountIn)
+	} else {
+		feeAmount = amountIn.Mul(swapFee).QuoRoundUp(sdk.OneDec().Sub(swapFee))
+	}
+	return sqrtPriceNext, amountIn, amountOut, feeAmount
+}
+
+// ComputeSwapStepInGivenOut swaps for at most amountRemainingOut within a
+// range of constant liquidity, from the current square root price towards the
+// target one. It returns the square root price the swap stops at, the amounts
+// swapped and the fee charged on top of amountIn.
+func ComputeSwapStepInGivenOut(sqrtPriceCurrent, sqrtPriceTarget, liquidity, amountRemainingOut, swapFee sdk.Dec) (sqrtPriceNext, amountIn, amountOut, feeAmount sdk.Dec) {
+	zeroForOne := sqrtPriceTarget.LT(sqrtPriceCurrent)
+
+	var amountOutToTarget sdk.Dec
+	if zeroForOne {
+		amountOutToTarget = CalcAmount1Delta(liquidity, sqrtPriceTarget, sqrtPriceCurrent, false)
+	} else {
+		amountOutToTarget = CalcAmount0Delta(liquidity, sqrtPriceCurrent, sqrtPriceTarget, false)
+	}
+
+	if amountRemainingOut.GTE(amountOutToTarget) {
+		sqrtPriceNext = sqrtPriceTarget
+		amountOut = amountOutToTarget
+	} else {
+		sqrtPriceNext = getNextSqrtPriceFromAmountOut(sqrtPriceCurrent, liquidity, amountRemainingOut, zeroForOne)
+		amountOut = amountRemainingOut
+	}
+
+	if zeroForOne {
+		amountIn = CalcAmount0Delta(liquidity, sqrtPriceNext, sqrtPriceCurrent, true)
+	} else {
+		amountIn = CalcAmount1Delta(liquidity, sqrtPriceCurrent, sqrtPriceNext, true)
+	}
+	feeAmount = amountIn.Mul(swapFee).QuoRoundUp(sdk.OneDec().Sub(swapFee))
+	return sqrtPriceNext, amountIn, amountOut, feeAmount
+}
+
+// getNextSqrtPriceFromAmountIn returns the square root price after amountIn
+// is swapped in, rounding in the pool's favor.
+func getNextSqrtPriceFromAmountIn(sqrtPrice, liquidity, amountIn sdk.Dec, zeroForOne bool) sdk.Dec {
+	if zeroForOne {
+		// L * p / (L + amountIn * p)
+		return liquidity.Mul(sqrtPrice).QuoRoundUp(liquidity.Add(amountIn.Mul(sqrtPrice)))
+	}
+	// p + amountIn / L
+	return sqrtPrice.Add(amountIn.QuoTruncate(liquidity))
+}
+
+// getNextSqrtPriceFromAmountOut returns the square root price after amountOut
+// is swapped out, rounding in the pool's favor.
+func getNextSqrtPriceFromAmountOut(sqrtPrice, liquidity, amountOut sdk.Dec, zeroForOne bool) sdk.Dec {
+	if zeroForOne {
+		// p - amountOut / L
+		return sqrtPrice.Sub(amountOut.QuoRoundUp(liquidity))
+	}
+	// L * p / (L - amountOut * p)
+	return liquidity.Mul(sqrtPrice).QuoRoundUp(liquidity.Sub(amountOut.Mul(sqrtPrice)))
+}
@@ -0,0 +1,169 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/concentrated-liquidity/types"
+)
+
+func TestTickToSqrtPrice(t *testing.T) {
+	tests := []struct {
+		name              string
+		tickIndex         int64
+		expectedSqrtPrice sdk.Dec
+		expectErr         bool
+	}{
+		{"tick 0 is price 1", 0, sdk.OneDec(), false},
+		// sqrt(1.0001^2) = 1.0001
+		{"tick 2", 2, sdk.MustNewDecFromStr("1.0001"), false},
+		// sqrt(1.0001^-2) = 1 / 1.0001
+		{"tick -2", -2, sdk.OneDec().Quo(sdk.MustNewDecFromStr("1.0001")), false},
+		// sqrt(1.0001^46054) = 1.0001^23027
+		{"tick 46054", 46054, sdk.MustNewDecFromStr("9.999997796810696239"), false},
+		{"max tick", types.MaxTick, types.MaxSqrtPrice, false},
+		{"min tick", types.MinTick, types.MinSqrtPrice, false},
+		{"above max tick", types.MaxTick + 1, sdk.Dec{}, true},
+		{"below min tick", types.MinTick - 1, sdk.Dec{}, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			sqrtPrice, err := types.TickToSqrtPrice(tc.tickIndex)
+			if tc.expectErr {
+				require.ErrorIs(t, err, types.ErrInvalidTickRange)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, tc.expectedSqrtPrice.Sub(sqrtPrice).Abs().LTE(sdk.NewDecWithPrec(1, 13)),
+				"expected %s, got %s", tc.expectedSqrtPrice, sqrtPrice)
+		})
+	}
+}
+
+func TestSqrtPriceToTick(t *testing.T) {
+	for _, tickIndex := range []int64{types.MinTick, -100000, -1, 0, 1, 46054, 100000, types.MaxTick - 1} {
+		sqrtPrice, err := types.TickToSqrtPrice(tickIndex)
+		require.NoError(t, err)
+		nextSqrtPrice, err := types.TickToSqrtPrice(tickIndex + 1)
+		require.NoError(t, err)
+		require.True(t, sqrtPrice.LT(nextSqrtPrice))
+
+		// the tick of its own price, and of any price up to the next tick.
+		tick, err := types.SqrtPriceToTick(sqrtPrice)
+		require.NoError(t, err)
+		require.Equal(t, tickIndex, tick)
+
+		tick, err = types.SqrtPriceToTick(nextSqrtPrice.Sub(sdk.SmallestDec()))
+		require.NoError(t, err)
+		require.Equal(t, tickIndex, tick)
+	}
+
+	_, err := types.SqrtPriceToTick(types.MinSqrtPrice.Sub(sdk.SmallestDec()))
+	require.ErrorIs(t, err, types.ErrInvalidTickRange)
+	_, err = types.SqrtPriceToTick(types.MaxSqrtPrice.Add(sdk.SmallestDec()))
+	require.ErrorIs(t, err, types.ErrInvalidTickRange)
+}
+
+func TestTickIndexBytesOrder(t *testing.T) {
+	ticks := []int64{types.MinTick, -1000, -1, 0, 1, 1000, types.MaxTick}
+	for i, tickIndex := range ticks {
+		bz := types.TickIndexToBytes(tickIndex)
+		require.Equal(t, tickIndex, types.TickIndexFromBytes(bz))
+		if i > 0 {
+			require.True(t, string(types.TickIndexToBytes(ticks[i-1])) < string(bz))
+		}
+	}
+}
+
+func TestLiquidityAmountsRoundTrip(t *testing.T) {
+	sqrtPriceLower, err := types.TickToSqrtPrice(-1000)
+	require.NoError(t, err)
+	sqrtPriceUpper, err := types.TickToSqrtPrice(1000)
+	require.NoError(t, err)
+	amount0, amount1 := sdk.NewInt(1000000), sdk.NewInt(1000000)
+
+	tests := []struct {
+		name      string
+		sqrtPrice sdk.Dec
+		// which of the amounts is used up.
+		limits0, limits1 bool
+	}{
+		{"below the range, only token0", sqrtPriceLower.Sub(sdk.NewDecWithPrec(1, 3)), true, false},
+		{"above the range, only token1", sqrtPriceUpper.Add(sdk.NewDecWithPrec(1, 3)), false, true},
+		{"price 1, token1 is the limit", sdk.OneDec(), false, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			liquidity := types.GetLiquidityFromAmounts(tc.sqrtPrice, sqrtPriceLower, sqrtPriceUpper, amount0, amount1)
+			require.True(t, liquidity.IsPositive())
+
+			deposit0, deposit1 := types.GetAmountsForLiquidity(tc.sqrtPrice, sqrtPriceLower, sqrtPriceUpper, liquidity, true)
+			withdraw0, withdraw1 := types.GetAmountsForLiquidity(tc.sqrtPrice, sqrtPriceLower, sqrtPriceUpper, liquidity, false)
+			require.True(t, withdraw0.LTE(deposit0))
+			require.True(t, withdraw1.LTE(deposit1))
+
+			// the liquidity takes at most one unit more than the amounts it
+			// was computed from.
+			require.True(t, deposit0.Ceil().TruncateInt().LTE(amount0.AddRaw(1)))
+			require.True(t, deposit1.Ceil().TruncateInt().LTE(amount1.AddRaw(1)))
+			if tc.limits0 {
+				require.Equal(t, amount0, deposit0.Ceil().TruncateInt())
+			} else if !tc.limits1 {
+				require.True(t, deposit0.IsZero())
+			}
+			if tc.limits1 {
+				require.Equal(t, amount1, deposit1.Ceil().TruncateInt())
+			} else {
+				require.True(t, deposit1.IsZero())
+			}
+		})
+	}
+}
+
+func TestComputeSwapStep(t *testing.T) {
+	liquidity := sdk.NewDec(1000000)
+	sqrtPrice := sdk.OneDec()
+	swapFee := sdk.NewDecWithPrec(1, 2)
+
+	tests := []struct {
+		name            string
+		sqrtPriceTarget sdk.Dec
+		amount          sdk.Dec
+		reachesTarget   bool
+	}{
+		{"zero for one, stops before target", sdk.MustNewDecFromStr("0.9"), sdk.NewDec(1000), false},
+		{"zero for one, reaches target", sdk.MustNewDecFromStr("0.999"), sdk.NewDec(1000000), true},
+		{"one for zero, stops before target", sdk.MustNewDecFromStr("1.1"), sdk.NewDec(1000), false},
+		{"one for zero, reaches target", sdk.MustNewDecFromStr("1.001"), sdk.NewDec(1000000), true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			sqrtPriceNext, amountIn, amountOut, fee := types.ComputeSwapStepOutGivenIn(sqrtPrice, tc.sqrtPriceTarget, liquidity, tc.amount, swapFee)
+			require.Equal(t, tc.reachesTarget, sqrtPriceNext.Equal(tc.sqrtPriceTarget))
+			require.True(t, amountIn.Add(fee).LTE(tc.amount.Add(sdk.SmallestDec())))
+			if !tc.reachesTarget {
+				require.Equal(t, tc.amount, amountIn.Add(fee))
+			}
+			// the fee is swapFee of the amount charged.
+			require.True(t, fee.Sub(amountIn.Add(fee).Mul(swapFee)).Abs().LTE(sdk.NewDecWithPrec(1, 15)))
+			// at a price around 1, about as much comes out as goes in.
+			require.True(t, amountOut.LTE(amountIn))
+			require.True(t, amountOut.GT(amountIn.Mul(sdk.MustNewDecFromStr("0.89"))))
+
+			// swapping for amountOut takes amountIn back, and moves the price
+			// to the same point.
+			sqrtPriceNextOut, amountInOut, amountOutOut, feeOut := types.ComputeSwapStepInGivenOut(sqrtPrice, tc.sqrtPriceTarget, liquidity, amountOut, swapFee)
+			require.Equal(t, amountOut, amountOutOut)
+			require.True(t, sqrtPriceNextOut.Sub(sqrtPriceNext).Abs().LTE(sdk.NewDecWithPrec(1, 15)))
+			require.True(t, amountInOut.Sub(amountIn).Abs().LTE(sdk.NewDecWithPrec(1, 9)),
+				"expected %s, got %s", amountIn, amountInOut)
+			require.True(t, feeOut.Sub(fee).Abs().LTE(sdk.NewDecWithPrec(1, 9)))
+		})
+	}
+}
@@ -0,0 +1,188 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// constants.
+const (
+	TypeMsgCreateConcentratedPool = "create_concentrated_pool"
+	TypeMsgCreatePosition         = "create_position"
+	TypeMsgAddToPosition          = "add_to_position"
+	TypeMsgWithdrawPosition       = "withdraw_position"
+	TypeMsgCollectFees            = "collect_fees"
+)
+
+var _ sdk.Msg = &MsgCreateConcentratedPool{}
+
+func (msg MsgCreateConcentratedPool) Route() string { return RouterKey }
+func (msg MsgCreateConcentratedPool) Type() string  { return TypeMsgCreateConcentratedPool }
+func (msg MsgCreateConcentratedPool) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if err := validateDenoms(msg.Denom0, msg.Denom1); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if msg.TickSpacing == 0 {
+		return sdkerrors.Wrap(ErrInvalidTickSpacing, "tick spacing must be positive")
+	}
+
+	if err := validateSwapFee(msg.SwapFee); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+func (msg MsgCreateConcentratedPool) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCreateConcentratedPool) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgCreatePosition{}
+
+func (msg MsgCreatePosition) Route() string { return RouterKey }
+func (msg MsgCreatePosition) Type() string  { return TypeMsgCreatePosition }
+func (msg MsgCreatePosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if err := ValidateTickRange(msg.LowerTick, msg.UpperTick); err != nil {
+		return err
+	}
+
+	return validateDepositAmounts(msg.TokenDesired0, msg.TokenDesired1, msg.TokenMinAmount0, msg.TokenMinAmount1)
+}
+
+func (msg MsgCreatePosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCreatePosition) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgAddToPosition{}
+
+func (msg MsgAddToPosition) Route() string { return RouterKey }
+func (msg MsgAddToPosition) Type() string  { return TypeMsgAddToPosition }
+func (msg MsgAddToPosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	return validateDepositAmounts(msg.TokenDesired0, msg.TokenDesired1, msg.TokenMinAmount0, msg.TokenMinAmount1)
+}
+
+func (msg MsgAddToPosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgAddToPosition) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgWithdrawPosition{}
+
+func (msg MsgWithdrawPosition) Route() string { return RouterKey }
+func (msg MsgWithdrawPosition) Type() string  { return TypeMsgWithdrawPosition }
+func (msg MsgWithdrawPosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if msg.LiquidityAmount.IsNil() || !msg.LiquidityAmount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "liquidity amount must be positive")
+	}
+
+	return nil
+}
+
+func (msg MsgWithdrawPosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgWithdrawPosition) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgCollectFees{}
+
+func (msg MsgCollectFees) Route() string { return RouterKey }
+func (msg MsgCollectFees) Type() string  { return TypeMsgCollectFees }
+func (msg MsgCollectFees) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if len(msg.PositionIds) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no position ids given")
+	}
+	seen := make(map[uint64]bool, len(msg.PositionIds))
+	for _, positionId := range msg.PositionIds {
+		if seen[positionId] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate position id %d", positionId)
+		}
+		seen[positionId] = true
+	}
+
+	return nil
+}
+
+func (msg MsgCollectFees) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCollectFees) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func validateDepositAmounts(desired0, desired1, min0, min1 sdk.Int) error {
+	for _, amount := range []sdk.Int{desired0, desired1, min0, min1} {
+		if amount.IsNil() || amount.IsNegative() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "token amounts must not be negative")
+		}
+	}
+	if desired0.IsZero() && desired1.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "at least one token amount must be positive")
+	}
+	if min0.GT(desired0) || min1.GT(desired1) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("min amounts (%s, %s) exceed desired amounts (%s, %s)", min0, min1, desired0, desired1))
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	appParams "github.com/osmosis-labs/osmosis/v7/app/params"
+)
+
+func TestMsgCreatePosition(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg MsgCreatePosition) MsgCreatePosition) MsgCreatePosition {
+		properMsg := MsgCreatePosition{
+			Sender:          addr1,
+			PoolId:          1,
+			LowerTick:       -1000,
+			UpperTick:       1000,
+			TokenDesired0:   sdk.NewInt(100),
+			TokenDesired1:   sdk.NewInt(100),
+			TokenMinAmount0: sdk.ZeroInt(),
+			TokenMinAmount1: sdk.ZeroInt(),
+		}
+
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg MsgCreatePosition) MsgCreatePosition {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "create_position")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        MsgCreatePosition
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg MsgCreatePosition) MsgCreatePosition {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg MsgCreatePosition) MsgCreatePosition {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "lower tick equal to upper tick",
+			msg: createMsg(func(msg MsgCreatePosition) MsgCreatePosition {
+				msg.LowerTick = msg.UpperTick
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "upper tick above the max tick",
+			msg: createMsg(func(msg MsgCreatePosition) MsgCreatePosition {
+				msg.UpperTick = MaxTick + 1
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "single token deposit",
+			msg: createMsg(func(msg MsgCreatePosition) MsgCreatePosition {
+				msg.TokenDesired0 = sdk.ZeroInt()
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "no tokens",
+			msg: createMsg(func(msg MsgCreatePosition) MsgCreatePosition {
+				msg.TokenDesired0 = sdk.ZeroInt()
+				msg.TokenDesired1 = sdk.ZeroInt()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "negative desired amount",
+			msg: createMsg(func(msg MsgCreatePosition) MsgCreatePosition {
+				msg.TokenDesired1 = sdk.NewInt(-1)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "min amount above desired amount",
+			msg: createMsg(func(msg MsgCreatePosition) MsgCreatePosition {
+				msg.TokenMinAmount0 = sdk.NewInt(101)
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
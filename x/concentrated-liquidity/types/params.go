@@ -0,0 +1,92 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	appparams "github.com/osmosis-labs/osmosis/v7/app/params"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store keys.
+var (
+	KeyAuthorizedTickSpacing = []byte("AuthorizedTickSpacing")
+	KeyPoolCreationFee       = []byte("PoolCreationFee")
+)
+
+// ParamKeyTable for the concentrated-liquidity module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(authorizedTickSpacing []uint64, poolCreationFee sdk.Coins) Params {
+	return Params{
+		AuthorizedTickSpacing: authorizedTickSpacing,
+		PoolCreationFee:       poolCreationFee,
+	}
+}
+
+// DefaultParams returns the default concentrated-liquidity module parameters.
+func DefaultParams() Params {
+	return Params{
+		AuthorizedTickSpacing: []uint64{1, 10, 100, 1000},
+		PoolCreationFee:       sdk.Coins{sdk.NewInt64Coin(appparams.BaseCoinUnit, 1000_000_000)}, // 1000 OSMO
+	}
+}
+
+// Validate validates params.
+func (p Params) Validate() error {
+	if err := validateAuthorizedTickSpacing(p.AuthorizedTickSpacing); err != nil {
+		return err
+	}
+	return validatePoolCreationFee(p.PoolCreationFee)
+}
+
+// IsAuthorizedTickSpacing returns whether pools can be created with the given
+// tick spacing.
+func (p Params) IsAuthorizedTickSpacing(tickSpacing uint64) bool {
+	for _, authorized := range p.AuthorizedTickSpacing {
+		if authorized == tickSpacing {
+			return true
+		}
+	}
+	return false
+}
+
+// Implements params.ParamSet.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyAuthorizedTickSpacing, &p.AuthorizedTickSpacing, validateAuthorizedTickSpacing),
+		paramtypes.NewParamSetPair(KeyPoolCreationFee, &p.PoolCreationFee, validatePoolCreationFee),
+	}
+}
+
+func validateAuthorizedTickSpacing(i interface{}) error {
+	v, ok := i.([]uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for _, tickSpacing := range v {
+		if tickSpacing == 0 || tickSpacing > uint64(MaxTick) {
+			return fmt.Errorf("invalid authorized tick spacing: %d", tickSpacing)
+		}
+	}
+
+	return nil
+}
+
+func validatePoolCreationFee(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.Validate() != nil {
+		return errors.New("invalid pool creation fee")
+	}
+
+	return nil
+}
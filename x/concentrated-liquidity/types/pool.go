@@ -0,0 +1,156 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	swaproutertypes "github.com/osmosis-labs/osmosis/v7/x/swaprouter/types"
+)
+
+var _ swaproutertypes.PoolI = &Pool{}
+
+// NewConcentratedPool returns a concentrated-liquidity pool of two tokens.
+// It has no liquidity and no price until its first position is created.
+func NewConcentratedPool(poolId uint64, denom0, denom1 string, tickSpacing uint64, swapFee sdk.Dec) Pool {
+	return Pool{
+		Address:              NewPoolAddress(poolId).String(),
+		Id:                   poolId,
+		Token0:               denom0,
+		Token1:               denom1,
+		TickSpacing:          tickSpacing,
+		SwapFee:              swapFee,
+		CurrentSqrtPrice:     sdk.ZeroDec(),
+		CurrentTick:          0,
+		CurrentTickLiquidity: sdk.ZeroDec(),
+		FeeGrowthGlobal0:     sdk.ZeroDec(),
+		FeeGrowthGlobal1:     sdk.ZeroDec(),
+	}
+}
+
+// NewPoolAddress returns the address of the account holding a pool's tokens.
+func NewPoolAddress(poolId uint64) sdk.AccAddress {
+	key := append([]byte("pool"), sdk.Uint64ToBigEndian(poolId)...)
+	return address.Module(ModuleName, key)
+}
+
+func (p Pool) GetAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(p.Address)
+	if err != nil {
+		panic(fmt.Sprintf("could not bech32 decode address of pool with id: %d", p.GetId()))
+	}
+	return addr
+}
+
+func (p Pool) String() string {
+	out, err := json.Marshal(p)
+	if err != nil {
+		panic(err)
+	}
+	return string(out)
+}
+
+func (p Pool) GetId() uint64 {
+	return p.Id
+}
+
+func (p Pool) GetSwapFee(ctx sdk.Context) sdk.Dec {
+	return p.SwapFee
+}
+
+// IsActive returns whether the pool can be swapped in, which it can once its
+// first position has set its price.
+func (p Pool) IsActive(ctx sdk.Context) bool {
+	return p.HasPrice()
+}
+
+func (p Pool) GetType() swaproutertypes.PoolType {
+	return swaproutertypes.Concentrated
+}
+
+// HasPrice returns whether the pool's price has been set by its first
+// position.
+func (p Pool) HasPrice() bool {
+	return p.CurrentSqrtPrice.IsPositive()
+}
+
+// HasDenom returns whether denom is one of the pool's tokens.
+func (p Pool) HasDenom(denom string) bool {
+	return denom == p.Token0 || denom == p.Token1
+}
+
+// GetPoolDenoms returns the pool's two tokens, sorted.
+func (p Pool) GetPoolDenoms(ctx sdk.Context) []string {
+	if p.Token1 < p.Token0 {
+		return []string{p.Token1, p.Token0}
+	}
+	return []string{p.Token0, p.Token1}
+}
+
+// SpotPrice returns how many of the base token one quote token is worth at
+// the pool's current price, which is of token1 in token0.
+func (p Pool) SpotPrice(ctx sdk.Context, baseAssetDenom string, quoteAssetDenom string) (sdk.Dec, error) {
+	if !p.HasDenom(baseAssetDenom) || !p.HasDenom(quoteAssetDenom) || baseAssetDenom == quoteAssetDenom {
+		return sdk.Dec{}, sdkerrors.Wrapf(ErrInvalidDenom, "%s and %s in pool %d", baseAssetDenom, quoteAssetDenom, p.Id)
+	}
+	if !p.HasPrice() {
+		return sdk.Dec{}, sdkerrors.Wrapf(ErrNotEnoughLiquidity, "pool %d has no price yet", p.Id)
+	}
+
+	price := p.CurrentSqrtPrice.Mul(p.CurrentSqrtPrice)
+	if baseAssetDenom == p.Token0 {
+		return sdk.OneDec().Quo(price), nil
+	}
+	return price, nil
+}
+
+// Validate returns an error if the pool's state is invalid.
+func (p Pool) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(p.Address); err != nil {
+		return fmt.Errorf("invalid address: %w", err)
+	}
+	if err := validateDenoms(p.Token0, p.Token1); err != nil {
+		return err
+	}
+	if p.TickSpacing == 0 {
+		return fmt.Errorf("tick spacing must be positive")
+	}
+	if err := validateSwapFee(p.SwapFee); err != nil {
+		return err
+	}
+	if err := ValidateTick(p.CurrentTick); err != nil {
+		return err
+	}
+	if p.CurrentSqrtPrice.IsNil() || p.CurrentSqrtPrice.IsNegative() {
+		return fmt.Errorf("invalid current square root price: %s", p.CurrentSqrtPrice)
+	}
+	for _, dec := range []sdk.Dec{p.CurrentTickLiquidity, p.FeeGrowthGlobal0, p.FeeGrowthGlobal1} {
+		if dec.IsNil() || dec.IsNegative() {
+			return fmt.Errorf("invalid pool state: %s", p)
+		}
+	}
+	return nil
+}
+
+func validateDenoms(denom0, denom1 string) error {
+	if err := sdk.ValidateDenom(denom0); err != nil {
+		return err
+	}
+	if err := sdk.ValidateDenom(denom1); err != nil {
+		return err
+	}
+	if denom0 == denom1 {
+		return fmt.Errorf("pool tokens must be different, got %s twice", denom0)
+	}
+	return nil
+}
+
+func validateSwapFee(swapFee sdk.Dec) error {
+	if swapFee.IsNil() || swapFee.IsNegative() || swapFee.GTE(sdk.OneDec()) {
+		return fmt.Errorf("swap fee must be in [0, 1), got %s", swapFee)
+	}
+	return nil
+}
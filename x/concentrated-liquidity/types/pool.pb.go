@@ -0,0 +1,756 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/concentrated-liquidity/v1beta1/pool.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Pool is a concentrated-liquidity pool of two tokens. Liquidity is provided
+// in positions over ranges of ticks, and only the positions whose range
+// contains the current price are swapped against.
+//
+// Prices are of token1 in token0, and tick i is the price 1.0001^i.
+type Pool struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Token0  string `protobuf:"bytes,3,opt,name=token0,proto3" json:"token0,omitempty" yaml:"token0"`
+	Token1  string `protobuf:"bytes,4,opt,name=token1,proto3" json:"token1,omitempty" yaml:"token1"`
+	// tick_spacing is the distance between the ticks positions can start and
+	// end at.
+	TickSpacing uint64                                 `protobuf:"varint,5,opt,name=tick_spacing,json=tickSpacing,proto3" json:"tick_spacing,omitempty" yaml:"tick_spacing"`
+	SwapFee     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=swap_fee,json=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee" yaml:"swap_fee"`
+	// current_sqrt_price is the square root of the current price. It is zero
+	// until the pool's first position sets the price.
+	CurrentSqrtPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=current_sqrt_price,json=currentSqrtPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"current_sqrt_price" yaml:"current_sqrt_price"`
+	// current_tick is the largest tick whose price is at most the current
+	// price.
+	CurrentTick int64 `protobuf:"varint,8,opt,name=current_tick,json=currentTick,proto3" json:"current_tick,omitempty" yaml:"current_tick"`
+	// current_tick_liquidity is the liquidity of the positions whose range
+	// contains the current tick.
+	CurrentTickLiquidity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=current_tick_liquidity,json=currentTickLiquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"current_tick_liquidity" yaml:"current_tick_liquidity"`
+	// fee_growth_global0 and fee_growth_global1 are the swap fees the pool has
+	// collected in token0 and token1, per unit of liquidity they were collected
+	// from.
+	FeeGrowthGlobal0 github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=fee_growth_global0,json=feeGrowthGlobal0,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_growth_global0" yaml:"fee_growth_global0"`
+	FeeGrowthGlobal1 github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=fee_growth_global1,json=feeGrowthGlobal1,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_growth_global1" yaml:"fee_growth_global1"`
+}
+
+func (m *Pool) Reset()      { *m = Pool{} }
+func (*Pool) ProtoMessage() {}
+func (*Pool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b7a978d8fb262fa, []int{0}
+}
+func (m *Pool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Pool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Pool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Pool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Pool.Merge(m, src)
+}
+func (m *Pool) XXX_Size() int {
+	return m.Size()
+}
+func (m *Pool) XXX_DiscardUnknown() {
+	xxx_messageInfo_Pool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Pool proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Pool)(nil), "osmosis.concentratedliquidity.v1beta1.Pool")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/concentrated-liquidity/v1beta1/pool.proto", fileDescriptor_7b7a978d8fb262fa)
+}
+
+var fileDescriptor_7b7a978d8fb262fa = []byte{
+	// 513 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0xed, 0x34, 0x24, 0xe9, 0x06, 0x4a, 0x59, 0x2a, 0x58, 0x90, 0xb0, 0xa3, 0x95, 0x40,
+	0x41, 0x22, 0x71, 0x2d, 0x0e, 0x48, 0xb9, 0x35, 0x42, 0xf4, 0x00, 0x12, 0x95, 0xcb, 0x09, 0x90,
+	0x2c, 0x7b, 0xbd, 0x71, 0x57, 0x71, 0xbc, 0x8e, 0x77, 0xd3, 0x92, 0x07, 0x40, 0xe2, 0xc8, 0x91,
+	0x63, 0x1f, 0xa7, 0xc7, 0x1e, 0x11, 0x07, 0x0b, 0x25, 0x6f, 0x10, 0x5e, 0x00, 0xf9, 0x5f, 0xb0,
+	0x94, 0x72, 0xa8, 0xe0, 0xe4, 0x9d, 0x99, 0xef, 0x9b, 0xdf, 0x78, 0xe4, 0x35, 0x30, 0xb9, 0x98,
+	0x70, 0xc1, 0x84, 0x41, 0x78, 0x48, 0x68, 0x28, 0x63, 0x47, 0x52, 0xaf, 0x17, 0xb0, 0xe9, 0x8c,
+	0x79, 0x4c, 0xce, 0x8d, 0x53, 0xd3, 0xa5, 0xd2, 0x31, 0x8d, 0x88, 0xf3, 0xa0, 0x1f, 0xc5, 0x5c,
+	0x72, 0xf8, 0xb8, 0xb0, 0xf4, 0xab, 0x96, 0xb5, 0xa3, 0x5f, 0x38, 0x1e, 0xee, 0xf9, 0xdc, 0xe7,
+	0x99, 0xc3, 0x48, 0x4f, 0xb9, 0x19, 0xff, 0x6a, 0x80, 0xfa, 0x11, 0xe7, 0x01, 0x7c, 0x06, 0x9a,
+	0x8e, 0xe7, 0xc5, 0x54, 0x08, 0xa4, 0x76, 0xd4, 0xee, 0xf6, 0x10, 0xae, 0x12, 0x7d, 0x67, 0xee,
+	0x4c, 0x82, 0x01, 0x2e, 0x0a, 0xd8, 0x2a, 0x25, 0x70, 0x07, 0xd4, 0x98, 0x87, 0x6a, 0x1d, 0xb5,
+	0x5b, 0xb7, 0x6a, 0xcc, 0x83, 0x4f, 0x41, 0x43, 0xf2, 0x31, 0x0d, 0xf7, 0xd1, 0x56, 0x66, 0xbe,
+	0xb3, 0x4a, 0xf4, 0x5b, 0xb9, 0x39, 0xcf, 0x63, 0xab, 0x10, 0xac, 0xa5, 0x26, 0xaa, 0x5f, 0x29,
+	0x35, 0x4b, 0xa9, 0x09, 0x07, 0xe0, 0xa6, 0x64, 0x64, 0x6c, 0x8b, 0xc8, 0x21, 0x2c, 0xf4, 0xd1,
+	0x8d, 0x94, 0x37, 0xbc, 0xbf, 0x4a, 0xf4, 0xbb, 0x85, 0xa1, 0x52, 0xc5, 0x56, 0x3b, 0x0d, 0x8f,
+	0xf3, 0x08, 0x7e, 0x04, 0x2d, 0x71, 0xe6, 0x44, 0xf6, 0x88, 0x52, 0xd4, 0xc8, 0x40, 0x07, 0x17,
+	0x89, 0xae, 0xfc, 0x48, 0xf4, 0x27, 0x3e, 0x93, 0x27, 0x33, 0xb7, 0x4f, 0xf8, 0xc4, 0x20, 0xd9,
+	0xee, 0x8a, 0x47, 0x4f, 0x78, 0x63, 0x43, 0xce, 0x23, 0x2a, 0xfa, 0x2f, 0x29, 0x59, 0x25, 0xfa,
+	0xed, 0x9c, 0x52, 0xf6, 0xc1, 0x56, 0x33, 0x3d, 0xbe, 0xa2, 0x14, 0xce, 0x01, 0x24, 0xb3, 0x38,
+	0xa6, 0xa1, 0xb4, 0xc5, 0x34, 0x96, 0x76, 0x14, 0x33, 0x42, 0x51, 0x33, 0xe3, 0xbc, 0xbe, 0x36,
+	0xe7, 0x41, 0xce, 0xd9, 0xec, 0x88, 0xad, 0xdd, 0x22, 0x79, 0x3c, 0x8d, 0xe5, 0x51, 0x9a, 0x4a,
+	0x97, 0x52, 0x0a, 0xd3, 0xf7, 0x45, 0xad, 0x8e, 0xda, 0xdd, 0xaa, 0x2e, 0xa5, 0x5a, 0xc5, 0x56,
+	0xbb, 0x08, 0xdf, 0x31, 0x32, 0x86, 0x9f, 0x55, 0x70, 0xaf, 0x5a, 0xb6, 0xd7, 0x9f, 0x09, 0xda,
+	0xce, 0x66, 0x7f, 0x7b, 0xed, 0xd9, 0x1f, 0x6d, 0x42, 0xff, 0x74, 0xc5, 0xd6, 0x5e, 0x05, 0xff,
+	0xa6, 0x4c, 0xa7, 0xeb, 0x1b, 0x51, 0x6a, 0xfb, 0x31, 0x3f, 0x93, 0x27, 0xb6, 0x1f, 0x70, 0xd7,
+	0x09, 0xf6, 0x11, 0xf8, 0xb7, 0xf5, 0x6d, 0x76, 0xc4, 0xd6, 0xee, 0x88, 0xd2, 0xc3, 0x2c, 0x77,
+	0x98, 0xa7, 0xae, 0x44, 0x9b, 0xa8, 0xfd, 0x9f, 0xd1, 0xe6, 0x26, 0xda, 0x1c, 0xb4, 0xbe, 0x9c,
+	0xeb, 0xca, 0xb7, 0x73, 0x5d, 0x19, 0x7e, 0xb8, 0x58, 0x68, 0xea, 0xe5, 0x42, 0x53, 0x7f, 0x2e,
+	0x34, 0xf5, 0xeb, 0x52, 0x53, 0x2e, 0x97, 0x9a, 0xf2, 0x7d, 0xa9, 0x29, 0xef, 0x0f, 0x2a, 0xe8,
+	0xe2, 0x5e, 0xf7, 0x02, 0xc7, 0x15, 0x65, 0x60, 0x9c, 0xbe, 0x30, 0x3e, 0xfd, 0xed, 0xe7, 0x90,
+	0x4d, 0xe6, 0x36, 0xb2, 0x9b, 0xfd, 0xfc, 0xf7, 0x00, 0x4d, 0xce, 0xfd, 0x0a, 0x4b, 0x04, 0x00,
+	0x00,
+}
+
+func (m *Pool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Pool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FeeGrowthGlobal1.Size()
+		i -= size
+		if _, err := m.FeeGrowthGlobal1.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.FeeGrowthGlobal0.Size()
+		i -= size
+		if _, err := m.FeeGrowthGlobal0.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.CurrentTickLiquidity.Size()
+		i -= size
+		if _, err := m.CurrentTickLiquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.CurrentTick != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.CurrentTick))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.CurrentSqrtPrice.Size()
+		i -= size
+		if _, err := m.CurrentSqrtPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.SwapFee.Size()
+		i -= size
+		if _, err := m.SwapFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.TickSpacing != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.TickSpacing))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Token1) > 0 {
+		i -= len(m.Token1)
+		copy(dAtA[i:], m.Token1)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.Token1)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Token0) > 0 {
+		i -= len(m.Token0)
+		copy(dAtA[i:], m.Token0)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.Token0)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPool(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPool(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Pool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovPool(uint64(m.Id))
+	}
+	l = len(m.Token0)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	l = len(m.Token1)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	if m.TickSpacing != 0 {
+		n += 1 + sovPool(uint64(m.TickSpacing))
+	}
+	l = m.SwapFee.Size()
+	n += 1 + l + sovPool(uint64(l))
+	l = m.CurrentSqrtPrice.Size()
+	n += 1 + l + sovPool(uint64(l))
+	if m.CurrentTick != 0 {
+		n += 1 + sovPool(uint64(m.CurrentTick))
+	}
+	l = m.CurrentTickLiquidity.Size()
+	n += 1 + l + sovPool(uint64(l))
+	l = m.FeeGrowthGlobal0.Size()
+	n += 1 + l + sovPool(uint64(l))
+	l = m.FeeGrowthGlobal1.Size()
+	n += 1 + l + sovPool(uint64(l))
+	return n
+}
+
+func sovPool(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPool(x uint64) (n int) {
+	return sovPool(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Pool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token0 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token1 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickSpacing", wireType)
+			}
+			m.TickSpacing = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickSpacing |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSqrtPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentSqrtPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTick", wireType)
+			}
+			m.CurrentTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTickLiquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentTickLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGrowthGlobal0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeGrowthGlobal0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGrowthGlobal1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeGrowthGlobal1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPool(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPool
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPool
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPool
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPool
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPool        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPool          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPool = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetOwnerAddress returns the address owning the position.
+func (p Position) GetOwnerAddress() sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(p.Owner)
+	if err != nil {
+		panic(fmt.Sprintf("could not bech32 decode owner of position with id: %d", p.PositionId))
+	}
+	return owner
+}
+
+// Validate returns an error if the position's state is invalid.
+func (p Position) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(p.Owner); err != nil {
+		return fmt.Errorf("invalid owner: %w", err)
+	}
+	if err := ValidateTickRange(p.LowerTick, p.UpperTick); err != nil {
+		return err
+	}
+	if p.Liquidity.IsNil() || !p.Liquidity.IsPositive() {
+		return fmt.Errorf("position %d has no liquidity", p.PositionId)
+	}
+	for _, dec := range []sdk.Dec{p.FeeGrowthInsideLast0, p.FeeGrowthInsideLast1, p.UncollectedFees0, p.UncollectedFees1} {
+		if dec.IsNil() {
+			return fmt.Errorf("position %d has unset fields", p.PositionId)
+		}
+	}
+	return nil
+}
+
+// ValidateTickRange returns an error if [lowerTick, upperTick] is not a
+// valid position range.
+func ValidateTickRange(lowerTick, upperTick int64) error {
+	if err := ValidateTick(lowerTick); err != nil {
+		return err
+	}
+	if err := ValidateTick(upperTick); err != nil {
+		return err
+	}
+	if lowerTick >= upperTick {
+		return fmt.Errorf("%w: lower tick %d must be below upper tick %d", ErrInvalidTickRange, lowerTick, upperTick)
+	}
+	return nil
+}
@@ -0,0 +1,720 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/concentrated-liquidity/v1beta1/position.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Position is liquidity provided to a pool over the price range from
+// lower_tick to upper_tick.
+type Position struct {
+	PositionId uint64                                 `protobuf:"varint,1,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
+	PoolId     uint64                                 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Owner      string                                 `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	LowerTick  int64                                  `protobuf:"varint,4,opt,name=lower_tick,json=lowerTick,proto3" json:"lower_tick,omitempty" yaml:"lower_tick"`
+	UpperTick  int64                                  `protobuf:"varint,5,opt,name=upper_tick,json=upperTick,proto3" json:"upper_tick,omitempty" yaml:"upper_tick"`
+	Liquidity  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=liquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity" yaml:"liquidity"`
+	// fee_growth_inside_last0 and fee_growth_inside_last1 are the fee growth
+	// inside the position's range when its fees were last accrued.
+	FeeGrowthInsideLast0 github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=fee_growth_inside_last0,json=feeGrowthInsideLast0,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_growth_inside_last0" yaml:"fee_growth_inside_last0"`
+	FeeGrowthInsideLast1 github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=fee_growth_inside_last1,json=feeGrowthInsideLast1,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_growth_inside_last1" yaml:"fee_growth_inside_last1"`
+	// uncollected_fees0 and uncollected_fees1 are the fees accrued to the
+	// position and not collected yet.
+	UncollectedFees0 github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=uncollected_fees0,json=uncollectedFees0,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"uncollected_fees0" yaml:"uncollected_fees0"`
+	UncollectedFees1 github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=uncollected_fees1,json=uncollectedFees1,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"uncollected_fees1" yaml:"uncollected_fees1"`
+}
+
+func (m *Position) Reset()         { *m = Position{} }
+func (m *Position) String() string { return proto.CompactTextString(m) }
+func (*Position) ProtoMessage()    {}
+func (*Position) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33940caeb0044530, []int{0}
+}
+func (m *Position) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Position) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Position.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Position) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Position.Merge(m, src)
+}
+func (m *Position) XXX_Size() int {
+	return m.Size()
+}
+func (m *Position) XXX_DiscardUnknown() {
+	xxx_messageInfo_Position.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Position proto.InternalMessageInfo
+
+func (m *Position) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
+	}
+	return 0
+}
+
+func (m *Position) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *Position) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Position) GetLowerTick() int64 {
+	if m != nil {
+		return m.LowerTick
+	}
+	return 0
+}
+
+func (m *Position) GetUpperTick() int64 {
+	if m != nil {
+		return m.UpperTick
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Position)(nil), "osmosis.concentratedliquidity.v1beta1.Position")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/concentrated-liquidity/v1beta1/position.proto", fileDescriptor_33940caeb0044530)
+}
+
+var fileDescriptor_33940caeb0044530 = []byte{
+	// 487 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x1b, 0xb6, 0x75, 0x8b, 0x41, 0xa8, 0xb3, 0x06, 0x58, 0x1c, 0x92, 0xca, 0x12, 0x53,
+	0x25, 0xd4, 0x64, 0x11, 0xa0, 0x49, 0xdc, 0x88, 0x10, 0xa8, 0x88, 0xc3, 0x14, 0x71, 0x82, 0x43,
+	0x48, 0x1d, 0xb7, 0xb3, 0xea, 0xc6, 0x21, 0x76, 0x57, 0xfa, 0x09, 0xb8, 0xf2, 0xb1, 0x76, 0xdc,
+	0x11, 0x71, 0x88, 0x50, 0x7b, 0xe5, 0x94, 0x4f, 0x80, 0xe2, 0x24, 0x5b, 0x35, 0x36, 0xa4, 0x49,
+	0xec, 0xd4, 0xf7, 0xde, 0xdf, 0xbf, 0xf7, 0xff, 0xcb, 0x95, 0x03, 0x5e, 0x08, 0x39, 0x15, 0x92,
+	0x49, 0x97, 0x88, 0x84, 0xd0, 0x44, 0x65, 0x91, 0xa2, 0x71, 0x9f, 0xb3, 0x2f, 0x33, 0x16, 0x33,
+	0xb5, 0x70, 0x4f, 0xbc, 0x21, 0x55, 0x91, 0xe7, 0xa6, 0x42, 0x32, 0xc5, 0x44, 0xe2, 0xa4, 0x99,
+	0x50, 0x02, 0x3e, 0xa9, 0x31, 0x67, 0x1d, 0x3b, 0xa7, 0x9c, 0x9a, 0x7a, 0xbc, 0x37, 0x16, 0x63,
+	0xa1, 0x09, 0xb7, 0xac, 0x2a, 0x18, 0xff, 0x6e, 0x83, 0x9d, 0xa3, 0x7a, 0x1f, 0x3c, 0x04, 0x77,
+	0x9b, 0xdd, 0x21, 0x8b, 0x91, 0xd1, 0x35, 0x7a, 0x9b, 0xfe, 0xc3, 0x22, 0xb7, 0xe1, 0x22, 0x9a,
+	0xf2, 0x97, 0x78, 0x4d, 0xc4, 0x01, 0x68, 0xba, 0x41, 0x0c, 0x9f, 0x82, 0xed, 0x54, 0x08, 0x5e,
+	0x42, 0x77, 0x34, 0x04, 0x8b, 0xdc, 0xbe, 0xdf, 0x40, 0x5a, 0xc0, 0x41, 0xbb, 0xac, 0x06, 0x31,
+	0xdc, 0x07, 0x5b, 0x62, 0x9e, 0xd0, 0x0c, 0x6d, 0x74, 0x8d, 0x9e, 0xe9, 0x77, 0x8a, 0xdc, 0xbe,
+	0x57, 0x1d, 0xd5, 0x63, 0x1c, 0x54, 0x32, 0x7c, 0x0e, 0x00, 0x17, 0x73, 0x9a, 0x85, 0x8a, 0x91,
+	0x09, 0xda, 0xec, 0x1a, 0xbd, 0x0d, 0xff, 0x41, 0x91, 0xdb, 0xbb, 0xd5, 0xe1, 0x0b, 0x0d, 0x07,
+	0xa6, 0x6e, 0x3e, 0x30, 0x32, 0x29, 0xa9, 0x59, 0x9a, 0x36, 0xd4, 0xd6, 0x65, 0xea, 0x42, 0xc3,
+	0x81, 0xa9, 0x1b, 0x4d, 0x7d, 0x06, 0xe6, 0xf9, 0x8d, 0xa1, 0xb6, 0xce, 0xe5, 0x9f, 0xe6, 0x76,
+	0xeb, 0x67, 0x6e, 0xef, 0x8f, 0x99, 0x3a, 0x9e, 0x0d, 0x1d, 0x22, 0xa6, 0x2e, 0xd1, 0x57, 0x5d,
+	0xff, 0xf4, 0x65, 0x3c, 0x71, 0xd5, 0x22, 0xa5, 0xd2, 0x79, 0x4d, 0x49, 0x91, 0xdb, 0x9d, 0x3a,
+	0x58, 0xb3, 0xa8, 0xcc, 0xd5, 0xd4, 0xf0, 0x9b, 0x01, 0x1e, 0x8d, 0x28, 0x0d, 0xc7, 0x99, 0x98,
+	0xab, 0xe3, 0x90, 0x25, 0x92, 0xc5, 0x34, 0xe4, 0x91, 0x54, 0x07, 0x68, 0x5b, 0x1b, 0x1e, 0xdd,
+	0xd8, 0xd0, 0xaa, 0x0c, 0xaf, 0x59, 0x8b, 0x83, 0xbd, 0x11, 0xa5, 0x6f, 0xb5, 0x30, 0xd0, 0xf3,
+	0xf7, 0xe5, 0xf8, 0x1f, 0x49, 0x3c, 0xb4, 0x73, 0x1b, 0x49, 0xbc, 0xab, 0x93, 0x78, 0x70, 0x0e,
+	0x76, 0x67, 0x09, 0x11, 0x9c, 0x53, 0xa2, 0x68, 0x1c, 0x8e, 0x28, 0x95, 0x07, 0xc8, 0xd4, 0x11,
+	0xde, 0xdd, 0x38, 0x02, 0xaa, 0xff, 0xe0, 0xcb, 0x0b, 0x71, 0xd0, 0x59, 0x9b, 0xbd, 0x29, 0x47,
+	0x57, 0x19, 0x7b, 0x08, 0xfc, 0x5f, 0x63, 0xef, 0x6f, 0x63, 0xcf, 0xff, 0x74, 0xba, 0xb4, 0x8c,
+	0xb3, 0xa5, 0x65, 0xfc, 0x5a, 0x5a, 0xc6, 0xf7, 0x95, 0xd5, 0x3a, 0x5b, 0x59, 0xad, 0x1f, 0x2b,
+	0xab, 0xf5, 0xf1, 0xd5, 0x9a, 0x5f, 0xfd, 0xa0, 0xfb, 0x3c, 0x1a, 0xca, 0xa6, 0x71, 0x4f, 0x0e,
+	0xdd, 0xaf, 0xd7, 0x7d, 0x19, 0x74, 0x9c, 0x61, 0x5b, 0x3f, 0xe9, 0x67, 0x7f, 0x06, 0x00, 0x5c,
+	0x91, 0xcb, 0x10, 0x48, 0x04, 0x00, 0x00,
+}
+
+func (m *Position) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Position) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Position) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.UncollectedFees1.Size()
+		i -= size
+		if _, err := m.UncollectedFees1.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPosition(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.UncollectedFees0.Size()
+		i -= size
+		if _, err := m.UncollectedFees0.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPosition(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.FeeGrowthInsideLast1.Size()
+		i -= size
+		if _, err := m.FeeGrowthInsideLast1.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPosition(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.FeeGrowthInsideLast0.Size()
+		i -= size
+		if _, err := m.FeeGrowthInsideLast0.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPosition(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Liquidity.Size()
+		i -= size
+		if _, err := m.Liquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPosition(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.UpperTick != 0 {
+		i = encodeVarintPosition(dAtA, i, uint64(m.UpperTick))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LowerTick != 0 {
+		i = encodeVarintPosition(dAtA, i, uint64(m.LowerTick))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintPosition(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintPosition(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PositionId != 0 {
+		i = encodeVarintPosition(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPosition(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPosition(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Position) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovPosition(uint64(m.PositionId))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovPosition(uint64(m.PoolId))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovPosition(uint64(l))
+	}
+	if m.LowerTick != 0 {
+		n += 1 + sovPosition(uint64(m.LowerTick))
+	}
+	if m.UpperTick != 0 {
+		n += 1 + sovPosition(uint64(m.UpperTick))
+	}
+	l = m.Liquidity.Size()
+	n += 1 + l + sovPosition(uint64(l))
+	l = m.FeeGrowthInsideLast0.Size()
+	n += 1 + l + sovPosition(uint64(l))
+	l = m.FeeGrowthInsideLast1.Size()
+	n += 1 + l + sovPosition(uint64(l))
+	l = m.UncollectedFees0.Size()
+	n += 1 + l + sovPosition(uint64(l))
+	l = m.UncollectedFees1.Size()
+	n += 1 + l + sovPosition(uint64(l))
+	return n
+}
+
+func sovPosition(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPosition(x uint64) (n int) {
+	return sovPosition(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Position) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPosition
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Position: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Position: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPosition
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPosition
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+			}
+			m.LowerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+			}
+			m.UpperTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpperTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPosition
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPosition
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Liquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGrowthInsideLast0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPosition
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPosition
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeGrowthInsideLast0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGrowthInsideLast1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPosition
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPosition
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeGrowthInsideLast1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UncollectedFees0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPosition
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPosition
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UncollectedFees0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UncollectedFees1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPosition
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPosition
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UncollectedFees1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPosition(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPosition
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPosition(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPosition
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPosition
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPosition
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPosition
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPosition        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPosition          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPosition = fmt.Errorf("proto: unexpected end of group")
+)
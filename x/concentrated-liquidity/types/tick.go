@@ -0,0 +1,38 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewTickInfo returns the state of a tick with no liquidity. Fees are assumed
+// to have all been collected below the current tick, so the fee growth
+// outside a tick at or below it starts at the pool's global fee growth.
+func NewTickInfo(pool Pool, tickIndex int64) TickInfo {
+	info := TickInfo{
+		LiquidityGross:    sdk.ZeroDec(),
+		LiquidityNet:      sdk.ZeroDec(),
+		FeeGrowthOutside0: sdk.ZeroDec(),
+		FeeGrowthOutside1: sdk.ZeroDec(),
+	}
+	if tickIndex <= pool.CurrentTick {
+		info.FeeGrowthOutside0 = pool.FeeGrowthGlobal0
+		info.FeeGrowthOutside1 = pool.FeeGrowthGlobal1
+	}
+	return info
+}
+
+// Validate returns an error if the tick's state is invalid.
+func (t FullTick) Validate() error {
+	if err := ValidateTick(t.TickIndex); err != nil {
+		return err
+	}
+	if t.Info.LiquidityGross.IsNil() || !t.Info.LiquidityGross.IsPositive() {
+		return fmt.Errorf("tick %d of pool %d has no liquidity", t.TickIndex, t.PoolId)
+	}
+	if t.Info.LiquidityNet.IsNil() || t.Info.FeeGrowthOutside0.IsNil() || t.Info.FeeGrowthOutside1.IsNil() {
+		return fmt.Errorf("tick %d of pool %d has unset fields", t.TickIndex, t.PoolId)
+	}
+	return nil
+}
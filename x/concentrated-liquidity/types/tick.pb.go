@@ -0,0 +1,725 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/concentrated-liquidity/v1beta1/tick.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TickInfo is the state of a tick that some position starts or ends at.
+type TickInfo struct {
+	// liquidity_gross is the liquidity of the positions starting or ending at
+	// the tick. The tick is deleted once it is zero.
+	LiquidityGross github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=liquidity_gross,json=liquidityGross,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity_gross" yaml:"liquidity_gross"`
+	// liquidity_net is the liquidity added to the pool's current liquidity when
+	// the price crosses the tick upwards, and removed when it crosses it
+	// downwards.
+	LiquidityNet github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=liquidity_net,json=liquidityNet,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity_net" yaml:"liquidity_net"`
+	// fee_growth_outside0 and fee_growth_outside1 are the fee growth on the
+	// other side of the tick from the current tick, relative to when the tick
+	// was created.
+	FeeGrowthOutside0 github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=fee_growth_outside0,json=feeGrowthOutside0,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_growth_outside0" yaml:"fee_growth_outside0"`
+	FeeGrowthOutside1 github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=fee_growth_outside1,json=feeGrowthOutside1,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_growth_outside1" yaml:"fee_growth_outside1"`
+}
+
+func (m *TickInfo) Reset()         { *m = TickInfo{} }
+func (m *TickInfo) String() string { return proto.CompactTextString(m) }
+func (*TickInfo) ProtoMessage()    {}
+func (*TickInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8de3ed8f71c06e48, []int{0}
+}
+func (m *TickInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TickInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TickInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TickInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TickInfo.Merge(m, src)
+}
+func (m *TickInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *TickInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_TickInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TickInfo proto.InternalMessageInfo
+
+// FullTick is a tick of a pool, with its index and state.
+type FullTick struct {
+	PoolId    uint64   `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	TickIndex int64    `protobuf:"varint,2,opt,name=tick_index,json=tickIndex,proto3" json:"tick_index,omitempty" yaml:"tick_index"`
+	Info      TickInfo `protobuf:"bytes,3,opt,name=info,proto3" json:"info"`
+}
+
+func (m *FullTick) Reset()         { *m = FullTick{} }
+func (m *FullTick) String() string { return proto.CompactTextString(m) }
+func (*FullTick) ProtoMessage()    {}
+func (*FullTick) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8de3ed8f71c06e48, []int{1}
+}
+func (m *FullTick) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FullTick) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FullTick.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FullTick) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FullTick.Merge(m, src)
+}
+func (m *FullTick) XXX_Size() int {
+	return m.Size()
+}
+func (m *FullTick) XXX_DiscardUnknown() {
+	xxx_messageInfo_FullTick.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FullTick proto.InternalMessageInfo
+
+func (m *FullTick) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *FullTick) GetTickIndex() int64 {
+	if m != nil {
+		return m.TickIndex
+	}
+	return 0
+}
+
+func (m *FullTick) GetInfo() TickInfo {
+	if m != nil {
+		return m.Info
+	}
+	return TickInfo{}
+}
+
+func init() {
+	proto.RegisterType((*TickInfo)(nil), "osmosis.concentratedliquidity.v1beta1.TickInfo")
+	proto.RegisterType((*FullTick)(nil), "osmosis.concentratedliquidity.v1beta1.FullTick")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/concentrated-liquidity/v1beta1/tick.proto", fileDescriptor_8de3ed8f71c06e48)
+}
+
+var fileDescriptor_8de3ed8f71c06e48 = []byte{
+	// 439 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xc1, 0x6a, 0xd4, 0x40,
+	0x18, 0xc7, 0x13, 0x37, 0xd4, 0x76, 0xd4, 0x4a, 0xc7, 0x2a, 0xa1, 0x87, 0x44, 0x06, 0x14, 0x41,
+	0x36, 0x63, 0x54, 0x10, 0xbc, 0x19, 0xa4, 0x75, 0x41, 0x14, 0x82, 0x27, 0x3d, 0x84, 0x24, 0x33,
+	0x49, 0x87, 0x64, 0x33, 0xdb, 0x9d, 0x49, 0xdd, 0x05, 0x1f, 0xc2, 0xc7, 0xf1, 0x11, 0x7a, 0xec,
+	0x51, 0x3c, 0x04, 0xd9, 0x05, 0x1f, 0x60, 0x9f, 0x40, 0x32, 0x49, 0xb3, 0xcb, 0xb2, 0x82, 0x0b,
+	0x3d, 0x65, 0xe6, 0x9b, 0xf9, 0x7f, 0xbf, 0xf0, 0xff, 0xfe, 0x03, 0x5c, 0x2e, 0x86, 0x5c, 0x30,
+	0x81, 0x63, 0x5e, 0xc4, 0xb4, 0x90, 0xe3, 0x50, 0x52, 0xd2, 0xcf, 0xd9, 0x59, 0xc9, 0x08, 0x93,
+	0x53, 0x7c, 0xee, 0x46, 0x54, 0x86, 0x2e, 0x96, 0x2c, 0xce, 0x9c, 0xd1, 0x98, 0x4b, 0x0e, 0x1f,
+	0xb5, 0x12, 0x67, 0x55, 0xd2, 0x29, 0x9c, 0x56, 0x71, 0x74, 0x98, 0xf2, 0x94, 0x2b, 0x05, 0xae,
+	0x57, 0x8d, 0x18, 0xfd, 0xe9, 0x81, 0xdd, 0x4f, 0x2c, 0xce, 0x06, 0x45, 0xc2, 0xe1, 0x19, 0xb8,
+	0xdb, 0xe9, 0x82, 0x74, 0xcc, 0x85, 0x30, 0xf5, 0x87, 0xfa, 0x93, 0x3d, 0xef, 0xdd, 0x45, 0x65,
+	0x6b, 0xbf, 0x2a, 0xfb, 0x71, 0xca, 0xe4, 0x69, 0x19, 0x39, 0x31, 0x1f, 0xe2, 0x58, 0x61, 0xdb,
+	0x4f, 0x5f, 0x90, 0x0c, 0xcb, 0xe9, 0x88, 0x0a, 0xe7, 0x2d, 0x8d, 0x17, 0x95, 0xfd, 0x60, 0x1a,
+	0x0e, 0xf3, 0xd7, 0x68, 0xad, 0x1d, 0xf2, 0xf7, 0xbb, 0xca, 0x49, 0x5d, 0x80, 0x19, 0xb8, 0xb3,
+	0xbc, 0x53, 0x50, 0x69, 0xde, 0x50, 0xc0, 0xe3, 0xad, 0x81, 0x87, 0xeb, 0xc0, 0x82, 0x4a, 0xe4,
+	0xdf, 0xee, 0xf6, 0x1f, 0xa8, 0x84, 0xdf, 0xc0, 0xbd, 0x84, 0xd2, 0xfa, 0x57, 0xbe, 0xca, 0xd3,
+	0x80, 0x97, 0x52, 0x30, 0x42, 0x9f, 0x99, 0x3d, 0x85, 0x7c, 0xbf, 0x35, 0xf2, 0xa8, 0x41, 0x6e,
+	0x68, 0x89, 0xfc, 0x83, 0x84, 0xd2, 0x13, 0x55, 0xfc, 0xd8, 0xd6, 0x36, 0xd3, 0x5d, 0xd3, 0xb8,
+	0x6e, 0xba, 0xbb, 0x81, 0xee, 0xa2, 0x1f, 0x3a, 0xd8, 0x3d, 0x2e, 0xf3, 0xbc, 0x1e, 0x36, 0x7c,
+	0x0a, 0x6e, 0x8e, 0x38, 0xcf, 0x03, 0x46, 0xd4, 0x80, 0x0d, 0x0f, 0x2e, 0x2a, 0x7b, 0xbf, 0x69,
+	0xd8, 0x1e, 0x20, 0x7f, 0xa7, 0x5e, 0x0d, 0x08, 0x7c, 0x09, 0x40, 0x9d, 0xb6, 0x80, 0x15, 0x84,
+	0x4e, 0xd4, 0x7c, 0x7a, 0xde, 0xfd, 0x45, 0x65, 0x1f, 0x34, 0xf7, 0x97, 0x67, 0xc8, 0xdf, 0x93,
+	0x2a, 0x4a, 0x84, 0x4e, 0xe0, 0x00, 0x18, 0xac, 0x48, 0xb8, 0x32, 0xf7, 0xd6, 0x73, 0xec, 0xfc,
+	0x57, 0x48, 0x9d, 0xab, 0x28, 0x7a, 0x46, 0xed, 0x87, 0xaf, 0x5a, 0x78, 0x5f, 0x2e, 0x66, 0x96,
+	0x7e, 0x39, 0xb3, 0xf4, 0xdf, 0x33, 0x4b, 0xff, 0x3e, 0xb7, 0xb4, 0xcb, 0xb9, 0xa5, 0xfd, 0x9c,
+	0x5b, 0xda, 0xe7, 0x37, 0x2b, 0x6e, 0xb5, 0x80, 0x7e, 0x1e, 0x46, 0xe2, 0x6a, 0x83, 0xcf, 0x5f,
+	0xe1, 0xc9, 0xbf, 0x9e, 0x92, 0x32, 0x33, 0xda, 0x51, 0xef, 0xe0, 0xc5, 0xdf, 0x01, 0x00, 0xdc,
+	0x21, 0x4e, 0x37, 0x79, 0x03, 0x00, 0x00,
+}
+
+func (m *TickInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TickInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TickInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FeeGrowthOutside1.Size()
+		i -= size
+		if _, err := m.FeeGrowthOutside1.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTick(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.FeeGrowthOutside0.Size()
+		i -= size
+		if _, err := m.FeeGrowthOutside0.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTick(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.LiquidityNet.Size()
+		i -= size
+		if _, err := m.LiquidityNet.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTick(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.LiquidityGross.Size()
+		i -= size
+		if _, err := m.LiquidityGross.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTick(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FullTick) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FullTick) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FullTick) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTick(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.TickIndex != 0 {
+		i = encodeVarintTick(dAtA, i, uint64(m.TickIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTick(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTick(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTick(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TickInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LiquidityGross.Size()
+	n += 1 + l + sovTick(uint64(l))
+	l = m.LiquidityNet.Size()
+	n += 1 + l + sovTick(uint64(l))
+	l = m.FeeGrowthOutside0.Size()
+	n += 1 + l + sovTick(uint64(l))
+	l = m.FeeGrowthOutside1.Size()
+	n += 1 + l + sovTick(uint64(l))
+	return n
+}
+
+func (m *FullTick) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovTick(uint64(m.PoolId))
+	}
+	if m.TickIndex != 0 {
+		n += 1 + sovTick(uint64(m.TickIndex))
+	}
+	l = m.Info.Size()
+	n += 1 + l + sovTick(uint64(l))
+	return n
+}
+
+func sovTick(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTick(x uint64) (n int) {
+	return sovTick(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TickInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTick
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TickInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TickInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityGross", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTick
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTick
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTick
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityGross.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityNet", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTick
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTick
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTick
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityNet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGrowthOutside0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTick
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTick
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTick
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeGrowthOutside0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGrowthOutside1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTick
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTick
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTick
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeGrowthOutside1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTick(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTick
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FullTick) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTick
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FullTick: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FullTick: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTick
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickIndex", wireType)
+			}
+			m.TickIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTick
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTick
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTick
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTick
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTick(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTick
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTick(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTick
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTick
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTick
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTick
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTick
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTick
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTick        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTick          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTick = fmt.Errorf("proto: unexpected end of group")
+)
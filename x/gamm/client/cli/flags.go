@@ -7,6 +7,11 @@ import (
 const (
 	// Will be parsed to string.
 	FlagPoolFile = "pool-file"
+	// Will be parsed to string, one of the PoolType* values.
+	FlagPoolType = "pool-type"
+
+	PoolTypeBalancer   = "balancer"
+	PoolTypeStableswap = "stableswap"
 
 	// Names of fields in pool json file.
 	PoolFileWeights        = "weights"
@@ -14,6 +19,7 @@ const (
 	PoolFileSwapFee        = "swap-fee"
 	PoolFileExitFee        = "exit-fee"
 	PoolFileFutureGovernor = "future-governor"
+	PoolFileScalingFactors = "scaling-factors"
 
 	PoolFileSmoothWeightChangeParams = "lbp-params"
 	PoolFileStartTime                = "start-time"
@@ -37,6 +43,9 @@ const (
 	FlagSwapRouteAmounts = "swap-route-amounts"
 	// Will be parsed to []string.
 	FlagSwapRouteDenoms = "swap-route-denoms"
+
+	// Will be parsed to time.Time, in RFC3339 format.
+	FlagStartTime = "start-time"
 )
 
 type createPoolInputs struct {
@@ -46,6 +55,7 @@ type createPoolInputs struct {
 	ExitFee                  string                         `json:"exit-fee"`
 	FutureGovernor           string                         `json:"future-governor"`
 	SmoothWeightChangeParams smoothWeightChangeParamsInputs `json:"lbp-params"`
+	ScalingFactors           string                         `json:"scaling-factors"`
 }
 
 type smoothWeightChangeParamsInputs struct {
@@ -74,6 +84,7 @@ func FlagSetCreatePool() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 
 	fs.String(FlagPoolFile, "", "Pool json file path (if this path is given, other create pool flags should not be used)")
+	fs.String(FlagPoolType, PoolTypeBalancer, "Pool type, either balancer or stableswap")
 	return fs
 }
 
@@ -104,3 +115,10 @@ func FlagSetJoinSwapExternAmount() *flag.FlagSet {
 
 	return fs
 }
+
+func FlagSetScheduleWeightChange() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagStartTime, "", "start time of the weight change, in RFC3339 format (defaults to now)")
+	return fs
+}
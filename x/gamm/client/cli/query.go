@@ -36,6 +36,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryTotalLiquidity(),
 		GetCmdEstimateSwapExactAmountIn(),
 		GetCmdEstimateSwapExactAmountOut(),
+		GetCmdEstimateCleanupPools(),
+		GetCmdTakerFeesCollected(),
 	)
 
 	return cmd
@@ -496,3 +498,99 @@ $ %s query gamm estimate-swap-exact-amount-out 1 osm11vmx8jtggpd9u7qr0t8vxclycz8
 
 	return cmd
 }
+
+// GetCmdEstimateCleanupPools returns who would be paid what if pools were
+// cleaned up.
+func GetCmdEstimateCleanupPools() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-cleanup-pools <poolIDs>",
+		Short: "Query who would be paid what if pools were cleaned up",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query who would be paid what if pools were cleaned up by a cleanup-pools proposal.
+Example:
+$ %s query gamm estimate-cleanup-pools 1,2
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			poolIds, err := parsePoolIds(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.EstimateCleanupPools(cmd.Context(), &types.QueryEstimateCleanupPoolsRequest{
+				PoolIds: poolIds,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdTakerFeesCollected returns the taker fees collected so far.
+func GetCmdTakerFeesCollected() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "taker-fees-collected [denom]",
+		Short: "Query the taker fees collected so far, of one denom or of all",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the taker fees collected so far, of one denom or of all.
+Example:
+$ %s query gamm taker-fees-collected uosmo
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryTakerFeesCollectedRequest{}
+			if len(args) > 0 {
+				req.Denom = args[0]
+			}
+
+			res, err := queryClient.TakerFeesCollected(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parsePoolIds parses a comma separated list of pool IDs.
+func parsePoolIds(poolIdsStr string) ([]uint64, error) {
+	poolIds := []uint64{}
+	for _, poolIdStr := range strings.Split(poolIdsStr, ",") {
+		poolId, err := strconv.ParseUint(strings.TrimSpace(poolIdStr), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		poolIds = append(poolIds, poolId)
+	}
+	return poolIds, nil
+}
@@ -11,13 +11,17 @@ import (
 	flag "github.com/spf13/pflag"
 
 	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/stableswap"
 	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v7/x/swaprouter/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func NewTxCmd() *cobra.Command {
@@ -39,6 +43,9 @@ func NewTxCmd() *cobra.Command {
 		NewJoinSwapShareAmountOut(),
 		NewExitSwapExternAmountOut(),
 		NewExitSwapShareAmountIn(),
+		NewSetPoolFeesCmd(),
+		NewSetPoolActiveCmd(),
+		NewScheduleWeightChangeCmd(),
 	)
 
 	return txCmd
@@ -63,8 +70,22 @@ Where pool.json contains:
 	"exit-fee": "0.01",
 	"future-governor": "168h"
 }
+
+For a stableswap pool, pass --pool-type=stableswap. Weights are not used, and
+scaling factors may be provided for assets with different precisions,
+in the (denom sorted) order of the initial deposit:
+$ %s tx gamm create-pool --pool-type=stableswap --pool-file="path/to/pool.json" --from mykey
+
+Where pool.json contains:
+{
+	"initial-deposit": "1000000uusdc,1000000000000000000wei",
+	"swap-fee": "0.001",
+	"exit-fee": "0",
+	"future-governor": "",
+	"scaling-factors": "1,1000000000000"
+}
 `,
-				version.AppName,
+				version.AppName, version.AppName,
 			),
 		),
 		Args: cobra.ExactArgs(0),
@@ -76,7 +97,20 @@ Where pool.json contains:
 
 			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
 
-			txf, msg, err := NewBuildCreateBalancerPoolMsg(clientCtx, txf, cmd.Flags())
+			poolType, err := cmd.Flags().GetString(FlagPoolType)
+			if err != nil {
+				return err
+			}
+
+			var msg sdk.Msg
+			switch poolType {
+			case PoolTypeBalancer:
+				txf, msg, err = NewBuildCreateBalancerPoolMsg(clientCtx, txf, cmd.Flags())
+			case PoolTypeStableswap:
+				txf, msg, err = NewBuildCreateStableswapPoolMsg(clientCtx, txf, cmd.Flags())
+			default:
+				err = fmt.Errorf("unknown pool type %s, expected %s or %s", poolType, PoolTypeBalancer, PoolTypeStableswap)
+			}
 			if err != nil {
 				return err
 			}
@@ -333,6 +367,94 @@ func NewExitSwapShareAmountIn() *cobra.Command {
 	return cmd
 }
 
+func NewSetPoolFeesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-pool-fees [pool-id] [swap-fee] [exit-fee]",
+		Short: "set the swap and exit fees of a pool you govern",
+		Example: fmt.Sprintf(`$ %s tx gamm set-pool-fees 1 0.002 0 --from=mykey`,
+			version.AppName),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			txf, msg, err := NewBuildSetPoolFeesMsg(clientCtx, args[0], args[1], args[2], txf)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewSetPoolActiveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-pool-active [pool-id] [is-active]",
+		Short: "pause swaps against a pool you govern, or unpause them",
+		Example: fmt.Sprintf(`$ %s tx gamm set-pool-active 1 false --from=mykey`,
+			version.AppName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			txf, msg, err := NewBuildSetPoolActiveMsg(clientCtx, args[0], args[1], txf)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewScheduleWeightChangeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule-weight-change [pool-id] [target-pool-weights] [duration]",
+		Short: "smoothly change the weights of a balancer pool you govern",
+		Example: fmt.Sprintf(`$ %s tx gamm schedule-weight-change 1 2uatom,1uosmo 72h --start-time=2022-07-01T00:00:00Z --from=mykey`,
+			version.AppName),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			txf, msg, err := NewBuildScheduleWeightChangeMsg(clientCtx, args[0], args[1], args[2], txf, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetScheduleWeightChange())
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func NewBuildCreateBalancerPoolMsg(clientCtx client.Context, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, sdk.Msg, error) {
 	pool, err := parseCreatePoolFlags(fs)
 	if err != nil {
@@ -432,6 +554,54 @@ func NewBuildCreateBalancerPoolMsg(clientCtx client.Context, txf tx.Factory, fs
 	return txf, msg, nil
 }
 
+func NewBuildCreateStableswapPoolMsg(clientCtx client.Context, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, sdk.Msg, error) {
+	pool, err := parseCreatePoolFlags(fs)
+	if err != nil {
+		return txf, nil, fmt.Errorf("failed to parse pool: %w", err)
+	}
+
+	deposit, err := sdk.ParseCoinsNormalized(pool.InitialDeposit)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	swapFee, err := sdk.NewDecFromStr(pool.SwapFee)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	exitFee, err := sdk.NewDecFromStr(pool.ExitFee)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	var scalingFactors []uint64
+	if pool.ScalingFactors != "" {
+		for _, scalingFactorStr := range strings.Split(pool.ScalingFactors, ",") {
+			scalingFactor, err := strconv.ParseUint(strings.TrimSpace(scalingFactorStr), 10, 64)
+			if err != nil {
+				return txf, nil, fmt.Errorf("could not parse scaling factor: %w", err)
+			}
+			scalingFactors = append(scalingFactors, scalingFactor)
+		}
+	}
+
+	poolParams := &stableswap.PoolParams{
+		SwapFee: swapFee,
+		ExitFee: exitFee,
+	}
+
+	msg := &stableswap.MsgCreateStableswapPool{
+		Sender:               clientCtx.GetFromAddress().String(),
+		PoolParams:           poolParams,
+		InitialPoolLiquidity: deposit,
+		ScalingFactors:       scalingFactors,
+		FuturePoolGovernor:   pool.FutureGovernor,
+	}
+
+	return txf, msg, nil
+}
+
 func NewBuildJoinPoolMsg(clientCtx client.Context, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, sdk.Msg, error) {
 	poolId, err := fs.GetUint64(FlagPoolId)
 	if err != nil {
@@ -512,7 +682,7 @@ func NewBuildExitPoolMsg(clientCtx client.Context, txf tx.Factory, fs *flag.Flag
 	return txf, msg, nil
 }
 
-func swapAmountInRoutes(fs *flag.FlagSet) ([]types.SwapAmountInRoute, error) {
+func swapAmountInRoutes(fs *flag.FlagSet) ([]swaproutertypes.SwapAmountInRoute, error) {
 	swapRoutePoolIds, err := fs.GetStringArray(FlagSwapRoutePoolIds)
 	if err != nil {
 		return nil, err
@@ -527,13 +697,13 @@ func swapAmountInRoutes(fs *flag.FlagSet) ([]types.SwapAmountInRoute, error) {
 		return nil, errors.New("swap route pool ids and denoms mismatch")
 	}
 
-	routes := []types.SwapAmountInRoute{}
+	routes := []swaproutertypes.SwapAmountInRoute{}
 	for index, poolIDStr := range swapRoutePoolIds {
 		pID, err := strconv.Atoi(poolIDStr)
 		if err != nil {
 			return nil, err
 		}
-		routes = append(routes, types.SwapAmountInRoute{
+		routes = append(routes, swaproutertypes.SwapAmountInRoute{
 			PoolId:        uint64(pID),
 			TokenOutDenom: swapRouteDenoms[index],
 		})
@@ -541,7 +711,7 @@ func swapAmountInRoutes(fs *flag.FlagSet) ([]types.SwapAmountInRoute, error) {
 	return routes, nil
 }
 
-func swapAmountOutRoutes(fs *flag.FlagSet) ([]types.SwapAmountOutRoute, error) {
+func swapAmountOutRoutes(fs *flag.FlagSet) ([]swaproutertypes.SwapAmountOutRoute, error) {
 	swapRoutePoolIds, err := fs.GetStringArray(FlagSwapRoutePoolIds)
 	if err != nil {
 		return nil, err
@@ -556,13 +726,13 @@ func swapAmountOutRoutes(fs *flag.FlagSet) ([]types.SwapAmountOutRoute, error) {
 		return nil, errors.New("swap route pool ids and denoms mismatch")
 	}
 
-	routes := []types.SwapAmountOutRoute{}
+	routes := []swaproutertypes.SwapAmountOutRoute{}
 	for index, poolIDStr := range swapRoutePoolIds {
 		pID, err := strconv.Atoi(poolIDStr)
 		if err != nil {
 			return nil, err
 		}
-		routes = append(routes, types.SwapAmountOutRoute{
+		routes = append(routes, swaproutertypes.SwapAmountOutRoute{
 			PoolId:       uint64(pID),
 			TokenInDenom: swapRouteDenoms[index],
 		})
@@ -724,3 +894,171 @@ func NewBuildExitSwapShareAmountInMsg(clientCtx client.Context, tokenOutDenom, s
 
 	return txf, msg, nil
 }
+
+func NewBuildSetPoolFeesMsg(clientCtx client.Context, poolIdStr, swapFeeStr, exitFeeStr string, txf tx.Factory) (tx.Factory, sdk.Msg, error) {
+	poolId, err := strconv.ParseUint(poolIdStr, 10, 64)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	swapFee, err := sdk.NewDecFromStr(swapFeeStr)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	exitFee, err := sdk.NewDecFromStr(exitFeeStr)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	msg := &types.MsgSetPoolFees{
+		Sender:  clientCtx.GetFromAddress().String(),
+		PoolId:  poolId,
+		SwapFee: swapFee,
+		ExitFee: exitFee,
+	}
+
+	return txf, msg, nil
+}
+
+func NewBuildSetPoolActiveMsg(clientCtx client.Context, poolIdStr, isActiveStr string, txf tx.Factory) (tx.Factory, sdk.Msg, error) {
+	poolId, err := strconv.ParseUint(poolIdStr, 10, 64)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	isActive, err := strconv.ParseBool(isActiveStr)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	msg := &types.MsgSetPoolActive{
+		Sender:   clientCtx.GetFromAddress().String(),
+		PoolId:   poolId,
+		IsActive: isActive,
+	}
+
+	return txf, msg, nil
+}
+
+func NewBuildScheduleWeightChangeMsg(clientCtx client.Context, poolIdStr, targetPoolWeightsStr, durationStr string, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, sdk.Msg, error) {
+	poolId, err := strconv.ParseUint(poolIdStr, 10, 64)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	targetPoolAssetCoins, err := sdk.ParseDecCoins(targetPoolWeightsStr)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	duration, err := time.ParseDuration(durationStr)
+	if err != nil {
+		return txf, nil, fmt.Errorf("could not parse duration: %w", err)
+	}
+
+	var targetPoolAssets []balancer.PoolAsset
+	for _, coin := range targetPoolAssetCoins {
+		targetPoolAssets = append(targetPoolAssets, balancer.PoolAsset{
+			Weight: coin.Amount.RoundInt(),
+			Token:  sdk.NewCoin(coin.Denom, sdk.ZeroInt()),
+		})
+	}
+
+	smoothWeightParams := balancer.SmoothWeightChangeParams{
+		Duration:          duration,
+		TargetPoolWeights: targetPoolAssets,
+	}
+
+	startTimeStr, err := fs.GetString(FlagStartTime)
+	if err != nil {
+		return txf, nil, err
+	}
+	if startTimeStr != "" {
+		startTime, err := time.Parse(time.RFC3339, startTimeStr)
+		if err != nil {
+			return txf, nil, fmt.Errorf("could not parse time: %w", err)
+		}
+
+		smoothWeightParams.StartTime = startTime
+	}
+
+	msg := &balancer.MsgScheduleWeightChange{
+		Sender:                   clientCtx.GetFromAddress().String(),
+		PoolId:                   poolId,
+		SmoothWeightChangeParams: smoothWeightParams,
+	}
+
+	return txf, msg, nil
+}
+
+// NewCmdSubmitCleanupPoolsProposal implements a command handler for submitting
+// a proposal to clean up pools.
+func NewCmdSubmitCleanupPoolsProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cleanup-pools [poolIDs]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to retire pools, paying their liquidity out to all share holders",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to retire pools, paying their liquidity out to all share holders.
+Locked shares are force unlocked. See who would be paid what with the estimate-cleanup-pools query.
+
+Example:
+$ %s tx gov submit-proposal cleanup-pools 1,2 --title="..." --description="..." --deposit="..."
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolIds, err := parsePoolIds(args[0])
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewCleanupPoolsProposal(title, description, poolIds)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	_ = cmd.MarkFlagRequired(govcli.FlagTitle)
+	_ = cmd.MarkFlagRequired(govcli.FlagDescription)
+
+	return cmd
+}
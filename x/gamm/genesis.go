@@ -12,7 +12,6 @@ import (
 // state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState, unpacker codectypes.AnyUnpacker) {
 	k.SetParams(ctx, genState.Params)
-	k.SetNextPoolNumber(ctx, genState.NextPoolNumber)
 
 	liquidity := sdk.Coins{}
 	for _, any := range genState.Pools {
@@ -33,6 +32,7 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState,
 	}
 
 	k.SetTotalLiquidity(ctx, liquidity)
+	k.SetTakerFeesCollected(ctx, genState.TakerFeesCollected)
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -50,8 +50,8 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		poolAnys = append(poolAnys, any)
 	}
 	return &types.GenesisState{
-		NextPoolNumber: k.GetNextPoolNumberAndIncrement(ctx),
-		Pools:          poolAnys,
-		Params:         k.GetParams(ctx),
+		Pools:              poolAnys,
+		Params:             k.GetParams(ctx),
+		TakerFeesCollected: k.GetTakerFeesCollected(ctx),
 	}
 }
@@ -40,14 +40,11 @@ func TestGammInitGenesis(t *testing.T) {
 	require.NoError(t, err)
 
 	gamm.InitGenesis(ctx, *app.GAMMKeeper, types.GenesisState{
-		Pools:          []*codectypes.Any{any},
-		NextPoolNumber: 2,
-		Params: types.Params{
-			PoolCreationFee: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000_000_000)},
-		},
+		Pools:              []*codectypes.Any{any},
+		Params:             types.NewParams(sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000_000_000)}),
+		TakerFeesCollected: sdk.Coins{sdk.NewInt64Coin("nodetoken", 3)},
 	}, app.AppCodec())
 
-	require.Equal(t, app.GAMMKeeper.GetNextPoolNumberAndIncrement(ctx), uint64(2))
 	poolStored, err := app.GAMMKeeper.GetPoolAndPoke(ctx, 1)
 	require.NoError(t, err)
 	require.Equal(t, balancerPool.GetId(), poolStored.GetId())
@@ -64,6 +61,7 @@ func TestGammInitGenesis(t *testing.T) {
 
 	liquidity := app.GAMMKeeper.GetTotalLiquidity(ctx)
 	require.Equal(t, liquidity, sdk.Coins{sdk.NewInt64Coin("nodetoken", 10), sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("nodetoken", 3)}, app.GAMMKeeper.GetTakerFeesCollected(ctx))
 }
 
 func TestGammExportGenesis(t *testing.T) {
@@ -105,7 +103,8 @@ func TestGammExportGenesis(t *testing.T) {
 	require.NoError(t, err)
 
 	genesis := gamm.ExportGenesis(ctx, *app.GAMMKeeper)
-	require.Equal(t, genesis.NextPoolNumber, uint64(3))
+	require.Equal(t, genesis.NextPoolNumber, uint64(0))
+	require.Equal(t, app.SwapRouterKeeper.GetNextPoolId(ctx), uint64(3))
 	require.Len(t, genesis.Pools, 2)
 }
 
@@ -3,16 +3,19 @@ package gamm
 import (
 	"github.com/osmosis-labs/osmosis/v7/x/gamm/keeper"
 	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/stableswap"
 	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // NewHandler returns a handler for "gamm" type messages.
 func NewHandler(k *keeper.Keeper) sdk.Handler {
 	msgServer := keeper.NewMsgServerImpl(k)
 	msgBalancerServer := keeper.NewBalancerMsgServerImpl(k)
+	msgStableswapServer := keeper.NewStableswapMsgServerImpl(k)
 
 	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
 		ctx = ctx.WithEventManager(sdk.NewEventManager())
@@ -30,6 +33,10 @@ func NewHandler(k *keeper.Keeper) sdk.Handler {
 			res, err := msgBalancerServer.CreateBalancerPool(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *stableswap.MsgCreateStableswapPool:
+			res, err := msgStableswapServer.CreateStableswapPool(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		case *types.MsgSwapExactAmountIn:
 			res, err := msgServer.SwapExactAmountIn(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
@@ -59,3 +66,16 @@ func NewHandler(k *keeper.Keeper) sdk.Handler {
 		}
 	}
 }
+
+// NewGammProposalHandler returns a handler for gamm governance proposals.
+func NewGammProposalHandler(k *keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.CleanupPoolsProposal:
+			return k.HandleCleanupPoolsProposal(ctx, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", types.ModuleName, c)
+		}
+	}
+}
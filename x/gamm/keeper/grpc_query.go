@@ -16,6 +16,7 @@ import (
 
 	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/balancer"
 	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v7/x/swaprouter/types"
 )
 
 var sdkIntMaxValue = sdk.NewInt(0)
@@ -120,7 +121,7 @@ func (q Querier) NumPools(ctx context.Context, _ *types.QueryNumPoolsRequest) (*
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
 	return &types.QueryNumPoolsResponse{
-		NumPools: q.Keeper.GetNextPoolNumberAndIncrement(sdkCtx) - 1,
+		NumPools: q.Keeper.poolManager.GetNextPoolId(sdkCtx) - 1,
 	}, nil
 }
 
@@ -240,7 +241,7 @@ func (q Querier) EstimateSwapExactAmountIn(ctx context.Context, req *types.Query
 		return nil, status.Error(codes.InvalidArgument, "invalid token")
 	}
 
-	if err := types.SwapAmountInRoutes(req.Routes).Validate(); err != nil {
+	if err := swaproutertypes.SwapAmountInRoutes(req.Routes).Validate(); err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
@@ -256,7 +257,7 @@ func (q Querier) EstimateSwapExactAmountIn(ctx context.Context, req *types.Query
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
-	tokenOutAmount, err := q.Keeper.MultihopSwapExactAmountIn(sdkCtx, sender, req.Routes, tokenIn, sdk.NewInt(1))
+	tokenOutAmount, err := q.Keeper.poolManager.RouteExactAmountIn(sdkCtx, sender, req.Routes, tokenIn, sdk.NewInt(1))
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -279,7 +280,7 @@ func (q Querier) EstimateSwapExactAmountOut(ctx context.Context, req *types.Quer
 		return nil, status.Error(codes.InvalidArgument, "invalid token")
 	}
 
-	if err := types.SwapAmountOutRoutes(req.Routes).Validate(); err != nil {
+	if err := swaproutertypes.SwapAmountOutRoutes(req.Routes).Validate(); err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
@@ -295,7 +296,7 @@ func (q Querier) EstimateSwapExactAmountOut(ctx context.Context, req *types.Quer
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
-	tokenInAmount, err := q.Keeper.MultihopSwapExactAmountOut(sdkCtx, sender, req.Routes, sdkIntMaxValue, tokenOut)
+	tokenInAmount, err := q.Keeper.poolManager.RouteExactAmountOut(sdkCtx, sender, req.Routes, sdkIntMaxValue, tokenOut)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -304,3 +305,46 @@ func (q Querier) EstimateSwapExactAmountOut(ctx context.Context, req *types.Quer
 		TokenInAmount: tokenInAmount,
 	}, nil
 }
+
+func (q Querier) EstimateCleanupPools(ctx context.Context, req *types.QueryEstimateCleanupPoolsRequest) (*types.QueryEstimateCleanupPoolsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := types.ValidateCleanupPoolIds(req.PoolIds); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	cleanups, err := q.Keeper.GetPoolCleanups(sdkCtx, req.PoolIds)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEstimateCleanupPoolsResponse{
+		Cleanups: cleanups,
+	}, nil
+}
+
+func (q Querier) TakerFeesCollected(ctx context.Context, req *types.QueryTakerFeesCollectedRequest) (*types.QueryTakerFeesCollectedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if req.Denom == "" {
+		return &types.QueryTakerFeesCollectedResponse{
+			TakerFeesCollected: q.Keeper.GetTakerFeesCollected(sdkCtx),
+		}, nil
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryTakerFeesCollectedResponse{
+		TakerFeesCollected: sdk.NewCoins(sdk.NewCoin(req.Denom, q.Keeper.GetTakerFeeCollected(sdkCtx, req.Denom))),
+	}, nil
+}
@@ -6,46 +6,137 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v7/x/lockup/types"
 )
 
-const poolBalanceInvariantName = "pool-account-balance-equals-expected"
+const (
+	poolBalanceInvariantName     = "pool-account-balance-equals-expected"
+	poolShareSupplyInvariantName = "pool-share-supply-equals-total-shares"
+	totalLiquidityInvariantName  = "total-liquidity-equals-sum-of-pools"
+)
 
 // RegisterInvariants registers all governance invariants.
 func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper, bk types.BankKeeper) {
 	ir.RegisterRoute(types.ModuleName, poolBalanceInvariantName, PoolAccountInvariant(keeper, bk))
+	ir.RegisterRoute(types.ModuleName, poolShareSupplyInvariantName, PoolShareSupplyInvariant(keeper, bk))
+	ir.RegisterRoute(types.ModuleName, totalLiquidityInvariantName, TotalLiquidityInvariant(keeper, bk))
 }
 
 // AllInvariants runs all invariants of the gamm module
 func AllInvariants(keeper Keeper, bk types.BankKeeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
-		msg, broke := PoolAccountInvariant(keeper, bk)(ctx)
-		return msg, broke
+		for _, invariant := range []sdk.Invariant{
+			PoolAccountInvariant(keeper, bk),
+			PoolShareSupplyInvariant(keeper, bk),
+			TotalLiquidityInvariant(keeper, bk),
+		} {
+			if msg, broke := invariant(ctx); broke {
+				return msg, broke
+			}
+		}
+		return sdk.FormatInvariant(types.ModuleName, "all",
+			"\tgamm pool balances, shares and total liquidity all match\n"), false
 	}
 }
 
-// PoolAccountInvariant checks that the pool account balance reflects the sum of
-// pool assets
+// PoolAccountInvariant checks that every pool account's balance covers the
+// sum of the pool's assets. Coins sent to a pool account on top of them are
+// reported, without breaking the invariant.
 func PoolAccountInvariant(keeper Keeper, bk types.BankKeeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
-		pools, err := keeper.GetPoolsAndPoke(ctx)
+		states, err := keeper.getPoolInvariantStates(ctx, bk)
 		if err != nil {
 			return sdk.FormatInvariant(types.ModuleName, poolBalanceInvariantName,
 				"\tgamm pool retrieval failed"), true
 		}
 
-		for _, pool := range pools {
-			expectedCoins := pool.GetTotalPoolLiquidity(ctx)
-			actualCoins := bk.GetAllBalances(ctx, pool.GetAddress())
-			if !actualCoins.IsAllGTE(expectedCoins) {
+		surpluses := ""
+		for _, state := range states {
+			if err := state.ValidateBalances(); err != nil {
 				return sdk.FormatInvariant(types.ModuleName, poolBalanceInvariantName,
-					fmt.Sprintf("\tgamm pool id %d\n\t pool-expected coins: %s\n\t account coins: %s\n",
-						pool.GetId(), expectedCoins, actualCoins)), true
+					fmt.Sprintf("\t%s\n", err)), true
+			}
+			if surplus := state.Surplus(); !surplus.Empty() {
+				surpluses += fmt.Sprintf("\tgamm pool id %d: pool account holds %s beyond pool liquidity\n", state.PoolId, surplus)
 			}
 		}
 
 		return sdk.FormatInvariant(types.ModuleName, poolBalanceInvariantName,
-			"\tgamm all pool asset coins and account coins match\n"), false
+			"\tgamm all pool account coins cover pool asset coins\n"+surpluses), false
+	}
+}
+
+// PoolShareSupplyInvariant checks that the bank supply of every pool's share
+// denom is its total shares, and that the shares held by the lockup module
+// cover what its locks record.
+func PoolShareSupplyInvariant(keeper Keeper, bk types.BankKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		states, err := keeper.getPoolInvariantStates(ctx, bk)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, poolShareSupplyInvariantName,
+				"\tgamm pool retrieval failed"), true
+		}
+
+		for _, state := range states {
+			if err := state.ValidateShares(); err != nil {
+				return sdk.FormatInvariant(types.ModuleName, poolShareSupplyInvariantName,
+					fmt.Sprintf("\t%s\n", err)), true
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, poolShareSupplyInvariantName,
+			"\tgamm all pool share supplies match the pools' total shares\n"), false
+	}
+}
+
+// TotalLiquidityInvariant checks that the stored total liquidity of every
+// denom is the sum of that denom over all pools.
+func TotalLiquidityInvariant(keeper Keeper, bk types.BankKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		states, err := keeper.getPoolInvariantStates(ctx, bk)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, totalLiquidityInvariantName,
+				"\tgamm pool retrieval failed"), true
+		}
+
+		if err := types.ValidateTotalLiquidity(states, keeper.GetTotalLiquidity(ctx)); err != nil {
+			return sdk.FormatInvariant(types.ModuleName, totalLiquidityInvariantName,
+				fmt.Sprintf("\t%s\n", err)), true
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, totalLiquidityInvariantName,
+			"\tgamm total liquidity matches the sum of pool liquidity\n"), false
+	}
+}
+
+// getPoolInvariantStates returns what the invariants check every pool against.
+func (k Keeper) getPoolInvariantStates(ctx sdk.Context, bk types.BankKeeper) ([]types.PoolInvariantState, error) {
+	pools, err := k.GetPoolsAndPoke(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	lockupAddr := authtypes.NewModuleAddress(lockuptypes.ModuleName)
+	states := make([]types.PoolInvariantState, 0, len(pools))
+	for _, pool := range pools {
+		shareDenom := types.GetPoolShareDenom(pool.GetId())
+		lockedShares := sdk.ZeroInt()
+		for _, lock := range k.lockupKeeper.GetLocksDenom(ctx, shareDenom) {
+			lockedShares = lockedShares.Add(lock.Coins.AmountOf(shareDenom))
+		}
+
+		states = append(states, types.PoolInvariantState{
+			PoolId:       pool.GetId(),
+			Liquidity:    pool.GetTotalPoolLiquidity(ctx),
+			TotalShares:  pool.GetTotalShares(),
+			Balances:     bk.GetAllBalances(ctx, pool.GetAddress()),
+			ShareSupply:  bk.GetSupply(ctx, shareDenom).Amount,
+			LockupShares: bk.GetBalance(ctx, lockupAddr, shareDenom).Amount,
+			LockedShares: lockedShares,
+		})
 	}
+	return states, nil
 }
@@ -0,0 +1,93 @@
+package keeper_test
+
+import (
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/keeper"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v7/x/lockup/types"
+)
+
+func (suite *KeeperTestSuite) TestInvariants() {
+	tests := map[string]struct {
+		breakState      func(poolId uint64)
+		brokenInvariant string
+		reportsSurplus  bool
+	}{
+		"valid state": {
+			breakState: func(uint64) {},
+		},
+		"coins sent to a pool account": {
+			breakState: func(poolId uint64) {
+				pool, err := suite.app.GAMMKeeper.GetPoolAndPoke(suite.ctx, poolId)
+				suite.Require().NoError(err)
+				err = suite.app.BankKeeper.SendCoins(suite.ctx, acc2, pool.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("foo", 10)))
+				suite.Require().NoError(err)
+			},
+			reportsSurplus: true,
+		},
+		"shares sent to the lockup module": {
+			breakState: func(poolId uint64) {
+				shares := sdk.NewCoins(sdk.NewInt64Coin(types.GetPoolShareDenom(poolId), 10))
+				err := suite.app.BankKeeper.SendCoinsFromAccountToModule(suite.ctx, acc1, lockuptypes.ModuleName, shares)
+				suite.Require().NoError(err)
+			},
+		},
+		"coins sent out of a pool account": {
+			breakState: func(poolId uint64) {
+				pool, err := suite.app.GAMMKeeper.GetPoolAndPoke(suite.ctx, poolId)
+				suite.Require().NoError(err)
+				err = suite.app.BankKeeper.SendCoins(suite.ctx, pool.GetAddress(), acc2, sdk.NewCoins(sdk.NewInt64Coin("foo", 10)))
+				suite.Require().NoError(err)
+			},
+			brokenInvariant: "pool account",
+		},
+		"shares minted outside of the pool": {
+			breakState: func(poolId uint64) {
+				shares := sdk.NewCoins(sdk.NewInt64Coin(types.GetPoolShareDenom(poolId), 10))
+				err := simapp.FundAccount(suite.app.BankKeeper, suite.ctx, acc2, shares)
+				suite.Require().NoError(err)
+			},
+			brokenInvariant: "pool share supply",
+		},
+		"total liquidity index drifts": {
+			breakState: func(uint64) {
+				suite.app.GAMMKeeper.RecordTotalLiquidityIncrease(suite.ctx, sdk.NewCoins(sdk.NewInt64Coin("bar", 1)))
+			},
+			brokenInvariant: "total liquidity",
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			poolId := suite.prepareBalancerPool()
+			shareDenom := types.GetPoolShareDenom(poolId)
+			shares := suite.app.BankKeeper.GetBalance(suite.ctx, acc1, shareDenom)
+			_, err := suite.app.LockupKeeper.LockTokens(suite.ctx, acc1, sdk.NewCoins(sdk.NewCoin(shareDenom, shares.Amount.QuoRaw(2))), time.Hour)
+			suite.Require().NoError(err)
+
+			tc.breakState(poolId)
+
+			invariants := map[string]func(keeper.Keeper, types.BankKeeper) sdk.Invariant{
+				"pool account":      keeper.PoolAccountInvariant,
+				"pool share supply": keeper.PoolShareSupplyInvariant,
+				"total liquidity":   keeper.TotalLiquidityInvariant,
+			}
+			for invariantName, invariant := range invariants {
+				msg, broken := invariant(*suite.app.GAMMKeeper, suite.app.BankKeeper)(suite.ctx)
+				suite.Require().Equal(invariantName == tc.brokenInvariant, broken, "%s: %s", invariantName, msg)
+				if invariantName == "pool account" {
+					suite.Require().Equal(tc.reportsSurplus, strings.Contains(msg, "10foo beyond pool liquidity"), msg)
+				}
+			}
+
+			_, broken := keeper.AllInvariants(*suite.app.GAMMKeeper, suite.app.BankKeeper)(suite.ctx)
+			suite.Require().Equal(tc.brokenInvariant != "", broken)
+		})
+	}
+}
@@ -30,9 +30,12 @@ type Keeper struct {
 	hooks      types.GammHooks
 
 	// keepers
-	accountKeeper types.AccountKeeper
-	bankKeeper    types.BankKeeper
-	distrKeeper   types.DistrKeeper
+	accountKeeper    types.AccountKeeper
+	bankKeeper       types.BankKeeper
+	distrKeeper      types.DistrKeeper
+	poolManager      types.PoolManager
+	lockupKeeper     types.LockupKeeper
+	superfluidKeeper types.SuperfluidKeeper
 }
 
 func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper, distrKeeper types.DistrKeeper) Keeper {
@@ -108,3 +111,42 @@ func (k *Keeper) SetHooks(gh types.GammHooks) *Keeper {
 
 	return k
 }
+
+// SetPoolManager sets the swap router, which gamm gets pool IDs from and
+// registers its pools with. It can't be passed to NewKeeper, as the swap
+// router itself routes swaps to gamm.
+func (k *Keeper) SetPoolManager(poolManager types.PoolManager) *Keeper {
+	if k.poolManager != nil {
+		panic("cannot set gamm pool manager twice")
+	}
+
+	k.poolManager = poolManager
+
+	return k
+}
+
+// SetLockupKeeper sets the lockup keeper, which decides who governs pools with
+// a lock based future pool governor. It can't be passed to NewKeeper, as the
+// lockup keeper is created after gamm's.
+func (k *Keeper) SetLockupKeeper(lockupKeeper types.LockupKeeper) *Keeper {
+	if k.lockupKeeper != nil {
+		panic("cannot set gamm lockup keeper twice")
+	}
+
+	k.lockupKeeper = lockupKeeper
+
+	return k
+}
+
+// SetSuperfluidKeeper sets the superfluid keeper, which ends the superfluid
+// staking of locks of pools being cleaned up. It can't be passed to NewKeeper,
+// as the superfluid keeper is created with gamm's.
+func (k *Keeper) SetSuperfluidKeeper(superfluidKeeper types.SuperfluidKeeper) *Keeper {
+	if k.superfluidKeeper != nil {
+		panic("cannot set gamm superfluid keeper twice")
+	}
+
+	k.superfluidKeeper = superfluidKeeper
+
+	return k
+}
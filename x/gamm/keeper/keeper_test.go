@@ -122,3 +122,21 @@ func (suite *KeeperTestSuite) prepareBalancerPool() uint64 {
 
 	return poolId
 }
+
+// swapExactAmountIn swaps as acc1 in the pool, charging the pool's swap fee.
+func (suite *KeeperTestSuite) swapExactAmountIn(poolId uint64, tokenIn sdk.Coin, tokenOutDenom string, tokenOutMinAmount sdk.Int) (sdk.Int, error) {
+	pool, err := suite.app.GAMMKeeper.GetPool(suite.ctx, poolId)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	return suite.app.GAMMKeeper.SwapExactAmountIn(suite.ctx, acc1, pool, tokenIn, tokenOutDenom, tokenOutMinAmount, pool.GetSwapFee(suite.ctx))
+}
+
+// swapExactAmountOut swaps as acc1 in the pool, charging the pool's swap fee.
+func (suite *KeeperTestSuite) swapExactAmountOut(poolId uint64, tokenInDenom string, tokenInMaxAmount sdk.Int, tokenOut sdk.Coin) (sdk.Int, error) {
+	pool, err := suite.app.GAMMKeeper.GetPool(suite.ctx, poolId)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	return suite.app.GAMMKeeper.SwapExactAmountOut(suite.ctx, acc1, pool, tokenInDenom, tokenInMaxAmount, tokenOut, pool.GetSwapFee(suite.ctx))
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/balancer"
 	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v7/x/swaprouter/types"
 )
 
 func (k Keeper) MarshalPool(pool types.PoolI) ([]byte, error) {
@@ -42,6 +43,21 @@ func (k Keeper) GetPoolAndPoke(ctx sdk.Context, poolId uint64) (types.PoolI, err
 	return pool, nil
 }
 
+// GetPool returns the pool with the given ID, for the swap router.
+func (k Keeper) GetPool(ctx sdk.Context, poolId uint64) (swaproutertypes.PoolI, error) {
+	return k.GetPoolAndPoke(ctx, poolId)
+}
+
+// asGammPool returns a pool the swap router passes back to gamm as a gamm
+// pool.
+func asGammPool(pool swaproutertypes.PoolI) (types.PoolI, error) {
+	gammPool, ok := pool.(types.PoolI)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrNotGammPool, "pool %d", pool.GetId())
+	}
+	return gammPool, nil
+}
+
 // Get pool, and check if the pool is active / allowed to be swapped against
 func (k Keeper) getPoolForSwap(ctx sdk.Context, poolId uint64) (types.PoolI, error) {
 	pool, err := k.GetPoolAndPoke(ctx, poolId)
@@ -103,124 +119,17 @@ func (k Keeper) DeletePool(ctx sdk.Context, poolId uint64) error {
 	return nil
 }
 
-// CleanupBalancerPool destructs a pool and refund all the assets according to
-// the shares held by the accounts. CleanupBalancerPool should not be called during
-// the chain execution time, as it iterates the entire account balances.
-// TODO: once SDK v0.46.0, use https://github.com/cosmos/cosmos-sdk/pull/9611
-//
-// All locks on this pool share must be unlocked prior to execution. Use LockupKeeper.ForceUnlock
-// on remaining locks before calling this function.
-// func (k Keeper) CleanupBalancerPool(ctx sdk.Context, poolIds []uint64, excludedModules []string) (err error) {
-// 	pools := make(map[string]types.PoolI)
-// 	totalShares := make(map[string]sdk.Int)
-// 	for _, poolId := range poolIds {
-// 		pool, err := k.GetPool(ctx, poolId)
-// 		if err != nil {
-// 			return err
-// 		}
-// 		shareDenom := pool.GetTotalShares().Denom
-// 		pools[shareDenom] = pool
-// 		totalShares[shareDenom] = pool.GetTotalShares().Amount
-// 	}
-
-// 	moduleAccounts := make(map[string]string)
-// 	for _, module := range excludedModules {
-// 		moduleAccounts[string(authtypes.NewModuleAddress(module))] = module
-// 	}
-
-// 	// first iterate through the share holders and burn them
-// 	k.bankKeeper.IterateAllBalances(ctx, func(addr sdk.AccAddress, coin sdk.Coin) (stop bool) {
-// 		if coin.Amount.IsZero() {
-// 			return
-// 		}
-
-// 		pool, ok := pools[coin.Denom]
-// 		if !ok {
-// 			return
-// 		}
-
-// 		// track the iterated shares
-// 		pool.SubTotalShares(coin.Amount)
-// 		pools[coin.Denom] = pool
-
-// 		// check if the shareholder is a module
-// 		if _, ok = moduleAccounts[coin.Denom]; ok {
-// 			return
-// 		}
-
-// 		// Burn the share tokens
-// 		err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, addr, types.ModuleName, sdk.Coins{coin})
-// 		if err != nil {
-// 			return true
-// 		}
-
-// 		err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.Coins{coin})
-// 		if err != nil {
-// 			return true
-// 		}
-
-// 		// Refund assets
-// 		for _, asset := range pool.GetAllPoolAssets() {
-// 			// lpShareEquivalentTokens = (amount in pool) * (your shares) / (total shares)
-// 			lpShareEquivalentTokens := asset.Token.Amount.Mul(coin.Amount).Quo(totalShares[coin.Denom])
-// 			if lpShareEquivalentTokens.IsZero() {
-// 				continue
-// 			}
-// 			err = k.bankKeeper.SendCoins(
-// 				ctx, pool.GetAddress(), addr, sdk.Coins{{asset.Token.Denom, lpShareEquivalentTokens}})
-// 			if err != nil {
-// 				return true
-// 			}
-// 		}
-
-// 		return false
-// 	})
-
-// 	if err != nil {
-// 		return err
-// 	}
-
-// 	for _, pool := range pools {
-// 		// sanity check
-// 		if !pool.GetTotalShares().IsZero() {
-// 			panic("pool total share should be zero after cleanup")
-// 		}
-
-// 		err = k.DeletePool(ctx, pool.GetId())
-// 		if err != nil {
-// 			return err
-// 		}
-// 	}
-
-// 	return nil
-// }
-
-// SetNextPoolNumber sets next pool number.
-func (k Keeper) SetNextPoolNumber(ctx sdk.Context, poolNumber uint64) {
+// GetNextPoolNumber returns the next pool number gamm stored before pool IDs
+// moved to the swaprouter module. It is only read when migrating the counter
+// there, and panics if gamm never stored one.
+func (k Keeper) GetNextPoolNumber(ctx sdk.Context) uint64 {
 	store := ctx.KVStore(k.storeKey)
-	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: poolNumber})
-	store.Set(types.KeyNextGlobalPoolNumber, bz)
-}
-
-// GetNextPoolNumberAndIncrement returns the next pool number, and increments the corresponding state entry.
-func (k Keeper) GetNextPoolNumberAndIncrement(ctx sdk.Context) uint64 {
-	var poolNumber uint64
-	store := ctx.KVStore(k.storeKey)
-
 	bz := store.Get(types.KeyNextGlobalPoolNumber)
 	if bz == nil {
 		panic(fmt.Errorf("pool has not been initialized -- Should have been done in InitGenesis"))
-	} else {
-		val := gogotypes.UInt64Value{}
-
-		err := k.cdc.Unmarshal(bz, &val)
-		if err != nil {
-			panic(err)
-		}
-
-		poolNumber = val.GetValue()
 	}
 
-	k.SetNextPoolNumber(ctx, poolNumber+1)
-	return poolNumber
+	val := gogotypes.UInt64Value{}
+	k.cdc.MustUnmarshal(bz, &val)
+	return val.GetValue()
 }
@@ -0,0 +1,246 @@
+package keeper
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v7/x/lockup/types"
+)
+
+// HandleCleanupPoolsProposal cleans up the pools of a passed
+// CleanupPoolsProposal.
+func (k Keeper) HandleCleanupPoolsProposal(ctx sdk.Context, p *types.CleanupPoolsProposal) error {
+	return k.CleanupPools(ctx, p.PoolIds)
+}
+
+// GetPoolCleanups returns who CleanupPools would pay what for the shares of
+// each pool. Every share holder, locked or not, is paid the pool's liquidity
+// times their shares over the total shares, rounded down. What rounding
+// leaves over is the pool's remainder.
+//
+// Module accounts keep records of the coins they hold, so they are not paid
+// like other holders. The community pool's shares are paid to the community
+// pool. The shares of other module accounts, such as gauge escrow, are left
+// with them, and what they are worth is added to the remainder.
+//
+// It iterates all account balances, so it must only be called from
+// governance and queries.
+func (k Keeper) GetPoolCleanups(ctx sdk.Context, poolIds []uint64) ([]types.PoolCleanup, error) {
+	if err := types.ValidateCleanupPoolIds(poolIds); err != nil {
+		return nil, err
+	}
+
+	pools := make(map[string]types.PoolI, len(poolIds))
+	holders := make(map[string]map[string]*types.CleanupPayout, len(poolIds))
+	for _, poolId := range poolIds {
+		pool, err := k.GetPoolAndPoke(ctx, poolId)
+		if err != nil {
+			return nil, err
+		}
+		shareDenom := types.GetPoolShareDenom(poolId)
+		pools[shareDenom] = pool
+		holders[shareDenom] = make(map[string]*types.CleanupPayout)
+	}
+
+	holder := func(shareDenom, addr string) *types.CleanupPayout {
+		payout, ok := holders[shareDenom][addr]
+		if !ok {
+			payout = &types.CleanupPayout{
+				Address:        addr,
+				UnlockedShares: sdk.ZeroInt(),
+				LockedShares:   sdk.ZeroInt(),
+				LockIds:        []uint64{},
+			}
+			holders[shareDenom][addr] = payout
+		}
+		return payout
+	}
+
+	// locked shares are held by the lockup module, and are paid to the lock
+	// owners instead.
+	lockupAddr := authtypes.NewModuleAddress(lockuptypes.ModuleName)
+	communityPoolAddr := authtypes.NewModuleAddress(distrtypes.ModuleName)
+	communityPool := k.distrKeeper.GetFeePool(ctx).CommunityPool
+	moduleShares := make(map[string]sdk.Int, len(poolIds))
+	for shareDenom := range pools {
+		moduleShares[shareDenom] = sdk.ZeroInt()
+	}
+	k.bankKeeper.IterateAllBalances(ctx, func(addr sdk.AccAddress, coin sdk.Coin) (stop bool) {
+		if _, ok := pools[coin.Denom]; !ok || coin.Amount.IsZero() || addr.Equals(lockupAddr) {
+			return false
+		}
+		shares := coin.Amount
+		if addr.Equals(communityPoolAddr) {
+			// the distribution module also holds outstanding rewards, which
+			// are left with it like other module accounts' shares.
+			shares = sdk.MinInt(shares, communityPool.AmountOf(coin.Denom).TruncateInt())
+			moduleShares[coin.Denom] = moduleShares[coin.Denom].Add(coin.Amount.Sub(shares))
+		} else if _, ok := k.accountKeeper.GetAccount(ctx, addr).(authtypes.ModuleAccountI); ok {
+			moduleShares[coin.Denom] = moduleShares[coin.Denom].Add(shares)
+			return false
+		}
+		if shares.IsZero() {
+			return false
+		}
+		payout := holder(coin.Denom, addr.String())
+		payout.UnlockedShares = payout.UnlockedShares.Add(shares)
+		return false
+	})
+	for shareDenom := range pools {
+		for _, lock := range k.lockupKeeper.GetLocksDenom(ctx, shareDenom) {
+			payout := holder(shareDenom, lock.Owner)
+			payout.LockedShares = payout.LockedShares.Add(lock.Coins.AmountOf(shareDenom))
+			payout.LockIds = append(payout.LockIds, lock.ID)
+		}
+	}
+
+	cleanups := make([]types.PoolCleanup, 0, len(poolIds))
+	for _, poolId := range poolIds {
+		shareDenom := types.GetPoolShareDenom(poolId)
+		pool := pools[shareDenom]
+		totalShares := pool.GetTotalShares()
+		liquidity := pool.GetTotalPoolLiquidity(ctx)
+
+		payouts := make([]types.CleanupPayout, 0, len(holders[shareDenom]))
+		for _, payout := range holders[shareDenom] {
+			payouts = append(payouts, *payout)
+		}
+		sort.Slice(payouts, func(i, j int) bool { return payouts[i].Address < payouts[j].Address })
+
+		heldShares := moduleShares[shareDenom]
+		paid := sdk.Coins{}
+		for i, payout := range payouts {
+			shares := payout.UnlockedShares.Add(payout.LockedShares)
+			heldShares = heldShares.Add(shares)
+			tokens := make([]sdk.Coin, 0, len(liquidity))
+			for _, coin := range liquidity {
+				// tokens = (amount in pool) * (your shares) / (total shares)
+				tokens = append(tokens, sdk.NewCoin(coin.Denom, coin.Amount.Mul(shares).Quo(totalShares)))
+			}
+			payouts[i].Tokens = sdk.NewCoins(tokens...)
+			paid = paid.Add(payouts[i].Tokens...)
+		}
+		if !heldShares.Equal(totalShares) {
+			return nil, sdkerrors.Wrapf(types.ErrSharesMismatch, "pool %d has %s shares, but %s are held", poolId, totalShares, heldShares)
+		}
+
+		cleanups = append(cleanups, types.PoolCleanup{
+			PoolId:    poolId,
+			Payouts:   payouts,
+			Remainder: k.bankKeeper.GetAllBalances(ctx, pool.GetAddress()).Sub(paid),
+		})
+	}
+	return cleanups, nil
+}
+
+// CleanupPools retires pools. It freezes them, force undelegates superfluid
+// staked locks of their shares and force unlocks all of them, burns every
+// share and pays its holder as GetPoolCleanups lists, sends the remainder to
+// the community pool, and deletes the pools.
+func (k Keeper) CleanupPools(ctx sdk.Context, poolIds []uint64) error {
+	cleanups, err := k.GetPoolCleanups(ctx, poolIds)
+	if err != nil {
+		return err
+	}
+
+	pools := make([]types.PoolI, 0, len(poolIds))
+	for _, poolId := range poolIds {
+		pool, err := k.GetPoolAndPoke(ctx, poolId)
+		if err != nil {
+			return err
+		}
+		if pool, ok := pool.(types.PoolGovernorExtension); ok {
+			pool.SetActive(false)
+		}
+		if err := k.SetPool(ctx, pool); err != nil {
+			return err
+		}
+		pools = append(pools, pool)
+	}
+
+	for i, cleanup := range cleanups {
+		pool := pools[i]
+		shareDenom := types.GetPoolShareDenom(cleanup.PoolId)
+		for _, lock := range k.lockupKeeper.GetLocksDenom(ctx, shareDenom) {
+			if k.lockupKeeper.HasAnySyntheticLockups(ctx, lock.ID) {
+				if err := k.superfluidKeeper.ForceUndelegateLock(ctx, lock.ID); err != nil {
+					return err
+				}
+			}
+			if err := k.lockupKeeper.ForceUnlock(ctx, lock); err != nil {
+				return err
+			}
+		}
+
+		for _, payout := range cleanup.Payouts {
+			if err := k.payoutPoolCleanup(ctx, pool, payout); err != nil {
+				return err
+			}
+		}
+		if !cleanup.Remainder.Empty() {
+			if err := k.distrKeeper.FundCommunityPool(ctx, cleanup.Remainder, pool.GetAddress()); err != nil {
+				return err
+			}
+		}
+		k.RecordTotalLiquidityDecrease(ctx, pool.GetTotalPoolLiquidity(ctx))
+
+		if err := k.DeletePool(ctx, cleanup.PoolId); err != nil {
+			return err
+		}
+		k.poolManager.DeletePoolRoute(ctx, cleanup.PoolId)
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.TypeEvtPoolCleanedUp,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(cleanup.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyRemainder, cleanup.Remainder.String()),
+		))
+	}
+	return nil
+}
+
+// payoutPoolCleanup burns the shares of a holder of a pool being cleaned up,
+// and pays them their tokens. The community pool's shares are taken out of
+// it, and their tokens are funded to it.
+func (k Keeper) payoutPoolCleanup(ctx sdk.Context, pool types.PoolI, payout types.CleanupPayout) error {
+	addr, err := sdk.AccAddressFromBech32(payout.Address)
+	if err != nil {
+		return err
+	}
+	shares := payout.UnlockedShares.Add(payout.LockedShares)
+	if err := k.BurnPoolShareFromAccount(ctx, pool, addr, shares); err != nil {
+		return err
+	}
+	if addr.Equals(authtypes.NewModuleAddress(distrtypes.ModuleName)) {
+		feePool := k.distrKeeper.GetFeePool(ctx)
+		feePool.CommunityPool = feePool.CommunityPool.Sub(sdk.NewDecCoins(sdk.NewDecCoin(types.GetPoolShareDenom(pool.GetId()), shares)))
+		k.distrKeeper.SetFeePool(ctx, feePool)
+		if err := k.distrKeeper.FundCommunityPool(ctx, payout.Tokens, pool.GetAddress()); err != nil {
+			return err
+		}
+	} else if err := k.bankKeeper.SendCoins(ctx, pool.GetAddress(), addr, payout.Tokens); err != nil {
+		return err
+	}
+
+	lockIds := make([]string, len(payout.LockIds))
+	for i, lockId := range payout.LockIds {
+		lockIds[i] = strconv.FormatUint(lockId, 10)
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtPoolCleanupPayout,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(pool.GetId(), 10)),
+		sdk.NewAttribute(types.AttributeKeyRecipient, payout.Address),
+		sdk.NewAttribute(types.AttributeKeyShares, shares.String()),
+		sdk.NewAttribute(types.AttributeKeyLockIds, strings.Join(lockIds, ",")),
+		sdk.NewAttribute(types.AttributeKeyTokensOut, payout.Tokens.String()),
+	))
+	return nil
+}
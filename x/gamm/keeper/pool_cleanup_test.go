@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+	incentivestypes "github.com/osmosis-labs/osmosis/v7/x/incentives/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v7/x/swaprouter/types"
+)
+
+func (suite *KeeperTestSuite) TestCleanupPools() {
+	poolId := suite.prepareGovernedBalancerPool("")
+	otherPoolId := suite.prepareGovernedBalancerPool("")
+	shareDenom := types.GetPoolShareDenom(poolId)
+	shares := suite.app.BankKeeper.GetBalance(suite.ctx, acc1, shareDenom)
+
+	// acc1 keeps half of its shares and locks the other half, acc2 holds a
+	// third of its shares unlocked, and acc3 joins and locks its shares.
+	half := sdk.NewCoins(sdk.NewCoin(shareDenom, shares.Amount.QuoRaw(2)))
+	lock, err := suite.app.LockupKeeper.LockTokens(suite.ctx, acc1, half, time.Hour)
+	suite.Require().NoError(err)
+	err = suite.app.BankKeeper.SendCoins(suite.ctx, acc1, acc2, sdk.NewCoins(sdk.NewCoin(shareDenom, shares.Amount.QuoRaw(6))))
+	suite.Require().NoError(err)
+	suite.joinAndLockShares(acc3, poolId, shares.Amount.QuoRaw(3))
+
+	cleanups, err := suite.app.GAMMKeeper.GetPoolCleanups(suite.ctx, []uint64{poolId})
+	suite.Require().NoError(err)
+	suite.Require().Len(cleanups, 1)
+	cleanup := cleanups[0]
+	suite.Require().Equal(poolId, cleanup.PoolId)
+	suite.Require().Len(cleanup.Payouts, 3)
+	payouts := make(map[string]types.CleanupPayout)
+	for _, payout := range cleanup.Payouts {
+		payouts[payout.Address] = payout
+	}
+	suite.Require().Equal([]uint64{lock.ID}, payouts[acc1.String()].LockIds)
+	suite.Require().Equal(shares.Amount.QuoRaw(2), payouts[acc1.String()].LockedShares)
+	suite.Require().Equal(shares.Amount.QuoRaw(2).Sub(shares.Amount.QuoRaw(6)), payouts[acc1.String()].UnlockedShares)
+	suite.Require().Equal(shares.Amount.QuoRaw(6), payouts[acc2.String()].UnlockedShares)
+	suite.Require().True(payouts[acc2.String()].LockedShares.IsZero())
+	suite.Require().Equal(shares.Amount.QuoRaw(3), payouts[acc3.String()].LockedShares)
+
+	// the dry run does not change anything.
+	pool, err := suite.app.GAMMKeeper.GetPoolAndPoke(suite.ctx, poolId)
+	suite.Require().NoError(err)
+	suite.Require().True(pool.IsActive(suite.ctx))
+	liquidity := pool.GetTotalPoolLiquidity(suite.ctx)
+
+	balancesBefore := make(map[string]sdk.Coins)
+	for _, acc := range []sdk.AccAddress{acc1, acc2, acc3} {
+		balancesBefore[acc.String()] = suite.app.BankKeeper.GetAllBalances(suite.ctx, acc)
+	}
+	err = suite.app.GAMMKeeper.CleanupPools(suite.ctx, []uint64{poolId})
+	suite.Require().NoError(err)
+
+	// every holder is paid as estimated, and has no shares or locks left.
+	paid := sdk.Coins{}
+	for _, acc := range []sdk.AccAddress{acc1, acc2, acc3} {
+		payout := payouts[acc.String()]
+		balance := suite.app.BankKeeper.GetAllBalances(suite.ctx, acc)
+		unlocked := sdk.NewCoins(sdk.NewCoin(shareDenom, payout.UnlockedShares))
+		suite.Require().Equal(balancesBefore[acc.String()].Sub(unlocked).Add(payout.Tokens...), balance)
+		suite.Require().Empty(suite.app.LockupKeeper.GetAccountLockedCoins(suite.ctx, acc))
+		paid = paid.Add(payout.Tokens...)
+	}
+	suite.Require().Equal(liquidity, paid.Add(cleanup.Remainder...))
+	suite.Require().True(suite.app.BankKeeper.GetSupply(suite.ctx, shareDenom).IsZero())
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, pool.GetAddress()).Empty())
+
+	// the pool and its route are deleted, and the other pool is untouched.
+	_, err = suite.app.GAMMKeeper.GetPoolAndPoke(suite.ctx, poolId)
+	suite.Require().Error(err)
+	_, err = suite.app.SwapRouterKeeper.GetPoolType(suite.ctx, poolId)
+	suite.Require().ErrorIs(err, swaproutertypes.ErrPoolRouteNotFound)
+	_, err = suite.app.GAMMKeeper.GetPoolAndPoke(suite.ctx, otherPoolId)
+	suite.Require().NoError(err)
+
+	payoutEvents, cleanedUpEvents := 0, 0
+	for _, event := range suite.ctx.EventManager().Events() {
+		switch event.Type {
+		case types.TypeEvtPoolCleanupPayout:
+			payoutEvents++
+		case types.TypeEvtPoolCleanedUp:
+			cleanedUpEvents++
+		}
+	}
+	suite.Require().Equal(3, payoutEvents)
+	suite.Require().Equal(1, cleanedUpEvents)
+}
+
+func (suite *KeeperTestSuite) TestCleanupPoolsModuleAccounts() {
+	poolId := suite.prepareGovernedBalancerPool("")
+	shareDenom := types.GetPoolShareDenom(poolId)
+	shares := suite.app.BankKeeper.GetBalance(suite.ctx, acc1, shareDenom)
+
+	// acc1 funds the community pool with a third of its shares, and sends a
+	// third to the incentives module, as if it were escrowed in a gauge.
+	third := sdk.NewCoins(sdk.NewCoin(shareDenom, shares.Amount.QuoRaw(3)))
+	err := suite.app.DistrKeeper.FundCommunityPool(suite.ctx, third, acc1)
+	suite.Require().NoError(err)
+	err = suite.app.BankKeeper.SendCoinsFromAccountToModule(suite.ctx, acc1, incentivestypes.ModuleName, third)
+	suite.Require().NoError(err)
+
+	communityPoolAddr := authtypes.NewModuleAddress(distrtypes.ModuleName)
+	incentivesAddr := authtypes.NewModuleAddress(incentivestypes.ModuleName)
+	cleanups, err := suite.app.GAMMKeeper.GetPoolCleanups(suite.ctx, []uint64{poolId})
+	suite.Require().NoError(err)
+	cleanup := cleanups[0]
+	payouts := make(map[string]types.CleanupPayout)
+	for _, payout := range cleanup.Payouts {
+		payouts[payout.Address] = payout
+	}
+	suite.Require().Len(payouts, 2)
+	suite.Require().Equal(third[0].Amount, payouts[communityPoolAddr.String()].UnlockedShares)
+	suite.Require().NotContains(payouts, incentivesAddr.String())
+
+	communityPoolBefore := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx)
+	err = suite.app.GAMMKeeper.CleanupPools(suite.ctx, []uint64{poolId})
+	suite.Require().NoError(err)
+
+	// the community pool's shares are swapped for its payout and the
+	// remainder, which includes what the incentives module's shares are worth.
+	// The incentives module keeps its shares.
+	payout := sdk.NewDecCoinsFromCoins(payouts[communityPoolAddr.String()].Tokens.Add(cleanup.Remainder...)...)
+	expected := communityPoolBefore.Sub(sdk.NewDecCoinsFromCoins(third...)).Add(payout...)
+	suite.Require().Equal(expected, suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx))
+	suite.Require().Equal(third, suite.app.BankKeeper.GetAllBalances(suite.ctx, incentivesAddr))
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, communityPoolAddr, shareDenom).IsZero())
+	suite.Require().Equal(third[0].Amount, suite.app.BankKeeper.GetSupply(suite.ctx, shareDenom).Amount)
+}
+
+func (suite *KeeperTestSuite) TestCleanupPoolsInvalid() {
+	poolId := suite.prepareGovernedBalancerPool("")
+
+	_, err := suite.app.GAMMKeeper.GetPoolCleanups(suite.ctx, []uint64{poolId, poolId})
+	suite.Require().ErrorIs(err, types.ErrInvalidPoolIds)
+
+	// a missing pool fails the whole cleanup.
+	err = suite.app.GAMMKeeper.CleanupPools(suite.ctx, []uint64{poolId, poolId + 1})
+	suite.Require().Error(err)
+	_, err = suite.app.GAMMKeeper.GetPoolAndPoke(suite.ctx, poolId)
+	suite.Require().NoError(err)
+}
@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+// SetPoolFees sets the swap and exit fees of a pool, on behalf of its future
+// pool governor.
+func (k Keeper) SetPoolFees(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, swapFee, exitFee sdk.Dec) error {
+	pool, err := k.getGovernedPool(ctx, sender, poolId)
+	if err != nil {
+		return err
+	}
+
+	if err := pool.SetPoolFees(swapFee, exitFee); err != nil {
+		return err
+	}
+	if err := k.SetPool(ctx, pool); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtPoolFeesChanged,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(types.AttributeKeySwapFee, swapFee.String()),
+		sdk.NewAttribute(types.AttributeKeyExitFee, exitFee.String()),
+	))
+	return nil
+}
+
+// SetPoolActive pauses swaps against a pool, or unpauses them, on behalf of
+// its future pool governor.
+func (k Keeper) SetPoolActive(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, isActive bool) error {
+	pool, err := k.getGovernedPool(ctx, sender, poolId)
+	if err != nil {
+		return err
+	}
+
+	pool.SetActive(isActive)
+	if err := k.SetPool(ctx, pool); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtPoolActiveChanged,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyIsActive, strconv.FormatBool(isActive)),
+	))
+	return nil
+}
+
+// ScheduleWeightChange starts a smooth change of the weights of a balancer
+// pool, on behalf of its future pool governor.
+func (k Keeper) ScheduleWeightChange(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, params balancer.SmoothWeightChangeParams) error {
+	pool, err := k.getGovernedPool(ctx, sender, poolId)
+	if err != nil {
+		return err
+	}
+	balancerPool, ok := pool.(*balancer.Pool)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrPoolNotGovernable, "pool %d has no weights to change", poolId)
+	}
+
+	if err := balancerPool.ScheduleWeightChange(params, ctx.BlockTime()); err != nil {
+		return err
+	}
+	if err := k.SetPool(ctx, balancerPool); err != nil {
+		return err
+	}
+
+	scheduled := balancerPool.PoolParams.SmoothWeightChangeParams
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtWeightChangeScheduled,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyStartTime, scheduled.StartTime.String()),
+		sdk.NewAttribute(types.AttributeKeyDuration, scheduled.Duration.String()),
+		sdk.NewAttribute(types.AttributeKeyTargetWeights, poolWeightsString(scheduled.TargetPoolWeights)),
+	))
+	return nil
+}
+
+// poolWeightsString formats the weights of pool assets like coins, with the
+// weight as the amount.
+func poolWeightsString(assets []balancer.PoolAsset) string {
+	weights := sdk.Coins{}
+	for _, asset := range assets {
+		weights = weights.Add(sdk.NewCoin(asset.Token.Denom, asset.Weight))
+	}
+	return weights.String()
+}
+
+// getGovernedPool returns a pool that sender governs.
+func (k Keeper) getGovernedPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) (types.PoolGovernorExtension, error) {
+	poolI, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+	pool, ok := poolI.(types.PoolGovernorExtension)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrPoolNotGovernable, "pool %d", poolId)
+	}
+
+	isGovernor, err := k.isPoolGovernor(ctx, pool, sender)
+	if err != nil {
+		return nil, err
+	}
+	if !isGovernor {
+		return nil, sdkerrors.Wrapf(types.ErrNotPoolGovernor, "%s does not govern pool %d", sender, poolId)
+	}
+	return pool, nil
+}
+
+// isPoolGovernor returns whether addr governs a pool. An address governor
+// governs the pool alone. A lock based governor, of the form
+// {token name},{duration} or {duration}, is whoever has locked more than half
+// of all the token (the pool's share token, if not given) locked for at least
+// the duration. A pool without a governor is governed by no one.
+func (k Keeper) isPoolGovernor(ctx sdk.Context, pool types.PoolGovernorExtension, addr sdk.AccAddress) (bool, error) {
+	governor, err := types.ParseFutureGovernor(pool.GetFuturePoolGovernor())
+	if err != nil {
+		return false, err
+	}
+	if governor == nil {
+		return false, nil
+	}
+	if governor.Address != nil {
+		return governor.Address.Equals(addr), nil
+	}
+
+	denom := governor.LockDenom
+	if denom == "" {
+		denom = types.GetPoolShareDenom(pool.GetId())
+	}
+	locked := sdk.ZeroInt()
+	for _, lock := range k.lockupKeeper.GetAccountLockedLongerDurationDenom(ctx, addr, denom, governor.LockDuration) {
+		locked = locked.Add(lock.Coins.AmountOf(denom))
+	}
+	totalLocked := k.lockupKeeper.GetLockedDenom(ctx, denom, governor.LockDuration)
+	return locked.IsPositive() && locked.MulRaw(2).GT(totalLocked), nil
+}
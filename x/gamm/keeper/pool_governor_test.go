@@ -0,0 +1,183 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v7/x/swaprouter/types"
+)
+
+// prepareGovernedBalancerPool creates a pool of foo, bar and baz as acc1, with
+// the given future pool governor.
+func (suite *KeeperTestSuite) prepareGovernedBalancerPool(governor string) uint64 {
+	poolAssets := []balancer.PoolAsset{
+		{Weight: sdk.NewInt(100), Token: sdk.NewInt64Coin("foo", 5000000)},
+		{Weight: sdk.NewInt(200), Token: sdk.NewInt64Coin("bar", 5000000)},
+		{Weight: sdk.NewInt(300), Token: sdk.NewInt64Coin("baz", 5000000)},
+	}
+	for _, acc := range []sdk.AccAddress{acc1, acc2, acc3} {
+		err := simapp.FundAccount(suite.app.BankKeeper, suite.ctx, acc, defaultAcctFunds)
+		suite.Require().NoError(err)
+	}
+
+	poolId, err := suite.app.GAMMKeeper.CreatePool(
+		suite.ctx,
+		balancer.NewMsgCreateBalancerPool(acc1, balancer.PoolParams{
+			SwapFee: sdk.NewDecWithPrec(1, 2),
+			ExitFee: sdk.ZeroDec(),
+		}, poolAssets, governor),
+	)
+	suite.Require().NoError(err)
+	return poolId
+}
+
+func (suite *KeeperTestSuite) TestSetPoolFees() {
+	tests := []struct {
+		name        string
+		governor    string
+		sender      sdk.AccAddress
+		swapFee     sdk.Dec
+		exitFee     sdk.Dec
+		expectedErr error
+	}{
+		{"address governor", acc2.String(), acc2, sdk.NewDecWithPrec(3, 3), sdk.NewDecWithPrec(1, 3), nil},
+		{"not the governor", acc2.String(), acc3, sdk.NewDecWithPrec(3, 3), sdk.ZeroDec(), types.ErrNotPoolGovernor},
+		{"pool without a governor", "", acc1, sdk.NewDecWithPrec(3, 3), sdk.ZeroDec(), types.ErrNotPoolGovernor},
+		{"swap fee of one", acc2.String(), acc2, sdk.OneDec(), sdk.ZeroDec(), types.ErrTooMuchSwapFee},
+		{"negative exit fee", acc2.String(), acc2, sdk.ZeroDec(), sdk.NewDec(-1), types.ErrNegativeExitFee},
+	}
+
+	for _, test := range tests {
+		suite.Run(test.name, func() {
+			suite.SetupTest()
+			poolId := suite.prepareGovernedBalancerPool(test.governor)
+
+			err := suite.app.GAMMKeeper.SetPoolFees(suite.ctx, test.sender, poolId, test.swapFee, test.exitFee)
+			pool, getErr := suite.app.GAMMKeeper.GetPoolAndPoke(suite.ctx, poolId)
+			suite.Require().NoError(getErr)
+			if test.expectedErr != nil {
+				suite.Require().ErrorIs(err, test.expectedErr)
+				suite.Require().Equal(sdk.NewDecWithPrec(1, 2), pool.GetSwapFee(suite.ctx))
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(test.swapFee, pool.GetSwapFee(suite.ctx))
+			suite.Require().Equal(test.exitFee, pool.GetExitFee(suite.ctx))
+
+			events := suite.ctx.EventManager().Events()
+			suite.Require().Equal(types.TypeEvtPoolFeesChanged, events[len(events)-1].Type)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSetPoolActive() {
+	poolId := suite.prepareGovernedBalancerPool(acc2.String())
+	routes := []swaproutertypes.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: "bar"}}
+	tokenIn := sdk.NewInt64Coin("foo", 10000)
+
+	err := suite.app.GAMMKeeper.SetPoolActive(suite.ctx, acc3, poolId, false)
+	suite.Require().ErrorIs(err, types.ErrNotPoolGovernor)
+
+	// a paused pool can't be swapped against.
+	err = suite.app.GAMMKeeper.SetPoolActive(suite.ctx, acc2, poolId, false)
+	suite.Require().NoError(err)
+	events := suite.ctx.EventManager().Events()
+	suite.Require().Equal(types.TypeEvtPoolActiveChanged, events[len(events)-1].Type)
+	_, err = suite.app.SwapRouterKeeper.RouteExactAmountIn(suite.ctx, acc1, routes, tokenIn, sdk.OneInt())
+	suite.Require().ErrorIs(err, swaproutertypes.ErrPoolLocked)
+
+	err = suite.app.GAMMKeeper.SetPoolActive(suite.ctx, acc2, poolId, true)
+	suite.Require().NoError(err)
+	_, err = suite.app.SwapRouterKeeper.RouteExactAmountIn(suite.ctx, acc1, routes, tokenIn, sdk.OneInt())
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestLockGovernor() {
+	poolId := suite.prepareGovernedBalancerPool("24h")
+	shareDenom := types.GetPoolShareDenom(poolId)
+	shares := suite.app.BankKeeper.GetBalance(suite.ctx, acc1, shareDenom)
+
+	// no one governs the pool until shares are locked.
+	err := suite.app.GAMMKeeper.SetPoolActive(suite.ctx, acc1, poolId, false)
+	suite.Require().ErrorIs(err, types.ErrNotPoolGovernor)
+
+	// acc1 locks 60% of the shares for a day, and acc2 the rest, in part for
+	// less than a day.
+	accShares := func(percent int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewCoin(shareDenom, shares.Amount.MulRaw(percent).QuoRaw(100)))
+	}
+	err = suite.app.BankKeeper.SendCoins(suite.ctx, acc1, acc2, accShares(40))
+	suite.Require().NoError(err)
+	_, err = suite.app.LockupKeeper.LockTokens(suite.ctx, acc1, accShares(60), time.Hour*24)
+	suite.Require().NoError(err)
+	_, err = suite.app.LockupKeeper.LockTokens(suite.ctx, acc2, accShares(30), time.Hour*24*7)
+	suite.Require().NoError(err)
+	_, err = suite.app.LockupKeeper.LockTokens(suite.ctx, acc2, accShares(10), time.Hour)
+	suite.Require().NoError(err)
+
+	err = suite.app.GAMMKeeper.SetPoolActive(suite.ctx, acc2, poolId, false)
+	suite.Require().ErrorIs(err, types.ErrNotPoolGovernor)
+	err = suite.app.GAMMKeeper.SetPoolActive(suite.ctx, acc1, poolId, false)
+	suite.Require().NoError(err)
+
+	// locking another 40% for longer than a day makes acc2 the governor.
+	suite.joinAndLockShares(acc2, poolId, shares.Amount.MulRaw(40).QuoRaw(100))
+	err = suite.app.GAMMKeeper.SetPoolActive(suite.ctx, acc1, poolId, true)
+	suite.Require().ErrorIs(err, types.ErrNotPoolGovernor)
+	err = suite.app.GAMMKeeper.SetPoolActive(suite.ctx, acc2, poolId, true)
+	suite.Require().NoError(err)
+}
+
+// joinAndLockShares joins the pool as acc for the given shares, and locks them
+// for a week.
+func (suite *KeeperTestSuite) joinAndLockShares(acc sdk.AccAddress, poolId uint64, shares sdk.Int) {
+	err := suite.app.GAMMKeeper.JoinPoolNoSwap(suite.ctx, acc, poolId, shares, sdk.Coins{})
+	suite.Require().NoError(err)
+	_, err = suite.app.LockupKeeper.LockTokens(suite.ctx, acc, sdk.NewCoins(sdk.NewCoin(types.GetPoolShareDenom(poolId), shares)), time.Hour*24*7)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestScheduleWeightChange() {
+	poolId := suite.prepareGovernedBalancerPool(acc2.String())
+	suite.ctx = suite.ctx.WithBlockTime(time.Unix(1000, 0))
+	params := balancer.SmoothWeightChangeParams{
+		Duration: time.Hour,
+		TargetPoolWeights: []balancer.PoolAsset{
+			{Weight: sdk.NewInt(300), Token: sdk.NewInt64Coin("foo", 0)},
+			{Weight: sdk.NewInt(200), Token: sdk.NewInt64Coin("bar", 0)},
+			{Weight: sdk.NewInt(100), Token: sdk.NewInt64Coin("baz", 0)},
+		},
+	}
+
+	err := suite.app.GAMMKeeper.ScheduleWeightChange(suite.ctx, acc3, poolId, params)
+	suite.Require().ErrorIs(err, types.ErrNotPoolGovernor)
+
+	// the target weights must cover the pool's assets.
+	missingAsset := params
+	missingAsset.TargetPoolWeights = params.TargetPoolWeights[:2]
+	err = suite.app.GAMMKeeper.ScheduleWeightChange(suite.ctx, acc2, poolId, missingAsset)
+	suite.Require().Error(err)
+
+	startedEarlier := params
+	startedEarlier.StartTime = time.Unix(999, 0)
+	err = suite.app.GAMMKeeper.ScheduleWeightChange(suite.ctx, acc2, poolId, startedEarlier)
+	suite.Require().Error(err)
+
+	err = suite.app.GAMMKeeper.ScheduleWeightChange(suite.ctx, acc2, poolId, params)
+	suite.Require().NoError(err)
+	events := suite.ctx.EventManager().Events()
+	suite.Require().Equal(types.TypeEvtWeightChangeScheduled, events[len(events)-1].Type)
+
+	// the weights reach the target at the end of the change.
+	suite.ctx = suite.ctx.WithBlockTime(time.Unix(1000, 0).Add(time.Hour))
+	pool, err := suite.app.GAMMKeeper.GetPoolAndPoke(suite.ctx, poolId)
+	suite.Require().NoError(err)
+	balancerPool := pool.(*balancer.Pool)
+	fooAsset, err := balancerPool.GetPoolAsset("foo")
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(300).MulRaw(balancer.GuaranteedWeightPrecision), fooAsset.Weight)
+}
@@ -105,7 +105,7 @@ func (k Keeper) CreatePool(ctx sdk.Context, msg types.CreatePoolMsg) (uint64, er
 		return 0, err
 	}
 
-	poolId := k.GetNextPoolNumberAndIncrement(ctx)
+	poolId := k.poolManager.GetNextPoolIdAndIncrement(ctx)
 	pool, err := msg.CreatePool(ctx, poolId)
 	if err != nil {
 		return 0, err
@@ -163,6 +163,7 @@ func (k Keeper) CreatePool(ctx sdk.Context, msg types.CreatePoolMsg) (uint64, er
 	if err := k.SetPool(ctx, pool); err != nil {
 		return 0, err
 	}
+	k.poolManager.SetPoolRoute(ctx, pool.GetId(), pool.GetType())
 
 	k.hooks.AfterPoolCreated(ctx, sender, pool.GetId())
 	k.RecordTotalLiquidityIncrease(ctx, initialPoolLiquidity)
@@ -380,7 +381,11 @@ func (k Keeper) ExitSwapShareAmountIn(
 		if coin.Denom == tokenOutDenom {
 			continue
 		}
-		swapOut, err := k.SwapExactAmountIn(ctx, sender, poolId, coin, tokenOutDenom, sdk.ZeroInt())
+		pool, err := k.getPoolForSwap(ctx, poolId)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		swapOut, err := k.swapExactAmountIn(ctx, sender, pool, coin, tokenOutDenom, sdk.ZeroInt(), pool.GetSwapFee(ctx))
 		if err != nil {
 			return sdk.Int{}, err
 		}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/balancer"
 	balancertypes "github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/stableswap"
 	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 )
 
@@ -211,9 +212,7 @@ func (suite *KeeperTestSuite) TestCreateBalancerPool() {
 	}, {
 		fn: func() {
 			keeper := suite.app.GAMMKeeper
-			keeper.SetParams(suite.ctx, types.Params{
-				PoolCreationFee: sdk.Coins{},
-			})
+			keeper.SetParams(suite.ctx, types.NewParams(sdk.Coins{}))
 			msg := balancer.NewMsgCreateBalancerPool(acc1, balancer.PoolParams{
 				SwapFee: sdk.NewDecWithPrec(1, 2),
 				ExitFee: sdk.NewDecWithPrec(1, 2),
@@ -227,9 +226,7 @@ func (suite *KeeperTestSuite) TestCreateBalancerPool() {
 	}, {
 		fn: func() {
 			keeper := suite.app.GAMMKeeper
-			keeper.SetParams(suite.ctx, types.Params{
-				PoolCreationFee: nil,
-			})
+			keeper.SetParams(suite.ctx, types.NewParams(nil))
 			msg := balancer.NewMsgCreateBalancerPool(acc1, balancer.PoolParams{
 				SwapFee: sdk.NewDecWithPrec(1, 2),
 				ExitFee: sdk.NewDecWithPrec(1, 2),
@@ -257,6 +254,96 @@ func (suite *KeeperTestSuite) TestCreateBalancerPool() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestCreateStableswapPool() {
+	stableswapPoolParams := stableswap.PoolParams{
+		SwapFee: defaultSwapFee,
+		ExitFee: defaultExitFee,
+	}
+	initialLiquidity := sdk.NewCoins(
+		sdk.NewCoin("bar", sdk.NewInt(1000000)),
+		sdk.NewCoin("baz", sdk.NewInt(1000000)),
+		sdk.NewCoin("foo", sdk.NewInt(1000000)),
+	)
+
+	tests := []struct {
+		name           string
+		poolParams     stableswap.PoolParams
+		liquidity      sdk.Coins
+		scalingFactors []uint64
+		expectPass     bool
+	}{
+		{
+			name:       "three asset pool with default scaling factors",
+			poolParams: stableswapPoolParams,
+			liquidity:  initialLiquidity,
+			expectPass: true,
+		},
+		{
+			name:           "three asset pool with scaling factors",
+			poolParams:     stableswapPoolParams,
+			liquidity:      initialLiquidity,
+			scalingFactors: []uint64{1, 10, 100},
+			expectPass:     true,
+		},
+		{
+			name:           "mismatched scaling factors",
+			poolParams:     stableswapPoolParams,
+			liquidity:      initialLiquidity,
+			scalingFactors: []uint64{1, 10},
+			expectPass:     false,
+		},
+		{
+			name: "negative swap fee",
+			poolParams: stableswap.PoolParams{
+				SwapFee: sdk.NewDecWithPrec(-1, 2),
+				ExitFee: defaultExitFee,
+			},
+			liquidity:  initialLiquidity,
+			expectPass: false,
+		},
+		{
+			name:       "single asset pool",
+			poolParams: stableswapPoolParams,
+			liquidity:  sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(1000000))),
+			expectPass: false,
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			err := simapp.FundAccount(suite.app.BankKeeper, suite.ctx, acc1, defaultAcctFunds)
+			suite.Require().NoError(err)
+
+			msg := stableswap.NewMsgCreateStableswapPool(acc1, tc.poolParams, tc.liquidity, tc.scalingFactors, defaultFutureGovernor)
+			poolId, err := suite.app.GAMMKeeper.CreatePool(suite.ctx, msg)
+			if !tc.expectPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			pool, err := suite.app.GAMMKeeper.GetPoolAndPoke(suite.ctx, poolId)
+			suite.Require().NoError(err)
+			suite.Require().Equal(types.InitPoolSharesSupply, pool.GetTotalShares())
+			suite.Require().Equal(tc.liquidity, suite.app.BankKeeper.GetAllBalances(suite.ctx, pool.GetAddress()))
+			suite.Require().Equal(types.InitPoolSharesSupply,
+				suite.app.BankKeeper.GetBalance(suite.ctx, acc1, types.GetPoolShareDenom(poolId)).Amount)
+
+			// the pool must be usable through the keeper once created.
+			_, err = suite.swapExactAmountIn(poolId, sdk.NewCoin("foo", sdk.NewInt(1000)), "bar", sdk.OneInt())
+			suite.Require().NoError(err)
+
+			shareOutAmount := types.InitPoolSharesSupply.QuoRaw(100)
+			err = suite.app.GAMMKeeper.JoinPoolNoSwap(suite.ctx, acc1, poolId, shareOutAmount, sdk.Coins{})
+			suite.Require().NoError(err)
+
+			_, err = suite.app.GAMMKeeper.ExitPool(suite.ctx, acc1, poolId, shareOutAmount, sdk.Coins{})
+			suite.Require().NoError(err)
+		})
+	}
+}
+
 // TODO: Add more edge cases around TokenInMaxs not containing every token in pool.
 func (suite *KeeperTestSuite) TestJoinPoolNoSwap() {
 	tests := []struct {
@@ -589,3 +676,30 @@ func (suite *KeeperTestSuite) TestJoinSwapExactAmountInConsistency() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestJoinSwapExactAmountInDenomNotInPool() {
+	suite.SetupTest()
+
+	poolID := suite.prepareCustomBalancerPool(
+		sdk.NewCoins(
+			sdk.NewCoin("uosmo", sdk.NewInt(10000000000)),
+			sdk.NewCoin("foo", sdk.NewInt(10000000)),
+			sdk.NewCoin("bar", sdk.NewInt(10000000)),
+			sdk.NewCoin("baz", sdk.NewInt(10000000)),
+		),
+		[]balancertypes.PoolAsset{
+			{
+				Weight: sdk.NewInt(100),
+				Token:  sdk.NewCoin("foo", sdk.NewInt(5000000)),
+			},
+			{
+				Weight: sdk.NewInt(200),
+				Token:  sdk.NewCoin("bar", sdk.NewInt(5000000)),
+			},
+		},
+		defaultPoolParams,
+	)
+
+	_, err := suite.app.GAMMKeeper.JoinSwapExactAmountIn(suite.ctx, acc1, poolID, sdk.NewCoins(sdk.NewCoin("baz", sdk.NewInt(1000000))), sdk.ZeroInt())
+	suite.Require().ErrorIs(err, types.ErrDenomNotFoundInPool)
+}
@@ -7,28 +7,30 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v7/x/swaprouter/types"
 )
 
 // SwapExactAmountIn attempts to swap one asset, tokenIn, for another asset
-// denominated via tokenOutDenom through a pool denoted by poolId specifying that
+// denominated via tokenOutDenom through a pool specifying that
 // tokenOutMinAmount must be returned in the resulting asset returning an error
-// upon failure. Upon success, the resulting tokens swapped for are returned. A
-// swap fee is applied determined by the pool's parameters.
+// upon failure. Upon success, the resulting tokens swapped for are returned.
+// The swap is charged swapFee, which the swap router takes from the pool's
+// parameters.
 func (k Keeper) SwapExactAmountIn(
 	ctx sdk.Context,
 	sender sdk.AccAddress,
-	poolId uint64,
+	pool swaproutertypes.PoolI,
 	tokenIn sdk.Coin,
 	tokenOutDenom string,
 	tokenOutMinAmount sdk.Int,
+	swapFee sdk.Dec,
 ) (sdk.Int, error) {
-	pool, err := k.getPoolForSwap(ctx, poolId)
+	gammPool, err := asGammPool(pool)
 	if err != nil {
 		return sdk.Int{}, err
 	}
 
-	swapFee := pool.GetSwapFee(ctx)
-	return k.swapExactAmountIn(ctx, sender, pool, tokenIn, tokenOutDenom, tokenOutMinAmount, swapFee)
+	return k.swapExactAmountIn(ctx, sender, gammPool, tokenIn, tokenOutDenom, tokenOutMinAmount, swapFee)
 }
 
 // swapExactAmountIn is an internal method for swapping an exact amount of tokens
@@ -71,20 +73,26 @@ func (k Keeper) swapExactAmountIn(
 	return tokenOutAmount, nil
 }
 
+// SwapExactAmountOut attempts to swap an asset denominated via tokenInDenom for
+// an exact amount of another asset, tokenOut, through a pool specifying that at
+// most tokenInMaxAmount may be swapped in, returning an error upon failure.
+// Upon success, the amount of tokens swapped in is returned. The swap is
+// charged swapFee, which the swap router takes from the pool's parameters.
 func (k Keeper) SwapExactAmountOut(
 	ctx sdk.Context,
 	sender sdk.AccAddress,
-	poolId uint64,
+	pool swaproutertypes.PoolI,
 	tokenInDenom string,
 	tokenInMaxAmount sdk.Int,
 	tokenOut sdk.Coin,
+	swapFee sdk.Dec,
 ) (tokenInAmount sdk.Int, err error) {
-	pool, err := k.getPoolForSwap(ctx, poolId)
+	gammPool, err := asGammPool(pool)
 	if err != nil {
 		return sdk.Int{}, err
 	}
-	swapFee := pool.GetSwapFee(ctx)
-	return k.swapExactAmountOut(ctx, sender, pool, tokenInDenom, tokenInMaxAmount, tokenOut, swapFee)
+
+	return k.swapExactAmountOut(ctx, sender, gammPool, tokenInDenom, tokenInMaxAmount, tokenOut, swapFee)
 }
 
 // swapExactAmountIn is an internal method for swapping to get an exact number of tokens out of a pool,
@@ -132,6 +140,52 @@ func (k Keeper) swapExactAmountOut(
 	return tokenInAmount, nil
 }
 
+// CalcOutAmtGivenIn returns the amount of tokens SwapExactAmountIn would
+// return on these arguments, without changing the pool.
+func (k Keeper) CalcOutAmtGivenIn(
+	ctx sdk.Context,
+	pool swaproutertypes.PoolI,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	swapFee sdk.Dec,
+) (tokenOut sdk.Coin, err error) {
+	gammPool, err := asGammPool(pool)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	tokenOutDec, err := gammPool.CalcOutAmtGivenIn(ctx, sdk.NewCoins(tokenIn), tokenOutDenom, swapFee)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	tokenOut, _ = tokenOutDec.TruncateDecimal()
+	return tokenOut, nil
+}
+
+// CalcInAmtGivenOut returns the amount of tokens SwapExactAmountOut would
+// take on these arguments, without changing the pool.
+func (k Keeper) CalcInAmtGivenOut(
+	ctx sdk.Context,
+	pool swaproutertypes.PoolI,
+	tokenOut sdk.Coin,
+	tokenInDenom string,
+	swapFee sdk.Dec,
+) (tokenIn sdk.Coin, err error) {
+	gammPool, err := asGammPool(pool)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	tokenInDec, err := gammPool.CalcInAmtGivenOut(ctx, sdk.NewCoins(tokenOut), tokenInDenom, swapFee)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	tokenIn, _ = tokenInDec.TruncateDecimal()
+	return tokenIn, nil
+}
+
 // updatePoolForSwap takes a pool, sender, and tokenIn, tokenOut amounts
 // It then updates the pool's balances to the new reserve amounts, and
 // sends the in tokens from the sender to the pool, and the out tokens from the pool to the sender.
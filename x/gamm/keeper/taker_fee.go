@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+// GetTakerFeeParams returns the params of the protocol's taker fee.
+func (k Keeper) GetTakerFeeParams(ctx sdk.Context) (params types.TakerFeeParams) {
+	k.paramSpace.Get(ctx, types.KeyTakerFeeParams, &params)
+	return params
+}
+
+// SetTakerFeeParams sets the params of the protocol's taker fee.
+func (k Keeper) SetTakerFeeParams(ctx sdk.Context, params types.TakerFeeParams) {
+	k.paramSpace.Set(ctx, types.KeyTakerFeeParams, params)
+}
+
+// TakerFeeForAmountIn returns the taker fee taken from tokenIn, when swapped for
+// tokenOutDenom. The fee is rounded down.
+func (k Keeper) TakerFeeForAmountIn(ctx sdk.Context, tokenIn sdk.Coin, tokenOutDenom string) sdk.Coin {
+	takerFee := k.GetTakerFeeParams(ctx).GetTakerFee(tokenIn.Denom, tokenOutDenom)
+	return sdk.Coin{Denom: tokenIn.Denom, Amount: tokenIn.Amount.ToDec().Mul(takerFee).TruncateInt()}
+}
+
+// TakerFeeForAmountOut returns the taker fee to take on top of the tokens a
+// pool swaps in for tokenOutDenom, so that the tokens left after the fee is
+// taken, as TakerFeeForAmountIn takes it, are at least tokenIn.
+func (k Keeper) TakerFeeForAmountOut(ctx sdk.Context, tokenIn sdk.Coin, tokenOutDenom string) sdk.Coin {
+	takerFee := k.GetTakerFeeParams(ctx).GetTakerFee(tokenIn.Denom, tokenOutDenom)
+	// tokenInWithFee = tokenIn / (1 - takerFee), rounded up
+	tokenInWithFee := tokenIn.Amount.ToDec().Quo(sdk.OneDec().Sub(takerFee)).Ceil().TruncateInt()
+	return sdk.Coin{Denom: tokenIn.Denom, Amount: tokenInWithFee.Sub(tokenIn.Amount)}
+}
+
+// ChargeTakerFee sends the taker fee of a swap in a pool from the sender to
+// the taker fee destination, and records it as collected. The swap router
+// charges it on every hop of a swap, whatever the pool's type.
+func (k Keeper) ChargeTakerFee(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, takerFee sdk.Coin) error {
+	if !takerFee.IsPositive() {
+		return nil
+	}
+
+	takerFees := sdk.NewCoins(takerFee)
+	destination := k.GetTakerFeeParams(ctx).Destination
+	switch destination {
+	case types.CommunityPool:
+		if err := k.distrKeeper.FundCommunityPool(ctx, takerFees, sender); err != nil {
+			return err
+		}
+	case types.FeeCollector:
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, authtypes.FeeCollectorName, takerFees); err != nil {
+			return err
+		}
+	case types.Burn:
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, takerFees); err != nil {
+			return err
+		}
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, takerFees); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("invalid taker fee destination: %s", destination)
+	}
+
+	k.setTakerFeeCollected(ctx, takerFee.Denom, k.GetTakerFeeCollected(ctx, takerFee.Denom).Add(takerFee.Amount))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtTakerFeeCharged,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyTakerFee, takerFee.String()),
+		sdk.NewAttribute(types.AttributeKeyDestination, destination.String()),
+	))
+	return nil
+}
+
+// GetTakerFeeCollected returns the taker fees of a denom collected so far.
+func (k Keeper) GetTakerFeeCollected(ctx sdk.Context, denom string) sdk.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetKeyTakerFeeCollected(denom))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	var amount sdk.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return amount
+}
+
+// GetTakerFeesCollected returns the taker fees collected so far, of every
+// denom.
+func (k Keeper) GetTakerFeesCollected(ctx sdk.Context) sdk.Coins {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTakerFeesCollected)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	fees := sdk.Coins{}
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdk.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		fees = fees.Add(sdk.NewCoin(string(iterator.Key()), amount))
+	}
+	return fees
+}
+
+// SetTakerFeesCollected sets the taker fees collected so far. It is only used
+// in genesis.
+func (k Keeper) SetTakerFeesCollected(ctx sdk.Context, fees sdk.Coins) {
+	for _, fee := range fees {
+		k.setTakerFeeCollected(ctx, fee.Denom, fee.Amount)
+	}
+}
+
+func (k Keeper) setTakerFeeCollected(ctx sdk.Context, denom string, amount sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.GetKeyTakerFeeCollected(denom), bz)
+}
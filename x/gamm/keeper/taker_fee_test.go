@@ -0,0 +1,110 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v7/x/swaprouter/types"
+)
+
+func (suite *KeeperTestSuite) TestTakerFee() {
+	tests := []struct {
+		name        string
+		destination types.TakerFeeDestination
+	}{
+		{"fund the community pool", types.CommunityPool},
+		{"send to the fee collector", types.FeeCollector},
+		{"burn", types.Burn},
+	}
+
+	for _, test := range tests {
+		suite.Run(test.name, func() {
+			suite.SetupTest()
+			poolId := suite.prepareBalancerPool()
+			suite.app.GAMMKeeper.SetTakerFeeParams(suite.ctx, types.TakerFeeParams{
+				TakerFee: sdk.NewDecWithPrec(1, 2),
+				Overrides: []types.TakerFeeOverride{
+					{Denom0: "bar", Denom1: "foo", TakerFee: sdk.NewDecWithPrec(5, 3)},
+				},
+				Destination: test.destination,
+			})
+			pool, err := suite.app.GAMMKeeper.GetPool(suite.ctx, poolId)
+			suite.Require().NoError(err)
+			feeCollector := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+			communityPoolBefore := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx)
+			supplyBefore := suite.app.BankKeeper.GetSupply(suite.ctx, "foo")
+
+			// swapping foo for bar takes the overridden taker fee, and the
+			// estimate matches the swap.
+			tokenIn := sdk.NewInt64Coin("foo", 100000)
+			estimate, err := suite.app.SwapRouterKeeper.CalcOutAmtGivenIn(suite.ctx, poolId, tokenIn, "bar")
+			suite.Require().NoError(err)
+			balanceBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, acc1)
+			tokenOutAmount, err := suite.app.SwapRouterKeeper.RouteExactAmountIn(suite.ctx, acc1,
+				[]swaproutertypes.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: "bar"}}, tokenIn, sdk.OneInt())
+			suite.Require().NoError(err)
+			suite.Require().Equal(estimate.Amount, tokenOutAmount)
+			balanceAfter := suite.app.BankKeeper.GetAllBalances(suite.ctx, acc1)
+			suite.Require().Equal(tokenIn.Amount, balanceBefore.AmountOf("foo").Sub(balanceAfter.AmountOf("foo")))
+
+			takerFee := sdk.NewInt64Coin("foo", 500)
+			suite.Require().Equal(takerFee.Amount, suite.app.GAMMKeeper.GetTakerFeeCollected(suite.ctx, "foo"))
+			switch test.destination {
+			case types.CommunityPool:
+				communityPool := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx)
+				suite.Require().Equal(communityPoolBefore.Add(sdk.NewDecCoinsFromCoins(takerFee)...), communityPool)
+			case types.FeeCollector:
+				suite.Require().Equal(takerFee, suite.app.BankKeeper.GetBalance(suite.ctx, feeCollector, "foo"))
+			case types.Burn:
+				suite.Require().Equal(supplyBefore.Sub(takerFee), suite.app.BankKeeper.GetSupply(suite.ctx, "foo"))
+			}
+			events := suite.ctx.EventManager().Events()
+			suite.Require().Equal(types.TypeEvtTakerFeeCharged, events[len(events)-1].Type)
+
+			// swapping for an exact amount of baz takes the default taker fee on
+			// top of what the pool swaps in, and the estimate matches the swap.
+			tokenOut := sdk.NewInt64Coin("baz", 100000)
+			pool, err = suite.app.GAMMKeeper.GetPool(suite.ctx, poolId)
+			suite.Require().NoError(err)
+			estimate, err = suite.app.GAMMKeeper.CalcInAmtGivenOut(suite.ctx, pool, tokenOut, "foo", pool.GetSwapFee(suite.ctx))
+			suite.Require().NoError(err)
+			estimate = estimate.Add(suite.app.GAMMKeeper.TakerFeeForAmountOut(suite.ctx, estimate, tokenOut.Denom))
+			balanceBefore = suite.app.BankKeeper.GetAllBalances(suite.ctx, acc1)
+			routes := []swaproutertypes.SwapAmountOutRoute{{PoolId: poolId, TokenInDenom: "foo"}}
+			cacheCtx, _ := suite.ctx.CacheContext()
+			_, err = suite.app.SwapRouterKeeper.RouteExactAmountOut(cacheCtx, acc1, routes, estimate.Amount.SubRaw(1), tokenOut)
+			suite.Require().ErrorIs(err, swaproutertypes.ErrLimitMaxAmount)
+			tokenInAmount, err := suite.app.SwapRouterKeeper.RouteExactAmountOut(suite.ctx, acc1, routes, estimate.Amount, tokenOut)
+			suite.Require().NoError(err)
+			suite.Require().Equal(estimate.Amount, tokenInAmount)
+			balanceAfter = suite.app.BankKeeper.GetAllBalances(suite.ctx, acc1)
+			suite.Require().Equal(tokenInAmount, balanceBefore.AmountOf("foo").Sub(balanceAfter.AmountOf("foo")))
+			suite.Require().Equal(tokenOut.Amount, balanceAfter.AmountOf("baz").Sub(balanceBefore.AmountOf("baz")))
+
+			collected := suite.app.GAMMKeeper.GetTakerFeeCollected(suite.ctx, "foo")
+			exactOutTakerFee := collected.Sub(takerFee.Amount)
+			expectedTakerFee := tokenInAmount.ToDec().Mul(sdk.NewDecWithPrec(1, 2))
+			suite.Require().True(exactOutTakerFee.ToDec().Sub(expectedTakerFee).Abs().LTE(sdk.OneDec()),
+				"taker fee %s, expected about %s", exactOutTakerFee, expectedTakerFee)
+
+			res, err := suite.queryClient.TakerFeesCollected(sdk.WrapSDKContext(suite.ctx), &types.QueryTakerFeesCollectedRequest{})
+			suite.Require().NoError(err)
+			suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("foo", collected)), res.TakerFeesCollected)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestNoTakerFee() {
+	poolId := suite.prepareBalancerPool()
+	communityPoolBefore := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx)
+
+	_, err := suite.app.SwapRouterKeeper.RouteExactAmountIn(suite.ctx, acc1,
+		[]swaproutertypes.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: "bar"}}, sdk.NewInt64Coin("foo", 100000), sdk.OneInt())
+	suite.Require().NoError(err)
+	suite.Require().True(suite.app.GAMMKeeper.GetTakerFeesCollected(suite.ctx).Empty())
+	suite.Require().Equal(communityPoolBefore, suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx))
+	for _, event := range suite.ctx.EventManager().Events() {
+		suite.Require().NotEqual(types.TypeEvtTakerFeeCharged, event.Type)
+	}
+}
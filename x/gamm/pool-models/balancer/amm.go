@@ -295,7 +295,11 @@ func (p *Pool) CalcJoinPoolShares(_ctx sdk.Context, tokensIn sdk.Coins, swapFee
 	totalShares := p.GetTotalShares()
 
 	if tokensIn.Len() == 1 {
-		numShares, err = p.calcSingleAssetJoin(tokensIn[0], swapFee, poolAssetsByDenom[tokensIn[0].Denom], totalShares)
+		tokenInPoolAsset, ok := poolAssetsByDenom[tokensIn[0].Denom]
+		if !ok {
+			return sdk.ZeroInt(), sdk.NewCoins(), sdkerrors.Wrapf(types.ErrDenomNotFoundInPool, "%s", tokensIn[0].Denom)
+		}
+		numShares, err = p.calcSingleAssetJoin(tokensIn[0], swapFee, tokenInPoolAsset, totalShares)
 		newLiquidity = tokensIn
 		return numShares, newLiquidity, err
 	} else if tokensIn.Len() != p.NumAssets() {
@@ -40,11 +40,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // the two weights, but more types may be added in the future.
 // When these parameters are set, the weight w(t) for pool time `t` is the
 // following:
-//   t <= start_time: w(t) = initial_pool_weights
-//   start_time < t <= start_time + duration:
-//     w(t) = initial_pool_weights + (t - start_time) *
-//       (target_pool_weights - initial_pool_weights) / (duration)
-//   t > start_time + duration: w(t) = target_pool_weights
+//
+//	t <= start_time: w(t) = initial_pool_weights
+//	start_time < t <= start_time + duration:
+//	  w(t) = initial_pool_weights + (t - start_time) *
+//	    (target_pool_weights - initial_pool_weights) / (duration)
+//	t > start_time + duration: w(t) = target_pool_weights
 type SmoothWeightChangeParams struct {
 	// The start time for beginning the weight change.
 	// If a parameter change / pool instantiation leaves this blank,
@@ -249,6 +250,8 @@ type Pool struct {
 	PoolAssets []PoolAsset `protobuf:"bytes,6,rep,name=poolAssets,proto3" json:"poolAssets" yaml:"pool_assets"`
 	// sum of all non-normalized pool weights
 	TotalWeight github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=totalWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"totalWeight" yaml:"total_weight"`
+	// whether the future pool governor paused swaps against the pool.
+	IsPaused bool `protobuf:"varint,8,opt,name=is_paused,json=isPaused,proto3" json:"is_paused,omitempty" yaml:"is_paused"`
 }
 
 func (m *Pool) Reset()      { *m = Pool{} }
@@ -295,59 +298,60 @@ func init() {
 }
 
 var fileDescriptor_7e991f749f68c2a4 = []byte{
-	// 821 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0xc7, 0xe3, 0x24, 0x6d, 0xe9, 0x14, 0x16, 0x75, 0xc8, 0xc1, 0xcd, 0x8a, 0xb8, 0x1a, 0x24,
-	0xb4, 0x42, 0x1b, 0x5b, 0x5d, 0x90, 0x90, 0xf6, 0x00, 0xda, 0x6c, 0x0b, 0xea, 0xad, 0xb8, 0x48,
-	0xad, 0xe8, 0xc1, 0x9a, 0x24, 0x53, 0xc7, 0xaa, 0xed, 0x31, 0x9e, 0x49, 0x7f, 0xfc, 0x07, 0x1c,
-	0x7b, 0x42, 0x3d, 0xf6, 0xce, 0x09, 0x89, 0xff, 0x81, 0x1e, 0x2b, 0x4e, 0x88, 0x83, 0x8b, 0xda,
-	0x1b, 0xc7, 0xfc, 0x05, 0x68, 0x66, 0x9e, 0xd3, 0x90, 0x26, 0xa2, 0xd5, 0x9e, 0x9a, 0x99, 0x79,
-	0xef, 0xf3, 0xbe, 0xf3, 0xde, 0x77, 0x5c, 0xf4, 0x05, 0x17, 0x09, 0x17, 0x91, 0xf0, 0x42, 0x9a,
-	0x24, 0x5e, 0xc6, 0x79, 0xdc, 0x4e, 0x78, 0x9f, 0xc5, 0xc2, 0xeb, 0xd2, 0x98, 0xa6, 0x3d, 0x96,
-	0x8f, 0x7f, 0xec, 0x70, 0x1e, 0xbb, 0x59, 0xce, 0x25, 0xc7, 0x0d, 0xc8, 0x72, 0x55, 0x96, 0x7b,
-	0xbc, 0xd1, 0x65, 0x92, 0x6e, 0x34, 0xd7, 0x7a, 0x7a, 0x3b, 0xd0, 0x31, 0x9e, 0x59, 0x98, 0x84,
-	0x66, 0x23, 0xe4, 0x21, 0x37, 0xfb, 0xea, 0x17, 0xec, 0xb6, 0x42, 0xce, 0xc3, 0x98, 0x79, 0x7a,
-	0xd5, 0x1d, 0x1e, 0x7a, 0xfd, 0x61, 0x4e, 0x65, 0xc4, 0x53, 0x38, 0x77, 0xa6, 0xcf, 0x65, 0x94,
-	0x30, 0x21, 0x69, 0x92, 0x95, 0x00, 0x53, 0xc4, 0xa3, 0x43, 0x39, 0xf0, 0x40, 0x86, 0x5e, 0x4c,
-	0x9d, 0x77, 0xa9, 0x60, 0xe3, 0xf3, 0x1e, 0x8f, 0xa0, 0x00, 0xf9, 0xbd, 0x86, 0xec, 0xdd, 0x84,
-	0x73, 0x39, 0xd8, 0x63, 0x51, 0x38, 0x90, 0x6f, 0x07, 0x34, 0x0d, 0xd9, 0x0e, 0xcd, 0x69, 0x22,
-	0xf0, 0x3e, 0x42, 0x42, 0xd2, 0x5c, 0x06, 0xaa, 0xaa, 0x6d, 0xad, 0x5b, 0x2f, 0x56, 0x5e, 0x35,
-	0x5d, 0x23, 0xc9, 0x2d, 0x25, 0xb9, 0xdf, 0x97, 0x92, 0x3a, 0x1f, 0x5f, 0x15, 0x4e, 0x65, 0x54,
-	0x38, 0xab, 0x67, 0x34, 0x89, 0x5f, 0x93, 0xfb, 0x5c, 0x72, 0x7e, 0xe3, 0x58, 0xfe, 0xb2, 0xde,
-	0x50, 0xe1, 0x78, 0x80, 0xde, 0x2b, 0x6f, 0x6a, 0x57, 0x35, 0x77, 0xed, 0x01, 0x77, 0x13, 0x02,
-	0x3a, 0x1b, 0x0a, 0xfb, 0x4f, 0xe1, 0xe0, 0x32, 0xe5, 0x25, 0x4f, 0x22, 0xc9, 0x92, 0x4c, 0x9e,
-	0x8d, 0x0a, 0xe7, 0x43, 0x53, 0xac, 0x3c, 0x23, 0x17, 0xaa, 0xd4, 0x98, 0x8e, 0x25, 0xc2, 0x51,
-	0x1a, 0xc9, 0x88, 0xc6, 0x6a, 0x7a, 0xe6, 0x92, 0xc2, 0xae, 0xad, 0xd7, 0x5e, 0xac, 0xbc, 0x72,
-	0xdc, 0x59, 0x53, 0x74, 0x55, 0xe0, 0x1b, 0x21, 0x98, 0xec, 0x7c, 0x02, 0x17, 0x7a, 0x6e, 0x6a,
-	0x00, 0x28, 0x50, 0x26, 0x09, 0x4e, 0x0c, 0x8a, 0xf8, 0x33, 0xf8, 0xf8, 0x47, 0xb4, 0x2a, 0x69,
-	0x1e, 0x32, 0x39, 0x59, 0xb4, 0xfe, 0xb8, 0xa2, 0x04, 0x8a, 0x36, 0x4d, 0x51, 0xc3, 0x99, 0xaa,
-	0xf9, 0x90, 0x4e, 0x6e, 0xaa, 0x08, 0xa9, 0x35, 0xcc, 0xee, 0x00, 0x2d, 0x89, 0x13, 0x9a, 0x7d,
-	0xc3, 0xcc, 0xe0, 0x96, 0x3b, 0x6f, 0x14, 0xf6, 0xaf, 0xc2, 0xf9, 0x34, 0x8c, 0xe4, 0x60, 0xd8,
-	0x75, 0x7b, 0x3c, 0x01, 0x87, 0xc2, 0x9f, 0xb6, 0xe8, 0x1f, 0x79, 0xf2, 0x2c, 0x63, 0xc2, 0xdd,
-	0x64, 0xbd, 0xfb, 0xce, 0x2a, 0x4c, 0x70, 0xc8, 0x18, 0xf1, 0x4b, 0xa2, 0x82, 0xb3, 0xd3, 0x48,
-	0x2a, 0x78, 0xf5, 0xdd, 0xe0, 0x0a, 0x03, 0x70, 0x20, 0xe2, 0x9f, 0x2d, 0x64, 0x8b, 0x39, 0x96,
-	0xb4, 0x6b, 0xda, 0x2c, 0xee, 0xec, 0x1e, 0xce, 0x33, 0x72, 0xe7, 0xb3, 0xab, 0xc2, 0xb1, 0x46,
-	0x85, 0x43, 0xe0, 0x46, 0x3a, 0x0e, 0xba, 0x19, 0xf4, 0x74, 0x64, 0x90, 0xe9, 0x50, 0xe2, 0xcf,
-	0xad, 0x4d, 0x7e, 0xb1, 0xd0, 0xf2, 0x78, 0x4c, 0x78, 0x0b, 0x2d, 0x48, 0x7e, 0xc4, 0x52, 0x78,
-	0x17, 0x6b, 0x2e, 0x3c, 0x77, 0xf5, 0xd2, 0xc6, 0x8a, 0xde, 0xf2, 0x28, 0xed, 0x34, 0x60, 0xa0,
-	0xef, 0xc3, 0x40, 0x55, 0x16, 0xf1, 0x4d, 0x36, 0xde, 0x43, 0x8b, 0x46, 0x07, 0x74, 0xf2, 0xeb,
-	0x27, 0x74, 0x72, 0x3b, 0x95, 0xa3, 0xc2, 0xf9, 0xc0, 0x60, 0x0d, 0x85, 0xf8, 0x80, 0x23, 0xbf,
-	0xd6, 0x51, 0x5d, 0xa9, 0xc5, 0x2f, 0xd1, 0x12, 0xed, 0xf7, 0x73, 0x26, 0x04, 0x38, 0x01, 0x8f,
-	0x0a, 0xe7, 0x99, 0x49, 0x82, 0x03, 0xe2, 0x97, 0x21, 0xf8, 0x19, 0xaa, 0x46, 0x7d, 0xad, 0xa5,
-	0xee, 0x57, 0xa3, 0x3e, 0x66, 0x08, 0x65, 0x63, 0x57, 0x41, 0xfb, 0xd7, 0xe7, 0x5b, 0x18, 0x1a,
-	0x3e, 0xf5, 0x70, 0xca, 0x0f, 0xa8, 0x71, 0x71, 0xd9, 0xe9, 0x09, 0x30, 0xfe, 0x0e, 0x35, 0x0e,
-	0x87, 0x72, 0x98, 0x33, 0x13, 0x12, 0xf2, 0x63, 0x96, 0xa7, 0x3c, 0xb7, 0xeb, 0x5a, 0xb1, 0x73,
-	0x8f, 0x9a, 0x15, 0x45, 0x7c, 0x6c, 0xb6, 0x95, 0x82, 0x6f, 0x61, 0x13, 0xef, 0xa3, 0x15, 0xc9,
-	0x25, 0x8d, 0x77, 0x07, 0x34, 0x67, 0xc2, 0x5e, 0xf8, 0xbf, 0x31, 0x3d, 0x07, 0xcd, 0x1f, 0x95,
-	0x63, 0x92, 0x34, 0x0e, 0x84, 0x4e, 0x26, 0xfe, 0x24, 0x0a, 0x1f, 0x98, 0x9e, 0x68, 0x1f, 0x08,
-	0x7b, 0xf1, 0x71, 0xcf, 0xba, 0x09, 0x78, 0x6c, 0xf0, 0xfa, 0x02, 0x54, 0x13, 0xa0, 0x13, 0x06,
-	0x87, 0x43, 0x90, 0x6d, 0x0c, 0x68, 0x2f, 0xe9, 0x06, 0x6c, 0x3d, 0xd9, 0x15, 0xff, 0xb9, 0x45,
-	0xe9, 0x8d, 0x49, 0xf2, 0xeb, 0xd5, 0x9f, 0x2e, 0x9d, 0xca, 0xc5, 0xa5, 0x53, 0xf9, 0xe3, 0xb7,
-	0xf6, 0x82, 0xd2, 0xb9, 0xdd, 0xd9, 0xbf, 0xba, 0x6d, 0x59, 0xd7, 0xb7, 0x2d, 0xeb, 0xef, 0xdb,
-	0x96, 0x75, 0x7e, 0xd7, 0xaa, 0x5c, 0xdf, 0xb5, 0x2a, 0x7f, 0xde, 0xb5, 0x2a, 0x3f, 0x7c, 0x35,
-	0x51, 0x18, 0x2e, 0xda, 0x8e, 0x69, 0x57, 0x94, 0x0b, 0xef, 0xf8, 0x4b, 0xef, 0x74, 0xfe, 0xbf,
-	0xd0, 0xee, 0xa2, 0xfe, 0xac, 0x7f, 0xfe, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe8, 0x95, 0xe6,
-	0x20, 0x6e, 0x07, 0x00, 0x00,
+	// 847 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0xbd, 0x8e, 0xf3, 0x6b, 0x02, 0x85, 0x0c, 0x3e, 0x6c, 0x5c, 0xe1, 0x89, 0x06, 0x09,
+	0x45, 0xa8, 0xd9, 0x55, 0x0a, 0x12, 0x52, 0x0f, 0xa0, 0xba, 0x2d, 0xa8, 0xb7, 0xb0, 0x45, 0x6a,
+	0x44, 0x0f, 0xab, 0xb1, 0x77, 0xb2, 0x1e, 0x75, 0x77, 0x67, 0xd9, 0x19, 0xa7, 0xcd, 0x7f, 0xc0,
+	0xb1, 0x27, 0xd4, 0x63, 0xef, 0x5c, 0xf9, 0x1f, 0xc8, 0xb1, 0xe2, 0x84, 0x38, 0x6c, 0x51, 0xc2,
+	0x89, 0xa3, 0xff, 0x02, 0x34, 0x33, 0x6f, 0x1d, 0x93, 0xda, 0xa2, 0x55, 0x4f, 0xd9, 0x99, 0xf7,
+	0xde, 0xe7, 0xfd, 0xfa, 0x4e, 0x8c, 0xbe, 0x90, 0x2a, 0x97, 0x4a, 0xa8, 0x30, 0x65, 0x79, 0x1e,
+	0x96, 0x52, 0x66, 0xfb, 0xb9, 0x4c, 0x78, 0xa6, 0xc2, 0x21, 0xcb, 0x58, 0x31, 0xe2, 0xd5, 0xec,
+	0xe3, 0x50, 0xca, 0x2c, 0x28, 0x2b, 0xa9, 0x25, 0xee, 0x42, 0x54, 0x60, 0xa2, 0x82, 0x93, 0x83,
+	0x21, 0xd7, 0xec, 0xa0, 0xb7, 0x33, 0xb2, 0xd7, 0xb1, 0xf5, 0x09, 0xdd, 0xc1, 0x05, 0xf4, 0xba,
+	0xa9, 0x4c, 0xa5, 0xbb, 0x37, 0x5f, 0x70, 0xdb, 0x4f, 0xa5, 0x4c, 0x33, 0x1e, 0xda, 0xd3, 0x70,
+	0x72, 0x1c, 0x26, 0x93, 0x8a, 0x69, 0x21, 0x0b, 0xb0, 0x93, 0xab, 0x76, 0x2d, 0x72, 0xae, 0x34,
+	0xcb, 0xcb, 0x06, 0xe0, 0x92, 0x84, 0x6c, 0xa2, 0xc7, 0x21, 0x94, 0x61, 0x0f, 0x57, 0xec, 0x43,
+	0xa6, 0xf8, 0xcc, 0x3e, 0x92, 0x02, 0x12, 0xd0, 0xdf, 0x56, 0x90, 0xff, 0x20, 0x97, 0x52, 0x8f,
+	0x1f, 0x72, 0x91, 0x8e, 0xf5, 0x9d, 0x31, 0x2b, 0x52, 0x7e, 0xc8, 0x2a, 0x96, 0x2b, 0x7c, 0x84,
+	0x90, 0xd2, 0xac, 0xd2, 0xb1, 0xc9, 0xea, 0x7b, 0xbb, 0xde, 0xde, 0xd6, 0xcd, 0x5e, 0xe0, 0x4a,
+	0x0a, 0x9a, 0x92, 0x82, 0xef, 0x9b, 0x92, 0x06, 0x1f, 0x9f, 0xd5, 0xa4, 0x35, 0xad, 0xc9, 0xf6,
+	0x29, 0xcb, 0xb3, 0x5b, 0xf4, 0x32, 0x96, 0x3e, 0x7b, 0x45, 0xbc, 0x68, 0xd3, 0x5e, 0x18, 0x77,
+	0x3c, 0x46, 0x1b, 0x4d, 0xa7, 0x7e, 0xdb, 0x72, 0x77, 0x5e, 0xe3, 0xde, 0x05, 0x87, 0xc1, 0x81,
+	0xc1, 0xfe, 0x53, 0x13, 0xdc, 0x84, 0xdc, 0x90, 0xb9, 0xd0, 0x3c, 0x2f, 0xf5, 0xe9, 0xb4, 0x26,
+	0x1f, 0xb8, 0x64, 0x8d, 0x8d, 0x3e, 0x37, 0xa9, 0x66, 0x74, 0xac, 0x11, 0x16, 0x85, 0xd0, 0x82,
+	0x65, 0x66, 0x7b, 0xae, 0x49, 0xe5, 0xaf, 0xec, 0xae, 0xec, 0x6d, 0xdd, 0x24, 0xc1, 0xa2, 0x2d,
+	0x06, 0xc6, 0xf1, 0xb6, 0x52, 0x5c, 0x0f, 0x3e, 0x81, 0x86, 0xae, 0xbb, 0x1c, 0x00, 0x8a, 0x8d,
+	0x48, 0xe2, 0x27, 0x0e, 0x45, 0xa3, 0x05, 0x7c, 0xfc, 0x23, 0xda, 0xd6, 0xac, 0x4a, 0xb9, 0x9e,
+	0x4f, 0xda, 0x79, 0xb3, 0xa4, 0x14, 0x92, 0xf6, 0x5c, 0x52, 0xc7, 0xb9, 0x92, 0xf3, 0x75, 0x3a,
+	0x7d, 0xd5, 0x46, 0xc8, 0x9c, 0x61, 0x77, 0x8f, 0xd0, 0xba, 0x7a, 0xc2, 0xca, 0x6f, 0xb8, 0x5b,
+	0xdc, 0xe6, 0xe0, 0xb6, 0xc1, 0xfe, 0x59, 0x93, 0x4f, 0x53, 0xa1, 0xc7, 0x93, 0x61, 0x30, 0x92,
+	0x39, 0x28, 0x14, 0xfe, 0xec, 0xab, 0xe4, 0x71, 0xa8, 0x4f, 0x4b, 0xae, 0x82, 0xbb, 0x7c, 0x74,
+	0x39, 0x59, 0x83, 0x89, 0x8f, 0x39, 0xa7, 0x51, 0x43, 0x34, 0x70, 0xfe, 0x54, 0x68, 0x03, 0x6f,
+	0xbf, 0x1b, 0xdc, 0x60, 0x00, 0x0e, 0x44, 0xfc, 0xb3, 0x87, 0x7c, 0xb5, 0x44, 0x92, 0xfe, 0x8a,
+	0x15, 0x4b, 0xb0, 0x78, 0x86, 0xcb, 0x84, 0x3c, 0xf8, 0xec, 0xac, 0x26, 0xde, 0xb4, 0x26, 0x14,
+	0x3a, 0xb2, 0x7e, 0x30, 0xcd, 0x78, 0x64, 0x3d, 0xe3, 0xd2, 0xba, 0xd2, 0x68, 0x69, 0x6e, 0xfa,
+	0x8b, 0x87, 0x36, 0x67, 0x6b, 0xc2, 0xf7, 0xd0, 0xaa, 0x96, 0x8f, 0x79, 0x01, 0xef, 0x62, 0x27,
+	0x80, 0xe7, 0x6e, 0x5e, 0xda, 0xac, 0xa2, 0x3b, 0x52, 0x14, 0x83, 0x2e, 0x2c, 0xf4, 0x3d, 0x58,
+	0xa8, 0x89, 0xa2, 0x91, 0x8b, 0xc6, 0x0f, 0xd1, 0x9a, 0xab, 0x03, 0x26, 0xf9, 0xf5, 0x5b, 0x4c,
+	0xf2, 0x7e, 0xa1, 0xa7, 0x35, 0x79, 0xdf, 0x61, 0x1d, 0x85, 0x46, 0x80, 0xa3, 0x7f, 0x77, 0x50,
+	0xc7, 0x54, 0x8b, 0x6f, 0xa0, 0x75, 0x96, 0x24, 0x15, 0x57, 0x0a, 0x94, 0x80, 0xa7, 0x35, 0xb9,
+	0xe6, 0x82, 0xc0, 0x40, 0xa3, 0xc6, 0x05, 0x5f, 0x43, 0x6d, 0x91, 0xd8, 0x5a, 0x3a, 0x51, 0x5b,
+	0x24, 0x98, 0x23, 0x54, 0xce, 0x54, 0x05, 0xe3, 0xdf, 0x5d, 0x2e, 0x61, 0x18, 0xf8, 0x95, 0x87,
+	0xd3, 0xfc, 0x03, 0x75, 0x2a, 0x6e, 0x26, 0x3d, 0x07, 0xc6, 0xdf, 0xa1, 0xee, 0xf1, 0x44, 0x4f,
+	0x2a, 0xee, 0x5c, 0x52, 0x79, 0xc2, 0xab, 0x42, 0x56, 0x7e, 0xc7, 0x56, 0x4c, 0x2e, 0x51, 0x8b,
+	0xbc, 0x68, 0x84, 0xdd, 0xb5, 0xa9, 0xe0, 0x5b, 0xb8, 0xc4, 0x47, 0x68, 0x4b, 0x4b, 0xcd, 0xb2,
+	0x07, 0x63, 0x56, 0x71, 0xe5, 0xaf, 0xfe, 0xdf, 0x9a, 0xae, 0x43, 0xcd, 0x1f, 0x35, 0x6b, 0xd2,
+	0x2c, 0x8b, 0x95, 0x0d, 0xa6, 0xd1, 0x3c, 0x0a, 0x3f, 0x72, 0x33, 0xb1, 0x3a, 0x50, 0xfe, 0xda,
+	0x9b, 0x3d, 0xeb, 0x1e, 0xe0, 0xb1, 0xc3, 0xdb, 0x06, 0x98, 0x25, 0xc0, 0x24, 0x1c, 0x0e, 0xa7,
+	0x50, 0xb6, 0x13, 0xa0, 0xbf, 0x6e, 0x07, 0x70, 0xef, 0xad, 0x55, 0xf1, 0x9f, 0x2e, 0x1a, 0x6d,
+	0xcc, 0x93, 0xf1, 0x01, 0xda, 0x14, 0x2a, 0x2e, 0xd9, 0x44, 0xf1, 0xc4, 0xdf, 0xd8, 0xf5, 0xf6,
+	0x36, 0x06, 0xdd, 0x69, 0x4d, 0x3e, 0x74, 0x81, 0x33, 0x13, 0x8d, 0x36, 0x84, 0x3a, 0xb4, 0x9f,
+	0xb7, 0xb6, 0x7f, 0x7a, 0x41, 0x5a, 0xcf, 0x5f, 0x90, 0xd6, 0xef, 0xbf, 0xee, 0xaf, 0x9a, 0xd6,
+	0xee, 0x0f, 0x8e, 0xce, 0xce, 0xfb, 0xde, 0xcb, 0xf3, 0xbe, 0xf7, 0xd7, 0x79, 0xdf, 0x7b, 0x76,
+	0xd1, 0x6f, 0xbd, 0xbc, 0xe8, 0xb7, 0xfe, 0xb8, 0xe8, 0xb7, 0x7e, 0xf8, 0x6a, 0xae, 0x56, 0x98,
+	0xcd, 0x7e, 0xc6, 0x86, 0xaa, 0x39, 0x84, 0x27, 0x5f, 0x86, 0x4f, 0x97, 0xff, 0xea, 0x0e, 0xd7,
+	0xec, 0x2f, 0xc1, 0xe7, 0xff, 0x0e, 0x00, 0x34, 0xfd, 0xb7, 0x7f, 0xa1, 0x07, 0x00, 0x00,
 }
 
 func (m *SmoothWeightChangeParams) Marshal() (dAtA []byte, err error) {
@@ -535,6 +539,16 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.IsPaused {
+		i--
+		if m.IsPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
 	{
 		size := m.TotalWeight.Size()
 		i -= size
@@ -696,6 +710,9 @@ func (m *Pool) Size() (n int) {
 	}
 	l = m.TotalWeight.Size()
 	n += 1 + l + sovBalancerPool(uint64(l))
+	if m.IsPaused {
+		n += 2
+	}
 	return n
 }
 
@@ -1406,6 +1423,26 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalancerPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsPaused = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBalancerPool(dAtA[iNdEx:])
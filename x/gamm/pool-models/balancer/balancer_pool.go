@@ -11,11 +11,13 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v7/x/swaprouter/types"
 )
 
 var (
 	_ types.PoolI                               = &Pool{}
 	_ types.PoolExitSwapExactAmountOutExtension = &Pool{}
+	_ types.PoolGovernorExtension               = &Pool{}
 )
 
 // NewPool returns a weighted CPMM pool with the provided parameters, and initial assets.
@@ -80,6 +82,16 @@ func (pa Pool) GetTotalPoolLiquidity(_ sdk.Context) sdk.Coins {
 	return PoolAssetsCoins(pa.PoolAssets)
 }
 
+// GetPoolDenoms returns the denoms of the pool's assets, which are kept
+// sorted.
+func (pa Pool) GetPoolDenoms(_ sdk.Context) []string {
+	denoms := make([]string, len(pa.PoolAssets))
+	for i, asset := range pa.PoolAssets {
+		denoms[i] = asset.Token.Denom
+	}
+	return denoms
+}
+
 func (pa Pool) GetExitFee(_ sdk.Context) sdk.Dec {
 	return pa.PoolParams.ExitFee
 }
@@ -466,25 +478,66 @@ func (pa Pool) NumAssets() int {
 	return len(pa.PoolAssets)
 }
 
+// IsActive returns whether swaps against the pool are allowed, which they are
+// unless its future pool governor paused them.
 func (pa Pool) IsActive(ctx sdk.Context) bool {
-	return true
+	return !pa.IsPaused
 }
 
-func (params PoolParams) Validate(poolWeights []PoolAsset) error {
-	if params.ExitFee.IsNegative() {
-		return types.ErrNegativeExitFee
+func (pa Pool) GetFuturePoolGovernor() string {
+	return pa.FuturePoolGovernor
+}
+
+// SetPoolFees sets the swap and exit fees of the pool.
+func (pa *Pool) SetPoolFees(swapFee, exitFee sdk.Dec) error {
+	if err := types.ValidatePoolFees(swapFee, exitFee); err != nil {
+		return err
 	}
+	pa.PoolParams.SwapFee = swapFee
+	pa.PoolParams.ExitFee = exitFee
+	return nil
+}
 
-	if params.ExitFee.GTE(sdk.OneDec()) {
-		return types.ErrTooMuchExitFee
+// SetActive pauses swaps against the pool, or unpauses them.
+func (pa *Pool) SetActive(isActive bool) {
+	pa.IsPaused = !isActive
+}
+
+// ScheduleWeightChange starts a smooth change of the pool's weights, from its
+// current weights to params' target weights, replacing any change in
+// progress. The change starts at blockTime, unless params sets a later start
+// time.
+func (pa *Pool) ScheduleWeightChange(params SmoothWeightChangeParams, blockTime time.Time) error {
+	if params.StartTime.Unix() > 0 && params.StartTime.Before(blockTime) {
+		return fmt.Errorf("weight change start time %s is before the current time %s", params.StartTime, blockTime)
 	}
+	// the target weights are sorted and scaled in place, so they are copied
+	// not to change the caller's. Only their denoms and weights are kept.
+	targetWeights := make([]PoolAsset, len(params.TargetPoolWeights))
+	for i, v := range params.TargetPoolWeights {
+		targetWeights[i] = PoolAsset{
+			Weight: v.Weight,
+			Token:  sdk.Coin{Denom: v.Token.Denom, Amount: sdk.ZeroInt()},
+		}
+	}
+	params.TargetPoolWeights = targetWeights
 
-	if params.SwapFee.IsNegative() {
-		return types.ErrNegativeSwapFee
+	poolParams := pa.PoolParams
+	poolParams.SmoothWeightChangeParams = &params
+	sortedAssets := pa.GetAllPoolAssets()
+	if err := poolParams.Validate(sortedAssets); err != nil {
+		return err
 	}
+	return pa.setInitialPoolParams(poolParams, sortedAssets, blockTime)
+}
+
+func (pa Pool) GetType() swaproutertypes.PoolType {
+	return swaproutertypes.Balancer
+}
 
-	if params.SwapFee.GTE(sdk.OneDec()) {
-		return types.ErrTooMuchSwapFee
+func (params PoolParams) Validate(poolWeights []PoolAsset) error {
+	if err := types.ValidatePoolFees(params.SwapFee, params.ExitFee); err != nil {
+		return err
 	}
 
 	if params.SmoothWeightChangeParams != nil {
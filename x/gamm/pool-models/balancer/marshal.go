@@ -14,6 +14,7 @@ type balancerPoolPretty struct {
 	TotalWeight        sdk.Dec        `json:"total_weight" yaml:"total_weight"`
 	TotalShares        sdk.Coin       `json:"total_shares" yaml:"total_shares"`
 	PoolAssets         []PoolAsset    `json:"pool_assets" yaml:"pool_assets"`
+	IsPaused           bool           `json:"is_paused,omitempty" yaml:"is_paused"`
 }
 
 func (pa Pool) String() string {
@@ -41,6 +42,7 @@ func (pa Pool) MarshalJSON() ([]byte, error) {
 		TotalWeight:        decTotalWeight,
 		TotalShares:        pa.TotalShares,
 		PoolAssets:         pa.PoolAssets,
+		IsPaused:           pa.IsPaused,
 	})
 }
 
@@ -58,6 +60,7 @@ func (pa *Pool) UnmarshalJSON(bz []byte) error {
 	pa.TotalWeight = alias.TotalWeight.RoundInt()
 	pa.TotalShares = alias.TotalShares
 	pa.PoolAssets = alias.PoolAssets
+	pa.IsPaused = alias.IsPaused
 
 	return nil
 }
@@ -1,6 +1,8 @@
 package balancer
 
 import (
+	"errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -8,12 +10,14 @@ import (
 )
 
 const (
-	TypeMsgCreateBalancerPool = "create_balancer_pool"
+	TypeMsgCreateBalancerPool   = "create_balancer_pool"
+	TypeMsgScheduleWeightChange = "schedule_weight_change"
 )
 
 var (
 	_ sdk.Msg             = &MsgCreateBalancerPool{}
 	_ types.CreatePoolMsg = &MsgCreateBalancerPool{}
+	_ sdk.Msg             = &MsgScheduleWeightChange{}
 )
 
 func NewMsgCreateBalancerPool(
@@ -98,3 +102,50 @@ func (msg MsgCreateBalancerPool) CreatePool(ctx sdk.Context, poolID uint64) (typ
 	poolI, err := NewBalancerPool(poolID, *msg.PoolParams, msg.PoolAssets, msg.FuturePoolGovernor, ctx.BlockTime())
 	return &poolI, err
 }
+
+func (msg MsgScheduleWeightChange) Route() string { return types.RouterKey }
+func (msg MsgScheduleWeightChange) Type() string  { return TypeMsgScheduleWeightChange }
+func (msg MsgScheduleWeightChange) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	// the target weights are checked against the pool's assets when the
+	// change is scheduled. Their token amounts are ignored.
+	targetWeights := msg.SmoothWeightChangeParams.TargetPoolWeights
+	if len(targetWeights) < 2 {
+		return types.ErrTooFewPoolAssets
+	}
+	denoms := map[string]bool{}
+	for _, asset := range targetWeights {
+		if err := ValidateUserSpecifiedWeight(asset.Weight); err != nil {
+			return err
+		}
+		if err := sdk.ValidateDenom(asset.Token.Denom); err != nil {
+			return err
+		}
+		if denoms[asset.Token.Denom] {
+			return sdkerrors.Wrapf(types.ErrPoolParamsInvalidDenom, "target weight of %s given twice", asset.Token.Denom)
+		}
+		denoms[asset.Token.Denom] = true
+	}
+
+	if msg.SmoothWeightChangeParams.Duration <= 0 {
+		return errors.New("smooth weight change params must have a positive duration")
+	}
+
+	return nil
+}
+
+func (msg MsgScheduleWeightChange) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgScheduleWeightChange) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
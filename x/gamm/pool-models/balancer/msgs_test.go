@@ -2,6 +2,7 @@ package balancer
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -239,3 +240,102 @@ func TestMsgCreateBalancerPool(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgScheduleWeightChange(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg MsgScheduleWeightChange) MsgScheduleWeightChange) MsgScheduleWeightChange {
+		properMsg := MsgScheduleWeightChange{
+			Sender: addr1,
+			PoolId: 1,
+			SmoothWeightChangeParams: SmoothWeightChangeParams{
+				Duration: time.Hour,
+				TargetPoolWeights: []PoolAsset{
+					{Weight: sdk.NewInt(200), Token: sdk.NewCoin("test", sdk.ZeroInt())},
+					{Weight: sdk.NewInt(100), Token: sdk.NewCoin("test2", sdk.ZeroInt())},
+				},
+			},
+		}
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg MsgScheduleWeightChange) MsgScheduleWeightChange {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), types.RouterKey)
+	require.Equal(t, msg.Type(), "schedule_weight_change")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        MsgScheduleWeightChange
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg MsgScheduleWeightChange) MsgScheduleWeightChange {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg MsgScheduleWeightChange) MsgScheduleWeightChange {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "single target weight",
+			msg: createMsg(func(msg MsgScheduleWeightChange) MsgScheduleWeightChange {
+				msg.SmoothWeightChangeParams.TargetPoolWeights = msg.SmoothWeightChangeParams.TargetPoolWeights[:1]
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "duplicate denom",
+			msg: createMsg(func(msg MsgScheduleWeightChange) MsgScheduleWeightChange {
+				msg.SmoothWeightChangeParams.TargetPoolWeights = []PoolAsset{
+					{Weight: sdk.NewInt(200), Token: sdk.NewCoin("test", sdk.ZeroInt())},
+					{Weight: sdk.NewInt(100), Token: sdk.NewCoin("test", sdk.ZeroInt())},
+				}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero weight",
+			msg: createMsg(func(msg MsgScheduleWeightChange) MsgScheduleWeightChange {
+				msg.SmoothWeightChangeParams.TargetPoolWeights[0].Weight = sdk.ZeroInt()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero duration",
+			msg: createMsg(func(msg MsgScheduleWeightChange) MsgScheduleWeightChange {
+				msg.SmoothWeightChangeParams.Duration = 0
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
@@ -141,9 +141,111 @@ func (m *MsgCreateBalancerPoolResponse) GetPoolID() uint64 {
 	return 0
 }
 
+// ===================== MsgScheduleWeightChange
+// MsgScheduleWeightChange lets the future pool governor of a balancer pool
+// start a smooth change of its weights, replacing any change in progress. The
+// weights change from the pool's current weights.
+type MsgScheduleWeightChange struct {
+	Sender                   string                   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolId                   uint64                   `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	SmoothWeightChangeParams SmoothWeightChangeParams `protobuf:"bytes,3,opt,name=smooth_weight_change_params,json=smoothWeightChangeParams,proto3" json:"smooth_weight_change_params" yaml:"smooth_weight_change_params"`
+}
+
+func (m *MsgScheduleWeightChange) Reset()         { *m = MsgScheduleWeightChange{} }
+func (m *MsgScheduleWeightChange) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleWeightChange) ProtoMessage()    {}
+func (*MsgScheduleWeightChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26dfff9c7e076bd8, []int{2}
+}
+func (m *MsgScheduleWeightChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleWeightChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleWeightChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleWeightChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleWeightChange.Merge(m, src)
+}
+func (m *MsgScheduleWeightChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleWeightChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleWeightChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleWeightChange proto.InternalMessageInfo
+
+func (m *MsgScheduleWeightChange) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgScheduleWeightChange) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *MsgScheduleWeightChange) GetSmoothWeightChangeParams() SmoothWeightChangeParams {
+	if m != nil {
+		return m.SmoothWeightChangeParams
+	}
+	return SmoothWeightChangeParams{}
+}
+
+type MsgScheduleWeightChangeResponse struct {
+}
+
+func (m *MsgScheduleWeightChangeResponse) Reset()         { *m = MsgScheduleWeightChangeResponse{} }
+func (m *MsgScheduleWeightChangeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleWeightChangeResponse) ProtoMessage()    {}
+func (*MsgScheduleWeightChangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26dfff9c7e076bd8, []int{3}
+}
+func (m *MsgScheduleWeightChangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleWeightChangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleWeightChangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleWeightChangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleWeightChangeResponse.Merge(m, src)
+}
+func (m *MsgScheduleWeightChangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleWeightChangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleWeightChangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleWeightChangeResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateBalancerPool)(nil), "osmosis.gamm.v1beta1.MsgCreateBalancerPool")
 	proto.RegisterType((*MsgCreateBalancerPoolResponse)(nil), "osmosis.gamm.v1beta1.MsgCreateBalancerPoolResponse")
+	proto.RegisterType((*MsgScheduleWeightChange)(nil), "osmosis.gamm.v1beta1.MsgScheduleWeightChange")
+	proto.RegisterType((*MsgScheduleWeightChangeResponse)(nil), "osmosis.gamm.v1beta1.MsgScheduleWeightChangeResponse")
 }
 
 func init() {
@@ -151,33 +253,40 @@ func init() {
 }
 
 var fileDescriptor_26dfff9c7e076bd8 = []byte{
-	// 408 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xc1, 0x8a, 0xda, 0x40,
-	0x18, 0xc7, 0x13, 0x95, 0x94, 0x8e, 0xf4, 0xd0, 0xc1, 0x96, 0x60, 0x69, 0x12, 0xd2, 0x8b, 0x6d,
-	0x31, 0x83, 0x5a, 0x28, 0xf4, 0x50, 0x68, 0x6a, 0x29, 0x1e, 0x04, 0x37, 0x27, 0xd9, 0x8b, 0x4c,
-	0xcc, 0x6c, 0x56, 0x48, 0x32, 0x61, 0x26, 0x06, 0xf7, 0xb0, 0xef, 0xb0, 0x4f, 0xb3, 0xcf, 0xe0,
-	0xd1, 0xe3, 0x9e, 0xc2, 0x12, 0xdf, 0xc0, 0x27, 0x58, 0x32, 0x31, 0x8b, 0x87, 0xc8, 0xb2, 0xb7,
-	0xc9, 0x7f, 0x7e, 0xdf, 0xff, 0xff, 0xcd, 0x97, 0x0f, 0x7c, 0xa3, 0x3c, 0xa4, 0x7c, 0xc5, 0x91,
-	0x8f, 0xc3, 0x10, 0xc5, 0x94, 0x06, 0xfd, 0x90, 0x7a, 0x24, 0xe0, 0xc8, 0xc5, 0x01, 0x8e, 0x96,
-	0x84, 0xa1, 0x64, 0x63, 0xc5, 0x8c, 0x26, 0x14, 0x76, 0x8e, 0xac, 0x55, 0xb0, 0x56, 0x3a, 0x70,
-	0x49, 0x82, 0x07, 0xdd, 0x8e, 0x4f, 0x7d, 0x2a, 0x00, 0x54, 0x9c, 0x4a, 0xb6, 0xfb, 0xe3, 0x65,
-	0xdf, 0xea, 0x30, 0xa3, 0x34, 0x28, 0xab, 0xcc, 0xfb, 0x06, 0xf8, 0x30, 0xe5, 0xfe, 0x5f, 0x46,
-	0x70, 0x42, 0xec, 0x93, 0x7b, 0xf8, 0x15, 0x28, 0x9c, 0x44, 0x1e, 0x61, 0xaa, 0x6c, 0xc8, 0xbd,
-	0xb7, 0xf6, 0xfb, 0x43, 0xa6, 0xbf, 0xbb, 0xc1, 0x61, 0xf0, 0xcb, 0x2c, 0x75, 0xd3, 0x39, 0x02,
-	0x70, 0x0e, 0x40, 0x91, 0x37, 0xc3, 0x0c, 0x87, 0x5c, 0x6d, 0x18, 0x72, 0xaf, 0x3d, 0x34, 0xac,
-	0xba, 0xde, 0xad, 0xd9, 0x33, 0x67, 0x7f, 0x3c, 0x64, 0x3a, 0x2c, 0x0d, 0x8b, 0xea, 0x45, 0x2c,
-	0x64, 0xd3, 0x39, 0xf1, 0x82, 0xff, 0x4a, 0xe7, 0x3f, 0x9c, 0x93, 0x84, 0xab, 0x4d, 0xa3, 0xd9,
-	0x6b, 0x0f, 0xf5, 0xf3, 0xce, 0x82, 0xb3, 0x5b, 0xdb, 0x4c, 0x97, 0x9c, 0x93, 0x42, 0x78, 0x01,
-	0x3a, 0x57, 0xeb, 0x64, 0xcd, 0xc8, 0x42, 0x24, 0xf9, 0x34, 0x25, 0x2c, 0xa2, 0x4c, 0x6d, 0x89,
-	0x97, 0xe9, 0x87, 0x4c, 0xff, 0x54, 0x36, 0x52, 0x47, 0x99, 0x0e, 0x2c, 0xe5, 0x22, 0xe1, 0x7f,
-	0x25, 0x8e, 0xc1, 0xe7, 0xda, 0xb9, 0x39, 0x84, 0xc7, 0x34, 0xe2, 0x04, 0x7e, 0x01, 0x6f, 0x84,
-	0xcd, 0xca, 0x13, 0x03, 0x6c, 0xd9, 0x20, 0xcf, 0x74, 0xa5, 0x40, 0x26, 0x63, 0x47, 0x29, 0xae,
-	0x26, 0xde, 0xf0, 0x16, 0x34, 0xa7, 0xdc, 0x87, 0x29, 0x80, 0x35, 0x7f, 0xe0, 0x7b, 0xfd, 0x43,
-	0x6b, 0x63, 0xbb, 0xa3, 0x57, 0xc0, 0x55, 0x8f, 0xf6, 0x7c, 0x9b, 0x6b, 0xf2, 0x2e, 0xd7, 0xe4,
-	0xc7, 0x5c, 0x93, 0xef, 0xf6, 0x9a, 0xb4, 0xdb, 0x6b, 0xd2, 0xc3, 0x5e, 0x93, 0x2e, 0x7f, 0xfb,
-	0xab, 0xe4, 0x7a, 0xed, 0x5a, 0x4b, 0x1a, 0xa2, 0xa3, 0x71, 0x3f, 0xc0, 0x2e, 0xaf, 0x3e, 0x50,
-	0xfa, 0x13, 0x6d, 0xce, 0xaf, 0x9a, 0xab, 0x88, 0xf5, 0x1a, 0x3d, 0x05, 0x00, 0x00, 0xff, 0xff,
-	0x66, 0xb1, 0xa1, 0xc1, 0xee, 0x02, 0x00, 0x00,
+	// 523 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xc1, 0x8a, 0xd3, 0x40,
+	0x18, 0xc7, 0x9b, 0xb6, 0x54, 0x9c, 0x45, 0xc1, 0xa1, 0x6a, 0xe8, 0x62, 0xa6, 0x8e, 0x97, 0xba,
+	0x4b, 0x13, 0xb6, 0xab, 0x08, 0x1e, 0x04, 0xb3, 0x2b, 0xb2, 0x87, 0x42, 0xcd, 0x1e, 0x5c, 0xbc,
+	0x94, 0x49, 0x33, 0x4e, 0x02, 0x49, 0xa6, 0x64, 0xd2, 0xba, 0x82, 0xaf, 0x20, 0x78, 0xf5, 0x45,
+	0x7c, 0x86, 0x3d, 0xee, 0xd1, 0x53, 0x90, 0xf6, 0x0d, 0x72, 0xf5, 0x22, 0x99, 0x24, 0x6b, 0x0e,
+	0x09, 0x4b, 0x6f, 0xd3, 0x6f, 0x7e, 0xdf, 0xff, 0xff, 0xf5, 0xfb, 0x87, 0x01, 0x07, 0x5c, 0x04,
+	0x5c, 0x78, 0xc2, 0x60, 0x24, 0x08, 0x8c, 0x25, 0xe7, 0xfe, 0x38, 0xe0, 0x0e, 0xf5, 0x85, 0x61,
+	0x13, 0x9f, 0x84, 0x0b, 0x1a, 0x19, 0xf1, 0xa5, 0xbe, 0x8c, 0x78, 0xcc, 0x61, 0xbf, 0x60, 0xf5,
+	0x8c, 0xd5, 0xd7, 0x47, 0x36, 0x8d, 0xc9, 0xd1, 0xa0, 0xcf, 0x38, 0xe3, 0x12, 0x30, 0xb2, 0x53,
+	0xce, 0x0e, 0x5e, 0xdc, 0xae, 0x5b, 0x1e, 0x66, 0x9c, 0xfb, 0x79, 0x17, 0xfe, 0xd5, 0x06, 0x0f,
+	0xa7, 0x82, 0x9d, 0x44, 0x94, 0xc4, 0xd4, 0xac, 0xdc, 0xc3, 0xe7, 0xa0, 0x27, 0x68, 0xe8, 0xd0,
+	0x48, 0x55, 0x86, 0xca, 0xe8, 0xae, 0xf9, 0x20, 0x4d, 0xd0, 0xbd, 0xaf, 0x24, 0xf0, 0x5f, 0xe3,
+	0xbc, 0x8e, 0xad, 0x02, 0x80, 0x17, 0x00, 0x64, 0x7e, 0x33, 0x12, 0x91, 0x40, 0xa8, 0xed, 0xa1,
+	0x32, 0xda, 0x9b, 0x0c, 0xf5, 0xba, 0xd9, 0xf5, 0xd9, 0x0d, 0x67, 0x3e, 0x4a, 0x13, 0x04, 0x73,
+	0xc1, 0xac, 0x7b, 0xbe, 0x94, 0x65, 0x6c, 0x55, 0xb4, 0xe0, 0xbb, 0x5c, 0xf9, 0xad, 0x10, 0x34,
+	0x16, 0x6a, 0x67, 0xd8, 0x19, 0xed, 0x4d, 0x50, 0xb3, 0xb2, 0xe4, 0xcc, 0xee, 0x55, 0x82, 0x5a,
+	0x56, 0xa5, 0x11, 0x7e, 0x00, 0xfd, 0xcf, 0xab, 0x78, 0x15, 0xd1, 0xb9, 0x74, 0x62, 0x7c, 0x4d,
+	0xa3, 0x90, 0x47, 0x6a, 0x57, 0xfe, 0x33, 0x94, 0x26, 0x68, 0x3f, 0x1f, 0xa4, 0x8e, 0xc2, 0x16,
+	0xcc, 0xcb, 0x99, 0xc3, 0xfb, 0xb2, 0x78, 0x0a, 0x9e, 0xd4, 0xee, 0xcd, 0xa2, 0x62, 0xc9, 0x43,
+	0x41, 0xe1, 0x33, 0x70, 0x47, 0xca, 0x78, 0x8e, 0x5c, 0x60, 0xd7, 0x04, 0x9b, 0x04, 0xf5, 0x32,
+	0xe4, 0xec, 0xd4, 0xea, 0x65, 0x57, 0x67, 0x0e, 0xfe, 0xde, 0x06, 0x8f, 0xa7, 0x82, 0x9d, 0x2f,
+	0x5c, 0xea, 0xac, 0x7c, 0xfa, 0x91, 0x7a, 0xcc, 0x8d, 0x4f, 0x5c, 0x12, 0x32, 0xba, 0x4b, 0x00,
+	0x87, 0xff, 0xbd, 0xda, 0xd2, 0x0b, 0xa6, 0x09, 0xba, 0x5f, 0xd9, 0xad, 0xe7, 0xe0, 0xd2, 0x13,
+	0xfe, 0x54, 0xc0, 0xbe, 0x08, 0x38, 0x8f, 0xdd, 0xf9, 0x17, 0xe9, 0x37, 0x5f, 0x48, 0xc3, 0x22,
+	0x00, 0xb5, 0x23, 0xf3, 0xd3, 0xeb, 0xb7, 0x7c, 0x2e, 0x1b, 0xab, 0x73, 0x16, 0x69, 0x1e, 0x64,
+	0x4b, 0x4f, 0x13, 0x84, 0x8b, 0x09, 0x9b, 0x0d, 0xb0, 0xa5, 0x8a, 0x06, 0x15, 0xfc, 0x14, 0xa0,
+	0x86, 0x75, 0x94, 0x7b, 0x9d, 0xfc, 0x55, 0x40, 0x67, 0x2a, 0x18, 0x5c, 0x03, 0x58, 0xf3, 0xd5,
+	0x1e, 0xd6, 0x8f, 0x5d, 0x1b, 0xd5, 0xe0, 0x78, 0x07, 0xf8, 0x26, 0xd7, 0x6f, 0xa0, 0x5f, 0x1b,
+	0xd7, 0xb8, 0x51, 0xac, 0x0e, 0x1f, 0xbc, 0xdc, 0x09, 0x2f, 0xdd, 0xcd, 0x8b, 0xab, 0x8d, 0xa6,
+	0x5c, 0x6f, 0x34, 0xe5, 0xcf, 0x46, 0x53, 0x7e, 0x6c, 0xb5, 0xd6, 0xf5, 0x56, 0x6b, 0xfd, 0xde,
+	0x6a, 0xad, 0x4f, 0x6f, 0x98, 0x17, 0xbb, 0x2b, 0x5b, 0x5f, 0xf0, 0xc0, 0x28, 0xa4, 0xc7, 0x3e,
+	0xb1, 0x45, 0xf9, 0xc3, 0x58, 0xbf, 0x32, 0x2e, 0x9b, 0x1f, 0x07, 0xbb, 0x27, 0x1f, 0x84, 0xe3,
+	0x7f, 0x03, 0x00, 0x02, 0x4f, 0x4f, 0x6c, 0xa0, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -193,6 +302,7 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MsgClient interface {
 	CreateBalancerPool(ctx context.Context, in *MsgCreateBalancerPool, opts ...grpc.CallOption) (*MsgCreateBalancerPoolResponse, error)
+	ScheduleWeightChange(ctx context.Context, in *MsgScheduleWeightChange, opts ...grpc.CallOption) (*MsgScheduleWeightChangeResponse, error)
 }
 
 type msgClient struct {
@@ -212,9 +322,19 @@ func (c *msgClient) CreateBalancerPool(ctx context.Context, in *MsgCreateBalance
 	return out, nil
 }
 
+func (c *msgClient) ScheduleWeightChange(ctx context.Context, in *MsgScheduleWeightChange, opts ...grpc.CallOption) (*MsgScheduleWeightChangeResponse, error) {
+	out := new(MsgScheduleWeightChangeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Msg/ScheduleWeightChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	CreateBalancerPool(context.Context, *MsgCreateBalancerPool) (*MsgCreateBalancerPoolResponse, error)
+	ScheduleWeightChange(context.Context, *MsgScheduleWeightChange) (*MsgScheduleWeightChangeResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -224,6 +344,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) CreateBalancerPool(ctx context.Context, req *MsgCreateBalancerPool) (*MsgCreateBalancerPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateBalancerPool not implemented")
 }
+func (*UnimplementedMsgServer) ScheduleWeightChange(ctx context.Context, req *MsgScheduleWeightChange) (*MsgScheduleWeightChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleWeightChange not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -247,6 +370,24 @@ func _Msg_CreateBalancerPool_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ScheduleWeightChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleWeightChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleWeightChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Msg/ScheduleWeightChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleWeightChange(ctx, req.(*MsgScheduleWeightChange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.gamm.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -255,6 +396,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateBalancerPool",
 			Handler:    _Msg_CreateBalancerPool_Handler,
 		},
+		{
+			MethodName: "ScheduleWeightChange",
+			Handler:    _Msg_ScheduleWeightChange_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/gamm/pool-models/balancer/tx.proto",
@@ -351,6 +496,74 @@ func (m *MsgCreateBalancerPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgScheduleWeightChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleWeightChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleWeightChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SmoothWeightChangeParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleWeightChangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleWeightChangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleWeightChangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -401,6 +614,33 @@ func (m *MsgCreateBalancerPoolResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgScheduleWeightChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = m.SmoothWeightChangeParams.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgScheduleWeightChangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -660,6 +900,190 @@ func (m *MsgCreateBalancerPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgScheduleWeightChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleWeightChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleWeightChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SmoothWeightChangeParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SmoothWeightChangeParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScheduleWeightChangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleWeightChangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleWeightChangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,6 +1,10 @@
 package stableswap
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 var (
 	cubeRootTwo, _   = sdk.NewDec(2).ApproxRoot(3)
@@ -188,3 +192,159 @@ func spotPrice(baseReserve, quoteReserve sdk.Dec) sdk.Dec {
 	// no need to divide by a, since a = 1.
 	return solveCfmm(baseReserve, quoteReserve, a)
 }
+
+// The rest of this file generalizes the solidly CFMM to n assets.
+// For reserves x_1, ..., x_n, the invariant is:
+// (x_1 * x_2 * ... * x_n)(x_1^2 + x_2^2 + ... + x_n^2) = k
+// which is exactly xy(x^2 + y^2) = k in the two asset case.
+//
+// When solving for a single reserve x, all other reserves are held fixed, so we
+// summarize them by their product u and their sum of squares v. The invariant then reads:
+// xu(x^2 + v) = k
+// which is strictly increasing in x for positive reserves, and can thus be binary searched.
+//
+// Every reserve passed into these functions is expected to already be scaled by
+// the pool's scaling factors.
+
+const (
+	// maxSolverIterations bounds the number of binary search steps.
+	// sdk.Dec has 18 decimals of precision, so ~60 halvings of a normalized
+	// search interval already exhausts it. The remaining headroom is for growing the upper bound.
+	maxSolverIterations = 256
+)
+
+// cfmmConstantMulti returns k for the given reserves.
+func cfmmConstantMulti(reserves []sdk.Dec) sdk.Dec {
+	product := sdk.OneDec()
+	sumSquares := sdk.ZeroDec()
+	for _, r := range reserves {
+		product = product.Mul(r)
+		sumSquares = sumSquares.Add(r.Mul(r))
+	}
+	return product.Mul(sumSquares)
+}
+
+// cfmmConstantWithRest returns k = xu(x^2 + v).
+func cfmmConstantWithRest(x, u, v sdk.Dec) sdk.Dec {
+	return x.Mul(u).Mul(x.Mul(x).Add(v))
+}
+
+// normalizeReserves divides every reserve by the mean reserve, so that the values the
+// CFMM is evaluated on are close to 1 for a balanced pool. As the invariant is homogeneous,
+// solving on normalized reserves and multiplying the result back by the mean is exact,
+// while keeping the product of reserves within sdk.Dec's range and precision.
+func normalizeReserves(reserves []sdk.Dec) (normalized []sdk.Dec, normalizer sdk.Dec) {
+	normalizer = sdk.ZeroDec()
+	for _, r := range reserves {
+		normalizer = normalizer.Add(r)
+	}
+	normalizer = normalizer.QuoInt64(int64(len(reserves)))
+
+	normalized = make([]sdk.Dec, len(reserves))
+	for i, r := range reserves {
+		normalized[i] = r.Quo(normalizer)
+	}
+	return normalized, normalizer
+}
+
+// solveCFMMMulti returns the new reserve of the asset at index outIdx, that keeps k constant,
+// after the reserve of the asset at index inIdx changes by inDelta.
+// inDelta is positive when tokens are added to the pool, and negative when they are removed.
+// The result is rounded up, which is in the pool's favor in both directions:
+// fewer tokens leave the pool if inDelta > 0, and more tokens are required to enter it if inDelta < 0.
+func solveCFMMMulti(reserves []sdk.Dec, inIdx int, outIdx int, inDelta sdk.Dec) (sdk.Dec, error) {
+	if inIdx == outIdx {
+		return sdk.Dec{}, errors.New("cannot solve the CFMM for the same asset in and out")
+	}
+	for _, r := range reserves {
+		if !r.IsPositive() {
+			return sdk.Dec{}, errors.New("stableswap reserves must all be positive")
+		}
+	}
+
+	normalized, normalizer := normalizeReserves(reserves)
+	k := cfmmConstantMulti(normalized)
+
+	normInDelta := inDelta.Quo(normalizer)
+	newInReserve := normalized[inIdx].Add(normInDelta)
+	if !newInReserve.IsPositive() {
+		return sdk.Dec{}, errors.New("cannot remove the entire reserve of an asset from the pool")
+	}
+
+	// u = product of all reserves other than out, v = sum of their squares.
+	u := sdk.OneDec()
+	v := sdk.ZeroDec()
+	for i, r := range normalized {
+		if i == outIdx {
+			continue
+		}
+		if i == inIdx {
+			r = newInReserve
+		}
+		u = u.Mul(r)
+		v = v.Add(r.Mul(r))
+	}
+
+	// Bound the search. Adding tokens in decreases the out reserve, removing them increases it.
+	low, high := sdk.ZeroDec(), normalized[outIdx]
+	if !normInDelta.IsPositive() {
+		low = normalized[outIdx]
+		high = low.MulInt64(2)
+		iterations := 0
+		for cfmmConstantWithRest(high, u, v).LT(k) {
+			iterations++
+			if iterations > maxSolverIterations {
+				return sdk.Dec{}, errors.New("stableswap solver could not bound the new reserve")
+			}
+			high = high.MulInt64(2)
+		}
+	}
+
+	for i := 0; i < maxSolverIterations; i++ {
+		mid := low.Add(high).QuoInt64(2)
+		if mid.Equal(low) || mid.Equal(high) {
+			break
+		}
+		if cfmmConstantWithRest(mid, u, v).GT(k) {
+			high = mid
+		} else {
+			low = mid
+		}
+	}
+
+	return high.Mul(normalizer), nil
+}
+
+// spotPriceMulti returns the spot price of the base asset in terms of the quote asset.
+// This is the marginal rate of substitution of the invariant, i.e. (dk/d_base) / (dk/d_quote).
+// With P the product of all reserves and S the sum of their squares, dk/dx_i = P(S/x_i + 2x_i),
+// so the common factor P cancels out.
+func spotPriceMulti(reserves []sdk.Dec, baseIdx int, quoteIdx int) sdk.Dec {
+	normalized, _ := normalizeReserves(reserves)
+	sumSquares := sdk.ZeroDec()
+	for _, r := range normalized {
+		sumSquares = sumSquares.Add(r.Mul(r))
+	}
+
+	base, quote := normalized[baseIdx], normalized[quoteIdx]
+	dBase := sumSquares.Quo(base).Add(base.MulInt64(2))
+	dQuote := sumSquares.Quo(quote).Add(quote.MulInt64(2))
+	return dBase.Quo(dQuote)
+}
+
+// liquidityGrowth returns L'/L, where L = k^(1 / (n + 2)) is the pool's liquidity.
+// The invariant is homogeneous of degree n + 2, so L scales linearly in the reserves,
+// which makes LP shares proportional to it.
+func liquidityGrowth(reservesBefore []sdk.Dec, reservesAfter []sdk.Dec) (sdk.Dec, error) {
+	if len(reservesBefore) != len(reservesAfter) {
+		return sdk.Dec{}, errors.New("reserves before and after must have the same length")
+	}
+	normalizedBefore, normalizer := normalizeReserves(reservesBefore)
+	normalizedAfter := make([]sdk.Dec, len(reservesAfter))
+	for i, r := range reservesAfter {
+		normalizedAfter[i] = r.Quo(normalizer)
+	}
+
+	kRatio := cfmmConstantMulti(normalizedAfter).Quo(cfmmConstantMulti(normalizedBefore))
+	return kRatio.ApproxRoot(uint64(len(reservesBefore) + 2))
+}
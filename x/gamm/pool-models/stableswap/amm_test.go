@@ -51,3 +51,78 @@ func TestCFMMInvariant(t *testing.T) {
 		decApproxEq(t, k0, k1, kErrTolerance)
 	}
 }
+
+func TestCFMMMultiInvariant(t *testing.T) {
+	kErrTolerance := sdk.MustNewDecFromStr("0.000001")
+
+	tests := map[string]struct {
+		reserves []sdk.Dec
+		inIdx    int
+		outIdx   int
+		inDelta  sdk.Dec
+	}{
+		"two assets, small swap in": {
+			reserves: []sdk.Dec{sdk.NewDec(100), sdk.NewDec(100)},
+			inIdx:    0, outIdx: 1,
+			inDelta: sdk.NewDec(1),
+		},
+		"two assets, swap in larger than reserves": {
+			reserves: []sdk.Dec{sdk.NewDec(100), sdk.NewDec(100)},
+			inIdx:    1, outIdx: 0,
+			inDelta: sdk.NewDec(1000),
+		},
+		"two assets, swap out": {
+			reserves: []sdk.Dec{sdk.NewDec(1000000), sdk.NewDec(2000000)},
+			inIdx:    0, outIdx: 1,
+			inDelta: sdk.NewDec(-500000),
+		},
+		"three assets, large reserves": {
+			reserves: []sdk.Dec{sdk.NewDec(1000000000000), sdk.NewDec(900000000000), sdk.NewDec(1100000000000)},
+			inIdx:    2, outIdx: 0,
+			inDelta: sdk.NewDec(50000000000),
+		},
+		"eight assets": {
+			reserves: []sdk.Dec{
+				sdk.NewDec(1000), sdk.NewDec(2000), sdk.NewDec(3000), sdk.NewDec(4000),
+				sdk.NewDec(5000), sdk.NewDec(6000), sdk.NewDec(7000), sdk.NewDec(8000),
+			},
+			inIdx: 7, outIdx: 3,
+			inDelta: sdk.NewDec(-100),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			newOutReserve, err := solveCFMMMulti(test.reserves, test.inIdx, test.outIdx, test.inDelta)
+			require.NoError(t, err)
+
+			newReserves := make([]sdk.Dec, len(test.reserves))
+			copy(newReserves, test.reserves)
+			newReserves[test.inIdx] = newReserves[test.inIdx].Add(test.inDelta)
+			newReserves[test.outIdx] = newOutReserve
+
+			_, normalizer := normalizeReserves(test.reserves)
+			k0 := cfmmConstantMulti(divideReserves(test.reserves, normalizer))
+			k1 := cfmmConstantMulti(divideReserves(newReserves, normalizer))
+			decApproxEq(t, sdk.OneDec(), k1.Quo(k0), kErrTolerance)
+		})
+	}
+}
+
+func TestCFMMMultiMatchesTwoAssetSolver(t *testing.T) {
+	xReserve, yReserve, yIn := sdk.NewDec(100), sdk.NewDec(100), sdk.NewDec(1)
+
+	expectedXOut := solveCfmm(xReserve, yReserve, yIn)
+	newXReserve, err := solveCFMMMulti([]sdk.Dec{xReserve, yReserve}, 1, 0, yIn)
+	require.NoError(t, err)
+
+	decApproxEq(t, expectedXOut, xReserve.Sub(newXReserve), sdk.MustNewDecFromStr("0.0000001"))
+}
+
+func divideReserves(reserves []sdk.Dec, divisor sdk.Dec) []sdk.Dec {
+	result := make([]sdk.Dec, len(reserves))
+	for i, r := range reserves {
+		result[i] = r.Quo(divisor)
+	}
+	return result
+}
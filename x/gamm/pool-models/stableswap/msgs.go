@@ -20,12 +20,14 @@ func NewMsgCreateStableswapPool(
 	sender sdk.AccAddress,
 	poolParams PoolParams,
 	initialLiquidity sdk.Coins,
+	scalingFactors []uint64,
 	futurePoolGovernor string,
 ) MsgCreateStableswapPool {
 	return MsgCreateStableswapPool{
 		Sender:               sender.String(),
 		PoolParams:           &poolParams,
 		InitialPoolLiquidity: initialLiquidity,
+		ScalingFactors:       scalingFactors,
 		FuturePoolGovernor:   futurePoolGovernor,
 	}
 }
@@ -38,12 +40,32 @@ func (msg MsgCreateStableswapPool) ValidateBasic() error {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
 	}
 
+	if msg.PoolParams == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pool params must be provided")
+	}
 	err = msg.PoolParams.Validate()
 	if err != nil {
 		return err
 	}
 
-	// TODO: Add validation for pool initial liquidity
+	err = msg.InitialPoolLiquidity.Validate()
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	numAssets := msg.InitialPoolLiquidity.Len()
+	if numAssets < types.MinPoolAssets {
+		return types.ErrTooFewPoolAssets
+	}
+	if numAssets > types.MaxPoolAssets {
+		return sdkerrors.Wrapf(types.ErrTooManyPoolAssets, "pool has too many assets (%d)", numAssets)
+	}
+
+	// scaling factors are optional, and default to 1 for every asset.
+	if len(msg.ScalingFactors) != 0 {
+		if err = validateScalingFactors(msg.ScalingFactors, numAssets); err != nil {
+			return err
+		}
+	}
 
 	// validation for future owner
 	if err = types.ValidateFutureGovernor(msg.FuturePoolGovernor); err != nil {
@@ -84,5 +106,10 @@ func (msg MsgCreateStableswapPool) InitialLiquidity() sdk.Coins {
 }
 
 func (msg MsgCreateStableswapPool) CreatePool(ctx sdk.Context, poolID uint64) (types.PoolI, error) {
-	return &Pool{}, types.ErrNotImplemented
+	stableswapPool, err := NewStableswapPool(poolID, *msg.PoolParams, msg.InitialPoolLiquidity, msg.ScalingFactors, msg.FuturePoolGovernor)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stableswapPool, nil
 }
@@ -7,11 +7,47 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v7/x/swaprouter/types"
 )
 
-var _ types.PoolI = &Pool{}
+var (
+	_ types.PoolI                 = &Pool{}
+	_ types.PoolGovernorExtension = &Pool{}
+)
+
+// NewStableswapPool returns a stableswap pool
+// Invariants that are assumed to be satisfied and not checked:
+// (This is handled in ValidateBasic)
+// * 2 <= len(initialLiquidity) <= 8
+// * FutureGovernor is valid
+// * poolID doesn't already exist
+func NewStableswapPool(poolId uint64, stableswapPoolParams PoolParams, initialLiquidity sdk.Coins, scalingFactors []uint64, futureGovernor string) (Pool, error) {
+	if err := stableswapPoolParams.Validate(); err != nil {
+		return Pool{}, err
+	}
+
+	if len(scalingFactors) == 0 {
+		scalingFactors = defaultScalingFactors(len(initialLiquidity))
+	}
+	if err := validateScalingFactors(scalingFactors, len(initialLiquidity)); err != nil {
+		return Pool{}, err
+	}
+
+	pool := &Pool{
+		Address:            types.NewPoolAddress(poolId).String(),
+		Id:                 poolId,
+		PoolParams:         stableswapPoolParams,
+		TotalShares:        sdk.NewCoin(types.GetPoolShareDenom(poolId), types.InitPoolSharesSupply),
+		PoolLiquidity:      initialLiquidity.Sort(),
+		ScalingFactor:      scalingFactors,
+		FuturePoolGovernor: futureGovernor,
+	}
+
+	return *pool, nil
+}
 
 func (pa Pool) GetAddress() sdk.AccAddress {
 	addr, err := sdk.AccAddressFromBech32(pa.Address)
@@ -41,8 +77,33 @@ func (pa Pool) GetExitFee(ctx sdk.Context) sdk.Dec {
 	return pa.PoolParams.ExitFee
 }
 
+// IsActive returns whether swaps against the pool are allowed, which they are
+// unless its future pool governor paused them.
 func (pa Pool) IsActive(ctx sdk.Context) bool {
-	return true
+	return !pa.IsPaused
+}
+
+func (pa Pool) GetType() swaproutertypes.PoolType {
+	return swaproutertypes.Stableswap
+}
+
+func (pa Pool) GetFuturePoolGovernor() string {
+	return pa.FuturePoolGovernor
+}
+
+// SetPoolFees sets the swap and exit fees of the pool.
+func (pa *Pool) SetPoolFees(swapFee, exitFee sdk.Dec) error {
+	if err := types.ValidatePoolFees(swapFee, exitFee); err != nil {
+		return err
+	}
+	pa.PoolParams.SwapFee = swapFee
+	pa.PoolParams.ExitFee = exitFee
+	return nil
+}
+
+// SetActive pauses swaps against the pool, or unpauses them.
+func (pa *Pool) SetActive(isActive bool) {
+	pa.IsPaused = !isActive
 }
 
 // Returns the coins in the pool owned by all LP shareholders
@@ -50,69 +111,376 @@ func (pa Pool) GetTotalPoolLiquidity(ctx sdk.Context) sdk.Coins {
 	return pa.PoolLiquidity
 }
 
+// GetPoolDenoms returns the denoms of the pool's liquidity, which is kept
+// sorted.
+func (pa Pool) GetPoolDenoms(ctx sdk.Context) []string {
+	denoms := make([]string, len(pa.PoolLiquidity))
+	for i, coin := range pa.PoolLiquidity {
+		denoms[i] = coin.Denom
+	}
+	return denoms
+}
+
 func (pa Pool) GetTotalShares() sdk.Int {
 	return pa.TotalShares.Amount
 }
 
-// returns pool liquidity of the provided denoms, in the same order the denoms were provided in
-func (pa Pool) getPoolAmts(denoms ...string) ([]sdk.Int, error) {
-	result := make([]sdk.Int, len(denoms))
-	poolLiquidity := pa.PoolLiquidity
-	for i, d := range denoms {
-		amt := poolLiquidity.AmountOf(d)
-		if amt.IsZero() {
-			return []sdk.Int{}, fmt.Errorf("denom %s does not exist in pool", d)
+// GetScalingFactors returns the scaling factors of the pool's assets,
+// in the same order as GetTotalPoolLiquidity.
+func (pa Pool) GetScalingFactors() []uint64 {
+	return pa.ScalingFactor
+}
+
+// getDenomIndex returns the index of denom in the pool's liquidity, which is also
+// the index of its scaling factor.
+func (pa Pool) getDenomIndex(denom string) (int, error) {
+	for i, coin := range pa.PoolLiquidity {
+		if coin.Denom == denom {
+			return i, nil
 		}
-		result[i] = amt
 	}
-	return result, nil
+	return -1, fmt.Errorf("denom %s does not exist in pool", denom)
+}
+
+// scaledReserves returns every reserve of the pool, divided by its scaling factor.
+func (pa Pool) scaledReserves() ([]sdk.Dec, error) {
+	if len(pa.ScalingFactor) != len(pa.PoolLiquidity) {
+		return nil, types.ErrInvalidScalingFactors
+	}
+	reserves := make([]sdk.Dec, len(pa.PoolLiquidity))
+	for i, coin := range pa.PoolLiquidity {
+		reserves[i] = pa.scaleAmount(coin.Amount.ToDec(), i)
+	}
+	return reserves, nil
+}
+
+// scaleAmount converts an amount of the i-th asset into the units the CFMM is evaluated in.
+func (pa Pool) scaleAmount(amount sdk.Dec, i int) sdk.Dec {
+	return amount.QuoInt(sdk.NewIntFromUint64(pa.ScalingFactor[i]))
+}
+
+// descaleAmount is the inverse of scaleAmount.
+func (pa Pool) descaleAmount(amount sdk.Dec, i int) sdk.Dec {
+	return amount.MulInt(sdk.NewIntFromUint64(pa.ScalingFactor[i]))
+}
+
+// parseSwapDenoms checks that tokensA is a single coin of a pool asset different from tokenBDenom,
+// and returns the indexes of both assets.
+func (pa Pool) parseSwapDenoms(tokensA sdk.Coins, tokenBDenom string) (tokenA sdk.Coin, aIdx int, bIdx int, err error) {
+	if tokensA.Len() != 1 {
+		return sdk.Coin{}, -1, -1, errors.New("stableswap pools only support swapping a single token at a time")
+	}
+	tokenA = tokensA[0]
+	if tokenA.Denom == tokenBDenom {
+		return sdk.Coin{}, -1, -1, errors.New("cannot trade same denomination in and out")
+	}
+	aIdx, err = pa.getDenomIndex(tokenA.Denom)
+	if err != nil {
+		return sdk.Coin{}, -1, -1, err
+	}
+	bIdx, err = pa.getDenomIndex(tokenBDenom)
+	if err != nil {
+		return sdk.Coin{}, -1, -1, err
+	}
+	return tokenA, aIdx, bIdx, nil
 }
 
-// These should all get moved to amm.go
+// CalcOutAmtGivenIn calculates tokens to be swapped out given the provided amount,
+// with the swap fee deducted from the input, by solving the CFMM for the out reserve.
 func (pa Pool) CalcOutAmtGivenIn(ctx sdk.Context, tokenIn sdk.Coins, tokenOutDenom string, swapFee sdk.Dec) (tokenOut sdk.DecCoin, err error) {
-	if tokenIn.Len() != 1 {
-		return sdk.DecCoin{}, errors.New("asdf")
+	coinIn, inIdx, outIdx, err := pa.parseSwapDenoms(tokenIn, tokenOutDenom)
+	if err != nil {
+		return sdk.DecCoin{}, err
 	}
-	reserves, err := pa.getPoolAmts(tokenIn[0].Denom, tokenOutDenom)
+	reserves, err := pa.scaledReserves()
 	if err != nil {
 		return sdk.DecCoin{}, err
 	}
-	// document which is x vs y
-	outAmt := solveCfmm(reserves[1].ToDec(), reserves[0].ToDec(), tokenIn[0].Amount.ToDec())
-	return sdk.DecCoin{Denom: tokenOutDenom, Amount: outAmt}, nil
+
+	tokenInAfterFee := coinIn.Amount.ToDec().Mul(sdk.OneDec().Sub(swapFee))
+	newOutReserve, err := solveCFMMMulti(reserves, inIdx, outIdx, pa.scaleAmount(tokenInAfterFee, inIdx))
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	outAmt := pa.descaleAmount(reserves[outIdx].Sub(newOutReserve), outIdx)
+	if outAmt.IsNegative() {
+		outAmt = sdk.ZeroDec()
+	}
+	return sdk.NewDecCoinFromDec(tokenOutDenom, outAmt), nil
 }
 
+// SwapOutAmtGivenIn is a mutative method for CalcOutAmtGivenIn, which includes the actual swap.
 func (pa *Pool) SwapOutAmtGivenIn(ctx sdk.Context, tokenIn sdk.Coins, tokenOutDenom string, swapFee sdk.Dec) (tokenOut sdk.Coin, err error) {
-	return sdk.Coin{}, types.ErrNotImplemented
+	tokenOutDecCoin, err := pa.CalcOutAmtGivenIn(ctx, tokenIn, tokenOutDenom, swapFee)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	tokenOut, _ = tokenOutDecCoin.TruncateDecimal()
+	if !tokenOut.Amount.IsPositive() {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "token amount must be positive")
+	}
+
+	if err := pa.applySwap(tokenIn, sdk.Coins{tokenOut}); err != nil {
+		return sdk.Coin{}, err
+	}
+	return tokenOut, nil
 }
 
+// CalcInAmtGivenOut calculates the tokens to be provided, swap fee included,
+// to get the provided tokenOut out of the pool.
 func (pa Pool) CalcInAmtGivenOut(ctx sdk.Context, tokenOut sdk.Coins, tokenInDenom string, swapFee sdk.Dec) (tokenIn sdk.DecCoin, err error) {
-	return sdk.DecCoin{}, types.ErrNotImplemented
+	coinOut, outIdx, inIdx, err := pa.parseSwapDenoms(tokenOut, tokenInDenom)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+	reserves, err := pa.scaledReserves()
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	if coinOut.Amount.GTE(pa.PoolLiquidity[outIdx].Amount) {
+		return sdk.DecCoin{}, types.ErrTooManyTokensOut
+	}
+
+	newInReserve, err := solveCFMMMulti(reserves, outIdx, inIdx, pa.scaleAmount(coinOut.Amount.ToDec(), outIdx).Neg())
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	// As in balancer, the swap fee is deducted from the input,
+	// so the input that goes through the invariant is (1 - swap fee) * trade input.
+	inAmtBeforeFee := pa.descaleAmount(newInReserve.Sub(reserves[inIdx]), inIdx)
+	// Round up, so that the pool never receives less than what the invariant requires.
+	inAmt := inAmtBeforeFee.Quo(sdk.OneDec().Sub(swapFee)).Ceil()
+	return sdk.NewDecCoinFromDec(tokenInDenom, inAmt), nil
 }
 
+// SwapInAmtGivenOut is a mutative method for CalcInAmtGivenOut, which includes the actual swap.
 func (pa *Pool) SwapInAmtGivenOut(ctx sdk.Context, tokenOut sdk.Coins, tokenInDenom string, swapFee sdk.Dec) (tokenIn sdk.Coin, err error) {
-	return sdk.Coin{}, types.ErrNotImplemented
+	tokenInDecCoin, err := pa.CalcInAmtGivenOut(ctx, tokenOut, tokenInDenom, swapFee)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	// CalcInAmtGivenOut already rounds up, so this doesn't truncate anything.
+	tokenIn, _ = tokenInDecCoin.TruncateDecimal()
+	if !tokenIn.Amount.IsPositive() {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "token amount must be positive")
+	}
+
+	if err := pa.applySwap(sdk.Coins{tokenIn}, tokenOut); err != nil {
+		return sdk.Coin{}, err
+	}
+	return tokenIn, nil
 }
 
+// applySwap updates the pool liquidity for a swap of tokensIn for tokensOut.
+// Every pool asset must keep a positive balance, as the scaling factors are indexed by the pool liquidity.
+func (pa *Pool) applySwap(tokensIn sdk.Coins, tokensOut sdk.Coins) error {
+	if tokensIn.Len() != 1 || tokensOut.Len() != 1 {
+		return errors.New("stableswap pools only support swapping a single token at a time")
+	}
+	if !tokensIn.DenomsSubsetOf(pa.PoolLiquidity) || !tokensOut.DenomsSubsetOf(pa.PoolLiquidity) {
+		return errors.New("one of the provided denoms does not exist in pool")
+	}
+	if tokensOut[0].Amount.GTE(pa.PoolLiquidity.AmountOf(tokensOut[0].Denom)) {
+		return types.ErrTooManyTokensOut
+	}
+
+	pa.PoolLiquidity = pa.PoolLiquidity.Add(tokensIn...).Sub(tokensOut)
+	return nil
+}
+
+// SpotPrice returns the spot price of the base asset in terms of the quote asset.
+// This is the marginal price of the CFMM at the current reserves, converted back
+// from scaled units into the assets' own units.
 func (pa Pool) SpotPrice(ctx sdk.Context, baseAssetDenom string, quoteAssetDenom string) (sdk.Dec, error) {
-	return sdk.Dec{}, types.ErrNotImplemented
+	baseIdx, err := pa.getDenomIndex(baseAssetDenom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	quoteIdx, err := pa.getDenomIndex(quoteAssetDenom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	reserves, err := pa.scaledReserves()
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	// One unit of quote is 1/scaling_quote scaled units, which are worth
+	// scaledPrice * scaling_base / scaling_quote units of base.
+	scaledPrice := spotPriceMulti(reserves, quoteIdx, baseIdx)
+	price := pa.descaleAmount(pa.scaleAmount(scaledPrice, quoteIdx), baseIdx)
+	ratio := (price.Mul(types.SigFigs).RoundInt()).ToDec().Quo(types.SigFigs)
+	return ratio, nil
 }
 
+// calcSingleAssetJoin returns the number of shares minted for adding tokenIn to a pool with the
+// given (unscaled) liquidity and total shares. A swap fee is charged on the portion of tokenIn
+// that isn't already at the pool's ratio, i.e. on (1 - tokenIn's share of the pool's scaled reserves).
+func (pa Pool) calcSingleAssetJoin(tokenIn sdk.Coin, swapFee sdk.Dec, poolLiquidity sdk.Coins, totalShares sdk.Int) (sdk.Int, error) {
+	idx, err := pa.getDenomIndex(tokenIn.Denom)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	reservesBefore := make([]sdk.Dec, len(poolLiquidity))
+	totalReserves := sdk.ZeroDec()
+	for i, coin := range poolLiquidity {
+		reservesBefore[i] = pa.scaleAmount(coin.Amount.ToDec(), i)
+		totalReserves = totalReserves.Add(reservesBefore[i])
+	}
+
+	assetRatio := reservesBefore[idx].Quo(totalReserves)
+	effectiveSwapFee := sdk.OneDec().Sub(assetRatio).Mul(swapFee)
+	tokenInAfterFee := tokenIn.Amount.ToDec().Mul(sdk.OneDec().Sub(effectiveSwapFee))
+
+	reservesAfter := make([]sdk.Dec, len(reservesBefore))
+	copy(reservesAfter, reservesBefore)
+	reservesAfter[idx] = reservesAfter[idx].Add(pa.scaleAmount(tokenInAfterFee, idx))
+
+	growth, err := liquidityGrowth(reservesBefore, reservesAfter)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	return growth.Sub(sdk.OneDec()).MulInt(totalShares).TruncateInt(), nil
+}
+
+// maximalExactRatioJoin returns the number of shares that can be minted by joining tokensIn
+// at the pool's current ratio, along with the coins of tokensIn that do not fit that ratio.
+func (pa Pool) maximalExactRatioJoin(tokensIn sdk.Coins) (numShares sdk.Int, remCoins sdk.Coins, err error) {
+	minShareRatio := sdk.MaxSortableDec
+	for _, coin := range tokensIn {
+		shareRatio := coin.Amount.ToDec().QuoInt(pa.PoolLiquidity.AmountOf(coin.Denom))
+		if shareRatio.LT(minShareRatio) {
+			minShareRatio = shareRatio
+		}
+	}
+	if minShareRatio.Equal(sdk.MaxSortableDec) {
+		return sdk.Int{}, sdk.Coins{}, errors.New("unexpected error in stableswap maximalExactRatioJoin")
+	}
+
+	numShares = minShareRatio.MulInt(pa.GetTotalShares()).TruncateInt()
+	remCoins = sdk.Coins{}
+	for _, coin := range tokensIn {
+		usedAmount := minShareRatio.MulInt(pa.PoolLiquidity.AmountOf(coin.Denom)).Ceil().TruncateInt()
+		if remAmount := coin.Amount.Sub(usedAmount); remAmount.IsPositive() {
+			remCoins = remCoins.Add(sdk.NewCoin(coin.Denom, remAmount))
+		}
+	}
+	return numShares, remCoins, nil
+}
+
+// CalcJoinPoolShares returns how many shares JoinPool would mint for tokensIn.
+// Joins with all of the pool's assets first add as much as possible at the pool's ratio.
+// Any other coins, including the remainder of such a join, are added one asset at a time.
 func (pa Pool) CalcJoinPoolShares(ctx sdk.Context, tokensIn sdk.Coins, swapFee sdk.Dec) (numShares sdk.Int, newLiquidity sdk.Coins, err error) {
-	return sdk.Int{}, sdk.Coins{}, types.ErrNotImplemented
+	if tokensIn.Empty() {
+		return sdk.ZeroInt(), sdk.Coins{}, errors.New("no tokens provided to join the pool")
+	}
+	if !tokensIn.DenomsSubsetOf(pa.PoolLiquidity) {
+		return sdk.ZeroInt(), sdk.Coins{}, errors.New("attempted joining pool with assets that do not exist in pool")
+	}
+
+	numShares = sdk.ZeroInt()
+	totalShares := pa.GetTotalShares()
+	poolLiquidity := pa.PoolLiquidity
+	remCoins := tokensIn
+
+	if tokensIn.Len() == pa.PoolLiquidity.Len() {
+		numShares, remCoins, err = pa.maximalExactRatioJoin(tokensIn)
+		if err != nil {
+			return sdk.ZeroInt(), sdk.Coins{}, err
+		}
+		joined := tokensIn.Sub(remCoins)
+		poolLiquidity = poolLiquidity.Add(joined...)
+		totalShares = totalShares.Add(numShares)
+	}
+
+	for _, coin := range remCoins {
+		newShares, err := pa.calcSingleAssetJoin(coin, swapFee, poolLiquidity, totalShares)
+		if err != nil {
+			return sdk.ZeroInt(), sdk.Coins{}, err
+		}
+		poolLiquidity = poolLiquidity.Add(coin)
+		totalShares = totalShares.Add(newShares)
+		numShares = numShares.Add(newShares)
+	}
+
+	return numShares, tokensIn, nil
 }
 
+// JoinPool joins the pool with all of tokensIn, and updates the pool's liquidity and total shares.
 func (pa *Pool) JoinPool(ctx sdk.Context, tokensIn sdk.Coins, swapFee sdk.Dec) (numShares sdk.Int, err error) {
-	return sdk.Int{}, types.ErrNotImplemented
+	numShares, newLiquidity, err := pa.CalcJoinPoolShares(ctx, tokensIn, swapFee)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	pa.PoolLiquidity = pa.PoolLiquidity.Add(newLiquidity...)
+	pa.TotalShares.Amount = pa.TotalShares.Amount.Add(numShares)
+	return numShares, nil
 }
 
+// ExitPool exits numShares from the pool, returning an equal fraction of every asset
+// net of the exit fee, and updates the pool's liquidity and total shares.
 func (pa *Pool) ExitPool(ctx sdk.Context, numShares sdk.Int, exitFee sdk.Dec) (exitedCoins sdk.Coins, err error) {
-	return sdk.Coins{}, types.ErrNotImplemented
+	exitedCoins, err = pa.CalcExitPoolShares(ctx, numShares, exitFee)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
+	pa.PoolLiquidity = pa.PoolLiquidity.Sub(exitedCoins)
+	pa.TotalShares.Amount = pa.TotalShares.Amount.Sub(numShares)
+	return exitedCoins, nil
 }
 
+// CalcExitPoolShares returns the coins ExitPool would return for numShares.
 func (pa Pool) CalcExitPoolShares(ctx sdk.Context, numShares sdk.Int, exitFee sdk.Dec) (exitedCoins sdk.Coins, err error) {
-	return sdk.Coins{}, types.ErrNotImplemented
+	totalShares := pa.GetTotalShares()
+	if numShares.GTE(totalShares) {
+		return sdk.Coins{}, errors.New("too many shares out")
+	}
+
+	refundedShares := numShares
+	if !exitFee.IsZero() {
+		// numShares * (1 - exit fee)
+		refundedShares = sdk.OneDec().Sub(exitFee).MulInt(numShares).TruncateInt()
+	}
+
+	shareOutRatio := refundedShares.ToDec().QuoInt(totalShares)
+	exitedCoins = sdk.Coins{}
+	for _, asset := range pa.PoolLiquidity {
+		exitAmt := shareOutRatio.MulInt(asset.Amount).TruncateInt()
+		if !exitAmt.IsPositive() {
+			continue
+		}
+		exitedCoins = exitedCoins.Add(sdk.NewCoin(asset.Denom, exitAmt))
+	}
+	return exitedCoins, nil
 }
 
 // no-op for stableswap
 func (pa *Pool) PokePool(blockTime time.Time) {}
+
+func defaultScalingFactors(numAssets int) []uint64 {
+	scalingFactors := make([]uint64, numAssets)
+	for i := range scalingFactors {
+		scalingFactors[i] = 1
+	}
+	return scalingFactors
+}
+
+func validateScalingFactors(scalingFactors []uint64, numAssets int) error {
+	if len(scalingFactors) != numAssets {
+		return sdkerrors.Wrapf(types.ErrInvalidScalingFactors,
+			"got %d scaling factors for %d assets", len(scalingFactors), numAssets)
+	}
+	for _, scalingFactor := range scalingFactors {
+		if scalingFactor == 0 {
+			return sdkerrors.Wrap(types.ErrInvalidScalingFactors, "scaling factors must be positive")
+		}
+	}
+	return nil
+}
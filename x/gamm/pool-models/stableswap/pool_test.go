@@ -0,0 +1,210 @@
+package stableswap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+var (
+	defaultSwapFee    = sdk.MustNewDecFromStr("0.003")
+	defaultExitFee    = sdk.ZeroDec()
+	defaultPoolId     = uint64(1)
+	defaultPoolParams = PoolParams{
+		SwapFee: defaultSwapFee,
+		ExitFee: defaultExitFee,
+	}
+	defaultFutureGovernor = ""
+)
+
+func newTestPool(t *testing.T, liquidity sdk.Coins, scalingFactors []uint64) Pool {
+	pool, err := NewStableswapPool(defaultPoolId, defaultPoolParams, liquidity, scalingFactors, defaultFutureGovernor)
+	require.NoError(t, err)
+	return pool
+}
+
+func TestNewStableswapPool(t *testing.T) {
+	liquidity := sdk.NewCoins(sdk.NewInt64Coin("bar", 1000000), sdk.NewInt64Coin("foo", 1000000))
+
+	tests := map[string]struct {
+		scalingFactors         []uint64
+		expectedScalingFactors []uint64
+		expectErr              bool
+	}{
+		"default scaling factors": {
+			scalingFactors:         nil,
+			expectedScalingFactors: []uint64{1, 1},
+		},
+		"custom scaling factors": {
+			scalingFactors:         []uint64{1, 1000},
+			expectedScalingFactors: []uint64{1, 1000},
+		},
+		"too few scaling factors": {
+			scalingFactors: []uint64{1},
+			expectErr:      true,
+		},
+		"zero scaling factor": {
+			scalingFactors: []uint64{0, 1},
+			expectErr:      true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pool, err := NewStableswapPool(defaultPoolId, defaultPoolParams, liquidity, tc.scalingFactors, defaultFutureGovernor)
+			if tc.expectErr {
+				require.ErrorIs(t, err, types.ErrInvalidScalingFactors)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedScalingFactors, pool.GetScalingFactors())
+			require.Equal(t, types.InitPoolSharesSupply, pool.GetTotalShares())
+			require.Equal(t, liquidity, pool.GetTotalPoolLiquidity(sdk.Context{}))
+		})
+	}
+}
+
+func TestSpotPrice(t *testing.T) {
+	tests := map[string]struct {
+		liquidity      sdk.Coins
+		scalingFactors []uint64
+		base, quote    string
+		expectedPrice  sdk.Dec
+	}{
+		"balanced pool": {
+			liquidity:     sdk.NewCoins(sdk.NewInt64Coin("bar", 1000000), sdk.NewInt64Coin("foo", 1000000)),
+			base:          "foo",
+			quote:         "bar",
+			expectedPrice: sdk.OneDec(),
+		},
+		"balanced pool with different precisions": {
+			// 1 bar is 10^12 foo units, so the pool holds 1 bar for each foo.
+			liquidity:      sdk.NewCoins(sdk.NewInt64Coin("bar", 1000000), sdk.NewCoin("foo", sdk.NewInt(1000000).MulRaw(1000000000000))),
+			scalingFactors: []uint64{1, 1000000000000},
+			base:           "foo",
+			quote:          "bar",
+			expectedPrice:  sdk.NewDec(1000000000000),
+		},
+		"balanced three asset pool": {
+			liquidity:     sdk.NewCoins(sdk.NewInt64Coin("bar", 500000), sdk.NewInt64Coin("baz", 500000), sdk.NewInt64Coin("foo", 500000)),
+			base:          "baz",
+			quote:         "foo",
+			expectedPrice: sdk.OneDec(),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pool := newTestPool(t, tc.liquidity, tc.scalingFactors)
+			price, err := pool.SpotPrice(sdk.Context{}, tc.base, tc.quote)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedPrice, price)
+		})
+	}
+
+	t.Run("imbalanced pool prices the scarce asset higher", func(t *testing.T) {
+		pool := newTestPool(t, sdk.NewCoins(sdk.NewInt64Coin("bar", 2000000), sdk.NewInt64Coin("foo", 1000000)), nil)
+		fooPrice, err := pool.SpotPrice(sdk.Context{}, "bar", "foo")
+		require.NoError(t, err)
+		require.True(t, fooPrice.GT(sdk.OneDec()))
+
+		barPrice, err := pool.SpotPrice(sdk.Context{}, "foo", "bar")
+		require.NoError(t, err)
+		decApproxEq(t, sdk.OneDec(), fooPrice.Mul(barPrice), sdk.MustNewDecFromStr("0.0000001"))
+	})
+
+	t.Run("unknown denom", func(t *testing.T) {
+		pool := newTestPool(t, sdk.NewCoins(sdk.NewInt64Coin("bar", 1000000), sdk.NewInt64Coin("foo", 1000000)), nil)
+		_, err := pool.SpotPrice(sdk.Context{}, "baz", "bar")
+		require.Error(t, err)
+	})
+}
+
+func TestSwapRoundTrip(t *testing.T) {
+	liquidity := sdk.NewCoins(sdk.NewInt64Coin("bar", 1000000), sdk.NewCoin("foo", sdk.NewInt(1000000).MulRaw(1000000000000)))
+	pool := newTestPool(t, liquidity, []uint64{1, 1000000000000})
+
+	// swapping 1000 bar should give roughly 1000 * 10^12 foo, less the swap fee.
+	tokenIn := sdk.NewInt64Coin("bar", 1000)
+	tokenOut, err := pool.SwapOutAmtGivenIn(sdk.Context{}, sdk.Coins{tokenIn}, "foo", defaultSwapFee)
+	require.NoError(t, err)
+	expectedOut := sdk.NewDec(1000).Mul(sdk.OneDec().Sub(defaultSwapFee)).MulInt64(1000000000000)
+	decApproxEq(t, expectedOut, tokenOut.Amount.ToDec(), expectedOut.Mul(sdk.MustNewDecFromStr("0.001")))
+	require.Equal(t, liquidity.Add(tokenIn).Sub(sdk.Coins{tokenOut}), pool.GetTotalPoolLiquidity(sdk.Context{}))
+
+	// asking for that amount back out must cost at least what was put in.
+	tokenInRequired, err := pool.CalcInAmtGivenOut(sdk.Context{}, sdk.Coins{tokenOut}, "bar", sdk.ZeroDec())
+	require.NoError(t, err)
+	require.True(t, tokenInRequired.Amount.LTE(tokenIn.Amount.ToDec()))
+
+	_, err = pool.SwapInAmtGivenOut(sdk.Context{}, sdk.Coins{sdk.NewCoin("bar", liquidity.AmountOf("bar").MulRaw(2))}, "foo", defaultSwapFee)
+	require.ErrorIs(t, err, types.ErrTooManyTokensOut)
+}
+
+func TestJoinPool(t *testing.T) {
+	liquidity := sdk.NewCoins(sdk.NewInt64Coin("bar", 1000000), sdk.NewInt64Coin("foo", 1000000))
+
+	t.Run("exact ratio join", func(t *testing.T) {
+		pool := newTestPool(t, liquidity, nil)
+		tokensIn := sdk.NewCoins(sdk.NewInt64Coin("bar", 100000), sdk.NewInt64Coin("foo", 100000))
+
+		shares, err := pool.JoinPool(sdk.Context{}, tokensIn, defaultSwapFee)
+		require.NoError(t, err)
+		require.Equal(t, types.InitPoolSharesSupply.QuoRaw(10), shares)
+		require.Equal(t, liquidity.Add(tokensIn...), pool.GetTotalPoolLiquidity(sdk.Context{}))
+	})
+
+	t.Run("single asset join gets fewer shares than an exact ratio join of the same value", func(t *testing.T) {
+		pool := newTestPool(t, liquidity, nil)
+		tokensIn := sdk.NewCoins(sdk.NewInt64Coin("foo", 200000))
+
+		shares, err := pool.JoinPool(sdk.Context{}, tokensIn, defaultSwapFee)
+		require.NoError(t, err)
+		require.True(t, shares.IsPositive())
+		require.True(t, shares.LT(types.InitPoolSharesSupply.QuoRaw(10)))
+		require.Equal(t, liquidity.Add(tokensIn...), pool.GetTotalPoolLiquidity(sdk.Context{}))
+	})
+
+	t.Run("single asset join without a swap fee is close to an exact ratio join", func(t *testing.T) {
+		pool := newTestPool(t, liquidity, nil)
+		shares, _, err := pool.CalcJoinPoolShares(sdk.Context{}, sdk.NewCoins(sdk.NewInt64Coin("foo", 2)), sdk.ZeroDec())
+		require.NoError(t, err)
+		// 2 foo is worth 1 foo and 1 bar in a balanced pool, which is 1 / 10^6 of the pool.
+		decApproxEq(t, types.InitPoolSharesSupply.QuoRaw(1000000).ToDec(), shares.ToDec(), sdk.NewDec(1000))
+	})
+
+	t.Run("uneven join of all assets", func(t *testing.T) {
+		pool := newTestPool(t, liquidity, nil)
+		tokensIn := sdk.NewCoins(sdk.NewInt64Coin("bar", 100000), sdk.NewInt64Coin("foo", 150000))
+
+		shares, newLiquidity, err := pool.CalcJoinPoolShares(sdk.Context{}, tokensIn, defaultSwapFee)
+		require.NoError(t, err)
+		require.Equal(t, tokensIn, newLiquidity)
+		require.True(t, shares.GT(types.InitPoolSharesSupply.QuoRaw(10)))
+	})
+
+	t.Run("join with a denom not in the pool", func(t *testing.T) {
+		pool := newTestPool(t, liquidity, nil)
+		_, err := pool.JoinPool(sdk.Context{}, sdk.NewCoins(sdk.NewInt64Coin("baz", 100)), defaultSwapFee)
+		require.Error(t, err)
+		require.Equal(t, liquidity, pool.GetTotalPoolLiquidity(sdk.Context{}))
+	})
+}
+
+func TestExitPool(t *testing.T) {
+	liquidity := sdk.NewCoins(sdk.NewInt64Coin("bar", 1000000), sdk.NewInt64Coin("foo", 2000000))
+	pool := newTestPool(t, liquidity, nil)
+
+	exitedCoins, err := pool.ExitPool(sdk.Context{}, types.InitPoolSharesSupply.QuoRaw(4), sdk.MustNewDecFromStr("0.01"))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("bar", 247500), sdk.NewInt64Coin("foo", 495000)), exitedCoins)
+	require.Equal(t, liquidity.Sub(exitedCoins), pool.GetTotalPoolLiquidity(sdk.Context{}))
+	require.Equal(t, types.InitPoolSharesSupply.MulRaw(3).QuoRaw(4), pool.GetTotalShares())
+
+	_, err = pool.ExitPool(sdk.Context{}, pool.GetTotalShares(), sdk.ZeroDec())
+	require.Error(t, err)
+}
@@ -89,6 +89,13 @@ type Pool struct {
 	TotalShares types.Coin `protobuf:"bytes,5,opt,name=totalShares,proto3" json:"totalShares" yaml:"total_shares"`
 	// assets in the pool
 	PoolLiquidity github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=poolLiquidity,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"poolLiquidity"`
+	// for calculation amongst assets with different precisions.
+	// The i-th scaling factor applies to the i-th asset of poolLiquidity,
+	// which is sorted by denom. An asset's amount is divided by its scaling
+	// factor before it enters the CFMM.
+	ScalingFactor []uint64 `protobuf:"varint,7,rep,packed,name=scaling_factor,json=scalingFactor,proto3" json:"scaling_factor,omitempty" yaml:"stableswap_scaling_factor"`
+	// whether the future pool governor paused swaps against the pool.
+	IsPaused bool `protobuf:"varint,8,opt,name=is_paused,json=isPaused,proto3" json:"is_paused,omitempty" yaml:"is_paused"`
 }
 
 func (m *Pool) Reset()      { *m = Pool{} }
@@ -133,42 +140,46 @@ func init() {
 }
 
 var fileDescriptor_ae0f054436f9999a = []byte{
-	// 554 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xb6, 0x9b, 0xb4, 0x15, 0x57, 0x51, 0xc4, 0xd1, 0xc1, 0x6d, 0x85, 0x2f, 0xb2, 0x04, 0x8a,
-	0x04, 0xf1, 0x51, 0x18, 0x10, 0x9d, 0x20, 0x20, 0x10, 0x12, 0x43, 0x31, 0x0b, 0x2a, 0x43, 0x74,
-	0x8e, 0x2f, 0xce, 0x09, 0x3b, 0xe7, 0xfa, 0xce, 0xa1, 0xf9, 0x07, 0x8c, 0x8c, 0x8c, 0x9d, 0x99,
-	0xf9, 0x0d, 0xa8, 0x63, 0xc5, 0x84, 0x18, 0x0c, 0x4a, 0x46, 0xb6, 0xfc, 0x02, 0x74, 0xe7, 0x4b,
-	0x9a, 0x56, 0xa8, 0x20, 0x31, 0xd9, 0xef, 0xbd, 0xef, 0x7d, 0xdf, 0xfb, 0xde, 0xdd, 0x81, 0x07,
-	0x5c, 0xa4, 0x5c, 0x30, 0x81, 0x63, 0x92, 0xa6, 0x38, 0xe3, 0x3c, 0x69, 0xa5, 0x3c, 0xa2, 0x89,
-	0xc0, 0x42, 0x92, 0x30, 0xa1, 0xe2, 0x1d, 0xc9, 0x16, 0x7e, 0x3b, 0x0a, 0xe1, 0x67, 0x39, 0x97,
-	0x1c, 0x22, 0xd3, 0xea, 0xab, 0x56, 0xff, 0x14, 0xe3, 0x0f, 0x77, 0x42, 0x2a, 0xc9, 0xce, 0xd6,
-	0x66, 0x57, 0x23, 0x3a, 0x1a, 0x8e, 0xab, 0xa0, 0xea, 0xdd, 0xda, 0x88, 0x79, 0xcc, 0xab, 0xbc,
-	0xfa, 0x33, 0x59, 0x37, 0xe6, 0x3c, 0x4e, 0x28, 0xd6, 0x51, 0x58, 0xf4, 0x70, 0x54, 0xe4, 0x44,
-	0x32, 0x3e, 0x30, 0x75, 0x74, 0xbe, 0x2e, 0x59, 0x4a, 0x85, 0x24, 0x69, 0x36, 0x23, 0xa8, 0x44,
-	0x30, 0x29, 0x64, 0x1f, 0x9b, 0x31, 0x74, 0x70, 0xae, 0x1e, 0x12, 0x41, 0xe7, 0xf5, 0x2e, 0x67,
-	0x46, 0xc0, 0xfb, 0x62, 0x03, 0xb0, 0xc7, 0x79, 0xb2, 0x47, 0x72, 0x92, 0x0a, 0xf8, 0x06, 0xac,
-	0x2a, 0x43, 0x4f, 0x29, 0x75, 0xec, 0x86, 0xdd, 0xbc, 0xd4, 0x7e, 0x74, 0x5c, 0x22, 0xeb, 0x7b,
-	0x89, 0x6e, 0xc6, 0x4c, 0xf6, 0x8b, 0xd0, 0xef, 0xf2, 0xd4, 0xf8, 0x32, 0x9f, 0x96, 0x88, 0xde,
-	0x62, 0x39, 0xca, 0xa8, 0xf0, 0x9f, 0xd0, 0xee, 0xb4, 0x44, 0x57, 0x46, 0x24, 0x4d, 0x76, 0x3d,
-	0xbd, 0xbb, 0x1e, 0xa5, 0x5e, 0x30, 0x63, 0x54, 0xe4, 0xf4, 0x90, 0x49, 0x45, 0xbe, 0xf4, 0x7f,
-	0xe4, 0x8a, 0xc6, 0x90, 0x1b, 0x46, 0xef, 0x57, 0x0d, 0xd4, 0x95, 0x11, 0x78, 0x1b, 0xac, 0x92,
-	0x28, 0xca, 0xa9, 0x10, 0xc6, 0x02, 0x9c, 0x96, 0x68, 0xbd, 0xea, 0x33, 0x05, 0x2f, 0x98, 0x41,
-	0xe0, 0x3a, 0x58, 0x62, 0x91, 0x1e, 0xa7, 0x1e, 0x2c, 0xb1, 0x08, 0xe6, 0x00, 0x64, 0xf3, 0x75,
-	0x38, 0xb5, 0x86, 0xdd, 0x5c, 0xbb, 0x7b, 0xcb, 0xff, 0xcb, 0xb9, 0xfb, 0xa7, 0x1b, 0x6c, 0xdf,
-	0x50, 0x9e, 0xa6, 0x25, 0xba, 0x6e, 0xd6, 0x70, 0xf6, 0x22, 0x75, 0x32, 0x8d, 0xf2, 0x82, 0x05,
-	0x15, 0xf8, 0x12, 0x6c, 0xf4, 0x0a, 0x59, 0xe4, 0xb4, 0x82, 0xc4, 0x7c, 0x48, 0xf3, 0x01, 0xcf,
-	0x9d, 0xba, 0x1e, 0x1f, 0x4d, 0x4b, 0xb4, 0x5d, 0x91, 0xfd, 0x09, 0xe5, 0x05, 0xb0, 0x4a, 0xab,
-	0x19, 0x9e, 0x99, 0x24, 0x7c, 0x0d, 0xd6, 0x24, 0x97, 0x24, 0x79, 0xd5, 0x27, 0x39, 0x15, 0xce,
-	0xb2, 0xf6, 0xb1, 0xe9, 0x9b, 0x1b, 0xa9, 0x2e, 0xc3, 0x7c, 0xf6, 0xc7, 0x9c, 0x0d, 0xda, 0xdb,
-	0x66, 0xea, 0x6b, 0x95, 0x90, 0xee, 0xed, 0x08, 0xdd, 0xec, 0x05, 0x8b, 0x54, 0xf0, 0x00, 0x5c,
-	0x56, 0xfa, 0x2f, 0xd8, 0x41, 0xc1, 0x22, 0x26, 0x47, 0xce, 0x4a, 0xa3, 0x76, 0x31, 0xf7, 0x1d,
-	0xc5, 0xfd, 0xe9, 0x07, 0x6a, 0xfe, 0xc3, 0x29, 0xab, 0x06, 0x11, 0x9c, 0x55, 0xd8, 0xbd, 0xfa,
-	0xfe, 0x08, 0x59, 0x1f, 0x8f, 0x90, 0xf5, 0xf5, 0x73, 0x6b, 0x59, 0xd9, 0x7c, 0xde, 0xde, 0x3f,
-	0x1e, 0xbb, 0xf6, 0xc9, 0xd8, 0xb5, 0x7f, 0x8e, 0x5d, 0xfb, 0xc3, 0xc4, 0xb5, 0x4e, 0x26, 0xae,
-	0xf5, 0x6d, 0xe2, 0x5a, 0xfb, 0x0f, 0x17, 0x54, 0xcc, 0xb1, 0xb5, 0x12, 0x12, 0x8a, 0x59, 0x80,
-	0x87, 0xf7, 0xf1, 0xe1, 0x45, 0x6f, 0x3f, 0x5c, 0xd1, 0x2f, 0xe3, 0xde, 0xef, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x90, 0xbd, 0xb4, 0x70, 0x29, 0x04, 0x00, 0x00,
+	// 619 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x3f, 0x6f, 0xd4, 0x4e,
+	0x10, 0x3d, 0x27, 0x97, 0x7f, 0x1b, 0x25, 0xbf, 0x1f, 0x4b, 0x0a, 0x27, 0x11, 0xde, 0x93, 0x05,
+	0xe8, 0x24, 0x38, 0x9b, 0x40, 0x81, 0x48, 0x05, 0x06, 0x05, 0x21, 0x28, 0x82, 0x69, 0x50, 0x28,
+	0xac, 0xb5, 0xbd, 0xe7, 0xac, 0xb0, 0x6f, 0x1d, 0xef, 0x3a, 0x24, 0xdf, 0x80, 0x92, 0x92, 0x32,
+	0x35, 0x35, 0x9f, 0x01, 0xa5, 0x8c, 0xa8, 0x10, 0x85, 0x41, 0x49, 0x49, 0xe7, 0x4f, 0x80, 0x76,
+	0xbd, 0x77, 0xb9, 0x04, 0x14, 0x90, 0xa8, 0x6e, 0x67, 0xe6, 0xbd, 0x37, 0xf3, 0x66, 0xf7, 0x0c,
+	0xee, 0x31, 0x9e, 0x31, 0x4e, 0xb9, 0x9b, 0xe0, 0x2c, 0x73, 0x73, 0xc6, 0xd2, 0x5e, 0xc6, 0x62,
+	0x92, 0x72, 0x97, 0x0b, 0x1c, 0xa6, 0x84, 0xbf, 0xc1, 0xf9, 0xd8, 0x31, 0x90, 0x08, 0x27, 0x2f,
+	0x98, 0x60, 0x10, 0x69, 0xaa, 0x23, 0xa9, 0xce, 0x29, 0xc6, 0xd9, 0x5d, 0x0b, 0x89, 0xc0, 0x6b,
+	0x2b, 0xcb, 0x91, 0x42, 0x04, 0x0a, 0xee, 0x36, 0x41, 0xc3, 0x5d, 0x59, 0x4a, 0x58, 0xc2, 0x9a,
+	0xbc, 0x3c, 0xe9, 0xac, 0x95, 0x30, 0x96, 0xa4, 0xc4, 0x55, 0x51, 0x58, 0xf6, 0xdd, 0xb8, 0x2c,
+	0xb0, 0xa0, 0x6c, 0xa0, 0xeb, 0xe8, 0x7c, 0x5d, 0xd0, 0x8c, 0x70, 0x81, 0xb3, 0x7c, 0x28, 0xd0,
+	0x34, 0x71, 0x71, 0x29, 0xb6, 0x5d, 0x3d, 0x86, 0x0a, 0xce, 0xd5, 0x43, 0xcc, 0xc9, 0xa8, 0x1e,
+	0x31, 0xaa, 0x1b, 0xd8, 0x9f, 0x0c, 0x00, 0x36, 0x19, 0x4b, 0x37, 0x71, 0x81, 0x33, 0x0e, 0x5f,
+	0x81, 0x19, 0x69, 0x68, 0x83, 0x10, 0xd3, 0xe8, 0x18, 0xdd, 0x39, 0xef, 0xc1, 0x61, 0x85, 0x5a,
+	0x5f, 0x2b, 0x74, 0x3d, 0xa1, 0x62, 0xbb, 0x0c, 0x9d, 0x88, 0x65, 0xda, 0x97, 0xfe, 0xe9, 0xf1,
+	0xf8, 0xb5, 0x2b, 0xf6, 0x73, 0xc2, 0x9d, 0x47, 0x24, 0xaa, 0x2b, 0xf4, 0xdf, 0x3e, 0xce, 0xd2,
+	0x75, 0x5b, 0xed, 0xae, 0x4f, 0x88, 0xed, 0x0f, 0x15, 0xa5, 0x38, 0xd9, 0xa3, 0x42, 0x8a, 0x4f,
+	0xfc, 0x9b, 0xb8, 0x94, 0xd1, 0xe2, 0x5a, 0xd1, 0xfe, 0xd1, 0x06, 0x6d, 0x69, 0x04, 0xde, 0x04,
+	0x33, 0x38, 0x8e, 0x0b, 0xc2, 0xb9, 0xb6, 0x00, 0xeb, 0x0a, 0x2d, 0x36, 0x3c, 0x5d, 0xb0, 0xfd,
+	0x21, 0x04, 0x2e, 0x82, 0x09, 0x1a, 0xab, 0x71, 0xda, 0xfe, 0x04, 0x8d, 0x61, 0x01, 0x40, 0x3e,
+	0x5a, 0x87, 0x39, 0xd9, 0x31, 0xba, 0xf3, 0xb7, 0x6f, 0x38, 0x7f, 0xb8, 0x77, 0xe7, 0x74, 0x83,
+	0xde, 0x35, 0xe9, 0xa9, 0xae, 0xd0, 0x15, 0xbd, 0x86, 0xb3, 0x0f, 0x29, 0xc8, 0x15, 0xca, 0xf6,
+	0xc7, 0xba, 0xc0, 0xe7, 0x60, 0xa9, 0x5f, 0x8a, 0xb2, 0x20, 0x0d, 0x24, 0x61, 0xbb, 0xa4, 0x18,
+	0xb0, 0xc2, 0x6c, 0xab, 0xf1, 0x51, 0x5d, 0xa1, 0xd5, 0x46, 0xec, 0x77, 0x28, 0xdb, 0x87, 0x4d,
+	0x5a, 0xce, 0xf0, 0x58, 0x27, 0xe1, 0x4b, 0x30, 0x2f, 0x98, 0xc0, 0xe9, 0x8b, 0x6d, 0x5c, 0x10,
+	0x6e, 0x4e, 0x29, 0x1f, 0xcb, 0x8e, 0x7e, 0x91, 0xf2, 0x31, 0x8c, 0x66, 0x7f, 0xc8, 0xe8, 0xc0,
+	0x5b, 0xd5, 0x53, 0x5f, 0x6e, 0x1a, 0x29, 0x6e, 0xc0, 0x15, 0xd9, 0xf6, 0xc7, 0xa5, 0xe0, 0x0e,
+	0x58, 0x90, 0xfd, 0x9f, 0xd1, 0x9d, 0x92, 0xc6, 0x54, 0xec, 0x9b, 0xd3, 0x9d, 0xc9, 0x8b, 0xb5,
+	0x6f, 0x49, 0xed, 0x0f, 0xdf, 0x50, 0xf7, 0x2f, 0x6e, 0x59, 0x12, 0xb8, 0x7f, 0xb6, 0x03, 0x7c,
+	0x0a, 0x16, 0x79, 0x84, 0x53, 0x3a, 0x48, 0x82, 0x3e, 0x8e, 0x04, 0x2b, 0xcc, 0x99, 0xce, 0x64,
+	0xb7, 0xed, 0x5d, 0xad, 0x2b, 0xd4, 0xf9, 0x65, 0xcd, 0x67, 0xa1, 0xb6, 0xbf, 0xa0, 0x13, 0x1b,
+	0x2a, 0x86, 0x6b, 0x60, 0x8e, 0xf2, 0x20, 0xc7, 0x25, 0x27, 0xb1, 0x39, 0xdb, 0x31, 0xba, 0xb3,
+	0xde, 0x52, 0x5d, 0xa1, 0xff, 0x1b, 0x9d, 0x51, 0xc9, 0xf6, 0x67, 0x29, 0xdf, 0x54, 0xc7, 0xf5,
+	0x4b, 0x6f, 0x0f, 0x50, 0xeb, 0xfd, 0x01, 0x6a, 0x7d, 0xfe, 0xd8, 0x9b, 0x92, 0x6b, 0x7e, 0xe2,
+	0x6d, 0x1d, 0x1e, 0x5b, 0xc6, 0xd1, 0xb1, 0x65, 0x7c, 0x3f, 0xb6, 0x8c, 0x77, 0x27, 0x56, 0xeb,
+	0xe8, 0xc4, 0x6a, 0x7d, 0x39, 0xb1, 0x5a, 0x5b, 0xf7, 0xc7, 0x5c, 0xea, 0x67, 0xd3, 0x4b, 0x71,
+	0xc8, 0x87, 0x81, 0xbb, 0x7b, 0xd7, 0xdd, 0xbb, 0xe8, 0xdb, 0x13, 0x4e, 0xab, 0x7f, 0xe6, 0x9d,
+	0x9f, 0x03, 0x00, 0x55, 0xc2, 0xc5, 0x84, 0xa9, 0x04, 0x00, 0x00,
 }
 
 func (m *PoolParams) Marshal() (dAtA []byte, err error) {
@@ -234,6 +245,34 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.IsPaused {
+		i--
+		if m.IsPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ScalingFactor) > 0 {
+		dAtA2 := make([]byte, len(m.ScalingFactor)*10)
+		var j1 int
+		for _, num := range m.ScalingFactor {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintStableswapPool(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.PoolLiquidity) > 0 {
 		for iNdEx := len(m.PoolLiquidity) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -341,6 +380,16 @@ func (m *Pool) Size() (n int) {
 			n += 1 + l + sovStableswapPool(uint64(l))
 		}
 	}
+	if len(m.ScalingFactor) > 0 {
+		l = 0
+		for _, e := range m.ScalingFactor {
+			l += sovStableswapPool(uint64(e))
+		}
+		n += 1 + sovStableswapPool(uint64(l)) + l
+	}
+	if m.IsPaused {
+		n += 2
+	}
 	return n
 }
 
@@ -680,6 +729,102 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStableswapPool
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ScalingFactor = append(m.ScalingFactor, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStableswapPool
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStableswapPool
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStableswapPool
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ScalingFactor) == 0 {
+					m.ScalingFactor = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStableswapPool
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ScalingFactor = append(m.ScalingFactor, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScalingFactor", wireType)
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsPaused = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStableswapPool(dAtA[iNdEx:])
@@ -35,6 +35,9 @@ type MsgCreateStableswapPool struct {
 	PoolParams           *PoolParams                              `protobuf:"bytes,2,opt,name=poolParams,proto3" json:"poolParams,omitempty" yaml:"pool_params"`
 	InitialPoolLiquidity github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=initial_pool_liquidity,json=initialPoolLiquidity,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"initial_pool_liquidity"`
 	FuturePoolGovernor   string                                   `protobuf:"bytes,4,opt,name=future_pool_governor,json=futurePoolGovernor,proto3" json:"future_pool_governor,omitempty" yaml:"future_pool_governor"`
+	// scaling factors for the assets in initial_pool_liquidity, in the same
+	// (denom sorted) order. If empty, every asset uses a scaling factor of 1.
+	ScalingFactors []uint64 `protobuf:"varint,5,rep,packed,name=scaling_factors,json=scalingFactors,proto3" json:"scaling_factors,omitempty" yaml:"stableswap_scaling_factor"`
 }
 
 func (m *MsgCreateStableswapPool) Reset()         { *m = MsgCreateStableswapPool{} }
@@ -98,6 +101,13 @@ func (m *MsgCreateStableswapPool) GetFuturePoolGovernor() string {
 	return ""
 }
 
+func (m *MsgCreateStableswapPool) GetScalingFactors() []uint64 {
+	if m != nil {
+		return m.ScalingFactors
+	}
+	return nil
+}
+
 type MsgCreateStableswapPoolResponse struct {
 	PoolID uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
 }
@@ -152,37 +162,40 @@ func init() {
 }
 
 var fileDescriptor_46b7c8a0f24de97c = []byte{
-	// 478 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0x4d, 0x6f, 0xd3, 0x30,
-	0x18, 0xae, 0xe9, 0x54, 0x84, 0x27, 0x0e, 0x58, 0xd5, 0x28, 0x45, 0x8a, 0xab, 0x70, 0x29, 0x82,
-	0xda, 0xac, 0x1c, 0xf8, 0x38, 0x4d, 0x19, 0x02, 0x4d, 0x62, 0x52, 0x09, 0xb7, 0x5d, 0x2a, 0xa7,
-	0x31, 0xc1, 0x22, 0x89, 0x43, 0xec, 0x96, 0xf5, 0x06, 0xff, 0x00, 0x71, 0xe4, 0x27, 0xf0, 0x4b,
-	0x76, 0xdc, 0x91, 0x53, 0x40, 0xed, 0x3f, 0xe8, 0x2f, 0x40, 0xb6, 0xd3, 0x6e, 0x87, 0x8d, 0x21,
-	0x4e, 0x79, 0xf3, 0xf8, 0x79, 0x9f, 0xf7, 0xe3, 0x79, 0xe1, 0x43, 0xa9, 0x32, 0xa9, 0x84, 0xa2,
-	0x09, 0xcb, 0x32, 0x5a, 0x48, 0x99, 0x0e, 0x32, 0x19, 0xf3, 0x54, 0x51, 0xa5, 0x59, 0x94, 0x72,
-	0xf5, 0x89, 0x15, 0x54, 0x1f, 0x93, 0xa2, 0x94, 0x5a, 0x22, 0x5c, 0xb3, 0x89, 0x61, 0x93, 0x33,
-	0x06, 0x99, 0xed, 0x46, 0x5c, 0xb3, 0xdd, 0xae, 0x37, 0xb1, 0x0c, 0x1a, 0x31, 0xc5, 0x69, 0x0d,
-	0xd2, 0x89, 0x14, 0xb9, 0x13, 0xe8, 0xb6, 0x13, 0x99, 0x48, 0x1b, 0x52, 0x13, 0xd5, 0xe8, 0xb3,
-	0x7f, 0x69, 0xe2, 0x2c, 0x1c, 0x1b, 0x86, 0x4b, 0xf5, 0x3f, 0x37, 0xe1, 0xed, 0x43, 0x95, 0xec,
-	0x97, 0x9c, 0x69, 0xfe, 0x76, 0x43, 0x19, 0x49, 0x99, 0xa2, 0xfb, 0xb0, 0xa5, 0x78, 0x1e, 0xf3,
-	0xb2, 0x03, 0x7a, 0xa0, 0x7f, 0x23, 0xb8, 0xb5, 0xaa, 0xf0, 0xcd, 0x39, 0xcb, 0xd2, 0xe7, 0xbe,
-	0xc3, 0xfd, 0xb0, 0x26, 0xa0, 0x09, 0x84, 0x46, 0x74, 0xc4, 0x4a, 0x96, 0xa9, 0xce, 0xb5, 0x1e,
-	0xe8, 0x6f, 0x0f, 0x1f, 0x90, 0x2b, 0xa6, 0x25, 0xa3, 0x4d, 0x4a, 0xb0, 0xb3, 0xaa, 0x30, 0x72,
-	0xda, 0x46, 0x68, 0x5c, 0x58, 0xd8, 0x0f, 0xcf, 0xc9, 0xa2, 0x2f, 0x00, 0xee, 0x88, 0x5c, 0x68,
-	0xc1, 0x52, 0x3b, 0xc2, 0x38, 0x15, 0x1f, 0xa7, 0x22, 0x16, 0x7a, 0xde, 0x69, 0xf6, 0x9a, 0xfd,
-	0xed, 0xe1, 0x1d, 0xe2, 0xd6, 0x47, 0xcc, 0xfa, 0x36, 0x55, 0xf6, 0xa5, 0xc8, 0x83, 0x47, 0x27,
-	0x15, 0x6e, 0xfc, 0xf8, 0x85, 0xfb, 0x89, 0xd0, 0xef, 0xa7, 0x11, 0x99, 0xc8, 0x8c, 0xd6, 0xbb,
-	0x76, 0x9f, 0x81, 0x8a, 0x3f, 0x50, 0x3d, 0x2f, 0xb8, 0xb2, 0x09, 0x2a, 0x6c, 0xd7, 0xa5, 0x4c,
-	0x93, 0xaf, 0xd7, 0x85, 0xd0, 0x1b, 0xd8, 0x7e, 0x37, 0xd5, 0xd3, 0x92, 0xbb, 0x0e, 0x12, 0x39,
-	0xe3, 0x65, 0x2e, 0xcb, 0xce, 0x96, 0xdd, 0x10, 0x5e, 0x55, 0xf8, 0xae, 0x9b, 0xe2, 0x22, 0x96,
-	0x1f, 0x22, 0x07, 0x1b, 0xcd, 0x57, 0x6b, 0xf0, 0x25, 0xc4, 0x97, 0x38, 0x10, 0x72, 0x55, 0xc8,
-	0x5c, 0x71, 0x74, 0x0f, 0x5e, 0xb7, 0x42, 0x22, 0xb6, 0x56, 0x6c, 0x05, 0x70, 0x51, 0xe1, 0x96,
-	0xa1, 0x1c, 0xbc, 0x08, 0x5b, 0xe6, 0xe9, 0x20, 0x1e, 0x7e, 0x07, 0xb0, 0x79, 0xa8, 0x12, 0xf4,
-	0x0d, 0xc0, 0xf6, 0x85, 0x7e, 0x3e, 0xbd, 0xd2, 0x90, 0x4b, 0xfa, 0xe8, 0xee, 0xfd, 0x6f, 0xe6,
-	0x7a, 0x82, 0xe0, 0xe8, 0x64, 0xe1, 0x81, 0xd3, 0x85, 0x07, 0x7e, 0x2f, 0x3c, 0xf0, 0x75, 0xe9,
-	0x35, 0x4e, 0x97, 0x5e, 0xe3, 0xe7, 0xd2, 0x6b, 0x1c, 0xed, 0x9d, 0x73, 0xa4, 0xae, 0x32, 0x48,
-	0x59, 0xa4, 0xd6, 0x3f, 0x74, 0xf6, 0x84, 0x1e, 0xff, 0xed, 0xb2, 0xa3, 0x96, 0x3d, 0xe5, 0xc7,
-	0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x2b, 0x2a, 0xeb, 0xc5, 0x8c, 0x03, 0x00, 0x00,
+	// 517 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x4d, 0x6f, 0xd3, 0x3e,
+	0x1c, 0xc7, 0x9b, 0x7f, 0xfb, 0x2f, 0xc2, 0x13, 0x20, 0xac, 0x6a, 0x94, 0x22, 0xc5, 0x55, 0xe0,
+	0x50, 0x04, 0x8d, 0x59, 0x39, 0xf0, 0x70, 0x9a, 0x32, 0x34, 0x34, 0x89, 0x4a, 0x25, 0xdc, 0x76,
+	0xa9, 0x9c, 0xc4, 0x0b, 0x16, 0x49, 0x1c, 0x62, 0xb7, 0xac, 0x47, 0xde, 0x01, 0xe2, 0xc8, 0x4b,
+	0xe0, 0x95, 0xec, 0xc6, 0x8e, 0x9c, 0x02, 0x6a, 0xdf, 0x41, 0x5f, 0x01, 0x72, 0xec, 0xb6, 0x43,
+	0xda, 0x18, 0xe2, 0x14, 0xe7, 0x9b, 0xcf, 0xef, 0xfb, 0x7b, 0x8a, 0xc1, 0x43, 0x2e, 0x52, 0x2e,
+	0x98, 0xc0, 0x31, 0x49, 0x53, 0x9c, 0x73, 0x9e, 0xf4, 0x53, 0x1e, 0xd1, 0x44, 0x60, 0x21, 0x49,
+	0x90, 0x50, 0xf1, 0x81, 0xe4, 0x58, 0x1e, 0xbb, 0x79, 0xc1, 0x25, 0x87, 0xc8, 0xd0, 0xae, 0xa2,
+	0xdd, 0x0d, 0xe1, 0x4e, 0x77, 0x02, 0x2a, 0xc9, 0x4e, 0xc7, 0x0e, 0x2b, 0x02, 0x07, 0x44, 0x50,
+	0x6c, 0x44, 0x1c, 0x72, 0x96, 0x69, 0x83, 0x4e, 0x2b, 0xe6, 0x31, 0xaf, 0x8e, 0x58, 0x9d, 0x8c,
+	0xfa, 0xec, 0x6f, 0x8a, 0xd8, 0x1c, 0xc7, 0x8a, 0xd0, 0xa1, 0xce, 0xb7, 0x3a, 0xb8, 0x35, 0x14,
+	0xf1, 0x5e, 0x41, 0x89, 0xa4, 0x6f, 0xd6, 0xc8, 0x88, 0xf3, 0x04, 0xde, 0x07, 0x4d, 0x41, 0xb3,
+	0x88, 0x16, 0x6d, 0xab, 0x6b, 0xf5, 0xae, 0x7a, 0x37, 0x97, 0x25, 0xba, 0x36, 0x23, 0x69, 0xf2,
+	0xdc, 0xd1, 0xba, 0xe3, 0x1b, 0x00, 0x86, 0x00, 0x28, 0xd3, 0x11, 0x29, 0x48, 0x2a, 0xda, 0xff,
+	0x75, 0xad, 0xde, 0xd6, 0xe0, 0x81, 0x7b, 0x49, 0xb7, 0xee, 0x68, 0x1d, 0xe2, 0x6d, 0x2f, 0x4b,
+	0x04, 0xb5, 0xb7, 0x32, 0x1a, 0xe7, 0x95, 0xec, 0xf8, 0x67, 0x6c, 0xe1, 0x47, 0x0b, 0x6c, 0xb3,
+	0x8c, 0x49, 0x46, 0x92, 0xaa, 0x85, 0x71, 0xc2, 0xde, 0x4f, 0x58, 0xc4, 0xe4, 0xac, 0x5d, 0xef,
+	0xd6, 0x7b, 0x5b, 0x83, 0xdb, 0xae, 0x1e, 0x9f, 0xab, 0xc6, 0xb7, 0xce, 0xb2, 0xc7, 0x59, 0xe6,
+	0x3d, 0x3a, 0x29, 0x51, 0xed, 0xeb, 0x0f, 0xd4, 0x8b, 0x99, 0x7c, 0x3b, 0x09, 0xdc, 0x90, 0xa7,
+	0xd8, 0xcc, 0x5a, 0x3f, 0xfa, 0x22, 0x7a, 0x87, 0xe5, 0x2c, 0xa7, 0xa2, 0x0a, 0x10, 0x7e, 0xcb,
+	0xa4, 0x52, 0x45, 0xbe, 0x5a, 0x25, 0x82, 0xaf, 0x41, 0xeb, 0x68, 0x22, 0x27, 0x05, 0xd5, 0x15,
+	0xc4, 0x7c, 0x4a, 0x8b, 0x8c, 0x17, 0xed, 0x46, 0x35, 0x21, 0xb4, 0x2c, 0xd1, 0x1d, 0xdd, 0xc5,
+	0x79, 0x94, 0xe3, 0x43, 0x2d, 0x2b, 0xcf, 0x97, 0x46, 0x84, 0x43, 0x70, 0x43, 0x84, 0x24, 0x61,
+	0x59, 0x3c, 0x3e, 0x22, 0xa1, 0xe4, 0x85, 0x68, 0xff, 0xdf, 0xad, 0xf7, 0x1a, 0xde, 0xbd, 0x65,
+	0x89, 0xba, 0x66, 0xde, 0x9b, 0xe5, 0xfd, 0xce, 0x3a, 0xfe, 0x75, 0x23, 0xec, 0xeb, 0x58, 0x67,
+	0x1f, 0xa0, 0x0b, 0x16, 0xea, 0x53, 0x91, 0xf3, 0x4c, 0x50, 0x78, 0x17, 0x5c, 0xa9, 0xea, 0x62,
+	0x51, 0xb5, 0xd9, 0x86, 0x07, 0xe6, 0x25, 0x6a, 0x2a, 0xe4, 0xe0, 0x85, 0xdf, 0x54, 0x9f, 0x0e,
+	0xa2, 0xc1, 0x17, 0x0b, 0xd4, 0x87, 0x22, 0x86, 0x9f, 0x2d, 0xd0, 0x3a, 0xf7, 0xf7, 0x78, 0x7a,
+	0xe9, 0x7e, 0x2f, 0xa8, 0xa3, 0xb3, 0xfb, 0xaf, 0x91, 0xab, 0x0e, 0xbc, 0xc3, 0x93, 0xb9, 0x6d,
+	0x9d, 0xce, 0x6d, 0xeb, 0xe7, 0xdc, 0xb6, 0x3e, 0x2d, 0xec, 0xda, 0xe9, 0xc2, 0xae, 0x7d, 0x5f,
+	0xd8, 0xb5, 0xc3, 0xdd, 0x33, 0x0b, 0x36, 0x59, 0xfa, 0x09, 0x09, 0xc4, 0xea, 0x05, 0x4f, 0x9f,
+	0xe0, 0xe3, 0x3f, 0x5d, 0x94, 0xa0, 0x59, 0xdd, 0x8c, 0xc7, 0xbf, 0x06, 0x00, 0xc1, 0xc8, 0x36,
+	0xd8, 0xdb, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -285,6 +298,24 @@ func (m *MsgCreateStableswapPool) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.ScalingFactors) > 0 {
+		dAtA2 := make([]byte, len(m.ScalingFactors)*10)
+		var j1 int
+		for _, num := range m.ScalingFactors {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintTx(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.FuturePoolGovernor) > 0 {
 		i -= len(m.FuturePoolGovernor)
 		copy(dAtA[i:], m.FuturePoolGovernor)
@@ -391,6 +422,13 @@ func (m *MsgCreateStableswapPool) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if len(m.ScalingFactors) > 0 {
+		l = 0
+		for _, e := range m.ScalingFactors {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
 	return n
 }
 
@@ -575,6 +613,82 @@ func (m *MsgCreateStableswapPool) Unmarshal(dAtA []byte) error {
 			}
 			m.FuturePoolGovernor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ScalingFactors = append(m.ScalingFactors, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ScalingFactors) == 0 {
+					m.ScalingFactors = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ScalingFactors = append(m.ScalingFactors, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScalingFactors", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
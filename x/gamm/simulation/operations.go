@@ -159,9 +159,7 @@ func SimulateMsgCreateBalancerPool(ak stakingTypes.AccountKeeper, bk stakingType
 		}
 
 		// set the pool params to set the pool creation fee to dust amount of denom
-		k.SetParams(ctx, types.Params{
-			PoolCreationFee: sdk.Coins{sdk.NewInt64Coin(denoms[0], 1)},
-		})
+		k.SetParams(ctx, types.NewParams(sdk.Coins{sdk.NewInt64Coin(denoms[0], 1)}))
 
 		msg := &balancer.MsgCreateBalancerPool{
 			Sender:             simAccount.Address.String(),
@@ -14,6 +14,11 @@ var (
 	ErrInvalidMathApprox  = sdkerrors.Register(ModuleName, 8, "invalid calculated result")
 	ErrAlreadyInvalidPool = sdkerrors.Register(ModuleName, 9, "destruction on already invalid pool")
 	ErrInvalidPool        = sdkerrors.Register(ModuleName, 10, "attempting to create an invalid pool")
+	ErrNotGammPool        = sdkerrors.Register(ModuleName, 11, "pool is not a gamm pool")
+	ErrNotPoolGovernor    = sdkerrors.Register(ModuleName, 12, "sender is not the pool's governor")
+	ErrPoolNotGovernable  = sdkerrors.Register(ModuleName, 13, "pool does not support this change by its governor")
+	ErrInvalidPoolIds     = sdkerrors.Register(ModuleName, 14, "invalid pool ids")
+	ErrSharesMismatch     = sdkerrors.Register(ModuleName, 15, "pool shares held do not add up to the pool's total shares")
 
 	ErrEmptyRoutes              = sdkerrors.Register(ModuleName, 21, "routes not defined")
 	ErrEmptyPoolAssets          = sdkerrors.Register(ModuleName, 22, "PoolAssets not defined")
@@ -26,9 +31,11 @@ var (
 	ErrNotPositiveCriteria      = sdkerrors.Register(ModuleName, 29, "min out amount or max in amount should be positive")
 	ErrNotPositiveRequireAmount = sdkerrors.Register(ModuleName, 30, "required amount should be positive")
 	ErrTooManyTokensOut         = sdkerrors.Register(ModuleName, 31, "tx is trying to get more tokens out of the pool than exist")
+	ErrDenomNotFoundInPool      = sdkerrors.Register(ModuleName, 32, "denom does not exist in pool")
 
 	ErrPoolParamsInvalidDenom     = sdkerrors.Register(ModuleName, 50, "pool params' LBP params has an invalid denomination")
 	ErrPoolParamsInvalidNumDenoms = sdkerrors.Register(ModuleName, 51, "pool params' LBP doesn't have same number of params as underlying pool")
+	ErrInvalidScalingFactors      = sdkerrors.Register(ModuleName, 52, "pool's scaling factors must be positive, with one per pool asset")
 
 	ErrNotImplemented = sdkerrors.Register(ModuleName, 60, "function not implemented")
 )
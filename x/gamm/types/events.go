@@ -12,11 +12,29 @@ const (
 	TypeEvtPoolCreated  = "pool_created"
 	TypeEvtTokenSwapped = "token_swapped"
 
-	AttributeValueCategory = ModuleName
-	AttributeKeyPoolId     = "pool_id"
-	AttributeKeySwapFee    = "swap_fee"
-	AttributeKeyTokensIn   = "tokens_in"
-	AttributeKeyTokensOut  = "tokens_out"
+	TypeEvtPoolFeesChanged       = "pool_fees_changed"
+	TypeEvtPoolActiveChanged     = "pool_active_changed"
+	TypeEvtWeightChangeScheduled = "weight_change_scheduled"
+	TypeEvtPoolCleanupPayout     = "pool_cleanup_payout"
+	TypeEvtPoolCleanedUp         = "pool_cleaned_up"
+	TypeEvtTakerFeeCharged       = "taker_fee_charged"
+
+	AttributeValueCategory    = ModuleName
+	AttributeKeyPoolId        = "pool_id"
+	AttributeKeySwapFee       = "swap_fee"
+	AttributeKeyExitFee       = "exit_fee"
+	AttributeKeyIsActive      = "is_active"
+	AttributeKeyStartTime     = "start_time"
+	AttributeKeyDuration      = "duration"
+	AttributeKeyTargetWeights = "target_weights"
+	AttributeKeyTokensIn      = "tokens_in"
+	AttributeKeyTokensOut     = "tokens_out"
+	AttributeKeyRecipient     = "recipient"
+	AttributeKeyShares        = "shares"
+	AttributeKeyLockIds       = "lock_ids"
+	AttributeKeyRemainder     = "remainder"
+	AttributeKeyTakerFee      = "taker_fee"
+	AttributeKeyDestination   = "destination"
 )
 
 func CreateSwapEvent(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) sdk.Event {
@@ -1,9 +1,15 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	lockuptypes "github.com/osmosis-labs/osmosis/v7/x/lockup/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v7/x/swaprouter/types"
 )
 
 // AccountKeeper defines the account contract that must be fulfilled when
@@ -60,4 +66,48 @@ type BankKeeper interface {
 // DistrKeeper defines the contract needed to be fulfilled for distribution keeper.
 type DistrKeeper interface {
 	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+	GetFeePool(ctx sdk.Context) (feePool distrtypes.FeePool)
+	SetFeePool(ctx sdk.Context, feePool distrtypes.FeePool)
+}
+
+// LockupKeeper defines the contract needed to be fulfilled for the lockup
+// keeper, which decides who governs pools with a lock based governor, and
+// holds the locked shares of pools being cleaned up.
+type LockupKeeper interface {
+	GetLockedDenom(ctx sdk.Context, denom string, duration time.Duration) sdk.Int
+	GetAccountLockedLongerDurationDenom(ctx sdk.Context, addr sdk.AccAddress, denom string, duration time.Duration) []lockuptypes.PeriodLock
+	GetLocksDenom(ctx sdk.Context, denom string) []lockuptypes.PeriodLock
+	HasAnySyntheticLockups(ctx sdk.Context, lockID uint64) bool
+	ForceUnlock(ctx sdk.Context, lock lockuptypes.PeriodLock) error
+}
+
+// SuperfluidKeeper defines the contract needed to be fulfilled for the
+// superfluid keeper, which ends the superfluid staking of locked shares of
+// pools being cleaned up.
+type SuperfluidKeeper interface {
+	ForceUndelegateLock(ctx sdk.Context, lockID uint64) error
+}
+
+// PoolManager defines the contract needed to be fulfilled for the swap router,
+// which assigns pool IDs and routes swaps to the module owning each pool.
+type PoolManager interface {
+	GetNextPoolId(ctx sdk.Context) uint64
+	GetNextPoolIdAndIncrement(ctx sdk.Context) uint64
+	SetPoolRoute(ctx sdk.Context, poolId uint64, poolType swaproutertypes.PoolType)
+	DeletePoolRoute(ctx sdk.Context, poolId uint64)
+
+	RouteExactAmountIn(
+		ctx sdk.Context,
+		sender sdk.AccAddress,
+		routes []swaproutertypes.SwapAmountInRoute,
+		tokenIn sdk.Coin,
+		tokenOutMinAmount sdk.Int,
+	) (tokenOutAmount sdk.Int, err error)
+	RouteExactAmountOut(
+		ctx sdk.Context,
+		sender sdk.AccAddress,
+		routes []swaproutertypes.SwapAmountOutRoute,
+		tokenInMaxAmount sdk.Int,
+		tokenOut sdk.Coin,
+	) (tokenInAmount sdk.Int, err error)
 }
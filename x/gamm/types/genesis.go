@@ -2,14 +2,15 @@ package types
 
 import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // DefaultGenesis creates a default GenesisState object.
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Pools:          []*codectypes.Any{},
-		NextPoolNumber: 1,
-		Params:         DefaultParams(),
+		Pools:              []*codectypes.Any{},
+		Params:             DefaultParams(),
+		TakerFeesCollected: sdk.Coins{},
 	}
 }
 
@@ -19,5 +20,5 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
-	return nil
+	return gs.TakerFeesCollected.Validate()
 }
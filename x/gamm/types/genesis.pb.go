@@ -27,9 +27,43 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// TakerFeeDestination is where taker fees go.
+type TakerFeeDestination int32
+
+const (
+	// The taker fees fund the community pool.
+	CommunityPool TakerFeeDestination = 0
+	// The taker fees go to the fee collector, and are distributed like
+	// transaction fees.
+	FeeCollector TakerFeeDestination = 1
+	// The taker fees are burnt.
+	Burn TakerFeeDestination = 2
+)
+
+var TakerFeeDestination_name = map[int32]string{
+	0: "CommunityPool",
+	1: "FeeCollector",
+	2: "Burn",
+}
+
+var TakerFeeDestination_value = map[string]int32{
+	"CommunityPool": 0,
+	"FeeCollector":  1,
+	"Burn":          2,
+}
+
+func (x TakerFeeDestination) String() string {
+	return proto.EnumName(TakerFeeDestination_name, int32(x))
+}
+
+func (TakerFeeDestination) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5a324eb7f1dd793e, []int{0}
+}
+
 // Params holds parameters for the incentives module
 type Params struct {
 	PoolCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=pool_creation_fee,json=poolCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pool_creation_fee" yaml:"pool_creation_fee"`
+	TakerFeeParams  TakerFeeParams                           `protobuf:"bytes,2,opt,name=taker_fee_params,json=takerFeeParams,proto3" json:"taker_fee_params" yaml:"taker_fee_params"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -72,18 +106,141 @@ func (m *Params) GetPoolCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *Params) GetTakerFeeParams() TakerFeeParams {
+	if m != nil {
+		return m.TakerFeeParams
+	}
+	return TakerFeeParams{}
+}
+
+// TakerFeeParams configures the protocol's taker fee, which is taken from the
+// tokens swapped into every gamm pool, on top of the pool's swap fee.
+type TakerFeeParams struct {
+	// taker_fee is the fraction of the tokens in taken, unless the denom pair
+	// swapped has an override.
+	TakerFee    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=taker_fee,json=takerFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"taker_fee" yaml:"taker_fee"`
+	Overrides   []TakerFeeOverride                     `protobuf:"bytes,2,rep,name=overrides,proto3" json:"overrides" yaml:"overrides"`
+	Destination TakerFeeDestination                    `protobuf:"varint,3,opt,name=destination,proto3,enum=osmosis.gamm.v1beta1.TakerFeeDestination" json:"destination,omitempty" yaml:"destination"`
+}
+
+func (m *TakerFeeParams) Reset()         { *m = TakerFeeParams{} }
+func (m *TakerFeeParams) String() string { return proto.CompactTextString(m) }
+func (*TakerFeeParams) ProtoMessage()    {}
+func (*TakerFeeParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a324eb7f1dd793e, []int{1}
+}
+func (m *TakerFeeParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TakerFeeParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TakerFeeParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TakerFeeParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TakerFeeParams.Merge(m, src)
+}
+func (m *TakerFeeParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *TakerFeeParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_TakerFeeParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TakerFeeParams proto.InternalMessageInfo
+
+func (m *TakerFeeParams) GetOverrides() []TakerFeeOverride {
+	if m != nil {
+		return m.Overrides
+	}
+	return nil
+}
+
+func (m *TakerFeeParams) GetDestination() TakerFeeDestination {
+	if m != nil {
+		return m.Destination
+	}
+	return CommunityPool
+}
+
+// TakerFeeOverride sets the taker fee of swaps between two denoms, in either
+// direction.
+type TakerFeeOverride struct {
+	Denom0   string                                 `protobuf:"bytes,1,opt,name=denom0,proto3" json:"denom0,omitempty" yaml:"denom0"`
+	Denom1   string                                 `protobuf:"bytes,2,opt,name=denom1,proto3" json:"denom1,omitempty" yaml:"denom1"`
+	TakerFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=taker_fee,json=takerFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"taker_fee" yaml:"taker_fee"`
+}
+
+func (m *TakerFeeOverride) Reset()         { *m = TakerFeeOverride{} }
+func (m *TakerFeeOverride) String() string { return proto.CompactTextString(m) }
+func (*TakerFeeOverride) ProtoMessage()    {}
+func (*TakerFeeOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a324eb7f1dd793e, []int{2}
+}
+func (m *TakerFeeOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TakerFeeOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TakerFeeOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TakerFeeOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TakerFeeOverride.Merge(m, src)
+}
+func (m *TakerFeeOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *TakerFeeOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_TakerFeeOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TakerFeeOverride proto.InternalMessageInfo
+
+func (m *TakerFeeOverride) GetDenom0() string {
+	if m != nil {
+		return m.Denom0
+	}
+	return ""
+}
+
+func (m *TakerFeeOverride) GetDenom1() string {
+	if m != nil {
+		return m.Denom1
+	}
+	return ""
+}
+
 // GenesisState defines the gamm module's genesis state.
 type GenesisState struct {
-	Pools          []*types1.Any `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
-	NextPoolNumber uint64        `protobuf:"varint,2,opt,name=next_pool_number,json=nextPoolNumber,proto3" json:"next_pool_number,omitempty"`
-	Params         Params        `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	Pools []*types1.Any `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
+	// Deprecated: the next pool ID is tracked by the swaprouter module, for pools
+	// of every type. This field is no longer set or read.
+	NextPoolNumber uint64 `protobuf:"varint,2,opt,name=next_pool_number,json=nextPoolNumber,proto3" json:"next_pool_number,omitempty"`
+	Params         Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	// taker_fees_collected are the taker fees collected so far, by denom.
+	TakerFeesCollected github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=taker_fees_collected,json=takerFeesCollected,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"taker_fees_collected" yaml:"taker_fees_collected"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5a324eb7f1dd793e, []int{1}
+	return fileDescriptor_5a324eb7f1dd793e, []int{3}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -133,8 +290,18 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetTakerFeesCollected() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TakerFeesCollected
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("osmosis.gamm.v1beta1.TakerFeeDestination", TakerFeeDestination_name, TakerFeeDestination_value)
 	proto.RegisterType((*Params)(nil), "osmosis.gamm.v1beta1.Params")
+	proto.RegisterType((*TakerFeeParams)(nil), "osmosis.gamm.v1beta1.TakerFeeParams")
+	proto.RegisterType((*TakerFeeOverride)(nil), "osmosis.gamm.v1beta1.TakerFeeOverride")
 	proto.RegisterType((*GenesisState)(nil), "osmosis.gamm.v1beta1.GenesisState")
 }
 
@@ -143,32 +310,50 @@ func init() {
 }
 
 var fileDescriptor_5a324eb7f1dd793e = []byte{
-	// 400 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcf, 0xaa, 0xd3, 0x40,
-	0x18, 0xc5, 0x33, 0xde, 0x7b, 0x0b, 0xe6, 0x8a, 0x7f, 0x42, 0x17, 0xb9, 0x17, 0x49, 0x4b, 0x56,
-	0xd9, 0x74, 0x86, 0x5b, 0x11, 0xa1, 0x3b, 0x53, 0x50, 0x0a, 0x22, 0x25, 0xee, 0xdc, 0x84, 0x49,
-	0x9c, 0xc6, 0x60, 0x32, 0x5f, 0xc8, 0x4c, 0x4b, 0xf3, 0x16, 0x82, 0x7b, 0x1f, 0x40, 0xb7, 0x3e,
-	0x44, 0x71, 0xd5, 0xa5, 0xab, 0x2a, 0xed, 0x1b, 0xf8, 0x04, 0x32, 0x7f, 0x22, 0x82, 0x77, 0x95,
-	0x7c, 0xf3, 0xfd, 0xce, 0xe1, 0xcc, 0x49, 0xdc, 0x10, 0x44, 0x0d, 0xa2, 0x14, 0xa4, 0xa0, 0x75,
-	0x4d, 0x36, 0x37, 0x19, 0x93, 0xf4, 0x86, 0x14, 0x8c, 0x33, 0x51, 0x0a, 0xdc, 0xb4, 0x20, 0xc1,
-	0x1b, 0x5a, 0x06, 0x2b, 0x06, 0x5b, 0xe6, 0x7a, 0x58, 0x40, 0x01, 0x1a, 0x20, 0xea, 0xcd, 0xb0,
-	0xd7, 0x57, 0x05, 0x40, 0x51, 0x31, 0xa2, 0xa7, 0x6c, 0xbd, 0x22, 0x94, 0x77, 0xfd, 0x2a, 0xd7,
-	0x3e, 0xa9, 0xd1, 0x98, 0xc1, 0xae, 0x02, 0x33, 0x91, 0x8c, 0x0a, 0xf6, 0x37, 0x44, 0x0e, 0x25,
-	0x37, 0xfb, 0xf0, 0x33, 0x72, 0x07, 0x4b, 0xda, 0xd2, 0x5a, 0x78, 0x9f, 0x90, 0xfb, 0xa8, 0x01,
-	0xa8, 0xd2, 0xbc, 0x65, 0x54, 0x96, 0xc0, 0xd3, 0x15, 0x63, 0x3e, 0x1a, 0x9f, 0x45, 0x97, 0xd3,
-	0x2b, 0x6c, 0x5d, 0x95, 0x4f, 0x1f, 0x14, 0xcf, 0xa1, 0xe4, 0xf1, 0xab, 0xdd, 0x61, 0xe4, 0xfc,
-	0x3e, 0x8c, 0xfc, 0x8e, 0xd6, 0xd5, 0x2c, 0xfc, 0xcf, 0x21, 0xfc, 0xf2, 0x73, 0x14, 0x15, 0xa5,
-	0x7c, 0xbf, 0xce, 0x70, 0x0e, 0xb5, 0x8d, 0x67, 0x1f, 0x13, 0xf1, 0xee, 0x03, 0x91, 0x5d, 0xc3,
-	0x84, 0x36, 0x13, 0xc9, 0x03, 0xa5, 0x9f, 0x5b, 0xf9, 0x0b, 0xc6, 0xc2, 0xaf, 0xc8, 0xbd, 0xf7,
-	0xd2, 0x94, 0xf6, 0x46, 0x52, 0xc9, 0xbc, 0xa7, 0xee, 0x85, 0x62, 0x84, 0x4d, 0x36, 0xc4, 0xa6,
-	0x17, 0xdc, 0xf7, 0x82, 0x9f, 0xf3, 0x2e, 0xbe, 0xfb, 0xfd, 0xdb, 0xe4, 0x62, 0x09, 0x50, 0x2d,
-	0x12, 0x43, 0x7b, 0x91, 0xfb, 0x90, 0xb3, 0xad, 0x4c, 0x75, 0x3e, 0xbe, 0xae, 0x33, 0xd6, 0xfa,
-	0x77, 0xc6, 0x28, 0x3a, 0x4f, 0xee, 0xab, 0x73, 0xc5, 0xbe, 0xd6, 0xa7, 0xde, 0xcc, 0x1d, 0x34,
-	0xba, 0x11, 0xff, 0x6c, 0x8c, 0xa2, 0xcb, 0xe9, 0x63, 0x7c, 0xdb, 0x57, 0xc2, 0xa6, 0xb5, 0xf8,
-	0x5c, 0x5d, 0x3f, 0xb1, 0x8a, 0x78, 0xb1, 0x3b, 0x06, 0x68, 0x7f, 0x0c, 0xd0, 0xaf, 0x63, 0x80,
-	0x3e, 0x9e, 0x02, 0x67, 0x7f, 0x0a, 0x9c, 0x1f, 0xa7, 0xc0, 0x79, 0x4b, 0xfe, 0xa9, 0xc0, 0xfa,
-	0x4d, 0x2a, 0x9a, 0x89, 0x7e, 0x20, 0x9b, 0x67, 0x64, 0x6b, 0xfe, 0x15, 0xdd, 0x47, 0x36, 0xd0,
-	0x17, 0x7a, 0xf2, 0x27, 0x00, 0x00, 0xff, 0xff, 0xda, 0xf2, 0x18, 0x37, 0x48, 0x02, 0x00, 0x00,
+	// 678 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0xe3, 0x24, 0x8d, 0x9a, 0x6d, 0x1b, 0xdc, 0x25, 0x02, 0xb7, 0x20, 0x3b, 0xb2, 0x50,
+	0x95, 0x22, 0xd5, 0x26, 0x45, 0x08, 0xa9, 0x37, 0x9c, 0xaa, 0xa8, 0x08, 0xd1, 0xca, 0x70, 0xe2,
+	0x80, 0x65, 0x3b, 0x53, 0x63, 0xd5, 0xf6, 0x46, 0x5e, 0xa7, 0x6a, 0xde, 0x80, 0x1b, 0x48, 0x5c,
+	0xb9, 0x71, 0xe3, 0x8c, 0x78, 0x86, 0xd2, 0x53, 0x8f, 0x88, 0x43, 0x40, 0xed, 0x1b, 0xe4, 0x09,
+	0xd0, 0xda, 0xeb, 0x34, 0x09, 0x51, 0x01, 0x89, 0x53, 0xb2, 0xbb, 0xff, 0x7c, 0xfb, 0xcf, 0xec,
+	0x8c, 0x91, 0x4a, 0x68, 0x48, 0xa8, 0x4f, 0x75, 0xcf, 0x0e, 0x43, 0xfd, 0xa8, 0xe5, 0x40, 0x62,
+	0xb7, 0x74, 0x0f, 0x22, 0xa0, 0x3e, 0xd5, 0xba, 0x31, 0x49, 0x08, 0xae, 0x73, 0x8d, 0xc6, 0x34,
+	0x1a, 0xd7, 0xac, 0xd6, 0x3d, 0xe2, 0x91, 0x54, 0xa0, 0xb3, 0x7f, 0x99, 0x76, 0x75, 0xc5, 0x23,
+	0xc4, 0x0b, 0x40, 0x4f, 0x57, 0x4e, 0xef, 0x40, 0xb7, 0xa3, 0x7e, 0x7e, 0xe4, 0xa6, 0x1c, 0x2b,
+	0x8b, 0xc9, 0x16, 0xfc, 0x48, 0xce, 0x56, 0xba, 0x63, 0x53, 0x18, 0x99, 0x70, 0x89, 0x1f, 0x65,
+	0xe7, 0xea, 0xdb, 0x22, 0xaa, 0xec, 0xdb, 0xb1, 0x1d, 0x52, 0xfc, 0x5e, 0x40, 0xcb, 0x5d, 0x42,
+	0x02, 0xcb, 0x8d, 0xc1, 0x4e, 0x7c, 0x12, 0x59, 0x07, 0x00, 0x92, 0xd0, 0x28, 0x35, 0x17, 0x36,
+	0x57, 0x34, 0x4e, 0x65, 0x9c, 0xdc, 0xa8, 0xd6, 0x26, 0x7e, 0x64, 0x3c, 0x3d, 0x19, 0x28, 0x85,
+	0xe1, 0x40, 0x91, 0xfa, 0x76, 0x18, 0x6c, 0xa9, 0xbf, 0x11, 0xd4, 0x4f, 0x3f, 0x94, 0xa6, 0xe7,
+	0x27, 0xaf, 0x7b, 0x8e, 0xe6, 0x92, 0x90, 0xdb, 0xe3, 0x3f, 0x1b, 0xb4, 0x73, 0xa8, 0x27, 0xfd,
+	0x2e, 0xd0, 0x14, 0x46, 0xcd, 0x6b, 0x2c, 0xbe, 0xcd, 0xc3, 0x77, 0x00, 0x30, 0x41, 0x62, 0x62,
+	0x1f, 0x42, 0xcc, 0x50, 0x56, 0x37, 0x75, 0x2a, 0x15, 0x1b, 0x42, 0x73, 0x61, 0xf3, 0x8e, 0x36,
+	0xab, 0x7a, 0xda, 0x0b, 0xa6, 0xde, 0x01, 0xc8, 0xb2, 0x32, 0x14, 0x6e, 0xef, 0x66, 0x66, 0x6f,
+	0x9a, 0xa5, 0x9a, 0xb5, 0x64, 0x22, 0x40, 0xfd, 0x52, 0x44, 0xb5, 0x49, 0x06, 0xb6, 0x50, 0x75,
+	0x14, 0x27, 0x09, 0x0d, 0xa1, 0x59, 0x35, 0x0c, 0x86, 0xfd, 0x3e, 0x50, 0xd6, 0xfe, 0x22, 0xb3,
+	0x6d, 0x70, 0x87, 0x03, 0x45, 0x9c, 0x32, 0xa0, 0x9a, 0xf3, 0xf9, 0xcd, 0xf8, 0x15, 0xaa, 0x92,
+	0x23, 0x88, 0x63, 0xbf, 0x03, 0x2c, 0x3b, 0x56, 0xf1, 0xb5, 0xab, 0xb3, 0xdb, 0xe3, 0x72, 0x43,
+	0xe2, 0xf9, 0x71, 0xfc, 0x08, 0xa3, 0x9a, 0x97, 0x48, 0xec, 0xa2, 0x85, 0x0e, 0xd0, 0xc4, 0x8f,
+	0xd2, 0xb2, 0x4a, 0xa5, 0x86, 0xd0, 0xac, 0x6d, 0xae, 0x5f, 0x7d, 0xc3, 0xf6, 0x65, 0x80, 0x71,
+	0x63, 0x38, 0x50, 0x70, 0x76, 0xc1, 0x18, 0x47, 0x35, 0xc7, 0xa9, 0xea, 0x57, 0x01, 0x89, 0xd3,
+	0xf6, 0xf0, 0x3a, 0xaa, 0x74, 0x20, 0x22, 0xe1, 0x3d, 0x5e, 0xb7, 0xe5, 0xe1, 0x40, 0x59, 0xca,
+	0x49, 0x6c, 0x5f, 0x35, 0xb9, 0x60, 0x24, 0x6d, 0x49, 0xc5, 0x99, 0xd2, 0x56, 0x2e, 0x6d, 0x4d,
+	0x3e, 0x48, 0xe9, 0xff, 0x3f, 0x88, 0x7a, 0x5a, 0x44, 0x8b, 0x8f, 0xb3, 0x51, 0x7d, 0x9e, 0xd8,
+	0x09, 0xe0, 0x07, 0x68, 0x8e, 0x75, 0x26, 0xe5, 0xf3, 0x50, 0xd7, 0xb2, 0x69, 0xd4, 0xf2, 0x69,
+	0xd4, 0x1e, 0x45, 0x7d, 0xa3, 0x7a, 0xfa, 0x79, 0x63, 0x6e, 0x9f, 0x90, 0x60, 0xd7, 0xcc, 0xd4,
+	0xb8, 0x89, 0xc4, 0x08, 0x8e, 0x13, 0x8b, 0xad, 0xac, 0xa8, 0x17, 0x3a, 0x10, 0xa7, 0xd9, 0x95,
+	0xcd, 0x1a, 0xdb, 0x67, 0xda, 0x67, 0xe9, 0x2e, 0xde, 0x42, 0x15, 0xde, 0xdd, 0xa5, 0xb4, 0xbb,
+	0x6f, 0xcf, 0x7e, 0x1d, 0xde, 0xd5, 0x65, 0x96, 0xad, 0xc9, 0x23, 0xf0, 0x07, 0x01, 0xd5, 0x47,
+	0x69, 0x50, 0xcb, 0x25, 0x41, 0x00, 0x6e, 0x02, 0x1d, 0xa9, 0xfc, 0xa7, 0xe1, 0xdd, 0xe3, 0xdd,
+	0x73, 0x6b, 0xaa, 0x16, 0x63, 0x90, 0x7f, 0x9b, 0x5f, 0x9c, 0x97, 0x90, 0xb6, 0x73, 0xc0, 0xdd,
+	0x27, 0xe8, 0xfa, 0x8c, 0xa6, 0xc2, 0xcb, 0x68, 0xa9, 0x4d, 0xc2, 0xb0, 0x17, 0xf9, 0x49, 0x9f,
+	0x15, 0x42, 0x2c, 0x60, 0x11, 0x2d, 0xee, 0x00, 0xf0, 0x48, 0x12, 0x8b, 0x02, 0x9e, 0x47, 0x65,
+	0xa3, 0x17, 0x47, 0x62, 0x71, 0xb5, 0xfc, 0xe6, 0xa3, 0x5c, 0x30, 0x76, 0x4f, 0xce, 0x65, 0xe1,
+	0xec, 0x5c, 0x16, 0x7e, 0x9e, 0xcb, 0xc2, 0xbb, 0x0b, 0xb9, 0x70, 0x76, 0x21, 0x17, 0xbe, 0x5d,
+	0xc8, 0x85, 0x97, 0xfa, 0x98, 0x47, 0x5e, 0xba, 0x8d, 0xc0, 0x76, 0x68, 0xbe, 0xd0, 0x8f, 0x1e,
+	0xea, 0xc7, 0xd9, 0xc7, 0x38, 0x35, 0xec, 0x54, 0xd2, 0xb7, 0xbb, 0xff, 0x6b, 0x00, 0xfa, 0x86,
+	0x6e, 0x2c, 0xa9, 0x05, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -191,6 +376,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.TakerFeeParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.PoolCreationFee) > 0 {
 		for iNdEx := len(m.PoolCreationFee) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -208,6 +403,105 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TakerFeeParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TakerFeeParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TakerFeeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Destination != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Destination))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Overrides) > 0 {
+		for iNdEx := len(m.Overrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Overrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.TakerFee.Size()
+		i -= size
+		if _, err := m.TakerFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TakerFeeOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TakerFeeOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TakerFeeOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TakerFee.Size()
+		i -= size
+		if _, err := m.TakerFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom1) > 0 {
+		i -= len(m.Denom1)
+		copy(dAtA[i:], m.Denom1)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom1)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom0) > 0 {
+		i -= len(m.Denom0)
+		copy(dAtA[i:], m.Denom0)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom0)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -228,6 +522,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TakerFeesCollected) > 0 {
+		for iNdEx := len(m.TakerFeesCollected) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TakerFeesCollected[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -283,6 +591,47 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.TakerFeeParams.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *TakerFeeParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TakerFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Overrides) > 0 {
+		for _, e := range m.Overrides {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Destination != 0 {
+		n += 1 + sovGenesis(uint64(m.Destination))
+	}
+	return n
+}
+
+func (m *TakerFeeOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom0)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Denom1)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.TakerFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -303,6 +652,12 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.TakerFeesCollected) > 0 {
+		for _, e := range m.TakerFeesCollected {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -375,6 +730,324 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFeeParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TakerFeeParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TakerFeeParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TakerFeeParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Overrides = append(m.Overrides, TakerFeeOverride{})
+			if err := m.Overrides[len(m.Overrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			m.Destination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Destination |= TakerFeeDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TakerFeeOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TakerFeeOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TakerFeeOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom0 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom1 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -511,6 +1184,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeesCollected", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TakerFeesCollected = append(m.TakerFeesCollected, types.Coin{})
+			if err := m.TakerFeesCollected[len(m.TakerFeesCollected)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,73 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalTypeCleanupPools = "CleanupPools"
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeCleanupPools)
+	govtypes.RegisterProposalTypeCodec(&CleanupPoolsProposal{}, "osmosis/CleanupPoolsProposal")
+}
+
+var _ govtypes.Content = &CleanupPoolsProposal{}
+
+func NewCleanupPoolsProposal(title, description string, poolIds []uint64) *CleanupPoolsProposal {
+	return &CleanupPoolsProposal{
+		Title:       title,
+		Description: description,
+		PoolIds:     poolIds,
+	}
+}
+
+func (p *CleanupPoolsProposal) GetTitle() string { return p.Title }
+
+func (p *CleanupPoolsProposal) GetDescription() string { return p.Description }
+
+func (p *CleanupPoolsProposal) ProposalRoute() string { return RouterKey }
+
+func (p *CleanupPoolsProposal) ProposalType() string {
+	return ProposalTypeCleanupPools
+}
+
+func (p *CleanupPoolsProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	return ValidateCleanupPoolIds(p.PoolIds)
+}
+
+func (p CleanupPoolsProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Cleanup Pools Proposal:
+  Title:       %s
+  Description: %s
+  Pool IDs:    %v
+`, p.Title, p.Description, p.PoolIds))
+	return b.String()
+}
+
+// ValidateCleanupPoolIds checks that pools to clean up are listed, and listed
+// once.
+func ValidateCleanupPoolIds(poolIds []uint64) error {
+	if len(poolIds) == 0 {
+		return sdkerrors.Wrap(ErrInvalidPoolIds, "no pools to clean up")
+	}
+	seen := make(map[uint64]bool, len(poolIds))
+	for _, poolId := range poolIds {
+		if seen[poolId] {
+			return sdkerrors.Wrapf(ErrInvalidPoolIds, "pool %d is listed twice", poolId)
+		}
+		seen[poolId] = true
+	}
+	return nil
+}
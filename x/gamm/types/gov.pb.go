@@ -0,0 +1,1238 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/gamm/v1beta1/gov.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// CleanupPoolsProposal is a gov Content type for retiring pools. Each listed
+// pool is frozen, every holder of its shares, locked or not, is paid their
+// pro-rata share of the pool's liquidity, the shares are burnt and the pool is
+// deleted.
+type CleanupPoolsProposal struct {
+	Title       string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	PoolIds     []uint64 `protobuf:"varint,3,rep,packed,name=pool_ids,json=poolIds,proto3" json:"pool_ids,omitempty" yaml:"pool_ids"`
+}
+
+func (m *CleanupPoolsProposal) Reset()      { *m = CleanupPoolsProposal{} }
+func (*CleanupPoolsProposal) ProtoMessage() {}
+func (*CleanupPoolsProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f31b9a6c0dbbdfa3, []int{0}
+}
+func (m *CleanupPoolsProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CleanupPoolsProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CleanupPoolsProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CleanupPoolsProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CleanupPoolsProposal.Merge(m, src)
+}
+func (m *CleanupPoolsProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *CleanupPoolsProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_CleanupPoolsProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CleanupPoolsProposal proto.InternalMessageInfo
+
+// PoolCleanup lists who is paid what when a pool is cleaned up.
+type PoolCleanup struct {
+	PoolId  uint64          `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Payouts []CleanupPayout `protobuf:"bytes,2,rep,name=payouts,proto3" json:"payouts" yaml:"payouts"`
+	// remainder is the liquidity left over from rounding the payouts down, which
+	// goes to the community pool.
+	Remainder github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=remainder,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"remainder" yaml:"remainder"`
+}
+
+func (m *PoolCleanup) Reset()         { *m = PoolCleanup{} }
+func (m *PoolCleanup) String() string { return proto.CompactTextString(m) }
+func (*PoolCleanup) ProtoMessage()    {}
+func (*PoolCleanup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f31b9a6c0dbbdfa3, []int{1}
+}
+func (m *PoolCleanup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolCleanup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolCleanup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolCleanup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolCleanup.Merge(m, src)
+}
+func (m *PoolCleanup) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolCleanup) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolCleanup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolCleanup proto.InternalMessageInfo
+
+func (m *PoolCleanup) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolCleanup) GetPayouts() []CleanupPayout {
+	if m != nil {
+		return m.Payouts
+	}
+	return nil
+}
+
+func (m *PoolCleanup) GetRemainder() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Remainder
+	}
+	return nil
+}
+
+// CleanupPayout is what a share holder is paid for their shares of a pool.
+type CleanupPayout struct {
+	Address        string                                   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	UnlockedShares github_com_cosmos_cosmos_sdk_types.Int   `protobuf:"bytes,2,opt,name=unlocked_shares,json=unlockedShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"unlocked_shares" yaml:"unlocked_shares"`
+	LockedShares   github_com_cosmos_cosmos_sdk_types.Int   `protobuf:"bytes,3,opt,name=locked_shares,json=lockedShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"locked_shares" yaml:"locked_shares"`
+	Tokens         github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=tokens,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tokens" yaml:"tokens"`
+	// lock_ids are the locks of the shares, which are force unlocked.
+	LockIds []uint64 `protobuf:"varint,5,rep,packed,name=lock_ids,json=lockIds,proto3" json:"lock_ids,omitempty" yaml:"lock_ids"`
+}
+
+func (m *CleanupPayout) Reset()         { *m = CleanupPayout{} }
+func (m *CleanupPayout) String() string { return proto.CompactTextString(m) }
+func (*CleanupPayout) ProtoMessage()    {}
+func (*CleanupPayout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f31b9a6c0dbbdfa3, []int{2}
+}
+func (m *CleanupPayout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CleanupPayout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CleanupPayout.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CleanupPayout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CleanupPayout.Merge(m, src)
+}
+func (m *CleanupPayout) XXX_Size() int {
+	return m.Size()
+}
+func (m *CleanupPayout) XXX_DiscardUnknown() {
+	xxx_messageInfo_CleanupPayout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CleanupPayout proto.InternalMessageInfo
+
+func (m *CleanupPayout) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *CleanupPayout) GetTokens() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+func (m *CleanupPayout) GetLockIds() []uint64 {
+	if m != nil {
+		return m.LockIds
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*CleanupPoolsProposal)(nil), "osmosis.gamm.v1beta1.CleanupPoolsProposal")
+	proto.RegisterType((*PoolCleanup)(nil), "osmosis.gamm.v1beta1.PoolCleanup")
+	proto.RegisterType((*CleanupPayout)(nil), "osmosis.gamm.v1beta1.CleanupPayout")
+}
+
+func init() { proto.RegisterFile("osmosis/gamm/v1beta1/gov.proto", fileDescriptor_f31b9a6c0dbbdfa3) }
+
+var fileDescriptor_f31b9a6c0dbbdfa3 = []byte{
+	// 563 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x31, 0x6f, 0xd3, 0x40,
+	0x18, 0xb5, 0x9b, 0x34, 0xa1, 0x97, 0xa4, 0xad, 0x8e, 0x28, 0x0a, 0x1d, 0x7c, 0xd1, 0x21, 0x55,
+	0x91, 0xa0, 0xb6, 0x5a, 0x06, 0x50, 0x37, 0x0c, 0x42, 0x64, 0xab, 0x8c, 0x58, 0x58, 0xaa, 0x4b,
+	0x7c, 0x4a, 0xad, 0xd8, 0x3e, 0xe3, 0xbb, 0x44, 0x64, 0x60, 0x67, 0xec, 0xc8, 0x98, 0x99, 0x1f,
+	0xc0, 0x1f, 0x60, 0xe9, 0xd8, 0x11, 0x31, 0x18, 0x94, 0x2c, 0xcc, 0xf9, 0x05, 0xc8, 0x77, 0xe7,
+	0x36, 0x89, 0x90, 0xa0, 0x53, 0x2e, 0xf7, 0xde, 0xbd, 0xf7, 0x7c, 0xef, 0xb3, 0x81, 0xc5, 0x78,
+	0xc4, 0x78, 0xc0, 0x9d, 0x21, 0x89, 0x22, 0x67, 0x72, 0xdc, 0xa7, 0x82, 0x1c, 0x3b, 0x43, 0x36,
+	0xb1, 0x93, 0x94, 0x09, 0x06, 0x9b, 0x1a, 0xb7, 0x73, 0xdc, 0xd6, 0xf8, 0x41, 0x73, 0xc8, 0x86,
+	0x4c, 0x12, 0x9c, 0x7c, 0xa5, 0xb8, 0x07, 0xd6, 0x40, 0x92, 0x9d, 0x3e, 0xe1, 0xf4, 0x46, 0x6a,
+	0xc0, 0x82, 0x58, 0xe1, 0xf8, 0xab, 0x09, 0x9a, 0x2f, 0x42, 0x4a, 0xe2, 0x71, 0x72, 0xc6, 0x58,
+	0xc8, 0xcf, 0x52, 0x96, 0x30, 0x4e, 0x42, 0x78, 0x08, 0xb6, 0x45, 0x20, 0x42, 0xda, 0x36, 0x3b,
+	0x66, 0x77, 0xc7, 0xdd, 0x5f, 0x66, 0xa8, 0x3e, 0x25, 0x51, 0x78, 0x8a, 0xe5, 0x36, 0xf6, 0x14,
+	0x0c, 0x9f, 0x81, 0x9a, 0x4f, 0xf9, 0x20, 0x0d, 0x12, 0x11, 0xb0, 0xb8, 0xbd, 0x25, 0xd9, 0xad,
+	0x65, 0x86, 0xa0, 0x62, 0xaf, 0x80, 0xd8, 0x5b, 0xa5, 0x42, 0x1b, 0xdc, 0x4b, 0x18, 0x0b, 0xcf,
+	0x03, 0x9f, 0xb7, 0x4b, 0x9d, 0x52, 0xb7, 0xec, 0xde, 0x5f, 0x66, 0x68, 0x4f, 0x1d, 0x2b, 0x10,
+	0xec, 0x55, 0xf3, 0x65, 0xcf, 0xe7, 0xa7, 0xf5, 0x4f, 0x33, 0x64, 0x7c, 0x9e, 0x21, 0xe3, 0xf7,
+	0x0c, 0x99, 0xf8, 0x72, 0x0b, 0xd4, 0xf2, 0xc4, 0x3a, 0x3c, 0x7c, 0x04, 0xaa, 0xfa, 0x8c, 0x4c,
+	0x5c, 0x76, 0xe1, 0x32, 0x43, 0xbb, 0x6b, 0x62, 0xd8, 0xab, 0x28, 0x2d, 0xf8, 0x16, 0x54, 0x13,
+	0x32, 0x65, 0x63, 0xc1, 0xdb, 0x5b, 0x9d, 0x52, 0xb7, 0x76, 0xf2, 0xd0, 0xfe, 0xdb, 0x9d, 0xda,
+	0xc5, 0xcd, 0x48, 0xae, 0xdb, 0xba, 0xca, 0x90, 0xb1, 0xa2, 0xaa, 0x14, 0xf2, 0x84, 0x6a, 0x05,
+	0x3f, 0x82, 0x9d, 0x94, 0x46, 0x24, 0x88, 0x7d, 0x9a, 0xca, 0x47, 0xaa, 0x9d, 0x3c, 0xb0, 0x55,
+	0x01, 0x76, 0x5e, 0xc0, 0xad, 0x2e, 0x0b, 0x62, 0xf7, 0xa5, 0x96, 0xdb, 0x57, 0x72, 0x37, 0x27,
+	0xf1, 0x97, 0x9f, 0xa8, 0x3b, 0x0c, 0xc4, 0xc5, 0xb8, 0x6f, 0x0f, 0x58, 0xe4, 0xe8, 0x06, 0xd5,
+	0xcf, 0x11, 0xf7, 0x47, 0x8e, 0x98, 0x26, 0x94, 0x4b, 0x11, 0xee, 0xdd, 0x3a, 0xe2, 0x6f, 0x25,
+	0xd0, 0x58, 0x4b, 0x0c, 0x1f, 0x83, 0x2a, 0xf1, 0xfd, 0x94, 0x72, 0xae, 0x6b, 0x5c, 0xb9, 0x14,
+	0x0d, 0x60, 0xaf, 0xa0, 0xc0, 0xf7, 0x60, 0x6f, 0x1c, 0x87, 0x6c, 0x30, 0xa2, 0xfe, 0x39, 0xbf,
+	0x20, 0x29, 0xe5, 0xba, 0xce, 0xd7, 0x79, 0xd2, 0x1f, 0x19, 0x3a, 0xfc, 0x8f, 0x54, 0xbd, 0x58,
+	0x2c, 0x33, 0xd4, 0x52, 0x1e, 0x1b, 0x72, 0xd8, 0xdb, 0x2d, 0x76, 0xde, 0xc8, 0x0d, 0x38, 0x02,
+	0x8d, 0x75, 0xc3, 0x92, 0x34, 0x7c, 0x75, 0x67, 0xc3, 0xa6, 0x32, 0xdc, 0xb0, 0xab, 0xaf, 0x99,
+	0x09, 0x50, 0x11, 0x6c, 0x44, 0x63, 0xde, 0x2e, 0xff, 0xab, 0x9b, 0xe7, 0xba, 0x9b, 0x86, 0x1e,
+	0x79, 0x79, 0xec, 0x6e, 0xc5, 0x68, 0xaf, 0x7c, 0xcc, 0xf3, 0x14, 0x72, 0xcc, 0xb7, 0x37, 0xc7,
+	0xbc, 0x40, 0xb0, 0x57, 0xcd, 0x97, 0x3d, 0x9f, 0xbb, 0xbd, 0xab, 0xb9, 0x65, 0x5e, 0xcf, 0x2d,
+	0xf3, 0xd7, 0xdc, 0x32, 0x2f, 0x17, 0x96, 0x71, 0xbd, 0xb0, 0x8c, 0xef, 0x0b, 0xcb, 0x78, 0xe7,
+	0xac, 0x78, 0xeb, 0x71, 0x3d, 0x0a, 0x49, 0x9f, 0x17, 0x7f, 0x9c, 0xc9, 0x53, 0xe7, 0x83, 0xfa,
+	0x68, 0xc8, 0x20, 0xfd, 0x8a, 0x7c, 0xc7, 0x9f, 0xfc, 0x19, 0x00, 0x7e, 0x05, 0x6b, 0x73, 0x51,
+	0x04, 0x00, 0x00,
+}
+
+func (this *CleanupPoolsProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CleanupPoolsProposal)
+	if !ok {
+		that2, ok := that.(CleanupPoolsProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if len(this.PoolIds) != len(that1.PoolIds) {
+		return false
+	}
+	for i := range this.PoolIds {
+		if this.PoolIds[i] != that1.PoolIds[i] {
+			return false
+		}
+	}
+	return true
+}
+func (m *CleanupPoolsProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CleanupPoolsProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CleanupPoolsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PoolIds) > 0 {
+		dAtA2 := make([]byte, len(m.PoolIds)*10)
+		var j1 int
+		for _, num := range m.PoolIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintGov(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolCleanup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolCleanup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolCleanup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Remainder) > 0 {
+		for iNdEx := len(m.Remainder) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Remainder[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Payouts) > 0 {
+		for iNdEx := len(m.Payouts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payouts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CleanupPayout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CleanupPayout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CleanupPayout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LockIds) > 0 {
+		dAtA4 := make([]byte, len(m.LockIds)*10)
+		var j3 int
+		for _, num := range m.LockIds {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintGov(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.LockedShares.Size()
+		i -= size
+		if _, err := m.LockedShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.UnlockedShares.Size()
+		i -= size
+		if _, err := m.UnlockedShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGov(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CleanupPoolsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.PoolIds) > 0 {
+		l = 0
+		for _, e := range m.PoolIds {
+			l += sovGov(uint64(e))
+		}
+		n += 1 + sovGov(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *PoolCleanup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovGov(uint64(m.PoolId))
+	}
+	if len(m.Payouts) > 0 {
+		for _, e := range m.Payouts {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if len(m.Remainder) > 0 {
+		for _, e := range m.Remainder {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CleanupPayout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.UnlockedShares.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.LockedShares.Size()
+	n += 1 + l + sovGov(uint64(l))
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if len(m.LockIds) > 0 {
+		l = 0
+		for _, e := range m.LockIds {
+			l += sovGov(uint64(e))
+		}
+		n += 1 + sovGov(uint64(l)) + l
+	}
+	return n
+}
+
+func sovGov(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGov(x uint64) (n int) {
+	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CleanupPoolsProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CleanupPoolsProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CleanupPoolsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGov
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PoolIds = append(m.PoolIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGov
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGov
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGov
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PoolIds) == 0 {
+					m.PoolIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGov
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PoolIds = append(m.PoolIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolCleanup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolCleanup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolCleanup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payouts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payouts = append(m.Payouts, CleanupPayout{})
+			if err := m.Payouts[len(m.Payouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remainder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Remainder = append(m.Remainder, types.Coin{})
+			if err := m.Remainder[len(m.Remainder)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CleanupPayout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CleanupPayout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CleanupPayout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockedShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnlockedShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LockedShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, types.Coin{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGov
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.LockIds = append(m.LockIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGov
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGov
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGov
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.LockIds) == 0 {
+					m.LockIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGov
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.LockIds = append(m.LockIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGov(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGov
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGov
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGov
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGov        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGov          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGov = fmt.Errorf("proto: unexpected end of group")
+)
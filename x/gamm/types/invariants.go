@@ -0,0 +1,87 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PoolInvariantState is what the gamm invariants check a pool against. It is
+// filled from live state by the keeper, and from an exported genesis by the
+// offline audit command, so both run the exact same checks.
+type PoolInvariantState struct {
+	PoolId uint64
+	// Liquidity is the pool's GetTotalPoolLiquidity.
+	Liquidity sdk.Coins
+	// TotalShares is the pool's GetTotalShares.
+	TotalShares sdk.Int
+	// Balances are the bank balances of the pool account.
+	Balances sdk.Coins
+	// ShareSupply is the bank supply of the pool's share denom.
+	ShareSupply sdk.Int
+	// LockupShares are the shares held by the lockup module account.
+	LockupShares sdk.Int
+	// LockedShares are the shares recorded in the locks of the lockup module.
+	LockedShares sdk.Int
+}
+
+// ValidateBalances checks that the pool account holds at least the pool's
+// liquidity. Anyone can send coins to a pool account, so holding more is not
+// an error, and is reported by Surplus instead.
+func (s PoolInvariantState) ValidateBalances() error {
+	if !s.Balances.IsAllGTE(s.Liquidity) {
+		return fmt.Errorf("gamm pool id %d: pool account coins %s are less than pool liquidity %s",
+			s.PoolId, s.Balances, s.Liquidity)
+	}
+	return nil
+}
+
+// Surplus returns the coins the pool account holds beyond the pool's
+// liquidity.
+func (s PoolInvariantState) Surplus() sdk.Coins {
+	surplus := sdk.Coins{}
+	for _, coin := range s.Balances {
+		if extra := coin.Amount.Sub(s.Liquidity.AmountOf(coin.Denom)); extra.IsPositive() {
+			surplus = surplus.Add(sdk.NewCoin(coin.Denom, extra))
+		}
+	}
+	return surplus
+}
+
+// ValidateShares checks that the bank supply of the pool's share denom equals
+// its total shares. Locked shares stay in the supply, held by the lockup
+// module account, so it also checks that this balance covers what the locks
+// record. The lockup module account can hold more, as anyone can send coins
+// to it.
+func (s PoolInvariantState) ValidateShares() error {
+	if !s.ShareSupply.Equal(s.TotalShares) {
+		return fmt.Errorf("gamm pool id %d: bank supply of %s is %s, but the pool has %s total shares",
+			s.PoolId, GetPoolShareDenom(s.PoolId), s.ShareSupply, s.TotalShares)
+	}
+	if s.LockupShares.LT(s.LockedShares) {
+		return fmt.Errorf("gamm pool id %d: lockup module holds %s %s, less than its locks record, %s",
+			s.PoolId, s.LockupShares, GetPoolShareDenom(s.PoolId), s.LockedShares)
+	}
+	return nil
+}
+
+// ValidateTotalLiquidity checks that the recorded total liquidity index
+// equals the sum of the liquidity of every pool.
+func ValidateTotalLiquidity(pools []PoolInvariantState, recorded sdk.Coins) error {
+	expected := sdk.Coins{}
+	for _, pool := range pools {
+		expected = expected.Add(pool.Liquidity...)
+	}
+	// the index keeps zero entries for denoms that left every pool.
+	nonZero := sdk.Coins{}
+	for _, coin := range recorded {
+		if !coin.IsZero() {
+			nonZero = append(nonZero, coin)
+		}
+	}
+	if !expected.IsAllGTE(nonZero) || !nonZero.IsAllGTE(expected) {
+		return fmt.Errorf("recorded total liquidity %s does not equal the sum of pool liquidity %s",
+			nonZero, expected)
+	}
+	return nil
+}
@@ -20,11 +20,16 @@ const (
 
 var (
 	// KeyNextGlobalPoolNumber defines key to store the next Pool ID to be used.
+	// Deprecated: pool IDs are now tracked by the swaprouter module, and this is
+	// only read when migrating them there.
 	KeyNextGlobalPoolNumber = []byte{0x01}
 	// KeyPrefixPools defines prefix to store pools.
 	KeyPrefixPools = []byte{0x02}
 	// KeyTotalLiquidity defines key to store total liquidity.
 	KeyTotalLiquidity = []byte{0x03}
+	// KeyPrefixTakerFeesCollected defines prefix to store the taker fees
+	// collected, by denom.
+	KeyPrefixTakerFeesCollected = []byte{0x04}
 )
 
 func MustGetPoolIdFromShareDenom(denom string) uint64 {
@@ -36,6 +41,15 @@ func MustGetPoolIdFromShareDenom(denom string) uint64 {
 	return uint64(number)
 }
 
+// GetPoolIdFromShareDenom returns the ID of the pool a share denom belongs to,
+// or an error if denom is not a pool share denom.
+func GetPoolIdFromShareDenom(denom string) (uint64, error) {
+	if !strings.HasPrefix(denom, "gamm/pool/") {
+		return 0, fmt.Errorf("%s is not a pool share denom", denom)
+	}
+	return strconv.ParseUint(strings.TrimPrefix(denom, "gamm/pool/"), 10, 64)
+}
+
 func ValidatePoolShareDenom(denom string) error {
 	numberStr := strings.TrimLeft(denom, "gamm/pool/")
 	_, err := strconv.Atoi(numberStr)
@@ -49,6 +63,10 @@ func GetDenomPrefix(denom string) []byte {
 	return append(KeyTotalLiquidity, []byte(denom)...)
 }
 
+func GetKeyTakerFeeCollected(denom string) []byte {
+	return append(KeyPrefixTakerFeesCollected, []byte(denom)...)
+}
+
 func GetPoolShareDenom(poolId uint64) string {
 	return fmt.Sprintf("gamm/pool/%d", poolId)
 }
@@ -1,15 +1,12 @@
 package types
 
-// SwapMsg defines a simple interface for getting the token denoms on a swap message route.
-type SwapMsgRoute interface {
-	TokenInDenom() string
-	TokenOutDenom() string
-	TokenDenomsOnPath() []string
-}
+import (
+	swaproutertypes "github.com/osmosis-labs/osmosis/v7/x/swaprouter/types"
+)
 
 var (
-	_ SwapMsgRoute = MsgSwapExactAmountOut{}
-	_ SwapMsgRoute = MsgSwapExactAmountIn{}
+	_ swaproutertypes.SwapMsgRoute = MsgSwapExactAmountOut{}
+	_ swaproutertypes.SwapMsgRoute = MsgSwapExactAmountIn{}
 )
 
 func (msg MsgSwapExactAmountOut) TokenInDenom() string {
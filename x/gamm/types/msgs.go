@@ -7,6 +7,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	swaproutertypes "github.com/osmosis-labs/osmosis/v7/x/swaprouter/types"
 )
 
 // constants.
@@ -19,32 +21,53 @@ const (
 	TypeMsgJoinSwapShareAmountOut  = "join_swap_share_amount_out"
 	TypeMsgExitSwapExternAmountOut = "exit_swap_extern_amount_out"
 	TypeMsgExitSwapShareAmountIn   = "exit_swap_share_amount_in"
+	TypeMsgSetPoolFees             = "set_pool_fees"
+	TypeMsgSetPoolActive           = "set_pool_active"
 )
 
+// FutureGovernor is a parsed future pool governor. Either Address is set, and
+// that account governs the pool, or the pool is governed by whoever has locked
+// most of LockDenom for at least LockDuration. An empty LockDenom stands for
+// the pool's share denom.
+type FutureGovernor struct {
+	Address      sdk.AccAddress
+	LockDenom    string
+	LockDuration time.Duration
+}
+
 func ValidateFutureGovernor(governor string) error {
+	_, err := ParseFutureGovernor(governor)
+	return err
+}
+
+// ParseFutureGovernor parses a future pool governor, of one of the forms
+// {address}, {token name},{duration} or {duration}. The empty governor,
+// which governs nothing, parses to nil.
+func ParseFutureGovernor(governor string) (*FutureGovernor, error) {
 	// allow empty governor
 	if governor == "" {
-		return nil
+		return nil, nil
 	}
 
 	// validation for future owner
 	// "osmo1fqlr98d45v5ysqgp6h56kpujcj4cvsjnjq9nck"
-	_, err := sdk.AccAddressFromBech32(governor)
+	addr, err := sdk.AccAddressFromBech32(governor)
 	if err == nil {
-		return nil
+		return &FutureGovernor{Address: addr}, nil
 	}
 
+	lpTokenStr := ""
 	lockTimeStr := ""
 	splits := strings.Split(governor, ",")
 	if len(splits) > 2 {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid future governor: %s", governor))
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid future governor: %s", governor))
 	}
 
 	// token,100h
 	if len(splits) == 2 {
-		lpTokenStr := splits[0]
+		lpTokenStr = splits[0]
 		if sdk.ValidateDenom(lpTokenStr) != nil {
-			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid future governor: %s", governor))
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid future governor: %s", governor))
 		}
 		lockTimeStr = splits[1]
 	}
@@ -55,11 +78,11 @@ func ValidateFutureGovernor(governor string) error {
 	}
 
 	// Note that a duration of 0 is allowed
-	_, err = time.ParseDuration(lockTimeStr)
+	lockTime, err := time.ParseDuration(lockTimeStr)
 	if err != nil {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid future governor: %s", governor))
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid future governor: %s", governor))
 	}
-	return nil
+	return &FutureGovernor{LockDenom: lpTokenStr, LockDuration: lockTime}, nil
 }
 
 var _ sdk.Msg = &MsgSwapExactAmountIn{}
@@ -72,7 +95,7 @@ func (msg MsgSwapExactAmountIn) ValidateBasic() error {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
 	}
 
-	err = SwapAmountInRoutes(msg.Routes).Validate()
+	err = swaproutertypes.SwapAmountInRoutes(msg.Routes).Validate()
 	if err != nil {
 		return err
 	}
@@ -110,7 +133,7 @@ func (msg MsgSwapExactAmountOut) ValidateBasic() error {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
 	}
 
-	err = SwapAmountOutRoutes(msg.Routes).Validate()
+	err = swaproutertypes.SwapAmountOutRoutes(msg.Routes).Validate()
 	if err != nil {
 		return err
 	}
@@ -347,3 +370,53 @@ func (msg MsgExitSwapShareAmountIn) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgSetPoolFees{}
+
+func (msg MsgSetPoolFees) Route() string { return RouterKey }
+func (msg MsgSetPoolFees) Type() string  { return TypeMsgSetPoolFees }
+func (msg MsgSetPoolFees) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	return ValidatePoolFees(msg.SwapFee, msg.ExitFee)
+}
+
+func (msg MsgSetPoolFees) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSetPoolFees) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetPoolActive{}
+
+func (msg MsgSetPoolActive) Route() string { return RouterKey }
+func (msg MsgSetPoolActive) Type() string  { return TypeMsgSetPoolActive }
+func (msg MsgSetPoolActive) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	return nil
+}
+
+func (msg MsgSetPoolActive) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSetPoolActive) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
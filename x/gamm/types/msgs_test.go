@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -9,6 +10,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	appParams "github.com/osmosis-labs/osmosis/v7/app/params"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v7/x/swaprouter/types"
 )
 
 func TestMsgSwapExactAmountIn(t *testing.T) {
@@ -20,7 +22,7 @@ func TestMsgSwapExactAmountIn(t *testing.T) {
 	createMsg := func(after func(msg MsgSwapExactAmountIn) MsgSwapExactAmountIn) MsgSwapExactAmountIn {
 		properMsg := MsgSwapExactAmountIn{
 			Sender: addr1,
-			Routes: []SwapAmountInRoute{{
+			Routes: []swaproutertypes.SwapAmountInRoute{{
 				PoolId:        0,
 				TokenOutDenom: "test",
 			}, {
@@ -77,7 +79,7 @@ func TestMsgSwapExactAmountIn(t *testing.T) {
 		{
 			name: "empty routes2",
 			msg: createMsg(func(msg MsgSwapExactAmountIn) MsgSwapExactAmountIn {
-				msg.Routes = []SwapAmountInRoute{}
+				msg.Routes = []swaproutertypes.SwapAmountInRoute{}
 				return msg
 			}),
 			expectPass: false,
@@ -150,7 +152,7 @@ func TestMsgSwapExactAmountOut(t *testing.T) {
 	createMsg := func(after func(msg MsgSwapExactAmountOut) MsgSwapExactAmountOut) MsgSwapExactAmountOut {
 		properMsg := MsgSwapExactAmountOut{
 			Sender: addr1,
-			Routes: []SwapAmountOutRoute{{
+			Routes: []swaproutertypes.SwapAmountOutRoute{{
 				PoolId:       0,
 				TokenInDenom: "test",
 			}, {
@@ -207,7 +209,7 @@ func TestMsgSwapExactAmountOut(t *testing.T) {
 		{
 			name: "empty routes2",
 			msg: createMsg(func(msg MsgSwapExactAmountOut) MsgSwapExactAmountOut {
-				msg.Routes = []SwapAmountOutRoute{}
+				msg.Routes = []swaproutertypes.SwapAmountOutRoute{}
 				return msg
 			}),
 			expectPass: false,
@@ -867,3 +869,140 @@ func TestMsgExitSwapShareAmountIn(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgSetPoolFees(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg MsgSetPoolFees) MsgSetPoolFees) MsgSetPoolFees {
+		properMsg := MsgSetPoolFees{
+			Sender:  addr1,
+			PoolId:  1,
+			SwapFee: sdk.NewDecWithPrec(1, 2),
+			ExitFee: sdk.ZeroDec(),
+		}
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg MsgSetPoolFees) MsgSetPoolFees {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "set_pool_fees")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        MsgSetPoolFees
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg MsgSetPoolFees) MsgSetPoolFees {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg MsgSetPoolFees) MsgSetPoolFees {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "negative swap fee",
+			msg: createMsg(func(msg MsgSetPoolFees) MsgSetPoolFees {
+				msg.SwapFee = sdk.NewDecWithPrec(-1, 2)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "exit fee of one",
+			msg: createMsg(func(msg MsgSetPoolFees) MsgSetPoolFees {
+				msg.ExitFee = sdk.OneDec()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "nil exit fee",
+			msg: createMsg(func(msg MsgSetPoolFees) MsgSetPoolFees {
+				msg.ExitFee = sdk.Dec{}
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestParseFutureGovernor(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	tests := []struct {
+		name             string
+		governor         string
+		expectedGovernor *FutureGovernor
+		expectPass       bool
+	}{
+		{"no governor", "", nil, true},
+		{"address", addr.String(), &FutureGovernor{Address: addr}, true},
+		{"lock duration", "24h", &FutureGovernor{LockDuration: time.Hour * 24}, true},
+		{"lock denom and duration", "uosmo,1h", &FutureGovernor{LockDenom: "uosmo", LockDuration: time.Hour}, true},
+		{"invalid duration", "uosmo,1d", nil, false},
+		{"invalid denom", "1,1h", nil, false},
+		{"too many parts", "uosmo,1h,1h", nil, false},
+	}
+
+	for _, test := range tests {
+		governor, err := ParseFutureGovernor(test.governor)
+		if test.expectPass {
+			require.NoError(t, err, "test: %v", test.name)
+			require.Equal(t, test.expectedGovernor, governor, "test: %v", test.name)
+		} else {
+			require.Error(t, err, "test: %v", test.name)
+		}
+	}
+}
+
+func TestCleanupPoolsProposal(t *testing.T) {
+	tests := []struct {
+		name       string
+		poolIds    []uint64
+		expectPass bool
+	}{
+		{"one pool", []uint64{1}, true},
+		{"several pools", []uint64{1, 3, 2}, true},
+		{"no pools", []uint64{}, false},
+		{"pool listed twice", []uint64{1, 2, 1}, false},
+	}
+
+	for _, test := range tests {
+		proposal := NewCleanupPoolsProposal("title", "description", test.poolIds)
+		require.Equal(t, RouterKey, proposal.ProposalRoute())
+		require.Equal(t, ProposalTypeCleanupPools, proposal.ProposalType())
+		if test.expectPass {
+			require.NoError(t, proposal.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, proposal.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
@@ -12,6 +12,7 @@ import (
 // Parameter store keys.
 var (
 	KeyPoolCreationFee = []byte("PoolCreationFee")
+	KeyTakerFeeParams  = []byte("TakerFeeParams")
 )
 
 // ParamTable for gamm module.
@@ -19,9 +20,12 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
+// NewParams returns gamm params with the given pool creation fee, and no
+// taker fee.
 func NewParams(poolCreationFee sdk.Coins) Params {
 	return Params{
 		PoolCreationFee: poolCreationFee,
+		TakerFeeParams:  DefaultTakerFeeParams(),
 	}
 }
 
@@ -29,6 +33,17 @@ func NewParams(poolCreationFee sdk.Coins) Params {
 func DefaultParams() Params {
 	return Params{
 		PoolCreationFee: sdk.Coins{sdk.NewInt64Coin(appparams.BaseCoinUnit, 1000_000_000)}, // 1000 OSMO
+		TakerFeeParams:  DefaultTakerFeeParams(),
+	}
+}
+
+// DefaultTakerFeeParams charges no taker fee, and funds the community pool
+// with any taker fee set later.
+func DefaultTakerFeeParams() TakerFeeParams {
+	return TakerFeeParams{
+		TakerFee:    sdk.ZeroDec(),
+		Overrides:   []TakerFeeOverride{},
+		Destination: CommunityPool,
 	}
 }
 
@@ -38,13 +53,30 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateTakerFeeParams(p.TakerFeeParams); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// GetTakerFee returns the taker fee of swaps between two denoms, in either
+// direction.
+func (p TakerFeeParams) GetTakerFee(denomA, denomB string) sdk.Dec {
+	for _, override := range p.Overrides {
+		if (override.Denom0 == denomA && override.Denom1 == denomB) ||
+			(override.Denom0 == denomB && override.Denom1 == denomA) {
+			return override.TakerFee
+		}
+	}
+	return p.TakerFee
+}
+
 // Implements params.ParamSet.
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyPoolCreationFee, &p.PoolCreationFee, validatePoolCreationFee),
+		paramtypes.NewParamSetPair(KeyTakerFeeParams, &p.TakerFeeParams, validateTakerFeeParams),
 	}
 }
 
@@ -60,3 +92,52 @@ func validatePoolCreationFee(i interface{}) error {
 
 	return nil
 }
+
+func validateTakerFeeParams(i interface{}) error {
+	v, ok := i.(TakerFeeParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := validateTakerFee(v.TakerFee); err != nil {
+		return err
+	}
+
+	seen := make(map[[2]string]bool, len(v.Overrides))
+	for _, override := range v.Overrides {
+		if err := sdk.ValidateDenom(override.Denom0); err != nil {
+			return err
+		}
+		if err := sdk.ValidateDenom(override.Denom1); err != nil {
+			return err
+		}
+		if override.Denom0 == override.Denom1 {
+			return fmt.Errorf("taker fee override of %s with itself", override.Denom0)
+		}
+		pair := [2]string{override.Denom0, override.Denom1}
+		if pair[0] > pair[1] {
+			pair[0], pair[1] = pair[1], pair[0]
+		}
+		if seen[pair] {
+			return fmt.Errorf("taker fee of %s and %s overridden twice", pair[0], pair[1])
+		}
+		seen[pair] = true
+
+		if err := validateTakerFee(override.TakerFee); err != nil {
+			return err
+		}
+	}
+
+	if _, ok := TakerFeeDestination_name[int32(v.Destination)]; !ok {
+		return fmt.Errorf("invalid taker fee destination: %d", v.Destination)
+	}
+
+	return nil
+}
+
+func validateTakerFee(takerFee sdk.Dec) error {
+	if takerFee.IsNil() || takerFee.IsNegative() || takerFee.GTE(sdk.OneDec()) {
+		return fmt.Errorf("taker fee must be in [0, 1): %s", takerFee)
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestTakerFeeParams(t *testing.T) {
+	override := func(denom0, denom1 string, takerFee sdk.Dec) TakerFeeOverride {
+		return TakerFeeOverride{Denom0: denom0, Denom1: denom1, TakerFee: takerFee}
+	}
+	tests := []struct {
+		name       string
+		params     TakerFeeParams
+		expectPass bool
+	}{
+		{"default", DefaultTakerFeeParams(), true},
+		{"overrides", TakerFeeParams{
+			TakerFee:    sdk.NewDecWithPrec(1, 3),
+			Overrides:   []TakerFeeOverride{override("foo", "bar", sdk.ZeroDec()), override("foo", "baz", sdk.NewDecWithPrec(2, 3))},
+			Destination: Burn,
+		}, true},
+		{"taker fee of one", TakerFeeParams{TakerFee: sdk.OneDec()}, false},
+		{"negative taker fee", TakerFeeParams{TakerFee: sdk.NewDec(-1)}, false},
+		{"nil taker fee", TakerFeeParams{}, false},
+		{"pair overridden twice", TakerFeeParams{
+			TakerFee:  sdk.ZeroDec(),
+			Overrides: []TakerFeeOverride{override("foo", "bar", sdk.ZeroDec()), override("bar", "foo", sdk.ZeroDec())},
+		}, false},
+		{"override of a denom with itself", TakerFeeParams{
+			TakerFee:  sdk.ZeroDec(),
+			Overrides: []TakerFeeOverride{override("foo", "foo", sdk.ZeroDec())},
+		}, false},
+		{"invalid override", TakerFeeParams{
+			TakerFee:  sdk.ZeroDec(),
+			Overrides: []TakerFeeOverride{override("foo", "bar", sdk.OneDec())},
+		}, false},
+		{"unknown destination", TakerFeeParams{TakerFee: sdk.ZeroDec(), Destination: 3}, false},
+	}
+
+	for _, test := range tests {
+		err := validateTakerFeeParams(test.params)
+		if test.expectPass {
+			require.NoError(t, err, "test: %v", test.name)
+		} else {
+			require.Error(t, err, "test: %v", test.name)
+		}
+	}
+
+	params := TakerFeeParams{
+		TakerFee:  sdk.NewDecWithPrec(1, 3),
+		Overrides: []TakerFeeOverride{override("foo", "bar", sdk.ZeroDec())},
+	}
+	require.Equal(t, sdk.ZeroDec(), params.GetTakerFee("bar", "foo"))
+	require.Equal(t, sdk.NewDecWithPrec(1, 3), params.GetTakerFee("foo", "baz"))
+}
@@ -3,30 +3,19 @@ package types
 import (
 	"time"
 
-	proto "github.com/gogo/protobuf/proto"
-
 	"github.com/osmosis-labs/osmosis/v7/v043_temp/address"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v7/x/swaprouter/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // PoolI defines an interface for pools that hold tokens.
 type PoolI interface {
-	proto.Message
-
-	GetAddress() sdk.AccAddress
-	String() string
-	GetId() uint64
-	// GetSwapFee returns the pool's swap fee, based on the current state.
-	// Pools may choose to make their swap fees dependent upon state
-	// (prior TWAPs, network downtime, other pool states, etc.)
-	// hence Context is provided as an argument.
-	GetSwapFee(ctx sdk.Context) sdk.Dec
+	swaproutertypes.PoolI
+
 	// GetExitFee returns the pool's exit fee, based on the current state.
 	// Pools may choose to make their exit fees dependent upon state.
 	GetExitFee(ctx sdk.Context) sdk.Dec
-	// Returns whether the pool has swaps enabled at the moment
-	IsActive(ctx sdk.Context) bool
 	// GetTotalPoolLiquidity returns the coins in the pool owned by all LPs
 	GetTotalPoolLiquidity(ctx sdk.Context) sdk.Coins
 	// GetTotalShares returns the total number of LP shares in the pool
@@ -46,12 +35,6 @@ type PoolI interface {
 	// This does not mutate the pool, or state.
 	CalcInAmtGivenOut(ctx sdk.Context, tokenOut sdk.Coins, tokenInDenom string, swapFee sdk.Dec) (tokenIn sdk.DecCoin, err error)
 
-	// Returns the spot price of the 'base asset' in terms of the 'quote asset' in the pool,
-	// errors if either baseAssetDenom, or quoteAssetDenom does not exist.
-	// For example, if this was a UniV2 50-50 pool, with 2 ETH, and 8000 UST
-	// pool.SpotPrice(ctx, "eth", "ust") = 4000.00
-	SpotPrice(ctx sdk.Context, baseAssetDenom string, quoteAssetDenom string) (sdk.Dec, error)
-
 	// JoinPool joins the pool using all of the tokensIn provided.
 	// The AMM swaps to the correct internal ratio should be and returns the number of shares created.
 	// This function is mutative and updates the pool's internal state if there is no error.
@@ -91,6 +74,40 @@ type PoolExitSwapExactAmountOutExtension interface {
 	) (shareInAmount sdk.Int, err error)
 }
 
+// PoolGovernorExtension is an extension of the PoolI interface for pools that
+// their future pool governor can change after creation.
+type PoolGovernorExtension interface {
+	PoolI
+
+	// GetFuturePoolGovernor returns who governs the pool, in the format
+	// ParseFutureGovernor parses.
+	GetFuturePoolGovernor() string
+	// SetPoolFees sets the swap and exit fees of the pool.
+	SetPoolFees(swapFee, exitFee sdk.Dec) error
+	// SetActive pauses swaps against the pool, or unpauses them.
+	SetActive(isActive bool)
+}
+
+// ValidatePoolFees checks that the swap and exit fees of a pool are in [0, 1).
+func ValidatePoolFees(swapFee, exitFee sdk.Dec) error {
+	if exitFee.IsNil() || exitFee.IsNegative() {
+		return ErrNegativeExitFee
+	}
+
+	if exitFee.GTE(sdk.OneDec()) {
+		return ErrTooMuchExitFee
+	}
+
+	if swapFee.IsNil() || swapFee.IsNegative() {
+		return ErrNegativeSwapFee
+	}
+
+	if swapFee.GTE(sdk.OneDec()) {
+		return ErrTooMuchSwapFee
+	}
+	return nil
+}
+
 func NewPoolAddress(poolId uint64) sdk.AccAddress {
 	key := append([]byte("pool"), sdk.Uint64ToBigEndian(poolId)...)
 	return address.Module(ModuleName, key)
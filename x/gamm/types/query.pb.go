@@ -13,6 +13,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	types2 "github.com/osmosis-labs/osmosis/v7/x/swaprouter/types"
 	_ "github.com/regen-network/cosmos-proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
@@ -34,7 +35,7 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-//=============================== Pool
+// =============================== Pool
 type QueryPoolRequest struct {
 	PoolId uint64 `protobuf:"varint,1,opt,name=poolId,proto3" json:"poolId,omitempty" yaml:"pool_id"`
 }
@@ -123,7 +124,7 @@ func (m *QueryPoolResponse) GetPool() *types.Any {
 	return nil
 }
 
-//=============================== Pools
+// =============================== Pools
 type QueryPoolsRequest struct {
 	// pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
@@ -222,7 +223,7 @@ func (m *QueryPoolsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-//=============================== NumPools
+// =============================== NumPools
 type QueryNumPoolsRequest struct {
 }
 
@@ -303,7 +304,7 @@ func (m *QueryNumPoolsResponse) GetNumPools() uint64 {
 	return 0
 }
 
-//=============================== PoolParams
+// =============================== PoolParams
 type QueryPoolParamsRequest struct {
 	PoolId uint64 `protobuf:"varint,1,opt,name=poolId,proto3" json:"poolId,omitempty" yaml:"pool_id"`
 }
@@ -392,7 +393,7 @@ func (m *QueryPoolParamsResponse) GetParams() *types.Any {
 	return nil
 }
 
-//=============================== PoolLiquidity
+// =============================== PoolLiquidity
 type QueryTotalPoolLiquidityRequest struct {
 	PoolId uint64 `protobuf:"varint,1,opt,name=poolId,proto3" json:"poolId,omitempty" yaml:"pool_id"`
 }
@@ -481,7 +482,7 @@ func (m *QueryTotalPoolLiquidityResponse) GetLiquidity() github_com_cosmos_cosmo
 	return nil
 }
 
-//=============================== TotalShares
+// =============================== TotalShares
 type QueryTotalSharesRequest struct {
 	PoolId uint64 `protobuf:"varint,1,opt,name=poolId,proto3" json:"poolId,omitempty" yaml:"pool_id"`
 }
@@ -679,12 +680,12 @@ func (m *QuerySpotPriceResponse) GetSpotPrice() string {
 	return ""
 }
 
-//=============================== EstimateSwapExactAmountIn
+// =============================== EstimateSwapExactAmountIn
 type QuerySwapExactAmountInRequest struct {
-	Sender  string              `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
-	PoolId  uint64              `protobuf:"varint,2,opt,name=poolId,proto3" json:"poolId,omitempty" yaml:"pool_id"`
-	TokenIn string              `protobuf:"bytes,3,opt,name=tokenIn,proto3" json:"tokenIn,omitempty" yaml:"token_in"`
-	Routes  []SwapAmountInRoute `protobuf:"bytes,4,rep,name=routes,proto3" json:"routes" yaml:"routes"`
+	Sender  string                     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolId  uint64                     `protobuf:"varint,2,opt,name=poolId,proto3" json:"poolId,omitempty" yaml:"pool_id"`
+	TokenIn string                     `protobuf:"bytes,3,opt,name=tokenIn,proto3" json:"tokenIn,omitempty" yaml:"token_in"`
+	Routes  []types2.SwapAmountInRoute `protobuf:"bytes,4,rep,name=routes,proto3" json:"routes" yaml:"routes"`
 }
 
 func (m *QuerySwapExactAmountInRequest) Reset()         { *m = QuerySwapExactAmountInRequest{} }
@@ -741,7 +742,7 @@ func (m *QuerySwapExactAmountInRequest) GetTokenIn() string {
 	return ""
 }
 
-func (m *QuerySwapExactAmountInRequest) GetRoutes() []SwapAmountInRoute {
+func (m *QuerySwapExactAmountInRequest) GetRoutes() []types2.SwapAmountInRoute {
 	if m != nil {
 		return m.Routes
 	}
@@ -785,12 +786,12 @@ func (m *QuerySwapExactAmountInResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QuerySwapExactAmountInResponse proto.InternalMessageInfo
 
-//=============================== EstimateSwapExactAmountOut
+// =============================== EstimateSwapExactAmountOut
 type QuerySwapExactAmountOutRequest struct {
-	Sender   string               `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
-	PoolId   uint64               `protobuf:"varint,2,opt,name=poolId,proto3" json:"poolId,omitempty" yaml:"pool_id"`
-	Routes   []SwapAmountOutRoute `protobuf:"bytes,3,rep,name=routes,proto3" json:"routes" yaml:"routes"`
-	TokenOut string               `protobuf:"bytes,4,opt,name=tokenOut,proto3" json:"tokenOut,omitempty" yaml:"token_out"`
+	Sender   string                      `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolId   uint64                      `protobuf:"varint,2,opt,name=poolId,proto3" json:"poolId,omitempty" yaml:"pool_id"`
+	Routes   []types2.SwapAmountOutRoute `protobuf:"bytes,3,rep,name=routes,proto3" json:"routes" yaml:"routes"`
+	TokenOut string                      `protobuf:"bytes,4,opt,name=tokenOut,proto3" json:"tokenOut,omitempty" yaml:"token_out"`
 }
 
 func (m *QuerySwapExactAmountOutRequest) Reset()         { *m = QuerySwapExactAmountOutRequest{} }
@@ -840,7 +841,7 @@ func (m *QuerySwapExactAmountOutRequest) GetPoolId() uint64 {
 	return 0
 }
 
-func (m *QuerySwapExactAmountOutRequest) GetRoutes() []SwapAmountOutRoute {
+func (m *QuerySwapExactAmountOutRequest) GetRoutes() []types2.SwapAmountOutRoute {
 	if m != nil {
 		return m.Routes
 	}
@@ -971,6 +972,185 @@ func (m *QueryTotalLiquidityResponse) GetLiquidity() github_com_cosmos_cosmos_sd
 	return nil
 }
 
+// =============================== EstimateCleanupPools
+type QueryEstimateCleanupPoolsRequest struct {
+	PoolIds []uint64 `protobuf:"varint,1,rep,packed,name=pool_ids,json=poolIds,proto3" json:"pool_ids,omitempty" yaml:"pool_ids"`
+}
+
+func (m *QueryEstimateCleanupPoolsRequest) Reset()         { *m = QueryEstimateCleanupPoolsRequest{} }
+func (m *QueryEstimateCleanupPoolsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateCleanupPoolsRequest) ProtoMessage()    {}
+func (*QueryEstimateCleanupPoolsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{20}
+}
+func (m *QueryEstimateCleanupPoolsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateCleanupPoolsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateCleanupPoolsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimateCleanupPoolsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateCleanupPoolsRequest.Merge(m, src)
+}
+func (m *QueryEstimateCleanupPoolsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateCleanupPoolsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateCleanupPoolsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateCleanupPoolsRequest proto.InternalMessageInfo
+
+func (m *QueryEstimateCleanupPoolsRequest) GetPoolIds() []uint64 {
+	if m != nil {
+		return m.PoolIds
+	}
+	return nil
+}
+
+type QueryEstimateCleanupPoolsResponse struct {
+	Cleanups []PoolCleanup `protobuf:"bytes,1,rep,name=cleanups,proto3" json:"cleanups" yaml:"cleanups"`
+}
+
+func (m *QueryEstimateCleanupPoolsResponse) Reset()         { *m = QueryEstimateCleanupPoolsResponse{} }
+func (m *QueryEstimateCleanupPoolsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateCleanupPoolsResponse) ProtoMessage()    {}
+func (*QueryEstimateCleanupPoolsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{21}
+}
+func (m *QueryEstimateCleanupPoolsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateCleanupPoolsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateCleanupPoolsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimateCleanupPoolsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateCleanupPoolsResponse.Merge(m, src)
+}
+func (m *QueryEstimateCleanupPoolsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateCleanupPoolsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateCleanupPoolsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateCleanupPoolsResponse proto.InternalMessageInfo
+
+func (m *QueryEstimateCleanupPoolsResponse) GetCleanups() []PoolCleanup {
+	if m != nil {
+		return m.Cleanups
+	}
+	return nil
+}
+
+// =============================== TakerFeesCollected
+type QueryTakerFeesCollectedRequest struct {
+	// denom, if set, limits the fees returned to the denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+}
+
+func (m *QueryTakerFeesCollectedRequest) Reset()         { *m = QueryTakerFeesCollectedRequest{} }
+func (m *QueryTakerFeesCollectedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTakerFeesCollectedRequest) ProtoMessage()    {}
+func (*QueryTakerFeesCollectedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{22}
+}
+func (m *QueryTakerFeesCollectedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTakerFeesCollectedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTakerFeesCollectedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTakerFeesCollectedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTakerFeesCollectedRequest.Merge(m, src)
+}
+func (m *QueryTakerFeesCollectedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTakerFeesCollectedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTakerFeesCollectedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTakerFeesCollectedRequest proto.InternalMessageInfo
+
+func (m *QueryTakerFeesCollectedRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type QueryTakerFeesCollectedResponse struct {
+	TakerFeesCollected github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=taker_fees_collected,json=takerFeesCollected,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"taker_fees_collected" yaml:"taker_fees_collected"`
+}
+
+func (m *QueryTakerFeesCollectedResponse) Reset()         { *m = QueryTakerFeesCollectedResponse{} }
+func (m *QueryTakerFeesCollectedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTakerFeesCollectedResponse) ProtoMessage()    {}
+func (*QueryTakerFeesCollectedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{23}
+}
+func (m *QueryTakerFeesCollectedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTakerFeesCollectedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTakerFeesCollectedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTakerFeesCollectedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTakerFeesCollectedResponse.Merge(m, src)
+}
+func (m *QueryTakerFeesCollectedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTakerFeesCollectedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTakerFeesCollectedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTakerFeesCollectedResponse proto.InternalMessageInfo
+
+func (m *QueryTakerFeesCollectedResponse) GetTakerFeesCollected() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TakerFeesCollected
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryPoolRequest)(nil), "osmosis.gamm.v1beta1.QueryPoolRequest")
 	proto.RegisterType((*QueryPoolResponse)(nil), "osmosis.gamm.v1beta1.QueryPoolResponse")
@@ -992,95 +1172,113 @@ func init() {
 	proto.RegisterType((*QuerySwapExactAmountOutResponse)(nil), "osmosis.gamm.v1beta1.QuerySwapExactAmountOutResponse")
 	proto.RegisterType((*QueryTotalLiquidityRequest)(nil), "osmosis.gamm.v1beta1.QueryTotalLiquidityRequest")
 	proto.RegisterType((*QueryTotalLiquidityResponse)(nil), "osmosis.gamm.v1beta1.QueryTotalLiquidityResponse")
+	proto.RegisterType((*QueryEstimateCleanupPoolsRequest)(nil), "osmosis.gamm.v1beta1.QueryEstimateCleanupPoolsRequest")
+	proto.RegisterType((*QueryEstimateCleanupPoolsResponse)(nil), "osmosis.gamm.v1beta1.QueryEstimateCleanupPoolsResponse")
+	proto.RegisterType((*QueryTakerFeesCollectedRequest)(nil), "osmosis.gamm.v1beta1.QueryTakerFeesCollectedRequest")
+	proto.RegisterType((*QueryTakerFeesCollectedResponse)(nil), "osmosis.gamm.v1beta1.QueryTakerFeesCollectedResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/gamm/v1beta1/query.proto", fileDescriptor_d9a717df9ca609ef) }
 
 var fileDescriptor_d9a717df9ca609ef = []byte{
-	// 1319 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x97, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0xb3, 0xa9, 0x13, 0xea, 0x89, 0x9a, 0x26, 0xd3, 0xb4, 0x75, 0x37, 0xad, 0xb7, 0x0c,
-	0x90, 0x84, 0x36, 0xde, 0x6d, 0x9a, 0x56, 0xa8, 0xfc, 0x28, 0x8a, 0x49, 0xda, 0x1a, 0x15, 0x1a,
-	0xb6, 0x08, 0x10, 0x1c, 0xac, 0x75, 0xbc, 0xb8, 0xab, 0xda, 0x3b, 0x1b, 0xcf, 0x6c, 0x53, 0x0b,
-	0x55, 0x48, 0x95, 0xb8, 0x71, 0x00, 0x95, 0x03, 0x12, 0x08, 0x71, 0x40, 0x42, 0xe2, 0xcc, 0x9f,
-	0xc0, 0xa1, 0x42, 0x1c, 0x2a, 0x71, 0x41, 0x1c, 0x0c, 0x6a, 0x91, 0xb8, 0xfb, 0x8e, 0x84, 0x76,
-	0xe6, 0xed, 0x7a, 0x6d, 0x6f, 0xfc, 0x4b, 0x42, 0xe2, 0xd4, 0x7a, 0xde, 0x9b, 0xef, 0xfb, 0xbc,
-	0xf7, 0x66, 0x76, 0x5e, 0xd0, 0x69, 0xca, 0x6a, 0x94, 0x39, 0xcc, 0xa8, 0x58, 0xb5, 0x9a, 0x71,
-	0x67, 0xad, 0x64, 0x73, 0x6b, 0xcd, 0xd8, 0xf5, 0xed, 0x7a, 0x43, 0xf7, 0xea, 0x94, 0x53, 0xbc,
-	0x00, 0x1e, 0x7a, 0xe0, 0xa1, 0x83, 0x87, 0xba, 0x50, 0xa1, 0x15, 0x2a, 0x1c, 0x8c, 0xe0, 0x7f,
-	0xd2, 0x57, 0x3d, 0x95, 0xa8, 0xc6, 0xef, 0x82, 0x39, 0xbb, 0x23, 0xec, 0x46, 0xc9, 0x62, 0x76,
-	0x64, 0xdd, 0xa1, 0x8e, 0x0b, 0xf6, 0x33, 0x71, 0xbb, 0x60, 0x88, 0xbc, 0x3c, 0xab, 0xe2, 0xb8,
-	0x16, 0x77, 0x68, 0xe8, 0x7b, 0xb2, 0x42, 0x69, 0xa5, 0x6a, 0x1b, 0x96, 0xe7, 0x18, 0x96, 0xeb,
-	0x52, 0x2e, 0x8c, 0x0c, 0xac, 0x27, 0xc0, 0x2a, 0x7e, 0x95, 0xfc, 0x0f, 0x0d, 0xcb, 0x6d, 0x84,
-	0x26, 0x19, 0xa4, 0x28, 0xe1, 0xe5, 0x0f, 0x69, 0x22, 0x97, 0xd1, 0xdc, 0x5b, 0x41, 0xd4, 0x6d,
-	0x4a, 0xab, 0xa6, 0xbd, 0xeb, 0xdb, 0x8c, 0xe3, 0x33, 0x68, 0xda, 0xa3, 0xb4, 0x5a, 0x28, 0x67,
-	0x94, 0xd3, 0xca, 0x4a, 0x2a, 0x8f, 0x5b, 0x4d, 0x6d, 0xb6, 0x61, 0xd5, 0xaa, 0x2f, 0x92, 0x60,
-	0xbd, 0xe8, 0x94, 0x89, 0x09, 0x1e, 0xe4, 0x1a, 0x9a, 0x8f, 0xed, 0x67, 0x1e, 0x75, 0x99, 0x8d,
-	0xd7, 0x51, 0x2a, 0x30, 0x8b, 0xed, 0x33, 0xe7, 0x17, 0x74, 0x49, 0xa6, 0x87, 0x64, 0xfa, 0x86,
-	0xdb, 0xc8, 0xa7, 0x7f, 0xfe, 0x31, 0x37, 0x15, 0xec, 0x2a, 0x98, 0xc2, 0x99, 0x7c, 0x10, 0x53,
-	0x62, 0x21, 0xca, 0x15, 0x84, 0xda, 0x65, 0xc8, 0x4c, 0x0a, 0xbd, 0x25, 0x1d, 0x32, 0x08, 0x6a,
-	0xa6, 0xcb, 0xbe, 0x41, 0xcd, 0xf4, 0x6d, 0xab, 0x62, 0xc3, 0x5e, 0x33, 0xb6, 0x93, 0x7c, 0xa1,
-	0x20, 0x1c, 0x57, 0x07, 0xd0, 0x8b, 0x68, 0x2a, 0x88, 0xcd, 0x32, 0xca, 0xe9, 0x03, 0xc3, 0x90,
-	0x4a, 0x6f, 0x7c, 0x35, 0x81, 0x6a, 0x79, 0x20, 0x95, 0x8c, 0xd9, 0x81, 0x75, 0x0c, 0x2d, 0x08,
-	0xaa, 0x37, 0xfd, 0x5a, 0x3c, 0x6d, 0x52, 0x40, 0x47, 0xbb, 0xd6, 0x01, 0xf8, 0x1c, 0x3a, 0xe8,
-	0xc2, 0x1a, 0x34, 0x67, 0xa1, 0xd5, 0xd4, 0xe6, 0x64, 0x73, 0x5c, 0xbf, 0x56, 0x14, 0x80, 0xc4,
-	0x8c, 0xbc, 0xc8, 0x26, 0x3a, 0x16, 0x25, 0xbe, 0x6d, 0xd5, 0xad, 0x1a, 0x1b, 0xa7, 0xcd, 0x57,
-	0xd1, 0xf1, 0x1e, 0x15, 0x40, 0x5a, 0x45, 0xd3, 0x9e, 0x58, 0xe9, 0xd7, 0x6e, 0x13, 0x7c, 0xc8,
-	0x75, 0x94, 0x15, 0x42, 0x6f, 0x53, 0x6e, 0x55, 0x03, 0xb5, 0xeb, 0xce, 0xae, 0xef, 0x94, 0x1d,
-	0xde, 0x18, 0x07, 0xeb, 0x5b, 0x05, 0x69, 0xfb, 0xca, 0x01, 0xdf, 0x3d, 0x94, 0xae, 0x86, 0x8b,
-	0xd0, 0xe7, 0x13, 0x1d, 0xbd, 0x0a, 0xbb, 0xf4, 0x1a, 0x75, 0xdc, 0xfc, 0xe6, 0xc3, 0xa6, 0x36,
-	0xd1, 0x2e, 0x69, 0xb4, 0x93, 0xfc, 0xf0, 0x87, 0xb6, 0x52, 0x71, 0xf8, 0x2d, 0xbf, 0xa4, 0xef,
-	0xd0, 0x1a, 0x5c, 0x22, 0xf8, 0x27, 0xc7, 0xca, 0xb7, 0x0d, 0xde, 0xf0, 0x6c, 0x26, 0x44, 0x98,
-	0xd9, 0x8e, 0x48, 0xb6, 0xa0, 0x72, 0x82, 0xf0, 0xe6, 0x2d, 0xab, 0x6e, 0x8f, 0xd5, 0x00, 0x8e,
-	0x32, 0xbd, 0x32, 0x90, 0xe1, 0x7b, 0x68, 0x86, 0xb7, 0x97, 0xa1, 0x0d, 0x7d, 0x72, 0x5c, 0x84,
-	0x1c, 0x8f, 0xc8, 0x58, 0x62, 0x6f, 0x91, 0x89, 0xcd, 0xc4, 0x8c, 0x4b, 0x91, 0xbf, 0x15, 0x38,
-	0x88, 0x37, 0x3d, 0xca, 0xb7, 0xeb, 0xce, 0x8e, 0x3d, 0x06, 0x3b, 0xde, 0x42, 0x73, 0x01, 0x44,
-	0xd1, 0x62, 0xcc, 0xe6, 0xc5, 0xb2, 0xed, 0xd2, 0x9a, 0xb8, 0x34, 0xe9, 0xfc, 0x62, 0xab, 0xa9,
-	0x1d, 0x97, 0xbb, 0xba, 0x3d, 0x88, 0x39, 0x1b, 0x2c, 0x6d, 0x04, 0x2b, 0x9b, 0xc1, 0x02, 0xbe,
-	0x86, 0xe6, 0x77, 0x7d, 0xca, 0x3b, 0x75, 0x0e, 0x08, 0x9d, 0x93, 0xad, 0xa6, 0x96, 0x91, 0x3a,
-	0x3d, 0x2e, 0xc4, 0x3c, 0x2c, 0xd6, 0xda, 0x4a, 0xaf, 0xa7, 0x0e, 0xa6, 0xe6, 0xa6, 0xcc, 0x99,
-	0x3d, 0x87, 0xdf, 0xba, 0xb9, 0x67, 0x79, 0x57, 0x6c, 0x9b, 0xbc, 0x01, 0xd7, 0x24, 0x96, 0x68,
-	0xf4, 0x31, 0x4b, 0xb3, 0x70, 0x51, 0x24, 0x9b, 0xce, 0x1f, 0x6d, 0x35, 0xb5, 0x79, 0x19, 0x2e,
-	0x30, 0x15, 0xbd, 0xc0, 0x46, 0xcc, 0xb6, 0x1f, 0xf9, 0x47, 0x41, 0xa7, 0xa4, 0xde, 0x9e, 0xe5,
-	0x6d, 0xdd, 0xb5, 0x76, 0xf8, 0x46, 0x8d, 0xfa, 0x2e, 0x2f, 0xb8, 0x61, 0x01, 0x9f, 0x47, 0xd3,
-	0xcc, 0x76, 0xcb, 0x76, 0x1d, 0x34, 0xe7, 0x5b, 0x4d, 0xed, 0x10, 0x68, 0x8a, 0x75, 0x62, 0x82,
-	0x43, 0xac, 0xd6, 0x93, 0x03, 0x6b, 0x9d, 0x43, 0x4f, 0x71, 0x7a, 0xdb, 0x76, 0x0b, 0x2e, 0x94,
-	0xe6, 0x48, 0xab, 0xa9, 0x1d, 0x0e, 0x1b, 0x7d, 0xdb, 0x76, 0x8b, 0x8e, 0x4b, 0xcc, 0xd0, 0x07,
-	0xbf, 0x83, 0xa6, 0xeb, 0xd4, 0xe7, 0x36, 0xcb, 0xa4, 0xc4, 0xcd, 0x58, 0xd6, 0x93, 0x9e, 0x3e,
-	0x3d, 0xc8, 0x22, 0x4a, 0x20, 0xf0, 0xcf, 0x1f, 0x85, 0x33, 0x04, 0xc8, 0x52, 0x84, 0x98, 0xa0,
-	0x46, 0x1e, 0x28, 0x70, 0xcf, 0x13, 0xf2, 0x87, 0xba, 0xee, 0xa2, 0x59, 0x41, 0x71, 0xc3, 0x07,
-	0x1b, 0x14, 0xa2, 0x10, 0x28, 0xff, 0xde, 0xd4, 0x96, 0x86, 0xb8, 0x6d, 0x05, 0x97, 0xb7, 0x4f,
-	0x90, 0x4c, 0x8f, 0xfa, 0xbc, 0x68, 0x09, 0x3d, 0x62, 0x76, 0x05, 0x20, 0xf7, 0x27, 0x93, 0xa9,
-	0x6e, 0xf8, 0xfc, 0x3f, 0x6e, 0xcb, 0xbb, 0x51, 0x9d, 0x0f, 0x88, 0x3a, 0xaf, 0x0c, 0xaa, 0x73,
-	0x80, 0x34, 0x44, 0xa1, 0x83, 0x07, 0x21, 0x4c, 0x32, 0x93, 0x12, 0xc4, 0xb1, 0x07, 0x21, 0xaa,
-	0x08, 0x31, 0x23, 0x2f, 0xf2, 0x79, 0xf8, 0xcd, 0x4c, 0x2a, 0x02, 0xf4, 0xc6, 0x45, 0x87, 0xe0,
-	0x84, 0x74, 0xb4, 0xe6, 0xda, 0xc8, 0xad, 0x39, 0xd6, 0x79, 0xf2, 0xa2, 0xce, 0x74, 0xca, 0x93,
-	0x93, 0x48, 0x6d, 0x7f, 0xdd, 0xba, 0x5f, 0x04, 0xf2, 0xb5, 0x82, 0x16, 0x13, 0xcd, 0xff, 0x8b,
-	0x2f, 0xfc, 0xf9, 0x2f, 0x0f, 0xa1, 0x29, 0x81, 0x87, 0x3f, 0x46, 0x62, 0x4e, 0x60, 0x78, 0x9f,
-	0x6b, 0xd4, 0x33, 0xdf, 0xa8, 0x2b, 0x83, 0x1d, 0x65, 0x92, 0xe4, 0x99, 0xfb, 0xbf, 0xfe, 0xf5,
-	0x60, 0xf2, 0x14, 0x5e, 0x34, 0x12, 0x07, 0x4e, 0x39, 0x98, 0x7c, 0xaa, 0xa0, 0x83, 0xe1, 0xcc,
-	0x80, 0xcf, 0xf4, 0xd1, 0xee, 0x1a, 0x38, 0xd4, 0xb3, 0x43, 0xf9, 0x02, 0xca, 0xb2, 0x40, 0x79,
-	0x1a, 0x6b, 0xc9, 0x28, 0xd1, 0x18, 0x82, 0xbf, 0x53, 0xd0, 0x6c, 0x67, 0xcf, 0xf0, 0xb9, 0x3e,
-	0x81, 0x12, 0xbb, 0xaf, 0xae, 0x8d, 0xb0, 0x03, 0x00, 0x73, 0x02, 0x70, 0x19, 0x3f, 0x97, 0x0c,
-	0x28, 0x1f, 0xbc, 0xa8, 0x81, 0xf8, 0x13, 0x05, 0xa5, 0x82, 0x0c, 0xf1, 0xd2, 0x80, 0x6e, 0x84,
-	0x48, 0xcb, 0x03, 0xfd, 0x00, 0x64, 0x55, 0x80, 0x2c, 0xe1, 0x67, 0xfb, 0x34, 0xcd, 0xf8, 0x48,
-	0x7e, 0x23, 0xee, 0xe1, 0x6f, 0x14, 0x84, 0xda, 0x03, 0x16, 0x5e, 0x1d, 0x10, 0xa5, 0x63, 0x9a,
-	0x53, 0x73, 0x43, 0x7a, 0x03, 0xd9, 0xba, 0x20, 0xcb, 0xe1, 0xb3, 0xc3, 0x90, 0x19, 0x72, 0x78,
-	0xc3, 0x3f, 0x29, 0x08, 0xf7, 0x4e, 0x5a, 0xf8, 0xc2, 0xa0, 0x0e, 0x25, 0xcd, 0x79, 0xea, 0xc5,
-	0x11, 0x77, 0x01, 0xf8, 0x86, 0x00, 0x7f, 0x09, 0x5f, 0x1a, 0x0a, 0x5c, 0xb6, 0x5a, 0x7c, 0x8e,
-	0xdb, 0xfd, 0xfe, 0x5e, 0x41, 0x33, 0xb1, 0x39, 0x0a, 0xe7, 0x06, 0x91, 0x74, 0x8c, 0x6d, 0xaa,
-	0x3e, 0xac, 0x3b, 0x10, 0x5f, 0x12, 0xc4, 0xeb, 0x78, 0x6d, 0x04, 0x62, 0x39, 0x8d, 0xe1, 0xaf,
-	0x14, 0x94, 0x8e, 0x26, 0x12, 0xdc, 0xef, 0x92, 0x76, 0x0f, 0x68, 0xea, 0xea, 0x70, 0xce, 0xe3,
-	0x1d, 0x87, 0x60, 0x2f, 0xc3, 0xbf, 0x28, 0xe8, 0xc4, 0x16, 0xe3, 0x4e, 0xcd, 0xe2, 0x76, 0xcf,
-	0x3b, 0x8f, 0xd7, 0xfb, 0x01, 0xec, 0x33, 0x15, 0xa9, 0x17, 0x46, 0xdb, 0x04, 0xf4, 0x9b, 0x82,
-	0xfe, 0x32, 0x7e, 0x39, 0x99, 0x3e, 0xe2, 0xb6, 0x01, 0xd6, 0x60, 0x7b, 0x96, 0x57, 0xb4, 0x03,
-	0x2d, 0x78, 0x91, 0x8a, 0x8e, 0x8b, 0x1f, 0x29, 0x48, 0xdd, 0x27, 0x9d, 0x1b, 0x3e, 0xc7, 0x23,
-	0xa0, 0xb5, 0xe7, 0x89, 0xbe, 0xa7, 0x7c, 0xff, 0x07, 0x98, 0x6c, 0x89, 0x8c, 0x5e, 0xc5, 0xaf,
-	0x8c, 0x9f, 0x11, 0xf5, 0x79, 0xbe, 0xf0, 0xf0, 0x71, 0x56, 0x79, 0xf4, 0x38, 0xab, 0xfc, 0xf9,
-	0x38, 0xab, 0x7c, 0xf6, 0x24, 0x3b, 0xf1, 0xe8, 0x49, 0x76, 0xe2, 0xb7, 0x27, 0xd9, 0x89, 0xf7,
-	0x8d, 0xd8, 0x4b, 0x07, 0x21, 0x72, 0x55, 0xab, 0xc4, 0xa2, 0x78, 0x77, 0x5e, 0x30, 0xee, 0xca,
-	0xa0, 0xe2, 0xd9, 0x2b, 0x4d, 0x8b, 0x3f, 0xe7, 0xd6, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x22,
-	0xfc, 0xd6, 0x3e, 0x3e, 0x11, 0x00, 0x00,
+	// 1543 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xce, 0xa6, 0x4e, 0x9a, 0x4c, 0x7e, 0x4d, 0x93, 0x69, 0x9a, 0xa4, 0x9b, 0xd6, 0x4e, 0xe7,
+	0x07, 0x49, 0x49, 0xe3, 0xdd, 0xa6, 0x69, 0xa8, 0xca, 0x47, 0x51, 0xdc, 0xa4, 0x8d, 0x51, 0x21,
+	0x61, 0x8b, 0x10, 0xe2, 0x43, 0xd6, 0xc6, 0x99, 0xba, 0xab, 0xd8, 0x3b, 0x8e, 0x77, 0xb6, 0x69,
+	0x54, 0x55, 0x48, 0x20, 0x24, 0x0e, 0x1c, 0x8a, 0xca, 0x8d, 0x0a, 0x71, 0x40, 0x42, 0xe2, 0x0c,
+	0x47, 0x24, 0x0e, 0x1c, 0x2a, 0xc4, 0xa1, 0x12, 0x17, 0xc4, 0xc1, 0xa0, 0x16, 0x89, 0xbb, 0xff,
+	0x02, 0x34, 0x33, 0xef, 0xae, 0xbf, 0x36, 0xfe, 0x88, 0x84, 0xc4, 0xa9, 0xdd, 0xf7, 0xe3, 0x99,
+	0xe7, 0x7d, 0xdf, 0xf9, 0x78, 0x62, 0x34, 0xcd, 0xbc, 0x02, 0xf3, 0x1c, 0xcf, 0xcc, 0xd9, 0x85,
+	0x82, 0x79, 0x7b, 0x61, 0x93, 0x72, 0x7b, 0xc1, 0xdc, 0xf1, 0x69, 0x69, 0xcf, 0x28, 0x96, 0x18,
+	0x67, 0x78, 0x0c, 0x22, 0x0c, 0x11, 0x61, 0x40, 0x84, 0x3e, 0x96, 0x63, 0x39, 0x26, 0x03, 0x4c,
+	0xf1, 0x3f, 0x15, 0xab, 0x9f, 0x0d, 0xd0, 0xbc, 0x5d, 0xbb, 0x58, 0x62, 0x3e, 0xa7, 0xa5, 0x10,
+	0x53, 0x98, 0x32, 0xd2, 0x06, 0xc1, 0xf1, 0xc8, 0xa5, 0x73, 0xec, 0x76, 0xe0, 0xcf, 0xca, 0x00,
+	0x73, 0xd3, 0xf6, 0x68, 0xe8, 0xce, 0x32, 0xc7, 0x05, 0xff, 0x5c, 0xad, 0x5f, 0x32, 0x0e, 0xa3,
+	0x8a, 0x76, 0xce, 0x71, 0x6d, 0xee, 0xb0, 0x20, 0xf6, 0x64, 0x8e, 0xb1, 0x5c, 0x9e, 0x9a, 0x76,
+	0xd1, 0x31, 0x6d, 0xd7, 0x65, 0x5c, 0x3a, 0x3d, 0xf0, 0x9e, 0x00, 0xaf, 0xfc, 0xda, 0xf4, 0x6f,
+	0x9a, 0xb6, 0xbb, 0x17, 0xb8, 0xd4, 0x22, 0x19, 0x55, 0xaa, 0xfa, 0x50, 0x2e, 0x72, 0x19, 0x8d,
+	0xbc, 0x21, 0x56, 0xdd, 0x60, 0x2c, 0x6f, 0xd1, 0x1d, 0x9f, 0x7a, 0x1c, 0xcf, 0xa1, 0xfe, 0x22,
+	0x63, 0xf9, 0xf4, 0xd6, 0xa4, 0x36, 0xad, 0x9d, 0x89, 0xa5, 0x70, 0xa5, 0x9c, 0x18, 0xde, 0xb3,
+	0x0b, 0xf9, 0x17, 0x88, 0xb0, 0x67, 0x9c, 0x2d, 0x62, 0x41, 0x04, 0x59, 0x43, 0xa3, 0x35, 0xf9,
+	0x5e, 0x91, 0xb9, 0x1e, 0xc5, 0x8b, 0x28, 0x26, 0xdc, 0x32, 0x7d, 0xe8, 0xfc, 0x98, 0xa1, 0x98,
+	0x19, 0x01, 0x33, 0x63, 0xd9, 0xdd, 0x4b, 0x0d, 0xfe, 0xfc, 0x5d, 0xb2, 0x4f, 0x64, 0xa5, 0x2d,
+	0x19, 0x4c, 0xde, 0xad, 0x41, 0xf2, 0x02, 0x2a, 0x57, 0x11, 0xaa, 0xb6, 0x61, 0xb2, 0x57, 0xe2,
+	0xcd, 0x18, 0x50, 0x81, 0xe8, 0x99, 0xa1, 0xa6, 0x0c, 0x3d, 0x33, 0x36, 0xec, 0x1c, 0x85, 0x5c,
+	0xab, 0x26, 0x93, 0x7c, 0xae, 0x21, 0x5c, 0x8b, 0x0e, 0x44, 0x97, 0x50, 0x9f, 0x58, 0xdb, 0x9b,
+	0xd4, 0xa6, 0x0f, 0x75, 0xc2, 0x54, 0x45, 0xe3, 0x6b, 0x11, 0xac, 0x66, 0xdb, 0xb2, 0x52, 0x6b,
+	0xd6, 0xd1, 0x1a, 0x47, 0x63, 0x92, 0xd5, 0xeb, 0x7e, 0xa1, 0xb6, 0x6c, 0x92, 0x46, 0xc7, 0x1b,
+	0xec, 0x40, 0xf8, 0x1c, 0x1a, 0x70, 0xc1, 0x06, 0xc3, 0x19, 0xab, 0x94, 0x13, 0x23, 0x6a, 0x38,
+	0xae, 0x5f, 0xc8, 0x48, 0x82, 0xc4, 0x0a, 0xa3, 0xc8, 0x0a, 0x1a, 0x0f, 0x0b, 0xdf, 0xb0, 0x4b,
+	0x76, 0xc1, 0x3b, 0xc8, 0x98, 0xaf, 0xa1, 0x89, 0x26, 0x14, 0xa0, 0x34, 0x8f, 0xfa, 0x8b, 0xd2,
+	0xd2, 0x6a, 0xdc, 0x16, 0xc4, 0x90, 0xeb, 0x28, 0x2e, 0x81, 0xde, 0x64, 0xdc, 0xce, 0x0b, 0xb4,
+	0xeb, 0xce, 0x8e, 0xef, 0x6c, 0x39, 0x7c, 0xef, 0x20, 0xb4, 0xbe, 0xd2, 0x50, 0x62, 0x5f, 0x38,
+	0xe0, 0x77, 0x0f, 0x0d, 0xe6, 0x03, 0x23, 0xcc, 0xf9, 0x44, 0xdd, 0xac, 0x82, 0x29, 0x5d, 0x61,
+	0x8e, 0x9b, 0x5a, 0x79, 0x54, 0x4e, 0xf4, 0x54, 0x5b, 0x1a, 0x66, 0x92, 0x6f, 0xff, 0x48, 0x9c,
+	0xc9, 0x39, 0xfc, 0x96, 0xbf, 0x69, 0x64, 0x59, 0x01, 0x0e, 0x11, 0xfc, 0x93, 0xf4, 0xb6, 0xb6,
+	0x4d, 0xbe, 0x57, 0xa4, 0x9e, 0x04, 0xf1, 0xac, 0xea, 0x8a, 0x64, 0x15, 0x4d, 0x54, 0x19, 0xde,
+	0xb8, 0x65, 0x97, 0xe8, 0x81, 0x06, 0xc0, 0xd1, 0x64, 0x33, 0x0c, 0x54, 0xf8, 0x36, 0x1a, 0xe2,
+	0x55, 0x33, 0x8c, 0xa1, 0x45, 0x8d, 0x53, 0x50, 0xe3, 0x31, 0xb5, 0x96, 0xcc, 0xcd, 0x78, 0x32,
+	0x99, 0x58, 0xb5, 0x50, 0xe4, 0x6f, 0x0d, 0x36, 0xe2, 0x8d, 0x22, 0xe3, 0x1b, 0x25, 0x27, 0x4b,
+	0x0f, 0xc0, 0x1d, 0xaf, 0xa2, 0x11, 0x41, 0x22, 0x63, 0x7b, 0x1e, 0xe5, 0x99, 0x2d, 0xea, 0xb2,
+	0x82, 0x3c, 0x34, 0x83, 0xa9, 0xa9, 0x4a, 0x39, 0x31, 0xa1, 0xb2, 0x1a, 0x23, 0x88, 0x35, 0x2c,
+	0x4c, 0xcb, 0xc2, 0xb2, 0x22, 0x0c, 0x78, 0x0d, 0x8d, 0xee, 0xf8, 0x8c, 0xd7, 0xe3, 0x1c, 0x92,
+	0x38, 0x27, 0x2b, 0xe5, 0xc4, 0xa4, 0xc2, 0x69, 0x0a, 0x21, 0xd6, 0x51, 0x69, 0xab, 0x22, 0xbd,
+	0x1a, 0x1b, 0x88, 0x8d, 0xf4, 0x59, 0x43, 0xbb, 0x0e, 0xbf, 0x75, 0x63, 0xd7, 0x2e, 0x5e, 0xa5,
+	0x94, 0xbc, 0x86, 0xc6, 0x1b, 0x0b, 0x0d, 0x2f, 0xb3, 0x41, 0x2f, 0x30, 0xca, 0x62, 0x07, 0x53,
+	0xc7, 0x2b, 0xe5, 0xc4, 0xa8, 0x5a, 0x4e, 0xb8, 0x32, 0x45, 0xe1, 0x23, 0x56, 0x35, 0x8e, 0x7c,
+	0xd4, 0x8b, 0x4e, 0x29, 0xbc, 0x5d, 0xbb, 0xb8, 0x7a, 0xc7, 0xce, 0xf2, 0xe5, 0x02, 0xf3, 0x5d,
+	0x9e, 0x76, 0x83, 0x06, 0x3e, 0x87, 0xfa, 0x3d, 0xea, 0x6e, 0xd1, 0x12, 0x60, 0x8e, 0x56, 0xca,
+	0x89, 0x23, 0x80, 0x29, 0xed, 0xc4, 0x82, 0x80, 0x9a, 0x5e, 0xf7, 0xb6, 0xed, 0x75, 0x12, 0x1d,
+	0xe6, 0x6c, 0x9b, 0xba, 0x69, 0x17, 0x5a, 0x73, 0xac, 0x52, 0x4e, 0x1c, 0x0d, 0x06, 0xbd, 0x4d,
+	0xdd, 0x8c, 0xe3, 0x12, 0x2b, 0x88, 0xc1, 0xef, 0xa1, 0x7e, 0xf9, 0x9a, 0x79, 0x93, 0x31, 0x79,
+	0x32, 0x92, 0x46, 0xf0, 0x50, 0x56, 0x1f, 0xbf, 0x70, 0xf3, 0x88, 0x5a, 0xc2, 0x32, 0x84, 0x2b,
+	0x75, 0x1c, 0x76, 0x12, 0x10, 0x57, 0x50, 0xc4, 0x02, 0x4c, 0xf2, 0x40, 0x83, 0xd3, 0x1e, 0xd1,
+	0x05, 0xe8, 0xee, 0x0e, 0x1a, 0x96, 0x5c, 0xd6, 0x7d, 0xf0, 0x41, 0x3b, 0xd2, 0x02, 0xf9, 0xf7,
+	0x72, 0x62, 0xa6, 0x83, 0x33, 0x97, 0x76, 0x79, 0x75, 0x1f, 0xa9, 0x22, 0x99, 0xcf, 0x33, 0xb6,
+	0xc4, 0x23, 0x56, 0xc3, 0x02, 0xe4, 0x93, 0xde, 0x68, 0x56, 0xeb, 0x3e, 0xff, 0x97, 0x87, 0xf3,
+	0x7e, 0xd8, 0xed, 0x43, 0xb2, 0xdb, 0x46, 0x67, 0xdd, 0x16, 0xc4, 0x3a, 0x68, 0xb7, 0x78, 0x1c,
+	0x82, 0x52, 0x27, 0x63, 0x92, 0x77, 0xcd, 0xe3, 0x10, 0xf6, 0x85, 0x58, 0x61, 0x14, 0xf9, 0x2c,
+	0xb8, 0x3f, 0xa3, 0x5a, 0x01, 0x13, 0x72, 0xd1, 0x11, 0xd8, 0x2d, 0x75, 0x03, 0x5a, 0xeb, 0x7a,
+	0x40, 0xe3, 0xf5, 0xbb, 0x30, 0x9c, 0x4f, 0x3d, 0x3c, 0x39, 0x89, 0xf4, 0xea, 0x4d, 0xd7, 0xf8,
+	0x3a, 0x90, 0x87, 0x1a, 0x9a, 0x8a, 0x74, 0xff, 0x37, 0x6e, 0x7b, 0x0b, 0x4d, 0x4b, 0x76, 0xab,
+	0x1e, 0x77, 0x0a, 0x36, 0xa7, 0x57, 0xf2, 0xd4, 0x76, 0xfd, 0x62, 0x9d, 0xa6, 0x31, 0xd0, 0x00,
+	0xec, 0x0c, 0xa5, 0x3b, 0x62, 0xb5, 0x67, 0x34, 0xf0, 0x10, 0xeb, 0xb0, 0xda, 0x34, 0x1e, 0xb9,
+	0x8b, 0x4e, 0xb7, 0xc0, 0x84, 0xba, 0xdf, 0x42, 0x03, 0x59, 0x65, 0x0f, 0xc4, 0xcc, 0x69, 0x23,
+	0x4a, 0xf3, 0x1a, 0x22, 0x0d, 0x10, 0x52, 0x13, 0x50, 0x3e, 0xac, 0x1d, 0x00, 0x10, 0x2b, 0xc4,
+	0x22, 0x6b, 0xc1, 0x7b, 0x6d, 0x6f, 0xd3, 0xd2, 0x55, 0x4a, 0xbd, 0x2b, 0x2c, 0x9f, 0xa7, 0x59,
+	0x4e, 0xb7, 0x82, 0x72, 0x66, 0x50, 0x9f, 0xba, 0x8a, 0xd5, 0xbe, 0x18, 0xa9, 0x94, 0x13, 0xff,
+	0x53, 0x78, 0x70, 0xfd, 0x2a, 0x37, 0xf9, 0x31, 0x7c, 0xab, 0x23, 0xa0, 0xa0, 0x8a, 0x87, 0x1a,
+	0x1a, 0xe3, 0xc2, 0x9d, 0xb9, 0x49, 0xa9, 0x97, 0xc9, 0x06, 0x01, 0xed, 0x27, 0xb9, 0x0e, 0xa5,
+	0x4c, 0xc1, 0x26, 0x8b, 0x00, 0xe9, 0x6e, 0xa8, 0x98, 0x37, 0xd1, 0x3c, 0x7f, 0x7f, 0x04, 0xf5,
+	0xc9, 0x12, 0xf0, 0x07, 0x48, 0x2a, 0x42, 0x0f, 0xcf, 0x46, 0x77, 0xb9, 0x49, 0xc9, 0xea, 0x67,
+	0xda, 0x07, 0xaa, 0x26, 0x90, 0xff, 0x7f, 0xf8, 0xeb, 0x5f, 0x0f, 0x7a, 0x4f, 0xe1, 0x29, 0x33,
+	0xf2, 0x6f, 0x0b, 0x25, 0x41, 0x3f, 0xd5, 0xd0, 0x40, 0xa0, 0x0e, 0xf1, 0x5c, 0x0b, 0xec, 0x06,
+	0x69, 0xa9, 0x9f, 0xed, 0x28, 0x16, 0xa8, 0xcc, 0x4a, 0x2a, 0xa7, 0x71, 0x22, 0x9a, 0x4a, 0x28,
+	0x38, 0xf1, 0xd7, 0x1a, 0x1a, 0xae, 0x3f, 0x91, 0xf8, 0x5c, 0x8b, 0x85, 0x22, 0xcf, 0xb6, 0xbe,
+	0xd0, 0x45, 0x06, 0x10, 0x4c, 0x4a, 0x82, 0xb3, 0xf8, 0xd9, 0x68, 0x82, 0x4a, 0xda, 0x84, 0xc7,
+	0x13, 0x7f, 0xac, 0xa1, 0x98, 0xa8, 0x10, 0xcf, 0xb4, 0x99, 0x46, 0x40, 0x69, 0xb6, 0x6d, 0x1c,
+	0x10, 0x99, 0x97, 0x44, 0x66, 0xf0, 0x33, 0x2d, 0x86, 0x66, 0xde, 0x55, 0x47, 0xfa, 0x1e, 0xfe,
+	0x52, 0x43, 0xa8, 0x2a, 0xa5, 0xf1, 0x7c, 0x9b, 0x55, 0xea, 0x74, 0xbb, 0x9e, 0xec, 0x30, 0x1a,
+	0x98, 0x2d, 0x4a, 0x66, 0x49, 0x7c, 0xb6, 0x13, 0x66, 0xa6, 0x92, 0xe9, 0xf8, 0x27, 0x0d, 0xe1,
+	0x66, 0x4d, 0x8d, 0x2f, 0xb4, 0x9b, 0x50, 0x94, 0xa2, 0xd7, 0x97, 0xba, 0xcc, 0x02, 0xe2, 0xcb,
+	0x92, 0xf8, 0x8b, 0xf8, 0x52, 0x47, 0xc4, 0xd5, 0xa8, 0xc5, 0x57, 0xcd, 0xbc, 0xbf, 0xd1, 0xd0,
+	0x50, 0x8d, 0x62, 0xc6, 0xc9, 0x76, 0x4c, 0xea, 0x04, 0xba, 0x6e, 0x74, 0x1a, 0x0e, 0x8c, 0x2f,
+	0x49, 0xc6, 0x8b, 0x78, 0xa1, 0x0b, 0xc6, 0x4a, 0x77, 0xe3, 0x2f, 0x34, 0x34, 0x18, 0x6a, 0x4f,
+	0xdc, 0xea, 0x90, 0x36, 0x4a, 0x71, 0x7d, 0xbe, 0xb3, 0xe0, 0x83, 0x6d, 0x07, 0x91, 0xeb, 0xe1,
+	0x5f, 0x34, 0x74, 0x22, 0x78, 0x7e, 0x9a, 0xb4, 0x1c, 0x5e, 0x6c, 0x45, 0x60, 0x1f, 0xfd, 0xab,
+	0x5f, 0xe8, 0x2e, 0x09, 0xd8, 0xaf, 0x48, 0xf6, 0x97, 0xf1, 0x4b, 0xd1, 0xec, 0x43, 0xde, 0x14,
+	0xc8, 0xaa, 0x1f, 0x6c, 0xa8, 0xc0, 0x02, 0xbd, 0x91, 0x71, 0x5c, 0xfc, 0x58, 0x43, 0xfa, 0x3e,
+	0xe5, 0xac, 0xfb, 0x1c, 0x77, 0x41, 0xad, 0xaa, 0x19, 0xf5, 0xa5, 0x2e, 0xb3, 0xa0, 0xa2, 0x55,
+	0x59, 0xd1, 0x2b, 0xf8, 0xe5, 0x83, 0x57, 0xc4, 0x7c, 0x8e, 0x7f, 0xd0, 0xd0, 0x58, 0x94, 0x40,
+	0xc0, 0xcf, 0xb7, 0xa0, 0xd5, 0x42, 0xa5, 0xe8, 0x17, 0xbb, 0xce, 0x83, 0x82, 0x2e, 0xc8, 0x82,
+	0x0c, 0x3c, 0x1f, 0x5d, 0x50, 0x58, 0x07, 0x48, 0x0c, 0x78, 0x40, 0xbe, 0x17, 0x17, 0x4e, 0xd3,
+	0x8b, 0xdb, 0xfa, 0xc2, 0xd9, 0x4f, 0x92, 0xe8, 0x4b, 0x5d, 0x66, 0x01, 0xf3, 0xf3, 0x92, 0xf9,
+	0x3c, 0x9e, 0xdb, 0xe7, 0x31, 0x89, 0xd0, 0x14, 0xa9, 0xf4, 0xa3, 0x27, 0x71, 0xed, 0xf1, 0x93,
+	0xb8, 0xf6, 0xe7, 0x93, 0xb8, 0x76, 0xff, 0x69, 0xbc, 0xe7, 0xf1, 0xd3, 0x78, 0xcf, 0x6f, 0x4f,
+	0xe3, 0x3d, 0xef, 0x98, 0x35, 0x52, 0x03, 0xf0, 0x92, 0x79, 0x7b, 0xd3, 0x0b, 0xc1, 0x6f, 0x5f,
+	0x34, 0xef, 0xa8, 0x15, 0xa4, 0xee, 0xd8, 0xec, 0x97, 0x3f, 0x98, 0x2c, 0xfe, 0x33, 0x00, 0x53,
+	0x7e, 0x9d, 0xb0, 0xce, 0x14, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1109,6 +1307,12 @@ type QueryClient interface {
 	// Estimate the swap.
 	EstimateSwapExactAmountIn(ctx context.Context, in *QuerySwapExactAmountInRequest, opts ...grpc.CallOption) (*QuerySwapExactAmountInResponse, error)
 	EstimateSwapExactAmountOut(ctx context.Context, in *QuerySwapExactAmountOutRequest, opts ...grpc.CallOption) (*QuerySwapExactAmountOutResponse, error)
+	// EstimateCleanupPools returns who would be paid what if the pools were
+	// cleaned up by a CleanupPoolsProposal.
+	EstimateCleanupPools(ctx context.Context, in *QueryEstimateCleanupPoolsRequest, opts ...grpc.CallOption) (*QueryEstimateCleanupPoolsResponse, error)
+	// TakerFeesCollected returns the taker fees collected so far, of one denom
+	// or of all.
+	TakerFeesCollected(ctx context.Context, in *QueryTakerFeesCollectedRequest, opts ...grpc.CallOption) (*QueryTakerFeesCollectedResponse, error)
 }
 
 type queryClient struct {
@@ -1209,6 +1413,24 @@ func (c *queryClient) EstimateSwapExactAmountOut(ctx context.Context, in *QueryS
 	return out, nil
 }
 
+func (c *queryClient) EstimateCleanupPools(ctx context.Context, in *QueryEstimateCleanupPoolsRequest, opts ...grpc.CallOption) (*QueryEstimateCleanupPoolsResponse, error) {
+	out := new(QueryEstimateCleanupPoolsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/EstimateCleanupPools", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TakerFeesCollected(ctx context.Context, in *QueryTakerFeesCollectedRequest, opts ...grpc.CallOption) (*QueryTakerFeesCollectedResponse, error) {
+	out := new(QueryTakerFeesCollectedResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/TakerFeesCollected", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	Pools(context.Context, *QueryPoolsRequest) (*QueryPoolsResponse, error)
@@ -1225,6 +1447,12 @@ type QueryServer interface {
 	// Estimate the swap.
 	EstimateSwapExactAmountIn(context.Context, *QuerySwapExactAmountInRequest) (*QuerySwapExactAmountInResponse, error)
 	EstimateSwapExactAmountOut(context.Context, *QuerySwapExactAmountOutRequest) (*QuerySwapExactAmountOutResponse, error)
+	// EstimateCleanupPools returns who would be paid what if the pools were
+	// cleaned up by a CleanupPoolsProposal.
+	EstimateCleanupPools(context.Context, *QueryEstimateCleanupPoolsRequest) (*QueryEstimateCleanupPoolsResponse, error)
+	// TakerFeesCollected returns the taker fees collected so far, of one denom
+	// or of all.
+	TakerFeesCollected(context.Context, *QueryTakerFeesCollectedRequest) (*QueryTakerFeesCollectedResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1261,6 +1489,12 @@ func (*UnimplementedQueryServer) EstimateSwapExactAmountIn(ctx context.Context,
 func (*UnimplementedQueryServer) EstimateSwapExactAmountOut(ctx context.Context, req *QuerySwapExactAmountOutRequest) (*QuerySwapExactAmountOutResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EstimateSwapExactAmountOut not implemented")
 }
+func (*UnimplementedQueryServer) EstimateCleanupPools(ctx context.Context, req *QueryEstimateCleanupPoolsRequest) (*QueryEstimateCleanupPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateCleanupPools not implemented")
+}
+func (*UnimplementedQueryServer) TakerFeesCollected(ctx context.Context, req *QueryTakerFeesCollectedRequest) (*QueryTakerFeesCollectedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TakerFeesCollected not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1446,6 +1680,42 @@ func _Query_EstimateSwapExactAmountOut_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EstimateCleanupPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateCleanupPoolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateCleanupPools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/EstimateCleanupPools",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateCleanupPools(ctx, req.(*QueryEstimateCleanupPoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TakerFeesCollected_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTakerFeesCollectedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TakerFeesCollected(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/TakerFeesCollected",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TakerFeesCollected(ctx, req.(*QueryTakerFeesCollectedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.gamm.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1490,6 +1760,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EstimateSwapExactAmountOut",
 			Handler:    _Query_EstimateSwapExactAmountOut_Handler,
 		},
+		{
+			MethodName: "EstimateCleanupPools",
+			Handler:    _Query_EstimateCleanupPools_Handler,
+		},
+		{
+			MethodName: "TakerFeesCollected",
+			Handler:    _Query_TakerFeesCollected_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/gamm/v1beta1/query.proto",
@@ -2192,63 +2470,208 @@ func (m *QueryTotalLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryEstimateCleanupPoolsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryPoolRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryEstimateCleanupPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateCleanupPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	if len(m.PoolIds) > 0 {
+		dAtA7 := make([]byte, len(m.PoolIds)*10)
+		var j6 int
+		for _, num := range m.PoolIds {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintQuery(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryEstimateCleanupPoolsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateCleanupPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateCleanupPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pool != nil {
-		l = m.Pool.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Cleanups) > 0 {
+		for iNdEx := len(m.Cleanups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Cleanups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPoolsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTakerFeesCollectedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTakerFeesCollectedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTakerFeesCollectedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPoolsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTakerFeesCollectedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTakerFeesCollectedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTakerFeesCollectedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Pools) > 0 {
-		for _, e := range m.Pools {
+	if len(m.TakerFeesCollected) > 0 {
+		for iNdEx := len(m.TakerFeesCollected) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TakerFeesCollected[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pool != nil {
+		l = m.Pool.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
@@ -2487,6 +2910,65 @@ func (m *QueryTotalLiquidityResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryEstimateCleanupPoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PoolIds) > 0 {
+		l = 0
+		for _, e := range m.PoolIds {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryEstimateCleanupPoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Cleanups) > 0 {
+		for _, e := range m.Cleanups {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTakerFeesCollectedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTakerFeesCollectedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TakerFeesCollected) > 0 {
+		for _, e := range m.TakerFeesCollected {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3789,7 +4271,7 @@ func (m *QuerySwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, SwapAmountInRoute{})
+			m.Routes = append(m.Routes, types2.SwapAmountInRoute{})
 			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -4008,7 +4490,7 @@ func (m *QuerySwapExactAmountOutRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, SwapAmountOutRoute{})
+			m.Routes = append(m.Routes, types2.SwapAmountOutRoute{})
 			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -4284,6 +4766,382 @@ func (m *QueryTotalLiquidityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryEstimateCleanupPoolsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateCleanupPoolsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateCleanupPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PoolIds = append(m.PoolIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PoolIds) == 0 {
+					m.PoolIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PoolIds = append(m.PoolIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateCleanupPoolsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateCleanupPoolsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateCleanupPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cleanups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cleanups = append(m.Cleanups, PoolCleanup{})
+			if err := m.Cleanups[len(m.Cleanups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTakerFeesCollectedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTakerFeesCollectedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTakerFeesCollectedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTakerFeesCollectedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTakerFeesCollectedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTakerFeesCollectedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeesCollected", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TakerFeesCollected = append(m.TakerFeesCollected, types1.Coin{})
+			if err := m.TakerFeesCollected[len(m.TakerFeesCollected)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
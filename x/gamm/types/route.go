@@ -1,37 +0,0 @@
-package types
-
-import sdk "github.com/cosmos/cosmos-sdk/types"
-
-type SwapAmountInRoutes []SwapAmountInRoute
-
-func (routes SwapAmountInRoutes) Validate() error {
-	if len(routes) == 0 {
-		return ErrEmptyRoutes
-	}
-
-	for _, route := range routes {
-		err := sdk.ValidateDenom(route.TokenOutDenom)
-		if err != nil {
-			return err
-		}
-	}
-
-	return nil
-}
-
-type SwapAmountOutRoutes []SwapAmountOutRoute
-
-func (routes SwapAmountOutRoutes) Validate() error {
-	if len(routes) == 0 {
-		return ErrEmptyRoutes
-	}
-
-	for _, route := range routes {
-		err := sdk.ValidateDenom(route.TokenInDenom)
-		if err != nil {
-			return err
-		}
-	}
-
-	return nil
-}
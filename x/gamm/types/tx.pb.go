@@ -11,6 +11,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	types1 "github.com/osmosis-labs/osmosis/v7/x/swaprouter/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -228,61 +229,9 @@ func (m *MsgExitPoolResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_MsgExitPoolResponse proto.InternalMessageInfo
 
 // ===================== MsgSwapExactAmountIn
-type SwapAmountInRoute struct {
-	PoolId        uint64 `protobuf:"varint,1,opt,name=poolId,proto3" json:"poolId,omitempty" yaml:"pool_id"`
-	TokenOutDenom string `protobuf:"bytes,2,opt,name=tokenOutDenom,proto3" json:"tokenOutDenom,omitempty" yaml:"token_out_denom"`
-}
-
-func (m *SwapAmountInRoute) Reset()         { *m = SwapAmountInRoute{} }
-func (m *SwapAmountInRoute) String() string { return proto.CompactTextString(m) }
-func (*SwapAmountInRoute) ProtoMessage()    {}
-func (*SwapAmountInRoute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{4}
-}
-func (m *SwapAmountInRoute) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *SwapAmountInRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_SwapAmountInRoute.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *SwapAmountInRoute) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SwapAmountInRoute.Merge(m, src)
-}
-func (m *SwapAmountInRoute) XXX_Size() int {
-	return m.Size()
-}
-func (m *SwapAmountInRoute) XXX_DiscardUnknown() {
-	xxx_messageInfo_SwapAmountInRoute.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_SwapAmountInRoute proto.InternalMessageInfo
-
-func (m *SwapAmountInRoute) GetPoolId() uint64 {
-	if m != nil {
-		return m.PoolId
-	}
-	return 0
-}
-
-func (m *SwapAmountInRoute) GetTokenOutDenom() string {
-	if m != nil {
-		return m.TokenOutDenom
-	}
-	return ""
-}
-
 type MsgSwapExactAmountIn struct {
 	Sender            string                                 `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
-	Routes            []SwapAmountInRoute                    `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes"`
+	Routes            []types1.SwapAmountInRoute             `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes"`
 	TokenIn           types.Coin                             `protobuf:"bytes,3,opt,name=tokenIn,proto3" json:"tokenIn" yaml:"token_in"`
 	TokenOutMinAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=tokenOutMinAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"tokenOutMinAmount" yaml:"token_out_min_amount"`
 }
@@ -291,7 +240,7 @@ func (m *MsgSwapExactAmountIn) Reset()         { *m = MsgSwapExactAmountIn{} }
 func (m *MsgSwapExactAmountIn) String() string { return proto.CompactTextString(m) }
 func (*MsgSwapExactAmountIn) ProtoMessage()    {}
 func (*MsgSwapExactAmountIn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{5}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{4}
 }
 func (m *MsgSwapExactAmountIn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -327,7 +276,7 @@ func (m *MsgSwapExactAmountIn) GetSender() string {
 	return ""
 }
 
-func (m *MsgSwapExactAmountIn) GetRoutes() []SwapAmountInRoute {
+func (m *MsgSwapExactAmountIn) GetRoutes() []types1.SwapAmountInRoute {
 	if m != nil {
 		return m.Routes
 	}
@@ -349,7 +298,7 @@ func (m *MsgSwapExactAmountInResponse) Reset()         { *m = MsgSwapExactAmount
 func (m *MsgSwapExactAmountInResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSwapExactAmountInResponse) ProtoMessage()    {}
 func (*MsgSwapExactAmountInResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{6}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{5}
 }
 func (m *MsgSwapExactAmountInResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -379,61 +328,9 @@ func (m *MsgSwapExactAmountInResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_MsgSwapExactAmountInResponse proto.InternalMessageInfo
 
 // ===================== MsgSwapExactAmountOut
-type SwapAmountOutRoute struct {
-	PoolId       uint64 `protobuf:"varint,1,opt,name=poolId,proto3" json:"poolId,omitempty" yaml:"pool_id"`
-	TokenInDenom string `protobuf:"bytes,2,opt,name=tokenInDenom,proto3" json:"tokenInDenom,omitempty" yaml:"token_out_denom"`
-}
-
-func (m *SwapAmountOutRoute) Reset()         { *m = SwapAmountOutRoute{} }
-func (m *SwapAmountOutRoute) String() string { return proto.CompactTextString(m) }
-func (*SwapAmountOutRoute) ProtoMessage()    {}
-func (*SwapAmountOutRoute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{7}
-}
-func (m *SwapAmountOutRoute) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *SwapAmountOutRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_SwapAmountOutRoute.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *SwapAmountOutRoute) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SwapAmountOutRoute.Merge(m, src)
-}
-func (m *SwapAmountOutRoute) XXX_Size() int {
-	return m.Size()
-}
-func (m *SwapAmountOutRoute) XXX_DiscardUnknown() {
-	xxx_messageInfo_SwapAmountOutRoute.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_SwapAmountOutRoute proto.InternalMessageInfo
-
-func (m *SwapAmountOutRoute) GetPoolId() uint64 {
-	if m != nil {
-		return m.PoolId
-	}
-	return 0
-}
-
-func (m *SwapAmountOutRoute) GetTokenInDenom() string {
-	if m != nil {
-		return m.TokenInDenom
-	}
-	return ""
-}
-
 type MsgSwapExactAmountOut struct {
 	Sender           string                                 `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
-	Routes           []SwapAmountOutRoute                   `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes"`
+	Routes           []types1.SwapAmountOutRoute            `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes"`
 	TokenInMaxAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=tokenInMaxAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"tokenInMaxAmount" yaml:"token_in_max_amount"`
 	TokenOut         types.Coin                             `protobuf:"bytes,4,opt,name=tokenOut,proto3" json:"tokenOut" yaml:"token_out"`
 }
@@ -442,7 +339,7 @@ func (m *MsgSwapExactAmountOut) Reset()         { *m = MsgSwapExactAmountOut{} }
 func (m *MsgSwapExactAmountOut) String() string { return proto.CompactTextString(m) }
 func (*MsgSwapExactAmountOut) ProtoMessage()    {}
 func (*MsgSwapExactAmountOut) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{8}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{6}
 }
 func (m *MsgSwapExactAmountOut) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -478,7 +375,7 @@ func (m *MsgSwapExactAmountOut) GetSender() string {
 	return ""
 }
 
-func (m *MsgSwapExactAmountOut) GetRoutes() []SwapAmountOutRoute {
+func (m *MsgSwapExactAmountOut) GetRoutes() []types1.SwapAmountOutRoute {
 	if m != nil {
 		return m.Routes
 	}
@@ -500,7 +397,7 @@ func (m *MsgSwapExactAmountOutResponse) Reset()         { *m = MsgSwapExactAmoun
 func (m *MsgSwapExactAmountOutResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSwapExactAmountOutResponse) ProtoMessage()    {}
 func (*MsgSwapExactAmountOutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{9}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{7}
 }
 func (m *MsgSwapExactAmountOutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -542,7 +439,7 @@ func (m *MsgJoinSwapExternAmountIn) Reset()         { *m = MsgJoinSwapExternAmou
 func (m *MsgJoinSwapExternAmountIn) String() string { return proto.CompactTextString(m) }
 func (*MsgJoinSwapExternAmountIn) ProtoMessage()    {}
 func (*MsgJoinSwapExternAmountIn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{10}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{8}
 }
 func (m *MsgJoinSwapExternAmountIn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -600,7 +497,7 @@ func (m *MsgJoinSwapExternAmountInResponse) Reset()         { *m = MsgJoinSwapEx
 func (m *MsgJoinSwapExternAmountInResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgJoinSwapExternAmountInResponse) ProtoMessage()    {}
 func (*MsgJoinSwapExternAmountInResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{11}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{9}
 }
 func (m *MsgJoinSwapExternAmountInResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -642,7 +539,7 @@ func (m *MsgJoinSwapShareAmountOut) Reset()         { *m = MsgJoinSwapShareAmoun
 func (m *MsgJoinSwapShareAmountOut) String() string { return proto.CompactTextString(m) }
 func (*MsgJoinSwapShareAmountOut) ProtoMessage()    {}
 func (*MsgJoinSwapShareAmountOut) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{12}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{10}
 }
 func (m *MsgJoinSwapShareAmountOut) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -700,7 +597,7 @@ func (m *MsgJoinSwapShareAmountOutResponse) Reset()         { *m = MsgJoinSwapSh
 func (m *MsgJoinSwapShareAmountOutResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgJoinSwapShareAmountOutResponse) ProtoMessage()    {}
 func (*MsgJoinSwapShareAmountOutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{13}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{11}
 }
 func (m *MsgJoinSwapShareAmountOutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -742,7 +639,7 @@ func (m *MsgExitSwapShareAmountIn) Reset()         { *m = MsgExitSwapShareAmount
 func (m *MsgExitSwapShareAmountIn) String() string { return proto.CompactTextString(m) }
 func (*MsgExitSwapShareAmountIn) ProtoMessage()    {}
 func (*MsgExitSwapShareAmountIn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{14}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{12}
 }
 func (m *MsgExitSwapShareAmountIn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -800,7 +697,7 @@ func (m *MsgExitSwapShareAmountInResponse) Reset()         { *m = MsgExitSwapSha
 func (m *MsgExitSwapShareAmountInResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgExitSwapShareAmountInResponse) ProtoMessage()    {}
 func (*MsgExitSwapShareAmountInResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{15}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{13}
 }
 func (m *MsgExitSwapShareAmountInResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -841,7 +738,7 @@ func (m *MsgExitSwapExternAmountOut) Reset()         { *m = MsgExitSwapExternAmo
 func (m *MsgExitSwapExternAmountOut) String() string { return proto.CompactTextString(m) }
 func (*MsgExitSwapExternAmountOut) ProtoMessage()    {}
 func (*MsgExitSwapExternAmountOut) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{16}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{14}
 }
 func (m *MsgExitSwapExternAmountOut) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -899,7 +796,7 @@ func (m *MsgExitSwapExternAmountOutResponse) Reset()         { *m = MsgExitSwapE
 func (m *MsgExitSwapExternAmountOutResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgExitSwapExternAmountOutResponse) ProtoMessage()    {}
 func (*MsgExitSwapExternAmountOutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{17}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{15}
 }
 func (m *MsgExitSwapExternAmountOutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -928,15 +825,205 @@ func (m *MsgExitSwapExternAmountOutResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgExitSwapExternAmountOutResponse proto.InternalMessageInfo
 
+// ===================== MsgSetPoolFees
+// MsgSetPoolFees lets the future pool governor of a pool change its swap and
+// exit fees.
+type MsgSetPoolFees struct {
+	Sender  string                                 `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolId  uint64                                 `protobuf:"varint,2,opt,name=poolId,proto3" json:"poolId,omitempty" yaml:"pool_id"`
+	SwapFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swapFee" yaml:"swap_fee"`
+	ExitFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=exitFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exitFee" yaml:"exit_fee"`
+}
+
+func (m *MsgSetPoolFees) Reset()         { *m = MsgSetPoolFees{} }
+func (m *MsgSetPoolFees) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPoolFees) ProtoMessage()    {}
+func (*MsgSetPoolFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfc8fd3ac7df3247, []int{16}
+}
+func (m *MsgSetPoolFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPoolFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPoolFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPoolFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPoolFees.Merge(m, src)
+}
+func (m *MsgSetPoolFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPoolFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPoolFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPoolFees proto.InternalMessageInfo
+
+func (m *MsgSetPoolFees) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSetPoolFees) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+type MsgSetPoolFeesResponse struct {
+}
+
+func (m *MsgSetPoolFeesResponse) Reset()         { *m = MsgSetPoolFeesResponse{} }
+func (m *MsgSetPoolFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPoolFeesResponse) ProtoMessage()    {}
+func (*MsgSetPoolFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfc8fd3ac7df3247, []int{17}
+}
+func (m *MsgSetPoolFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPoolFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPoolFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPoolFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPoolFeesResponse.Merge(m, src)
+}
+func (m *MsgSetPoolFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPoolFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPoolFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPoolFeesResponse proto.InternalMessageInfo
+
+// ===================== MsgSetPoolActive
+// MsgSetPoolActive lets the future pool governor of a pool pause swaps against
+// it, or unpause them.
+type MsgSetPoolActive struct {
+	Sender   string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolId   uint64 `protobuf:"varint,2,opt,name=poolId,proto3" json:"poolId,omitempty" yaml:"pool_id"`
+	IsActive bool   `protobuf:"varint,3,opt,name=isActive,proto3" json:"isActive,omitempty" yaml:"is_active"`
+}
+
+func (m *MsgSetPoolActive) Reset()         { *m = MsgSetPoolActive{} }
+func (m *MsgSetPoolActive) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPoolActive) ProtoMessage()    {}
+func (*MsgSetPoolActive) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfc8fd3ac7df3247, []int{18}
+}
+func (m *MsgSetPoolActive) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPoolActive) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPoolActive.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPoolActive) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPoolActive.Merge(m, src)
+}
+func (m *MsgSetPoolActive) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPoolActive) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPoolActive.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPoolActive proto.InternalMessageInfo
+
+func (m *MsgSetPoolActive) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSetPoolActive) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *MsgSetPoolActive) GetIsActive() bool {
+	if m != nil {
+		return m.IsActive
+	}
+	return false
+}
+
+type MsgSetPoolActiveResponse struct {
+}
+
+func (m *MsgSetPoolActiveResponse) Reset()         { *m = MsgSetPoolActiveResponse{} }
+func (m *MsgSetPoolActiveResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPoolActiveResponse) ProtoMessage()    {}
+func (*MsgSetPoolActiveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfc8fd3ac7df3247, []int{19}
+}
+func (m *MsgSetPoolActiveResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPoolActiveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPoolActiveResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPoolActiveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPoolActiveResponse.Merge(m, src)
+}
+func (m *MsgSetPoolActiveResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPoolActiveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPoolActiveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPoolActiveResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgJoinPool)(nil), "osmosis.gamm.v1beta1.MsgJoinPool")
 	proto.RegisterType((*MsgJoinPoolResponse)(nil), "osmosis.gamm.v1beta1.MsgJoinPoolResponse")
 	proto.RegisterType((*MsgExitPool)(nil), "osmosis.gamm.v1beta1.MsgExitPool")
 	proto.RegisterType((*MsgExitPoolResponse)(nil), "osmosis.gamm.v1beta1.MsgExitPoolResponse")
-	proto.RegisterType((*SwapAmountInRoute)(nil), "osmosis.gamm.v1beta1.SwapAmountInRoute")
 	proto.RegisterType((*MsgSwapExactAmountIn)(nil), "osmosis.gamm.v1beta1.MsgSwapExactAmountIn")
 	proto.RegisterType((*MsgSwapExactAmountInResponse)(nil), "osmosis.gamm.v1beta1.MsgSwapExactAmountInResponse")
-	proto.RegisterType((*SwapAmountOutRoute)(nil), "osmosis.gamm.v1beta1.SwapAmountOutRoute")
 	proto.RegisterType((*MsgSwapExactAmountOut)(nil), "osmosis.gamm.v1beta1.MsgSwapExactAmountOut")
 	proto.RegisterType((*MsgSwapExactAmountOutResponse)(nil), "osmosis.gamm.v1beta1.MsgSwapExactAmountOutResponse")
 	proto.RegisterType((*MsgJoinSwapExternAmountIn)(nil), "osmosis.gamm.v1beta1.MsgJoinSwapExternAmountIn")
@@ -947,80 +1034,92 @@ func init() {
 	proto.RegisterType((*MsgExitSwapShareAmountInResponse)(nil), "osmosis.gamm.v1beta1.MsgExitSwapShareAmountInResponse")
 	proto.RegisterType((*MsgExitSwapExternAmountOut)(nil), "osmosis.gamm.v1beta1.MsgExitSwapExternAmountOut")
 	proto.RegisterType((*MsgExitSwapExternAmountOutResponse)(nil), "osmosis.gamm.v1beta1.MsgExitSwapExternAmountOutResponse")
+	proto.RegisterType((*MsgSetPoolFees)(nil), "osmosis.gamm.v1beta1.MsgSetPoolFees")
+	proto.RegisterType((*MsgSetPoolFeesResponse)(nil), "osmosis.gamm.v1beta1.MsgSetPoolFeesResponse")
+	proto.RegisterType((*MsgSetPoolActive)(nil), "osmosis.gamm.v1beta1.MsgSetPoolActive")
+	proto.RegisterType((*MsgSetPoolActiveResponse)(nil), "osmosis.gamm.v1beta1.MsgSetPoolActiveResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/gamm/v1beta1/tx.proto", fileDescriptor_cfc8fd3ac7df3247) }
 
 var fileDescriptor_cfc8fd3ac7df3247 = []byte{
-	// 1083 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0xd8, 0x6e, 0x9a, 0xbe, 0x90, 0xd0, 0x2c, 0x49, 0xe3, 0x6c, 0x5b, 0x3b, 0x1d, 0x10,
-	0xa4, 0x45, 0xdd, 0xa5, 0xa9, 0x44, 0x11, 0x12, 0x08, 0x0c, 0x41, 0x18, 0xd5, 0x32, 0xda, 0x5e,
-	0x2a, 0x2e, 0x61, 0x6d, 0xaf, 0xdc, 0x55, 0xb3, 0x33, 0xc6, 0x33, 0x1b, 0x5c, 0x71, 0xe0, 0x87,
-	0xc4, 0x19, 0x10, 0x3f, 0x8e, 0x88, 0x3f, 0x83, 0x1e, 0xe0, 0xdc, 0x63, 0x25, 0x84, 0x84, 0x38,
-	0x58, 0x28, 0xf9, 0x0f, 0xfc, 0x17, 0xa0, 0xdd, 0x9d, 0x5d, 0xef, 0x4f, 0xdb, 0xdb, 0xc4, 0xed,
-	0x29, 0xb1, 0xf7, 0xcd, 0x7b, 0xdf, 0x7c, 0xef, 0xdb, 0x6f, 0xde, 0x18, 0x2e, 0x53, 0x66, 0x51,
-	0x66, 0x32, 0xb5, 0xab, 0x5b, 0x96, 0x7a, 0x78, 0xa3, 0x65, 0x70, 0xfd, 0x86, 0xca, 0x07, 0x4a,
-	0xaf, 0x4f, 0x39, 0x95, 0xd6, 0xc5, 0x63, 0xc5, 0x79, 0xac, 0x88, 0xc7, 0xf2, 0x7a, 0x97, 0x76,
-	0xa9, 0x1b, 0xa0, 0x3a, 0xff, 0x79, 0xb1, 0x72, 0xa5, 0xed, 0x06, 0xab, 0x2d, 0x9d, 0x19, 0x41,
-	0xa6, 0x36, 0x35, 0x89, 0xf7, 0x1c, 0xff, 0x5e, 0x80, 0xe5, 0x06, 0xeb, 0x7e, 0x44, 0x4d, 0xf2,
-	0x31, 0xa5, 0x07, 0xd2, 0x55, 0x58, 0x64, 0x06, 0xe9, 0x18, 0xfd, 0x32, 0xda, 0x46, 0x3b, 0xe7,
-	0x6a, 0x6b, 0xa3, 0x61, 0x75, 0xe5, 0x81, 0x6e, 0x1d, 0xbc, 0x89, 0xbd, 0xef, 0xb1, 0x26, 0x02,
-	0xa4, 0x6b, 0xb0, 0xd8, 0xa3, 0xf4, 0xa0, 0xde, 0x29, 0x17, 0xb6, 0xd1, 0x4e, 0xa9, 0x26, 0x8d,
-	0x86, 0xd5, 0x55, 0x2f, 0xd4, 0xf9, 0x7e, 0xdf, 0xec, 0x60, 0x4d, 0x44, 0x48, 0x3d, 0x58, 0x65,
-	0xf7, 0xf4, 0xbe, 0xd1, 0xb4, 0xf9, 0xbb, 0x16, 0xb5, 0x09, 0x2f, 0x17, 0xdd, 0xf4, 0x1f, 0x3e,
-	0x1a, 0x56, 0x17, 0xfe, 0x1d, 0x56, 0x5f, 0xee, 0x9a, 0xfc, 0x9e, 0xdd, 0x52, 0xda, 0xd4, 0x52,
-	0x05, 0x62, 0xef, 0xcf, 0x75, 0xd6, 0xb9, 0xaf, 0xf2, 0x07, 0x3d, 0x83, 0x29, 0x75, 0xc2, 0x47,
-	0xc3, 0xea, 0x85, 0x50, 0x05, 0xdd, 0x4d, 0xb5, 0x4f, 0x6d, 0x8e, 0xb5, 0x58, 0x7e, 0xe9, 0x53,
-	0x58, 0xe6, 0xf4, 0xbe, 0x41, 0xea, 0xa4, 0xa1, 0x0f, 0x58, 0xb9, 0xb4, 0x5d, 0xdc, 0x59, 0xde,
-	0xdd, 0x52, 0xbc, 0xac, 0x8a, 0x43, 0x87, 0xcf, 0x9c, 0xf2, 0x1e, 0x35, 0x49, 0xed, 0x45, 0x07,
-	0xc9, 0x68, 0x58, 0xbd, 0xe8, 0xe5, 0x77, 0xd7, 0xee, 0x9b, 0x64, 0xdf, 0xd2, 0x07, 0xa2, 0x0e,
-	0xc3, 0x5a, 0x38, 0x25, 0xde, 0x80, 0x17, 0x42, 0xcc, 0x69, 0x06, 0xeb, 0x51, 0xc2, 0x0c, 0xfc,
-	0xd0, 0x63, 0x74, 0x6f, 0x60, 0xf2, 0x79, 0x32, 0x4a, 0x60, 0xc5, 0xdd, 0x71, 0x9d, 0x9c, 0x0e,
-	0xa1, 0x6e, 0x32, 0x67, 0xc3, 0xde, 0x66, 0xb1, 0x16, 0x4d, 0x2f, 0xb5, 0xe1, 0x39, 0x77, 0xf3,
-	0x4d, 0x9b, 0x37, 0x4c, 0x32, 0x03, 0xa1, 0x2f, 0x09, 0x42, 0x2f, 0x85, 0x09, 0xa5, 0x36, 0xdf,
-	0xb7, 0x82, 0x22, 0x0c, 0x6b, 0x91, 0xa4, 0x82, 0x52, 0x9f, 0xba, 0x80, 0xd2, 0xaf, 0x11, 0xac,
-	0xdd, 0xf9, 0x5c, 0xef, 0x79, 0x50, 0xea, 0x44, 0xa3, 0x36, 0x37, 0x42, 0x6c, 0xa1, 0xa9, 0x6c,
-	0xbd, 0x03, 0x2b, 0x7e, 0xa1, 0xf7, 0x0d, 0x42, 0x2d, 0x97, 0xe0, 0x73, 0x35, 0x79, 0xbc, 0xff,
-	0x31, 0xbe, 0x8e, 0x13, 0x80, 0xb5, 0xe8, 0x02, 0xfc, 0x57, 0x01, 0xd6, 0x1b, 0xac, 0xeb, 0xc0,
-	0xd8, 0x1b, 0xe8, 0x6d, 0xee, 0x63, 0xc9, 0xd3, 0xdf, 0x3d, 0x58, 0xec, 0x3b, 0xd0, 0x59, 0xb9,
-	0xe0, 0xb2, 0xf7, 0x8a, 0x92, 0xf6, 0x26, 0x2b, 0x89, 0xad, 0xd6, 0x4a, 0x0e, 0x97, 0x9a, 0x58,
-	0x2c, 0xdd, 0x86, 0xb3, 0x42, 0x87, 0x6e, 0xd3, 0x27, 0x76, 0x61, 0x53, 0x74, 0xe1, 0xf9, 0xa8,
-	0xac, 0xb1, 0xe6, 0xa7, 0x90, 0xbe, 0x80, 0xb5, 0x50, 0x0f, 0x84, 0x98, 0x4a, 0xee, 0x56, 0x1a,
-	0xb9, 0xc5, 0x74, 0x31, 0xbb, 0xd9, 0x58, 0x4b, 0xd6, 0xc1, 0x3f, 0x20, 0xb8, 0x94, 0xc6, 0xaa,
-	0xdf, 0x7a, 0xe9, 0x33, 0x58, 0xf5, 0x57, 0x09, 0x68, 0x1e, 0xcb, 0xf5, 0xdc, 0xd0, 0x36, 0xe3,
-	0xd0, 0x7c, 0x58, 0xb1, 0x02, 0xf8, 0x2b, 0x04, 0xd2, 0xb8, 0x05, 0x4d, 0x9b, 0xe7, 0x97, 0xdb,
-	0xdb, 0xe2, 0x65, 0xa9, 0x93, 0x59, 0xd5, 0x16, 0x89, 0xc7, 0x7f, 0x17, 0x60, 0x23, 0x49, 0x4b,
-	0xd3, 0xe6, 0x79, 0xd4, 0xf6, 0x41, 0x4c, 0x6d, 0x3b, 0xd3, 0xd4, 0xe6, 0x6f, 0x35, 0x26, 0xb7,
-	0x01, 0x9c, 0x1f, 0xdb, 0x5e, 0xc4, 0x6c, 0x6e, 0xe7, 0x6e, 0x82, 0x9c, 0xe9, 0xae, 0x58, 0x4b,
-	0x54, 0x91, 0x9a, 0xb0, 0xe4, 0xf7, 0xc6, 0x55, 0xe4, 0x44, 0xa5, 0x97, 0x85, 0xd2, 0xcf, 0xc7,
-	0x18, 0xc6, 0x5a, 0x90, 0x04, 0x7f, 0x87, 0xe0, 0x72, 0x2a, 0xaf, 0x81, 0xde, 0x88, 0x30, 0x8a,
-	0xc0, 0x56, 0xd1, 0xc9, 0x6c, 0x35, 0xd8, 0x69, 0x60, 0xab, 0x91, 0xf4, 0xf8, 0x8f, 0x02, 0x6c,
-	0x89, 0x53, 0xc4, 0x43, 0xc5, 0x8d, 0x3e, 0x79, 0x12, 0x6f, 0xc9, 0x73, 0x76, 0x9c, 0xba, 0x81,
-	0xf8, 0x67, 0xef, 0xa9, 0x19, 0x88, 0x77, 0x1a, 0x25, 0x0c, 0x24, 0x51, 0x07, 0xff, 0x82, 0xe0,
-	0x4a, 0x26, 0x7f, 0x61, 0x17, 0x89, 0x8d, 0x1f, 0x27, 0x74, 0x91, 0x31, 0xbe, 0xc0, 0x45, 0xa2,
-	0x05, 0xf0, 0xaf, 0xc5, 0x48, 0x63, 0xef, 0x38, 0x4f, 0x9f, 0xe8, 0x35, 0xce, 0xd3, 0xd8, 0xb7,
-	0x62, 0xbe, 0xe3, 0xbd, 0xa6, 0x5b, 0xa3, 0x61, 0x75, 0x23, 0x26, 0xc7, 0x34, 0xdb, 0x49, 0xa1,
-	0xa9, 0x34, 0x67, 0x9a, 0x52, 0xcd, 0xe5, 0xcc, 0xd3, 0x30, 0x17, 0xfc, 0x63, 0x54, 0x39, 0xd1,
-	0x06, 0x3d, 0x33, 0x3f, 0xf8, 0xad, 0x08, 0x65, 0x31, 0x02, 0xc5, 0x50, 0xcd, 0xcf, 0x0e, 0x12,
-	0xc3, 0x51, 0x31, 0xe7, 0x70, 0x94, 0x1c, 0x46, 0x4b, 0xf3, 0x1d, 0x46, 0x53, 0x67, 0x96, 0x33,
-	0x4f, 0x69, 0x66, 0xf9, 0x19, 0xc1, 0x76, 0x56, 0x8b, 0x9e, 0xe5, 0xdc, 0xf2, 0x67, 0x01, 0xe4,
-	0x10, 0xae, 0xb0, 0x15, 0xce, 0xd1, 0x72, 0xc2, 0x67, 0x74, 0xf1, 0x14, 0xce, 0x68, 0xc7, 0x11,
-	0x44, 0xb3, 0xc7, 0x8e, 0x50, 0x3a, 0x99, 0x23, 0x04, 0x72, 0x8a, 0x38, 0x42, 0xbc, 0x0a, 0xfe,
-	0x09, 0x01, 0xce, 0x26, 0x30, 0x6c, 0x09, 0x51, 0xb1, 0xa3, 0xb9, 0x8a, 0x7d, 0xf7, 0xe1, 0x59,
-	0x28, 0x36, 0x58, 0x57, 0xba, 0x0b, 0x4b, 0xc1, 0x35, 0xfd, 0x4a, 0xfa, 0x2c, 0x17, 0xba, 0x8f,
-	0xca, 0x57, 0xa7, 0x86, 0x04, 0x3b, 0xba, 0x0b, 0x4b, 0xc1, 0x75, 0x35, 0x3b, 0xb3, 0x1f, 0x32,
-	0x21, 0x73, 0xfc, 0xe6, 0x26, 0x31, 0xef, 0xe2, 0x16, 0xbd, 0x31, 0x5d, 0xcb, 0x5c, 0x9f, 0x88,
-	0x95, 0x77, 0x67, 0x8f, 0x0d, 0x8a, 0x1e, 0x7a, 0xf3, 0x7b, 0x6c, 0x72, 0x7e, 0x75, 0xd6, 0x4c,
-	0x4d, 0x9b, 0xcb, 0x37, 0x73, 0x04, 0x07, 0x75, 0xbf, 0x41, 0x70, 0x21, 0x63, 0x90, 0x53, 0x27,
-	0x36, 0x23, 0xb9, 0x40, 0xbe, 0x95, 0x73, 0x41, 0x2a, 0x88, 0xd8, 0xd0, 0x31, 0x1d, 0x44, 0x74,
-	0xc1, 0x0c, 0x20, 0x32, 0x4e, 0xcd, 0x6f, 0x11, 0x6c, 0x66, 0xf9, 0xd0, 0x6b, 0x13, 0xd5, 0x93,
-	0xb2, 0x42, 0x7e, 0x23, 0xef, 0x8a, 0x00, 0xc7, 0x97, 0xb0, 0x91, 0x7e, 0x92, 0x2a, 0x53, 0x53,
-	0x46, 0xe2, 0xe5, 0xd7, 0xf3, 0xc5, 0xfb, 0x00, 0x6a, 0xf5, 0x47, 0x47, 0x15, 0xf4, 0xf8, 0xa8,
-	0x82, 0xfe, 0x3b, 0xaa, 0xa0, 0xef, 0x8f, 0x2b, 0x0b, 0x8f, 0x8f, 0x2b, 0x0b, 0xff, 0x1c, 0x57,
-	0x16, 0x3e, 0x51, 0x43, 0x36, 0x21, 0x72, 0x5f, 0x3f, 0xd0, 0x5b, 0xcc, 0xff, 0xa0, 0x1e, 0xde,
-	0x52, 0x07, 0xde, 0x0f, 0x80, 0xae, 0x67, 0xb4, 0x16, 0xdd, 0x1f, 0xec, 0x6e, 0xfe, 0x1f, 0x00,
-	0x00, 0xff, 0xff, 0x55, 0x14, 0xab, 0x6d, 0x1d, 0x14, 0x00, 0x00,
+	// 1204 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x5f, 0x6f, 0xdb, 0x54,
+	0x14, 0xaf, 0x93, 0xac, 0xeb, 0x4e, 0x69, 0x69, 0x4d, 0xff, 0xa4, 0xde, 0x96, 0x74, 0x97, 0x69,
+	0x6a, 0x37, 0x6a, 0x6f, 0x9d, 0x44, 0x11, 0x12, 0x12, 0x0d, 0xdb, 0x44, 0xa0, 0x51, 0x90, 0xf7,
+	0x32, 0xc1, 0x43, 0x70, 0xd3, 0x4b, 0x66, 0xad, 0xf1, 0x0d, 0xb9, 0xd7, 0x5d, 0x26, 0x1e, 0x90,
+	0x90, 0xf6, 0x0c, 0x88, 0x3f, 0x2f, 0x48, 0x08, 0xbe, 0x05, 0x3c, 0xc0, 0xf3, 0x1e, 0xf7, 0xc8,
+	0x1f, 0x29, 0x42, 0xed, 0x37, 0xc8, 0x27, 0x40, 0xb6, 0xaf, 0x6f, 0x6c, 0xc7, 0x6e, 0xe2, 0xb6,
+	0xd9, 0x9e, 0xda, 0xf8, 0x9e, 0xfb, 0x3b, 0xe7, 0xfe, 0xce, 0x39, 0x3f, 0x9f, 0x6b, 0xb8, 0x4c,
+	0x68, 0x93, 0x50, 0x93, 0x6a, 0x0d, 0xa3, 0xd9, 0xd4, 0x0e, 0x6e, 0xed, 0x62, 0x66, 0xdc, 0xd2,
+	0x58, 0x47, 0x6d, 0xb5, 0x09, 0x23, 0xf2, 0x02, 0x5f, 0x56, 0x9d, 0x65, 0x95, 0x2f, 0x2b, 0x0b,
+	0x0d, 0xd2, 0x20, 0xae, 0x81, 0xe6, 0xfc, 0xe7, 0xd9, 0x2a, 0x85, 0xba, 0x6b, 0xac, 0xed, 0x1a,
+	0x14, 0x0b, 0xa4, 0x3a, 0x31, 0x2d, 0xbe, 0x7e, 0xc3, 0x77, 0x45, 0x1f, 0x1b, 0xad, 0x36, 0xb1,
+	0x19, 0x6e, 0x0b, 0x33, 0xe7, 0x51, 0xcd, 0x7d, 0xe6, 0x19, 0xa3, 0xdf, 0x32, 0x30, 0x5d, 0xa1,
+	0x8d, 0x0f, 0x88, 0x69, 0x7d, 0x44, 0xc8, 0xbe, 0xbc, 0x0e, 0x93, 0x14, 0x5b, 0x7b, 0xb8, 0x9d,
+	0x97, 0x56, 0xa5, 0xb5, 0x0b, 0xa5, 0xf9, 0x5e, 0xb7, 0x38, 0xf3, 0xc4, 0x68, 0xee, 0xbf, 0x8d,
+	0xbc, 0xe7, 0x48, 0xe7, 0x06, 0xf2, 0x75, 0x98, 0x6c, 0x11, 0xb2, 0x5f, 0xde, 0xcb, 0x67, 0x56,
+	0xa5, 0xb5, 0x5c, 0x49, 0xee, 0x75, 0x8b, 0xb3, 0x9e, 0xa9, 0xf3, 0xbc, 0x66, 0xee, 0x21, 0x9d,
+	0x5b, 0xc8, 0x2d, 0x98, 0xa5, 0x0f, 0x8d, 0x36, 0xae, 0xda, 0x6c, 0xbb, 0x49, 0x6c, 0x8b, 0xe5,
+	0xb3, 0x2e, 0xfc, 0xfb, 0xcf, 0xba, 0xc5, 0x89, 0x7f, 0xba, 0xc5, 0x6b, 0x0d, 0x93, 0x3d, 0xb4,
+	0x77, 0xd5, 0x3a, 0x69, 0x6a, 0xfc, 0x78, 0xde, 0x9f, 0x0d, 0xba, 0xf7, 0x48, 0x63, 0x4f, 0x5a,
+	0x98, 0xaa, 0x65, 0x8b, 0xf5, 0xba, 0xc5, 0xa5, 0x80, 0x07, 0xc3, 0x85, 0xaa, 0x11, 0x9b, 0x21,
+	0x3d, 0x82, 0x2f, 0x7f, 0x0a, 0xd3, 0x8c, 0x3c, 0xc2, 0x56, 0xd9, 0xaa, 0x18, 0x1d, 0x9a, 0xcf,
+	0xad, 0x66, 0xd7, 0xa6, 0x37, 0x57, 0x54, 0x0f, 0x55, 0x75, 0xb8, 0xf3, 0x69, 0x56, 0xdf, 0x23,
+	0xa6, 0x55, 0x7a, 0xdd, 0x89, 0xa4, 0xd7, 0x2d, 0x5e, 0xf4, 0xf0, 0xdd, 0xbd, 0x35, 0xd3, 0xaa,
+	0x35, 0x8d, 0x0e, 0xf7, 0x43, 0x91, 0x1e, 0x84, 0x44, 0x8b, 0xf0, 0x5a, 0x80, 0x39, 0x1d, 0xd3,
+	0x16, 0xb1, 0x28, 0x46, 0xbf, 0x7b, 0x8c, 0xde, 0xed, 0x98, 0x6c, 0x9c, 0x8c, 0x5a, 0x30, 0xe3,
+	0x9e, 0xb8, 0x6c, 0x9d, 0x0d, 0xa1, 0x2e, 0x98, 0x73, 0x60, 0xef, 0xb0, 0x48, 0x0f, 0xc3, 0xcb,
+	0x75, 0x78, 0xc5, 0x3d, 0x7c, 0xd5, 0x66, 0x15, 0xd3, 0x1a, 0x81, 0xd0, 0xab, 0x9c, 0xd0, 0x4b,
+	0x41, 0x42, 0x89, 0xcd, 0x6a, 0x4d, 0xe1, 0x84, 0x22, 0x3d, 0x04, 0xca, 0x29, 0xf5, 0xa9, 0x13,
+	0x94, 0xfe, 0x9d, 0x81, 0x85, 0x0a, 0x6d, 0xdc, 0x7f, 0x6c, 0xb4, 0xee, 0x76, 0x8c, 0x3a, 0x4f,
+	0x71, 0xd9, 0x4a, 0xc3, 0xed, 0x87, 0x30, 0xe9, 0xd6, 0x3d, 0xcd, 0x67, 0xdc, 0xc8, 0x37, 0x54,
+	0xbf, 0xe5, 0xfa, 0x6d, 0x22, 0x0e, 0xe0, 0x78, 0xf2, 0x9d, 0xe8, 0xce, 0x52, 0x29, 0xe7, 0x9c,
+	0x46, 0xe7, 0x10, 0xf2, 0x0e, 0x9c, 0xe7, 0x95, 0xe0, 0xd2, 0x7e, 0x2c, 0x0f, 0xcb, 0x9c, 0x87,
+	0x57, 0xc3, 0x85, 0x85, 0x74, 0x1f, 0x42, 0xfe, 0x02, 0xe6, 0x03, 0x2c, 0xf0, 0x74, 0xe6, 0xdc,
+	0x03, 0x55, 0x52, 0xa7, 0xf3, 0x62, 0x32, 0xdd, 0x48, 0x1f, 0xf4, 0x83, 0xbe, 0x95, 0xe0, 0x52,
+	0x1c, 0xb7, 0x3e, 0xf9, 0xf2, 0xe7, 0x30, 0xeb, 0xef, 0xe2, 0xa1, 0x79, 0x5c, 0x97, 0x53, 0x87,
+	0xb6, 0x1c, 0x0d, 0xcd, 0x0f, 0x2b, 0xe2, 0x00, 0xfd, 0x9b, 0x81, 0xc5, 0xc1, 0x98, 0xaa, 0x36,
+	0x4b, 0x93, 0xf0, 0x9d, 0x48, 0xc2, 0xd5, 0xd1, 0x12, 0x5e, 0xb5, 0x59, 0x5c, 0xc6, 0x3b, 0x30,
+	0xd7, 0xef, 0xfd, 0x50, 0xc7, 0xed, 0xa4, 0xe6, 0x41, 0x49, 0x94, 0x18, 0xa4, 0x0f, 0x78, 0x91,
+	0xab, 0x30, 0xe5, 0xd3, 0x93, 0xcf, 0x0d, 0x2b, 0xb6, 0x3c, 0x2f, 0xb6, 0xb9, 0x08, 0xd5, 0x48,
+	0x17, 0x20, 0xe8, 0x6b, 0x09, 0x2e, 0xc7, 0xb2, 0x2b, 0x52, 0x6e, 0xc1, 0x0c, 0x0f, 0x23, 0x94,
+	0xf1, 0x13, 0x6b, 0x8b, 0x38, 0xa9, 0xd0, 0x96, 0x10, 0x3c, 0xfa, 0x23, 0x03, 0x2b, 0x5c, 0x4a,
+	0xbd, 0xa8, 0x18, 0x6e, 0x5b, 0x27, 0x69, 0xf2, 0x34, 0x02, 0x7a, 0xe6, 0x3d, 0xec, 0xbf, 0x80,
+	0xce, 0xac, 0x87, 0x3d, 0x49, 0x1e, 0xe8, 0xe1, 0x01, 0x3f, 0xe8, 0x47, 0x09, 0xae, 0x24, 0xf2,
+	0x17, 0x6c, 0xe4, 0xc8, 0x3b, 0xf8, 0x94, 0x8d, 0xdc, 0x8f, 0x4f, 0x34, 0x72, 0xd8, 0x01, 0xfa,
+	0x39, 0x1b, 0x4a, 0xec, 0x7d, 0x67, 0xf5, 0x44, 0xcd, 0x9c, 0x26, 0xb1, 0xef, 0xf0, 0x37, 0x55,
+	0xd9, 0xba, 0x83, 0x2d, 0xd2, 0xe4, 0x6d, 0xba, 0xd2, 0xeb, 0x16, 0x17, 0x23, 0xe5, 0xb8, 0xe7,
+	0xac, 0x23, 0x3d, 0x64, 0x1e, 0x43, 0x53, 0x6e, 0xcc, 0x34, 0xc5, 0x8a, 0xcb, 0xb9, 0x17, 0x21,
+	0x2e, 0xe8, 0xbb, 0x70, 0xe5, 0x84, 0x13, 0xf4, 0xd2, 0xf4, 0xe0, 0x97, 0x2c, 0xe4, 0xf9, 0x1c,
+	0x10, 0x89, 0x6a, 0x7c, 0x72, 0xf0, 0x2e, 0x3f, 0x63, 0xd5, 0x66, 0xc1, 0xb2, 0x51, 0xa2, 0x51,
+	0x3b, 0x79, 0xe4, 0x75, 0x13, 0xde, 0x30, 0x38, 0x91, 0xe5, 0xc6, 0x3b, 0x91, 0xc5, 0x8e, 0x0d,
+	0xe7, 0x5e, 0xd0, 0xd8, 0xf0, 0x83, 0x04, 0xab, 0x49, 0x29, 0x7a, 0x99, 0xa3, 0xc3, 0x9f, 0x19,
+	0x50, 0x02, 0x71, 0x05, 0xa5, 0x70, 0x8c, 0x92, 0x13, 0x7c, 0x47, 0x67, 0xcf, 0xe0, 0x1d, 0xed,
+	0x28, 0x02, 0x4f, 0x76, 0x5f, 0x11, 0x72, 0xa7, 0x53, 0x04, 0x51, 0x4e, 0x21, 0x45, 0x88, 0x7a,
+	0x41, 0xdf, 0x4b, 0x80, 0x92, 0x09, 0x0c, 0x4a, 0x42, 0xb8, 0xd8, 0xa5, 0xb1, 0x16, 0x3b, 0xfa,
+	0x35, 0x03, 0xb3, 0xce, 0xd0, 0x82, 0xdd, 0x9b, 0xc1, 0x3d, 0x8c, 0xe9, 0xb8, 0x72, 0xf9, 0x09,
+	0x9c, 0x77, 0x06, 0xc4, 0x7b, 0x18, 0x73, 0x09, 0xd8, 0x4e, 0x71, 0xa6, 0x3b, 0xb8, 0xde, 0x1f,
+	0x13, 0xdc, 0xcb, 0xf6, 0x67, 0x18, 0x23, 0xdd, 0x47, 0x74, 0xc0, 0x71, 0xc7, 0x64, 0x0e, 0x78,
+	0xee, 0x74, 0xe0, 0x0e, 0x0c, 0x07, 0xe7, 0x88, 0x28, 0x0f, 0x4b, 0x61, 0x8a, 0xc4, 0x05, 0xea,
+	0x27, 0x09, 0xe6, 0xfa, 0x4b, 0xdb, 0x75, 0x66, 0x1e, 0xe0, 0x71, 0xf1, 0x77, 0x13, 0xa6, 0x4c,
+	0xea, 0xb9, 0x70, 0x09, 0x9c, 0x2a, 0x2d, 0xf4, 0x8b, 0xdd, 0xa4, 0x35, 0xc3, 0x5d, 0x42, 0xba,
+	0xb0, 0x42, 0x0a, 0xe4, 0xa3, 0xc1, 0xf9, 0x91, 0x6f, 0x3e, 0xbd, 0x00, 0xd9, 0x0a, 0x6d, 0xc8,
+	0x0f, 0x60, 0x4a, 0x7c, 0xa3, 0xb8, 0xa2, 0xc6, 0x7d, 0x2d, 0x51, 0x03, 0x97, 0x71, 0x65, 0x7d,
+	0xa8, 0x89, 0xa8, 0xe4, 0x07, 0x30, 0x25, 0xee, 0xea, 0xc9, 0xc8, 0xbe, 0x89, 0xb2, 0x3e, 0xd4,
+	0x44, 0x20, 0x53, 0x98, 0x1f, 0xbc, 0xb2, 0x5e, 0x4f, 0xdc, 0x3f, 0x60, 0xab, 0x6c, 0x8e, 0x6e,
+	0x2b, 0x9c, 0x1e, 0x80, 0x1c, 0x73, 0x6f, 0xba, 0x31, 0x2a, 0x52, 0xd5, 0x66, 0xca, 0xed, 0x14,
+	0xc6, 0xc2, 0xef, 0x57, 0x12, 0x2c, 0x25, 0x0c, 0xf0, 0xda, 0xb1, 0xc9, 0x18, 0xdc, 0xa0, 0x6c,
+	0xa5, 0xdc, 0x10, 0x1b, 0x44, 0x64, 0xd8, 0x1c, 0x1e, 0x44, 0x78, 0x83, 0xb2, 0x95, 0x72, 0x83,
+	0x08, 0xe2, 0xa9, 0x04, 0xcb, 0x49, 0xef, 0x9f, 0x9b, 0xc7, 0x56, 0x4f, 0xcc, 0x0e, 0xe5, 0xad,
+	0xb4, 0x3b, 0x44, 0x1c, 0x5f, 0xc2, 0x62, 0xfc, 0x04, 0xa5, 0x0e, 0x85, 0x0c, 0xd9, 0x2b, 0x6f,
+	0xa6, 0xb3, 0x17, 0x01, 0x18, 0x30, 0x1d, 0xd4, 0xeb, 0xab, 0xc9, 0x65, 0xd5, 0xb7, 0x52, 0xde,
+	0x18, 0xc5, 0x4a, 0xb8, 0x68, 0xc0, 0x4c, 0x58, 0xd4, 0xae, 0x0d, 0xdb, 0xee, 0xd9, 0x29, 0xea,
+	0x68, 0x76, 0xbe, 0xa3, 0x52, 0xf9, 0xd9, 0x61, 0x41, 0x7a, 0x7e, 0x58, 0x90, 0xfe, 0x3b, 0x2c,
+	0x48, 0xdf, 0x1c, 0x15, 0x26, 0x9e, 0x1f, 0x15, 0x26, 0xfe, 0x3a, 0x2a, 0x4c, 0x7c, 0xac, 0x05,
+	0x94, 0x9b, 0x63, 0x6e, 0xec, 0x1b, 0xbb, 0xd4, 0xff, 0xa1, 0x1d, 0x6c, 0x69, 0x1d, 0xef, 0xb3,
+	0xaf, 0x2b, 0xe3, 0xbb, 0x93, 0xee, 0x97, 0xd7, 0xdb, 0xff, 0x0f, 0x00, 0x80, 0xaa, 0xd2, 0xa9,
+	0x13, 0x16, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1043,6 +1142,8 @@ type MsgClient interface {
 	JoinSwapShareAmountOut(ctx context.Context, in *MsgJoinSwapShareAmountOut, opts ...grpc.CallOption) (*MsgJoinSwapShareAmountOutResponse, error)
 	ExitSwapExternAmountOut(ctx context.Context, in *MsgExitSwapExternAmountOut, opts ...grpc.CallOption) (*MsgExitSwapExternAmountOutResponse, error)
 	ExitSwapShareAmountIn(ctx context.Context, in *MsgExitSwapShareAmountIn, opts ...grpc.CallOption) (*MsgExitSwapShareAmountInResponse, error)
+	SetPoolFees(ctx context.Context, in *MsgSetPoolFees, opts ...grpc.CallOption) (*MsgSetPoolFeesResponse, error)
+	SetPoolActive(ctx context.Context, in *MsgSetPoolActive, opts ...grpc.CallOption) (*MsgSetPoolActiveResponse, error)
 }
 
 type msgClient struct {
@@ -1125,6 +1226,24 @@ func (c *msgClient) ExitSwapShareAmountIn(ctx context.Context, in *MsgExitSwapSh
 	return out, nil
 }
 
+func (c *msgClient) SetPoolFees(ctx context.Context, in *MsgSetPoolFees, opts ...grpc.CallOption) (*MsgSetPoolFeesResponse, error) {
+	out := new(MsgSetPoolFeesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Msg/SetPoolFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetPoolActive(ctx context.Context, in *MsgSetPoolActive, opts ...grpc.CallOption) (*MsgSetPoolActiveResponse, error) {
+	out := new(MsgSetPoolActiveResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Msg/SetPoolActive", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	JoinPool(context.Context, *MsgJoinPool) (*MsgJoinPoolResponse, error)
@@ -1135,6 +1254,8 @@ type MsgServer interface {
 	JoinSwapShareAmountOut(context.Context, *MsgJoinSwapShareAmountOut) (*MsgJoinSwapShareAmountOutResponse, error)
 	ExitSwapExternAmountOut(context.Context, *MsgExitSwapExternAmountOut) (*MsgExitSwapExternAmountOutResponse, error)
 	ExitSwapShareAmountIn(context.Context, *MsgExitSwapShareAmountIn) (*MsgExitSwapShareAmountInResponse, error)
+	SetPoolFees(context.Context, *MsgSetPoolFees) (*MsgSetPoolFeesResponse, error)
+	SetPoolActive(context.Context, *MsgSetPoolActive) (*MsgSetPoolActiveResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1165,6 +1286,12 @@ func (*UnimplementedMsgServer) ExitSwapExternAmountOut(ctx context.Context, req
 func (*UnimplementedMsgServer) ExitSwapShareAmountIn(ctx context.Context, req *MsgExitSwapShareAmountIn) (*MsgExitSwapShareAmountInResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExitSwapShareAmountIn not implemented")
 }
+func (*UnimplementedMsgServer) SetPoolFees(ctx context.Context, req *MsgSetPoolFees) (*MsgSetPoolFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPoolFees not implemented")
+}
+func (*UnimplementedMsgServer) SetPoolActive(ctx context.Context, req *MsgSetPoolActive) (*MsgSetPoolActiveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPoolActive not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1314,6 +1441,42 @@ func _Msg_ExitSwapShareAmountIn_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetPoolFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPoolFees)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPoolFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Msg/SetPoolFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPoolFees(ctx, req.(*MsgSetPoolFees))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPoolActive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPoolActive)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPoolActive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Msg/SetPoolActive",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPoolActive(ctx, req.(*MsgSetPoolActive))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.gamm.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1350,6 +1513,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ExitSwapShareAmountIn",
 			Handler:    _Msg_ExitSwapShareAmountIn_Handler,
 		},
+		{
+			MethodName: "SetPoolFees",
+			Handler:    _Msg_SetPoolFees_Handler,
+		},
+		{
+			MethodName: "SetPoolActive",
+			Handler:    _Msg_SetPoolActive_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/gamm/v1beta1/tx.proto",
@@ -1519,7 +1690,7 @@ func (m *MsgExitPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *SwapAmountInRoute) Marshal() (dAtA []byte, err error) {
+func (m *MsgSwapExactAmountIn) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1529,47 +1700,12 @@ func (m *SwapAmountInRoute) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SwapAmountInRoute) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSwapExactAmountIn) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SwapAmountInRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TokenOutDenom) > 0 {
-		i -= len(m.TokenOutDenom)
-		copy(dAtA[i:], m.TokenOutDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenOutDenom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgSwapExactAmountIn) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgSwapExactAmountIn) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgSwapExactAmountIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSwapExactAmountIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1651,41 +1787,6 @@ func (m *MsgSwapExactAmountInResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *SwapAmountOutRoute) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *SwapAmountOutRoute) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SwapAmountOutRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TokenInDenom) > 0 {
-		i -= len(m.TokenInDenom)
-		copy(dAtA[i:], m.TokenInDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenInDenom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
 func (m *MsgSwapExactAmountOut) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2149,6 +2250,152 @@ func (m *MsgExitSwapExternAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetPoolFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPoolFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPoolFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ExitFee.Size()
+		i -= size
+		if _, err := m.ExitFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.SwapFee.Size()
+		i -= size
+		if _, err := m.SwapFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPoolFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPoolFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPoolFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPoolActive) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPoolActive) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPoolActive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsActive {
+		i--
+		if m.IsActive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPoolActiveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPoolActiveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPoolActiveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -2226,22 +2473,6 @@ func (m *MsgExitPoolResponse) Size() (n int) {
 	return n
 }
 
-func (m *SwapAmountInRoute) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovTx(uint64(m.PoolId))
-	}
-	l = len(m.TokenOutDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
-
 func (m *MsgSwapExactAmountIn) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2276,22 +2507,6 @@ func (m *MsgSwapExactAmountInResponse) Size() (n int) {
 	return n
 }
 
-func (m *SwapAmountOutRoute) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovTx(uint64(m.PoolId))
-	}
-	l = len(m.TokenInDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
-
 func (m *MsgSwapExactAmountOut) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2458,28 +2673,85 @@ func (m *MsgExitSwapExternAmountOutResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgSetPoolFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = m.SwapFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ExitFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgSetPoolFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func (m *MsgJoinPool) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
+
+func (m *MsgSetPoolActive) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	if m.IsActive {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetPoolActiveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgJoinPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -2902,107 +3174,6 @@ func (m *MsgExitPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SwapAmountInRoute) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SwapAmountInRoute: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SwapAmountInRoute: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
 func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3093,7 +3264,7 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, SwapAmountInRoute{})
+			m.Routes = append(m.Routes, types1.SwapAmountInRoute{})
 			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -3270,107 +3441,6 @@ func (m *MsgSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SwapAmountOutRoute) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SwapAmountOutRoute: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SwapAmountOutRoute: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
 func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3461,7 +3531,7 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, SwapAmountOutRoute{})
+			m.Routes = append(m.Routes, types1.SwapAmountOutRoute{})
 			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -4712,6 +4782,396 @@ func (m *MsgExitSwapExternAmountOutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetPoolFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPoolFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPoolFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExitFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPoolFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPoolFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPoolFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPoolActive) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPoolActive: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPoolActive: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsActive = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPoolActiveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPoolActiveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPoolActiveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,26 @@
+package incentives
+
+import (
+	"math"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/incentives/keeper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker is called on every block to pay out the distributions of the
+// last epoch, a bounded number of locks at a time.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
+	maxLocks := k.GetParams(ctx).MaxDistributionLocksPerBlock
+	// distributions left over from before incremental distribution was disabled are paid at once
+	if maxLocks == 0 {
+		maxLocks = math.MaxUint64
+	}
+	_, err := k.DistributePending(ctx, maxLocks)
+	if err != nil {
+		panic(err)
+	}
+	return []abci.ValidatorUpdate{}
+}
@@ -28,5 +28,6 @@ func FlagSetCreateGauge() *flag.FlagSet {
 	fs.String(FlagStartTime, "", "Timestamp to begin distribution")
 	fs.Uint64(FlagEpochs, 0, "Total epochs to distribute tokens")
 	fs.Bool(FlagPerpetual, false, "Perpetual distribution")
+	fs.String(FlagTimestamp, "", "Distribute to locks ending after this time instead of by lock duration")
 	return fs
 }
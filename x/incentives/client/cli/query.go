@@ -34,6 +34,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		GetCmdUpcomingGauges(),
 		GetCmdUpcomingGaugesPerDenom(),
 		GetCmdRewardsEst(),
+		GetCmdDistributionProgress(),
 	)
 
 	return cmd
@@ -418,3 +419,39 @@ $ %s query incentives rewards-estimation
 
 	return cmd
 }
+
+// GetCmdDistributionProgress returns the progress of the distributions still being paid out.
+func GetCmdDistributionProgress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "distribution-progress",
+		Short: "Query the progress of the distributions still being paid out",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the progress of the gauge distributions still being paid out.
+
+Example:
+$ %s query incentives distribution-progress
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DistributionProgress(cmd.Context(), &types.DistributionProgressRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
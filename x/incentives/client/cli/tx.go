@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/osmosis-labs/osmosis/v7/x/incentives/types"
@@ -29,6 +30,8 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(
 		NewCreateGaugeCmd(),
 		NewAddToGaugeCmd(),
+		NewClaimRewardsCmd(),
+		NewCancelGaugeCmd(),
 	)
 
 	return cmd
@@ -95,6 +98,23 @@ func NewCreateGaugeCmd() *cobra.Command {
 				Timestamp:     time.Unix(0, 0), // XXX check
 			}
 
+			// a lock end time distributes to the locks ending after it instead of by duration
+			timestampStr, err := cmd.Flags().GetString(FlagTimestamp)
+			if err != nil {
+				return err
+			}
+			if timestampStr != "" {
+				if timeUnix, err := strconv.ParseInt(timestampStr, 10, 64); err == nil { // unix time
+					distributeTo.Timestamp = time.Unix(timeUnix, 0)
+				} else if timeRFC, err := time.Parse(time.RFC3339, timestampStr); err == nil { // RFC time
+					distributeTo.Timestamp = timeRFC
+				} else { // invalid input
+					return errors.New("Invalid lock end time format")
+				}
+				distributeTo.LockQueryType = lockuptypes.ByTime
+				distributeTo.Duration = 0
+			}
+
 			msg := types.NewMsgCreateGauge(
 				epochs == 1,
 				clientCtx.GetFromAddress(),
@@ -151,3 +171,67 @@ func NewAddToGaugeCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewClaimRewardsCmd broadcast MsgClaimRewards.
+func NewClaimRewardsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-rewards [lock_ids] [flags]",
+		Short: "claim the rewards accrued by locks, or by all of your locks if none are given",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			lockIDs := []uint64{}
+			if len(args) > 0 {
+				for _, lockIDStr := range strings.Split(args[0], ",") {
+					lockID, err := strconv.ParseUint(strings.TrimSpace(lockIDStr), 10, 64)
+					if err != nil {
+						return err
+					}
+					lockIDs = append(lockIDs, lockID)
+				}
+			}
+
+			msg := types.NewMsgClaimRewards(clientCtx.GetFromAddress(), lockIDs)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCancelGaugeCmd broadcast MsgCancelGauge.
+func NewCancelGaugeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-gauge [gauge_id] [flags]",
+		Short: "cancel a gauge you created and refund the rewards it has not distributed to its funders",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			gaugeId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelGauge(clientCtx.GetFromAddress(), gaugeId)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -18,6 +18,15 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 			panic(err)
 		}
 	}
+	for _, distribution := range genState.Distributions {
+		k.SetGaugeDistribution(ctx, distribution)
+	}
+	for _, index := range genState.RewardIndexes {
+		k.SetRewardIndex(ctx, index)
+	}
+	for _, lockRewards := range genState.LockRewards {
+		k.SetLockRewards(ctx, lockRewards)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -26,5 +35,8 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		Params:            k.GetParams(ctx),
 		LockableDurations: k.GetLockableDurations(ctx),
 		Gauges:            k.GetNotFinishedGauges(ctx),
+		Distributions:     k.GetGaugeDistributions(ctx),
+		RewardIndexes:     k.GetAllRewardIndexes(ctx),
+		LockRewards:       k.GetAllLockRewards(ctx),
 	}
 }
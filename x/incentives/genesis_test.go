@@ -83,9 +83,7 @@ func TestIncentivesInitGenesis(t *testing.T) {
 		StartTime:         startTime.UTC(),
 	}
 	incentives.InitGenesis(ctx, *app.IncentivesKeeper, types.GenesisState{
-		Params: types.Params{
-			DistrEpochIdentifier: "week",
-		},
+		Params: types.DefaultParams(),
 		Gauges: []types.Gauge{gauge},
 		LockableDurations: []time.Duration{
 			time.Second,
@@ -23,6 +23,12 @@ func NewHandler(k *keeper.Keeper) sdk.Handler {
 		case *types.MsgAddToGauge:
 			res, err := msgServer.AddToGauge(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgClaimRewards:
+			res, err := msgServer.ClaimRewards(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCancelGauge:
+			res, err := msgServer.CancelGauge(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	gammtypes "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/v7/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v7/x/lockup/types"
 	db "github.com/tendermint/tm-db"
@@ -93,22 +94,38 @@ func (k Keeper) getLocksToDistributionWithMaxDuration(ctx sdk.Context, distrTo l
 		}
 		return k.lk.GetLocksLongerThanDurationDenom(ctx, distrTo.Denom, distrTo.Duration)
 	case lockuptypes.ByTime:
-		panic("Gauge by time is present!?!? Should have been blocked in ValidateBasic")
+		return k.lk.GetLocksPastTimeDenom(ctx, distrTo.Denom, distrTo.Timestamp)
 	default:
 	}
 	return []lockuptypes.PeriodLock{}
 }
 
+// getLockedAmount returns the amount locked by the locks that fit a distribution condition.
+// Locks are only accumulated by duration, so time conditions sum their locks.
+func (k Keeper) getLockedAmount(ctx sdk.Context, distrTo lockuptypes.QueryCondition) sdk.Int {
+	if distrTo.LockQueryType == lockuptypes.ByTime {
+		locks := k.lk.GetLocksPastTimeDenom(ctx, distrTo.Denom, distrTo.Timestamp)
+		return lockuptypes.SumLocksByDenom(locks, distrTo.Denom)
+	}
+	return k.lk.GetPeriodLocksAccumulation(ctx, distrTo)
+}
+
 // FilteredLocksDistributionEst estimate distribution amount coins from gauge for fitting conditions
 // Expectation: gauge is a valid gauge
 // filteredLocks are all locks that are valid for gauge
 // It also applies an update for the gauge, handling the sending of the rewards.
 // (Note this update is in-memory, it does not change state.)
 func (k Keeper) FilteredLocksDistributionEst(ctx sdk.Context, gauge types.Gauge, filteredLocks []lockuptypes.PeriodLock) (types.Gauge, sdk.Coins, error) {
-	TotalAmtLocked := k.lk.GetPeriodLocksAccumulation(ctx, gauge.DistributeTo)
+	TotalAmtLocked := k.getLockedAmount(ctx, gauge.DistributeTo)
 	if TotalAmtLocked.IsZero() {
 		return types.Gauge{}, nil, nil
 	}
+	if gauge.DistributeTo.LockQueryType == lockuptypes.ByTime && len(filteredLocks) != 0 {
+		filteredLocks = FilterLocksByEndTime(filteredLocks, ctx.BlockTime(), gauge.DistributeTo.Timestamp)
+		if len(filteredLocks) == 0 {
+			return gauge, sdk.Coins{}, nil
+		}
+	}
 
 	remainCoins := gauge.Coins.Sub(gauge.DistributedCoins)
 	// Remaining epochs is the number of remaining epochs that the gauge will pay out its rewards
@@ -164,6 +181,24 @@ type distributionInfo struct {
 	idToBech32Addr    []string
 	idToDecodedAddr   []sdk.AccAddress
 	idToDistrCoins    []sdk.Coins
+	// rewards of auto-compounding locks, to compound once they have been sent
+	lockIDToCompound map[uint64]int
+	compounds        []lockCompound
+	// rewards of tokenized locks, held by x/lockup for their receipt holders
+	lockIDToReceipt map[uint64]int
+	receipts        []lockReceiptRewards
+}
+
+// lockReceiptRewards is the rewards a tokenized lock earned.
+type lockReceiptRewards struct {
+	lockID  uint64
+	rewards sdk.Coins
+}
+
+// lockCompound is the rewards an auto-compounding lock earned.
+type lockCompound struct {
+	lock    lockuptypes.PeriodLock
+	rewards sdk.Coins
 }
 
 func newDistributionInfo() distributionInfo {
@@ -173,6 +208,10 @@ func newDistributionInfo() distributionInfo {
 		idToBech32Addr:    []string{},
 		idToDecodedAddr:   []sdk.AccAddress{},
 		idToDistrCoins:    []sdk.Coins{},
+		lockIDToCompound:  make(map[uint64]int),
+		compounds:         []lockCompound{},
+		lockIDToReceipt:   make(map[uint64]int),
+		receipts:          []lockReceiptRewards{},
 	}
 }
 
@@ -195,6 +234,45 @@ func (d *distributionInfo) addLockRewards(owner string, rewards sdk.Coins) error
 	return nil
 }
 
+// addLockCompound records rewards to compound into an auto-compounding lock.
+// They still have to be added to the lock owner's rewards.
+func (d *distributionInfo) addLockCompound(lock lockuptypes.PeriodLock, rewards sdk.Coins) {
+	if id, ok := d.lockIDToCompound[lock.ID]; ok {
+		d.compounds[id].rewards = d.compounds[id].rewards.Add(rewards...)
+		return
+	}
+	d.lockIDToCompound[lock.ID] = len(d.compounds)
+	d.compounds = append(d.compounds, lockCompound{lock: lock, rewards: rewards})
+}
+
+// addReceiptRewards records rewards of a tokenized lock, which go to the
+// holder of its receipt instead of its owner.
+func (d *distributionInfo) addReceiptRewards(lockID uint64, rewards sdk.Coins) {
+	if id, ok := d.lockIDToReceipt[lockID]; ok {
+		d.receipts[id].rewards = d.receipts[id].rewards.Add(rewards...)
+		return
+	}
+	d.lockIDToReceipt[lockID] = len(d.receipts)
+	d.receipts = append(d.receipts, lockReceiptRewards{lockID: lockID, rewards: rewards})
+}
+
+// addLockRewardsOrReceipt records the rewards of a lock for its owner, or for
+// its receipt holder if the lock is tokenized.
+func (d *distributionInfo) addLockRewardsOrReceipt(lock lockuptypes.PeriodLock, rewards sdk.Coins) error {
+	if lock.Tokenized {
+		d.addReceiptRewards(lock.ID, rewards)
+		return nil
+	}
+	err := d.addLockRewards(lock.Owner, rewards)
+	if err != nil {
+		return err
+	}
+	if lock.AutoCompound {
+		d.addLockCompound(lock, rewards)
+	}
+	return nil
+}
+
 func (k Keeper) doDistributionSends(ctx sdk.Context, distrs *distributionInfo) error {
 	numIDs := len(distrs.idToDecodedAddr)
 	ctx.Logger().Debug(fmt.Sprintf("Beginning distribution to %d users", numIDs))
@@ -216,10 +294,129 @@ func (k Keeper) doDistributionSends(ctx sdk.Context, distrs *distributionInfo) e
 			),
 		})
 	}
+	for _, receipt := range distrs.receipts {
+		err := k.lk.AddReceiptRewards(ctx, receipt.lockID, types.ModuleName, receipt.rewards)
+		if err != nil {
+			return err
+		}
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.TypeEvtReceiptDistribution,
+				sdk.NewAttribute(types.AttributeLockID, fmt.Sprintf("%d", receipt.lockID)),
+				sdk.NewAttribute(types.AttributeAmount, receipt.rewards.String()),
+			),
+		})
+	}
 	ctx.Logger().Debug(fmt.Sprintf("Finished Distributing to %d users", numIDs))
 	return nil
 }
 
+// autoCompoundMinShares returns the least shares that joining reward into a
+// pool must mint: what the reward is worth in shares at the pool's TWAP over
+// AutoCompoundTwapWindow, less maxSlippage. It errors if the pool has no TWAP
+// of the reward denom over the window.
+func (k Keeper) autoCompoundMinShares(ctx sdk.Context, pool gammtypes.PoolI, reward sdk.Coin, maxSlippage sdk.Dec) (sdk.Int, error) {
+	startTime := ctx.BlockTime().Add(-types.AutoCompoundTwapWindow)
+	// value of the pool's liquidity, in the reward denom
+	poolValue := sdk.ZeroDec()
+	for _, coin := range pool.GetTotalPoolLiquidity(ctx) {
+		if coin.Denom == reward.Denom {
+			poolValue = poolValue.Add(coin.Amount.ToDec())
+			continue
+		}
+		price, err := k.tk.GetArithmeticTwapToNow(ctx, pool.GetId(), reward.Denom, coin.Denom, startTime)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		poolValue = poolValue.Add(coin.Amount.ToDec().Mul(price))
+	}
+	if !poolValue.IsPositive() {
+		return sdk.Int{}, fmt.Errorf("pool %d has no liquidity", pool.GetId())
+	}
+
+	shares := pool.GetTotalShares().ToDec().Mul(reward.Amount.ToDec()).Quo(poolValue)
+	minShares := shares.Mul(sdk.OneDec().Sub(maxSlippage)).TruncateInt()
+	if !minShares.IsPositive() {
+		return sdk.OneInt(), nil
+	}
+	return minShares, nil
+}
+
+// doAutoCompounds joins the rewards of every auto-compounding lock into the pool
+// of the lock's shares, one coin at a time, and adds the shares to the lock.
+// Each join must mint at least what the reward is worth at the pool's TWAP,
+// less the AutoCompoundMaxSlippage param, so that a manipulated spot price
+// cannot take the rewards. The rewards were already sent to the lock owners,
+// so any reward that cannot be compounded, such as a coin the pool does not
+// hold or a join that would mint too few shares, stays with the owner liquid.
+func (k Keeper) doAutoCompounds(ctx sdk.Context, distrs *distributionInfo) {
+	if len(distrs.compounds) == 0 {
+		return
+	}
+	maxSlippage := k.GetParams(ctx).AutoCompoundMaxSlippage
+	for _, compound := range distrs.compounds {
+		lock := compound.lock
+		// unlocking locks are on their way out, so their rewards are not compounded
+		if lock.IsUnlocking() {
+			continue
+		}
+		lockedCoin, err := lock.SingleCoin()
+		if err != nil {
+			continue
+		}
+		poolId, err := gammtypes.GetPoolIdFromShareDenom(lockedCoin.Denom)
+		if err != nil {
+			continue
+		}
+
+		shares := sdk.ZeroInt()
+		compounded := sdk.Coins{}
+		for _, reward := range compound.rewards {
+			cacheCtx, write := ctx.CacheContext()
+			pool, err := k.gk.GetPoolAndPoke(cacheCtx, poolId)
+			if err != nil {
+				k.Logger(ctx).Debug(fmt.Sprintf("reward %s of lock %d not compounded: %s", reward, lock.ID, err))
+				continue
+			}
+			minShares, err := k.autoCompoundMinShares(cacheCtx, pool, reward, maxSlippage)
+			if err != nil {
+				k.Logger(ctx).Debug(fmt.Sprintf("reward %s of lock %d not compounded: %s", reward, lock.ID, err))
+				continue
+			}
+			sharesOut, err := k.gk.JoinSwapExactAmountIn(cacheCtx, lock.OwnerAddress(), poolId, sdk.Coins{reward}, minShares)
+			if err != nil {
+				k.Logger(ctx).Debug(fmt.Sprintf("reward %s of lock %d not compounded: %s", reward, lock.ID, err))
+				continue
+			}
+			write()
+			shares = shares.Add(sharesOut)
+			compounded = compounded.Add(reward)
+		}
+		if shares.IsZero() {
+			continue
+		}
+
+		sharesCoins := sdk.NewCoins(sdk.NewCoin(lockedCoin.Denom, shares))
+		cacheCtx, write := ctx.CacheContext()
+		if _, err := k.lk.AddTokensToLockByID(cacheCtx, lock.ID, sharesCoins); err != nil {
+			// the owner keeps the shares liquid
+			k.Logger(ctx).Error(fmt.Sprintf("compounded shares of lock %d not added to the lock: %s", lock.ID, err))
+			continue
+		}
+		write()
+
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.TypeEvtAutoCompound,
+				sdk.NewAttribute(types.AttributeLockID, fmt.Sprintf("%d", lock.ID)),
+				sdk.NewAttribute(types.AttributeReceiver, lock.Owner),
+				sdk.NewAttribute(types.AttributeAmount, compounded.String()),
+				sdk.NewAttribute(types.AttributeShares, sharesCoins.String()),
+			),
+		})
+	}
+}
+
 // distributeSyntheticInternal runs the distribution logic for a synthetic rewards distribution gauge, and adds the sends to
 // the distrInfo computed. It also updates the gauge for the distribution.
 // locks is expected to be the correct set of lock recipients for this gauge.
@@ -274,7 +471,7 @@ func (k Keeper) distributeSyntheticInternal(
 			continue
 		}
 		// Update the amount for that address
-		err := distrInfo.addLockRewards(lock.Owner, distrCoins)
+		err := distrInfo.addLockRewardsOrReceipt(lock, distrCoins)
 		if err != nil {
 			return nil, err
 		}
@@ -323,7 +520,7 @@ func (k Keeper) distributeInternal(
 			continue
 		}
 		// Update the amount for that address
-		err := distrInfo.addLockRewards(lock.Owner, distrCoins)
+		err := distrInfo.addLockRewardsOrReceipt(lock, distrCoins)
 		if err != nil {
 			return nil, err
 		}
@@ -350,9 +547,12 @@ func (k Keeper) getDistributeToBaseLocks(ctx sdk.Context, gauge types.Gauge, cac
 	if gauge.Coins.Empty() {
 		return []lockuptypes.PeriodLock{}
 	}
+	// gauges by time are never for synthetic denoms, and their locks are not shared with other gauges
+	if gauge.DistributeTo.LockQueryType == lockuptypes.ByTime {
+		return k.getLocksToDistributionWithMaxDuration(ctx, gauge.DistributeTo, time.Millisecond)
+	}
 	// TODO: FIXME!!!
 	// Confusingly, there is no way to get all synthetic lockups. Thus we use a separate method `distributeSyntheticInternal` to separately get lockSum for synthetic lockups.
-	// All gauges have a precondition of being ByDuration.
 	distributeBaseDenom := lockuptypes.NativeDenom(gauge.DistributeTo.Denom)
 	if _, ok := cache[distributeBaseDenom]; !ok {
 		cache[distributeBaseDenom] = k.getLocksToDistributionWithMaxDuration(
@@ -390,6 +590,7 @@ func (k Keeper) Distribute(ctx sdk.Context, gauges []types.Gauge) (sdk.Coins, er
 	if err != nil {
 		return nil, err
 	}
+	k.doAutoCompounds(ctx, &distrInfo)
 	k.hooks.AfterEpochDistribution(ctx)
 
 	k.checkFinishDistribution(ctx, gauges)
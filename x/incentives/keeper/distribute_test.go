@@ -1,11 +1,15 @@
 package keeper_test
 
 import (
+	"fmt"
 	"time"
 
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/balancer"
+	gammtypes "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/v7/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v7/x/lockup/types"
 
+	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -250,3 +254,155 @@ func (suite *KeeperTestSuite) TestNoLockNonPerpetualGaugeDistribution() {
 	suite.Require().Len(gauges, 1)
 	suite.Require().Equal(gauges[0].String(), expectedGauge.String())
 }
+
+// TestAutoCompoundDistribution tests that rewards of an auto-compounding lock
+// are joined into the lock's pool and added to the lock, while rewards that
+// cannot be joined are paid out liquid.
+func (suite *KeeperTestSuite) TestAutoCompoundDistribution() {
+	suite.SetupTest()
+
+	// create a pool with the reward denom as one of its assets
+	poolCreator := sdk.AccAddress([]byte("addr_pool_creator---"))
+	poolAssets := []balancer.PoolAsset{
+		{Weight: sdk.NewInt(1), Token: sdk.NewInt64Coin(defaultRewardDenom, 1_000_000)},
+		{Weight: sdk.NewInt(1), Token: sdk.NewInt64Coin("foo", 1_000_000)},
+	}
+	err := simapp.FundAccount(suite.app.BankKeeper, suite.ctx, poolCreator, sdk.NewCoins(
+		sdk.NewInt64Coin("uosmo", 10_000_000_000),
+		poolAssets[0].Token, poolAssets[1].Token))
+	suite.Require().NoError(err)
+	poolId, err := suite.app.GAMMKeeper.CreatePool(suite.ctx, balancer.NewMsgCreateBalancerPool(
+		poolCreator, balancer.PoolParams{SwapFee: sdk.ZeroDec(), ExitFee: sdk.ZeroDec()}, poolAssets, ""))
+	suite.Require().NoError(err)
+	shareDenom := gammtypes.GetPoolShareDenom(poolId)
+	// rewards are valued at the pool's TWAP, which needs price history
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(types.AutoCompoundTwapWindow))
+
+	// lock shares of the pool and opt in to auto-compounding
+	lockOwner := sdk.AccAddress([]byte("addr_lock_owner-----"))
+	lockedShares := sdk.NewCoins(sdk.NewCoin(shareDenom, gammtypes.OneShare))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoins(suite.ctx, poolCreator, lockOwner, lockedShares))
+	lock, err := suite.app.LockupKeeper.LockTokens(suite.ctx, lockOwner, lockedShares, defaultLockDuration)
+	suite.Require().NoError(err)
+	err = suite.app.LockupKeeper.SetAutoCompound(suite.ctx, lock.ID, lockOwner, true)
+	suite.Require().NoError(err)
+
+	// the gauge pays a pool asset, which is compounded, and a foreign denom, which is not
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(defaultRewardDenom, 1000), sdk.NewInt64Coin("bar", 500))
+	distrTo := lockuptypes.QueryCondition{
+		LockQueryType: lockuptypes.ByDuration,
+		Denom:         shareDenom,
+		Duration:      defaultLockDuration,
+	}
+	_, gauge := suite.CreateGauge(true, poolCreator, rewards, distrTo, suite.ctx.BlockTime(), 1)
+
+	_, err = suite.app.IncentivesKeeper.Distribute(suite.ctx, []types.Gauge{*gauge})
+	suite.Require().NoError(err)
+
+	// the lock holds more shares than before
+	compoundedLock, err := suite.app.LockupKeeper.GetLockByID(suite.ctx, lock.ID)
+	suite.Require().NoError(err)
+	suite.Require().True(compoundedLock.Coins.AmountOf(shareDenom).GT(gammtypes.OneShare))
+
+	// only the foreign denom was paid out liquid
+	bal := suite.app.BankKeeper.GetAllBalances(suite.ctx, lockOwner)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("bar", 500)).String(), bal.String())
+
+	// once the spot price of the reward is pushed well below its TWAP, joining
+	// it would mint too few shares, so it is paid out liquid
+	swapIn := sdk.NewInt64Coin(defaultRewardDenom, 500_000)
+	suite.Require().NoError(simapp.FundAccount(suite.app.BankKeeper, suite.ctx, poolCreator, sdk.NewCoins(swapIn)))
+	_, err = suite.app.SwapRouterKeeper.SwapExactAmountIn(suite.ctx, poolCreator, poolId, swapIn, "foo", sdk.OneInt())
+	suite.Require().NoError(err)
+	_, gauge = suite.CreateGauge(true, poolCreator, sdk.NewCoins(sdk.NewInt64Coin(defaultRewardDenom, 1000)), distrTo, suite.ctx.BlockTime(), 1)
+	_, err = suite.app.IncentivesKeeper.Distribute(suite.ctx, []types.Gauge{*gauge})
+	suite.Require().NoError(err)
+	uncompoundedLock, err := suite.app.LockupKeeper.GetLockByID(suite.ctx, lock.ID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(compoundedLock.Coins, uncompoundedLock.Coins)
+	suite.Require().Equal(sdk.NewInt(1000), suite.app.BankKeeper.GetBalance(suite.ctx, lockOwner, defaultRewardDenom).Amount)
+}
+
+// TestTokenizedLockDistribution tests that rewards of a tokenized lock are held
+// for the holder of its receipt, rather than paid to the lock owner.
+func (suite *KeeperTestSuite) TestTokenizedLockDistribution() {
+	suite.SetupTest()
+
+	lockOwner := sdk.AccAddress([]byte("addr_lock_owner-----"))
+	receiptHolder := sdk.AccAddress([]byte("addr_receipt_holder-"))
+	lockedCoins := sdk.Coins{sdk.NewInt64Coin("lptoken", 10)}
+	err := simapp.FundAccount(suite.app.BankKeeper, suite.ctx, lockOwner, lockedCoins)
+	suite.Require().NoError(err)
+	lock, err := suite.app.LockupKeeper.LockTokens(suite.ctx, lockOwner, lockedCoins, defaultLockDuration)
+	suite.Require().NoError(err)
+	err = suite.app.LockupKeeper.TokenizeLock(suite.ctx, lock.ID, lockOwner)
+	suite.Require().NoError(err)
+	err = suite.app.BankKeeper.SendCoins(suite.ctx, lockOwner, receiptHolder, lockuptypes.ReceiptCoins(lock.ID))
+	suite.Require().NoError(err)
+
+	rewards := sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 3000)}
+	distrTo := lockuptypes.QueryCondition{
+		LockQueryType: lockuptypes.ByDuration,
+		Denom:         "lptoken",
+		Duration:      defaultLockDuration,
+	}
+	_, gauge := suite.CreateGauge(true, lockOwner, rewards, distrTo, suite.ctx.BlockTime(), 1)
+
+	distrCoins, err := suite.app.IncentivesKeeper.Distribute(suite.ctx, []types.Gauge{*gauge})
+	suite.Require().NoError(err)
+	suite.Require().Equal(rewards, distrCoins)
+
+	// the rewards are held by the lock, for neither the owner nor the holder yet
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, lockOwner, defaultRewardDenom).IsZero())
+	tokenizedLock, err := suite.app.LockupKeeper.GetLockByID(suite.ctx, lock.ID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(rewards, tokenizedLock.ReceiptRewards)
+
+	// the receipt holder claims them
+	claimed, err := suite.app.LockupKeeper.ClaimReceiptRewards(suite.ctx, lock.ID, receiptHolder)
+	suite.Require().NoError(err)
+	suite.Require().Equal(rewards, claimed)
+	suite.Require().Equal(rewards, suite.app.BankKeeper.GetAllBalances(suite.ctx, receiptHolder).Sub(lockuptypes.ReceiptCoins(lock.ID)))
+}
+
+// TestByTimeGaugeDistribution tests that a gauge by time only pays the locks
+// ending after its lock end time, and estimates their rewards.
+func (suite *KeeperTestSuite) TestByTimeGaugeDistribution() {
+	suite.SetupTest()
+	lockEndTime := suite.ctx.BlockTime().Add(2 * time.Hour)
+
+	// the first and last locks end before the lock end time
+	addrs := []sdk.AccAddress{}
+	for i, duration := range []time.Duration{time.Hour, 3 * time.Hour, 3 * time.Hour, time.Hour} {
+		addr := sdk.AccAddress([]byte(fmt.Sprintf("bytime_lock_owner_%02d", i)))
+		suite.LockTokens(addr, defaultLPTokens, duration)
+		addrs = append(addrs, addr)
+	}
+	for _, addr := range addrs[2:] {
+		_, err := suite.app.LockupKeeper.BeginUnlockAllNotUnlockings(suite.ctx, addr)
+		suite.Require().NoError(err)
+	}
+
+	distrTo := lockuptypes.QueryCondition{
+		LockQueryType: lockuptypes.ByTime,
+		Denom:         defaultLPDenom,
+		Timestamp:     lockEndTime,
+	}
+	rewards := sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 3000)}
+	_, gauge := suite.CreateGauge(false, sdk.AccAddress([]byte("Gauge_Creation_Addr_")), rewards, distrTo, suite.ctx.BlockTime(), 1)
+
+	expectedRewards := []sdk.Coins{{}, {sdk.NewInt64Coin(defaultRewardDenom, 1500)}, {sdk.NewInt64Coin(defaultRewardDenom, 1500)}, {}}
+	for i, addr := range addrs {
+		rewardsEst := suite.app.IncentivesKeeper.GetRewardsEst(suite.ctx, addr, []lockuptypes.PeriodLock{}, 100)
+		suite.Require().Equal(expectedRewards[i].String(), rewardsEst.String(), "lock %d", i)
+	}
+
+	err := suite.app.IncentivesKeeper.BeginDistribution(suite.ctx, *gauge)
+	suite.Require().NoError(err)
+	distributed, err := suite.app.IncentivesKeeper.Distribute(suite.ctx, []types.Gauge{*gauge})
+	suite.Require().NoError(err)
+	suite.Require().Equal(rewards, distributed)
+	for i, addr := range addrs {
+		suite.Require().Equal(expectedRewards[i].AmountOf(defaultRewardDenom), suite.app.BankKeeper.GetBalance(suite.ctx, addr, defaultRewardDenom).Amount, "lock %d", i)
+	}
+}
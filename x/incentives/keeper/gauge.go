@@ -13,6 +13,7 @@ import (
 	db "github.com/tendermint/tm-db"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // Iterate over everything in a gauges iterator, until it reaches the end. Return all gauges iterated over.
@@ -96,7 +97,18 @@ func (k Keeper) SetGaugeWithRefKey(ctx sdk.Context, gauge *types.Gauge) error {
 }
 
 // CreateGauge create a gauge and send coins to the gauge.
+// The gauge is not recorded as owned by owner, so it cannot be cancelled.
 func (k Keeper) CreateGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddress, coins sdk.Coins, distrTo lockuptypes.QueryCondition, startTime time.Time, numEpochsPaidOver uint64) (uint64, error) {
+	return k.createGauge(ctx, isPerpetual, owner, coins, distrTo, startTime, numEpochsPaidOver, false)
+}
+
+// CreateExternalGauge creates a gauge funded by an account, which is recorded
+// as its owner and can cancel it to refund its undistributed coins.
+func (k Keeper) CreateExternalGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddress, coins sdk.Coins, distrTo lockuptypes.QueryCondition, startTime time.Time, numEpochsPaidOver uint64) (uint64, error) {
+	return k.createGauge(ctx, isPerpetual, owner, coins, distrTo, startTime, numEpochsPaidOver, true)
+}
+
+func (k Keeper) createGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddress, coins sdk.Coins, distrTo lockuptypes.QueryCondition, startTime time.Time, numEpochsPaidOver uint64, recordOwner bool) (uint64, error) {
 	// Ensure that this gauge's duration is one of the allowed durations on chain
 	durations := k.GetLockableDurations(ctx)
 	if distrTo.LockQueryType == lockuptypes.ByDuration {
@@ -111,6 +123,13 @@ func (k Keeper) CreateGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddr
 			return 0, fmt.Errorf("invalid duration: %d", distrTo.Duration)
 		}
 	}
+	if distrTo.LockQueryType != lockuptypes.ByDuration && distrTo.LockQueryType != lockuptypes.ByTime {
+		return 0, fmt.Errorf("invalid lock query type: %d", distrTo.LockQueryType)
+	}
+	// synthetic locks are only indexed by duration
+	if distrTo.LockQueryType == lockuptypes.ByTime && lockuptypes.IsSyntheticDenom(distrTo.Denom) {
+		return 0, fmt.Errorf("time query condition is not allowed for synthetic denom: %s", distrTo.Denom)
+	}
 
 	// Ensure that the denom this gauge pays out to exists on-chain
 	if !k.bk.HasSupply(ctx, distrTo.Denom) && !strings.Contains(distrTo.Denom, "osmovaloper") {
@@ -125,6 +144,10 @@ func (k Keeper) CreateGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddr
 		StartTime:         startTime,
 		NumEpochsPaidOver: numEpochsPaidOver,
 	}
+	if recordOwner {
+		gauge.Owner = owner.String()
+		addGaugeFunder(&gauge, owner, coins)
+	}
 
 	if err := k.bk.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, gauge.Coins); err != nil {
 		return 0, err
@@ -159,6 +182,10 @@ func (k Keeper) AddToGaugeRewards(ctx sdk.Context, owner sdk.AccAddress, coins s
 	}
 
 	gauge.Coins = gauge.Coins.Add(coins...)
+	// the funders of owned gauges are refunded when the gauge is cancelled
+	if gauge.Owner != "" {
+		addGaugeFunder(gauge, owner, coins)
+	}
 	err = k.setGauge(ctx, gauge)
 	if err != nil {
 		return err
@@ -167,6 +194,123 @@ func (k Keeper) AddToGaugeRewards(ctx sdk.Context, owner sdk.AccAddress, coins s
 	return nil
 }
 
+// addGaugeFunder records that funder added coins to the gauge.
+func addGaugeFunder(gauge *types.Gauge, funder sdk.AccAddress, coins sdk.Coins) {
+	if coins.Empty() {
+		return
+	}
+	for i, gaugeFunder := range gauge.Funders {
+		if gaugeFunder.Address == funder.String() {
+			gauge.Funders[i].Coins = gaugeFunder.Coins.Add(coins...)
+			return
+		}
+	}
+	gauge.Funders = append(gauge.Funders, types.GaugeFunder{Address: funder.String(), Coins: coins})
+}
+
+// gaugeRefunds splits the undistributed coins of a gauge between its funders,
+// in proportion to the coins each of them added. The owner is refunded what
+// the division rounds down, along with its own share.
+func gaugeRefunds(gauge types.Gauge, undistributed sdk.Coins) []types.GaugeFunder {
+	funded := sdk.Coins{}
+	for _, funder := range gauge.Funders {
+		funded = funded.Add(funder.Coins...)
+	}
+
+	refunds := []types.GaugeFunder{}
+	remainder := undistributed
+	ownerIndex := -1
+	for _, funder := range gauge.Funders {
+		refund := sdk.Coins{}
+		for _, coin := range undistributed {
+			added := funder.Coins.AmountOf(coin.Denom)
+			if !added.IsPositive() {
+				continue
+			}
+			amount := coin.Amount.Mul(added).Quo(funded.AmountOf(coin.Denom))
+			if amount.IsPositive() {
+				refund = refund.Add(sdk.NewCoin(coin.Denom, amount))
+			}
+		}
+		remainder = remainder.Sub(refund)
+		if funder.Address == gauge.Owner {
+			ownerIndex = len(refunds)
+		}
+		refunds = append(refunds, types.GaugeFunder{Address: funder.Address, Coins: refund})
+	}
+	if ownerIndex < 0 {
+		ownerIndex = len(refunds)
+		refunds = append(refunds, types.GaugeFunder{Address: gauge.Owner, Coins: sdk.Coins{}})
+	}
+	refunds[ownerIndex].Coins = refunds[ownerIndex].Coins.Add(remainder...)
+	return refunds
+}
+
+// CancelGauge stops a gauge, if it has not finished yet, and refunds the coins
+// it has not distributed to its funders, pro rata to the coins each added.
+// Only gauges recorded as owned by owner can be cancelled, and not while a
+// distribution of the gauge is being paid out. Rewards that already accrued to
+// locks stay with them. It returns the refund of each funder.
+func (k Keeper) CancelGauge(ctx sdk.Context, owner sdk.AccAddress, gaugeID uint64) ([]types.GaugeFunder, error) {
+	gauge, err := k.GetGaugeByID(ctx, gaugeID)
+	if err != nil {
+		return nil, err
+	}
+	if gauge.Owner == "" || gauge.Owner != owner.String() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "gauge %d is not owned by %s", gaugeID, owner)
+	}
+	if ctx.KVStore(k.storeKey).Has(gaugeDistributionStoreKey(gaugeID)) {
+		return nil, fmt.Errorf("gauge %d is still being distributed", gaugeID)
+	}
+
+	timeKey := getTimeKey(gauge.StartTime)
+	upcomingKey := combineKeys(types.KeyPrefixUpcomingGauges, timeKey)
+	activeKey := combineKeys(types.KeyPrefixActiveGauges, timeKey)
+	finished := true
+	if findIndex(k.getGaugeRefs(ctx, upcomingKey), gaugeID) >= 0 {
+		// upcoming gauges are finished the same way as active ones
+		if err := k.deleteGaugeRefByKey(ctx, upcomingKey, gaugeID); err != nil {
+			return nil, err
+		}
+		if err := k.addGaugeRefByKey(ctx, activeKey, gaugeID); err != nil {
+			return nil, err
+		}
+		finished = false
+	} else if findIndex(k.getGaugeRefs(ctx, activeKey), gaugeID) >= 0 {
+		finished = false
+	}
+
+	reclaimed := gauge.Coins.Sub(gauge.DistributedCoins)
+	if finished && reclaimed.Empty() {
+		return nil, fmt.Errorf("gauge %d has finished and has no undistributed coins", gaugeID)
+	}
+	if !finished {
+		if err := k.FinishDistribution(ctx, *gauge); err != nil {
+			return nil, err
+		}
+	}
+
+	// the gauge is left with what it distributed
+	gauge.Coins = gauge.DistributedCoins
+	if err := k.setGauge(ctx, gauge); err != nil {
+		return nil, err
+	}
+	refunds := gaugeRefunds(*gauge, reclaimed)
+	for _, refund := range refunds {
+		if refund.Coins.Empty() {
+			continue
+		}
+		funder, err := sdk.AccAddressFromBech32(refund.Address)
+		if err != nil {
+			return nil, err
+		}
+		if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, funder, refund.Coins); err != nil {
+			return nil, err
+		}
+	}
+	return refunds, nil
+}
+
 // GetGaugeByID Returns gauge from gauge ID.
 func (k Keeper) GetGaugeByID(ctx sdk.Context, gaugeID uint64) (*types.Gauge, error) {
 	gauge := types.Gauge{}
@@ -0,0 +1,326 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/osmosis-labs/osmosis/v7/x/incentives/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v7/x/lockup/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// gaugeDistributionStoreKey returns the store key of the distribution of a gauge.
+func gaugeDistributionStoreKey(gaugeID uint64) []byte {
+	return combineKeys(types.KeyPrefixGaugeDistributions, sdk.Uint64ToBigEndian(gaugeID))
+}
+
+// distributionLockStoreKey returns the store key of the lock at index in the
+// distribution of a gauge.
+func distributionLockStoreKey(gaugeID, index uint64) []byte {
+	return combineKeys(types.KeyPrefixGaugeDistributionLocks, sdk.Uint64ToBigEndian(gaugeID), sdk.Uint64ToBigEndian(index))
+}
+
+// SetGaugeDistribution stores the distribution of a gauge still being paid out,
+// and each of its locks under its own key, from NextLockIndex on.
+func (k Keeper) SetGaugeDistribution(ctx sdk.Context, distribution types.GaugeDistribution) {
+	store := ctx.KVStore(k.storeKey)
+	for i, lock := range distribution.Locks {
+		bz, err := proto.Marshal(&lock)
+		if err != nil {
+			panic(err)
+		}
+		store.Set(distributionLockStoreKey(distribution.GaugeId, distribution.NextLockIndex+uint64(i)), bz)
+	}
+	distribution.Locks = nil
+	k.setGaugeDistribution(ctx, distribution)
+}
+
+// setGaugeDistribution stores the distribution of a gauge, without its locks.
+func (k Keeper) setGaugeDistribution(ctx sdk.Context, distribution types.GaugeDistribution) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := proto.Marshal(&distribution)
+	if err != nil {
+		panic(err)
+	}
+	store.Set(gaugeDistributionStoreKey(distribution.GaugeId), bz)
+}
+
+func (k Keeper) deleteGaugeDistribution(ctx sdk.Context, gaugeID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(gaugeDistributionStoreKey(gaugeID))
+}
+
+// popDistributionLock returns the lock at index in the distribution of a
+// gauge, and deletes it.
+func (k Keeper) popDistributionLock(ctx sdk.Context, gaugeID, index uint64) (types.DistributionLock, error) {
+	store := ctx.KVStore(k.storeKey)
+	key := distributionLockStoreKey(gaugeID, index)
+	bz := store.Get(key)
+	if bz == nil {
+		return types.DistributionLock{}, fmt.Errorf("lock %d of the distribution of gauge %d not found", index, gaugeID)
+	}
+	lock := types.DistributionLock{}
+	if err := proto.Unmarshal(bz, &lock); err != nil {
+		return types.DistributionLock{}, err
+	}
+	store.Delete(key)
+	return lock, nil
+}
+
+// getDistributionLocks returns the locks the distribution of a gauge has not
+// paid yet, in distribution order.
+func (k Keeper) getDistributionLocks(ctx sdk.Context, gaugeID uint64) []types.DistributionLock {
+	prefix := combineKeys(types.KeyPrefixGaugeDistributionLocks, sdk.Uint64ToBigEndian(gaugeID), []byte{})
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+
+	locks := []types.DistributionLock{}
+	for ; iterator.Valid(); iterator.Next() {
+		lock := types.DistributionLock{}
+		if err := proto.Unmarshal(iterator.Value(), &lock); err != nil {
+			panic(err)
+		}
+		locks = append(locks, lock)
+	}
+	return locks
+}
+
+// iterateGaugeDistributions iterates over the distributions still being paid
+// out, without their locks, by gauge ID, until fn returns true.
+func (k Keeper) iterateGaugeDistributions(ctx sdk.Context, fn func(distribution types.GaugeDistribution) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixGaugeDistributions)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		distribution := types.GaugeDistribution{}
+		if err := proto.Unmarshal(iterator.Value(), &distribution); err != nil {
+			panic(err)
+		}
+		if fn(distribution) {
+			return
+		}
+	}
+}
+
+// GetGaugeDistributions returns the distributions still being paid out, by
+// gauge ID, with the locks they have not paid yet.
+func (k Keeper) GetGaugeDistributions(ctx sdk.Context) []types.GaugeDistribution {
+	distributions := []types.GaugeDistribution{}
+	k.iterateGaugeDistributions(ctx, func(distribution types.GaugeDistribution) bool {
+		distributions = append(distributions, distribution)
+		return false
+	})
+	for i := range distributions {
+		distributions[i].Locks = k.getDistributionLocks(ctx, distributions[i].GaugeId)
+	}
+	return distributions
+}
+
+// GetDistributionProgress returns how far each distribution still being paid out has been paid.
+func (k Keeper) GetDistributionProgress(ctx sdk.Context) []types.GaugeDistributionProgress {
+	progress := []types.GaugeDistributionProgress{}
+	k.iterateGaugeDistributions(ctx, func(distribution types.GaugeDistribution) bool {
+		progress = append(progress, types.GaugeDistributionProgress{
+			GaugeId:          distribution.GaugeId,
+			NumLocks:         distribution.NumLocks,
+			PaidLocks:        distribution.NextLockIndex,
+			DistributedCoins: distribution.DistributedCoins,
+		})
+		return false
+	})
+	return progress
+}
+
+// newGaugeDistribution snapshots the qualifying locks of a gauge, and what the
+// gauge has left to distribute over how many epochs. It returns nil if no
+// coins are locked for the gauge, in which case Distribute skips it too.
+func (k Keeper) newGaugeDistribution(ctx sdk.Context, gauge types.Gauge, locks []lockuptypes.PeriodLock) *types.GaugeDistribution {
+	denom := gauge.DistributeTo.Denom
+	sumDenom := denom
+	isSynthetic := lockuptypes.IsSyntheticDenom(denom)
+	if isSynthetic {
+		qualifiedLocks := make([]lockuptypes.PeriodLock, 0, len(locks))
+		for _, lock := range locks {
+			if _, err := k.lk.GetSyntheticLockup(ctx, lock.ID, denom); err != nil {
+				continue
+			}
+			qualifiedLocks = append(qualifiedLocks, lock)
+		}
+		locks = qualifiedLocks
+		sumDenom = lockuptypes.NativeDenom(denom)
+	}
+
+	lockSum := lockuptypes.SumLocksByDenom(locks, sumDenom)
+	if lockSum.IsZero() {
+		return nil
+	}
+
+	remainEpochs := uint64(1)
+	if !gauge.IsPerpetual { // set remain epochs when it's not perpetual gauge
+		remainEpochs = gauge.NumEpochsPaidOver - gauge.FilledEpochs
+	}
+
+	distrLocks := make([]types.DistributionLock, 0, len(locks))
+	for _, lock := range locks {
+		amount := lock.Coins.AmountOfNoDenomValidation(denom)
+		if isSynthetic {
+			lockedCoin, err := lock.SingleCoin()
+			if err != nil {
+				k.Logger(ctx).Error(err.Error())
+				continue
+			}
+			amount = lockedCoin.Amount
+		}
+		// locks with nothing locked for the gauge are paid nothing
+		if !amount.IsPositive() {
+			continue
+		}
+		distrLocks = append(distrLocks, types.DistributionLock{LockId: lock.ID, Owner: lock.Owner, Amount: amount})
+	}
+
+	return &types.GaugeDistribution{
+		GaugeId:          gauge.Id,
+		Coins:            gauge.Coins.Sub(gauge.DistributedCoins),
+		RemainEpochs:     remainEpochs,
+		LockSum:          lockSum,
+		Locks:            distrLocks,
+		NextLockIndex:    0,
+		DistributedCoins: sdk.Coins{},
+		NumLocks:         uint64(len(distrLocks)),
+	}
+}
+
+// distributionLockRewards returns the rewards of a lock of a gauge distribution,
+// computed the same way as in distributeInternal.
+func distributionLockRewards(distribution types.GaugeDistribution, lock types.DistributionLock) sdk.Coins {
+	distrCoins := sdk.Coins{}
+	for _, coin := range distribution.Coins {
+		// distribution amount = gauge_size * denom_lock_amount / (total_denom_lock_amount * remain_epochs)
+		amt := coin.Amount.Mul(lock.Amount).Quo(distribution.LockSum.Mul(sdk.NewIntFromUint64(distribution.RemainEpochs)))
+		if amt.IsPositive() {
+			distrCoins = distrCoins.Add(sdk.Coin{Denom: coin.Denom, Amount: amt})
+		}
+	}
+	return distrCoins.Sort()
+}
+
+// addDistributionLockRewards records the rewards of a lock of a gauge
+// distribution. They go to the receipt holder if the lock is tokenized, and
+// are compounded if the lock auto-compounds. If the lock has been deleted or
+// transferred since the epoch, its owner at the epoch is paid.
+func (k Keeper) addDistributionLockRewards(ctx sdk.Context, distrInfo *distributionInfo, distrLock types.DistributionLock, rewards sdk.Coins) error {
+	lock, err := k.lk.GetLockByID(ctx, distrLock.LockId)
+	if err != nil || (!lock.Tokenized && lock.Owner != distrLock.Owner) {
+		return distrInfo.addLockRewards(distrLock.Owner, rewards)
+	}
+	return distrInfo.addLockRewardsOrReceipt(*lock, rewards)
+}
+
+// ScheduleDistribution records what each gauge owes its qualifying locks for
+// this epoch, to be paid by DistributePending over the following blocks. The
+// gauges are updated for the epoch the same way Distribute updates them, and
+// each lock is paid what Distribute would have paid it.
+func (k Keeper) ScheduleDistribution(ctx sdk.Context, gauges []types.Gauge) error {
+	locksByDenomCache := make(map[string][]lockuptypes.PeriodLock)
+	for _, gauge := range gauges {
+		filteredLocks := k.getDistributeToBaseLocks(ctx, gauge, locksByDenomCache)
+		distribution := k.newGaugeDistribution(ctx, gauge, filteredLocks)
+		if distribution == nil {
+			continue
+		}
+		// distributed coins are added as the locks are paid
+		if err := k.updateGaugePostDistribute(ctx, gauge, sdk.Coins{}); err != nil {
+			return err
+		}
+		k.SetGaugeDistribution(ctx, *distribution)
+	}
+
+	k.checkFinishDistribution(ctx, gauges)
+	return nil
+}
+
+// DistributePending pays at most maxLocks locks of the gauge distributions
+// still being paid out, in gauge ID order, and returns the coins paid. Only
+// the distributions and locks paid are read, and the locks paid are deleted.
+// Once every distribution has been paid, the AfterEpochDistribution hook is called.
+func (k Keeper) DistributePending(ctx sdk.Context, maxLocks uint64) (sdk.Coins, error) {
+	distributions := []types.GaugeDistribution{}
+	pending := false
+	unpaidLocks := maxLocks
+	k.iterateGaugeDistributions(ctx, func(distribution types.GaugeDistribution) bool {
+		if unpaidLocks == 0 {
+			pending = true
+			return true
+		}
+		distributions = append(distributions, distribution)
+		numLocks := distribution.NumLocks - distribution.NextLockIndex
+		if numLocks > unpaidLocks {
+			numLocks = unpaidLocks
+		}
+		unpaidLocks -= numLocks
+		return false
+	})
+	if len(distributions) == 0 {
+		return sdk.Coins{}, nil
+	}
+
+	distrInfo := newDistributionInfo()
+	totalDistributedCoins := sdk.Coins{}
+	paidLocks := uint64(0)
+	for _, distribution := range distributions {
+		numLocks := distribution.NumLocks - distribution.NextLockIndex
+		if numLocks > maxLocks-paidLocks {
+			numLocks = maxLocks - paidLocks
+		}
+		end := distribution.NextLockIndex + numLocks
+
+		gaugeDistributedCoins := sdk.Coins{}
+		for index := distribution.NextLockIndex; index < end; index++ {
+			distrLock, err := k.popDistributionLock(ctx, distribution.GaugeId, index)
+			if err != nil {
+				return nil, err
+			}
+			distrCoins := distributionLockRewards(distribution, distrLock)
+			if distrCoins.Empty() {
+				continue
+			}
+			if err := k.addDistributionLockRewards(ctx, &distrInfo, distrLock, distrCoins); err != nil {
+				return nil, err
+			}
+			gaugeDistributedCoins = gaugeDistributedCoins.Add(distrCoins...)
+		}
+		paidLocks += numLocks
+
+		gauge, err := k.GetGaugeByID(ctx, distribution.GaugeId)
+		if err != nil {
+			return nil, err
+		}
+		gauge.DistributedCoins = gauge.DistributedCoins.Add(gaugeDistributedCoins...)
+		if err := k.setGauge(ctx, gauge); err != nil {
+			return nil, err
+		}
+
+		distribution.NextLockIndex = end
+		distribution.DistributedCoins = distribution.DistributedCoins.Add(gaugeDistributedCoins...)
+		if end == distribution.NumLocks {
+			k.deleteGaugeDistribution(ctx, distribution.GaugeId)
+		} else {
+			k.setGaugeDistribution(ctx, distribution)
+			pending = true
+		}
+		totalDistributedCoins = totalDistributedCoins.Add(gaugeDistributedCoins...)
+	}
+
+	err := k.doDistributionSends(ctx, &distrInfo)
+	if err != nil {
+		return nil, err
+	}
+	k.doAutoCompounds(ctx, &distrInfo)
+
+	if !pending {
+		k.hooks.AfterEpochDistribution(ctx)
+	}
+	return totalDistributedCoins, nil
+}
@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/osmosis-labs/osmosis/v7/x/incentives/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v7/x/lockup/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// setupIncrementalDistribution creates locks of uneven amounts, so that rewards
+// are rounded, and a gauge over two epochs distributing to them.
+func (suite *KeeperTestSuite) setupIncrementalDistribution() ([]sdk.AccAddress, types.Gauge) {
+	addrs := []sdk.AccAddress{}
+	for i, amount := range []int64{7, 11, 13, 17, 19} {
+		coins := sdk.Coins{sdk.NewInt64Coin(defaultLPDenom, amount)}
+		addr := suite.setupAddr(i, "incrdist", coins)
+		_, err := suite.app.LockupKeeper.LockTokens(suite.ctx, addr, coins, time.Second)
+		suite.Require().NoError(err)
+		addrs = append(addrs, addr)
+	}
+
+	distrTo := lockuptypes.QueryCondition{
+		LockQueryType: lockuptypes.ByDuration,
+		Denom:         defaultLPDenom,
+		Duration:      time.Second,
+	}
+	rewards := sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 1000), sdk.NewInt64Coin("stake", 333)}
+	_, gauge := suite.CreateGauge(false, sdk.AccAddress([]byte("Gauge_Creation_Addr_")), rewards, distrTo, suite.ctx.BlockTime(), 2)
+	return addrs, *gauge
+}
+
+func (suite *KeeperTestSuite) TestIncrementalDistribution() {
+	// distribute in a single block
+	suite.SetupTest()
+	addrs, gauge := suite.setupIncrementalDistribution()
+	distributed, err := suite.app.IncentivesKeeper.Distribute(suite.ctx, []types.Gauge{gauge})
+	suite.Require().NoError(err)
+	expectedBalances := []sdk.Coins{}
+	for _, addr := range addrs {
+		expectedBalances = append(expectedBalances, suite.app.BankKeeper.GetAllBalances(suite.ctx, addr))
+	}
+	expectedGauge, err := suite.app.IncentivesKeeper.GetGaugeByID(suite.ctx, gauge.Id)
+	suite.Require().NoError(err)
+
+	// distribute the same gauge two locks at a time
+	suite.SetupTest()
+	addrs, gauge = suite.setupIncrementalDistribution()
+	err = suite.app.IncentivesKeeper.ScheduleDistribution(suite.ctx, []types.Gauge{gauge})
+	suite.Require().NoError(err)
+	scheduledGauge, err := suite.app.IncentivesKeeper.GetGaugeByID(suite.ctx, gauge.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedGauge.FilledEpochs, scheduledGauge.FilledEpochs)
+	suite.Require().Empty(scheduledGauge.DistributedCoins)
+
+	incrementallyDistributed := sdk.Coins{}
+	for block := 0; block < 3; block++ {
+		coins, err := suite.app.IncentivesKeeper.DistributePending(suite.ctx, 2)
+		suite.Require().NoError(err)
+		incrementallyDistributed = incrementallyDistributed.Add(coins...)
+
+		res, err := suite.querier.DistributionProgress(sdk.WrapSDKContext(suite.ctx), &types.DistributionProgressRequest{})
+		suite.Require().NoError(err)
+		if block < 2 {
+			// only the locks not paid yet are left in the store
+			distributions := suite.app.IncentivesKeeper.GetGaugeDistributions(suite.ctx)
+			suite.Require().Len(distributions, 1)
+			suite.Require().Len(distributions[0].Locks, 5-2*(block+1))
+			// and storing the distribution back, as genesis import does, leaves the same locks to pay
+			suite.app.IncentivesKeeper.SetGaugeDistribution(suite.ctx, distributions[0])
+			suite.Require().Len(res.Distributions, 1)
+			suite.Require().Equal(gauge.Id, res.Distributions[0].GaugeId)
+			suite.Require().Equal(uint64(5), res.Distributions[0].NumLocks)
+			suite.Require().Equal(uint64(2*(block+1)), res.Distributions[0].PaidLocks)
+			suite.Require().Equal(incrementallyDistributed, res.Distributions[0].DistributedCoins)
+		} else {
+			suite.Require().Empty(res.Distributions)
+		}
+	}
+
+	// nothing is left to pay
+	coins, err := suite.app.IncentivesKeeper.DistributePending(suite.ctx, 2)
+	suite.Require().NoError(err)
+	suite.Require().Empty(coins)
+
+	suite.Require().Equal(distributed, incrementallyDistributed)
+	for i, addr := range addrs {
+		suite.Require().Equal(expectedBalances[i], suite.app.BankKeeper.GetAllBalances(suite.ctx, addr))
+	}
+	incrementalGauge, err := suite.app.IncentivesKeeper.GetGaugeByID(suite.ctx, gauge.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedGauge.FilledEpochs, incrementalGauge.FilledEpochs)
+	suite.Require().Equal(expectedGauge.DistributedCoins, incrementalGauge.DistributedCoins)
+}
+
+func (suite *KeeperTestSuite) TestIncrementalDistributionPaysPreviousEpoch() {
+	suite.SetupTest()
+	params := suite.app.IncentivesKeeper.GetParams(suite.ctx)
+	params.MaxDistributionLocksPerBlock = 2
+	suite.app.IncentivesKeeper.SetParams(suite.ctx, params)
+	addrs, gauge := suite.setupIncrementalDistribution()
+
+	// the epoch only schedules the distribution
+	suite.app.IncentivesKeeper.AfterEpochEnd(suite.ctx, params.DistrEpochIdentifier, 1)
+	suite.Require().Len(suite.app.IncentivesKeeper.GetGaugeDistributions(suite.ctx), 1)
+	for _, addr := range addrs {
+		suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, addr, defaultRewardDenom).IsZero())
+	}
+
+	_, err := suite.app.IncentivesKeeper.DistributePending(suite.ctx, params.MaxDistributionLocksPerBlock)
+	suite.Require().NoError(err)
+
+	// the next epoch pays what is left of the first one before distributing the second,
+	// which pays out the rest of the gauge
+	suite.app.IncentivesKeeper.AfterEpochEnd(suite.ctx, params.DistrEpochIdentifier, 2)
+	_, err = suite.app.IncentivesKeeper.DistributePending(suite.ctx, 5)
+	suite.Require().NoError(err)
+	suite.Require().Empty(suite.app.IncentivesKeeper.GetGaugeDistributions(suite.ctx))
+
+	finishedGauge, err := suite.app.IncentivesKeeper.GetGaugeByID(suite.ctx, gauge.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), finishedGauge.FilledEpochs)
+	paid := sdk.Coins{}
+	for _, addr := range addrs {
+		paid = paid.Add(suite.app.BankKeeper.GetAllBalances(suite.ctx, addr)...)
+	}
+	suite.Require().Equal(finishedGauge.DistributedCoins, paid)
+	suite.Require().Len(suite.app.IncentivesKeeper.GetFinishedGauges(suite.ctx), 1)
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/osmosis-labs/osmosis/v7/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v7/x/lockup/types"
 
+	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -22,6 +23,11 @@ func (suite *KeeperTestSuite) TestInvalidDurationGaugeCreationValidation() {
 	suite.Require().Error(err)
 
 	distrTo.Duration = defaultLockDuration
+	distrTo.LockQueryType = 2 // unknown lock query type
+	_, err = suite.app.IncentivesKeeper.CreateGauge(suite.ctx, false, addrs[0], defaultLiquidTokens, distrTo, time.Time{}, 1)
+	suite.Require().Error(err)
+
+	distrTo.LockQueryType = lockuptypes.ByDuration
 	_, err = suite.app.IncentivesKeeper.CreateGauge(suite.ctx, false, addrs[0], defaultLiquidTokens, distrTo, time.Time{}, 1)
 	suite.Require().NoError(err)
 }
@@ -310,3 +316,88 @@ func (suite *KeeperTestSuite) TestGaugesByDenom() {
 	testGaugeByDenom(true)
 	testGaugeByDenom(false)
 }
+
+func (suite *KeeperTestSuite) TestCancelGauge() {
+	suite.SetupTest()
+
+	lockOwners := suite.SetupManyLocks(1, defaultLiquidTokens, defaultLPTokens, time.Second)
+	owner := sdk.AccAddress([]byte("Gauge_Creation_Addr_"))
+	rewards := sdk.Coins{sdk.NewInt64Coin("stake", 1000)}
+	err := simapp.FundAccount(suite.app.BankKeeper, suite.ctx, owner, rewards.Add(rewards...).Add(rewards...))
+	suite.Require().NoError(err)
+	funder := sdk.AccAddress([]byte("Gauge_Funder_Addr___"))
+	otherFunder := sdk.AccAddress([]byte("Gauge_Funder_Addr_2_"))
+	err = simapp.FundAccount(suite.app.BankKeeper, suite.ctx, funder, sdk.Coins{sdk.NewInt64Coin("stake", 500)})
+	suite.Require().NoError(err)
+	err = simapp.FundAccount(suite.app.BankKeeper, suite.ctx, otherFunder, sdk.Coins{sdk.NewInt64Coin("stake", 1)})
+	suite.Require().NoError(err)
+	distrTo := lockuptypes.QueryCondition{
+		LockQueryType: lockuptypes.ByDuration,
+		Denom:         defaultLPDenom,
+		Duration:      time.Second,
+	}
+	startTime := suite.ctx.BlockTime()
+
+	// a gauge over two epochs, and a gauge no lock qualifies for
+	gaugeID, err := suite.app.IncentivesKeeper.CreateExternalGauge(suite.ctx, false, owner, rewards, distrTo, startTime, 2)
+	suite.Require().NoError(err)
+	distrTo.Duration = time.Hour
+	noLockGaugeID, err := suite.app.IncentivesKeeper.CreateExternalGauge(suite.ctx, false, owner, rewards, distrTo, startTime, 1)
+	suite.Require().NoError(err)
+	// gauges created by modules have no owner
+	moduleGaugeID, err := suite.app.IncentivesKeeper.CreateGauge(suite.ctx, false, owner, rewards, distrTo, startTime, 1)
+	suite.Require().NoError(err)
+
+	_, err = suite.app.IncentivesKeeper.CancelGauge(suite.ctx, lockOwners[0], gaugeID)
+	suite.Require().Error(err)
+	_, err = suite.app.IncentivesKeeper.CancelGauge(suite.ctx, owner, moduleGaugeID)
+	suite.Require().Error(err)
+
+	// anyone can add to an owned gauge, and is recorded as its funder
+	err = suite.app.IncentivesKeeper.AddToGaugeRewards(suite.ctx, funder, sdk.Coins{sdk.NewInt64Coin("stake", 500)}, gaugeID)
+	suite.Require().NoError(err)
+	err = suite.app.IncentivesKeeper.AddToGaugeRewards(suite.ctx, otherFunder, sdk.Coins{sdk.NewInt64Coin("stake", 1)}, gaugeID)
+	suite.Require().NoError(err)
+	gauge, err := suite.app.IncentivesKeeper.GetGaugeByID(suite.ctx, gaugeID)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.GaugeFunder{
+		{Address: owner.String(), Coins: rewards},
+		{Address: funder.String(), Coins: sdk.Coins{sdk.NewInt64Coin("stake", 500)}},
+		{Address: otherFunder.String(), Coins: sdk.Coins{sdk.NewInt64Coin("stake", 1)}},
+	}, gauge.Funders)
+
+	// distribute the first epoch of both owned gauges, which finishes the gauge no lock qualifies for
+	gauges, err := suite.app.IncentivesKeeper.GetGaugeFromIDs(suite.ctx, []uint64{gaugeID, noLockGaugeID})
+	suite.Require().NoError(err)
+	for _, gauge := range gauges {
+		err = suite.app.IncentivesKeeper.BeginDistribution(suite.ctx, gauge)
+		suite.Require().NoError(err)
+	}
+	_, err = suite.app.IncentivesKeeper.Distribute(suite.ctx, gauges)
+	suite.Require().NoError(err)
+
+	// cancelling the active gauge refunds the 751 stake it has not distributed
+	// pro rata to its funders, with the rounding left to the owner, and finishes it
+	refunds, err := suite.app.IncentivesKeeper.CancelGauge(suite.ctx, owner, gaugeID)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.GaugeFunder{
+		{Address: owner.String(), Coins: sdk.Coins{sdk.NewInt64Coin("stake", 501)}},
+		{Address: funder.String(), Coins: sdk.Coins{sdk.NewInt64Coin("stake", 250)}},
+		{Address: otherFunder.String(), Coins: sdk.Coins{}},
+	}, refunds)
+	suite.Require().Equal(sdk.NewInt(250), suite.app.BankKeeper.GetBalance(suite.ctx, funder, "stake").Amount)
+	suite.Require().Empty(suite.app.IncentivesKeeper.GetActiveGauges(suite.ctx))
+	suite.Require().Len(suite.app.IncentivesKeeper.GetFinishedGauges(suite.ctx), 2)
+	gauge, err = suite.app.IncentivesKeeper.GetGaugeByID(suite.ctx, gaugeID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(gauge.DistributedCoins, gauge.Coins)
+
+	// the finished gauge's remainder can be reclaimed once
+	refunds, err = suite.app.IncentivesKeeper.CancelGauge(suite.ctx, owner, noLockGaugeID)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.GaugeFunder{{Address: owner.String(), Coins: rewards}}, refunds)
+	_, err = suite.app.IncentivesKeeper.CancelGauge(suite.ctx, owner, noLockGaugeID)
+	suite.Require().Error(err)
+
+	suite.Require().Equal(sdk.NewInt(1501), suite.app.BankKeeper.GetBalance(suite.ctx, owner, "stake").Amount)
+}
@@ -232,6 +232,12 @@ func (q Querier) LockableDurations(ctx context.Context, _ *types.QueryLockableDu
 	return &types.QueryLockableDurationsResponse{LockableDurations: q.Keeper.GetLockableDurations(sdkCtx)}, nil
 }
 
+// DistributionProgress returns the progress of the distributions still being paid out.
+func (q Querier) DistributionProgress(goCtx context.Context, _ *types.DistributionProgressRequest) (*types.DistributionProgressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.DistributionProgressResponse{Distributions: q.Keeper.GetDistributionProgress(ctx)}, nil
+}
+
 // getGaugeFromIDJsonBytes returns gauges from gauge id json bytes.
 func (q Querier) getGaugeFromIDJsonBytes(ctx sdk.Context, refValue []byte) ([]types.Gauge, error) {
 	gauges := []types.Gauge{}
@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"math"
+	"time"
+
 	epochstypes "github.com/osmosis-labs/osmosis/v7/x/epochs/types"
 	"github.com/osmosis-labs/osmosis/v7/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v7/x/lockup/types"
@@ -26,7 +29,28 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 
 		// distribute due to epoch event
 		ctx.EventManager().IncreaseCapacity(2e6)
+		// the previous epoch is paid in full before this one is distributed
+		if _, err := k.DistributePending(ctx, math.MaxUint64); err != nil {
+			panic(err)
+		}
 		gauges = k.GetActiveGauges(ctx)
+		// when rewards accrue, gauges by duration for native denoms only add to
+		// the reward indexes, and their locks are paid as they claim or change.
+		if params.AccrueRewards {
+			accrueGauges := []types.Gauge{}
+			pushGauges := []types.Gauge{}
+			for _, gauge := range gauges {
+				if lockuptypes.IsSyntheticDenom(gauge.DistributeTo.Denom) || gauge.DistributeTo.LockQueryType == lockuptypes.ByTime {
+					pushGauges = append(pushGauges, gauge)
+				} else {
+					accrueGauges = append(accrueGauges, gauge)
+				}
+			}
+			if err := k.AccrueRewards(ctx, accrueGauges); err != nil {
+				panic(err)
+			}
+			gauges = pushGauges
+		}
 		// only distribute to active gauges that are for native denoms
 		// or non-perpetual and for synthetic denoms.
 		// We distribute to perpetual synthetic denoms elsewhere in superfluid.
@@ -38,7 +62,12 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 				distrGauges = append(distrGauges, gauge)
 			}
 		}
-		_, err := k.Distribute(ctx, distrGauges)
+		var err error
+		if params.MaxDistributionLocksPerBlock == 0 {
+			_, err = k.Distribute(ctx, distrGauges)
+		} else {
+			err = k.ScheduleDistribution(ctx, distrGauges)
+		}
 		if err != nil {
 			panic(err)
 		}
@@ -52,7 +81,10 @@ type Hooks struct {
 	k Keeper
 }
 
-var _ epochstypes.EpochHooks = Hooks{}
+var (
+	_ epochstypes.EpochHooks  = Hooks{}
+	_ lockuptypes.LockupHooks = Hooks{}
+)
 
 // Return the wrapper struct.
 func (k Keeper) Hooks() Hooks {
@@ -67,3 +99,97 @@ func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNu
 func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
 	h.k.AfterEpochEnd(ctx, epochIdentifier, epochNumber)
 }
+
+// lockup hooks. Every change to the coins or duration of a lock first settles
+// the rewards it accrued as it was before the change.
+func (h Hooks) AfterAddTokensToLock(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins) {
+	lock, err := h.k.lk.GetLockByID(ctx, lockID)
+	if err != nil {
+		return
+	}
+	prevLock := *lock
+	prevLock.Coins = lock.Coins.Sub(amount)
+	h.k.settleChangedLock(ctx, prevLock)
+}
+
+func (h Hooks) OnTokenLocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+	// tokens added to an existing lock were settled by AfterAddTokensToLock
+	if _, found := h.k.getLockRewards(ctx, lockID); found {
+		return
+	}
+	if err := h.k.InitializeLockRewards(ctx, lockID); err != nil {
+		h.k.Logger(ctx).Error(err.Error())
+	}
+}
+
+func (h Hooks) OnStartUnlock(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+}
+
+func (h Hooks) OnTokenUnlocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+	h.k.settleChangedLock(ctx, lockuptypes.PeriodLock{
+		ID:       lockID,
+		Owner:    address.String(),
+		Duration: lockDuration,
+		Coins:    amount,
+	})
+}
+
+func (h Hooks) OnTokenSlashed(ctx sdk.Context, lockID uint64, amount sdk.Coins) {
+	lock, err := h.k.lk.GetLockByID(ctx, lockID)
+	if err != nil {
+		return
+	}
+	prevLock := *lock
+	prevLock.Coins = lock.Coins.Add(amount...)
+	h.k.settleChangedLock(ctx, prevLock)
+}
+
+func (h Hooks) OnLockupExtend(ctx sdk.Context, lockID uint64, prevDuration, newDuration time.Duration) {
+	lock, err := h.k.lk.GetLockByID(ctx, lockID)
+	if err != nil {
+		return
+	}
+	prevLock := *lock
+	prevLock.Duration = prevDuration
+	h.k.settleChangedLock(ctx, prevLock)
+}
+
+// OnLockupTransfer settles the rewards the lock accrued to its previous owner.
+func (h Hooks) OnLockupTransfer(ctx sdk.Context, lockID uint64, prevOwner, newOwner sdk.AccAddress) {
+	lock, err := h.k.lk.GetLockByID(ctx, lockID)
+	if err != nil {
+		return
+	}
+	prevLock := *lock
+	prevLock.Owner = prevOwner.String()
+	h.k.settleChangedLock(ctx, prevLock)
+}
+
+func (h Hooks) OnLockupSplit(ctx sdk.Context, lockID, newLockID uint64, amount sdk.Coins) {
+	lock, err := h.k.lk.GetLockByID(ctx, lockID)
+	if err != nil {
+		return
+	}
+	prevLock := *lock
+	prevLock.Coins = lock.Coins.Add(amount...)
+	h.k.settleChangedLock(ctx, prevLock)
+	if err := h.k.InitializeLockRewards(ctx, newLockID); err != nil {
+		h.k.Logger(ctx).Error(err.Error())
+	}
+}
+
+func (h Hooks) OnLockupMerge(ctx sdk.Context, lockID uint64, mergedLockIDs []uint64) {
+	lock, err := h.k.lk.GetLockByID(ctx, lockID)
+	if err != nil {
+		return
+	}
+	prevLock := *lock
+	for _, mergedLockID := range mergedLockIDs {
+		// merged locks were deleted, and are settled as recorded
+		if lockRewards, found := h.k.getLockRewards(ctx, mergedLockID); found {
+			prevLock.Coins = prevLock.Coins.Sub(lockRewards.Coins)
+		}
+		h.k.settleChangedLock(ctx, lockuptypes.PeriodLock{ID: mergedLockID, Owner: lock.Owner})
+	}
+	h.k.settleChangedLock(ctx, prevLock)
+}
@@ -69,3 +69,20 @@ func FilterLocksByMinDuration(locks []lockuptypes.PeriodLock, minDuration time.D
 	}
 	return filteredLocks
 }
+
+// FilterLocksByEndTime returns the locks that end after timestamp, counting
+// locks that have not begun unlocking as if they began at blockTime, as
+// lockup's GetLocksPastTimeDenom does.
+func FilterLocksByEndTime(locks []lockuptypes.PeriodLock, blockTime, timestamp time.Time) []lockuptypes.PeriodLock {
+	filteredLocks := make([]lockuptypes.PeriodLock, 0, len(locks))
+	for _, lock := range locks {
+		if lock.IsUnlocking() {
+			if lock.EndTime.After(timestamp) {
+				filteredLocks = append(filteredLocks, lock)
+			}
+		} else if !blockTime.Add(lock.Duration).Before(timestamp) {
+			filteredLocks = append(filteredLocks, lock)
+		}
+	}
+	return filteredLocks
+}
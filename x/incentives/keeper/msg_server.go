@@ -30,7 +30,7 @@ func (server msgServer) CreateGauge(goCtx context.Context, msg *types.MsgCreateG
 		return nil, err
 	}
 
-	gaugeID, err := server.keeper.CreateGauge(ctx, msg.IsPerpetual, owner, msg.Coins, msg.DistributeTo, msg.StartTime, msg.NumEpochsPaidOver)
+	gaugeID, err := server.keeper.CreateExternalGauge(ctx, msg.IsPerpetual, owner, msg.Coins, msg.DistributeTo, msg.StartTime, msg.NumEpochsPaidOver)
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
@@ -65,3 +65,56 @@ func (server msgServer) AddToGauge(goCtx context.Context, msg *types.MsgAddToGau
 
 	return &types.MsgAddToGaugeResponse{}, nil
 }
+
+func (server msgServer) ClaimRewards(goCtx context.Context, msg *types.MsgClaimRewards) (*types.MsgClaimRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	rewards, err := server.keeper.ClaimRewards(ctx, owner, msg.LockIds)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtClaimRewards,
+			sdk.NewAttribute(types.AttributeReceiver, msg.Owner),
+			sdk.NewAttribute(types.AttributeAmount, rewards.String()),
+		),
+	})
+
+	return &types.MsgClaimRewardsResponse{Rewards: rewards}, nil
+}
+
+func (server msgServer) CancelGauge(goCtx context.Context, msg *types.MsgCancelGauge) (*types.MsgCancelGaugeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	refunds, err := server.keeper.CancelGauge(ctx, owner, msg.GaugeId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	reclaimed := sdk.Coins{}
+	for _, refund := range refunds {
+		if refund.Address == msg.Owner {
+			reclaimed = refund.Coins
+		}
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.TypeEvtCancelGauge,
+				sdk.NewAttribute(types.AttributeGaugeID, utils.Uint64ToString(msg.GaugeId)),
+				sdk.NewAttribute(types.AttributeReceiver, refund.Address),
+				sdk.NewAttribute(types.AttributeAmount, refund.Coins.String()),
+			),
+		})
+	}
+
+	return &types.MsgCancelGaugeResponse{Reclaimed: reclaimed}, nil
+}
@@ -0,0 +1,321 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/utils"
+	"github.com/osmosis-labs/osmosis/v7/x/incentives/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v7/x/lockup/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// rewardIndexStoreKey returns the store key of the reward index of a denom and duration.
+func rewardIndexStoreKey(denom string, duration time.Duration) []byte {
+	return combineKeys(types.KeyPrefixRewardIndexes, []byte(denom), sdk.Uint64ToBigEndian(uint64(duration)))
+}
+
+// lockRewardsStoreKey returns the store key of the rewards settlement of a lock.
+func lockRewardsStoreKey(lockID uint64) []byte {
+	return combineKeys(types.KeyPrefixLockRewards, sdk.Uint64ToBigEndian(lockID))
+}
+
+// SetRewardIndex stores the reward index of a denom and duration.
+func (k Keeper) SetRewardIndex(ctx sdk.Context, index types.RewardIndex) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := proto.Marshal(&index)
+	if err != nil {
+		panic(err)
+	}
+	store.Set(rewardIndexStoreKey(index.Denom, index.Duration), bz)
+}
+
+// GetRewardIndex returns the reward index of a denom and duration, which is
+// empty if no gauge has accrued rewards to it yet.
+func (k Keeper) GetRewardIndex(ctx sdk.Context, denom string, duration time.Duration) types.RewardIndex {
+	index := types.RewardIndex{Denom: denom, Duration: duration, Index: sdk.DecCoins{}}
+	bz := ctx.KVStore(k.storeKey).Get(rewardIndexStoreKey(denom, duration))
+	if bz == nil {
+		return index
+	}
+	if err := proto.Unmarshal(bz, &index); err != nil {
+		panic(err)
+	}
+	return index
+}
+
+// getRewardIndexesFromPrefix returns the reward indexes under a store prefix, by denom and duration.
+func (k Keeper) getRewardIndexesFromPrefix(ctx sdk.Context, prefix []byte) []types.RewardIndex {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+
+	indexes := []types.RewardIndex{}
+	for ; iterator.Valid(); iterator.Next() {
+		index := types.RewardIndex{}
+		if err := proto.Unmarshal(iterator.Value(), &index); err != nil {
+			panic(err)
+		}
+		indexes = append(indexes, index)
+	}
+	return indexes
+}
+
+// GetRewardIndexes returns the reward indexes of a denom, by duration.
+func (k Keeper) GetRewardIndexes(ctx sdk.Context, denom string) []types.RewardIndex {
+	return k.getRewardIndexesFromPrefix(ctx, combineKeys(types.KeyPrefixRewardIndexes, []byte(denom), []byte{}))
+}
+
+// GetAllRewardIndexes returns every reward index.
+func (k Keeper) GetAllRewardIndexes(ctx sdk.Context) []types.RewardIndex {
+	return k.getRewardIndexesFromPrefix(ctx, types.KeyPrefixRewardIndexes)
+}
+
+// SetLockRewards stores the rewards settlement of a lock.
+func (k Keeper) SetLockRewards(ctx sdk.Context, lockRewards types.LockRewards) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := proto.Marshal(&lockRewards)
+	if err != nil {
+		panic(err)
+	}
+	store.Set(lockRewardsStoreKey(lockRewards.LockId), bz)
+}
+
+func (k Keeper) getLockRewards(ctx sdk.Context, lockID uint64) (types.LockRewards, bool) {
+	lockRewards := types.LockRewards{}
+	bz := ctx.KVStore(k.storeKey).Get(lockRewardsStoreKey(lockID))
+	if bz == nil {
+		return lockRewards, false
+	}
+	if err := proto.Unmarshal(bz, &lockRewards); err != nil {
+		panic(err)
+	}
+	return lockRewards, true
+}
+
+func (k Keeper) deleteLockRewards(ctx sdk.Context, lockID uint64) {
+	ctx.KVStore(k.storeKey).Delete(lockRewardsStoreKey(lockID))
+}
+
+// GetAllLockRewards returns the rewards settlement of every lock, by lock ID.
+func (k Keeper) GetAllLockRewards(ctx sdk.Context) []types.LockRewards {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixLockRewards)
+	defer iterator.Close()
+
+	allLockRewards := []types.LockRewards{}
+	for ; iterator.Valid(); iterator.Next() {
+		lockRewards := types.LockRewards{}
+		if err := proto.Unmarshal(iterator.Value(), &lockRewards); err != nil {
+			panic(err)
+		}
+		allLockRewards = append(allLockRewards, lockRewards)
+	}
+	return allLockRewards
+}
+
+// newLockRewards returns the rewards settlement of a lock paid up to the
+// current reward indexes of its denoms and durations.
+func (k Keeper) newLockRewards(ctx sdk.Context, lock lockuptypes.PeriodLock) types.LockRewards {
+	indexes := []types.RewardIndex{}
+	for _, coin := range lock.Coins {
+		for _, index := range k.GetRewardIndexes(ctx, coin.Denom) {
+			if index.Duration > lock.Duration {
+				break
+			}
+			indexes = append(indexes, index)
+		}
+	}
+	return types.LockRewards{
+		LockId:   lock.ID,
+		Coins:    lock.Coins,
+		Duration: lock.Duration,
+		Indexes:  indexes,
+	}
+}
+
+// InitializeLockRewards records a lock as paid up to the current reward
+// indexes, so that it only accrues rewards from now on.
+func (k Keeper) InitializeLockRewards(ctx sdk.Context, lockID uint64) error {
+	lock, err := k.lk.GetLockByID(ctx, lockID)
+	if err != nil {
+		return err
+	}
+	k.SetLockRewards(ctx, k.newLockRewards(ctx, *lock))
+	return nil
+}
+
+// InitializeLockRewardsForExistingLocks records every existing lock that has
+// no rewards settlement yet as paid up to the current reward indexes.
+func (k Keeper) InitializeLockRewardsForExistingLocks(ctx sdk.Context) error {
+	locks, err := k.lk.GetPeriodLocks(ctx)
+	if err != nil {
+		return err
+	}
+	for _, lock := range locks {
+		if _, found := k.getLockRewards(ctx, lock.ID); found {
+			continue
+		}
+		k.SetLockRewards(ctx, k.newLockRewards(ctx, lock))
+	}
+	return nil
+}
+
+// accruedRewards returns the rewards a lock accrued since its rewards were last settled.
+func (k Keeper) accruedRewards(ctx sdk.Context, lockRewards types.LockRewards) sdk.Coins {
+	paidIndexes := make(map[string]sdk.DecCoins, len(lockRewards.Indexes))
+	for _, index := range lockRewards.Indexes {
+		paidIndexes[string(rewardIndexStoreKey(index.Denom, index.Duration))] = index.Index
+	}
+
+	accrued := sdk.DecCoins{}
+	for _, coin := range lockRewards.Coins {
+		for _, index := range k.GetRewardIndexes(ctx, coin.Denom) {
+			if index.Duration > lockRewards.Duration {
+				break
+			}
+			// the lock accrued its amount times how much the index grew since it was paid
+			growth := index.Index.Sub(paidIndexes[string(rewardIndexStoreKey(index.Denom, index.Duration))])
+			accrued = accrued.Add(growth.MulDecTruncate(coin.Amount.ToDec())...)
+		}
+	}
+	rewards, _ := accrued.TruncateDecimal()
+	return rewards
+}
+
+// settleLockRewards adds the rewards a lock accrued since they were last
+// settled to distrInfo, and records the lock as it is now, or forgets it if it
+// was deleted. prevLock is the lock as it was before its last change. A lock
+// with no settlement recorded yet is settled from the start of the reward
+// indexes with it.
+func (k Keeper) settleLockRewards(ctx sdk.Context, distrInfo *distributionInfo, prevLock lockuptypes.PeriodLock) (sdk.Coins, error) {
+	lockRewards, found := k.getLockRewards(ctx, prevLock.ID)
+	if !found {
+		lockRewards = types.LockRewards{LockId: prevLock.ID, Coins: prevLock.Coins, Duration: prevLock.Duration}
+	}
+	rewards := k.accruedRewards(ctx, lockRewards)
+
+	lock, err := k.lk.GetLockByID(ctx, prevLock.ID)
+	if err != nil {
+		// the lock was deleted, so its last owner is paid
+		k.deleteLockRewards(ctx, prevLock.ID)
+		if rewards.Empty() {
+			return rewards, nil
+		}
+		return rewards, distrInfo.addLockRewards(prevLock.Owner, rewards)
+	}
+
+	if !rewards.Empty() {
+		// rewards accrued before a transfer go to the previous owner
+		payLock := *lock
+		payLock.Owner = prevLock.Owner
+		if err := distrInfo.addLockRewardsOrReceipt(payLock, rewards); err != nil {
+			return nil, err
+		}
+	}
+	k.SetLockRewards(ctx, k.newLockRewards(ctx, *lock))
+	return rewards, nil
+}
+
+// settleChangedLock settles and sends the accrued rewards of a lock that
+// changed, given the lock as it was before the change. Rewards of
+// auto-compounding locks are not compounded, since the lock is being changed.
+func (k Keeper) settleChangedLock(ctx sdk.Context, prevLock lockuptypes.PeriodLock) {
+	cacheCtx, write := ctx.CacheContext()
+	distrInfo := newDistributionInfo()
+	_, err := k.settleLockRewards(cacheCtx, &distrInfo, prevLock)
+	if err == nil {
+		err = k.doDistributionSends(cacheCtx, &distrInfo)
+	}
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("rewards of lock %d not settled: %s", prevLock.ID, err))
+		return
+	}
+	write()
+}
+
+// AccrueRewards adds what each gauge distributes this epoch to the reward
+// index of its denom and duration, instead of sending it to the locks. The
+// coins stay in the module account until the lock owners claim them, or
+// their locks change. Like Distribute, gauges with no coins locked for them
+// skip the epoch.
+func (k Keeper) AccrueRewards(ctx sdk.Context, gauges []types.Gauge) error {
+	for _, gauge := range gauges {
+		lockSum := k.lk.GetPeriodLocksAccumulation(ctx, gauge.DistributeTo)
+		if lockSum.IsZero() {
+			continue
+		}
+
+		remainCoins := gauge.Coins.Sub(gauge.DistributedCoins)
+		remainEpochs := uint64(1)
+		if !gauge.IsPerpetual { // set remain epochs when it's not perpetual gauge
+			remainEpochs = gauge.NumEpochsPaidOver - gauge.FilledEpochs
+		}
+
+		epochCoins := sdk.Coins{}
+		for _, coin := range remainCoins {
+			// distribution amount per epoch = gauge_size / (remain_epochs)
+			amt := coin.Amount.QuoRaw(int64(remainEpochs))
+			if amt.IsPositive() {
+				epochCoins = epochCoins.Add(sdk.NewCoin(coin.Denom, amt))
+			}
+		}
+
+		index := k.GetRewardIndex(ctx, gauge.DistributeTo.Denom, gauge.DistributeTo.Duration)
+		index.Index = index.Index.Add(sdk.NewDecCoinsFromCoins(epochCoins...).QuoDecTruncate(lockSum.ToDec())...)
+		k.SetRewardIndex(ctx, index)
+
+		if err := k.updateGaugePostDistribute(ctx, gauge, epochCoins); err != nil {
+			return err
+		}
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.TypeEvtAccrueRewards,
+				sdk.NewAttribute(types.AttributeGaugeID, utils.Uint64ToString(gauge.Id)),
+				sdk.NewAttribute(types.AttributeAmount, epochCoins.String()),
+			),
+		})
+	}
+
+	k.checkFinishDistribution(ctx, gauges)
+	return nil
+}
+
+// ClaimRewards pays the owner of locks the rewards they accrued, or all of the
+// owner's locks if lockIDs is empty. Rewards of tokenized locks are held for
+// their receipt holder, and rewards of auto-compounding locks are compounded.
+func (k Keeper) ClaimRewards(ctx sdk.Context, owner sdk.AccAddress, lockIDs []uint64) (sdk.Coins, error) {
+	locks := []lockuptypes.PeriodLock{}
+	if len(lockIDs) == 0 {
+		locks = k.lk.GetAccountPeriodLocks(ctx, owner)
+	}
+	for _, lockID := range lockIDs {
+		lock, err := k.lk.GetLockByID(ctx, lockID)
+		if err != nil {
+			return nil, err
+		}
+		if lock.Owner != owner.String() {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "lock %d is not owned by %s", lock.ID, owner)
+		}
+		locks = append(locks, *lock)
+	}
+
+	distrInfo := newDistributionInfo()
+	totalRewards := sdk.Coins{}
+	for _, lock := range locks {
+		rewards, err := k.settleLockRewards(ctx, &distrInfo, lock)
+		if err != nil {
+			return nil, err
+		}
+		totalRewards = totalRewards.Add(rewards...)
+	}
+
+	err := k.doDistributionSends(ctx, &distrInfo)
+	if err != nil {
+		return nil, err
+	}
+	k.doAutoCompounds(ctx, &distrInfo)
+	return totalRewards, nil
+}
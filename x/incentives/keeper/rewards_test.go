@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	"github.com/osmosis-labs/osmosis/v7/x/incentives/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// setupAccrueRewards enables reward accrual, and creates locks and a gauge over
+// two epochs for them, which pays 500 of its reward denom each epoch.
+func (suite *KeeperTestSuite) setupAccrueRewards() ([]sdk.AccAddress, types.Gauge) {
+	suite.SetupTest()
+	params := suite.app.IncentivesKeeper.GetParams(suite.ctx)
+	params.AccrueRewards = true
+	suite.app.IncentivesKeeper.SetParams(suite.ctx, params)
+	return suite.setupIncrementalDistribution()
+}
+
+func (suite *KeeperTestSuite) TestAccrueAndClaimRewards() {
+	addrs, gauge := suite.setupAccrueRewards()
+	params := suite.app.IncentivesKeeper.GetParams(suite.ctx)
+
+	// the epoch only accrues the rewards
+	suite.app.IncentivesKeeper.AfterEpochEnd(suite.ctx, params.DistrEpochIdentifier, 1)
+	for _, addr := range addrs {
+		suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, addr, defaultRewardDenom).IsZero())
+	}
+	accruedGauge, err := suite.app.IncentivesKeeper.GetGaugeByID(suite.ctx, gauge.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), accruedGauge.FilledEpochs)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 500), sdk.NewInt64Coin("stake", 166)}, accruedGauge.DistributedCoins)
+
+	// each lock is paid its share of the epoch when claiming, and only once
+	lockSum := int64(7 + 11 + 13 + 17 + 19)
+	for i, addr := range addrs {
+		locked := suite.app.LockupKeeper.GetAccountPeriodLocks(suite.ctx, addr)[0].Coins.AmountOf(defaultLPDenom).Int64()
+		expected := sdk.NewCoins(sdk.NewInt64Coin(defaultRewardDenom, locked*500/lockSum), sdk.NewInt64Coin("stake", locked*166/lockSum))
+
+		rewards, err := suite.app.IncentivesKeeper.ClaimRewards(suite.ctx, addr, nil)
+		suite.Require().NoError(err, "lock %d", i)
+		suite.Require().Equal(expected, rewards)
+		suite.Require().Equal(expected, suite.app.BankKeeper.GetAllBalances(suite.ctx, addr))
+
+		rewards, err = suite.app.IncentivesKeeper.ClaimRewards(suite.ctx, addr, nil)
+		suite.Require().NoError(err)
+		suite.Require().Empty(rewards)
+	}
+
+	// locks of someone else cannot be claimed
+	lockID := suite.app.LockupKeeper.GetAccountPeriodLocks(suite.ctx, addrs[0])[0].ID
+	_, err = suite.app.IncentivesKeeper.ClaimRewards(suite.ctx, addrs[1], []uint64{lockID})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestRewardsSettleOnLockChanges() {
+	addrs, _ := suite.setupAccrueRewards()
+	params := suite.app.IncentivesKeeper.GetParams(suite.ctx)
+	suite.app.IncentivesKeeper.AfterEpochEnd(suite.ctx, params.DistrEpochIdentifier, 1)
+
+	// adding to a lock pays what it accrued with its previous amount
+	lock := suite.app.LockupKeeper.GetAccountPeriodLocks(suite.ctx, addrs[0])[0]
+	addCoins := sdk.Coins{sdk.NewInt64Coin(defaultLPDenom, 10)}
+	err := simapp.FundAccount(suite.app.BankKeeper, suite.ctx, addrs[0], addCoins)
+	suite.Require().NoError(err)
+	_, err = suite.app.LockupKeeper.AddTokensToLockByID(suite.ctx, lock.ID, addCoins)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(7*500/67), suite.app.BankKeeper.GetBalance(suite.ctx, addrs[0], defaultRewardDenom).Amount)
+
+	// splitting a lock pays the original lock, and the new lock only accrues from now on
+	lock = suite.app.LockupKeeper.GetAccountPeriodLocks(suite.ctx, addrs[1])[0]
+	splitLock, err := suite.app.LockupKeeper.SplitLock(suite.ctx, lock.ID, addrs[1], sdk.Coins{sdk.NewInt64Coin(defaultLPDenom, 5)})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(11*500/67), suite.app.BankKeeper.GetBalance(suite.ctx, addrs[1], defaultRewardDenom).Amount)
+	rewards, err := suite.app.IncentivesKeeper.ClaimRewards(suite.ctx, addrs[1], []uint64{lock.ID, splitLock.ID})
+	suite.Require().NoError(err)
+	suite.Require().Empty(rewards)
+
+	// transferring a lock pays the previous owner, and the new owner only accrues from now on
+	lock = suite.app.LockupKeeper.GetAccountPeriodLocks(suite.ctx, addrs[3])[0]
+	err = suite.app.LockupKeeper.TransferLock(suite.ctx, lock.ID, addrs[3], addrs[4])
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(17*500/67), suite.app.BankKeeper.GetBalance(suite.ctx, addrs[3], defaultRewardDenom).Amount)
+	rewards, err = suite.app.IncentivesKeeper.ClaimRewards(suite.ctx, addrs[4], []uint64{lock.ID})
+	suite.Require().NoError(err)
+	suite.Require().Empty(rewards)
+
+	// the second epoch pays out the rest of the gauge pro-rata over the new amounts,
+	// which unlocking a lock pays as well
+	suite.app.IncentivesKeeper.AfterEpochEnd(suite.ctx, params.DistrEpochIdentifier, 2)
+	lock = suite.app.LockupKeeper.GetAccountPeriodLocks(suite.ctx, addrs[2])[0]
+	err = suite.app.LockupKeeper.ForceUnlock(suite.ctx, lock)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(13*500/67+13*500/77), suite.app.BankKeeper.GetBalance(suite.ctx, addrs[2], defaultRewardDenom).Amount)
+	found := false
+	for _, lockRewards := range suite.app.IncentivesKeeper.GetAllLockRewards(suite.ctx) {
+		if lockRewards.LockId == lock.ID {
+			found = true
+		}
+	}
+	suite.Require().False(found)
+
+	rewards, err = suite.app.IncentivesKeeper.ClaimRewards(suite.ctx, addrs[1], nil)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(6*500/77+5*500/77), rewards.AmountOf(defaultRewardDenom))
+	finishedGauges := suite.app.IncentivesKeeper.GetFinishedGauges(suite.ctx)
+	suite.Require().Len(finishedGauges, 1)
+}
@@ -27,10 +27,10 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { distrEpochIdentifier = GenParamsDistrEpochIdentifier(r) },
 	)
 
+	params := types.DefaultParams()
+	params.DistrEpochIdentifier = distrEpochIdentifier
 	incentivesGenesis := types.GenesisState{
-		Params: types.Params{
-			DistrEpochIdentifier: distrEpochIdentifier,
-		},
+		Params: params,
 		// Gauges: gauges,
 		LockableDurations: []time.Duration{
 			time.Second,
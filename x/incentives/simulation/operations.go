@@ -74,18 +74,17 @@ func genRewardCoins(r *rand.Rand, coins sdk.Coins) (res sdk.Coins) {
 	return
 }
 
-func genQueryCondition(r *rand.Rand, blocktime time.Time, coins sdk.Coins, durations []time.Duration) lockuptypes.QueryCondition {
-	// TODO: reset to 2 after postlaunch, only allow duration based query type on postlaunch
-	// lockQueryType := r.Intn(2)
-	lockQueryType := 0
+func genQueryCondition(r *rand.Rand, startTime time.Time, coins sdk.Coins, durations []time.Duration) lockuptypes.QueryCondition {
+	lockQueryType := r.Intn(2)
 	denom := coins[r.Intn(len(coins))].Denom
 	// TODO: for postlaunch, only specific lock durations are allowed
 	// durationSecs := r.Intn(1*60*60*24*7) + 1*60*60 // range of 1 week, min 1 hour
 	// duration := time.Duration(durationSecs) * time.Second
 	durationIndex := r.Intn(len(durations))
 	duration := durations[durationIndex]
+	// locks by time have to end after the gauge starts
 	timestampSecs := r.Intn(1 * 60 * 60 * 24 * 7) // range of 1 week
-	timestamp := blocktime.Add(time.Duration(timestampSecs) * time.Second)
+	timestamp := startTime.Add(time.Duration(timestampSecs) * time.Second)
 
 	return lockuptypes.QueryCondition{
 		LockQueryType: lockuptypes.LockQueryType(lockQueryType),
@@ -122,10 +121,10 @@ func SimulateMsgCreateGauge(ak stakingTypes.AccountKeeper, bk stakingTypes.BankK
 		}
 
 		isPerpetual := r.Int()%2 == 0
-		distributeTo := genQueryCondition(r, ctx.BlockTime(), simCoins, types.DefaultGenesis().LockableDurations)
 		rewards := genRewardCoins(r, simCoins)
 		startTimeSecs := r.Intn(1 * 60 * 60 * 24 * 7) // range of 1 week
 		startTime := ctx.BlockTime().Add(time.Duration(startTimeSecs) * time.Second)
+		distributeTo := genQueryCondition(r, startTime, simCoins, types.DefaultGenesis().LockableDurations)
 		durationSecs := r.Intn(1*60*60*24*7) + 1*60*60*24 // range of 1 week, min 1 day
 		numEpochsPaidOver := uint64(r.Int63n(int64(durationSecs)/(ek.GetEpochInfo(ctx, k.GetParams(ctx).DistrEpochIdentifier).Duration.Milliseconds()/1000))) + 1
 
@@ -2,12 +2,19 @@ package types
 
 // event types.
 const (
-	TypeEvtCreateGauge  = "create_gauge"
-	TypeEvtAddToGauge   = "add_to_gauge"
-	TypeEvtDistribution = "distribution"
+	TypeEvtCreateGauge         = "create_gauge"
+	TypeEvtAddToGauge          = "add_to_gauge"
+	TypeEvtDistribution        = "distribution"
+	TypeEvtAutoCompound        = "auto_compound"
+	TypeEvtReceiptDistribution = "receipt_distribution"
+	TypeEvtAccrueRewards       = "accrue_rewards"
+	TypeEvtClaimRewards        = "claim_rewards"
+	TypeEvtCancelGauge         = "cancel_gauge"
 
 	AttributeGaugeID     = "gauge_id"
 	AttributeLockedDenom = "denom"
 	AttributeReceiver    = "receiver"
 	AttributeAmount      = "amount"
+	AttributeLockID      = "lock_id"
+	AttributeShares      = "shares"
 )
@@ -51,6 +51,12 @@ type Gauge struct {
 	FilledEpochs uint64 `protobuf:"varint,7,opt,name=filled_epochs,json=filledEpochs,proto3" json:"filled_epochs,omitempty"`
 	// already distributed coins
 	DistributedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=distributed_coins,json=distributedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"distributed_coins"`
+	// account that created the gauge with MsgCreateGauge, which can cancel it
+	// and reclaim its undistributed coins. Empty for gauges created by modules.
+	Owner string `protobuf:"bytes,9,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	// coins each account added to the gauge, recorded for gauges with an owner
+	// so that cancelling the gauge refunds every funder pro rata
+	Funders []GaugeFunder `protobuf:"bytes,10,rep,name=funders,proto3" json:"funders"`
 }
 
 func (m *Gauge) Reset()         { *m = Gauge{} }
@@ -142,6 +148,368 @@ func (m *Gauge) GetDistributedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *Gauge) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Gauge) GetFunders() []GaugeFunder {
+	if m != nil {
+		return m.Funders
+	}
+	return nil
+}
+
+// GaugeFunder is an account that added coins to a gauge, with the coins it
+// added in total.
+type GaugeFunder struct {
+	Address string                                   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Coins   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+}
+
+func (m *GaugeFunder) Reset()         { *m = GaugeFunder{} }
+func (m *GaugeFunder) String() string { return proto.CompactTextString(m) }
+func (*GaugeFunder) ProtoMessage()    {}
+func (*GaugeFunder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0304e2bb0159901, []int{1}
+}
+func (m *GaugeFunder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GaugeFunder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GaugeFunder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GaugeFunder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GaugeFunder.Merge(m, src)
+}
+func (m *GaugeFunder) XXX_Size() int {
+	return m.Size()
+}
+func (m *GaugeFunder) XXX_DiscardUnknown() {
+	xxx_messageInfo_GaugeFunder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GaugeFunder proto.InternalMessageInfo
+
+func (m *GaugeFunder) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GaugeFunder) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
+// GaugeDistribution is what a gauge owes the locks that qualified for it at
+// the last distribution epoch, paid out over the following blocks when
+// incremental distribution is enabled. The store keeps the locks apart, one
+// entry per lock, so that each block only reads the locks it pays.
+type GaugeDistribution struct {
+	GaugeId uint64 `protobuf:"varint,1,opt,name=gauge_id,json=gaugeId,proto3" json:"gauge_id,omitempty"`
+	// coins the gauge had left to distribute at the epoch
+	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	// epochs the gauge had left to distribute over at the epoch
+	RemainEpochs uint64 `protobuf:"varint,3,opt,name=remain_epochs,json=remainEpochs,proto3" json:"remain_epochs,omitempty"`
+	// sum of the locked amounts of the qualifying locks
+	LockSum github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=lock_sum,json=lockSum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"lock_sum"`
+	// qualifying locks not paid yet, in distribution order, from
+	// next_lock_index on. Only set in genesis.
+	Locks []DistributionLock `protobuf:"bytes,5,rep,name=locks,proto3" json:"locks"`
+	// index of the next lock to pay
+	NextLockIndex uint64 `protobuf:"varint,6,opt,name=next_lock_index,json=nextLockIndex,proto3" json:"next_lock_index,omitempty"`
+	// coins paid to the locks so far
+	DistributedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=distributed_coins,json=distributedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"distributed_coins"`
+	// number of qualifying locks
+	NumLocks uint64 `protobuf:"varint,8,opt,name=num_locks,json=numLocks,proto3" json:"num_locks,omitempty"`
+}
+
+func (m *GaugeDistribution) Reset()         { *m = GaugeDistribution{} }
+func (m *GaugeDistribution) String() string { return proto.CompactTextString(m) }
+func (*GaugeDistribution) ProtoMessage()    {}
+func (*GaugeDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0304e2bb0159901, []int{2}
+}
+func (m *GaugeDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GaugeDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GaugeDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GaugeDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GaugeDistribution.Merge(m, src)
+}
+func (m *GaugeDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *GaugeDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_GaugeDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GaugeDistribution proto.InternalMessageInfo
+
+func (m *GaugeDistribution) GetGaugeId() uint64 {
+	if m != nil {
+		return m.GaugeId
+	}
+	return 0
+}
+
+func (m *GaugeDistribution) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
+func (m *GaugeDistribution) GetRemainEpochs() uint64 {
+	if m != nil {
+		return m.RemainEpochs
+	}
+	return 0
+}
+
+func (m *GaugeDistribution) GetLocks() []DistributionLock {
+	if m != nil {
+		return m.Locks
+	}
+	return nil
+}
+
+func (m *GaugeDistribution) GetNextLockIndex() uint64 {
+	if m != nil {
+		return m.NextLockIndex
+	}
+	return 0
+}
+
+func (m *GaugeDistribution) GetDistributedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DistributedCoins
+	}
+	return nil
+}
+
+func (m *GaugeDistribution) GetNumLocks() uint64 {
+	if m != nil {
+		return m.NumLocks
+	}
+	return 0
+}
+
+// DistributionLock is a lock that qualified for a gauge distribution, as it
+// was at the epoch.
+type DistributionLock struct {
+	LockId uint64 `protobuf:"varint,1,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	Owner  string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// amount of the gauge's denom locked
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *DistributionLock) Reset()         { *m = DistributionLock{} }
+func (m *DistributionLock) String() string { return proto.CompactTextString(m) }
+func (*DistributionLock) ProtoMessage()    {}
+func (*DistributionLock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0304e2bb0159901, []int{3}
+}
+func (m *DistributionLock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DistributionLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DistributionLock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DistributionLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionLock.Merge(m, src)
+}
+func (m *DistributionLock) XXX_Size() int {
+	return m.Size()
+}
+func (m *DistributionLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionLock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributionLock proto.InternalMessageInfo
+
+func (m *DistributionLock) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
+func (m *DistributionLock) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// RewardIndex is the cumulative reward per unit of a denom locked for at least
+// a duration, accrued by the gauges distributing to those locks when rewards
+// are accrued instead of sent.
+type RewardIndex struct {
+	Denom    string                                      `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Duration time.Duration                               `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration" yaml:"duration"`
+	Index    github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=index,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"index"`
+}
+
+func (m *RewardIndex) Reset()         { *m = RewardIndex{} }
+func (m *RewardIndex) String() string { return proto.CompactTextString(m) }
+func (*RewardIndex) ProtoMessage()    {}
+func (*RewardIndex) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0304e2bb0159901, []int{4}
+}
+func (m *RewardIndex) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardIndex.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardIndex) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardIndex.Merge(m, src)
+}
+func (m *RewardIndex) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardIndex) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardIndex.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardIndex proto.InternalMessageInfo
+
+func (m *RewardIndex) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *RewardIndex) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *RewardIndex) GetIndex() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Index
+	}
+	return nil
+}
+
+// LockRewards is a lock as it was when its accrued rewards were last settled,
+// with the reward indexes it has been paid up to.
+type LockRewards struct {
+	LockId   uint64                                   `protobuf:"varint,1,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	Coins    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	Duration time.Duration                            `protobuf:"bytes,3,opt,name=duration,proto3,stdduration" json:"duration" yaml:"duration"`
+	Indexes  []RewardIndex                            `protobuf:"bytes,4,rep,name=indexes,proto3" json:"indexes"`
+}
+
+func (m *LockRewards) Reset()         { *m = LockRewards{} }
+func (m *LockRewards) String() string { return proto.CompactTextString(m) }
+func (*LockRewards) ProtoMessage()    {}
+func (*LockRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0304e2bb0159901, []int{5}
+}
+func (m *LockRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockRewards.Merge(m, src)
+}
+func (m *LockRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockRewards proto.InternalMessageInfo
+
+func (m *LockRewards) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
+func (m *LockRewards) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
+func (m *LockRewards) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *LockRewards) GetIndexes() []RewardIndex {
+	if m != nil {
+		return m.Indexes
+	}
+	return nil
+}
+
 type LockableDurationsInfo struct {
 	LockableDurations []time.Duration `protobuf:"bytes,1,rep,name=lockable_durations,json=lockableDurations,proto3,stdduration" json:"lockable_durations" yaml:"lockable_durations"`
 }
@@ -150,7 +518,7 @@ func (m *LockableDurationsInfo) Reset()         { *m = LockableDurationsInfo{} }
 func (m *LockableDurationsInfo) String() string { return proto.CompactTextString(m) }
 func (*LockableDurationsInfo) ProtoMessage()    {}
 func (*LockableDurationsInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c0304e2bb0159901, []int{1}
+	return fileDescriptor_c0304e2bb0159901, []int{6}
 }
 func (m *LockableDurationsInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -188,48 +556,76 @@ func (m *LockableDurationsInfo) GetLockableDurations() []time.Duration {
 
 func init() {
 	proto.RegisterType((*Gauge)(nil), "osmosis.incentives.Gauge")
+	proto.RegisterType((*GaugeFunder)(nil), "osmosis.incentives.GaugeFunder")
+	proto.RegisterType((*GaugeDistribution)(nil), "osmosis.incentives.GaugeDistribution")
+	proto.RegisterType((*DistributionLock)(nil), "osmosis.incentives.DistributionLock")
+	proto.RegisterType((*RewardIndex)(nil), "osmosis.incentives.RewardIndex")
+	proto.RegisterType((*LockRewards)(nil), "osmosis.incentives.LockRewards")
 	proto.RegisterType((*LockableDurationsInfo)(nil), "osmosis.incentives.LockableDurationsInfo")
 }
 
 func init() { proto.RegisterFile("osmosis/incentives/gauge.proto", fileDescriptor_c0304e2bb0159901) }
 
 var fileDescriptor_c0304e2bb0159901 = []byte{
-	// 545 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xcf, 0x6a, 0xdb, 0x30,
-	0x1c, 0xc7, 0xe3, 0x34, 0xe9, 0x3a, 0x25, 0x1d, 0x8b, 0xe8, 0xc0, 0x09, 0xcc, 0xc9, 0x32, 0x06,
-	0xb9, 0x54, 0x5a, 0x3b, 0xc6, 0x60, 0xc7, 0x74, 0x63, 0x14, 0x06, 0xed, 0x42, 0x0f, 0x63, 0x17,
-	0x23, 0xdb, 0x8a, 0x2b, 0x62, 0xfb, 0x67, 0x2c, 0x39, 0x34, 0x6f, 0xb0, 0x63, 0xd9, 0x69, 0xcf,
-	0xb0, 0x27, 0xe9, 0xb1, 0xc7, 0x9d, 0xda, 0x91, 0xbc, 0xc1, 0x9e, 0x60, 0x58, 0xb2, 0x49, 0xc8,
-	0xae, 0x3d, 0xc9, 0xfa, 0x7d, 0x7f, 0xff, 0xbe, 0x1f, 0x64, 0xe4, 0x80, 0x8c, 0x41, 0x0a, 0x49,
-	0x45, 0xe2, 0xf3, 0x44, 0x89, 0x39, 0x97, 0x34, 0x64, 0x79, 0xc8, 0x49, 0x9a, 0x81, 0x02, 0x8c,
-	0x4b, 0x9d, 0xac, 0xf5, 0xde, 0x41, 0x08, 0x21, 0x68, 0x99, 0x16, 0x5f, 0x26, 0xb3, 0xe7, 0x84,
-	0x00, 0x61, 0xc4, 0xa9, 0xbe, 0x79, 0xf9, 0x94, 0x06, 0x79, 0xc6, 0x94, 0x80, 0xa4, 0xd4, 0xfb,
-	0xdb, 0xba, 0x12, 0x31, 0x97, 0x8a, 0xc5, 0x69, 0xd5, 0xc0, 0xd7, 0xb3, 0xa8, 0xc7, 0x24, 0xa7,
-	0xf3, 0x23, 0x8f, 0x2b, 0x76, 0x44, 0x7d, 0x10, 0x55, 0x83, 0x6e, 0xb5, 0x6a, 0x04, 0xfe, 0x2c,
-	0x4f, 0xf5, 0x61, 0xa4, 0xe1, 0x8f, 0x06, 0x6a, 0x7e, 0x2a, 0xb6, 0xc6, 0x4f, 0x50, 0x5d, 0x04,
-	0xb6, 0x35, 0xb0, 0x46, 0x8d, 0x49, 0x5d, 0x04, 0xf8, 0x05, 0x6a, 0x0b, 0xe9, 0xa6, 0x3c, 0x4b,
-	0xb9, 0xca, 0x59, 0x64, 0xd7, 0x07, 0xd6, 0x68, 0x6f, 0xd2, 0x12, 0xf2, 0xbc, 0x0a, 0xe1, 0x53,
-	0xb4, 0x1f, 0x08, 0xa9, 0x32, 0xe1, 0xe5, 0x8a, 0xbb, 0x0a, 0xec, 0x9d, 0x81, 0x35, 0x6a, 0x1d,
-	0x3b, 0xa4, 0xb2, 0x6e, 0xe6, 0x91, 0x2f, 0x39, 0xcf, 0x16, 0x27, 0x90, 0x04, 0xa2, 0x70, 0x35,
-	0x6e, 0xdc, 0xdc, 0xf5, 0x6b, 0x93, 0xf6, 0xba, 0xf4, 0x02, 0x30, 0x43, 0xcd, 0x62, 0x61, 0x69,
-	0x37, 0x06, 0x3b, 0xa3, 0xd6, 0x71, 0x97, 0x18, 0x4b, 0xa4, 0xb0, 0x44, 0x4a, 0x4b, 0xe4, 0x04,
-	0x44, 0x32, 0x7e, 0x5d, 0x54, 0xff, 0xba, 0xef, 0x8f, 0x42, 0xa1, 0x2e, 0x73, 0x8f, 0xf8, 0x10,
-	0xd3, 0xd2, 0xbf, 0x39, 0x0e, 0x65, 0x30, 0xa3, 0x6a, 0x91, 0x72, 0xa9, 0x0b, 0xe4, 0xc4, 0x74,
-	0xc6, 0x5f, 0x11, 0x92, 0x8a, 0x65, 0xca, 0x2d, 0xf0, 0xd9, 0x4d, 0xbd, 0x6a, 0x8f, 0x18, 0xb6,
-	0xa4, 0x62, 0x4b, 0x2e, 0x2a, 0xb6, 0xe3, 0xe7, 0xc5, 0xa0, 0xbf, 0x77, 0xfd, 0xce, 0x82, 0xc5,
-	0xd1, 0xfb, 0xe1, 0xba, 0x76, 0x78, 0x7d, 0xdf, 0xb7, 0x26, 0x8f, 0x75, 0xa0, 0x48, 0xc7, 0x14,
-	0x1d, 0x24, 0x79, 0xec, 0xf2, 0x14, 0xfc, 0x4b, 0xe9, 0xa6, 0x4c, 0x04, 0x2e, 0xcc, 0x79, 0x66,
-	0xef, 0x6a, 0x98, 0x9d, 0x24, 0x8f, 0x3f, 0x6a, 0xe9, 0x9c, 0x89, 0xe0, 0x6c, 0xce, 0x33, 0xfc,
-	0x12, 0xed, 0x4f, 0x45, 0x14, 0xf1, 0xa0, 0xac, 0xb1, 0x1f, 0xe9, 0xcc, 0xb6, 0x09, 0x9a, 0x64,
-	0x7c, 0x85, 0x3a, 0x6b, 0x44, 0x81, 0x6b, 0xf0, 0xec, 0x3d, 0x3c, 0x9e, 0xa7, 0x1b, 0x53, 0x74,
-	0x64, 0xf8, 0xdd, 0x42, 0xcf, 0x3e, 0x83, 0x3f, 0x63, 0x5e, 0xc4, 0x3f, 0x94, 0x6f, 0x51, 0x9e,
-	0x26, 0x53, 0xc0, 0x80, 0x70, 0x54, 0x0a, 0x6e, 0xf5, 0x4a, 0xa5, 0x6d, 0x95, 0x4b, 0x6d, 0xb3,
-	0xac, 0x6a, 0xc7, 0xaf, 0x4a, 0x94, 0x5d, 0x83, 0xf2, 0xff, 0x16, 0xc3, 0x9f, 0x05, 0xd2, 0x4e,
-	0xb4, 0x3d, 0x74, 0x7c, 0x76, 0xb3, 0x74, 0xac, 0xdb, 0xa5, 0x63, 0xfd, 0x59, 0x3a, 0xd6, 0xf5,
-	0xca, 0xa9, 0xdd, 0xae, 0x9c, 0xda, 0xef, 0x95, 0x53, 0xfb, 0xf6, 0x76, 0xc3, 0x60, 0xf9, 0xde,
-	0x0e, 0x23, 0xe6, 0xc9, 0xea, 0x42, 0xe7, 0xef, 0xe8, 0xd5, 0xe6, 0xcf, 0xa9, 0x3d, 0x7b, 0xbb,
-	0x7a, 0xbb, 0x37, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xc5, 0xeb, 0x47, 0xca, 0xbf, 0x03, 0x00,
-	0x00,
+	// 919 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xfa, 0x4f, 0x6c, 0x3f, 0x27, 0x34, 0x1e, 0x05, 0xb1, 0x49, 0x61, 0x6d, 0x16, 0x88,
+	0x2c, 0xa1, 0xee, 0xd2, 0x56, 0x08, 0x89, 0x4b, 0x91, 0x1b, 0x8a, 0x2c, 0x21, 0xb5, 0x2c, 0x3d,
+	0x20, 0x2e, 0xab, 0xb5, 0x67, 0xe2, 0x8e, 0xb2, 0x3b, 0x63, 0xed, 0xcc, 0xba, 0xc9, 0x95, 0x13,
+	0x70, 0xea, 0x91, 0x13, 0x1f, 0x80, 0x4f, 0xd2, 0x63, 0x8f, 0x88, 0x43, 0x5a, 0x25, 0xdf, 0xa0,
+	0x57, 0x2e, 0x68, 0xfe, 0xd5, 0x56, 0x1a, 0x50, 0x85, 0xd2, 0x9c, 0xec, 0x79, 0x7f, 0xe6, 0xfd,
+	0x7e, 0xbf, 0xf7, 0xde, 0xd8, 0x10, 0x70, 0x51, 0x70, 0x41, 0x45, 0x4c, 0xd9, 0x94, 0x30, 0x49,
+	0x17, 0x44, 0xc4, 0xb3, 0xac, 0x9a, 0x91, 0x68, 0x5e, 0x72, 0xc9, 0x11, 0xb2, 0xfe, 0x68, 0xe9,
+	0xdf, 0xdd, 0x9e, 0xf1, 0x19, 0xd7, 0xee, 0x58, 0x7d, 0x33, 0x91, 0xbb, 0xc1, 0x8c, 0xf3, 0x59,
+	0x4e, 0x62, 0x7d, 0x9a, 0x54, 0x07, 0x31, 0xae, 0xca, 0x4c, 0x52, 0xce, 0xac, 0xbf, 0x7f, 0xde,
+	0x2f, 0x69, 0x41, 0x84, 0xcc, 0x8a, 0xb9, 0xbb, 0x60, 0xaa, 0x6b, 0xc5, 0x93, 0x4c, 0x90, 0x78,
+	0x71, 0x73, 0x42, 0x64, 0x76, 0x33, 0x9e, 0x72, 0xea, 0x2e, 0xd8, 0x71, 0x50, 0x73, 0x3e, 0x3d,
+	0xac, 0xe6, 0xfa, 0xc3, 0xb8, 0xc2, 0xbf, 0x1b, 0xd0, 0xfc, 0x46, 0xa1, 0x46, 0xef, 0x40, 0x8d,
+	0x62, 0xdf, 0x1b, 0x78, 0xc3, 0x46, 0x52, 0xa3, 0x18, 0x7d, 0x08, 0x1b, 0x54, 0xa4, 0x73, 0x52,
+	0xce, 0x89, 0xac, 0xb2, 0xdc, 0xaf, 0x0d, 0xbc, 0x61, 0x3b, 0xe9, 0x52, 0xf1, 0xc0, 0x99, 0xd0,
+	0x18, 0x36, 0x31, 0x15, 0xb2, 0xa4, 0x93, 0x4a, 0x92, 0x54, 0x72, 0xbf, 0x3e, 0xf0, 0x86, 0xdd,
+	0x5b, 0x41, 0xe4, 0xa8, 0x9b, 0x7a, 0xd1, 0x77, 0x15, 0x29, 0x8f, 0xef, 0x72, 0x86, 0xa9, 0x62,
+	0x35, 0x6a, 0x3c, 0x3d, 0xe9, 0xaf, 0x25, 0x1b, 0xcb, 0xd4, 0x87, 0x1c, 0x65, 0xd0, 0x54, 0x80,
+	0x85, 0xdf, 0x18, 0xd4, 0x87, 0xdd, 0x5b, 0x3b, 0x91, 0xa1, 0x14, 0x29, 0x4a, 0x91, 0xa5, 0x14,
+	0xdd, 0xe5, 0x94, 0x8d, 0x3e, 0x53, 0xd9, 0x7f, 0x3c, 0xef, 0x0f, 0x67, 0x54, 0x3e, 0xaa, 0x26,
+	0xd1, 0x94, 0x17, 0xb1, 0xe5, 0x6f, 0x3e, 0x6e, 0x08, 0x7c, 0x18, 0xcb, 0xe3, 0x39, 0x11, 0x3a,
+	0x41, 0x24, 0xe6, 0x66, 0xf4, 0x03, 0x80, 0x90, 0x59, 0x29, 0x53, 0x25, 0x9f, 0xdf, 0xd4, 0x50,
+	0x77, 0x23, 0xa3, 0x6d, 0xe4, 0xb4, 0x8d, 0x1e, 0x3a, 0x6d, 0x47, 0x1f, 0xa8, 0x42, 0x2f, 0x4f,
+	0xfa, 0xbd, 0xe3, 0xac, 0xc8, 0xbf, 0x0c, 0x97, 0xb9, 0xe1, 0x93, 0xe7, 0x7d, 0x2f, 0xe9, 0x68,
+	0x83, 0x0a, 0x47, 0x31, 0x6c, 0xb3, 0xaa, 0x48, 0xc9, 0x9c, 0x4f, 0x1f, 0x89, 0x74, 0x9e, 0x51,
+	0x9c, 0xf2, 0x05, 0x29, 0xfd, 0x75, 0x2d, 0x66, 0x8f, 0x55, 0xc5, 0xd7, 0xda, 0xf5, 0x20, 0xa3,
+	0xf8, 0xfe, 0x82, 0x94, 0xe8, 0x23, 0xd8, 0x3c, 0xa0, 0x79, 0x4e, 0xb0, 0xcd, 0xf1, 0x5b, 0x3a,
+	0x72, 0xc3, 0x18, 0x4d, 0x30, 0x3a, 0x82, 0xde, 0x52, 0x22, 0x9c, 0x1a, 0x79, 0xda, 0x97, 0x2f,
+	0xcf, 0xd6, 0x4a, 0x15, 0x6d, 0x41, 0x7b, 0xd0, 0xe4, 0x8f, 0x19, 0x29, 0xfd, 0xce, 0xc0, 0x1b,
+	0x76, 0x46, 0x5b, 0x2f, 0x4f, 0xfa, 0x1b, 0x46, 0x04, 0x6d, 0x0e, 0x13, 0xe3, 0x46, 0x77, 0xa0,
+	0x75, 0x50, 0x31, 0x4c, 0x4a, 0xe1, 0x83, 0xc6, 0xd5, 0x8f, 0x5e, 0x1f, 0xfa, 0x48, 0x8f, 0xd7,
+	0x3d, 0x1d, 0x67, 0x5b, 0xef, 0xb2, 0xc2, 0x5f, 0x3d, 0xe8, 0xae, 0xb8, 0x91, 0x0f, 0xad, 0x0c,
+	0xe3, 0x92, 0x08, 0xa1, 0x07, 0xb1, 0x93, 0xb8, 0xe3, 0x72, 0x3e, 0x6a, 0x6f, 0x6b, 0x3e, 0xc2,
+	0x9f, 0x1a, 0xd0, 0xd3, 0x60, 0xf6, 0x9d, 0x1e, 0x94, 0x33, 0xb4, 0x03, 0x6d, 0xbd, 0xd5, 0xe9,
+	0xab, 0xe5, 0x68, 0xe9, 0xf3, 0x18, 0x5f, 0x01, 0x26, 0x35, 0x28, 0x25, 0x29, 0x32, 0xca, 0xdc,
+	0xa0, 0xd4, 0xcd, 0xa0, 0x18, 0xa3, 0x1d, 0x94, 0x31, 0xb4, 0xd5, 0xa2, 0xa5, 0xa2, 0x2a, 0xfc,
+	0x86, 0xee, 0x58, 0xa4, 0xea, 0xfd, 0x75, 0xd2, 0xdf, 0x7b, 0x83, 0x7a, 0x63, 0x26, 0x93, 0x96,
+	0xca, 0xff, 0xbe, 0x2a, 0xd0, 0x57, 0xd0, 0x54, 0x5f, 0x85, 0xdf, 0xd4, 0x94, 0x3e, 0xbe, 0xa8,
+	0x9f, 0xab, 0xf2, 0x7c, 0xcb, 0xa7, 0x87, 0xb6, 0xa9, 0x26, 0x11, 0xed, 0xc1, 0x35, 0x46, 0x8e,
+	0x64, 0xaa, 0x11, 0x51, 0x86, 0xc9, 0x91, 0x5d, 0x83, 0x4d, 0x65, 0x56, 0xf1, 0x63, 0x65, 0xbc,
+	0x78, 0xba, 0x5b, 0x57, 0x31, 0xdd, 0xd7, 0xa1, 0xa3, 0xb6, 0xd5, 0xf0, 0x6c, 0x6b, 0x6c, 0x6d,
+	0x56, 0x15, 0x0a, 0x9a, 0x08, 0x7f, 0xf1, 0x60, 0xeb, 0x3c, 0x41, 0xf4, 0x1e, 0xb4, 0x0c, 0x1d,
+	0x37, 0x02, 0xeb, 0xea, 0x38, 0xc6, 0x68, 0xdb, 0x2d, 0x4a, 0x4d, 0x4f, 0xab, 0x39, 0xa0, 0x7b,
+	0xb0, 0x9e, 0x15, 0xbc, 0x62, 0xd2, 0xaf, 0xff, 0xaf, 0x6e, 0xd8, 0xec, 0xf0, 0x85, 0x07, 0xdd,
+	0x84, 0x3c, 0xce, 0x4a, 0x6c, 0x24, 0xdb, 0x86, 0x26, 0x26, 0x8c, 0x17, 0x76, 0x37, 0xcc, 0x01,
+	0x25, 0xd0, 0x76, 0xbf, 0x17, 0x1a, 0x86, 0xd2, 0xef, 0xfc, 0xa3, 0xb6, 0x6f, 0x03, 0x46, 0xd7,
+	0xed, 0x9b, 0x76, 0xcd, 0xac, 0xb3, 0x4b, 0x0c, 0x7f, 0x53, 0x2f, 0xda, 0xab, 0x7b, 0xd0, 0x0c,
+	0x9a, 0xa6, 0x75, 0x75, 0xdd, 0x90, 0xf7, 0x2f, 0x6c, 0xc8, 0x3e, 0x99, 0xea, 0x9e, 0xdc, 0xb6,
+	0x3d, 0xf9, 0xf4, 0x0d, 0xe8, 0xd9, 0x1c, 0x91, 0x98, 0xfb, 0xc3, 0xdf, 0x6b, 0xd0, 0x55, 0x12,
+	0x1b, 0x9a, 0xe2, 0xdf, 0x95, 0xbe, 0x82, 0x5d, 0x5b, 0x15, 0xb2, 0x7e, 0x49, 0x42, 0xde, 0x81,
+	0x96, 0x26, 0x4a, 0xdc, 0x0f, 0xdb, 0x85, 0x2f, 0xe4, 0x4a, 0x93, 0xdd, 0x0b, 0x69, 0xb3, 0xc2,
+	0x9f, 0x3d, 0x78, 0x57, 0x09, 0x94, 0x4d, 0x72, 0xe2, 0x8a, 0x8b, 0x31, 0x3b, 0xe0, 0x88, 0x03,
+	0xca, 0xad, 0x23, 0x75, 0xf5, 0xd4, 0xb3, 0x59, 0xff, 0x6f, 0xe0, 0x9f, 0x58, 0xe0, 0x3b, 0x06,
+	0xf8, 0xeb, 0x57, 0x18, 0x0a, 0xbd, 0xfc, 0x7c, 0xd1, 0xd1, 0xfd, 0xa7, 0xa7, 0x81, 0xf7, 0xec,
+	0x34, 0xf0, 0x5e, 0x9c, 0x06, 0xde, 0x93, 0xb3, 0x60, 0xed, 0xd9, 0x59, 0xb0, 0xf6, 0xe7, 0x59,
+	0xb0, 0xf6, 0xe3, 0xe7, 0x2b, 0x52, 0x5b, 0x7a, 0x37, 0xf2, 0x6c, 0x22, 0xdc, 0x21, 0x5e, 0x7c,
+	0x11, 0x1f, 0xad, 0xfe, 0x4f, 0xd2, 0xea, 0x4f, 0xd6, 0x35, 0xba, 0xdb, 0xff, 0x0c, 0x00, 0x8a,
+	0xd3, 0x06, 0x87, 0x4a, 0x09, 0x00, 0x00,
 }
 
 func (m *Gauge) Marshal() (dAtA []byte, err error) {
@@ -252,6 +648,27 @@ func (m *Gauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Funders) > 0 {
+		for iNdEx := len(m.Funders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Funders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGauge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintGauge(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.DistributedCoins) > 0 {
 		for iNdEx := len(m.DistributedCoins) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -326,7 +743,7 @@ func (m *Gauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *LockableDurationsInfo) Marshal() (dAtA []byte, err error) {
+func (m *GaugeFunder) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -336,41 +753,341 @@ func (m *LockableDurationsInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LockableDurationsInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *GaugeFunder) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LockableDurationsInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GaugeFunder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.LockableDurations) > 0 {
-		for iNdEx := len(m.LockableDurations) - 1; iNdEx >= 0; iNdEx-- {
-			n, err := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.LockableDurations[iNdEx], dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.LockableDurations[iNdEx]):])
-			if err != nil {
-				return 0, err
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGauge(dAtA, i, uint64(size))
 			}
-			i -= n
-			i = encodeVarintGauge(dAtA, i, uint64(n))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
 		}
 	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGauge(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGauge(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGauge(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *GaugeDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
+}
+
+func (m *GaugeDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GaugeDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumLocks != 0 {
+		i = encodeVarintGauge(dAtA, i, uint64(m.NumLocks))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.DistributedCoins) > 0 {
+		for iNdEx := len(m.DistributedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGauge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.NextLockIndex != 0 {
+		i = encodeVarintGauge(dAtA, i, uint64(m.NextLockIndex))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Locks) > 0 {
+		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGauge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.LockSum.Size()
+		i -= size
+		if _, err := m.LockSum.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGauge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.RemainEpochs != 0 {
+		i = encodeVarintGauge(dAtA, i, uint64(m.RemainEpochs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGauge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.GaugeId != 0 {
+		i = encodeVarintGauge(dAtA, i, uint64(m.GaugeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DistributionLock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionLock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGauge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintGauge(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.LockId != 0 {
+		i = encodeVarintGauge(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardIndex) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardIndex) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		for iNdEx := len(m.Index) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Index[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGauge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintGauge(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGauge(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LockRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Indexes) > 0 {
+		for iNdEx := len(m.Indexes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Indexes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGauge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintGauge(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGauge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.LockId != 0 {
+		i = encodeVarintGauge(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LockableDurationsInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockableDurationsInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockableDurationsInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LockableDurations) > 0 {
+		for iNdEx := len(m.LockableDurations) - 1; iNdEx >= 0; iNdEx-- {
+			n, err := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.LockableDurations[iNdEx], dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.LockableDurations[iNdEx]):])
+			if err != nil {
+				return 0, err
+			}
+			i -= n
+			i = encodeVarintGauge(dAtA, i, uint64(n))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGauge(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGauge(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
 }
 func (m *Gauge) Size() (n int) {
 	if m == nil {
@@ -406,31 +1123,871 @@ func (m *Gauge) Size() (n int) {
 			n += 1 + l + sovGauge(uint64(l))
 		}
 	}
-	return n
-}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovGauge(uint64(l))
+	}
+	if len(m.Funders) > 0 {
+		for _, e := range m.Funders {
+			l = e.Size()
+			n += 1 + l + sovGauge(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GaugeFunder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGauge(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovGauge(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GaugeDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GaugeId != 0 {
+		n += 1 + sovGauge(uint64(m.GaugeId))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovGauge(uint64(l))
+		}
+	}
+	if m.RemainEpochs != 0 {
+		n += 1 + sovGauge(uint64(m.RemainEpochs))
+	}
+	l = m.LockSum.Size()
+	n += 1 + l + sovGauge(uint64(l))
+	if len(m.Locks) > 0 {
+		for _, e := range m.Locks {
+			l = e.Size()
+			n += 1 + l + sovGauge(uint64(l))
+		}
+	}
+	if m.NextLockIndex != 0 {
+		n += 1 + sovGauge(uint64(m.NextLockIndex))
+	}
+	if len(m.DistributedCoins) > 0 {
+		for _, e := range m.DistributedCoins {
+			l = e.Size()
+			n += 1 + l + sovGauge(uint64(l))
+		}
+	}
+	if m.NumLocks != 0 {
+		n += 1 + sovGauge(uint64(m.NumLocks))
+	}
+	return n
+}
+
+func (m *DistributionLock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		n += 1 + sovGauge(uint64(m.LockId))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovGauge(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGauge(uint64(l))
+	return n
+}
+
+func (m *RewardIndex) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGauge(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovGauge(uint64(l))
+	if len(m.Index) > 0 {
+		for _, e := range m.Index {
+			l = e.Size()
+			n += 1 + l + sovGauge(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LockRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		n += 1 + sovGauge(uint64(m.LockId))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovGauge(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovGauge(uint64(l))
+	if len(m.Indexes) > 0 {
+		for _, e := range m.Indexes {
+			l = e.Size()
+			n += 1 + l + sovGauge(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LockableDurationsInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LockableDurations) > 0 {
+		for _, e := range m.LockableDurations {
+			l = github_com_gogo_protobuf_types.SizeOfStdDuration(e)
+			n += 1 + l + sovGauge(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGauge(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGauge(x uint64) (n int) {
+	return sovGauge(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Gauge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGauge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Gauge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Gauge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsPerpetual", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsPerpetual = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributeTo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DistributeTo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types1.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumEpochsPaidOver", wireType)
+			}
+			m.NumEpochsPaidOver = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumEpochsPaidOver |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilledEpochs", wireType)
+			}
+			m.FilledEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FilledEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributedCoins = append(m.DistributedCoins, types1.Coin{})
+			if err := m.DistributedCoins[len(m.DistributedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funders = append(m.Funders, GaugeFunder{})
+			if err := m.Funders[len(m.Funders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGauge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GaugeFunder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGauge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GaugeFunder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GaugeFunder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types1.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGauge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GaugeDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGauge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GaugeDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GaugeDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeId", wireType)
+			}
+			m.GaugeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GaugeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types1.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainEpochs", wireType)
+			}
+			m.RemainEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockSum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LockSum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locks = append(m.Locks, DistributionLock{})
+			if err := m.Locks[len(m.Locks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextLockIndex", wireType)
+			}
+			m.NextLockIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextLockIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributedCoins = append(m.DistributedCoins, types1.Coin{})
+			if err := m.DistributedCoins[len(m.DistributedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumLocks", wireType)
+			}
+			m.NumLocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumLocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGauge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *LockableDurationsInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.LockableDurations) > 0 {
-		for _, e := range m.LockableDurations {
-			l = github_com_gogo_protobuf_types.SizeOfStdDuration(e)
-			n += 1 + l + sovGauge(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovGauge(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGauge(x uint64) (n int) {
-	return sovGauge(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Gauge) Unmarshal(dAtA []byte) error {
+func (m *DistributionLock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -453,17 +2010,17 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Gauge: wiretype end group for non-group")
+			return fmt.Errorf("proto: DistributionLock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Gauge: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DistributionLock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
 			}
-			m.Id = 0
+			m.LockId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGauge
@@ -473,16 +2030,16 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.LockId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsPerpetual", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGauge
@@ -492,17 +2049,29 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsPerpetual = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributeTo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGauge
@@ -512,28 +2081,111 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGauge
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGauge
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DistributeTo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGauge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardIndex) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGauge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardIndex: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -560,14 +2212,13 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = append(m.Coins, types1.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -594,15 +2245,66 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.Index = append(m.Index, types1.DecCoin{})
+			if err := m.Index[len(m.Index)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGauge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGauge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumEpochsPaidOver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
 			}
-			m.NumEpochsPaidOver = 0
+			m.LockId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGauge
@@ -612,16 +2314,16 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumEpochsPaidOver |= uint64(b&0x7F) << shift
+				m.LockId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FilledEpochs", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
 			}
-			m.FilledEpochs = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGauge
@@ -631,14 +2333,29 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FilledEpochs |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+			if msglen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types1.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributedCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -665,8 +2382,41 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DistributedCoins = append(m.DistributedCoins, types1.Coin{})
-			if err := m.DistributedCoins[len(m.DistributedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indexes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Indexes = append(m.Indexes, RewardIndex{})
+			if err := m.Indexes[len(m.Indexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -14,10 +14,11 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default Capability genesis state.
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params: Params{
-			DistrEpochIdentifier: "week",
-		},
-		Gauges: []Gauge{},
+		Params:        DefaultParams(),
+		Gauges:        []Gauge{},
+		Distributions: []GaugeDistribution{},
+		RewardIndexes: []RewardIndex{},
+		LockRewards:   []LockRewards{},
 		LockableDurations: []time.Duration{
 			time.Second,
 			time.Hour,
@@ -34,6 +34,12 @@ type GenesisState struct {
 	Gauges            []Gauge         `protobuf:"bytes,2,rep,name=gauges,proto3" json:"gauges"`
 	LockableDurations []time.Duration `protobuf:"bytes,3,rep,name=lockable_durations,json=lockableDurations,proto3,stdduration" json:"lockable_durations" yaml:"lockable_durations"`
 	LastGaugeId       uint64          `protobuf:"varint,4,opt,name=last_gauge_id,json=lastGaugeId,proto3" json:"last_gauge_id,omitempty"`
+	// distributions still being paid out
+	Distributions []GaugeDistribution `protobuf:"bytes,5,rep,name=distributions,proto3" json:"distributions"`
+	// reward indexes accrued per lock denom and duration
+	RewardIndexes []RewardIndex `protobuf:"bytes,6,rep,name=reward_indexes,json=rewardIndexes,proto3" json:"reward_indexes"`
+	// rewards settlement of every lock
+	LockRewards []LockRewards `protobuf:"bytes,7,rep,name=lock_rewards,json=lockRewards,proto3" json:"lock_rewards"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -97,6 +103,27 @@ func (m *GenesisState) GetLastGaugeId() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetDistributions() []GaugeDistribution {
+	if m != nil {
+		return m.Distributions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRewardIndexes() []RewardIndex {
+	if m != nil {
+		return m.RewardIndexes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLockRewards() []LockRewards {
+	if m != nil {
+		return m.LockRewards
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.incentives.GenesisState")
 }
@@ -104,29 +131,34 @@ func init() {
 func init() { proto.RegisterFile("osmosis/incentives/genesis.proto", fileDescriptor_a288ccc95d977d2d) }
 
 var fileDescriptor_a288ccc95d977d2d = []byte{
-	// 339 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xb1, 0x4e, 0xc3, 0x30,
-	0x10, 0x86, 0x93, 0xb6, 0xea, 0x90, 0xc2, 0x80, 0xc5, 0x90, 0x76, 0x70, 0xa2, 0x48, 0x48, 0x5d,
-	0xb0, 0xa5, 0x22, 0x54, 0xc4, 0x58, 0x21, 0x55, 0x4c, 0xa0, 0xb2, 0xb1, 0x54, 0x4e, 0x6b, 0x8c,
-	0x45, 0x12, 0x57, 0x3d, 0xa7, 0xa2, 0x6f, 0xc1, 0x84, 0x78, 0xa4, 0x8e, 0x1d, 0x99, 0x0a, 0x6a,
-	0xdf, 0x80, 0x27, 0x40, 0x71, 0x62, 0x81, 0xd4, 0x6e, 0x3e, 0xff, 0xdf, 0xfd, 0xf7, 0xdf, 0x79,
-	0xa1, 0x82, 0x54, 0x81, 0x04, 0x2a, 0xb3, 0x09, 0xcf, 0xb4, 0x5c, 0x70, 0xa0, 0x82, 0x67, 0x1c,
-	0x24, 0x90, 0xd9, 0x5c, 0x69, 0x85, 0x50, 0x45, 0x90, 0x3f, 0xa2, 0x73, 0x2a, 0x94, 0x50, 0x46,
-	0xa6, 0xc5, 0xab, 0x24, 0x3b, 0x58, 0x28, 0x25, 0x12, 0x4e, 0x4d, 0x15, 0xe7, 0x4f, 0x74, 0x9a,
-	0xcf, 0x99, 0x96, 0x2a, 0xab, 0xf4, 0xe0, 0xc0, 0xac, 0x19, 0x9b, 0xb3, 0x14, 0xac, 0xc1, 0xa1,
-	0x30, 0x2c, 0x17, 0xbc, 0xd4, 0xa3, 0xf7, 0x9a, 0x77, 0x34, 0x2c, 0xc3, 0x3d, 0x68, 0xa6, 0x39,
-	0xba, 0xf2, 0x9a, 0xa5, 0x81, 0xef, 0x86, 0x6e, 0xb7, 0xd5, 0xeb, 0x90, 0xfd, 0xb0, 0xe4, 0xde,
-	0x10, 0x83, 0xc6, 0x6a, 0x13, 0x38, 0xa3, 0x8a, 0x47, 0x7d, 0xaf, 0x69, 0x9c, 0xc1, 0xaf, 0x85,
-	0xf5, 0x6e, 0xab, 0xd7, 0x3e, 0xd4, 0x39, 0x2c, 0x08, 0xdb, 0x58, 0xe2, 0x48, 0x79, 0x28, 0x51,
-	0x93, 0x17, 0x16, 0x27, 0x7c, 0x6c, 0xf7, 0x03, 0xbf, 0x5e, 0x99, 0x94, 0x17, 0x20, 0xf6, 0x02,
-	0xe4, 0xa6, 0x22, 0x06, 0x67, 0x85, 0xc9, 0xcf, 0x26, 0x68, 0x2f, 0x59, 0x9a, 0x5c, 0x47, 0xfb,
-	0x16, 0xd1, 0xc7, 0x57, 0xe0, 0x8e, 0x4e, 0xac, 0x60, 0x1b, 0x01, 0x45, 0xde, 0x71, 0xc2, 0x40,
-	0x8f, 0xcd, 0xfc, 0xb1, 0x9c, 0xfa, 0x8d, 0xd0, 0xed, 0x36, 0x46, 0xad, 0xe2, 0xd3, 0x04, 0xbc,
-	0x9d, 0x0e, 0xee, 0x56, 0x5b, 0xec, 0xae, 0xb7, 0xd8, 0xfd, 0xde, 0x62, 0xf7, 0x6d, 0x87, 0x9d,
-	0xf5, 0x0e, 0x3b, 0x9f, 0x3b, 0xec, 0x3c, 0x5e, 0x0a, 0xa9, 0x9f, 0xf3, 0x98, 0x4c, 0x54, 0x4a,
-	0xab, 0x0d, 0xcf, 0x13, 0x16, 0x83, 0x2d, 0xe8, 0xa2, 0x4f, 0x5f, 0xff, 0xdf, 0x5b, 0x2f, 0x67,
-	0x1c, 0xe2, 0xa6, 0xd9, 0xe0, 0xe2, 0x37, 0x00, 0x00, 0xff, 0xff, 0x51, 0x99, 0x95, 0x16, 0x1f,
-	0x02, 0x00, 0x00,
+	// 420 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xc1, 0x6a, 0xd4, 0x40,
+	0x18, 0xc7, 0x13, 0x1b, 0x23, 0x4c, 0x5a, 0xc1, 0xc1, 0x43, 0xba, 0x87, 0x24, 0x04, 0x0a, 0x7b,
+	0x31, 0x03, 0x15, 0xa9, 0x78, 0x2c, 0x85, 0x5a, 0x28, 0xa8, 0xf1, 0xe6, 0x25, 0x4c, 0x92, 0x31,
+	0x0e, 0x4d, 0x32, 0x4b, 0xbe, 0x49, 0x6d, 0x8f, 0xbe, 0x81, 0x47, 0x1f, 0xa9, 0xc7, 0x1e, 0x3d,
+	0x55, 0xd9, 0x7d, 0x03, 0x9f, 0x40, 0x32, 0x93, 0x61, 0xb7, 0x6c, 0xf6, 0x96, 0xf9, 0xbe, 0xdf,
+	0xfc, 0xe6, 0xff, 0xcd, 0x04, 0x45, 0x02, 0x1a, 0x01, 0x1c, 0x08, 0x6f, 0x0b, 0xd6, 0x4a, 0x7e,
+	0xcd, 0x80, 0x54, 0xac, 0x65, 0xc0, 0x21, 0x59, 0x74, 0x42, 0x0a, 0x8c, 0x47, 0x22, 0x59, 0x13,
+	0xb3, 0x97, 0x95, 0xa8, 0x84, 0x6a, 0x93, 0xe1, 0x4b, 0x93, 0xb3, 0xa0, 0x12, 0xa2, 0xaa, 0x19,
+	0x51, 0xab, 0xbc, 0xff, 0x4a, 0xca, 0xbe, 0xa3, 0x92, 0x8b, 0x76, 0xec, 0x87, 0x13, 0x67, 0x2d,
+	0x68, 0x47, 0x1b, 0x30, 0x82, 0xa9, 0x30, 0xb4, 0xaf, 0x98, 0xee, 0xc7, 0x3f, 0x1c, 0xb4, 0x7f,
+	0xae, 0xc3, 0x7d, 0x96, 0x54, 0x32, 0xfc, 0x16, 0xb9, 0x5a, 0xe0, 0xdb, 0x91, 0x3d, 0xf7, 0x8e,
+	0x67, 0xc9, 0x76, 0xd8, 0xe4, 0xa3, 0x22, 0x4e, 0x9d, 0xbb, 0x87, 0xd0, 0x4a, 0x47, 0x1e, 0x9f,
+	0x20, 0x57, 0x99, 0xc1, 0x7f, 0x12, 0xed, 0xcd, 0xbd, 0xe3, 0xc3, 0xa9, 0x9d, 0xe7, 0x03, 0x61,
+	0x36, 0x6a, 0x1c, 0x0b, 0x84, 0x6b, 0x51, 0x5c, 0xd1, 0xbc, 0x66, 0x99, 0x99, 0x0f, 0xfc, 0xbd,
+	0x51, 0xa2, 0x6f, 0x20, 0x31, 0x37, 0x90, 0x9c, 0x8d, 0xc4, 0xe9, 0xd1, 0x20, 0xf9, 0xf7, 0x10,
+	0x1e, 0xde, 0xd2, 0xa6, 0x7e, 0x17, 0x6f, 0x2b, 0xe2, 0x5f, 0x7f, 0x42, 0x3b, 0x7d, 0x61, 0x1a,
+	0x66, 0x23, 0xe0, 0x18, 0x1d, 0xd4, 0x14, 0x64, 0xa6, 0xce, 0xcf, 0x78, 0xe9, 0x3b, 0x91, 0x3d,
+	0x77, 0x52, 0x6f, 0x28, 0xaa, 0x80, 0x17, 0x25, 0xfe, 0x84, 0x0e, 0x4a, 0x0e, 0xb2, 0xe3, 0x79,
+	0xaf, 0xf3, 0x3c, 0x55, 0x79, 0x8e, 0x76, 0x0e, 0x75, 0xb6, 0x41, 0x8f, 0x03, 0x3e, 0x36, 0xe0,
+	0x4b, 0xf4, 0xbc, 0x63, 0xdf, 0x69, 0x57, 0x66, 0xbc, 0x2d, 0xd9, 0x0d, 0x03, 0xdf, 0x55, 0xce,
+	0x70, 0xca, 0x99, 0x2a, 0xf2, 0x62, 0x00, 0x8d, 0xad, 0x5b, 0x97, 0x18, 0xe0, 0xf7, 0x68, 0x7f,
+	0x98, 0x2c, 0xd3, 0x55, 0xf0, 0x9f, 0xed, 0x76, 0x5d, 0x8a, 0xe2, 0x4a, 0xfb, 0xcc, 0x9b, 0x79,
+	0xf5, 0x46, 0xe9, 0xc3, 0xdd, 0x32, 0xb0, 0xef, 0x97, 0x81, 0xfd, 0x77, 0x19, 0xd8, 0x3f, 0x57,
+	0x81, 0x75, 0xbf, 0x0a, 0xac, 0xdf, 0xab, 0xc0, 0xfa, 0xf2, 0xa6, 0xe2, 0xf2, 0x5b, 0x9f, 0x27,
+	0x85, 0x68, 0xc8, 0xe8, 0x7d, 0x55, 0xd3, 0x1c, 0xcc, 0x82, 0x5c, 0x9f, 0x90, 0x9b, 0xcd, 0x5f,
+	0x4b, 0xde, 0x2e, 0x18, 0xe4, 0xae, 0x7a, 0xac, 0xd7, 0xff, 0x07, 0x00, 0xd5, 0x03, 0x43, 0x7f,
+	0x0a, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -149,6 +181,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LockRewards) > 0 {
+		for iNdEx := len(m.LockRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.RewardIndexes) > 0 {
+		for iNdEx := len(m.RewardIndexes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardIndexes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Distributions) > 0 {
+		for iNdEx := len(m.Distributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Distributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.LastGaugeId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.LastGaugeId))
 		i--
@@ -227,6 +301,24 @@ func (m *GenesisState) Size() (n int) {
 	if m.LastGaugeId != 0 {
 		n += 1 + sovGenesis(uint64(m.LastGaugeId))
 	}
+	if len(m.Distributions) > 0 {
+		for _, e := range m.Distributions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RewardIndexes) > 0 {
+		for _, e := range m.RewardIndexes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LockRewards) > 0 {
+		for _, e := range m.LockRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -385,6 +477,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distributions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Distributions = append(m.Distributions, GaugeDistribution{})
+			if err := m.Distributions[len(m.Distributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardIndexes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardIndexes = append(m.RewardIndexes, RewardIndex{})
+			if err := m.RewardIndexes[len(m.RewardIndexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockRewards = append(m.LockRewards, LockRewards{})
+			if err := m.LockRewards[len(m.LockRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -40,6 +40,18 @@ var (
 	// KeyPrefixGaugesByDenom defines prefix key for storing indexes of gauge IDs by denomination.
 	KeyPrefixGaugesByDenom = []byte{0x05}
 
+	// KeyPrefixGaugeDistributions defines prefix key for storing gauge distributions still being paid out.
+	KeyPrefixGaugeDistributions = []byte{0x06}
+
+	// KeyPrefixRewardIndexes defines prefix key for storing reward indexes by denom and duration.
+	KeyPrefixRewardIndexes = []byte{0x08}
+
+	// KeyPrefixLockRewards defines prefix key for storing the rewards settlement of locks.
+	KeyPrefixLockRewards = []byte{0x09}
+
+	// KeyPrefixGaugeDistributionLocks defines prefix key for storing the locks of gauge distributions not paid yet.
+	KeyPrefixGaugeDistributionLocks = []byte{0x0A}
+
 	// KeyIndexSeparator defines key for merging bytes.
 	KeyIndexSeparator = []byte{0x07}
 
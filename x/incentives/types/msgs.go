@@ -2,17 +2,21 @@ package types
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	lockuptypes "github.com/osmosis-labs/osmosis/v7/x/lockup/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // constants.
 const (
-	TypeMsgCreateGauge = "create_gauge"
-	TypeMsgAddToGauge  = "add_to_gauge"
+	TypeMsgCreateGauge  = "create_gauge"
+	TypeMsgAddToGauge   = "add_to_gauge"
+	TypeMsgClaimRewards = "claim_rewards"
+	TypeMsgCancelGauge  = "cancel_gauge"
 )
 
 var _ sdk.Msg = &MsgCreateGauge{}
@@ -38,7 +42,8 @@ func (m MsgCreateGauge) ValidateBasic() error {
 	if sdk.ValidateDenom(m.DistributeTo.Denom) != nil {
 		return errors.New("denom should be valid for the condition")
 	}
-	if lockuptypes.LockQueryType_name[int32(m.DistributeTo.LockQueryType)] == "" {
+	// gauges only distribute to locks queried by duration or by time
+	if m.DistributeTo.LockQueryType != lockuptypes.ByDuration && m.DistributeTo.LockQueryType != lockuptypes.ByTime {
 		return errors.New("lock query type is invalid")
 	}
 	if m.StartTime.Equal(time.Time{}) {
@@ -51,8 +56,13 @@ func (m MsgCreateGauge) ValidateBasic() error {
 		return errors.New("distribution period should be 1 epoch for perpetual gauge")
 	}
 
-	if lockuptypes.LockQueryType_name[int32(m.DistributeTo.LockQueryType)] != "ByDuration" {
-		return errors.New("only duration query condition is allowed. Start time distr conditions is an obsolete codepath slated for deletion")
+	if m.DistributeTo.LockQueryType == lockuptypes.ByTime {
+		if m.DistributeTo.Timestamp.Equal(time.Time{}) {
+			return errors.New("lock end time should be set for a time query condition")
+		}
+		if m.DistributeTo.Timestamp.Before(m.StartTime) {
+			return errors.New("lock end time should not be before the distribution start time")
+		}
 	}
 
 	return nil
@@ -99,3 +109,68 @@ func (m MsgAddToGauge) GetSigners() []sdk.AccAddress {
 	owner, _ := sdk.AccAddressFromBech32(m.Owner)
 	return []sdk.AccAddress{owner}
 }
+
+var _ sdk.Msg = &MsgClaimRewards{}
+
+// NewMsgClaimRewards creates a message to claim the accrued rewards of locks.
+func NewMsgClaimRewards(owner sdk.AccAddress, lockIDs []uint64) *MsgClaimRewards {
+	return &MsgClaimRewards{
+		Owner:   owner.String(),
+		LockIds: lockIDs,
+	}
+}
+
+func (m MsgClaimRewards) Route() string { return RouterKey }
+func (m MsgClaimRewards) Type() string  { return TypeMsgClaimRewards }
+func (m MsgClaimRewards) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+	seen := make(map[uint64]bool, len(m.LockIds))
+	for _, lockID := range m.LockIds {
+		if seen[lockID] {
+			return fmt.Errorf("lock %d is listed twice", lockID)
+		}
+		seen[lockID] = true
+	}
+
+	return nil
+}
+
+func (m MsgClaimRewards) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgClaimRewards) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+var _ sdk.Msg = &MsgCancelGauge{}
+
+// NewMsgCancelGauge creates a message to cancel a gauge and reclaim its undistributed coins.
+func NewMsgCancelGauge(owner sdk.AccAddress, gaugeID uint64) *MsgCancelGauge {
+	return &MsgCancelGauge{
+		Owner:   owner.String(),
+		GaugeId: gaugeID,
+	}
+}
+
+func (m MsgCancelGauge) Route() string { return RouterKey }
+func (m MsgCancelGauge) Type() string  { return TypeMsgCancelGauge }
+func (m MsgCancelGauge) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+
+	return nil
+}
+
+func (m MsgCancelGauge) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgCancelGauge) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
@@ -96,6 +96,14 @@ func TestMsgCreatePool(t *testing.T) {
 			}),
 			expectPass: false,
 		},
+		{
+			name: "unknown lock query type",
+			msg: createMsg(func(msg MsgCreateGauge) MsgCreateGauge {
+				msg.DistributeTo.LockQueryType = 2
+				return msg
+			}),
+			expectPass: false,
+		},
 		{
 			name: "invalid distribution start time",
 			msg: createMsg(func(msg MsgCreateGauge) MsgCreateGauge {
@@ -130,6 +138,33 @@ func TestMsgCreatePool(t *testing.T) {
 			}),
 			expectPass: true,
 		},
+		{
+			name: "valid lock end time",
+			msg: createMsg(func(msg MsgCreateGauge) MsgCreateGauge {
+				msg.DistributeTo.LockQueryType = lockuptypes.ByTime
+				msg.DistributeTo.Timestamp = msg.StartTime.Add(time.Hour)
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "empty lock end time",
+			msg: createMsg(func(msg MsgCreateGauge) MsgCreateGauge {
+				msg.DistributeTo.LockQueryType = lockuptypes.ByTime
+				msg.DistributeTo.Timestamp = time.Time{}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "lock end time before distribution start time",
+			msg: createMsg(func(msg MsgCreateGauge) MsgCreateGauge {
+				msg.DistributeTo.LockQueryType = lockuptypes.ByTime
+				msg.DistributeTo.Timestamp = msg.StartTime.Add(-time.Hour)
+				return msg
+			}),
+			expectPass: false,
+		},
 	}
 
 	for _, test := range tests {
@@ -203,3 +238,54 @@ func TestMsgAddToGauge(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgCancelGauge(t *testing.T) {
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+
+	createMsg := func(after func(msg MsgCancelGauge) MsgCancelGauge) MsgCancelGauge {
+		properMsg := *NewMsgCancelGauge(addr1, 1)
+
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg MsgCancelGauge) MsgCancelGauge {
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "cancel_gauge")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        MsgCancelGauge
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg MsgCancelGauge) MsgCancelGauge {
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "empty owner",
+			msg: createMsg(func(msg MsgCancelGauge) MsgCancelGauge {
+				msg.Owner = ""
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
@@ -1,14 +1,21 @@
 package types
 
 import (
+	"errors"
+	"fmt"
+
 	epochtypes "github.com/osmosis-labs/osmosis/v7/x/epochs/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 // Parameter store keys.
 var (
-	KeyDistrEpochIdentifier = []byte("DistrEpochIdentifier")
+	KeyDistrEpochIdentifier         = []byte("DistrEpochIdentifier")
+	KeyMaxDistributionLocksPerBlock = []byte("MaxDistributionLocksPerBlock")
+	KeyAccrueRewards                = []byte("AccrueRewards")
+	KeyAutoCompoundMaxSlippage      = []byte("AutoCompoundMaxSlippage")
 )
 
 // ParamTable for minting module.
@@ -16,16 +23,22 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(distrEpochIdentifier string) Params {
+func NewParams(distrEpochIdentifier string, maxDistributionLocksPerBlock uint64, accrueRewards bool, autoCompoundMaxSlippage sdk.Dec) Params {
 	return Params{
-		DistrEpochIdentifier: distrEpochIdentifier,
+		DistrEpochIdentifier:         distrEpochIdentifier,
+		MaxDistributionLocksPerBlock: maxDistributionLocksPerBlock,
+		AccrueRewards:                accrueRewards,
+		AutoCompoundMaxSlippage:      autoCompoundMaxSlippage,
 	}
 }
 
 // default minting module parameters.
 func DefaultParams() Params {
 	return Params{
-		DistrEpochIdentifier: "week",
+		DistrEpochIdentifier:         "week",
+		MaxDistributionLocksPerBlock: 0,
+		AccrueRewards:                false,
+		AutoCompoundMaxSlippage:      sdk.NewDecWithPrec(5, 2), // 5%
 	}
 }
 
@@ -34,12 +47,55 @@ func (p Params) Validate() error {
 	if err := epochtypes.ValidateEpochIdentifierInterface(p.DistrEpochIdentifier); err != nil {
 		return err
 	}
-	return nil
+	if err := validateMaxDistributionLocksPerBlock(p.MaxDistributionLocksPerBlock); err != nil {
+		return err
+	}
+	if err := validateAccrueRewards(p.AccrueRewards); err != nil {
+		return err
+	}
+	return validateAutoCompoundMaxSlippage(p.AutoCompoundMaxSlippage)
 }
 
 // Implements params.ParamSet.
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyDistrEpochIdentifier, &p.DistrEpochIdentifier, epochtypes.ValidateEpochIdentifierInterface),
+		paramtypes.NewParamSetPair(KeyMaxDistributionLocksPerBlock, &p.MaxDistributionLocksPerBlock, validateMaxDistributionLocksPerBlock),
+		paramtypes.NewParamSetPair(KeyAccrueRewards, &p.AccrueRewards, validateAccrueRewards),
+		paramtypes.NewParamSetPair(KeyAutoCompoundMaxSlippage, &p.AutoCompoundMaxSlippage, validateAutoCompoundMaxSlippage),
+	}
+}
+
+func validateMaxDistributionLocksPerBlock(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateAccrueRewards(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateAutoCompoundMaxSlippage(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return errors.New("auto-compound max slippage cannot be negative")
 	}
+	if v.GTE(sdk.OneDec()) {
+		return errors.New("auto-compound max slippage must be less than one")
+	}
+
+	return nil
 }
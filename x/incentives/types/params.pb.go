@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -27,6 +28,16 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	// distribution epoch identifier
 	DistrEpochIdentifier string `protobuf:"bytes,1,opt,name=distr_epoch_identifier,json=distrEpochIdentifier,proto3" json:"distr_epoch_identifier,omitempty" yaml:"distr_epoch_identifier"`
+	// maximum number of locks paid per block when distributing. Zero pays
+	// every lock in the epoch block.
+	MaxDistributionLocksPerBlock uint64 `protobuf:"varint,2,opt,name=max_distribution_locks_per_block,json=maxDistributionLocksPerBlock,proto3" json:"max_distribution_locks_per_block,omitempty" yaml:"max_distribution_locks_per_block"`
+	// whether gauges distributing to native denoms accrue rewards into reward
+	// indexes for the lock owners to claim, instead of sending them each epoch
+	AccrueRewards bool `protobuf:"varint,3,opt,name=accrue_rewards,json=accrueRewards,proto3" json:"accrue_rewards,omitempty" yaml:"accrue_rewards"`
+	// maximum fraction by which the shares minted when compounding a reward may
+	// fall short of what the reward is worth at the pool's TWAP. Rewards that
+	// would mint fewer shares are paid out liquid.
+	AutoCompoundMaxSlippage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=auto_compound_max_slippage,json=autoCompoundMaxSlippage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"auto_compound_max_slippage" yaml:"auto_compound_max_slippage"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -69,6 +80,20 @@ func (m *Params) GetDistrEpochIdentifier() string {
 	return ""
 }
 
+func (m *Params) GetMaxDistributionLocksPerBlock() uint64 {
+	if m != nil {
+		return m.MaxDistributionLocksPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetAccrueRewards() bool {
+	if m != nil {
+		return m.AccrueRewards
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.incentives.Params")
 }
@@ -76,20 +101,32 @@ func init() {
 func init() { proto.RegisterFile("osmosis/incentives/params.proto", fileDescriptor_1cc8b460d089f845) }
 
 var fileDescriptor_1cc8b460d089f845 = []byte{
-	// 206 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcf, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0xcf, 0xcc, 0x4b, 0x4e, 0xcd, 0x2b, 0xc9, 0x2c, 0x4b, 0x2d, 0xd6, 0x2f,
-	0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82, 0x2a, 0xd0,
-	0x43, 0x28, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x4b, 0xeb, 0x83, 0x58, 0x10, 0x95, 0x4a,
-	0x89, 0x5c, 0x6c, 0x01, 0x60, 0x9d, 0x42, 0xe1, 0x5c, 0x62, 0x29, 0x99, 0xc5, 0x25, 0x45, 0xf1,
-	0xa9, 0x05, 0xf9, 0xc9, 0x19, 0xf1, 0x99, 0x29, 0x20, 0x8d, 0x69, 0x99, 0xa9, 0x45, 0x12, 0x8c,
-	0x0a, 0x8c, 0x1a, 0x9c, 0x4e, 0x8a, 0x9f, 0xee, 0xc9, 0xcb, 0x56, 0x26, 0xe6, 0xe6, 0x58, 0x29,
-	0x61, 0x57, 0xa7, 0x14, 0x24, 0x02, 0x96, 0x70, 0x05, 0x89, 0x7b, 0xc2, 0x85, 0x9d, 0xfc, 0x4f,
-	0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18,
-	0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x34, 0x3d, 0xb3, 0x24, 0xa3, 0x34,
-	0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0xea, 0x62, 0xdd, 0x9c, 0xc4, 0xa4, 0x62, 0x18, 0x47, 0xbf,
-	0xcc, 0x5c, 0xbf, 0x02, 0xd9, 0x93, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0xa7, 0x1b,
-	0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xe2, 0x27, 0x6e, 0x20, 0x07, 0x01, 0x00, 0x00,
+	// 389 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xc1, 0xca, 0xd3, 0x40,
+	0x10, 0xc7, 0xb3, 0x7e, 0x1f, 0x1f, 0x1a, 0xd0, 0x43, 0xa8, 0x1a, 0x8b, 0x26, 0x69, 0x0e, 0x5a,
+	0x90, 0x26, 0x07, 0x11, 0xc1, 0x93, 0xc4, 0x7a, 0x10, 0x14, 0x4b, 0x7a, 0x10, 0xbc, 0x2c, 0x9b,
+	0xcd, 0x9a, 0x2e, 0x4d, 0xb2, 0xcb, 0x6e, 0x52, 0xd3, 0xb7, 0xe8, 0x7b, 0xf8, 0x22, 0x3d, 0xf6,
+	0x28, 0x1e, 0x82, 0xb4, 0x6f, 0x90, 0x27, 0x90, 0xdd, 0x44, 0xad, 0xa0, 0x7c, 0xa7, 0xcc, 0xcc,
+	0xef, 0x3f, 0xf9, 0x0f, 0xb3, 0x63, 0xba, 0x4c, 0x16, 0x4c, 0x52, 0x19, 0xd2, 0x12, 0x93, 0xb2,
+	0xa2, 0x1b, 0x22, 0x43, 0x8e, 0x04, 0x2a, 0x64, 0xc0, 0x05, 0xab, 0x98, 0x65, 0x0d, 0x82, 0xe0,
+	0x8f, 0x60, 0x3c, 0xca, 0x58, 0xc6, 0x34, 0x0e, 0x55, 0xd4, 0x2b, 0xfd, 0xaf, 0x17, 0xe6, 0xd5,
+	0x42, 0xb7, 0x5a, 0x1f, 0xcd, 0x7b, 0x29, 0x95, 0x95, 0x80, 0x84, 0x33, 0xbc, 0x82, 0x34, 0x55,
+	0x9d, 0x9f, 0x29, 0x11, 0x36, 0xf0, 0xc0, 0xf4, 0x56, 0x34, 0xe9, 0x5a, 0xf7, 0xd1, 0x16, 0x15,
+	0xf9, 0x4b, 0xff, 0xdf, 0x3a, 0x3f, 0x1e, 0x69, 0xf0, 0x46, 0xd5, 0xdf, 0xfe, 0x2e, 0x5b, 0xd2,
+	0xf4, 0x0a, 0xd4, 0x40, 0xcd, 0x68, 0x52, 0x57, 0x94, 0x95, 0x30, 0x67, 0x78, 0x2d, 0x21, 0x27,
+	0x02, 0x26, 0x2a, 0xb4, 0x6f, 0x78, 0x60, 0x7a, 0x19, 0x3d, 0xed, 0x5a, 0xf7, 0x49, 0x6f, 0x71,
+	0x5d, 0x87, 0x1f, 0x3f, 0x2c, 0x50, 0x33, 0x3f, 0x53, 0xbc, 0x53, 0x82, 0x05, 0x11, 0x91, 0xc2,
+	0xd6, 0x2b, 0xf3, 0x0e, 0xc2, 0x58, 0xd4, 0x04, 0x0a, 0xf2, 0x05, 0x89, 0x54, 0xda, 0x17, 0x1e,
+	0x98, 0xde, 0x8c, 0x1e, 0x74, 0xad, 0x7b, 0xb7, 0xb7, 0xf8, 0x9b, 0xfb, 0xf1, 0xed, 0xbe, 0x10,
+	0xf7, 0xb9, 0xb5, 0x03, 0xe6, 0x18, 0xd5, 0x15, 0x83, 0x98, 0x15, 0x9c, 0xd5, 0x65, 0x0a, 0xd5,
+	0x4c, 0x32, 0xa7, 0x9c, 0xa3, 0x8c, 0xd8, 0x97, 0x7a, 0x29, 0xcb, 0x7d, 0xeb, 0x1a, 0xdf, 0x5b,
+	0xf7, 0x71, 0x46, 0xab, 0x55, 0x9d, 0x04, 0x98, 0x15, 0x21, 0xd6, 0xdb, 0x1f, 0x3e, 0x33, 0x99,
+	0xae, 0xc3, 0x6a, 0xcb, 0x89, 0x0c, 0xe6, 0x04, 0x77, 0xad, 0x3b, 0x19, 0xcc, 0xff, 0xfb, 0x67,
+	0x3f, 0xbe, 0xaf, 0xe0, 0xeb, 0x81, 0xbd, 0x47, 0xcd, 0x72, 0x20, 0xd1, 0x87, 0xfd, 0xd1, 0x01,
+	0x87, 0xa3, 0x03, 0x7e, 0x1c, 0x1d, 0xb0, 0x3b, 0x39, 0xc6, 0xe1, 0xe4, 0x18, 0xdf, 0x4e, 0x8e,
+	0xf1, 0xe9, 0xf9, 0x99, 0xff, 0xf0, 0xf8, 0xb3, 0x1c, 0x25, 0xf2, 0x57, 0x12, 0x6e, 0x5e, 0x84,
+	0xcd, 0xf9, 0xbd, 0xe8, 0x91, 0x92, 0x2b, 0x7d, 0x05, 0xcf, 0x7e, 0x0e, 0x00, 0xef, 0x8d, 0x2e,
+	0x68, 0x52, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -112,6 +149,31 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.AutoCompoundMaxSlippage.Size()
+		i -= size
+		if _, err := m.AutoCompoundMaxSlippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.AccrueRewards {
+		i--
+		if m.AccrueRewards {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxDistributionLocksPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxDistributionLocksPerBlock))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.DistrEpochIdentifier) > 0 {
 		i -= len(m.DistrEpochIdentifier)
 		copy(dAtA[i:], m.DistrEpochIdentifier)
@@ -143,6 +205,14 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovParams(uint64(l))
 	}
+	if m.MaxDistributionLocksPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxDistributionLocksPerBlock))
+	}
+	if m.AccrueRewards {
+		n += 2
+	}
+	l = m.AutoCompoundMaxSlippage.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -213,6 +283,79 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.DistrEpochIdentifier = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDistributionLocksPerBlock", wireType)
+			}
+			m.MaxDistributionLocksPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDistributionLocksPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccrueRewards", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AccrueRewards = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundMaxSlippage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AutoCompoundMaxSlippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
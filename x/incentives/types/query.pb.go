@@ -973,6 +973,159 @@ func (m *QueryLockableDurationsResponse) GetLockableDurations() []time.Duration
 	return nil
 }
 
+type DistributionProgressRequest struct {
+}
+
+func (m *DistributionProgressRequest) Reset()         { *m = DistributionProgressRequest{} }
+func (m *DistributionProgressRequest) String() string { return proto.CompactTextString(m) }
+func (*DistributionProgressRequest) ProtoMessage()    {}
+func (*DistributionProgressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8124258a89427f98, []int{20}
+}
+func (m *DistributionProgressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DistributionProgressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DistributionProgressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DistributionProgressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionProgressRequest.Merge(m, src)
+}
+func (m *DistributionProgressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DistributionProgressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionProgressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributionProgressRequest proto.InternalMessageInfo
+
+type DistributionProgressResponse struct {
+	Distributions []GaugeDistributionProgress `protobuf:"bytes,1,rep,name=distributions,proto3" json:"distributions"`
+}
+
+func (m *DistributionProgressResponse) Reset()         { *m = DistributionProgressResponse{} }
+func (m *DistributionProgressResponse) String() string { return proto.CompactTextString(m) }
+func (*DistributionProgressResponse) ProtoMessage()    {}
+func (*DistributionProgressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8124258a89427f98, []int{21}
+}
+func (m *DistributionProgressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DistributionProgressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DistributionProgressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DistributionProgressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionProgressResponse.Merge(m, src)
+}
+func (m *DistributionProgressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DistributionProgressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionProgressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributionProgressResponse proto.InternalMessageInfo
+
+func (m *DistributionProgressResponse) GetDistributions() []GaugeDistributionProgress {
+	if m != nil {
+		return m.Distributions
+	}
+	return nil
+}
+
+// GaugeDistributionProgress is how far the distribution of a gauge has been
+// paid out.
+type GaugeDistributionProgress struct {
+	GaugeId uint64 `protobuf:"varint,1,opt,name=gauge_id,json=gaugeId,proto3" json:"gauge_id,omitempty"`
+	// number of locks the gauge distributes to
+	NumLocks uint64 `protobuf:"varint,2,opt,name=num_locks,json=numLocks,proto3" json:"num_locks,omitempty"`
+	// number of locks paid so far
+	PaidLocks uint64 `protobuf:"varint,3,opt,name=paid_locks,json=paidLocks,proto3" json:"paid_locks,omitempty"`
+	// coins paid so far
+	DistributedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=distributed_coins,json=distributedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"distributed_coins"`
+}
+
+func (m *GaugeDistributionProgress) Reset()         { *m = GaugeDistributionProgress{} }
+func (m *GaugeDistributionProgress) String() string { return proto.CompactTextString(m) }
+func (*GaugeDistributionProgress) ProtoMessage()    {}
+func (*GaugeDistributionProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8124258a89427f98, []int{22}
+}
+func (m *GaugeDistributionProgress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GaugeDistributionProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GaugeDistributionProgress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GaugeDistributionProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GaugeDistributionProgress.Merge(m, src)
+}
+func (m *GaugeDistributionProgress) XXX_Size() int {
+	return m.Size()
+}
+func (m *GaugeDistributionProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_GaugeDistributionProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GaugeDistributionProgress proto.InternalMessageInfo
+
+func (m *GaugeDistributionProgress) GetGaugeId() uint64 {
+	if m != nil {
+		return m.GaugeId
+	}
+	return 0
+}
+
+func (m *GaugeDistributionProgress) GetNumLocks() uint64 {
+	if m != nil {
+		return m.NumLocks
+	}
+	return 0
+}
+
+func (m *GaugeDistributionProgress) GetPaidLocks() uint64 {
+	if m != nil {
+		return m.PaidLocks
+	}
+	return 0
+}
+
+func (m *GaugeDistributionProgress) GetDistributedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DistributedCoins
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ModuleToDistributeCoinsRequest)(nil), "osmosis.incentives.ModuleToDistributeCoinsRequest")
 	proto.RegisterType((*ModuleToDistributeCoinsResponse)(nil), "osmosis.incentives.ModuleToDistributeCoinsResponse")
@@ -994,81 +1147,93 @@ func init() {
 	proto.RegisterType((*RewardsEstResponse)(nil), "osmosis.incentives.RewardsEstResponse")
 	proto.RegisterType((*QueryLockableDurationsRequest)(nil), "osmosis.incentives.QueryLockableDurationsRequest")
 	proto.RegisterType((*QueryLockableDurationsResponse)(nil), "osmosis.incentives.QueryLockableDurationsResponse")
+	proto.RegisterType((*DistributionProgressRequest)(nil), "osmosis.incentives.DistributionProgressRequest")
+	proto.RegisterType((*DistributionProgressResponse)(nil), "osmosis.incentives.DistributionProgressResponse")
+	proto.RegisterType((*GaugeDistributionProgress)(nil), "osmosis.incentives.GaugeDistributionProgress")
 }
 
 func init() { proto.RegisterFile("osmosis/incentives/query.proto", fileDescriptor_8124258a89427f98) }
 
 var fileDescriptor_8124258a89427f98 = []byte{
-	// 1103 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0x4d, 0x6f, 0x1b, 0x45,
-	0x18, 0xc7, 0x33, 0x4e, 0x52, 0x9a, 0x87, 0x36, 0x34, 0x43, 0x28, 0x89, 0xdb, 0xae, 0xcd, 0xaa,
-	0x4d, 0xdd, 0x94, 0xec, 0xc6, 0x76, 0x93, 0x20, 0xde, 0x24, 0x8c, 0x4b, 0xa9, 0x04, 0xa2, 0xac,
-	0x40, 0x48, 0x48, 0x68, 0xb5, 0xf6, 0x0e, 0xdb, 0x55, 0xec, 0x1d, 0xd7, 0xb3, 0x9b, 0x60, 0x45,
-	0xb9, 0x00, 0xe7, 0x0a, 0x44, 0x54, 0x71, 0xe8, 0x27, 0xe0, 0x08, 0x12, 0x37, 0x38, 0x70, 0xea,
-	0xb1, 0x12, 0x17, 0x4e, 0x29, 0x4a, 0xf8, 0x04, 0xfd, 0x04, 0x68, 0x67, 0x66, 0xfd, 0xba, 0x5e,
-	0x3b, 0x88, 0x46, 0x39, 0x39, 0x93, 0xe7, 0xed, 0xf7, 0x3c, 0x1e, 0xcf, 0xff, 0x01, 0x85, 0xb2,
-	0x3a, 0x65, 0x2e, 0xd3, 0x5d, 0xaf, 0x4a, 0x3c, 0xdf, 0xdd, 0x22, 0x4c, 0xbf, 0x17, 0x90, 0x66,
-	0x4b, 0x6b, 0x34, 0xa9, 0x4f, 0x31, 0x96, 0x76, 0xad, 0x63, 0x4f, 0xcf, 0x3b, 0xd4, 0xa1, 0xdc,
-	0xac, 0x87, 0x7f, 0x09, 0xcf, 0xf4, 0x45, 0x87, 0x52, 0xa7, 0x46, 0x74, 0xab, 0xe1, 0xea, 0x96,
-	0xe7, 0x51, 0xdf, 0xf2, 0x5d, 0xea, 0x31, 0x69, 0x55, 0xa4, 0x95, 0x9f, 0x2a, 0xc1, 0x97, 0xba,
-	0x1d, 0x34, 0xb9, 0x43, 0x64, 0xaf, 0xf2, 0x42, 0x7a, 0xc5, 0x62, 0x44, 0xdf, 0xca, 0x57, 0x88,
-	0x6f, 0xe5, 0xf5, 0x2a, 0x75, 0x23, 0xfb, 0x72, 0xb7, 0x9d, 0x03, 0xb6, 0xbd, 0x1a, 0x96, 0xe3,
-	0x7a, 0x3d, 0xb9, 0x62, 0x7a, 0x72, 0xac, 0xc0, 0x21, 0xd2, 0xbe, 0x18, 0xd9, 0x6b, 0xb4, 0xba,
-	0x19, 0x34, 0xf8, 0x87, 0x30, 0xa9, 0x59, 0x50, 0x3e, 0xa4, 0x76, 0x50, 0x23, 0x9f, 0xd0, 0xb2,
-	0xcb, 0xfc, 0xa6, 0x5b, 0x09, 0x7c, 0xf2, 0x2e, 0x75, 0x3d, 0x66, 0x90, 0x7b, 0x01, 0x61, 0xbe,
-	0xfa, 0x2d, 0x82, 0xcc, 0x50, 0x17, 0xd6, 0xa0, 0x1e, 0x23, 0xd8, 0x82, 0xe9, 0x10, 0x9d, 0x2d,
-	0xa0, 0xec, 0x64, 0xee, 0xf9, 0xc2, 0xa2, 0x26, 0xe0, 0xb5, 0x10, 0x5e, 0x93, 0xd8, 0x5a, 0x18,
-	0x52, 0x5a, 0x7d, 0xb4, 0x9f, 0x99, 0xf8, 0xe9, 0x49, 0x26, 0xe7, 0xb8, 0xfe, 0xdd, 0xa0, 0xa2,
-	0x55, 0x69, 0x5d, 0x97, 0x9d, 0x8a, 0x8f, 0x15, 0x66, 0x6f, 0xea, 0x7e, 0xab, 0x41, 0x98, 0x26,
-	0x6a, 0x88, 0xcc, 0x6a, 0x06, 0x2e, 0x09, 0x8a, 0x0e, 0x83, 0xdd, 0xc3, 0xf9, 0x0d, 0x8a, 0x5a,
-	0x19, 0xf4, 0x38, 0x3e, 0x4c, 0x15, 0xce, 0xdd, 0x0a, 0x27, 0x5f, 0x6a, 0xdd, 0x2e, 0x4b, 0x32,
-	0x3c, 0x0b, 0x29, 0xd7, 0x5e, 0x40, 0x59, 0x94, 0x9b, 0x32, 0x52, 0xae, 0xad, 0x96, 0x61, 0xae,
-	0xcb, 0x47, 0xb2, 0xe9, 0x30, 0xcd, 0xbf, 0x32, 0xee, 0x17, 0xb2, 0x0d, 0xde, 0x43, 0x8d, 0x47,
-	0x19, 0xc2, 0x4f, 0xfd, 0x0c, 0xce, 0xf2, 0x73, 0x34, 0x00, 0xfc, 0x1e, 0x40, 0xe7, 0x66, 0xc8,
-	0x34, 0x4b, 0x3d, 0x2d, 0x8a, 0x7b, 0x1e, 0x35, 0x7a, 0xc7, 0x72, 0x88, 0x8c, 0x35, 0xba, 0x22,
-	0xd5, 0xfb, 0x08, 0x66, 0xa3, 0xcc, 0x12, 0xae, 0x08, 0x53, 0xb6, 0xe5, 0x5b, 0xed, 0xb9, 0x0d,
-	0x63, 0x2b, 0x4d, 0x85, 0x73, 0x33, 0xb8, 0x33, 0xbe, 0xd5, 0xc3, 0x93, 0xe2, 0x3c, 0x57, 0x47,
-	0xf2, 0x88, 0x8a, 0x3d, 0x40, 0x5f, 0xc0, 0x8b, 0xef, 0x54, 0xc3, 0x2a, 0xcf, 0xa6, 0xdf, 0x3d,
-	0x04, 0xf3, 0xbd, 0xf9, 0x4f, 0x44, 0xd7, 0x3b, 0x70, 0xa1, 0x9b, 0xea, 0x0e, 0x69, 0x96, 0x89,
-	0x47, 0xeb, 0x51, 0xf7, 0xf3, 0x30, 0x6d, 0x87, 0x67, 0xde, 0xf8, 0x8c, 0x21, 0x0e, 0x7d, 0x33,
-	0x49, 0xfd, 0xe7, 0x99, 0x3c, 0x44, 0x70, 0x31, 0xbe, 0xfa, 0x89, 0x98, 0x8d, 0x09, 0x2f, 0x7d,
-	0xda, 0xa8, 0xd2, 0xba, 0xeb, 0x39, 0xcf, 0xe6, 0x4e, 0x3c, 0x40, 0x70, 0xbe, 0xbf, 0xc2, 0x89,
-	0xe8, 0x7c, 0x17, 0x2e, 0xf5, 0x72, 0x1d, 0xef, 0xbd, 0xf8, 0x05, 0x81, 0x32, 0xac, 0xbe, 0x9c,
-	0xcf, 0xfb, 0xf0, 0x42, 0x20, 0x3d, 0x4c, 0xfe, 0x52, 0xb1, 0x71, 0x47, 0x35, 0x1b, 0xf4, 0x64,
-	0xfe, 0xff, 0x86, 0xc6, 0x60, 0xce, 0x20, 0xdb, 0x56, 0xd3, 0x66, 0x37, 0x99, 0x1f, 0x0d, 0x6a,
-	0x09, 0xa6, 0xe9, 0xb6, 0x47, 0x9a, 0x62, 0x50, 0xa5, 0x73, 0x4f, 0xf7, 0x33, 0x67, 0x5a, 0x56,
-	0xbd, 0xf6, 0xba, 0xca, 0xff, 0xad, 0x1a, 0xc2, 0x8c, 0x17, 0xe1, 0x74, 0xa8, 0x97, 0xa6, 0x6b,
-	0xb3, 0x85, 0x54, 0x76, 0x32, 0x37, 0x65, 0x3c, 0x17, 0x9e, 0x6f, 0xdb, 0x0c, 0x5f, 0x80, 0x19,
-	0xe2, 0xd9, 0x26, 0x69, 0xd0, 0xea, 0xdd, 0x85, 0xc9, 0x2c, 0xca, 0x4d, 0x1a, 0xa7, 0x89, 0x67,
-	0xdf, 0x0c, 0xcf, 0xea, 0x36, 0xe0, 0xee, 0xa2, 0xc7, 0xaa, 0x94, 0x1f, 0x87, 0x73, 0xf9, 0x80,
-	0x56, 0x37, 0xad, 0x4a, 0x8d, 0x94, 0xe5, 0xe2, 0xd1, 0x56, 0xca, 0xef, 0x11, 0x28, 0xc3, 0x3c,
-	0x24, 0x26, 0x05, 0x5c, 0x93, 0x46, 0x33, 0x5a, 0x5c, 0x3a, 0xcc, 0x62, 0xb5, 0xd1, 0xa2, 0xd5,
-	0x46, 0x8b, 0xe2, 0x4b, 0x57, 0x42, 0xe6, 0xa7, 0xfb, 0x99, 0x45, 0x31, 0xc8, 0xc1, 0x14, 0xea,
-	0x8f, 0x4f, 0x32, 0xc8, 0x98, 0xab, 0xf5, 0x17, 0x2e, 0x3c, 0x38, 0x0b, 0xd3, 0x9c, 0x09, 0xff,
-	0x81, 0xe0, 0xe5, 0x21, 0xfb, 0x06, 0x2e, 0xc4, 0x5d, 0xa1, 0xe4, 0xfd, 0x25, 0x5d, 0x3c, 0x52,
-	0x8c, 0xe8, 0x5f, 0x7d, 0xfb, 0xeb, 0x3f, 0xff, 0xf9, 0x21, 0xf5, 0x1a, 0x5e, 0xd7, 0x63, 0x56,
-	0xab, 0x68, 0x0f, 0xab, 0xf3, 0x24, 0xa6, 0x4f, 0x4d, 0xbb, 0x9d, 0xc6, 0xe4, 0xdf, 0x01, 0xfe,
-	0x0d, 0xc1, 0xf9, 0xf8, 0x65, 0x04, 0xe7, 0x87, 0xf3, 0x0c, 0x59, 0x6d, 0xd2, 0x85, 0xa3, 0x84,
-	0xc8, 0x0e, 0xde, 0xe4, 0x1d, 0xac, 0xe3, 0x1b, 0x63, 0x74, 0xd0, 0xc1, 0xb7, 0x25, 0xff, 0x7d,
-	0x04, 0x33, 0xed, 0x1d, 0x05, 0x5f, 0x1e, 0xfe, 0xcb, 0xed, 0xac, 0x39, 0xe9, 0x2b, 0x23, 0xbc,
-	0x24, 0xd8, 0x0d, 0x0e, 0xa6, 0xe1, 0x57, 0x93, 0xc0, 0xf8, 0xc3, 0x61, 0x56, 0x5a, 0xa6, 0x6b,
-	0xeb, 0x3b, 0xae, 0xbd, 0x8b, 0x77, 0xe0, 0x94, 0x7c, 0x15, 0x5e, 0x19, 0x5a, 0xa6, 0x3d, 0x2f,
-	0x35, 0xc9, 0x45, 0x62, 0x2c, 0x73, 0x8c, 0xcb, 0x58, 0x1d, 0x89, 0xc1, 0xf0, 0x1e, 0x82, 0x33,
-	0xdd, 0x6a, 0x88, 0xaf, 0xc6, 0x15, 0x88, 0xd9, 0x51, 0xd2, 0xb9, 0xd1, 0x8e, 0x92, 0x27, 0xcf,
-	0x79, 0xae, 0xe3, 0x6b, 0x49, 0x3c, 0x16, 0x8f, 0x94, 0xcf, 0x2a, 0xfe, 0xb5, 0x6f, 0x71, 0x89,
-	0x9e, 0x62, 0xac, 0x8f, 0xaa, 0xda, 0x27, 0x1a, 0xe9, 0xd5, 0xf1, 0x03, 0x24, 0xee, 0x1b, 0x1c,
-	0x77, 0x0d, 0x17, 0xc7, 0xc6, 0x35, 0x1b, 0xa4, 0x69, 0x0a, 0x35, 0x7a, 0x88, 0x60, 0xb6, 0x57,
-	0x45, 0xf0, 0xb5, 0x38, 0x82, 0x58, 0x8d, 0x4f, 0x2f, 0x8f, 0xe3, 0x2a, 0x31, 0x8b, 0x1c, 0x73,
-	0x05, 0x5f, 0x4f, 0xc2, 0xec, 0x93, 0x2b, 0xfc, 0xfb, 0x80, 0xf8, 0xb7, 0x27, 0x9b, 0x1f, 0x5d,
-	0xbb, 0x7f, 0xb6, 0x85, 0xa3, 0x84, 0x48, 0xec, 0xb7, 0x38, 0xf6, 0x06, 0x5e, 0x3b, 0x02, 0x76,
-	0xd7, 0x7c, 0xf7, 0x10, 0x40, 0x47, 0x7b, 0x70, 0xec, 0x0f, 0x73, 0x40, 0x10, 0xd3, 0x4b, 0xa3,
-	0xdc, 0x24, 0xdc, 0x06, 0x87, 0xcb, 0x63, 0x3d, 0x09, 0xae, 0x29, 0xe2, 0x4c, 0xc2, 0x7c, 0x7d,
-	0x87, 0x0b, 0xe9, 0x2e, 0xfe, 0x19, 0xc1, 0xdc, 0x80, 0xe4, 0xc4, 0x8f, 0x34, 0x51, 0xc0, 0xe2,
-	0x47, 0x9a, 0xac, 0x68, 0xea, 0x3a, 0xa7, 0x5e, 0xc5, 0x5a, 0x12, 0xf5, 0xa0, 0x60, 0x95, 0x3e,
-	0x7a, 0x74, 0xa0, 0xa0, 0xc7, 0x07, 0x0a, 0xfa, 0xfb, 0x40, 0x41, 0xdf, 0x1d, 0x2a, 0x13, 0x8f,
-	0x0f, 0x95, 0x89, 0xbf, 0x0e, 0x95, 0x89, 0xcf, 0xd7, 0xba, 0x84, 0x59, 0xe6, 0x5c, 0xa9, 0x59,
-	0x15, 0xd6, 0x2e, 0xb0, 0xb5, 0xa1, 0x7f, 0xd5, 0x5d, 0x85, 0x6b, 0x75, 0xe5, 0x14, 0x97, 0xcd,
-	0xe2, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x54, 0x04, 0x4d, 0xb2, 0x89, 0x10, 0x00, 0x00,
+	// 1238 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0x33, 0x89, 0xd3, 0x36, 0x8f, 0x36, 0x24, 0x43, 0x28, 0x89, 0x93, 0xac, 0xc3, 0xaa,
+	0x4d, 0xdd, 0x94, 0xec, 0xc6, 0x76, 0x93, 0xf0, 0x5b, 0x22, 0xa4, 0x2d, 0x91, 0x40, 0x84, 0x15,
+	0x08, 0x81, 0x84, 0x56, 0x6b, 0xef, 0xe0, 0xae, 0x62, 0xef, 0xb8, 0x9e, 0xdd, 0xa4, 0x56, 0x94,
+	0x0b, 0x70, 0xae, 0x40, 0x44, 0x88, 0x43, 0xff, 0x02, 0x8e, 0x80, 0xb8, 0xc1, 0x81, 0x53, 0x2f,
+	0x48, 0x95, 0xb8, 0x70, 0x4a, 0x51, 0xc2, 0x91, 0x53, 0xff, 0x02, 0xb4, 0x33, 0xb3, 0xfe, 0xb9,
+	0x6b, 0x3b, 0xa8, 0x8d, 0x72, 0xb2, 0xc7, 0xef, 0xbd, 0x79, 0x9f, 0xf7, 0xf6, 0x79, 0xdf, 0x17,
+	0x14, 0xca, 0xca, 0x94, 0x39, 0x4c, 0x77, 0xdc, 0x02, 0x71, 0x3d, 0x67, 0x9b, 0x30, 0xfd, 0x8e,
+	0x4f, 0xaa, 0x35, 0xad, 0x52, 0xa5, 0x1e, 0xc5, 0x58, 0xda, 0xb5, 0x86, 0x3d, 0x39, 0x51, 0xa4,
+	0x45, 0xca, 0xcd, 0x7a, 0xf0, 0x4d, 0x78, 0x26, 0x67, 0x8a, 0x94, 0x16, 0x4b, 0x44, 0xb7, 0x2a,
+	0x8e, 0x6e, 0xb9, 0x2e, 0xf5, 0x2c, 0xcf, 0xa1, 0x2e, 0x93, 0x56, 0x45, 0x5a, 0xf9, 0x29, 0xef,
+	0x7f, 0xae, 0xdb, 0x7e, 0x95, 0x3b, 0x84, 0xf6, 0x02, 0x4f, 0xa4, 0xe7, 0x2d, 0x46, 0xf4, 0xed,
+	0x4c, 0x9e, 0x78, 0x56, 0x46, 0x2f, 0x50, 0x27, 0xb4, 0x2f, 0x34, 0xdb, 0x39, 0x60, 0xdd, 0xab,
+	0x62, 0x15, 0x1d, 0xb7, 0xe5, 0xae, 0x88, 0x9a, 0x8a, 0x96, 0x5f, 0x24, 0xd2, 0x3e, 0x15, 0xda,
+	0x4b, 0xb4, 0xb0, 0xe5, 0x57, 0xf8, 0x87, 0x30, 0xa9, 0x73, 0xa0, 0xbc, 0x47, 0x6d, 0xbf, 0x44,
+	0x3e, 0xa4, 0xeb, 0x0e, 0xf3, 0xaa, 0x4e, 0xde, 0xf7, 0xc8, 0xdb, 0xd4, 0x71, 0x99, 0x41, 0xee,
+	0xf8, 0x84, 0x79, 0xea, 0x57, 0x08, 0x52, 0xb1, 0x2e, 0xac, 0x42, 0x5d, 0x46, 0xb0, 0x05, 0xc3,
+	0x01, 0x3a, 0x9b, 0x44, 0x73, 0x43, 0xe9, 0x67, 0xb2, 0x53, 0x9a, 0x80, 0xd7, 0x02, 0x78, 0x4d,
+	0x62, 0x6b, 0x41, 0xc8, 0xda, 0xd2, 0x83, 0x83, 0xd4, 0xc0, 0x0f, 0x8f, 0x52, 0xe9, 0xa2, 0xe3,
+	0xdd, 0xf6, 0xf3, 0x5a, 0x81, 0x96, 0x75, 0x59, 0xa9, 0xf8, 0x58, 0x64, 0xf6, 0x96, 0xee, 0xd5,
+	0x2a, 0x84, 0x69, 0x22, 0x87, 0xb8, 0x59, 0x4d, 0xc1, 0xac, 0xa0, 0x68, 0x30, 0xd8, 0x2d, 0x9c,
+	0x5f, 0x22, 0x50, 0xe2, 0x3c, 0x4e, 0x0e, 0x53, 0x85, 0xb1, 0x5b, 0x41, 0xe7, 0xd7, 0x6a, 0x1b,
+	0xeb, 0x92, 0x0c, 0x8f, 0xc2, 0xa0, 0x63, 0x4f, 0xa2, 0x39, 0x94, 0x4e, 0x18, 0x83, 0x8e, 0xad,
+	0xae, 0xc3, 0x78, 0x93, 0x8f, 0x64, 0xd3, 0x61, 0x98, 0x3f, 0x32, 0xee, 0x17, 0xb0, 0x75, 0xce,
+	0xa1, 0xc6, 0xa3, 0x0c, 0xe1, 0xa7, 0x7e, 0x0c, 0x17, 0xf8, 0x39, 0x6c, 0x00, 0xbe, 0x09, 0xd0,
+	0x98, 0x0c, 0x79, 0xcd, 0x7c, 0x4b, 0x89, 0x62, 0xce, 0xc3, 0x42, 0x37, 0xad, 0x22, 0x91, 0xb1,
+	0x46, 0x53, 0xa4, 0x7a, 0x0f, 0xc1, 0x68, 0x78, 0xb3, 0x84, 0xcb, 0x41, 0xc2, 0xb6, 0x3c, 0xab,
+	0xde, 0xb7, 0x38, 0xb6, 0xb5, 0x44, 0xd0, 0x37, 0x83, 0x3b, 0xe3, 0x5b, 0x2d, 0x3c, 0x83, 0x9c,
+	0xe7, 0x4a, 0x4f, 0x1e, 0x91, 0xb1, 0x05, 0xe8, 0x33, 0x78, 0xee, 0xad, 0x42, 0x90, 0xe5, 0xe9,
+	0xd4, 0xbb, 0x8f, 0x60, 0xa2, 0xf5, 0xfe, 0x53, 0x51, 0xf5, 0x2e, 0x4c, 0x37, 0x53, 0x6d, 0x92,
+	0xea, 0x3a, 0x71, 0x69, 0x39, 0xac, 0x7e, 0x02, 0x86, 0xed, 0xe0, 0xcc, 0x0b, 0x1f, 0x31, 0xc4,
+	0x01, 0xdf, 0x8c, 0xc8, 0xfe, 0x7f, 0x7a, 0x72, 0x1f, 0xc1, 0x4c, 0x74, 0xf6, 0x53, 0xd1, 0x1b,
+	0x13, 0x9e, 0xff, 0xa8, 0x52, 0xa0, 0x65, 0xc7, 0x2d, 0x3e, 0x9d, 0x99, 0xf8, 0x0e, 0xc1, 0xc5,
+	0xf6, 0x0c, 0xa7, 0xa2, 0xf2, 0x3d, 0x98, 0x6d, 0xe5, 0x3a, 0xd9, 0xb9, 0xf8, 0x09, 0x81, 0x12,
+	0x97, 0x5f, 0xf6, 0xe7, 0x1d, 0x78, 0xd6, 0x97, 0x1e, 0x26, 0x7f, 0x53, 0xb1, 0x7e, 0x5b, 0x35,
+	0xea, 0xb7, 0xdc, 0xfc, 0xe4, 0x9a, 0xc6, 0x60, 0xdc, 0x20, 0x3b, 0x56, 0xd5, 0x66, 0x37, 0x98,
+	0x17, 0x36, 0x6a, 0x1e, 0x86, 0xe9, 0x8e, 0x4b, 0xaa, 0xa2, 0x51, 0x6b, 0x63, 0x8f, 0x0f, 0x52,
+	0xe7, 0x6b, 0x56, 0xb9, 0xf4, 0xaa, 0xca, 0x7f, 0x56, 0x0d, 0x61, 0xc6, 0x53, 0x70, 0x2e, 0xd8,
+	0x97, 0xa6, 0x63, 0xb3, 0xc9, 0xc1, 0xb9, 0xa1, 0x74, 0xc2, 0x38, 0x1b, 0x9c, 0x37, 0x6c, 0x86,
+	0xa7, 0x61, 0x84, 0xb8, 0xb6, 0x49, 0x2a, 0xb4, 0x70, 0x7b, 0x72, 0x68, 0x0e, 0xa5, 0x87, 0x8c,
+	0x73, 0xc4, 0xb5, 0x6f, 0x04, 0x67, 0x75, 0x07, 0x70, 0x73, 0xd2, 0x13, 0xdd, 0x94, 0x1f, 0x04,
+	0x7d, 0x79, 0x97, 0x16, 0xb6, 0xac, 0x7c, 0x89, 0xac, 0x4b, 0xe1, 0x51, 0xdf, 0x94, 0xdf, 0x20,
+	0x50, 0xe2, 0x3c, 0x24, 0x26, 0x05, 0x5c, 0x92, 0x46, 0x33, 0x14, 0x2e, 0x0d, 0x66, 0x21, 0x6d,
+	0xb4, 0x50, 0xda, 0x68, 0x61, 0xfc, 0xda, 0xe5, 0x80, 0xf9, 0xf1, 0x41, 0x6a, 0x4a, 0x34, 0xb2,
+	0xf3, 0x0a, 0xf5, 0xfb, 0x47, 0x29, 0x64, 0x8c, 0x97, 0xda, 0x13, 0xab, 0xb3, 0x30, 0x5d, 0x5f,
+	0xdb, 0x0e, 0x75, 0x37, 0xab, 0xb4, 0x58, 0x25, 0xac, 0x8e, 0x5c, 0x83, 0x99, 0x68, 0xb3, 0xe4,
+	0xfd, 0x04, 0x2e, 0xd8, 0x4d, 0xf6, 0x10, 0x75, 0x31, 0x76, 0xe4, 0xa2, 0x6e, 0x93, 0x63, 0xd8,
+	0x7a, 0x93, 0xfa, 0x2f, 0x82, 0xa9, 0xd8, 0x90, 0x60, 0x3a, 0xf8, 0x90, 0x9b, 0xf5, 0x0d, 0x7f,
+	0x96, 0x9f, 0x37, 0xec, 0x60, 0x3a, 0x5c, 0xbf, 0x6c, 0x06, 0xb5, 0x32, 0x3e, 0xbd, 0x09, 0xe3,
+	0x9c, 0xeb, 0x97, 0x83, 0xa6, 0x33, 0x3c, 0x1b, 0xcc, 0xb6, 0x63, 0x4b, 0xeb, 0x10, 0xb7, 0x8e,
+	0x04, 0xbf, 0x08, 0xf3, 0x5d, 0x18, 0xb7, 0x1b, 0x2a, 0xc6, 0x14, 0x23, 0x93, 0x78, 0xf2, 0x23,
+	0x33, 0x66, 0xb7, 0x69, 0xa5, 0xec, 0x1f, 0xa3, 0x30, 0xcc, 0x87, 0x03, 0xff, 0x8e, 0xe0, 0x85,
+	0x18, 0xe1, 0x87, 0xb3, 0x51, 0x8d, 0xed, 0x2e, 0x24, 0x93, 0xb9, 0x63, 0xc5, 0x88, 0x07, 0xab,
+	0xbe, 0xf9, 0xc5, 0x9f, 0xff, 0x7c, 0x3b, 0xf8, 0x32, 0x5e, 0xd1, 0x23, 0x34, 0x6e, 0x28, 0x88,
+	0xcb, 0xfc, 0x12, 0xd3, 0xa3, 0x66, 0xa3, 0x1c, 0xd1, 0x33, 0xfc, 0x2b, 0x82, 0x8b, 0xd1, 0xaa,
+	0x10, 0x67, 0xe2, 0x79, 0x62, 0x34, 0x66, 0x32, 0x7b, 0x9c, 0x10, 0x59, 0xc1, 0xeb, 0xbc, 0x82,
+	0x15, 0x7c, 0xbd, 0x8f, 0x0a, 0x3a, 0x9e, 0x39, 0xbe, 0x87, 0x60, 0xa4, 0x2e, 0x16, 0xf1, 0xa5,
+	0xf8, 0x57, 0x68, 0x43, 0x6f, 0x26, 0x2f, 0xf7, 0xf0, 0x92, 0x60, 0xd7, 0x39, 0x98, 0x86, 0x5f,
+	0xea, 0x06, 0x26, 0x86, 0x3b, 0x5f, 0x33, 0x1d, 0x5b, 0xdf, 0x75, 0xec, 0x3d, 0xbc, 0x0b, 0x67,
+	0xe4, 0xeb, 0xf9, 0xc5, 0xd8, 0x34, 0xf5, 0x7e, 0xa9, 0xdd, 0x5c, 0x24, 0xc6, 0x02, 0xc7, 0xb8,
+	0x84, 0xd5, 0x9e, 0x18, 0x0c, 0xef, 0x23, 0x38, 0xdf, 0x2c, 0x4b, 0xf0, 0x95, 0xa8, 0x04, 0x11,
+	0x62, 0x31, 0x99, 0xee, 0xed, 0x28, 0x79, 0x32, 0x9c, 0xe7, 0x1a, 0xbe, 0xda, 0x8d, 0xc7, 0xe2,
+	0x91, 0x72, 0xbf, 0xe1, 0x5f, 0xda, 0x14, 0x64, 0xb8, 0x13, 0xb1, 0xde, 0x2b, 0x6b, 0xdb, 0xf6,
+	0x4e, 0x2e, 0xf5, 0x1f, 0x20, 0x71, 0x5f, 0xe3, 0xb8, 0xcb, 0x38, 0xd7, 0x37, 0xae, 0x59, 0x21,
+	0x55, 0x53, 0xc8, 0x82, 0xfb, 0x08, 0x46, 0x5b, 0xd7, 0x39, 0xbe, 0x1a, 0x45, 0x10, 0x29, 0xb6,
+	0x92, 0x0b, 0xfd, 0xb8, 0x4a, 0xcc, 0x1c, 0xc7, 0x5c, 0xc4, 0xd7, 0xba, 0x61, 0xb6, 0xe9, 0x06,
+	0xfc, 0x5b, 0x87, 0x0a, 0xab, 0x77, 0x36, 0xd3, 0x3b, 0x77, 0x7b, 0x6f, 0xb3, 0xc7, 0x09, 0x91,
+	0xd8, 0x6f, 0x70, 0xec, 0x55, 0xbc, 0x7c, 0x0c, 0xec, 0xa6, 0xfe, 0xee, 0x23, 0x80, 0x86, 0x08,
+	0xc0, 0x91, 0x7f, 0xcc, 0x0e, 0x65, 0x92, 0x9c, 0xef, 0xe5, 0x26, 0xe1, 0x56, 0x39, 0x5c, 0x06,
+	0xeb, 0xdd, 0xe0, 0xaa, 0x22, 0xce, 0x24, 0xcc, 0xd3, 0x77, 0xb9, 0xa2, 0xd9, 0xc3, 0x3f, 0x22,
+	0x18, 0xef, 0xd8, 0xfd, 0xd1, 0x2d, 0xed, 0xaa, 0x24, 0x92, 0xd9, 0xe3, 0x84, 0x48, 0xea, 0x15,
+	0x4e, 0xbd, 0x84, 0xb5, 0x6e, 0xd4, 0x9d, 0xca, 0x01, 0xff, 0x8c, 0x60, 0x22, 0x72, 0x05, 0x47,
+	0xfe, 0xc9, 0xba, 0x88, 0x89, 0xe4, 0x52, 0xff, 0x01, 0x92, 0xf9, 0x15, 0xce, 0x9c, 0xc3, 0x99,
+	0x6e, 0xcc, 0xcd, 0xb2, 0xc1, 0xac, 0x84, 0x9a, 0xe2, 0xfd, 0x07, 0x87, 0x0a, 0x7a, 0x78, 0xa8,
+	0xa0, 0xbf, 0x0f, 0x15, 0xf4, 0xf5, 0x91, 0x32, 0xf0, 0xf0, 0x48, 0x19, 0xf8, 0xeb, 0x48, 0x19,
+	0xf8, 0x74, 0xb9, 0x69, 0x4b, 0xcb, 0x6b, 0x17, 0x4b, 0x56, 0x9e, 0xd5, 0x73, 0x6c, 0xaf, 0xea,
+	0x77, 0x9b, 0x13, 0xf1, 0xc5, 0x9d, 0x3f, 0xc3, 0x65, 0x57, 0xee, 0xbf, 0x01, 0x00, 0xf9, 0x41,
+	0x88, 0xf3, 0xc9, 0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1105,6 +1270,8 @@ type QueryClient interface {
 	RewardsEst(ctx context.Context, in *RewardsEstRequest, opts ...grpc.CallOption) (*RewardsEstResponse, error)
 	// returns lockable durations that are valid to give incentives
 	LockableDurations(ctx context.Context, in *QueryLockableDurationsRequest, opts ...grpc.CallOption) (*QueryLockableDurationsResponse, error)
+	// returns the progress of the distributions still being paid out
+	DistributionProgress(ctx context.Context, in *DistributionProgressRequest, opts ...grpc.CallOption) (*DistributionProgressResponse, error)
 }
 
 type queryClient struct {
@@ -1205,6 +1372,15 @@ func (c *queryClient) LockableDurations(ctx context.Context, in *QueryLockableDu
 	return out, nil
 }
 
+func (c *queryClient) DistributionProgress(ctx context.Context, in *DistributionProgressRequest, opts ...grpc.CallOption) (*DistributionProgressResponse, error) {
+	out := new(DistributionProgressResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.incentives.Query/DistributionProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// returns coins that is going to be distributed
@@ -1229,6 +1405,8 @@ type QueryServer interface {
 	RewardsEst(context.Context, *RewardsEstRequest) (*RewardsEstResponse, error)
 	// returns lockable durations that are valid to give incentives
 	LockableDurations(context.Context, *QueryLockableDurationsRequest) (*QueryLockableDurationsResponse, error)
+	// returns the progress of the distributions still being paid out
+	DistributionProgress(context.Context, *DistributionProgressRequest) (*DistributionProgressResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1265,6 +1443,9 @@ func (*UnimplementedQueryServer) RewardsEst(ctx context.Context, req *RewardsEst
 func (*UnimplementedQueryServer) LockableDurations(ctx context.Context, req *QueryLockableDurationsRequest) (*QueryLockableDurationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LockableDurations not implemented")
 }
+func (*UnimplementedQueryServer) DistributionProgress(ctx context.Context, req *DistributionProgressRequest) (*DistributionProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DistributionProgress not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1450,6 +1631,24 @@ func _Query_LockableDurations_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DistributionProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DistributionProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DistributionProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.incentives.Query/DistributionProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DistributionProgress(ctx, req.(*DistributionProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.incentives.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1494,6 +1693,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LockableDurations",
 			Handler:    _Query_LockableDurations_Handler,
 		},
+		{
+			MethodName: "DistributionProgress",
+			Handler:    _Query_DistributionProgress_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/incentives/query.proto",
@@ -2264,6 +2467,118 @@ func (m *QueryLockableDurationsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *DistributionProgressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionProgressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionProgressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *DistributionProgressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionProgressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionProgressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Distributions) > 0 {
+		for iNdEx := len(m.Distributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Distributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GaugeDistributionProgress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GaugeDistributionProgress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GaugeDistributionProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DistributedCoins) > 0 {
+		for iNdEx := len(m.DistributedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.PaidLocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PaidLocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NumLocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumLocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.GaugeId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GaugeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2578,6 +2893,54 @@ func (m *QueryLockableDurationsResponse) Size() (n int) {
 	return n
 }
 
+func (m *DistributionProgressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DistributionProgressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Distributions) > 0 {
+		for _, e := range m.Distributions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GaugeDistributionProgress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GaugeId != 0 {
+		n += 1 + sovQuery(uint64(m.GaugeId))
+	}
+	if m.NumLocks != 0 {
+		n += 1 + sovQuery(uint64(m.NumLocks))
+	}
+	if m.PaidLocks != 0 {
+		n += 1 + sovQuery(uint64(m.PaidLocks))
+	}
+	if len(m.DistributedCoins) > 0 {
+		for _, e := range m.DistributedCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4496,6 +4859,281 @@ func (m *QueryLockableDurationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DistributionProgressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributionProgressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributionProgressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DistributionProgressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributionProgressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributionProgressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distributions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Distributions = append(m.Distributions, GaugeDistributionProgress{})
+			if err := m.Distributions[len(m.Distributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GaugeDistributionProgress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GaugeDistributionProgress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GaugeDistributionProgress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeId", wireType)
+			}
+			m.GaugeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GaugeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumLocks", wireType)
+			}
+			m.NumLocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumLocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaidLocks", wireType)
+			}
+			m.PaidLocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PaidLocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributedCoins = append(m.DistributedCoins, types.Coin{})
+			if err := m.DistributedCoins[len(m.DistributedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -257,55 +257,263 @@ func (m *MsgAddToGaugeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAddToGaugeResponse proto.InternalMessageInfo
 
+// MsgClaimRewards pays the owner of locks the rewards they accrued. All the
+// owner's locks are claimed if lock_ids is empty.
+type MsgClaimRewards struct {
+	Owner   string   `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	LockIds []uint64 `protobuf:"varint,2,rep,packed,name=lock_ids,json=lockIds,proto3" json:"lock_ids,omitempty"`
+}
+
+func (m *MsgClaimRewards) Reset()         { *m = MsgClaimRewards{} }
+func (m *MsgClaimRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimRewards) ProtoMessage()    {}
+func (*MsgClaimRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ea120e22291556e, []int{4}
+}
+func (m *MsgClaimRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimRewards.Merge(m, src)
+}
+func (m *MsgClaimRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimRewards proto.InternalMessageInfo
+
+func (m *MsgClaimRewards) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgClaimRewards) GetLockIds() []uint64 {
+	if m != nil {
+		return m.LockIds
+	}
+	return nil
+}
+
+type MsgClaimRewardsResponse struct {
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+}
+
+func (m *MsgClaimRewardsResponse) Reset()         { *m = MsgClaimRewardsResponse{} }
+func (m *MsgClaimRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimRewardsResponse) ProtoMessage()    {}
+func (*MsgClaimRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ea120e22291556e, []int{5}
+}
+func (m *MsgClaimRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimRewardsResponse.Merge(m, src)
+}
+func (m *MsgClaimRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimRewardsResponse proto.InternalMessageInfo
+
+func (m *MsgClaimRewardsResponse) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+// MsgCancelGauge stops a gauge created by its owner, and refunds the coins it
+// has not distributed to the accounts that added them, pro rata. A finished
+// gauge can be cancelled to refund what it did not distribute.
+type MsgCancelGauge struct {
+	Owner   string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	GaugeId uint64 `protobuf:"varint,2,opt,name=gauge_id,json=gaugeId,proto3" json:"gauge_id,omitempty"`
+}
+
+func (m *MsgCancelGauge) Reset()         { *m = MsgCancelGauge{} }
+func (m *MsgCancelGauge) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelGauge) ProtoMessage()    {}
+func (*MsgCancelGauge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ea120e22291556e, []int{6}
+}
+func (m *MsgCancelGauge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelGauge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelGauge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelGauge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelGauge.Merge(m, src)
+}
+func (m *MsgCancelGauge) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelGauge) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelGauge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelGauge proto.InternalMessageInfo
+
+func (m *MsgCancelGauge) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgCancelGauge) GetGaugeId() uint64 {
+	if m != nil {
+		return m.GaugeId
+	}
+	return 0
+}
+
+type MsgCancelGaugeResponse struct {
+	// coins refunded to the owner
+	Reclaimed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=reclaimed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reclaimed"`
+}
+
+func (m *MsgCancelGaugeResponse) Reset()         { *m = MsgCancelGaugeResponse{} }
+func (m *MsgCancelGaugeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelGaugeResponse) ProtoMessage()    {}
+func (*MsgCancelGaugeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ea120e22291556e, []int{7}
+}
+func (m *MsgCancelGaugeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelGaugeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelGaugeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelGaugeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelGaugeResponse.Merge(m, src)
+}
+func (m *MsgCancelGaugeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelGaugeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelGaugeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelGaugeResponse proto.InternalMessageInfo
+
+func (m *MsgCancelGaugeResponse) GetReclaimed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Reclaimed
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgCreateGauge)(nil), "osmosis.incentives.MsgCreateGauge")
 	proto.RegisterType((*MsgCreateGaugeResponse)(nil), "osmosis.incentives.MsgCreateGaugeResponse")
 	proto.RegisterType((*MsgAddToGauge)(nil), "osmosis.incentives.MsgAddToGauge")
 	proto.RegisterType((*MsgAddToGaugeResponse)(nil), "osmosis.incentives.MsgAddToGaugeResponse")
+	proto.RegisterType((*MsgClaimRewards)(nil), "osmosis.incentives.MsgClaimRewards")
+	proto.RegisterType((*MsgClaimRewardsResponse)(nil), "osmosis.incentives.MsgClaimRewardsResponse")
+	proto.RegisterType((*MsgCancelGauge)(nil), "osmosis.incentives.MsgCancelGauge")
+	proto.RegisterType((*MsgCancelGaugeResponse)(nil), "osmosis.incentives.MsgCancelGaugeResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/incentives/tx.proto", fileDescriptor_8ea120e22291556e) }
 
 var fileDescriptor_8ea120e22291556e = []byte{
-	// 596 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0xcd, 0x7c, 0x49, 0xff, 0x26, 0xed, 0xa7, 0x62, 0x15, 0x70, 0x03, 0xb2, 0x53, 0x2f, 0x90,
-	0x41, 0xea, 0x0c, 0x2d, 0x42, 0x48, 0xec, 0x48, 0x84, 0x50, 0x17, 0x51, 0x8b, 0x15, 0x09, 0xa9,
-	0x12, 0xb2, 0xc6, 0xf6, 0xe0, 0x8e, 0x1a, 0x7b, 0x2c, 0xcf, 0x38, 0x6d, 0xde, 0xa2, 0x12, 0x6f,
-	0xc1, 0x1b, 0xb0, 0x63, 0xd9, 0x65, 0x97, 0xac, 0x52, 0x94, 0xbc, 0x41, 0x9f, 0x00, 0x79, 0x6c,
-	0x37, 0x89, 0xf8, 0xe9, 0x86, 0xd5, 0x64, 0xe6, 0x9c, 0x7b, 0xe6, 0xce, 0x39, 0x37, 0x86, 0x8f,
-	0xb8, 0x88, 0xb8, 0x60, 0x02, 0xb3, 0xd8, 0xa7, 0xb1, 0x64, 0x43, 0x2a, 0xb0, 0x3c, 0x47, 0x49,
-	0xca, 0x25, 0xd7, 0xb4, 0x12, 0x44, 0x33, 0xb0, 0xb5, 0x15, 0xf2, 0x90, 0x2b, 0x18, 0xe7, 0xbf,
-	0x0a, 0x66, 0xcb, 0x0c, 0x39, 0x0f, 0x07, 0x14, 0xab, 0x9d, 0x97, 0x7d, 0xc2, 0x92, 0x45, 0x54,
-	0x48, 0x12, 0x25, 0x25, 0xc1, 0xf0, 0x95, 0x16, 0xf6, 0x88, 0xa0, 0x78, 0xb8, 0xe7, 0x51, 0x49,
-	0xf6, 0xb0, 0xcf, 0x59, 0x5c, 0xe1, 0xbf, 0xe9, 0x23, 0x24, 0x59, 0x48, 0x4b, 0x7c, 0xbb, 0xc2,
-	0x07, 0xdc, 0x3f, 0xcd, 0x12, 0xb5, 0x14, 0x90, 0xf5, 0xb9, 0x0e, 0xff, 0xef, 0x89, 0xb0, 0x9b,
-	0x52, 0x22, 0xe9, 0xbb, 0xbc, 0x46, 0xdb, 0x81, 0xeb, 0x4c, 0xb8, 0x09, 0x4d, 0x13, 0x2a, 0x33,
-	0x32, 0xd0, 0x41, 0x1b, 0xd8, 0xab, 0x4e, 0x93, 0x89, 0xa3, 0xea, 0x48, 0x7b, 0x02, 0x97, 0xf8,
-	0x59, 0x4c, 0x53, 0xfd, 0xbf, 0x36, 0xb0, 0xd7, 0x3a, 0x9b, 0x37, 0x63, 0x73, 0x7d, 0x44, 0xa2,
-	0xc1, 0x6b, 0x4b, 0x1d, 0x5b, 0x4e, 0x01, 0x6b, 0x07, 0x70, 0x23, 0x60, 0x42, 0xa6, 0xcc, 0xcb,
-	0x24, 0x75, 0x25, 0xd7, 0xeb, 0x6d, 0x60, 0x37, 0xf7, 0x0d, 0x54, 0x79, 0x53, 0x34, 0x84, 0xde,
-	0x67, 0x34, 0x1d, 0x75, 0x79, 0x1c, 0x30, 0xc9, 0x78, 0xdc, 0x69, 0x5c, 0x8e, 0xcd, 0x9a, 0xb3,
-	0x3e, 0x2b, 0xed, 0x73, 0x8d, 0xc0, 0xa5, 0xfc, 0xc5, 0x42, 0x6f, 0xb4, 0xeb, 0x76, 0x73, 0x7f,
-	0x1b, 0x15, 0x9e, 0xa0, 0xdc, 0x13, 0x54, 0x7a, 0x82, 0xba, 0x9c, 0xc5, 0x9d, 0xe7, 0x79, 0xf5,
-	0x97, 0x6b, 0xd3, 0x0e, 0x99, 0x3c, 0xc9, 0x3c, 0xe4, 0xf3, 0x08, 0x97, 0x06, 0x16, 0xcb, 0xae,
-	0x08, 0x4e, 0xb1, 0x1c, 0x25, 0x54, 0xa8, 0x02, 0xe1, 0x14, 0xca, 0xda, 0x07, 0x08, 0x85, 0x24,
-	0xa9, 0x74, 0x73, 0xff, 0xf5, 0x25, 0xd5, 0x6a, 0x0b, 0x15, 0xe1, 0xa0, 0x2a, 0x1c, 0xd4, 0xaf,
-	0xc2, 0xe9, 0x3c, 0xce, 0x2f, 0xba, 0x19, 0x9b, 0x9b, 0xc5, 0xd3, 0x6f, 0x53, 0xb3, 0x2e, 0xae,
-	0x4d, 0xe0, 0xac, 0x29, 0xad, 0x9c, 0xad, 0x61, 0xb8, 0x15, 0x67, 0x91, 0x4b, 0x13, 0xee, 0x9f,
-	0x08, 0x37, 0x21, 0x2c, 0x70, 0xf9, 0x90, 0xa6, 0xfa, 0x72, 0x1b, 0xd8, 0x0d, 0xe7, 0x5e, 0x9c,
-	0x45, 0x6f, 0x15, 0x74, 0x44, 0x58, 0x70, 0x38, 0xa4, 0xa9, 0xa5, 0xc3, 0x07, 0x8b, 0xa1, 0x38,
-	0x54, 0x24, 0x3c, 0x16, 0xd4, 0xfa, 0x0a, 0xe0, 0x46, 0x4f, 0x84, 0x6f, 0x82, 0xa0, 0xcf, 0x8b,
-	0xb8, 0x6e, 0xb3, 0x00, 0x7f, 0xcf, 0x62, 0x1b, 0xae, 0xaa, 0x99, 0x70, 0x59, 0xa0, 0x62, 0x6b,
-	0x38, 0x2b, 0x6a, 0x7f, 0x10, 0x68, 0x14, 0xae, 0xa4, 0xf4, 0x8c, 0xa4, 0x81, 0xd0, 0xeb, 0xff,
-	0xde, 0xdd, 0x4a, 0xdb, 0x7a, 0x08, 0xef, 0x2f, 0xb4, 0x5e, 0x3d, 0x6a, 0xff, 0x1b, 0x80, 0xf5,
-	0x9e, 0x08, 0xb5, 0x8f, 0xb0, 0x39, 0x3f, 0x88, 0x16, 0xfa, 0xf5, 0x2f, 0x84, 0x16, 0x7d, 0x69,
-	0x3d, 0xbb, 0x9b, 0x53, 0x5d, 0xa3, 0x1d, 0x43, 0x38, 0xe7, 0xdb, 0xce, 0x1f, 0x2a, 0x67, 0x94,
-	0xd6, 0xd3, 0x3b, 0x29, 0x95, 0x76, 0xe7, 0xf0, 0x72, 0x62, 0x80, 0xab, 0x89, 0x01, 0x7e, 0x4c,
-	0x0c, 0x70, 0x31, 0x35, 0x6a, 0x57, 0x53, 0xa3, 0xf6, 0x7d, 0x6a, 0xd4, 0x8e, 0x5f, 0xce, 0x19,
-	0x55, 0xca, 0xed, 0x0e, 0x88, 0x27, 0xaa, 0x0d, 0x1e, 0xbe, 0xc2, 0xe7, 0x0b, 0x5f, 0x90, 0xdc,
-	0x3b, 0x6f, 0x59, 0x0d, 0xdc, 0x8b, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xbc, 0x77, 0xba, 0xf8,
-	0x64, 0x04, 0x00, 0x00,
+	// 703 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xdf, 0x4e, 0xd4, 0x4a,
+	0x1c, 0xde, 0x61, 0x97, 0x7f, 0xb3, 0xcb, 0x39, 0x9c, 0x86, 0x03, 0xa5, 0xe7, 0xa4, 0xbb, 0xd4,
+	0xc4, 0xac, 0x18, 0xa6, 0x82, 0x31, 0x26, 0xde, 0xb9, 0xc4, 0x18, 0x2e, 0x08, 0x58, 0x37, 0x31,
+	0x21, 0x31, 0x75, 0xda, 0x8e, 0x65, 0x42, 0xdb, 0x69, 0x3a, 0xd3, 0x05, 0xee, 0x34, 0xbe, 0x00,
+	0x89, 0x6f, 0xe1, 0x1b, 0xf8, 0x06, 0x5c, 0x72, 0xe9, 0x15, 0x18, 0x78, 0x03, 0x9e, 0xc0, 0x4c,
+	0xbb, 0xed, 0xee, 0xaa, 0x88, 0x26, 0x70, 0xd5, 0x9d, 0xfe, 0xbe, 0xf9, 0xe6, 0xf7, 0x7d, 0xdf,
+	0x6f, 0xa7, 0xf0, 0x3f, 0xc6, 0x43, 0xc6, 0x29, 0x37, 0x69, 0xe4, 0x92, 0x48, 0xd0, 0x1e, 0xe1,
+	0xa6, 0x38, 0x40, 0x71, 0xc2, 0x04, 0x53, 0x94, 0x7e, 0x11, 0x0d, 0x8a, 0xda, 0x9c, 0xcf, 0x7c,
+	0x96, 0x95, 0x4d, 0xf9, 0x2b, 0x47, 0x6a, 0x4d, 0x9f, 0x31, 0x3f, 0x20, 0x66, 0xb6, 0x72, 0xd2,
+	0xb7, 0xa6, 0xa0, 0x21, 0xe1, 0x02, 0x87, 0x71, 0x1f, 0xa0, 0xbb, 0x19, 0x97, 0xe9, 0x60, 0x4e,
+	0xcc, 0xde, 0xaa, 0x43, 0x04, 0x5e, 0x35, 0x5d, 0x46, 0xa3, 0xa2, 0xfe, 0x93, 0x3e, 0x7c, 0x9c,
+	0xfa, 0xa4, 0x5f, 0x5f, 0x2c, 0xea, 0x01, 0x73, 0xf7, 0xd2, 0x38, 0x7b, 0xe4, 0x25, 0xe3, 0x63,
+	0x15, 0xfe, 0xb5, 0xc9, 0xfd, 0xf5, 0x84, 0x60, 0x41, 0x9e, 0xcb, 0x3d, 0xca, 0x12, 0x6c, 0x50,
+	0x6e, 0xc7, 0x24, 0x89, 0x89, 0x48, 0x71, 0xa0, 0x82, 0x16, 0x68, 0x4f, 0x59, 0x75, 0xca, 0xb7,
+	0x8b, 0x57, 0xca, 0x5d, 0x38, 0xce, 0xf6, 0x23, 0x92, 0xa8, 0x63, 0x2d, 0xd0, 0x9e, 0xee, 0xcc,
+	0x5e, 0x9e, 0x36, 0x1b, 0x87, 0x38, 0x0c, 0x9e, 0x18, 0xd9, 0x6b, 0xc3, 0xca, 0xcb, 0xca, 0x06,
+	0x9c, 0xf1, 0x28, 0x17, 0x09, 0x75, 0x52, 0x41, 0x6c, 0xc1, 0xd4, 0x6a, 0x0b, 0xb4, 0xeb, 0x6b,
+	0x3a, 0x2a, 0xbc, 0xc9, 0x1b, 0x42, 0x2f, 0x52, 0x92, 0x1c, 0xae, 0xb3, 0xc8, 0xa3, 0x82, 0xb2,
+	0xa8, 0x53, 0x3b, 0x3e, 0x6d, 0x56, 0xac, 0xc6, 0x60, 0x6b, 0x97, 0x29, 0x18, 0x8e, 0x4b, 0xc5,
+	0x5c, 0xad, 0xb5, 0xaa, 0xed, 0xfa, 0xda, 0x22, 0xca, 0x3d, 0x41, 0xd2, 0x13, 0xd4, 0xf7, 0x04,
+	0xad, 0x33, 0x1a, 0x75, 0x1e, 0xc8, 0xdd, 0x9f, 0xce, 0x9a, 0x6d, 0x9f, 0x8a, 0xdd, 0xd4, 0x41,
+	0x2e, 0x0b, 0xcd, 0xbe, 0x81, 0xf9, 0x63, 0x85, 0x7b, 0x7b, 0xa6, 0x38, 0x8c, 0x09, 0xcf, 0x36,
+	0x70, 0x2b, 0x67, 0x56, 0x5e, 0x41, 0xc8, 0x05, 0x4e, 0x84, 0x2d, 0xfd, 0x57, 0xc7, 0xb3, 0x56,
+	0x35, 0x94, 0x87, 0x83, 0x8a, 0x70, 0x50, 0xb7, 0x08, 0xa7, 0xf3, 0xbf, 0x3c, 0xe8, 0xf2, 0xb4,
+	0x39, 0x9b, 0x4b, 0x2f, 0x53, 0x33, 0x8e, 0xce, 0x9a, 0xc0, 0x9a, 0xce, 0xb8, 0x24, 0x5a, 0x31,
+	0xe1, 0x5c, 0x94, 0x86, 0x36, 0x89, 0x99, 0xbb, 0xcb, 0xed, 0x18, 0x53, 0xcf, 0x66, 0x3d, 0x92,
+	0xa8, 0x13, 0x2d, 0xd0, 0xae, 0x59, 0xff, 0x44, 0x69, 0xf8, 0x2c, 0x2b, 0x6d, 0x63, 0xea, 0x6d,
+	0xf5, 0x48, 0x62, 0xa8, 0x70, 0x7e, 0x34, 0x14, 0x8b, 0xf0, 0x98, 0x45, 0x9c, 0x18, 0x9f, 0x01,
+	0x9c, 0xd9, 0xe4, 0xfe, 0x53, 0xcf, 0xeb, 0xb2, 0x3c, 0xae, 0x32, 0x0b, 0xf0, 0xeb, 0x2c, 0x16,
+	0xe1, 0x54, 0x36, 0x13, 0x36, 0xf5, 0xb2, 0xd8, 0x6a, 0xd6, 0x64, 0xb6, 0xde, 0xf0, 0x14, 0x02,
+	0x27, 0x13, 0xb2, 0x8f, 0x13, 0x8f, 0xab, 0xd5, 0x9b, 0x77, 0xb7, 0xe0, 0x36, 0x16, 0xe0, 0xbf,
+	0x23, 0xad, 0x97, 0xa2, 0xba, 0xf0, 0x6f, 0x29, 0x37, 0xc0, 0x34, 0xb4, 0x72, 0xec, 0x9f, 0xa8,
+	0x92, 0x33, 0x64, 0x53, 0x8f, 0xab, 0x63, 0xad, 0xaa, 0x54, 0x25, 0xd7, 0x1b, 0x1e, 0x37, 0xde,
+	0x01, 0xb8, 0xf0, 0x1d, 0x6d, 0x71, 0xe2, 0xb0, 0x62, 0x70, 0x8b, 0x8a, 0x5f, 0xe6, 0x7f, 0x2e,
+	0x1c, 0xb9, 0x24, 0xb8, 0xa9, 0xb4, 0x8c, 0x0f, 0x00, 0xce, 0x8f, 0xb2, 0x96, 0xb2, 0x28, 0x9c,
+	0x4e, 0x88, 0x2b, 0x05, 0x13, 0xef, 0x36, 0x84, 0x0d, 0xd8, 0xd7, 0xde, 0x57, 0x61, 0x75, 0x93,
+	0xfb, 0xca, 0x6b, 0x58, 0x1f, 0xbe, 0x3c, 0x0c, 0xf4, 0xe3, 0xb5, 0x87, 0x46, 0x67, 0x59, 0x5b,
+	0xbe, 0x1e, 0x53, 0x2a, 0xda, 0x81, 0x70, 0x68, 0xd6, 0x97, 0xae, 0xd8, 0x39, 0x80, 0x68, 0xf7,
+	0xae, 0x85, 0x94, 0xdc, 0x6f, 0x60, 0x63, 0x64, 0xe6, 0xee, 0x5c, 0xd5, 0xd7, 0x10, 0x48, 0xbb,
+	0xff, 0x1b, 0xa0, 0xf2, 0x04, 0x69, 0xce, 0x50, 0xf8, 0x57, 0x9a, 0x33, 0xc0, 0x68, 0xcb, 0xd7,
+	0x63, 0x0a, 0xfa, 0xce, 0xd6, 0xf1, 0xb9, 0x0e, 0x4e, 0xce, 0x75, 0xf0, 0xf5, 0x5c, 0x07, 0x47,
+	0x17, 0x7a, 0xe5, 0xe4, 0x42, 0xaf, 0x7c, 0xb9, 0xd0, 0x2b, 0x3b, 0x8f, 0x86, 0x22, 0xed, 0xf3,
+	0xad, 0x04, 0xd8, 0xe1, 0xc5, 0xc2, 0xec, 0x3d, 0x36, 0x0f, 0x46, 0x3e, 0x5b, 0x32, 0x65, 0x67,
+	0x22, 0xbb, 0xe5, 0x1e, 0x7e, 0x1b, 0x00, 0xd2, 0x2e, 0x60, 0x03, 0xd9, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -322,6 +530,8 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	CreateGauge(ctx context.Context, in *MsgCreateGauge, opts ...grpc.CallOption) (*MsgCreateGaugeResponse, error)
 	AddToGauge(ctx context.Context, in *MsgAddToGauge, opts ...grpc.CallOption) (*MsgAddToGaugeResponse, error)
+	ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error)
+	CancelGauge(ctx context.Context, in *MsgCancelGauge, opts ...grpc.CallOption) (*MsgCancelGaugeResponse, error)
 }
 
 type msgClient struct {
@@ -350,10 +560,30 @@ func (c *msgClient) AddToGauge(ctx context.Context, in *MsgAddToGauge, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error) {
+	out := new(MsgClaimRewardsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.incentives.Msg/ClaimRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelGauge(ctx context.Context, in *MsgCancelGauge, opts ...grpc.CallOption) (*MsgCancelGaugeResponse, error) {
+	out := new(MsgCancelGaugeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.incentives.Msg/CancelGauge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	CreateGauge(context.Context, *MsgCreateGauge) (*MsgCreateGaugeResponse, error)
 	AddToGauge(context.Context, *MsgAddToGauge) (*MsgAddToGaugeResponse, error)
+	ClaimRewards(context.Context, *MsgClaimRewards) (*MsgClaimRewardsResponse, error)
+	CancelGauge(context.Context, *MsgCancelGauge) (*MsgCancelGaugeResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -366,6 +596,12 @@ func (*UnimplementedMsgServer) CreateGauge(ctx context.Context, req *MsgCreateGa
 func (*UnimplementedMsgServer) AddToGauge(ctx context.Context, req *MsgAddToGauge) (*MsgAddToGaugeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddToGauge not implemented")
 }
+func (*UnimplementedMsgServer) ClaimRewards(ctx context.Context, req *MsgClaimRewards) (*MsgClaimRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimRewards not implemented")
+}
+func (*UnimplementedMsgServer) CancelGauge(ctx context.Context, req *MsgCancelGauge) (*MsgCancelGaugeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelGauge not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -407,6 +643,42 @@ func _Msg_AddToGauge_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ClaimRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.incentives.Msg/ClaimRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimRewards(ctx, req.(*MsgClaimRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelGauge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelGauge)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelGauge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.incentives.Msg/CancelGauge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelGauge(ctx, req.(*MsgCancelGauge))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.incentives.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -419,6 +691,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AddToGauge",
 			Handler:    _Msg_AddToGauge_Handler,
 		},
+		{
+			MethodName: "ClaimRewards",
+			Handler:    _Msg_ClaimRewards_Handler,
+		},
+		{
+			MethodName: "CancelGauge",
+			Handler:    _Msg_CancelGauge_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/incentives/tx.proto",
@@ -596,84 +876,307 @@ func (m *MsgAddToGaugeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgClaimRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateGauge) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgClaimRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.IsPerpetual {
-		n += 2
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.DistributeTo.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if len(m.LockIds) > 0 {
+		dAtA4 := make([]byte, len(m.LockIds)*10)
+		var j3 int
+		for _, num := range m.LockIds {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
 		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintTx(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovTx(uint64(l))
-	if m.NumEpochsPaidOver != 0 {
-		n += 1 + sovTx(uint64(m.NumEpochsPaidOver))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateGaugeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgClaimRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgAddToGauge) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.GaugeId != 0 {
-		n += 1 + sovTx(uint64(m.GaugeId))
-	}
-	if len(m.Rewards) > 0 {
-		for _, e := range m.Rewards {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+func (m *MsgClaimRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddToGaugeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgClaimRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelGauge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelGauge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelGauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GaugeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GaugeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelGaugeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelGaugeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelGaugeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reclaimed) > 0 {
+		for iNdEx := len(m.Reclaimed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reclaimed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateGauge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsPerpetual {
+		n += 2
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.DistributeTo.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	if m.NumEpochsPaidOver != 0 {
+		n += 1 + sovTx(uint64(m.NumEpochsPaidOver))
+	}
+	return n
+}
+
+func (m *MsgCreateGaugeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddToGauge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GaugeId != 0 {
+		n += 1 + sovTx(uint64(m.GaugeId))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddToGaugeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.LockIds) > 0 {
+		l = 0
+		for _, e := range m.LockIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgClaimRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCancelGauge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GaugeId != 0 {
+		n += 1 + sovTx(uint64(m.GaugeId))
+	}
+	return n
+}
+
+func (m *MsgCancelGaugeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Reclaimed) > 0 {
+		for _, e := range m.Reclaimed {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
 }
 
 func sovTx(x uint64) (n int) {
@@ -1138,6 +1641,433 @@ func (m *MsgAddToGaugeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgClaimRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.LockIds = append(m.LockIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.LockIds) == 0 {
+					m.LockIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.LockIds = append(m.LockIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types1.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelGauge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelGauge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelGauge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeId", wireType)
+			}
+			m.GaugeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GaugeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelGaugeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelGaugeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelGaugeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reclaimed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reclaimed = append(m.Reclaimed, types1.Coin{})
+			if err := m.Reclaimed[len(m.Reclaimed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1 +1,7 @@
 package types
+
+import "time"
+
+// AutoCompoundTwapWindow is how far back the TWAP that auto-compounded rewards
+// are valued at goes.
+const AutoCompoundTwapWindow = time.Hour
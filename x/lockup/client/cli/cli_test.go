@@ -450,7 +450,7 @@ func (s IntegrationTestSuite) TestCmdAccountLockedCoins() {
 			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
 			s.Require().NoError(err)
 
-			var result types.ModuleLockedAmountResponse
+			var result types.AccountLockedCoinsResponse
 			s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &result))
 			s.Require().Equal(tc.coins.String(), result.Coins.String())
 		})
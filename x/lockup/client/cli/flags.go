@@ -9,6 +9,13 @@ const (
 	FlagDuration    = "duration"
 	FlagMinDuration = "min-duration"
 	FlagAmount      = "amount"
+	FlagTokenize    = "tokenize"
+
+	FlagOwner       = "owner"
+	FlagDenom       = "denom"
+	FlagMaxDuration = "max-duration"
+	FlagUnlocking   = "unlocking"
+	FlagSynthetic   = "synthetic"
 )
 
 // FlagSetLockTokens returns flags for LockTokens msg builder.
@@ -32,3 +39,16 @@ func FlagSetMinDuration() *flag.FlagSet {
 	fs.String(FlagMinDuration, "336h", "The minimum duration of token bonded. e.g. 24h, 168h, 336h")
 	return fs
 }
+
+// FlagSetLocksFiltered returns flags for the LocksFiltered query.
+func FlagSetLocksFiltered() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagOwner, "", "The owner of the locks, all owners when empty")
+	fs.String(FlagDenom, "", "The denom the locks hold, all denoms when empty")
+	fs.String(FlagMinDuration, "0s", "The minimum duration of the locks. e.g. 24h, 168h, 336h")
+	fs.String(FlagMaxDuration, "0s", "The exclusive maximum duration of the locks, unbounded when 0s")
+	fs.String(FlagUnlocking, "any", "The unlocking state of the locks. any | unlocking | not-unlocking")
+	fs.String(FlagSynthetic, "any", "Whether the locks have synthetic locks. any | with | without")
+	return fs
+}
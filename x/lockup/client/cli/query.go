@@ -45,8 +45,14 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		GetCmdAccountLockedLongerDurationNotUnlockingOnly(),
 		GetCmdAccountLockedLongerDurationDenom(),
 		GetCmdTotalLockedByDenom(),
+		GetCmdTotalLockedByDenomAtHeight(),
+		GetCmdTimeWeightedLockedByDenom(),
+		GetCmdAccumulationCheckpoints(),
 		GetCmdOutputLocksJson(),
 		GetCmdSyntheticLockupsByLockupID(),
+		GetCmdLocksFiltered(),
+		GetCmdAccountCallbackContract(),
+		GetCmdParams(),
 	)
 
 	return cmd
@@ -264,7 +270,12 @@ $ %s query lockup account-locked-pastime <address> <timestamp>
 
 			queryClient := types.NewQueryClient(clientCtx)
 
-			res, err := queryClient.AccountLockedPastTime(cmd.Context(), &types.AccountLockedPastTimeRequest{Owner: args[0], Timestamp: timestamp})
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AccountLockedPastTime(cmd.Context(), &types.AccountLockedPastTimeRequest{Owner: args[0], Timestamp: timestamp, Pagination: pageReq})
 			if err != nil {
 				return err
 			}
@@ -274,6 +285,7 @@ $ %s query lockup account-locked-pastime <address> <timestamp>
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "account-locked-pastime")
 
 	return cmd
 }
@@ -307,7 +319,12 @@ $ %s query lockup account-locked-pastime-not-unlocking <address> <timestamp>
 
 			queryClient := types.NewQueryClient(clientCtx)
 
-			res, err := queryClient.AccountLockedPastTimeNotUnlockingOnly(cmd.Context(), &types.AccountLockedPastTimeNotUnlockingOnlyRequest{Owner: args[0], Timestamp: timestamp})
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AccountLockedPastTimeNotUnlockingOnly(cmd.Context(), &types.AccountLockedPastTimeNotUnlockingOnlyRequest{Owner: args[0], Timestamp: timestamp, Pagination: pageReq})
 			if err != nil {
 				return err
 			}
@@ -317,6 +334,7 @@ $ %s query lockup account-locked-pastime-not-unlocking <address> <timestamp>
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "account-locked-pastime-not-unlocking")
 
 	return cmd
 }
@@ -350,7 +368,12 @@ $ %s query lockup account-locked-pastime <address> <timestamp>
 
 			queryClient := types.NewQueryClient(clientCtx)
 
-			res, err := queryClient.AccountUnlockedBeforeTime(cmd.Context(), &types.AccountUnlockedBeforeTimeRequest{Owner: args[0], Timestamp: timestamp})
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AccountUnlockedBeforeTime(cmd.Context(), &types.AccountUnlockedBeforeTimeRequest{Owner: args[0], Timestamp: timestamp, Pagination: pageReq})
 			if err != nil {
 				return err
 			}
@@ -360,6 +383,7 @@ $ %s query lockup account-locked-pastime <address> <timestamp>
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "account-locked-beforetime")
 
 	return cmd
 }
@@ -395,7 +419,12 @@ $ %s query lockup account-locked-pastime-denom <address> <timestamp> <denom>
 
 			queryClient := types.NewQueryClient(clientCtx)
 
-			res, err := queryClient.AccountLockedPastTimeDenom(cmd.Context(), &types.AccountLockedPastTimeDenomRequest{Owner: args[0], Timestamp: timestamp, Denom: denom})
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AccountLockedPastTimeDenom(cmd.Context(), &types.AccountLockedPastTimeDenomRequest{Owner: args[0], Timestamp: timestamp, Denom: denom, Pagination: pageReq})
 			if err != nil {
 				return err
 			}
@@ -405,6 +434,7 @@ $ %s query lockup account-locked-pastime-denom <address> <timestamp> <denom>
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "account-locked-pastime-denom")
 
 	return cmd
 }
@@ -521,7 +551,12 @@ $ %s query lockup account-locked-longer-duration <address> <duration>
 
 			queryClient := types.NewQueryClient(clientCtx)
 
-			res, err := queryClient.AccountLockedLongerDuration(cmd.Context(), &types.AccountLockedLongerDurationRequest{Owner: args[0], Duration: duration})
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AccountLockedLongerDuration(cmd.Context(), &types.AccountLockedLongerDurationRequest{Owner: args[0], Duration: duration, Pagination: pageReq})
 			if err != nil {
 				return err
 			}
@@ -531,6 +566,7 @@ $ %s query lockup account-locked-longer-duration <address> <duration>
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "account-locked-longer-duration")
 
 	return cmd
 }
@@ -563,7 +599,12 @@ $ %s query lockup account-locked-longer-duration-not-unlocking <address> <durati
 
 			queryClient := types.NewQueryClient(clientCtx)
 
-			res, err := queryClient.AccountLockedLongerDurationNotUnlockingOnly(cmd.Context(), &types.AccountLockedLongerDurationNotUnlockingOnlyRequest{Owner: args[0], Duration: duration})
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AccountLockedLongerDurationNotUnlockingOnly(cmd.Context(), &types.AccountLockedLongerDurationNotUnlockingOnlyRequest{Owner: args[0], Duration: duration, Pagination: pageReq})
 			if err != nil {
 				return err
 			}
@@ -573,6 +614,7 @@ $ %s query lockup account-locked-longer-duration-not-unlocking <address> <durati
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "account-locked-longer-duration-not-unlocking")
 
 	return cmd
 }
@@ -607,7 +649,12 @@ $ %s query lockup account-locked-pastime <address> <duration> <denom>
 
 			queryClient := types.NewQueryClient(clientCtx)
 
-			res, err := queryClient.AccountLockedLongerDurationDenom(cmd.Context(), &types.AccountLockedLongerDurationDenomRequest{Owner: args[0], Duration: duration, Denom: denom})
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AccountLockedLongerDurationDenom(cmd.Context(), &types.AccountLockedLongerDurationDenomRequest{Owner: args[0], Duration: duration, Denom: denom, Pagination: pageReq})
 			if err != nil {
 				return err
 			}
@@ -617,6 +664,111 @@ $ %s query lockup account-locked-pastime <address> <duration> <denom>
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "account-locked-longer-duration-denom")
+
+	return cmd
+}
+
+// GetCmdLocksFiltered returns the locks matching all of the given filters.
+func GetCmdLocksFiltered() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "locks-filtered",
+		Short: "Query locks filtered by owner, denom, duration range, unlocking state and synthetic locks",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query locks filtered by owner, denom, duration range, unlocking state and synthetic locks.
+
+Example:
+$ %s query lockup locks-filtered --owner=<address> --denom=gamm/pool/1 --min-duration=24h --max-duration=336h --unlocking=not-unlocking --synthetic=without
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			owner, err := cmd.Flags().GetString(FlagOwner)
+			if err != nil {
+				return err
+			}
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			minDurationStr, err := cmd.Flags().GetString(FlagMinDuration)
+			if err != nil {
+				return err
+			}
+			minDuration, err := time.ParseDuration(minDurationStr)
+			if err != nil {
+				return err
+			}
+			maxDurationStr, err := cmd.Flags().GetString(FlagMaxDuration)
+			if err != nil {
+				return err
+			}
+			maxDuration, err := time.ParseDuration(maxDurationStr)
+			if err != nil {
+				return err
+			}
+
+			unlockingStr, err := cmd.Flags().GetString(FlagUnlocking)
+			if err != nil {
+				return err
+			}
+			unlocking, ok := map[string]types.UnlockingFilter{
+				"any":           types.AnyUnlockingState,
+				"unlocking":     types.UnlockingOnly,
+				"not-unlocking": types.NotUnlockingOnly,
+			}[unlockingStr]
+			if !ok {
+				return fmt.Errorf("invalid unlocking state %s, expected any, unlocking or not-unlocking", unlockingStr)
+			}
+
+			syntheticStr, err := cmd.Flags().GetString(FlagSynthetic)
+			if err != nil {
+				return err
+			}
+			synthetic, ok := map[string]types.SyntheticFilter{
+				"any":     types.AnySyntheticState,
+				"with":    types.WithSyntheticOnly,
+				"without": types.WithoutSyntheticOnly,
+			}[syntheticStr]
+			if !ok {
+				return fmt.Errorf("invalid synthetic state %s, expected any, with or without", syntheticStr)
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.LocksFiltered(cmd.Context(), &types.LocksFilteredRequest{
+				Owner:       owner,
+				Denom:       denom,
+				MinDuration: minDuration,
+				MaxDuration: maxDuration,
+				Unlocking:   unlocking,
+				Synthetic:   synthetic,
+				Pagination:  pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetLocksFiltered())
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "locks-filtered")
 
 	return cmd
 }
@@ -669,6 +821,196 @@ $ %s query lockup total-locked-of-denom <denom>
 	return cmd
 }
 
+// GetCmdTotalLockedByDenomAtHeight returns the locked amount for a specific denom bigger then duration provided at a past height.
+func GetCmdTotalLockedByDenomAtHeight() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "total-locked-of-denom-at-height <denom> <height>",
+		Short: "Query locked amount for a specific denom bigger then duration provided at a past height",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query locked amount for a specific denom bigger then duration provided at a past height.
+The amount is read from the latest accumulation checkpoint taken at or before the height.
+
+Example:
+$ %s query lockup total-locked-of-denom-at-height <denom> 1000000 --min-duration=336h
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			durationStr, err := cmd.Flags().GetString(FlagMinDuration)
+			if err != nil {
+				return err
+			}
+
+			duration, err := time.ParseDuration(durationStr)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.LockedDenomAtHeight(cmd.Context(), &types.LockedDenomAtHeightRequest{Denom: args[0], Duration: duration, Height: height})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetMinDuration())
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdTimeWeightedLockedByDenom returns the time-weighted average locked amount for a specific denom bigger then duration provided.
+func GetCmdTimeWeightedLockedByDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "time-weighted-locked-of-denom <denom> <start timestamp> <end timestamp>",
+		Short: "Query time-weighted average locked amount for a specific denom bigger then duration provided",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the average locked amount for a specific denom bigger then duration provided between two unix timestamps, weighted by time.
+The amounts are read from the accumulation checkpoints, so the start timestamp must not be before the first one.
+
+Example:
+$ %s query lockup time-weighted-locked-of-denom <denom> 1611879610 1612484410 --min-duration=336h
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			start, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			end, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			durationStr, err := cmd.Flags().GetString(FlagMinDuration)
+			if err != nil {
+				return err
+			}
+
+			duration, err := time.ParseDuration(durationStr)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TimeWeightedLockedDenom(cmd.Context(), &types.TimeWeightedLockedDenomRequest{
+				Denom:     args[0],
+				Duration:  duration,
+				StartTime: time.Unix(start, 0),
+				EndTime:   time.Unix(end, 0),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetMinDuration())
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdAccumulationCheckpoints returns the accumulation checkpoints that are kept.
+func GetCmdAccumulationCheckpoints() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accumulation-checkpoints",
+		Short: "Query the accumulation checkpoints that historical locked amounts are read from",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the accumulation checkpoints that historical locked amounts are read from.
+
+Example:
+$ %s query lockup accumulation-checkpoints
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AccumulationCheckpoints(cmd.Context(), &types.AccumulationCheckpointsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdAccountCallbackContract returns the contract an account registered to receive the lockup hooks of its locks.
+func GetCmdAccountCallbackContract() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-callback-contract <address>",
+		Short: "Query the contract an account registered to receive the lockup hooks of its locks",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the contract an account registered to receive the lockup hooks of its locks.
+
+Example:
+$ %s query lockup account-callback-contract <address>
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AccountCallbackContract(cmd.Context(), &types.AccountCallbackContractRequest{Owner: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdOutputLocksJson outputs all locks into a file called lock_export.json.
 func GetCmdOutputLocksJson() *cobra.Command {
 	cmd := &cobra.Command{
@@ -751,3 +1093,39 @@ $ %s query lockup output-all-locks <max lock ID>
 
 	return cmd
 }
+
+// GetCmdParams returns the params of the module.
+func GetCmdParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query module params",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the lockup module params, including the addresses allowed to force-unlock their locks.
+
+Example:
+$ %s query lockup params
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.ParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -29,6 +29,14 @@ func GetTxCmd() *cobra.Command {
 		NewLockTokensCmd(),
 		NewBeginUnlockingCmd(),
 		NewBeginUnlockByIDCmd(),
+		NewExtendLockupCmd(),
+		NewTransferLockCmd(),
+		NewSetAutoCompoundCmd(),
+		NewForceUnlockCmd(),
+		NewSetCallbackContractCmd(),
+		NewSplitLockCmd(),
+		NewMergeLocksCmd(),
+		NewClaimReceiptRewardsCmd(),
 	)
 
 	return cmd
@@ -62,17 +70,24 @@ func NewLockTokensCmd() *cobra.Command {
 				return err
 			}
 
+			tokenize, err := cmd.Flags().GetBool(FlagTokenize)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgLockTokens(
 				clientCtx.GetFromAddress(),
 				duration,
 				coins,
 			)
+			msg.Tokenize = tokenize
 
 			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
 		},
 	}
 
 	cmd.Flags().AddFlagSet(FlagSetLockTokens())
+	cmd.Flags().Bool(FlagTokenize, false, "Mint the receipt of the lock, lock/{id}, which owns the lock wherever it is sent")
 	flags.AddTxFlagsToCmd(cmd)
 	err := cmd.MarkFlagRequired(FlagDuration)
 	if err != nil {
@@ -154,3 +169,313 @@ func NewBeginUnlockByIDCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewExtendLockupCmd extends the duration of a period lock by ID.
+func NewExtendLockupCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "extend-lockup [id]",
+		Short: "extend the duration of a period lock that is not unlocking",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			durationStr, err := cmd.Flags().GetString(FlagDuration)
+			if err != nil {
+				return err
+			}
+
+			duration, err := time.ParseDuration(durationStr)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgExtendLockup(
+				clientCtx.GetFromAddress(),
+				id,
+				duration,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetLockTokens())
+	flags.AddTxFlagsToCmd(cmd)
+	err := cmd.MarkFlagRequired(FlagDuration)
+	if err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+// NewTransferLockCmd transfers a period lock by ID to a new owner.
+func NewTransferLockCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-lock [id] [new-owner]",
+		Short: "transfer a period lock and its synthetic locks to a new owner",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			newOwner, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferLock(
+				clientCtx.GetFromAddress(),
+				id,
+				newOwner,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetAutoCompoundCmd opts a period lock in or out of compounding its incentive rewards.
+func NewSetAutoCompoundCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-auto-compound [id] [true|false]",
+		Short: "opt a period lock in or out of compounding its incentive rewards into the lock",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			autoCompound, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAutoCompound(
+				clientCtx.GetFromAddress(),
+				id,
+				autoCompound,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewForceUnlockCmd instantly unlocks a period lock of an address allowed to force-unlock.
+func NewForceUnlockCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "force-unlock [id]",
+		Short: "instantly unlock individual period lock by ID, if governance allowed the owner to",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			coins := sdk.Coins(nil)
+			amountStr, err := cmd.Flags().GetString(FlagAmount)
+			if err != nil {
+				return err
+			}
+
+			if amountStr != "" {
+				coins, err = sdk.ParseCoinsNormalized(amountStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgForceUnlock(
+				clientCtx.GetFromAddress(),
+				id,
+				coins,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetUnlockTokens())
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetCallbackContractCmd registers a contract to receive the lockup hooks of the sender's locks.
+func NewSetCallbackContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-callback-contract [contract]",
+		Short: "register a contract to receive the lockup hooks of your locks, or remove it if no contract is given",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			contract := ""
+			if len(args) == 1 {
+				contract = args[0]
+			}
+
+			msg := types.NewMsgSetCallbackContract(
+				clientCtx.GetFromAddress(),
+				contract,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSplitLockCmd moves part of the coins of a period lock into a new lock.
+func NewSplitLockCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "split-lock [id] [tokens]",
+		Short: "move tokens out of a period lock that is not unlocking into a new lock with the same duration",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			coins, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSplitLock(
+				clientCtx.GetFromAddress(),
+				id,
+				coins,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewMergeLocksCmd merges period locks of the same denom and duration into the first of them.
+func NewMergeLocksCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "merge-locks [id] [id]...",
+		Short: "merge period locks of the same denom, duration and synthetic locks into the first of them",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			ids := make([]uint64, 0, len(args))
+			for _, arg := range args {
+				id, err := strconv.ParseUint(arg, 10, 64)
+				if err != nil {
+					return err
+				}
+				ids = append(ids, id)
+			}
+
+			msg := types.NewMsgMergeLocks(
+				clientCtx.GetFromAddress(),
+				ids,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewClaimReceiptRewardsCmd claims the rewards of a tokenized lock whose receipt the sender holds.
+func NewClaimReceiptRewardsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-receipt-rewards [id]",
+		Short: "claim the incentive rewards of a tokenized lock whose receipt you hold",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgClaimReceiptRewards(
+				clientCtx.GetFromAddress(),
+				id,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
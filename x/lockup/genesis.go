@@ -10,6 +10,7 @@ import (
 // InitGenesis initializes the capability module's state from a provided genesis
 // state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
 	k.SetLastLockID(ctx, genState.LastLockId)
 	if err := k.ResetAllLocks(ctx, genState.Locks); err != nil {
 		return
@@ -17,6 +18,14 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	if err := k.ResetAllSyntheticLocks(ctx, genState.SyntheticLocks); err != nil {
 		return
 	}
+	for _, record := range genState.AccumulationCheckpoints {
+		k.SetAccumulationCheckpointRecord(ctx, record)
+	}
+	for _, record := range genState.CallbackContracts {
+		if err := k.SetCallbackContractRecord(ctx, record); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -26,8 +35,11 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		panic(err)
 	}
 	return &types.GenesisState{
-		LastLockId:     k.GetLastLockID(ctx),
-		Locks:          locks,
-		SyntheticLocks: k.GetAllSyntheticLockups(ctx),
+		LastLockId:              k.GetLastLockID(ctx),
+		Locks:                   locks,
+		SyntheticLocks:          k.GetAllSyntheticLockups(ctx),
+		Params:                  k.GetParams(ctx),
+		AccumulationCheckpoints: k.GetAccumulationCheckpointRecords(ctx),
+		CallbackContracts:       k.GetAllCallbackContracts(ctx),
 	}
 }
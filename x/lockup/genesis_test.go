@@ -44,6 +44,10 @@ var (
 				Coins:    sdk.Coins{sdk.NewInt64Coin("foo", 5000000)},
 			},
 		},
+		Params: types.NewParams([]string{acc2.String()}, "week", time.Hour, 100_000),
+		CallbackContracts: []types.CallbackContract{
+			{Owner: acc2.String(), Contract: acc1.String()},
+		},
 	}
 )
 
@@ -115,6 +119,8 @@ func TestExportGenesis(t *testing.T) {
 			Coins:    sdk.Coins{sdk.NewInt64Coin("foo", 15000000)},
 		},
 	})
+	require.Equal(t, genesisExported.Params, testGenesis.Params)
+	require.Equal(t, genesisExported.CallbackContracts, testGenesis.CallbackContracts)
 }
 
 func TestMarshalUnmarshalGenesis(t *testing.T) {
@@ -0,0 +1,180 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/osmosis-labs/osmosis/v7/osmoutils"
+	"github.com/osmosis-labs/osmosis/v7/x/lockup/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func callbackContractKey(owner sdk.AccAddress) []byte {
+	return combineKeys(types.KeyPrefixCallbackContract, owner)
+}
+
+// GetCallbackContract returns the contract the owner registered to receive
+// the lockup hooks of its locks, or nil if there is none.
+func (k Keeper) GetCallbackContract(ctx sdk.Context, owner sdk.AccAddress) sdk.AccAddress {
+	return ctx.KVStore(k.storeKey).Get(callbackContractKey(owner))
+}
+
+// SetCallbackContract registers the contract to receive the lockup hooks of
+// the owner's locks. A nil contract removes the registered one.
+func (k Keeper) SetCallbackContract(ctx sdk.Context, owner, contract sdk.AccAddress) error {
+	store := ctx.KVStore(k.storeKey)
+	if contract.Empty() {
+		store.Delete(callbackContractKey(owner))
+		return nil
+	}
+	if k.wk == nil || !k.wk.HasContractInfo(ctx, contract) {
+		return sdkerrors.Wrapf(types.ErrCallbackContractNotFound, "contract %s", contract)
+	}
+	store.Set(callbackContractKey(owner), contract)
+	return nil
+}
+
+// SetCallbackContractRecord stores the callback contract of an owner, for genesis import.
+// The contract is not checked to exist, as the wasm module may be imported later.
+func (k Keeper) SetCallbackContractRecord(ctx sdk.Context, record types.CallbackContract) error {
+	owner, err := sdk.AccAddressFromBech32(record.Owner)
+	if err != nil {
+		return err
+	}
+	contract, err := sdk.AccAddressFromBech32(record.Contract)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(callbackContractKey(owner), contract)
+	return nil
+}
+
+// GetAllCallbackContracts returns the callback contracts of all owners, for genesis export.
+func (k Keeper) GetAllCallbackContracts(ctx sdk.Context) []types.CallbackContract {
+	contracts := []types.CallbackContract{}
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), combineKeys(types.KeyPrefixCallbackContract, []byte{}))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		owner := sdk.AccAddress(iter.Key()[len(types.KeyPrefixCallbackContract)+len(types.KeyIndexSeparator):])
+		contracts = append(contracts, types.CallbackContract{
+			Owner:    owner.String(),
+			Contract: sdk.AccAddress(iter.Value()).String(),
+		})
+	}
+	return contracts
+}
+
+// skipCallbackContractsKey marks the contexts in which callback contracts
+// are not called.
+type skipCallbackContractsKey struct{}
+
+// withoutCallbackContracts returns ctx with callback contracts disabled. The
+// calls that would be made on it emit EventCallbackContractSkipped instead.
+func withoutCallbackContracts(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(skipCallbackContractsKey{}, true)
+}
+
+// callCallbackContract sends msg to the callback contract of owner, if it
+// registered one. The call is limited to the ContractCallbackGasLimit param,
+// and the gas it uses is charged to ctx. A call that fails or runs out of gas
+// has its state changes dropped, without failing the lockup operation. On a
+// context without callback contracts, the call is only recorded in an event.
+func (k Keeper) callCallbackContract(ctx sdk.Context, owner sdk.AccAddress, msg types.CallbackContractSudoMsg) {
+	if k.wk == nil {
+		return
+	}
+	contract := k.GetCallbackContract(ctx, owner)
+	if contract.Empty() {
+		return
+	}
+
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	if skip, _ := ctx.Value(skipCallbackContractsKey{}).(bool); skip {
+		err = ctx.EventManager().EmitTypedEvent(&types.EventCallbackContractSkipped{
+			Owner:    owner.String(),
+			Contract: contract.String(),
+			Msg:      string(bz),
+		})
+		if err != nil {
+			panic(err)
+		}
+		return
+	}
+
+	gasMeter := sdk.NewGasMeter(k.GetParams(ctx).ContractCallbackGasLimit)
+	err = osmoutils.ApplyFuncIfNoError(ctx.WithGasMeter(gasMeter), func(cacheCtx sdk.Context) error {
+		_, err := k.wk.Sudo(cacheCtx, contract, bz)
+		return err
+	})
+	ctx.GasMeter().ConsumeGas(gasMeter.GasConsumedToLimit(), "lockup callback contract")
+	if err != nil {
+		k.Logger(ctx).Debug(fmt.Sprintf("callback contract %s of %s failed: %s", contract, owner, err))
+	}
+}
+
+// CallbackContractHooks sends the lockup hooks of locks to the callback
+// contracts of their owners.
+type CallbackContractHooks struct {
+	k Keeper
+}
+
+var _ types.LockupHooks = CallbackContractHooks{}
+
+// CallbackContractHooks returns the lockup hooks receiver that calls the callback contracts of lock owners.
+func (k Keeper) CallbackContractHooks() CallbackContractHooks {
+	return CallbackContractHooks{k}
+}
+
+func (h CallbackContractHooks) AfterAddTokensToLock(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins) {
+}
+
+func (h CallbackContractHooks) OnTokenLocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+	h.k.callCallbackContract(ctx, address, types.CallbackContractSudoMsg{
+		OnTokenLocked: types.NewLockCallback(address, lockID, amount, lockDuration, unlockTime),
+	})
+}
+
+func (h CallbackContractHooks) OnStartUnlock(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+	h.k.callCallbackContract(ctx, address, types.CallbackContractSudoMsg{
+		OnStartUnlock: types.NewLockCallback(address, lockID, amount, lockDuration, unlockTime),
+	})
+}
+
+func (h CallbackContractHooks) OnTokenUnlocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+	h.k.callCallbackContract(ctx, address, types.CallbackContractSudoMsg{
+		OnTokenUnlocked: types.NewLockCallback(address, lockID, amount, lockDuration, unlockTime),
+	})
+}
+
+func (h CallbackContractHooks) OnTokenSlashed(ctx sdk.Context, lockID uint64, amount sdk.Coins) {
+	lock, err := h.k.GetLockByID(ctx, lockID)
+	if err != nil {
+		return
+	}
+	h.k.callCallbackContract(ctx, lock.OwnerAddress(), types.CallbackContractSudoMsg{
+		OnTokenSlashed: &types.SlashCallback{
+			Owner:  lock.Owner,
+			LockID: lockID,
+			Amount: amount,
+		},
+	})
+}
+
+func (h CallbackContractHooks) OnLockupExtend(ctx sdk.Context, lockID uint64, prevDuration, newDuration time.Duration) {
+}
+
+func (h CallbackContractHooks) OnLockupTransfer(ctx sdk.Context, lockID uint64, prevOwner, newOwner sdk.AccAddress) {
+}
+
+func (h CallbackContractHooks) OnLockupSplit(ctx sdk.Context, lockID, newLockID uint64, amount sdk.Coins) {
+}
+
+func (h CallbackContractHooks) OnLockupMerge(ctx sdk.Context, lockID uint64, mergedLockIDs []uint64) {
+}
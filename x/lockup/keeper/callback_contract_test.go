@@ -0,0 +1,206 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/lockup/keeper"
+	"github.com/osmosis-labs/osmosis/v7/x/lockup/types"
+)
+
+// mockWasmKeeper records the sudo messages sent to contracts, and runs sudo
+// on the sudo context passed to it.
+type mockWasmKeeper struct {
+	contracts map[string]bool
+	msgs      []types.CallbackContractSudoMsg
+	sudo      func(ctx sdk.Context) error
+}
+
+func (m *mockWasmKeeper) HasContractInfo(_ sdk.Context, contractAddress sdk.AccAddress) bool {
+	return m.contracts[contractAddress.String()]
+}
+
+func (m *mockWasmKeeper) Sudo(ctx sdk.Context, _ sdk.AccAddress, msg []byte) ([]byte, error) {
+	sudoMsg := types.CallbackContractSudoMsg{}
+	if err := json.Unmarshal(msg, &sudoMsg); err != nil {
+		return nil, err
+	}
+	m.msgs = append(m.msgs, sudoMsg)
+	if m.sudo != nil {
+		return nil, m.sudo(ctx)
+	}
+	return nil, nil
+}
+
+func (suite *KeeperTestSuite) TestCallbackContract() {
+	suite.SetupTest()
+
+	owner := sdk.AccAddress([]byte("addr1---------------"))
+	contract := sdk.AccAddress([]byte("contract------------"))
+	notContract := sdk.AccAddress([]byte("addr2---------------"))
+	wasmKeeper := &mockWasmKeeper{contracts: map[string]bool{contract.String(): true}}
+	lockupKeeper := suite.app.LockupKeeper.WithWasmKeeper(wasmKeeper)
+
+	// only contracts can be registered
+	err := lockupKeeper.SetCallbackContract(suite.ctx, owner, notContract)
+	suite.Require().Error(err)
+	err = lockupKeeper.SetCallbackContract(suite.ctx, owner, contract)
+	suite.Require().NoError(err)
+	suite.Require().Equal(contract, lockupKeeper.GetCallbackContract(suite.ctx, owner))
+	suite.Require().Equal([]types.CallbackContract{{Owner: owner.String(), Contract: contract.String()}}, lockupKeeper.GetAllCallbackContracts(suite.ctx))
+
+	// the hooks of the owner's locks are sent to the contract
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+	unlockTime := suite.ctx.BlockTime().Add(time.Second)
+	hooks := lockupKeeper.CallbackContractHooks()
+	hooks.OnTokenLocked(suite.ctx, owner, 1, coins, time.Second, time.Time{})
+	hooks.OnStartUnlock(suite.ctx, owner, 1, coins, time.Second, unlockTime)
+	hooks.OnTokenUnlocked(suite.ctx, owner, 1, coins, time.Second, unlockTime)
+	hooks.OnTokenLocked(suite.ctx, notContract, 2, coins, time.Second, time.Time{})
+	suite.Require().Equal([]types.CallbackContractSudoMsg{
+		{OnTokenLocked: &types.LockCallback{Owner: owner.String(), LockID: 1, Amount: coins, Duration: int64(time.Second)}},
+		{OnStartUnlock: &types.LockCallback{Owner: owner.String(), LockID: 1, Amount: coins, Duration: int64(time.Second), UnlockTime: unlockTime.UnixNano()}},
+		{OnTokenUnlocked: &types.LockCallback{Owner: owner.String(), LockID: 1, Amount: coins, Duration: int64(time.Second), UnlockTime: unlockTime.UnixNano()}},
+	}, wasmKeeper.msgs)
+
+	// locks unlocked at the end of a block only emit the message
+	wasmKeeper.msgs = nil
+	ctx := keeper.WithoutCallbackContracts(suite.ctx.WithEventManager(sdk.NewEventManager()))
+	hooks.OnTokenUnlocked(ctx, owner, 1, coins, time.Second, unlockTime)
+	suite.Require().Empty(wasmKeeper.msgs)
+	msg, err := json.Marshal(types.CallbackContractSudoMsg{
+		OnTokenUnlocked: types.NewLockCallback(owner, 1, coins, time.Second, unlockTime),
+	})
+	suite.Require().NoError(err)
+	suite.requireTypedEvents(ctx, &types.EventCallbackContractSkipped{Owner: owner.String(), Contract: contract.String(), Msg: string(msg)})
+
+	// slashes are sent to the contract of the lock owner
+	err = simapp.FundAccount(suite.app.BankKeeper, suite.ctx, owner, coins)
+	suite.Require().NoError(err)
+	lock, err := suite.app.LockupKeeper.LockTokens(suite.ctx, owner, coins, time.Second)
+	suite.Require().NoError(err)
+	wasmKeeper.msgs = nil
+	hooks.OnTokenSlashed(suite.ctx, lock.ID, coins)
+	suite.Require().Equal([]types.CallbackContractSudoMsg{
+		{OnTokenSlashed: &types.SlashCallback{Owner: owner.String(), LockID: lock.ID, Amount: coins}},
+	}, wasmKeeper.msgs)
+
+	// removing the contract stops the callbacks
+	err = lockupKeeper.SetCallbackContract(suite.ctx, owner, nil)
+	suite.Require().NoError(err)
+	suite.Require().Nil(lockupKeeper.GetCallbackContract(suite.ctx, owner))
+	wasmKeeper.msgs = nil
+	hooks.OnTokenLocked(suite.ctx, owner, 1, coins, time.Second, time.Time{})
+	suite.Require().Empty(wasmKeeper.msgs)
+}
+
+func (suite *KeeperTestSuite) TestCallbackContractFailure() {
+	owner := sdk.AccAddress([]byte("addr1---------------"))
+	contract := sdk.AccAddress([]byte("contract------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+	gasLimit := uint64(100_000)
+
+	tests := []struct {
+		name        string
+		sudo        func(lockupKeeper keeper.Keeper) func(ctx sdk.Context) error
+		expectedGas uint64
+		expectWrite bool
+	}{
+		{
+			name: "successful callback writes its state",
+			sudo: func(lockupKeeper keeper.Keeper) func(ctx sdk.Context) error {
+				return func(ctx sdk.Context) error {
+					ctx.GasMeter().ConsumeGas(1000, "test")
+					lockupKeeper.SetLastLockID(ctx, 100)
+					return nil
+				}
+			},
+			expectedGas: 1000,
+			expectWrite: true,
+		},
+		{
+			name: "failed callback drops its state",
+			sudo: func(lockupKeeper keeper.Keeper) func(ctx sdk.Context) error {
+				return func(ctx sdk.Context) error {
+					ctx.GasMeter().ConsumeGas(1000, "test")
+					lockupKeeper.SetLastLockID(ctx, 100)
+					return errors.New("contract error")
+				}
+			},
+			expectedGas: 1000,
+		},
+		{
+			name: "callback out of gas drops its state and is charged the gas limit",
+			sudo: func(lockupKeeper keeper.Keeper) func(ctx sdk.Context) error {
+				return func(ctx sdk.Context) error {
+					lockupKeeper.SetLastLockID(ctx, 100)
+					ctx.GasMeter().ConsumeGas(2*gasLimit, "test")
+					return nil
+				}
+			},
+			expectedGas: gasLimit,
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			params := suite.app.LockupKeeper.GetParams(suite.ctx)
+			params.ContractCallbackGasLimit = gasLimit
+			suite.app.LockupKeeper.SetParams(suite.ctx, params)
+
+			wasmKeeper := &mockWasmKeeper{contracts: map[string]bool{contract.String(): true}}
+			lockupKeeper := suite.app.LockupKeeper.WithWasmKeeper(wasmKeeper)
+			wasmKeeper.sudo = tc.sudo(lockupKeeper)
+			err := lockupKeeper.SetCallbackContract(suite.ctx, owner, contract)
+			suite.Require().NoError(err)
+			lastLockID := lockupKeeper.GetLastLockID(suite.ctx)
+
+			// measure the gas of the callback alone, without its store reads
+			suite.ctx = suite.ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+			suite.Require().NotPanics(func() {
+				lockupKeeper.CallbackContractHooks().OnTokenLocked(suite.ctx, owner, 1, coins, time.Second, time.Time{})
+			})
+			suite.Require().Len(wasmKeeper.msgs, 1)
+			suite.Require().GreaterOrEqual(suite.ctx.GasMeter().GasConsumed(), tc.expectedGas)
+			suite.Require().Less(suite.ctx.GasMeter().GasConsumed(), tc.expectedGas+10_000)
+
+			if tc.expectWrite {
+				suite.Require().Equal(uint64(100), lockupKeeper.GetLastLockID(suite.ctx))
+			} else {
+				suite.Require().Equal(lastLockID, lockupKeeper.GetLastLockID(suite.ctx))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgSetCallbackContract() {
+	suite.SetupTest()
+
+	owner := sdk.AccAddress([]byte("addr1---------------"))
+	notContract := sdk.AccAddress([]byte("addr2---------------"))
+	msgServer := keeper.NewMsgServerImpl(suite.app.LockupKeeper)
+	c := sdk.WrapSDKContext(suite.ctx)
+
+	// the app's wasm keeper has no contract at the address
+	_, err := msgServer.SetCallbackContract(c, types.NewMsgSetCallbackContract(owner, notContract.String()))
+	suite.Require().ErrorIs(err, types.ErrCallbackContractNotFound)
+
+	// removing a contract that was never registered is a no-op
+	_, err = msgServer.SetCallbackContract(c, types.NewMsgSetCallbackContract(owner, ""))
+	suite.Require().NoError(err)
+	suite.Require().Nil(suite.app.LockupKeeper.GetCallbackContract(suite.ctx, owner))
+
+	// locking tokens still works with a callback contract that cannot be called
+	err = suite.app.LockupKeeper.SetCallbackContractRecord(suite.ctx, types.CallbackContract{Owner: owner.String(), Contract: notContract.String()})
+	suite.Require().NoError(err)
+	suite.LockTokens(owner, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, time.Second)
+	res, err := suite.querier.AccountCallbackContract(c, &types.AccountCallbackContractRequest{Owner: owner.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(notContract.String(), res.Contract)
+}
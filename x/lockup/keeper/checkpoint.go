@@ -0,0 +1,245 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/osmosis-labs/osmosis/v7/store"
+	"github.com/osmosis-labs/osmosis/v7/x/lockup/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func accumulationCheckpointKey(number uint64) []byte {
+	return combineKeys(types.KeyPrefixAccumulationCheckpoint, sdk.Uint64ToBigEndian(number))
+}
+
+// accumulationCheckpointPrefix returns the prefix of the accumulation stores of a checkpoint.
+func accumulationCheckpointPrefix(number uint64) []byte {
+	return combineKeys(types.KeyPrefixAccumulationCheckpointStore, sdk.Uint64ToBigEndian(number), []byte{})
+}
+
+func accumulationCheckpointStorePrefix(number uint64, denom string) []byte {
+	return append(accumulationCheckpointPrefix(number), []byte(denom+"/")...)
+}
+
+func (k Keeper) accumulationCheckpointStore(ctx sdk.Context, number uint64, denom string) store.Tree {
+	return store.NewTree(prefix.NewStore(ctx.KVStore(k.storeKey), accumulationCheckpointStorePrefix(number, denom)), 10)
+}
+
+// accumulationStoreDenoms returns the denoms that have an accumulation store.
+func (k Keeper) accumulationStoreDenoms(ctx sdk.Context) []string {
+	return accumulationTreeDenoms(prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixLockAccumulation))
+}
+
+// accumulationTreeDenoms returns the denoms of the accumulation trees stored
+// in parent, each under the denom and a separator.
+func accumulationTreeDenoms(parent prefix.Store) []string {
+	denoms := []string{}
+	store.IterateTrees(parent, func(treePrefix []byte) bool {
+		denom := strings.TrimSuffix(string(treePrefix), "/")
+		if len(denom) == len(treePrefix) || sdk.ValidateDenom(denom) != nil {
+			return false
+		}
+		denoms = append(denoms, denom)
+		return false
+	})
+	return denoms
+}
+
+// accumulationEntries returns the non-zero leaves of an accumulation tree.
+func accumulationEntries(tree store.Tree, denom string) []types.AccumulationEntry {
+	entries := []types.AccumulationEntry{}
+	tree.IterateLeaves(func(key []byte, amount sdk.Int) bool {
+		if len(key) != 8 || amount.IsZero() {
+			return false
+		}
+		entries = append(entries, types.AccumulationEntry{
+			Denom:    denom,
+			Duration: time.Duration(sdk.BigEndianToUint64(key)),
+			Amount:   amount,
+		})
+		return false
+	})
+	return entries
+}
+
+// CheckpointAccumulationStores copies the lock accumulation store of every
+// denom into a new accumulation checkpoint, and returns the checkpoint.
+func (k Keeper) CheckpointAccumulationStores(ctx sdk.Context) types.AccumulationCheckpoint {
+	checkpoint := types.AccumulationCheckpoint{
+		Number: 1,
+		Height: ctx.BlockHeight(),
+		Time:   ctx.BlockTime(),
+	}
+	if last, found := k.lastAccumulationCheckpoint(ctx); found {
+		checkpoint.Number = last.Number + 1
+	}
+
+	for _, denom := range k.accumulationStoreDenoms(ctx) {
+		entries := accumulationEntries(k.accumulationStore(ctx, denom), denom)
+		if len(entries) == 0 {
+			continue
+		}
+		k.setAccumulationCheckpointEntries(ctx, checkpoint.Number, entries)
+	}
+	k.setAccumulationCheckpoint(ctx, checkpoint)
+	return checkpoint
+}
+
+func (k Keeper) setAccumulationCheckpointEntries(ctx sdk.Context, number uint64, entries []types.AccumulationEntry) {
+	for _, entry := range entries {
+		k.accumulationCheckpointStore(ctx, number, entry.Denom).Set(accumulationKey(entry.Duration), entry.Amount)
+	}
+}
+
+func (k Keeper) setAccumulationCheckpoint(ctx sdk.Context, checkpoint types.AccumulationCheckpoint) {
+	bz, err := proto.Marshal(&checkpoint)
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set(accumulationCheckpointKey(checkpoint.Number), bz)
+}
+
+func (k Keeper) lastAccumulationCheckpoint(ctx sdk.Context) (types.AccumulationCheckpoint, bool) {
+	iter := sdk.KVStoreReversePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixAccumulationCheckpoint)
+	defer iter.Close()
+	if !iter.Valid() {
+		return types.AccumulationCheckpoint{}, false
+	}
+	checkpoint := types.AccumulationCheckpoint{}
+	if err := proto.Unmarshal(iter.Value(), &checkpoint); err != nil {
+		panic(err)
+	}
+	return checkpoint, true
+}
+
+// GetAccumulationCheckpoints returns the accumulation checkpoints that are kept, oldest first.
+func (k Keeper) GetAccumulationCheckpoints(ctx sdk.Context) []types.AccumulationCheckpoint {
+	checkpoints := []types.AccumulationCheckpoint{}
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixAccumulationCheckpoint)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		checkpoint := types.AccumulationCheckpoint{}
+		if err := proto.Unmarshal(iter.Value(), &checkpoint); err != nil {
+			panic(err)
+		}
+		checkpoints = append(checkpoints, checkpoint)
+	}
+	return checkpoints
+}
+
+// PruneAccumulationCheckpoints deletes the accumulation checkpoints taken before the given time.
+func (k Keeper) PruneAccumulationCheckpoints(ctx sdk.Context, before time.Time) {
+	kvStore := ctx.KVStore(k.storeKey)
+	for _, checkpoint := range k.GetAccumulationCheckpoints(ctx) {
+		if !checkpoint.Time.Before(before) {
+			return
+		}
+		checkpointStore := prefix.NewStore(kvStore, accumulationCheckpointPrefix(checkpoint.Number))
+		iter := checkpointStore.Iterator(nil, nil)
+		keys := [][]byte{}
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+		iter.Close()
+		for _, key := range keys {
+			checkpointStore.Delete(key)
+		}
+		kvStore.Delete(accumulationCheckpointKey(checkpoint.Number))
+	}
+}
+
+// GetLockedDenomAtHeight returns the total amount of denom locked for at least
+// duration at a past height, read from the latest accumulation checkpoint
+// taken at or before the height. For heights that are not in the past, the
+// current amount is returned without a checkpoint.
+func (k Keeper) GetLockedDenomAtHeight(ctx sdk.Context, denom string, duration time.Duration, height int64) (sdk.Int, *types.AccumulationCheckpoint, error) {
+	if height >= ctx.BlockHeight() {
+		return k.GetLockedDenom(ctx, denom, duration), nil, nil
+	}
+
+	checkpoints := k.GetAccumulationCheckpoints(ctx)
+	for i := len(checkpoints) - 1; i >= 0; i-- {
+		if checkpoints[i].Height <= height {
+			amount := k.accumulationCheckpointStore(ctx, checkpoints[i].Number, denom).SubsetAccumulation(accumulationKey(duration), nil)
+			return amount, &checkpoints[i], nil
+		}
+	}
+	return sdk.Int{}, nil, fmt.Errorf("no accumulation checkpoint at or before height %d", height)
+}
+
+// GetTimeWeightedLockedDenom returns the average total amount of denom locked
+// for at least duration between startTime and endTime, weighted by time. The
+// amount of each accumulation checkpoint is taken to hold until the next one,
+// and the amount of the last one until the current block.
+func (k Keeper) GetTimeWeightedLockedDenom(ctx sdk.Context, denom string, duration time.Duration, startTime, endTime time.Time) (sdk.Dec, error) {
+	if !startTime.Before(endTime) {
+		return sdk.Dec{}, errors.New("start time must be before end time")
+	}
+	if endTime.After(ctx.BlockTime()) {
+		return sdk.Dec{}, fmt.Errorf("end time %s is after the current block time %s", endTime, ctx.BlockTime())
+	}
+
+	checkpoints := k.GetAccumulationCheckpoints(ctx)
+	if len(checkpoints) == 0 || startTime.Before(checkpoints[0].Time) {
+		return sdk.Dec{}, fmt.Errorf("no accumulation checkpoint at or before start time %s", startTime)
+	}
+
+	weightedSum := sdk.ZeroInt()
+	for i, checkpoint := range checkpoints {
+		segmentEnd := ctx.BlockTime()
+		if i+1 < len(checkpoints) {
+			segmentEnd = checkpoints[i+1].Time
+		}
+		overlap := minTime(segmentEnd, endTime).Sub(maxTime(checkpoint.Time, startTime))
+		if overlap <= 0 {
+			continue
+		}
+		amount := k.accumulationCheckpointStore(ctx, checkpoint.Number, denom).SubsetAccumulation(accumulationKey(duration), nil)
+		weightedSum = weightedSum.Add(amount.MulRaw(int64(overlap)))
+	}
+	return weightedSum.ToDec().QuoInt64(int64(endTime.Sub(startTime))), nil
+}
+
+// GetAccumulationCheckpointRecords returns the accumulation checkpoints with their entries, for genesis export.
+func (k Keeper) GetAccumulationCheckpointRecords(ctx sdk.Context) []types.AccumulationCheckpointRecord {
+	records := []types.AccumulationCheckpointRecord{}
+	for _, checkpoint := range k.GetAccumulationCheckpoints(ctx) {
+		record := types.AccumulationCheckpointRecord{Checkpoint: checkpoint, Entries: []types.AccumulationEntry{}}
+		for _, denom := range k.accumulationCheckpointDenoms(ctx, checkpoint.Number) {
+			record.Entries = append(record.Entries, accumulationEntries(k.accumulationCheckpointStore(ctx, checkpoint.Number, denom), denom)...)
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+// SetAccumulationCheckpointRecord stores an accumulation checkpoint with its entries, for genesis import.
+func (k Keeper) SetAccumulationCheckpointRecord(ctx sdk.Context, record types.AccumulationCheckpointRecord) {
+	k.setAccumulationCheckpointEntries(ctx, record.Checkpoint.Number, record.Entries)
+	k.setAccumulationCheckpoint(ctx, record.Checkpoint)
+}
+
+func (k Keeper) accumulationCheckpointDenoms(ctx sdk.Context, number uint64) []string {
+	return accumulationTreeDenoms(prefix.NewStore(ctx.KVStore(k.storeKey), accumulationCheckpointPrefix(number)))
+}
+
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,122 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *KeeperTestSuite) TestAccumulationCheckpoints() {
+	suite.SetupTest()
+
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	startTime := suite.ctx.BlockTime()
+	suite.ctx = suite.ctx.WithBlockHeight(10)
+
+	// checkpoint 1: 10stake for 1s, 20stake for 2s
+	suite.LockTokens(addr1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, time.Second)
+	suite.LockTokens(addr1, sdk.Coins{sdk.NewInt64Coin("stake", 20)}, 2*time.Second)
+	checkpoint1 := suite.app.LockupKeeper.CheckpointAccumulationStores(suite.ctx)
+	suite.Require().Equal(uint64(1), checkpoint1.Number)
+
+	// checkpoint 2: 40stake for 1s, 20stake for 2s
+	suite.ctx = suite.ctx.WithBlockHeight(20).WithBlockTime(startTime.Add(100 * time.Second))
+	suite.LockTokens(addr1, sdk.Coins{sdk.NewInt64Coin("stake", 30)}, time.Second)
+	checkpoint2 := suite.app.LockupKeeper.CheckpointAccumulationStores(suite.ctx)
+	suite.Require().Equal(uint64(2), checkpoint2.Number)
+
+	// the current amount changes after the last checkpoint
+	suite.ctx = suite.ctx.WithBlockHeight(30).WithBlockTime(startTime.Add(200 * time.Second))
+	suite.LockTokens(addr1, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, time.Second)
+
+	tests := []struct {
+		height             int64
+		duration           time.Duration
+		expectedAmount     int64
+		expectedCheckpoint uint64
+		expectPass         bool
+	}{
+		{height: 5, duration: time.Second, expectPass: false},
+		{height: 10, duration: time.Second, expectedAmount: 30, expectedCheckpoint: 1, expectPass: true},
+		{height: 15, duration: 2 * time.Second, expectedAmount: 20, expectedCheckpoint: 1, expectPass: true},
+		{height: 25, duration: time.Second, expectedAmount: 60, expectedCheckpoint: 2, expectPass: true},
+		{height: 25, duration: 3 * time.Second, expectedAmount: 0, expectedCheckpoint: 2, expectPass: true},
+		{height: 30, duration: time.Second, expectedAmount: 160, expectPass: true},
+	}
+	for _, tc := range tests {
+		amount, checkpoint, err := suite.app.LockupKeeper.GetLockedDenomAtHeight(suite.ctx, "stake", tc.duration, tc.height)
+		if !tc.expectPass {
+			suite.Require().Error(err)
+			continue
+		}
+		suite.Require().NoError(err)
+		suite.Require().Equal(sdk.NewInt(tc.expectedAmount), amount, "height %d, duration %s", tc.height, tc.duration)
+		if tc.expectedCheckpoint == 0 {
+			suite.Require().Nil(checkpoint)
+		} else {
+			suite.Require().Equal(tc.expectedCheckpoint, checkpoint.Number)
+		}
+	}
+
+	// 30stake for 100s, then 60stake for 100s
+	twa, err := suite.app.LockupKeeper.GetTimeWeightedLockedDenom(suite.ctx, "stake", time.Second, startTime, startTime.Add(200*time.Second))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(45), twa)
+	twa, err = suite.app.LockupKeeper.GetTimeWeightedLockedDenom(suite.ctx, "stake", time.Second, startTime.Add(50*time.Second), startTime.Add(150*time.Second))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(45), twa)
+	_, err = suite.app.LockupKeeper.GetTimeWeightedLockedDenom(suite.ctx, "stake", time.Second, startTime.Add(-time.Second), startTime.Add(200*time.Second))
+	suite.Require().Error(err)
+	_, err = suite.app.LockupKeeper.GetTimeWeightedLockedDenom(suite.ctx, "stake", time.Second, startTime, startTime.Add(201*time.Second))
+	suite.Require().Error(err)
+
+	// the checkpoints as exported to genesis
+	records := suite.app.LockupKeeper.GetAccumulationCheckpointRecords(suite.ctx)
+	suite.Require().Len(records, 2)
+	suite.Require().Len(records[0].Entries, 2)
+	suite.Require().Len(records[1].Entries, 2)
+
+	// pruning removes the checkpoints taken before the given time only
+	suite.app.LockupKeeper.PruneAccumulationCheckpoints(suite.ctx, startTime.Add(50*time.Second))
+	checkpoints := suite.app.LockupKeeper.GetAccumulationCheckpoints(suite.ctx)
+	suite.Require().Len(checkpoints, 1)
+	suite.Require().Equal(checkpoint2, checkpoints[0])
+	_, _, err = suite.app.LockupKeeper.GetLockedDenomAtHeight(suite.ctx, "stake", time.Second, 15)
+	suite.Require().Error(err)
+	amount, _, err := suite.app.LockupKeeper.GetLockedDenomAtHeight(suite.ctx, "stake", time.Second, 25)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(60), amount)
+
+	// importing the exported checkpoints restores the pruned one
+	for _, record := range records {
+		suite.app.LockupKeeper.SetAccumulationCheckpointRecord(suite.ctx, record)
+	}
+	suite.Require().Equal(records, suite.app.LockupKeeper.GetAccumulationCheckpointRecords(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestAccumulationCheckpointEpochHook() {
+	suite.SetupTest()
+
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	suite.LockTokens(addr1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, time.Second)
+
+	params := suite.app.LockupKeeper.GetParams(suite.ctx)
+	params.AccumulationCheckpointEpochIdentifier = "day"
+	params.AccumulationCheckpointKeepPeriod = 48 * time.Hour
+	suite.app.LockupKeeper.SetParams(suite.ctx, params)
+
+	// other epochs do not checkpoint
+	suite.app.LockupKeeper.Hooks().AfterEpochEnd(suite.ctx, "week", 1)
+	suite.Require().Len(suite.app.LockupKeeper.GetAccumulationCheckpoints(suite.ctx), 0)
+
+	for i := 1; i <= 4; i++ {
+		suite.app.LockupKeeper.Hooks().AfterEpochEnd(suite.ctx, "day", int64(i))
+		suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(24 * time.Hour))
+	}
+
+	// the first checkpoint was older than the keep period when the last was taken
+	checkpoints := suite.app.LockupKeeper.GetAccumulationCheckpoints(suite.ctx)
+	suite.Require().Len(checkpoints, 3)
+	suite.Require().Equal(uint64(2), checkpoints[0].Number)
+	suite.Require().Equal(uint64(4), checkpoints[2].Number)
+}
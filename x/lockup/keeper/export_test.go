@@ -25,3 +25,12 @@ func (k Keeper) SyntheticCoins(coins sdk.Coins, suffix string) sdk.Coins {
 func (k Keeper) GetCoinsFromLocks(locks []types.PeriodLock) sdk.Coins {
 	return k.getCoinsFromLocks(locks)
 }
+
+func (k Keeper) WithWasmKeeper(wk types.WasmKeeper) Keeper {
+	k.wk = wk
+	return k
+}
+
+func WithoutCallbackContracts(ctx sdk.Context) sdk.Context {
+	return withoutCallbackContracts(ctx)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -108,7 +109,14 @@ func (q Querier) AccountLockedPastTime(goCtx context.Context, req *types.Account
 		return nil, err
 	}
 
-	return &types.AccountLockedPastTimeResponse{Locks: q.Keeper.GetAccountLockedPastTime(ctx, owner, req.Timestamp)}, nil
+	locks, pageRes, err := q.Keeper.paginateLocksFromIterators(ctx, req.Pagination, nil,
+		q.Keeper.AccountLockIteratorLongerDuration(ctx, false, owner, durationUntil(ctx, req.Timestamp)),
+		q.Keeper.AccountLockIteratorAfterTime(ctx, owner, req.Timestamp))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.AccountLockedPastTimeResponse{Locks: locks, Pagination: pageRes}, nil
 }
 
 // AccountUnlockedBeforeTime Returns the total unlocks of an account whose unlock time is before timestamp.
@@ -127,7 +135,18 @@ func (q Querier) AccountUnlockedBeforeTime(goCtx context.Context, req *types.Acc
 		return nil, err
 	}
 
-	return &types.AccountUnlockedBeforeTimeResponse{Locks: q.Keeper.GetAccountUnlockedBeforeTime(ctx, owner, req.Timestamp)}, nil
+	// unlockings finish before specific time + not started locks that can finish before the time if start now
+	iterators := []sdk.Iterator{}
+	if !req.Timestamp.Before(ctx.BlockTime()) {
+		iterators = append(iterators, q.Keeper.AccountLockIteratorShorterThanDuration(ctx, false, owner, req.Timestamp.Sub(ctx.BlockTime())))
+	}
+	iterators = append(iterators, q.Keeper.AccountLockIteratorBeforeTime(ctx, owner, req.Timestamp))
+	locks, pageRes, err := q.Keeper.paginateLocksFromIterators(ctx, req.Pagination, nil, iterators...)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.AccountUnlockedBeforeTimeResponse{Locks: locks, Pagination: pageRes}, nil
 }
 
 // AccountLockedPastTimeDenom is equal to GetAccountLockedPastTime but denom specific.
@@ -146,7 +165,14 @@ func (q Querier) AccountLockedPastTimeDenom(goCtx context.Context, req *types.Ac
 		return nil, err
 	}
 
-	return &types.AccountLockedPastTimeDenomResponse{Locks: q.Keeper.GetAccountLockedPastTimeDenom(ctx, owner, req.Denom, req.Timestamp)}, nil
+	locks, pageRes, err := q.Keeper.paginateLocksFromIterators(ctx, req.Pagination, nil,
+		q.Keeper.AccountLockIteratorLongerDurationDenom(ctx, false, owner, req.Denom, durationUntil(ctx, req.Timestamp)),
+		q.Keeper.AccountLockIteratorAfterTimeDenom(ctx, owner, req.Denom, req.Timestamp))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.AccountLockedPastTimeDenomResponse{Locks: locks, Pagination: pageRes}, nil
 }
 
 // LockedByID Returns lock by lock ID.
@@ -187,8 +213,15 @@ func (q Querier) AccountLockedLongerDuration(goCtx context.Context, req *types.A
 		return nil, err
 	}
 
-	locks := q.Keeper.GetAccountLockedLongerDuration(ctx, owner, req.Duration)
-	return &types.AccountLockedLongerDurationResponse{Locks: locks}, nil
+	// it does not matter started unlocking or not for duration query
+	locks, pageRes, err := q.Keeper.paginateLocksFromIterators(ctx, req.Pagination, nil,
+		q.Keeper.AccountLockIteratorLongerDuration(ctx, false, owner, req.Duration),
+		q.Keeper.AccountLockIteratorLongerDuration(ctx, true, owner, req.Duration))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.AccountLockedLongerDurationResponse{Locks: locks, Pagination: pageRes}, nil
 }
 
 // AccountLockedLongerDurationDenom Returns account locked with duration longer than specified with specific denom.
@@ -207,8 +240,15 @@ func (q Querier) AccountLockedLongerDurationDenom(goCtx context.Context, req *ty
 		return nil, err
 	}
 
-	locks := q.Keeper.GetAccountLockedLongerDurationDenom(ctx, owner, req.Denom, req.Duration)
-	return &types.AccountLockedLongerDurationDenomResponse{Locks: locks}, nil
+	// it does not matter started unlocking or not for duration query
+	locks, pageRes, err := q.Keeper.paginateLocksFromIterators(ctx, req.Pagination, nil,
+		q.Keeper.AccountLockIteratorLongerDurationDenom(ctx, false, owner, req.Denom, req.Duration),
+		q.Keeper.AccountLockIteratorLongerDurationDenom(ctx, true, owner, req.Denom, req.Duration))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.AccountLockedLongerDurationDenomResponse{Locks: locks, Pagination: pageRes}, nil
 }
 
 // AccountLockedPastTimeNotUnlockingOnly Returns locked records of an account with unlock time beyond timestamp excluding tokens started unlocking.
@@ -227,7 +267,13 @@ func (q Querier) AccountLockedPastTimeNotUnlockingOnly(goCtx context.Context, re
 		return nil, err
 	}
 
-	return &types.AccountLockedPastTimeNotUnlockingOnlyResponse{Locks: q.Keeper.GetAccountLockedPastTimeNotUnlockingOnly(ctx, owner, req.Timestamp)}, nil
+	locks, pageRes, err := q.Keeper.paginateLocksFromIterators(ctx, req.Pagination, nil,
+		q.Keeper.AccountLockIteratorLongerDuration(ctx, false, owner, durationUntil(ctx, req.Timestamp)))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.AccountLockedPastTimeNotUnlockingOnlyResponse{Locks: locks, Pagination: pageRes}, nil
 }
 
 // AccountLockedLongerDurationNotUnlockingOnly Returns account locked records with longer duration excluding tokens started unlocking.
@@ -246,7 +292,13 @@ func (q Querier) AccountLockedLongerDurationNotUnlockingOnly(goCtx context.Conte
 		return nil, err
 	}
 
-	return &types.AccountLockedLongerDurationNotUnlockingOnlyResponse{Locks: q.Keeper.GetAccountLockedLongerDurationNotUnlockingOnly(ctx, owner, req.Duration)}, nil
+	locks, pageRes, err := q.Keeper.paginateLocksFromIterators(ctx, req.Pagination, nil,
+		q.Keeper.AccountLockIteratorLongerDuration(ctx, false, owner, req.Duration))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.AccountLockedLongerDurationNotUnlockingOnlyResponse{Locks: locks, Pagination: pageRes}, nil
 }
 
 func (q Querier) LockedDenom(goCtx context.Context, req *types.LockedDenomRequest) (*types.LockedDenomResponse, error) {
@@ -260,3 +312,145 @@ func (q Querier) LockedDenom(goCtx context.Context, req *types.LockedDenomReques
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	return &types.LockedDenomResponse{Amount: q.Keeper.GetLockedDenom(ctx, req.Denom, req.Duration)}, nil
 }
+
+// LocksFiltered returns the locks matching all of the given owner, denom, duration range, unlocking state and synthetic lock filters.
+func (q Querier) LocksFiltered(goCtx context.Context, req *types.LocksFilteredRequest) (*types.LocksFilteredResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.MaxDuration != 0 && req.MaxDuration <= req.MinDuration {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "max duration must be longer than min duration")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var owner sdk.AccAddress
+	if len(req.Owner) != 0 {
+		var err error
+		owner, err = sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	isUnlockings := []bool{false, true}
+	switch req.Unlocking {
+	case types.UnlockingOnly:
+		isUnlockings = []bool{true}
+	case types.NotUnlockingOnly:
+		isUnlockings = []bool{false}
+	}
+
+	iterators := []sdk.Iterator{}
+	for _, isUnlocking := range isUnlockings {
+		var iterator sdk.Iterator
+		switch {
+		case owner != nil && req.Denom != "":
+			iterator = q.Keeper.AccountLockIteratorLongerDurationDenom(ctx, isUnlocking, owner, req.Denom, req.MinDuration)
+		case owner != nil:
+			iterator = q.Keeper.AccountLockIteratorLongerDuration(ctx, isUnlocking, owner, req.MinDuration)
+		case req.Denom != "":
+			iterator = q.Keeper.LockIteratorLongerThanDurationDenom(ctx, isUnlocking, req.Denom, req.MinDuration)
+		default:
+			iterator = q.Keeper.LockIteratorLongerDuration(ctx, isUnlocking, req.MinDuration)
+		}
+		iterators = append(iterators, iterator)
+	}
+
+	var filter func(types.PeriodLock) bool
+	if req.MaxDuration != 0 || req.Synthetic != types.AnySyntheticState {
+		filter = func(lock types.PeriodLock) bool {
+			if req.MaxDuration != 0 && lock.Duration >= req.MaxDuration {
+				return false
+			}
+			switch req.Synthetic {
+			case types.WithSyntheticOnly:
+				return len(q.Keeper.GetAllSyntheticLockupsByLockup(ctx, lock.ID)) != 0
+			case types.WithoutSyntheticOnly:
+				return len(q.Keeper.GetAllSyntheticLockupsByLockup(ctx, lock.ID)) == 0
+			}
+			return true
+		}
+	}
+
+	locks, pageRes, err := q.Keeper.paginateLocksFromIterators(ctx, req.Pagination, filter, iterators...)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.LocksFilteredResponse{Locks: locks, Pagination: pageRes}, nil
+}
+
+// LockedDenomAtHeight returns the total locked per denom with longer duration at a past height.
+func (q Querier) LockedDenomAtHeight(goCtx context.Context, req *types.LockedDenomAtHeightRequest) (*types.LockedDenomAtHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.Denom) == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	amount, checkpoint, err := q.Keeper.GetLockedDenomAtHeight(ctx, req.Denom, req.Duration, req.Height)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return &types.LockedDenomAtHeightResponse{Amount: amount, Checkpoint: checkpoint}, nil
+}
+
+// TimeWeightedLockedDenom returns the time-weighted average of the total locked per denom with longer duration over a time range.
+func (q Querier) TimeWeightedLockedDenom(goCtx context.Context, req *types.TimeWeightedLockedDenomRequest) (*types.TimeWeightedLockedDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.Denom) == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	amount, err := q.Keeper.GetTimeWeightedLockedDenom(ctx, req.Denom, req.Duration, req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.TimeWeightedLockedDenomResponse{Amount: amount}, nil
+}
+
+// AccumulationCheckpoints returns the accumulation checkpoints that are kept.
+func (q Querier) AccumulationCheckpoints(goCtx context.Context, _ *types.AccumulationCheckpointsRequest) (*types.AccumulationCheckpointsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.AccumulationCheckpointsResponse{Checkpoints: q.Keeper.GetAccumulationCheckpoints(ctx)}, nil
+}
+
+// AccountCallbackContract returns the contract an account registered to receive the lockup hooks of its locks.
+func (q Querier) AccountCallbackContract(goCtx context.Context, req *types.AccountCallbackContractRequest) (*types.AccountCallbackContractResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	contract := q.Keeper.GetCallbackContract(ctx, owner)
+	if contract.Empty() {
+		return &types.AccountCallbackContractResponse{}, nil
+	}
+	return &types.AccountCallbackContractResponse{Contract: contract.String()}, nil
+}
+
+// Params returns the lockup module's params.
+func (q Querier) Params(goCtx context.Context, _ *types.ParamsRequest) (*types.ParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.ParamsResponse{Params: q.Keeper.GetParams(ctx)}, nil
+}
+
+// durationUntil returns how long a lock that starts unlocking now has to be
+// to still be locked at timestamp.
+func durationUntil(ctx sdk.Context, timestamp time.Time) time.Duration {
+	if timestamp.After(ctx.BlockTime()) {
+		return timestamp.Sub(ctx.BlockTime())
+	}
+	return time.Duration(0)
+}
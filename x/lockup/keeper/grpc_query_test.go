@@ -5,6 +5,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/osmosis-labs/osmosis/v7/x/lockup/types"
 )
@@ -397,6 +398,65 @@ func (suite *KeeperTestSuite) TestAccountLockedLongerDuration() {
 	suite.Require().Len(res.Locks, 0)
 }
 
+func (suite *KeeperTestSuite) TestAccountLockedLongerDurationPagination() {
+	suite.SetupTest()
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+
+	// lock coins, unlocking the first two locks
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+	suite.LockTokens(addr1, coins, time.Second)
+	suite.LockTokens(addr1, coins, time.Second)
+	suite.BeginUnlocking(addr1)
+	suite.LockTokens(addr1, coins, time.Second)
+	suite.LockTokens(addr1, coins, 2*time.Second)
+	suite.LockTokens(addr1, coins, 3*time.Second)
+
+	// page through the not unlocking locks, then the unlocking ones, by key
+	lockIDs := []uint64{}
+	pageReq := &query.PageRequest{Limit: 2}
+	for {
+		res, err := suite.querier.AccountLockedLongerDuration(sdk.WrapSDKContext(suite.ctx), &types.AccountLockedLongerDurationRequest{Owner: addr1.String(), Pagination: pageReq})
+		suite.Require().NoError(err)
+		suite.Require().LessOrEqual(len(res.Locks), 2)
+		for _, lock := range res.Locks {
+			lockIDs = append(lockIDs, lock.ID)
+		}
+		if res.Pagination.NextKey == nil {
+			break
+		}
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2}
+	}
+	suite.Require().Equal([]uint64{3, 4, 5, 1, 2}, lockIDs)
+
+	// page by offset, counting the total
+	res, err := suite.querier.AccountLockedLongerDuration(sdk.WrapSDKContext(suite.ctx), &types.AccountLockedLongerDurationRequest{Owner: addr1.String(), Pagination: &query.PageRequest{Offset: 2, Limit: 2, CountTotal: true}})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Locks, 2)
+	suite.Require().Equal(uint64(5), res.Locks[0].ID)
+	suite.Require().Equal(uint64(1), res.Locks[1].ID)
+	suite.Require().Equal(uint64(5), res.Pagination.Total)
+
+	// without pagination, every lock is returned
+	res, err = suite.querier.AccountLockedLongerDuration(sdk.WrapSDKContext(suite.ctx), &types.AccountLockedLongerDurationRequest{Owner: addr1.String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Locks, 5)
+	suite.Require().Nil(res.Pagination)
+
+	// coins are summed over every lock, however many there are
+	for i := 0; i < int(query.DefaultLimit); i++ {
+		suite.LockTokens(addr1, coins, 4*time.Second)
+	}
+	coinsRes, err := suite.querier.AccountLockedCoins(sdk.WrapSDKContext(suite.ctx), &types.AccountLockedCoinsRequest{Owner: addr1.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 10*int64(5+query.DefaultLimit))}, coinsRes.Coins)
+
+	// invalid page requests
+	_, err = suite.querier.AccountLockedLongerDuration(sdk.WrapSDKContext(suite.ctx), &types.AccountLockedLongerDurationRequest{Owner: addr1.String(), Pagination: &query.PageRequest{Offset: 1, Key: []byte{0}}})
+	suite.Require().Error(err)
+	_, err = suite.querier.AccountLockedLongerDuration(sdk.WrapSDKContext(suite.ctx), &types.AccountLockedLongerDurationRequest{Owner: addr1.String(), Pagination: &query.PageRequest{Key: []byte{2}}})
+	suite.Require().Error(err)
+}
+
 func (suite *KeeperTestSuite) TestAccountLockedLongerDurationNotUnlockingOnly() {
 	suite.SetupTest()
 	addr1 := sdk.AccAddress([]byte("addr1---------------"))
@@ -515,3 +575,95 @@ func (suite *KeeperTestSuite) TestLockedDenom() {
 	testTotalLockedDuration("2h", 0)
 	testTotalLockedDuration("1h", 10)
 }
+
+func (suite *KeeperTestSuite) TestLocksFiltered() {
+	suite.SetupTest()
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+
+	// lock 1 is unlocking, lock 3 has a synthetic lock
+	suite.LockTokens(addr1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, time.Second)
+	suite.BeginUnlocking(addr1)
+	suite.LockTokens(addr1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, 2*time.Second)
+	suite.LockTokens(addr1, sdk.Coins{sdk.NewInt64Coin("foo", 10)}, 3*time.Second)
+	suite.LockTokens(addr2, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, 4*time.Second)
+	err := suite.app.LockupKeeper.CreateSyntheticLockup(suite.ctx, 3, "foo/superbonding", 3*time.Second, false)
+	suite.Require().NoError(err)
+
+	tests := []struct {
+		name       string
+		req        types.LocksFilteredRequest
+		expLockIDs []uint64
+		expErr     bool
+	}{
+		{
+			name:       "no filter",
+			req:        types.LocksFilteredRequest{},
+			expLockIDs: []uint64{2, 3, 4, 1},
+		},
+		{
+			name:       "owner",
+			req:        types.LocksFilteredRequest{Owner: addr1.String()},
+			expLockIDs: []uint64{2, 3, 1},
+		},
+		{
+			name:       "owner and denom",
+			req:        types.LocksFilteredRequest{Owner: addr1.String(), Denom: "stake"},
+			expLockIDs: []uint64{2, 1},
+		},
+		{
+			name:       "denom and duration range",
+			req:        types.LocksFilteredRequest{Denom: "stake", MinDuration: 2 * time.Second, MaxDuration: 4 * time.Second},
+			expLockIDs: []uint64{2},
+		},
+		{
+			name:       "unlocking only",
+			req:        types.LocksFilteredRequest{Unlocking: types.UnlockingOnly},
+			expLockIDs: []uint64{1},
+		},
+		{
+			name:       "not unlocking only",
+			req:        types.LocksFilteredRequest{Owner: addr1.String(), Unlocking: types.NotUnlockingOnly},
+			expLockIDs: []uint64{2, 3},
+		},
+		{
+			name:       "with synthetic only",
+			req:        types.LocksFilteredRequest{Synthetic: types.WithSyntheticOnly},
+			expLockIDs: []uint64{3},
+		},
+		{
+			name:       "without synthetic only",
+			req:        types.LocksFilteredRequest{Owner: addr1.String(), Synthetic: types.WithoutSyntheticOnly},
+			expLockIDs: []uint64{2, 1},
+		},
+		{
+			name:       "filtered page",
+			req:        types.LocksFilteredRequest{Synthetic: types.WithoutSyntheticOnly, Pagination: &query.PageRequest{Offset: 1, Limit: 2}},
+			expLockIDs: []uint64{4, 1},
+		},
+		{
+			name:   "max duration not longer than min duration",
+			req:    types.LocksFilteredRequest{MinDuration: 2 * time.Second, MaxDuration: time.Second},
+			expErr: true,
+		},
+		{
+			name:   "invalid owner",
+			req:    types.LocksFilteredRequest{Owner: "invalid"},
+			expErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		res, err := suite.querier.LocksFiltered(sdk.WrapSDKContext(suite.ctx), &tc.req)
+		if tc.expErr {
+			suite.Require().Error(err, tc.name)
+			continue
+		}
+		suite.Require().NoError(err, tc.name)
+		lockIDs := []uint64{}
+		for _, lock := range res.Locks {
+			lockIDs = append(lockIDs, lock.ID)
+		}
+		suite.Require().Equal(tc.expLockIDs, lockIDs, tc.name)
+	}
+}
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	epochstypes "github.com/osmosis-labs/osmosis/v7/x/epochs/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Hooks wrapper struct for lockup keeper.
+type Hooks struct {
+	k Keeper
+}
+
+var _ epochstypes.EpochHooks = Hooks{}
+
+// Return the wrapper struct.
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// epochs hooks
+// Don't do anything pre epoch start.
+func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+}
+
+// AfterEpochEnd checkpoints the lock accumulation store at the end of every
+// checkpoint epoch, and prunes the checkpoints older than the keep period.
+func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	params := h.k.GetParams(ctx)
+	if epochIdentifier != params.AccumulationCheckpointEpochIdentifier {
+		return
+	}
+	h.k.CheckpointAccumulationStores(ctx)
+	h.k.PruneAccumulationCheckpoints(ctx, ctx.BlockTime().Add(-params.AccumulationCheckpointKeepPeriod))
+}
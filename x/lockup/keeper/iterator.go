@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"bytes"
+	"fmt"
+	"math"
 	"time"
 
 	"github.com/osmosis-labs/osmosis/v7/x/lockup/types"
@@ -8,6 +11,7 @@ import (
 
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 func unlockingPrefix(isUnlocking bool) []byte {
@@ -82,6 +86,12 @@ func (k Keeper) LockIterator(ctx sdk.Context, isUnlocking bool) sdk.Iterator {
 	return k.iterator(ctx, combineKeys(unlockingPrefix, types.KeyPrefixLockDuration))
 }
 
+// LockIteratorLongerDuration returns the iterator used for getting all locks longer than duration.
+func (k Keeper) LockIteratorLongerDuration(ctx sdk.Context, isUnlocking bool, duration time.Duration) sdk.Iterator {
+	unlockingPrefix := unlockingPrefix(isUnlocking)
+	return k.iteratorLongerDuration(ctx, combineKeys(unlockingPrefix, types.KeyPrefixLockDuration), duration)
+}
+
 // LockIteratorAfterTimeDenom returns the iterator to get locked coins by denom.
 func (k Keeper) LockIteratorAfterTimeDenom(ctx sdk.Context, denom string, time time.Time) sdk.Iterator {
 	unlockingPrefix := unlockingPrefix(true)
@@ -180,6 +190,96 @@ func (k Keeper) getLocksFromIterator(ctx sdk.Context, iterator db.Iterator) []ty
 	return locks
 }
 
+// paginateLocksFromIterators returns a page of the locks the iterators point to,
+// walking the iterators one after the other and skipping the locks filter
+// rejects. The next key of a page is
+// the index of the iterator to resume followed by the key to resume it from.
+// Without a page request, every lock is returned along with a nil page response.
+func (k Keeper) paginateLocksFromIterators(ctx sdk.Context, pageReq *query.PageRequest, filter func(types.PeriodLock) bool, iterators ...db.Iterator) ([]types.PeriodLock, *query.PageResponse, error) {
+	for _, iterator := range iterators {
+		defer iterator.Close()
+	}
+
+	paginated := pageReq != nil
+	if !paginated {
+		pageReq = &query.PageRequest{Limit: math.MaxUint64}
+	}
+	if pageReq.Offset > 0 && len(pageReq.Key) > 0 {
+		return nil, nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
+	}
+	if pageReq.Reverse {
+		return nil, nil, fmt.Errorf("invalid request, reverse pagination is not supported for locks")
+	}
+	limit, countTotal := pageReq.Limit, pageReq.CountTotal
+	if limit == 0 {
+		limit = query.DefaultLimit
+		countTotal = true
+	}
+
+	start, resumeKey := 0, []byte(nil)
+	if len(pageReq.Key) > 0 {
+		start, resumeKey = int(pageReq.Key[0]), pageReq.Key[1:]
+		if start >= len(iterators) {
+			return nil, nil, fmt.Errorf("invalid request, key %X is out of range", pageReq.Key)
+		}
+	}
+
+	locks := []types.PeriodLock{}
+	var nextKey []byte
+	total := uint64(0)
+	for i := start; i < len(iterators); i++ {
+		for iterator := iterators[i]; iterator.Valid(); iterator.Next() {
+			if i == start && bytes.Compare(iterator.Key(), resumeKey) < 0 {
+				continue
+			}
+
+			var lock *types.PeriodLock
+			if filter != nil {
+				var err error
+				lock, err = k.GetLockByID(ctx, sdk.BigEndianToUint64(iterator.Value()))
+				if err != nil {
+					return nil, nil, err
+				}
+				if !filter(*lock) {
+					continue
+				}
+			}
+
+			total++
+			if total <= pageReq.Offset {
+				continue
+			}
+			if uint64(len(locks)) == limit {
+				if nextKey == nil {
+					nextKey = append([]byte{byte(i)}, iterator.Key()...)
+				}
+				if !countTotal {
+					return locks, &query.PageResponse{NextKey: nextKey}, nil
+				}
+				continue
+			}
+
+			if lock == nil {
+				var err error
+				lock, err = k.GetLockByID(ctx, sdk.BigEndianToUint64(iterator.Value()))
+				if err != nil {
+					return nil, nil, err
+				}
+			}
+			locks = append(locks, *lock)
+		}
+	}
+
+	if !paginated {
+		return locks, nil, nil
+	}
+	pageRes := &query.PageResponse{NextKey: nextKey}
+	if countTotal && len(pageReq.Key) == 0 {
+		pageRes.Total = total
+	}
+	return locks, pageRes, nil
+}
+
 func (k Keeper) unlockFromIterator(ctx sdk.Context, iterator db.Iterator) ([]types.PeriodLock, sdk.Coins) {
 	// Note: this function is only used for an account
 	// and this has no conflicts with synthetic lockups
@@ -201,12 +301,17 @@ func (k Keeper) beginUnlockFromIterator(ctx sdk.Context, iterator db.Iterator) (
 	// Note: this function is only used for an account
 	// and this has no conflicts with synthetic lockups
 
-	locks := k.getLocksFromIterator(ctx, iterator)
-	for _, lock := range locks {
+	// tokenized locks are left to their receipt holders
+	locks := []types.PeriodLock{}
+	for _, lock := range k.getLocksFromIterator(ctx, iterator) {
+		if lock.Tokenized {
+			continue
+		}
 		err := k.BeginUnlock(ctx, lock.ID, nil)
 		if err != nil {
 			return locks, err
 		}
+		locks = append(locks, lock)
 	}
 	return locks, nil
 }
@@ -9,28 +9,36 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 // Keeper provides a way to manage module storage.
 type Keeper struct {
-	cdc      codec.Codec
-	storeKey sdk.StoreKey
+	cdc        codec.Codec
+	storeKey   sdk.StoreKey
+	paramSpace paramtypes.Subspace
 
 	hooks types.LockupHooks
 
 	ak types.AccountKeeper
 	bk types.BankKeeper
 	dk types.DistrKeeper
+	wk types.WasmKeeper
 }
 
 // NewKeeper returns an instance of Keeper.
-func NewKeeper(cdc codec.Codec, storeKey sdk.StoreKey, ak types.AccountKeeper, bk types.BankKeeper, dk types.DistrKeeper) *Keeper {
+func NewKeeper(cdc codec.Codec, storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, ak types.AccountKeeper, bk types.BankKeeper, dk types.DistrKeeper) *Keeper {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return &Keeper{
-		cdc:      cdc,
-		storeKey: storeKey,
-		ak:       ak,
-		bk:       bk,
-		dk:       dk,
+		cdc:        cdc,
+		storeKey:   storeKey,
+		paramSpace: paramSpace,
+		ak:         ak,
+		bk:         bk,
+		dk:         dk,
 	}
 }
 
@@ -50,6 +58,18 @@ func (k *Keeper) SetHooks(lh types.LockupHooks) *Keeper {
 	return k
 }
 
+// SetWasmKeeper sets the keeper used to call the callback contracts of lock owners.
+// Without one, callback contracts cannot be registered and are not called.
+func (k *Keeper) SetWasmKeeper(wk types.WasmKeeper) *Keeper {
+	if k.wk != nil {
+		panic("cannot set lockup wasm keeper twice")
+	}
+
+	k.wk = wk
+
+	return k
+}
+
 // AdminKeeper defines a god privilege keeper functions to remove tokens from locks and create new locks
 // For the governance system of token pools, we want a "ragequit" feature
 // So governance changes will take 1 week to go into effect
@@ -7,18 +7,21 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/osmosis-labs/osmosis/v7/store"
+	gammtypes "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/v7/x/lockup/types"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // TODO: Reorganize functions in this file
 
 // WithdrawAllMaturedLocks withdraws every lock thats in the process of unlocking, and has finished unlocking by
-// the current block time.
+// the current block time. As the number of matured locks is unbounded, the callback contracts of their
+// owners are not called, and EventCallbackContractSkipped is emitted instead.
 func (k Keeper) WithdrawAllMaturedLocks(ctx sdk.Context) {
-	k.unlockFromIterator(ctx, k.LockIteratorBeforeTime(ctx, ctx.BlockTime()))
+	k.unlockFromIterator(withoutCallbackContracts(ctx), k.LockIteratorBeforeTime(ctx, ctx.BlockTime()))
 }
 
 func (k Keeper) getCoinsFromLocks(locks []types.PeriodLock) sdk.Coins {
@@ -129,6 +132,10 @@ func (k Keeper) AddTokensToLockByID(ctx sdk.Context, lockID uint64, coins sdk.Co
 	if err != nil {
 		return nil, err
 	}
+	err = ctx.EventManager().EmitTypedEvent(&types.EventLockTokensAdded{Lock: *lock, Added: coins})
+	if err != nil {
+		return nil, err
+	}
 
 	if k.hooks == nil {
 		return lock, nil
@@ -155,6 +162,10 @@ func (k Keeper) SlashTokensFromLockByID(ctx sdk.Context, lockID uint64, coins sd
 	if err != nil {
 		return nil, err
 	}
+	err = ctx.EventManager().EmitTypedEvent(&types.EventLockSlashed{Lock: *lock, Slashed: coins})
+	if err != nil {
+		return nil, err
+	}
 
 	if k.hooks == nil {
 		return lock, nil
@@ -388,6 +399,11 @@ func (k Keeper) Lock(ctx sdk.Context, lock types.PeriodLock) error {
 		k.accumulationStore(ctx, coin.Denom).Increase(accumulationKey(lock.Duration), coin.Amount)
 	}
 
+	err = ctx.EventManager().EmitTypedEvent(&types.EventLockCreated{Lock: lock})
+	if err != nil {
+		return err
+	}
+
 	k.hooks.OnTokenLocked(ctx, owner, lock.ID, lock.Coins, lock.Duration, lock.EndTime)
 	return nil
 }
@@ -407,7 +423,13 @@ func (k Keeper) splitLock(ctx sdk.Context, lock types.PeriodLock, coins sdk.Coin
 	k.SetLastLockID(ctx, splitLockID)
 
 	splitLock := types.NewPeriodLock(splitLockID, lock.OwnerAddress(), lock.Duration, lock.EndTime, coins)
+	splitLock.AutoCompound = lock.AutoCompound
 	err = k.setLock(ctx, splitLock)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventLockSplit{Lock: lock, NewLock: splitLock})
 	return splitLock, err
 }
 
@@ -422,6 +444,10 @@ func (k Keeper) BeginUnlock(ctx sdk.Context, lockID uint64, coins sdk.Coins) err
 	if k.HasAnySyntheticLockups(ctx, lock.ID) {
 		return fmt.Errorf("cannot BeginUnlocking a lock with synthetic lockup")
 	}
+	// tokenized locks are unlocked by their receipt holder, with BeginUnlockWithReceipt
+	if lock.Tokenized {
+		return sdkerrors.Wrapf(types.ErrLockTokenized, "lock %d", lock.ID)
+	}
 
 	return k.beginForceUnlock(ctx, *lock, coins)
 }
@@ -448,6 +474,9 @@ func (k Keeper) beginForceUnlock(ctx sdk.Context, lock types.PeriodLock, coins s
 		if err != nil {
 			return err
 		}
+		if k.hooks != nil {
+			k.hooks.OnLockupSplit(ctx, lock.ID, splitLock.ID, coins)
+		}
 		lock = splitLock
 	}
 
@@ -470,6 +499,11 @@ func (k Keeper) beginForceUnlock(ctx sdk.Context, lock types.PeriodLock, coins s
 		return err
 	}
 
+	err = ctx.EventManager().EmitTypedEvent(&types.EventLockUnlockStarted{Lock: lock})
+	if err != nil {
+		return err
+	}
+
 	if k.hooks != nil {
 		k.hooks.OnStartUnlock(ctx, lock.OwnerAddress(), lock.ID, lock.Coins, lock.Duration, lock.EndTime)
 	}
@@ -477,6 +511,296 @@ func (k Keeper) beginForceUnlock(ctx sdk.Context, lock types.PeriodLock, coins s
 	return nil
 }
 
+// ExtendLockup raises the duration of a lock that has not started unlocking.
+// The lock moves from the old duration's lock refs and accumulation store
+// entries to the new duration's, so queries and distributions see the new
+// duration in the same block.
+func (k Keeper) ExtendLockup(ctx sdk.Context, lockID uint64, owner sdk.AccAddress, newDuration time.Duration) error {
+	lock, err := k.GetLockByID(ctx, lockID)
+	if err != nil {
+		return err
+	}
+	if lock.Owner != owner.String() {
+		return sdkerrors.Wrapf(types.ErrNotLockOwner, "msg sender (%s) and lock owner (%s) does not match", owner, lock.Owner)
+	}
+	if lock.Tokenized {
+		return sdkerrors.Wrapf(types.ErrLockTokenized, "lock %d", lock.ID)
+	}
+	if lock.IsUnlocking() {
+		return sdkerrors.Wrapf(types.ErrLockUnlocking, "lock %d", lock.ID)
+	}
+	prevDuration := lock.Duration
+	if newDuration <= prevDuration {
+		return sdkerrors.Wrapf(types.ErrDurationNotLonger, "%s <= %s", newDuration, prevDuration)
+	}
+
+	err = k.deleteLockRefs(ctx, types.KeyPrefixNotUnlocking, *lock)
+	if err != nil {
+		return err
+	}
+	for _, coin := range lock.Coins {
+		k.accumulationStore(ctx, coin.Denom).Decrease(accumulationKey(prevDuration), coin.Amount)
+		k.accumulationStore(ctx, coin.Denom).Increase(accumulationKey(newDuration), coin.Amount)
+	}
+
+	lock.Duration = newDuration
+	err = k.setLockAndResetLockRefs(ctx, *lock)
+	if err != nil {
+		return err
+	}
+	err = ctx.EventManager().EmitTypedEvent(&types.EventLockExtended{Lock: *lock, PrevDuration: prevDuration})
+	if err != nil {
+		return err
+	}
+
+	if k.hooks != nil {
+		k.hooks.OnLockupExtend(ctx, lock.ID, prevDuration, newDuration)
+	}
+	return nil
+}
+
+// TransferLock transfers a lock, unlocking or not, and its synthetic locks to
+// a new owner. Only the account lock refs change, since the lock's coins stay
+// in the module account and its accumulation store entries are not by owner.
+func (k Keeper) TransferLock(ctx sdk.Context, lockID uint64, owner, newOwner sdk.AccAddress) error {
+	lock, err := k.GetLockByID(ctx, lockID)
+	if err != nil {
+		return err
+	}
+	if lock.Owner != owner.String() {
+		return sdkerrors.Wrapf(types.ErrNotLockOwner, "msg sender (%s) and lock owner (%s) does not match", owner, lock.Owner)
+	}
+	if lock.Tokenized {
+		return sdkerrors.Wrapf(types.ErrLockTokenized, "lock %d", lock.ID)
+	}
+	if owner.Equals(newOwner) {
+		return types.ErrSameLockOwner
+	}
+	return k.transferLock(ctx, *lock, newOwner)
+}
+
+// transferLock moves the refs of a lock and its synthetic locks to a new
+// owner, and stores the lock.
+func (k Keeper) transferLock(ctx sdk.Context, lock types.PeriodLock, newOwner sdk.AccAddress) error {
+	owner := lock.OwnerAddress()
+
+	// remove the refs under the current owner
+	synthLocks := k.GetAllSyntheticLockupsByLockup(ctx, lock.ID)
+	err := k.deleteLockRefs(ctx, unlockingPrefix(lock.IsUnlocking()), lock)
+	if err != nil {
+		return err
+	}
+	for _, synthLock := range synthLocks {
+		err = k.deleteSyntheticLockRefs(ctx, lock, synthLock)
+		if err != nil {
+			return err
+		}
+	}
+
+	// and add them back under the new owner, who has to opt in to auto-compounding themselves
+	lock.Owner = newOwner.String()
+	lock.AutoCompound = false
+	err = k.setLockAndResetLockRefs(ctx, lock)
+	if err != nil {
+		return err
+	}
+	for _, synthLock := range synthLocks {
+		err = k.addSyntheticLockRefs(ctx, lock, synthLock)
+		if err != nil {
+			return err
+		}
+	}
+	err = ctx.EventManager().EmitTypedEvent(&types.EventLockTransferred{Lock: lock, PrevOwner: owner.String()})
+	if err != nil {
+		return err
+	}
+
+	if k.hooks != nil {
+		k.hooks.OnLockupTransfer(ctx, lock.ID, owner, newOwner)
+	}
+	return nil
+}
+
+// SetAutoCompound opts a lock in or out of having its incentive rewards
+// compounded into it. Only locks of the shares of a single gamm pool can opt in.
+func (k Keeper) SetAutoCompound(ctx sdk.Context, lockID uint64, owner sdk.AccAddress, autoCompound bool) error {
+	lock, err := k.GetLockByID(ctx, lockID)
+	if err != nil {
+		return err
+	}
+	if lock.Owner != owner.String() {
+		return sdkerrors.Wrapf(types.ErrNotLockOwner, "msg sender (%s) and lock owner (%s) does not match", owner, lock.Owner)
+	}
+	if lock.Tokenized {
+		return sdkerrors.Wrapf(types.ErrLockTokenized, "lock %d", lock.ID)
+	}
+	// rewards are compounded by joining the pool of the locked shares
+	if autoCompound {
+		coin, err := lock.SingleCoin()
+		if err != nil {
+			return sdkerrors.Wrapf(types.ErrLockNotCompoundable, "lock %d: %s", lock.ID, err)
+		}
+		if _, err := gammtypes.GetPoolIdFromShareDenom(coin.Denom); err != nil {
+			return sdkerrors.Wrapf(types.ErrLockNotCompoundable, "lock %d: %s", lock.ID, err)
+		}
+	}
+
+	// the lock refs do not depend on the flag
+	lock.AutoCompound = autoCompound
+	err = k.setLock(ctx, *lock)
+	if err != nil {
+		return err
+	}
+	return ctx.EventManager().EmitTypedEvent(&types.EventLockAutoCompoundSet{Lock: *lock})
+}
+
+// SplitLock moves coins out of a lock that is not unlocking into a new lock
+// with the same owner and duration. The new lock gets a copy of each of the
+// lock's synthetic locks, so that the accumulation stores do not change and
+// superfluid staked coins stay staked in both locks.
+func (k Keeper) SplitLock(ctx sdk.Context, lockID uint64, owner sdk.AccAddress, coins sdk.Coins) (types.PeriodLock, error) {
+	lock, err := k.GetLockByID(ctx, lockID)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+	if lock.Owner != owner.String() {
+		return types.PeriodLock{}, sdkerrors.Wrapf(types.ErrNotLockOwner, "msg sender (%s) and lock owner (%s) does not match", owner, lock.Owner)
+	}
+	if lock.Tokenized {
+		return types.PeriodLock{}, sdkerrors.Wrapf(types.ErrLockTokenized, "lock %d", lock.ID)
+	}
+	if lock.IsUnlocking() {
+		return types.PeriodLock{}, sdkerrors.Wrapf(types.ErrLockUnlocking, "lock %d", lock.ID)
+	}
+	if coins.Empty() || !coins.IsAllLTE(lock.Coins) || lock.Coins.Sub(coins).Empty() {
+		return types.PeriodLock{}, sdkerrors.Wrapf(types.ErrInvalidSplitCoins, "cannot split %s out of %s", coins, lock.Coins)
+	}
+
+	splitLock, err := k.splitLock(ctx, *lock, coins)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+	err = k.addLockRefs(ctx, splitLock)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+	for _, synthLock := range k.GetAllSyntheticLockupsByLockup(ctx, lock.ID) {
+		synthLock.UnderlyingLockId = splitLock.ID
+		err = k.setSyntheticLockAndResetRefs(ctx, splitLock, synthLock)
+		if err != nil {
+			return types.PeriodLock{}, err
+		}
+		err = ctx.EventManager().EmitTypedEvent(&types.EventSyntheticLockCreated{SyntheticLock: synthLock, Lock: splitLock})
+		if err != nil {
+			return types.PeriodLock{}, err
+		}
+	}
+
+	if k.hooks != nil {
+		k.hooks.OnLockupSplit(ctx, lock.ID, splitLock.ID, coins)
+	}
+	return splitLock, nil
+}
+
+// MergeLocks merges locks of an owner into the first of them, and deletes the
+// others. The locks must hold a single coin of the same denom, have the same
+// duration, not be unlocking and have the same synthetic locks, so that the
+// accumulation stores do not change and superfluid staked locks only merge
+// with locks staked the same way.
+func (k Keeper) MergeLocks(ctx sdk.Context, lockIDs []uint64, owner sdk.AccAddress) (types.PeriodLock, error) {
+	if len(lockIDs) < 2 {
+		return types.PeriodLock{}, sdkerrors.Wrap(types.ErrLocksNotMergeable, "at least two locks are needed")
+	}
+
+	locks := make([]types.PeriodLock, 0, len(lockIDs))
+	synthLocks := make([][]types.SyntheticLock, 0, len(lockIDs))
+	seen := make(map[uint64]bool, len(lockIDs))
+	for _, lockID := range lockIDs {
+		if seen[lockID] {
+			return types.PeriodLock{}, sdkerrors.Wrapf(types.ErrLocksNotMergeable, "lock %d is listed twice", lockID)
+		}
+		seen[lockID] = true
+		lock, err := k.GetLockByID(ctx, lockID)
+		if err != nil {
+			return types.PeriodLock{}, err
+		}
+		if lock.Owner != owner.String() {
+			return types.PeriodLock{}, sdkerrors.Wrapf(types.ErrNotLockOwner, "msg sender (%s) and lock owner (%s) does not match", owner, lock.Owner)
+		}
+		if lock.Tokenized {
+			return types.PeriodLock{}, sdkerrors.Wrapf(types.ErrLockTokenized, "lock %d", lock.ID)
+		}
+		if lock.IsUnlocking() {
+			return types.PeriodLock{}, sdkerrors.Wrapf(types.ErrLockUnlocking, "lock %d", lock.ID)
+		}
+		coin, err := lock.SingleCoin()
+		if err != nil {
+			return types.PeriodLock{}, sdkerrors.Wrapf(types.ErrLocksNotMergeable, "lock %d: %s", lock.ID, err)
+		}
+		lockSynthLocks := k.GetAllSyntheticLockupsByLockup(ctx, lock.ID)
+		if len(locks) > 0 {
+			if coin.Denom != locks[0].Coins[0].Denom || lock.Duration != locks[0].Duration {
+				return types.PeriodLock{}, sdkerrors.Wrapf(types.ErrLocksNotMergeable, "lock %d has a different denom or duration than lock %d", lock.ID, locks[0].ID)
+			}
+			if !sameSyntheticLocks(lockSynthLocks, synthLocks[0]) {
+				return types.PeriodLock{}, sdkerrors.Wrapf(types.ErrLocksNotMergeable, "lock %d has different synthetic locks than lock %d", lock.ID, locks[0].ID)
+			}
+		}
+		locks = append(locks, *lock)
+		synthLocks = append(synthLocks, lockSynthLocks)
+	}
+
+	mergedLock := locks[0]
+	for i, lock := range locks[1:] {
+		for _, synthLock := range synthLocks[i+1] {
+			err := k.deleteSyntheticLockRefs(ctx, lock, synthLock)
+			if err != nil {
+				return types.PeriodLock{}, err
+			}
+			k.deleteSyntheticLockupObject(ctx, lock.ID, synthLock.SynthDenom)
+			err = ctx.EventManager().EmitTypedEvent(&types.EventSyntheticLockDeleted{SyntheticLock: synthLock, Lock: lock})
+			if err != nil {
+				return types.PeriodLock{}, err
+			}
+		}
+		err := k.deleteLockRefs(ctx, types.KeyPrefixNotUnlocking, lock)
+		if err != nil {
+			return types.PeriodLock{}, err
+		}
+		k.deleteLock(ctx, lock.ID)
+		mergedLock.Coins = mergedLock.Coins.Add(lock.Coins...)
+	}
+
+	// the lock refs do not depend on the amount locked
+	err := k.setLock(ctx, mergedLock)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+	err = ctx.EventManager().EmitTypedEvent(&types.EventLocksMerged{Lock: mergedLock, MergedLockIds: lockIDs[1:]})
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+
+	if k.hooks != nil {
+		k.hooks.OnLockupMerge(ctx, mergedLock.ID, lockIDs[1:])
+	}
+	return mergedLock, nil
+}
+
+// sameSyntheticLocks returns whether two locks have synthetic locks of the
+// same denoms, durations and end times.
+func sameSyntheticLocks(a, b []types.SyntheticLock) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].SynthDenom != b[i].SynthDenom || a[i].Duration != b[i].Duration || !a[i].EndTime.Equal(b[i].EndTime) {
+			return false
+		}
+	}
+	return true
+}
+
 // Unlock is a utility to unlock coins from module account.
 func (k Keeper) Unlock(ctx sdk.Context, lockID uint64) error {
 	lock, err := k.GetLockByID(ctx, lockID)
@@ -505,10 +829,40 @@ func (k Keeper) ForceUnlock(ctx sdk.Context, lock types.PeriodLock) error {
 		if err != nil {
 			return err
 		}
+
+		// the lock refs moved to the unlocking queue under the lock's new
+		// end time, so unlock the lock as stored now.
+		unlockingLock, err := k.GetLockByID(ctx, lock.ID)
+		if err != nil {
+			return err
+		}
+		lock = *unlockingLock
 	}
 	return k.unlockInternalLogic(ctx, lock)
 }
 
+// PartialForceUnlock immediately unlocks coins from a lock and refunds them,
+// ignoring its duration. The whole lock is unlocked if coins is empty or
+// equals the locked coins; otherwise coins are split into a new lock first.
+func (k Keeper) PartialForceUnlock(ctx sdk.Context, lock types.PeriodLock, coins sdk.Coins) error {
+	if !coins.IsAllLTE(lock.Coins) {
+		return fmt.Errorf("requested amount to unlock exceeds locked tokens")
+	}
+
+	if len(coins) != 0 && !coins.IsEqual(lock.Coins) {
+		splitLock, err := k.splitLock(ctx, lock, coins)
+		if err != nil {
+			return err
+		}
+		if k.hooks != nil {
+			k.hooks.OnLockupSplit(ctx, lock.ID, splitLock.ID, coins)
+		}
+		lock = splitLock
+	}
+
+	return k.ForceUnlock(ctx, lock)
+}
+
 func (k Keeper) unlockInternalLogic(ctx sdk.Context, lock types.PeriodLock) error {
 	owner, err := sdk.AccAddressFromBech32(lock.Owner)
 	if err != nil {
@@ -533,6 +887,11 @@ func (k Keeper) unlockInternalLogic(ctx sdk.Context, lock types.PeriodLock) erro
 		k.accumulationStore(ctx, coin.Denom).Decrease(accumulationKey(lock.Duration), coin.Amount)
 	}
 
+	err = ctx.EventManager().EmitTypedEvent(&types.EventLockUnlocked{Lock: lock})
+	if err != nil {
+		return err
+	}
+
 	k.hooks.OnTokenUnlocked(ctx, owner, lock.ID, lock.Coins, lock.Duration, lock.EndTime)
 	return nil
 }
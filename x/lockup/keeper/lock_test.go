@@ -8,6 +8,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
 )
 
 func (suite *KeeperTestSuite) TestBeginUnlocking() { // test for all unlockable coins
@@ -203,6 +204,28 @@ func (suite *KeeperTestSuite) TestUnlock() {
 	suite.Require().NoError(err)
 }
 
+func (suite *KeeperTestSuite) TestForceUnlock() {
+	suite.SetupTest()
+
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+	lock := types.NewPeriodLock(1, addr1, time.Hour, time.Time{}, coins)
+
+	err := simapp.FundAccount(suite.app.BankKeeper, suite.ctx, addr1, coins)
+	suite.Require().NoError(err)
+	err = suite.app.LockupKeeper.Lock(suite.ctx, lock)
+	suite.Require().NoError(err)
+
+	// a lock that is not unlocking is unlocked at once, leaving no refs
+	// behind.
+	err = suite.app.LockupKeeper.ForceUnlock(suite.ctx, lock)
+	suite.Require().NoError(err)
+	suite.Require().Equal(coins, suite.app.BankKeeper.GetAllBalances(suite.ctx, addr1))
+	suite.Require().Empty(suite.app.LockupKeeper.GetAccountPeriodLocks(suite.ctx, addr1))
+	suite.Require().Empty(suite.app.LockupKeeper.GetLocksDenom(suite.ctx, "stake"))
+	suite.Require().Equal(sdk.ZeroInt(), suite.app.LockupKeeper.GetLockedDenom(suite.ctx, "stake", 0))
+}
+
 func (suite *KeeperTestSuite) TestPartialUnlock() {
 	suite.SetupTest()
 	now := suite.ctx.BlockTime()
@@ -648,3 +671,314 @@ func (suite *KeeperTestSuite) TestSlashTokensFromLockByID() {
 	_, err = suite.app.LockupKeeper.SlashTokensFromLockByID(suite.ctx, 1, sdk.Coins{sdk.NewInt64Coin("stake1", 1)})
 	suite.Require().Error(err)
 }
+
+func (suite *KeeperTestSuite) TestExtendLockup() {
+	suite.SetupTest()
+
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+	suite.LockTokens(addr1, coins, time.Second)
+
+	// only the owner can extend, and only to a longer duration
+	err := suite.app.LockupKeeper.ExtendLockup(suite.ctx, 1, addr2, time.Hour)
+	suite.Require().ErrorIs(err, types.ErrNotLockOwner)
+	err = suite.app.LockupKeeper.ExtendLockup(suite.ctx, 1, addr1, time.Second)
+	suite.Require().ErrorIs(err, types.ErrDurationNotLonger)
+
+	err = suite.app.LockupKeeper.ExtendLockup(suite.ctx, 1, addr1, time.Hour)
+	suite.Require().NoError(err)
+
+	lock, err := suite.app.LockupKeeper.GetLockByID(suite.ctx, 1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(time.Hour, lock.Duration)
+	suite.Require().False(lock.IsUnlocking())
+
+	// the lock refs and accumulation store moved to the new duration
+	locks := suite.app.LockupKeeper.GetLocksLongerThanDurationDenom(suite.ctx, "stake", time.Hour)
+	suite.Require().Len(locks, 1)
+	locks = suite.app.LockupKeeper.GetAccountLockedDurationNotUnlockingOnly(suite.ctx, addr1, "stake", time.Second)
+	suite.Require().Len(locks, 0)
+	locks = suite.app.LockupKeeper.GetAccountLockedDurationNotUnlockingOnly(suite.ctx, addr1, "stake", time.Hour)
+	suite.Require().Len(locks, 1)
+	accum := suite.app.LockupKeeper.GetPeriodLocksAccumulation(suite.ctx, types.QueryCondition{
+		LockQueryType: types.ByDuration,
+		Denom:         "stake",
+		Duration:      time.Hour,
+	})
+	suite.Require().Equal(int64(10), accum.Int64())
+	accum = suite.app.LockupKeeper.GetPeriodLocksAccumulation(suite.ctx, types.QueryCondition{
+		LockQueryType: types.ByDuration,
+		Denom:         "stake",
+		Duration:      time.Hour + time.Second,
+	})
+	suite.Require().Equal(int64(0), accum.Int64())
+
+	// unlocking locks can't be extended
+	err = suite.app.LockupKeeper.BeginUnlock(suite.ctx, 1, nil)
+	suite.Require().NoError(err)
+	err = suite.app.LockupKeeper.ExtendLockup(suite.ctx, 1, addr1, 2*time.Hour)
+	suite.Require().ErrorIs(err, types.ErrLockUnlocking)
+
+	// the unlocking lock unlocks after its new duration
+	lock, err = suite.app.LockupKeeper.GetLockByID(suite.ctx, 1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.ctx.BlockTime().Add(time.Hour), lock.EndTime)
+}
+
+func (suite *KeeperTestSuite) TestTransferLock() {
+	suite.SetupTest()
+
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+	suite.LockTokens(addr1, coins, time.Second)
+	err := suite.app.LockupKeeper.CreateSyntheticLockup(suite.ctx, 1, "synth", time.Second, false)
+	suite.Require().NoError(err)
+
+	// only the owner can transfer, and only to another account
+	err = suite.app.LockupKeeper.TransferLock(suite.ctx, 1, addr2, addr1)
+	suite.Require().ErrorIs(err, types.ErrNotLockOwner)
+	err = suite.app.LockupKeeper.TransferLock(suite.ctx, 1, addr1, addr1)
+	suite.Require().ErrorIs(err, types.ErrSameLockOwner)
+
+	err = suite.app.LockupKeeper.TransferLock(suite.ctx, 1, addr1, addr2)
+	suite.Require().NoError(err)
+
+	lock, err := suite.app.LockupKeeper.GetLockByID(suite.ctx, 1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(addr2.String(), lock.Owner)
+
+	// the account lock refs and synthetic lock refs moved to the new owner
+	suite.Require().Len(suite.app.LockupKeeper.GetAccountPeriodLocks(suite.ctx, addr1), 0)
+	suite.Require().Len(suite.app.LockupKeeper.GetAccountPeriodLocks(suite.ctx, addr2), 1)
+	suite.Require().Len(suite.app.LockupKeeper.GetAccountLockedLongerDurationDenom(suite.ctx, addr1, "synth", time.Second), 0)
+	suite.Require().Len(suite.app.LockupKeeper.GetAccountLockedLongerDurationDenom(suite.ctx, addr2, "synth", time.Second), 1)
+	suite.Require().Len(suite.app.LockupKeeper.GetAllSyntheticLockupsByAddr(suite.ctx, addr2), 1)
+
+	// the lock unlocks to the new owner
+	err = suite.app.LockupKeeper.BeginUnlock(suite.ctx, 1, nil)
+	suite.Require().Error(err) // synthetic lock is still there
+	err = suite.app.LockupKeeper.DeleteSyntheticLockup(suite.ctx, 1, "synth")
+	suite.Require().NoError(err)
+	err = suite.app.LockupKeeper.BeginUnlock(suite.ctx, 1, nil)
+	suite.Require().NoError(err)
+	suite.Require().Len(suite.app.LockupKeeper.GetAccountUnlockingCoins(suite.ctx, addr2), 1)
+
+	// unlocking locks can be transferred too
+	err = suite.app.LockupKeeper.TransferLock(suite.ctx, 1, addr2, addr1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(coins, suite.app.LockupKeeper.GetAccountUnlockingCoins(suite.ctx, addr1))
+	suite.Require().True(suite.app.LockupKeeper.GetAccountUnlockingCoins(suite.ctx, addr2).Empty())
+
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Second))
+	suite.app.LockupKeeper.WithdrawAllMaturedLocks(suite.ctx)
+	suite.Require().Equal(coins, suite.app.BankKeeper.GetAllBalances(suite.ctx, addr1))
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, addr2).Empty())
+}
+
+func (suite *KeeperTestSuite) TestSplitLock() {
+	suite.SetupTest()
+
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("gamm/pool/1", 10)}
+	suite.LockTokens(addr1, coins, time.Second)
+	err := suite.app.LockupKeeper.CreateSyntheticLockup(suite.ctx, 1, "synth", time.Second, false)
+	suite.Require().NoError(err)
+	err = suite.app.LockupKeeper.SetAutoCompound(suite.ctx, 1, addr1, true)
+	suite.Require().NoError(err)
+
+	// only the owner can split, and only part of the lock's coins
+	_, err = suite.app.LockupKeeper.SplitLock(suite.ctx, 1, addr2, sdk.Coins{sdk.NewInt64Coin("gamm/pool/1", 4)})
+	suite.Require().ErrorIs(err, types.ErrNotLockOwner)
+	_, err = suite.app.LockupKeeper.SplitLock(suite.ctx, 1, addr1, coins)
+	suite.Require().ErrorIs(err, types.ErrInvalidSplitCoins)
+	_, err = suite.app.LockupKeeper.SplitLock(suite.ctx, 1, addr1, sdk.Coins{sdk.NewInt64Coin("gamm/pool/1", 11)})
+	suite.Require().ErrorIs(err, types.ErrInvalidSplitCoins)
+	_, err = suite.app.LockupKeeper.SplitLock(suite.ctx, 1, addr1, sdk.Coins{sdk.NewInt64Coin("foo", 1)})
+	suite.Require().ErrorIs(err, types.ErrInvalidSplitCoins)
+
+	splitLock, err := suite.app.LockupKeeper.SplitLock(suite.ctx, 1, addr1, sdk.Coins{sdk.NewInt64Coin("gamm/pool/1", 4)})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), splitLock.ID)
+	suite.Require().Equal(time.Second, splitLock.Duration)
+	suite.Require().True(splitLock.AutoCompound)
+
+	lock, err := suite.app.LockupKeeper.GetLockByID(suite.ctx, 1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("gamm/pool/1", 6)}, lock.Coins)
+
+	// both locks are found by the lock refs, and the split lock has the synthetic lock too
+	suite.Require().Len(suite.app.LockupKeeper.GetAccountPeriodLocks(suite.ctx, addr1), 2)
+	suite.Require().Len(suite.app.LockupKeeper.GetAccountLockedLongerDurationDenom(suite.ctx, addr1, "synth", time.Second), 2)
+	synthLock, err := suite.app.LockupKeeper.GetSyntheticLockup(suite.ctx, 2, "synth")
+	suite.Require().NoError(err)
+	suite.Require().Equal(time.Second, synthLock.Duration)
+
+	// the accumulation stores are unchanged
+	suite.Require().Equal(sdk.NewInt(10), suite.app.LockupKeeper.GetPeriodLocksAccumulation(suite.ctx, types.QueryCondition{Denom: "gamm/pool/1", Duration: time.Second}))
+	suite.Require().Equal(sdk.NewInt(10), suite.app.LockupKeeper.GetPeriodLocksAccumulation(suite.ctx, types.QueryCondition{Denom: "synth", Duration: time.Second}))
+
+	// unlocking locks cannot be split
+	err = suite.app.LockupKeeper.DeleteSyntheticLockup(suite.ctx, 1, "synth")
+	suite.Require().NoError(err)
+	err = suite.app.LockupKeeper.BeginUnlock(suite.ctx, 1, nil)
+	suite.Require().NoError(err)
+	_, err = suite.app.LockupKeeper.SplitLock(suite.ctx, 1, addr1, sdk.Coins{sdk.NewInt64Coin("gamm/pool/1", 1)})
+	suite.Require().ErrorIs(err, types.ErrLockUnlocking)
+}
+
+func (suite *KeeperTestSuite) TestMergeLocks() {
+	suite.SetupTest()
+
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	suite.LockTokens(addr1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, time.Second)
+	suite.LockTokens(addr1, sdk.Coins{sdk.NewInt64Coin("stake", 20)}, time.Second)
+	suite.LockTokens(addr1, sdk.Coins{sdk.NewInt64Coin("stake", 30)}, time.Second)
+	suite.LockTokens(addr1, sdk.Coins{sdk.NewInt64Coin("stake", 40)}, 2*time.Second)
+	suite.LockTokens(addr1, sdk.Coins{sdk.NewInt64Coin("foo", 50)}, time.Second)
+	suite.LockTokens(addr2, sdk.Coins{sdk.NewInt64Coin("stake", 60)}, time.Second)
+	for _, lockID := range []uint64{1, 2, 3} {
+		err := suite.app.LockupKeeper.CreateSyntheticLockup(suite.ctx, lockID, "synth", time.Second, false)
+		suite.Require().NoError(err)
+	}
+	err := suite.app.LockupKeeper.CreateSyntheticLockup(suite.ctx, 3, "synth2", time.Second, false)
+	suite.Require().NoError(err)
+
+	tests := []struct {
+		name        string
+		lockIDs     []uint64
+		owner       sdk.AccAddress
+		expectedErr error
+	}{
+		{name: "single lock", lockIDs: []uint64{1}, owner: addr1, expectedErr: types.ErrLocksNotMergeable},
+		{name: "duplicate lock", lockIDs: []uint64{1, 1}, owner: addr1, expectedErr: types.ErrLocksNotMergeable},
+		{name: "another owner's lock", lockIDs: []uint64{1, 6}, owner: addr1, expectedErr: types.ErrNotLockOwner},
+		{name: "different duration", lockIDs: []uint64{1, 4}, owner: addr1, expectedErr: types.ErrLocksNotMergeable},
+		{name: "different denom", lockIDs: []uint64{1, 5}, owner: addr1, expectedErr: types.ErrLocksNotMergeable},
+		{name: "different synthetic locks", lockIDs: []uint64{1, 3}, owner: addr1, expectedErr: types.ErrLocksNotMergeable},
+	}
+	for _, tc := range tests {
+		_, err := suite.app.LockupKeeper.MergeLocks(suite.ctx, tc.lockIDs, tc.owner)
+		suite.Require().ErrorIs(err, tc.expectedErr, tc.name)
+	}
+
+	mergedLock, err := suite.app.LockupKeeper.MergeLocks(suite.ctx, []uint64{2, 1}, addr1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), mergedLock.ID)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 30)}, mergedLock.Coins)
+
+	// the merged lock and its synthetic lock are gone
+	_, err = suite.app.LockupKeeper.GetLockByID(suite.ctx, 1)
+	suite.Require().Error(err)
+	_, err = suite.app.LockupKeeper.GetSyntheticLockup(suite.ctx, 1, "synth")
+	suite.Require().Error(err)
+	suite.Require().Len(suite.app.LockupKeeper.GetAccountPeriodLocks(suite.ctx, addr1), 4)
+	suite.Require().Len(suite.app.LockupKeeper.GetAccountLockedLongerDurationDenom(suite.ctx, addr1, "synth", time.Second), 2)
+
+	// the accumulation stores are unchanged
+	suite.Require().Equal(sdk.NewInt(160), suite.app.LockupKeeper.GetPeriodLocksAccumulation(suite.ctx, types.QueryCondition{Denom: "stake", Duration: time.Second}))
+	suite.Require().Equal(sdk.NewInt(60), suite.app.LockupKeeper.GetPeriodLocksAccumulation(suite.ctx, types.QueryCondition{Denom: "synth", Duration: time.Second}))
+
+	// deleting the synthetic lock of the merged lock removes all of its amount
+	err = suite.app.LockupKeeper.DeleteSyntheticLockup(suite.ctx, 2, "synth")
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(30), suite.app.LockupKeeper.GetPeriodLocksAccumulation(suite.ctx, types.QueryCondition{Denom: "synth", Duration: time.Second}))
+}
+
+func (suite *KeeperTestSuite) TestSetAutoCompound() {
+	suite.SetupTest()
+
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("gamm/pool/1", 10)}
+	suite.LockTokens(addr1, coins, time.Second)
+	suite.LockTokens(addr1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, time.Second)
+
+	// only the owner can change the flag
+	err := suite.app.LockupKeeper.SetAutoCompound(suite.ctx, 1, addr2, true)
+	suite.Require().ErrorIs(err, types.ErrNotLockOwner)
+
+	// locks that do not hold gamm pool shares cannot compound, but can opt out
+	err = suite.app.LockupKeeper.SetAutoCompound(suite.ctx, 2, addr1, true)
+	suite.Require().ErrorIs(err, types.ErrLockNotCompoundable)
+	err = suite.app.LockupKeeper.SetAutoCompound(suite.ctx, 2, addr1, false)
+	suite.Require().NoError(err)
+
+	err = suite.app.LockupKeeper.SetAutoCompound(suite.ctx, 1, addr1, true)
+	suite.Require().NoError(err)
+	lock, err := suite.app.LockupKeeper.GetLockByID(suite.ctx, 1)
+	suite.Require().NoError(err)
+	suite.Require().True(lock.AutoCompound)
+
+	// a transferred lock does not compound until the new owner opts in
+	err = suite.app.LockupKeeper.TransferLock(suite.ctx, 1, addr1, addr2)
+	suite.Require().NoError(err)
+	lock, err = suite.app.LockupKeeper.GetLockByID(suite.ctx, 1)
+	suite.Require().NoError(err)
+	suite.Require().False(lock.AutoCompound)
+}
+
+// requireTypedEvents checks the typed events emitted on ctx so far. They are
+// compared as text, since empty coins are decoded from events as nil.
+func (suite *KeeperTestSuite) requireTypedEvents(ctx sdk.Context, expected ...proto.Message) {
+	expectedStrs := []string{}
+	for _, msg := range expected {
+		expectedStrs = append(expectedStrs, proto.MessageName(msg)+" "+msg.String())
+	}
+	emittedStrs := []string{}
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		msg, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			continue
+		}
+		emittedStrs = append(emittedStrs, proto.MessageName(msg)+" "+msg.String())
+	}
+	suite.Require().Equal(expectedStrs, emittedStrs)
+}
+
+func (suite *KeeperTestSuite) TestLockTypedEvents() {
+	suite.SetupTest()
+
+	addr := sdk.AccAddress([]byte("addr1---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+	err := simapp.FundAccount(suite.app.BankKeeper, suite.ctx, addr, coins.Add(coins...))
+	suite.Require().NoError(err)
+	ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+
+	// every state transition emits the lock as stored after it
+	lock, err := suite.app.LockupKeeper.LockTokens(ctx, addr, coins, time.Second)
+	suite.Require().NoError(err)
+	_, err = suite.app.LockupKeeper.AddTokensToLockByID(ctx, lock.ID, coins)
+	suite.Require().NoError(err)
+	err = suite.app.LockupKeeper.BeginUnlock(ctx, lock.ID, coins)
+	suite.Require().NoError(err)
+
+	remainingLock := lock
+	splitLock := types.NewPeriodLock(lock.ID+1, addr, time.Second, time.Time{}, coins)
+	unlockingLock := splitLock
+	unlockingLock.EndTime = ctx.BlockTime().Add(time.Second)
+	suite.requireTypedEvents(ctx,
+		&types.EventLockCreated{Lock: lock},
+		&types.EventLockTokensAdded{Lock: types.NewPeriodLock(lock.ID, addr, time.Second, time.Time{}, coins.Add(coins...)), Added: coins},
+		&types.EventLockSplit{Lock: remainingLock, NewLock: splitLock},
+		&types.EventLockUnlockStarted{Lock: unlockingLock},
+	)
+
+	// so do the synthetic locks, and the locks withdrawn in the end blocker
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	err = suite.app.LockupKeeper.CreateSyntheticLockup(ctx, lock.ID, "synthstakestakedtovalidator1", time.Second, true)
+	suite.Require().NoError(err)
+	synthLock, err := suite.app.LockupKeeper.GetSyntheticLockup(ctx, lock.ID, "synthstakestakedtovalidator1")
+	suite.Require().NoError(err)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Second))
+	suite.app.LockupKeeper.DeleteAllMaturedSyntheticLocks(ctx)
+	suite.app.LockupKeeper.WithdrawAllMaturedLocks(ctx)
+	suite.requireTypedEvents(ctx,
+		&types.EventSyntheticLockCreated{SyntheticLock: *synthLock, Lock: remainingLock},
+		&types.EventSyntheticLockDeleted{SyntheticLock: *synthLock, Lock: remainingLock},
+		&types.EventLockUnlocked{Lock: unlockingLock},
+	)
+}
@@ -3,6 +3,8 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/osmosis-labs/osmosis/v7/x/gamm/utils"
 	"github.com/osmosis-labs/osmosis/v7/x/lockup/types"
@@ -38,8 +40,15 @@ func (server msgServer) LockTokens(goCtx context.Context, msg *types.MsgLockToke
 		return nil, err
 	}
 
-	if len(msg.Coins) == 1 {
-		locks := server.keeper.GetAccountLockedDurationNotUnlockingOnly(ctx, owner, msg.Coins[0].Denom, msg.Duration)
+	// a tokenized lock is always a new lock, and tokens are never added to
+	// tokenized locks, as their owner may not hold the receipt
+	if len(msg.Coins) == 1 && !msg.Tokenize {
+		locks := []types.PeriodLock{}
+		for _, lock := range server.keeper.GetAccountLockedDurationNotUnlockingOnly(ctx, owner, msg.Coins[0].Denom, msg.Duration) {
+			if !lock.Tokenized {
+				locks = append(locks, lock)
+			}
+		}
 		// if existing lock with same duration and denom exists, just add there
 		if len(locks) > 0 {
 			lock := locks[0]
@@ -84,6 +93,22 @@ func (server msgServer) LockTokens(goCtx context.Context, msg *types.MsgLockToke
 		),
 	})
 
+	if msg.Tokenize {
+		err = server.keeper.TokenizeLock(ctx, lock.ID, owner)
+		if err != nil {
+			return nil, err
+		}
+
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.TypeEvtLockTokenized,
+				sdk.NewAttribute(types.AttributePeriodLockID, utils.Uint64ToString(lock.ID)),
+				sdk.NewAttribute(types.AttributePeriodLockOwner, lock.Owner),
+				sdk.NewAttribute(types.AttributeReceiptDenom, types.ReceiptDenom(lock.ID)),
+			),
+		})
+	}
+
 	return &types.MsgLockTokensResponse{ID: lock.ID}, nil
 }
 
@@ -95,13 +120,25 @@ func (server msgServer) BeginUnlocking(goCtx context.Context, msg *types.MsgBegi
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
-	if msg.Owner != lock.Owner {
-		return nil, sdkerrors.Wrap(types.ErrNotLockOwner, fmt.Sprintf("msg sender (%s) and lock owner (%s) does not match", msg.Owner, lock.Owner))
-	}
+	// tokenized locks are unlocked by whoever holds their receipt
+	if lock.Tokenized {
+		holder, err := sdk.AccAddressFromBech32(msg.Owner)
+		if err != nil {
+			return nil, err
+		}
+		err = server.keeper.BeginUnlockWithReceipt(ctx, lock.ID, holder, msg.Coins)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		if msg.Owner != lock.Owner {
+			return nil, sdkerrors.Wrap(types.ErrNotLockOwner, fmt.Sprintf("msg sender (%s) and lock owner (%s) does not match", msg.Owner, lock.Owner))
+		}
 
-	err = server.keeper.BeginUnlock(ctx, lock.ID, msg.Coins)
-	if err != nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		err = server.keeper.BeginUnlock(ctx, lock.ID, msg.Coins)
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
 	}
 
 	lock, err = server.keeper.GetLockByID(ctx, msg.ID)
@@ -146,12 +183,259 @@ func (server msgServer) BeginUnlockingAll(goCtx context.Context, msg *types.MsgB
 	return &types.MsgBeginUnlockingAllResponse{}, nil
 }
 
+func (server msgServer) ExtendLockup(goCtx context.Context, msg *types.MsgExtendLockup) (*types.MsgExtendLockupResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	lock, err := server.keeper.GetLockByID(ctx, msg.ID)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	prevDuration := lock.Duration
+
+	err = server.keeper.ExtendLockup(ctx, msg.ID, owner, msg.Duration)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtLockExtended,
+			sdk.NewAttribute(types.AttributePeriodLockID, utils.Uint64ToString(msg.ID)),
+			sdk.NewAttribute(types.AttributePeriodLockOwner, msg.Owner),
+			sdk.NewAttribute(types.AttributePrevLockDuration, prevDuration.String()),
+			sdk.NewAttribute(types.AttributePeriodLockDuration, msg.Duration.String()),
+		),
+	})
+
+	return &types.MsgExtendLockupResponse{Success: true}, nil
+}
+
+func (server msgServer) TransferLock(goCtx context.Context, msg *types.MsgTransferLock) (*types.MsgTransferLockResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+	newOwner, err := sdk.AccAddressFromBech32(msg.NewOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.keeper.TransferLock(ctx, msg.ID, owner, newOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtLockTransferred,
+			sdk.NewAttribute(types.AttributePeriodLockID, utils.Uint64ToString(msg.ID)),
+			sdk.NewAttribute(types.AttributePeriodLockOwner, msg.Owner),
+			sdk.NewAttribute(types.AttributeNewLockOwner, msg.NewOwner),
+		),
+	})
+
+	return &types.MsgTransferLockResponse{Success: true}, nil
+}
+
+func (server msgServer) SetAutoCompound(goCtx context.Context, msg *types.MsgSetAutoCompound) (*types.MsgSetAutoCompoundResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.keeper.SetAutoCompound(ctx, msg.ID, owner, msg.AutoCompound)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtSetAutoCompound,
+			sdk.NewAttribute(types.AttributePeriodLockID, utils.Uint64ToString(msg.ID)),
+			sdk.NewAttribute(types.AttributePeriodLockOwner, msg.Owner),
+			sdk.NewAttribute(types.AttributeAutoCompound, strconv.FormatBool(msg.AutoCompound)),
+		),
+	})
+
+	return &types.MsgSetAutoCompoundResponse{Success: true}, nil
+}
+
+func (server msgServer) ForceUnlock(goCtx context.Context, msg *types.MsgForceUnlock) (*types.MsgForceUnlockResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	lock, err := server.keeper.GetLockByID(ctx, msg.ID)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if msg.Owner != lock.Owner {
+		return nil, sdkerrors.Wrap(types.ErrNotLockOwner, fmt.Sprintf("msg sender (%s) and lock owner (%s) does not match", msg.Owner, lock.Owner))
+	}
+
+	if !server.keeper.GetParams(ctx).IsForceUnlockAllowed(msg.Owner) {
+		return nil, sdkerrors.Wrapf(types.ErrForceUnlockNotAllowed, "address %s", msg.Owner)
+	}
+
+	if lock.Tokenized {
+		return nil, sdkerrors.Wrapf(types.ErrLockTokenized, "lock %d", lock.ID)
+	}
+
+	// superfluid staked locks must be undelegated through x/superfluid first
+	if server.keeper.HasAnySyntheticLockups(ctx, lock.ID) {
+		return nil, sdkerrors.Wrapf(types.ErrLockHasSyntheticLockups, "lock %d", lock.ID)
+	}
+
+	unlockedCoins := msg.Coins
+	if len(unlockedCoins) == 0 {
+		unlockedCoins = lock.Coins
+	}
+
+	err = server.keeper.PartialForceUnlock(ctx, *lock, msg.Coins)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtForceUnlock,
+			sdk.NewAttribute(types.AttributePeriodLockID, utils.Uint64ToString(lock.ID)),
+			sdk.NewAttribute(types.AttributePeriodLockOwner, lock.Owner),
+			sdk.NewAttribute(types.AttributeUnlockedCoins, unlockedCoins.String()),
+		),
+	})
+
+	return &types.MsgForceUnlockResponse{Success: true}, nil
+}
+
 func createBeginUnlockEvent(lock *types.PeriodLock) sdk.Event {
 	return sdk.NewEvent(
 		types.TypeEvtBeginUnlock,
 		sdk.NewAttribute(types.AttributePeriodLockID, utils.Uint64ToString(lock.ID)),
 		sdk.NewAttribute(types.AttributePeriodLockOwner, lock.Owner),
+		sdk.NewAttribute(types.AttributePeriodLockAmount, lock.Coins.String()),
 		sdk.NewAttribute(types.AttributePeriodLockDuration, lock.Duration.String()),
 		sdk.NewAttribute(types.AttributePeriodLockUnlockTime, lock.EndTime.String()),
 	)
 }
+
+func (server msgServer) SetCallbackContract(goCtx context.Context, msg *types.MsgSetCallbackContract) (*types.MsgSetCallbackContractResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	var contract sdk.AccAddress
+	if msg.Contract != "" {
+		contract, err = sdk.AccAddressFromBech32(msg.Contract)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = server.keeper.SetCallbackContract(ctx, owner, contract)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtSetCallbackContract,
+			sdk.NewAttribute(types.AttributePeriodLockOwner, msg.Owner),
+			sdk.NewAttribute(types.AttributeCallbackContract, msg.Contract),
+		),
+	})
+
+	return &types.MsgSetCallbackContractResponse{Success: true}, nil
+}
+
+func (server msgServer) SplitLock(goCtx context.Context, msg *types.MsgSplitLock) (*types.MsgSplitLockResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	splitLock, err := server.keeper.SplitLock(ctx, msg.ID, owner, msg.Coins)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtLockSplit,
+			sdk.NewAttribute(types.AttributePeriodLockID, utils.Uint64ToString(msg.ID)),
+			sdk.NewAttribute(types.AttributeNewPeriodLockID, utils.Uint64ToString(splitLock.ID)),
+			sdk.NewAttribute(types.AttributePeriodLockOwner, msg.Owner),
+			sdk.NewAttribute(types.AttributePeriodLockAmount, msg.Coins.String()),
+		),
+	})
+
+	return &types.MsgSplitLockResponse{ID: splitLock.ID}, nil
+}
+
+func (server msgServer) MergeLocks(goCtx context.Context, msg *types.MsgMergeLocks) (*types.MsgMergeLocksResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	mergedLock, err := server.keeper.MergeLocks(ctx, msg.IDs, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	mergedIDs := make([]string, 0, len(msg.IDs)-1)
+	for _, id := range msg.IDs[1:] {
+		mergedIDs = append(mergedIDs, utils.Uint64ToString(id))
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtLocksMerged,
+			sdk.NewAttribute(types.AttributePeriodLockID, utils.Uint64ToString(mergedLock.ID)),
+			sdk.NewAttribute(types.AttributeMergedPeriodLockIDs, strings.Join(mergedIDs, ",")),
+			sdk.NewAttribute(types.AttributePeriodLockOwner, msg.Owner),
+			sdk.NewAttribute(types.AttributePeriodLockAmount, mergedLock.Coins.String()),
+		),
+	})
+
+	return &types.MsgMergeLocksResponse{ID: mergedLock.ID}, nil
+}
+
+func (server msgServer) ClaimReceiptRewards(goCtx context.Context, msg *types.MsgClaimReceiptRewards) (*types.MsgClaimReceiptRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	rewards, err := server.keeper.ClaimReceiptRewards(ctx, msg.ID, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtClaimReceiptRewards,
+			sdk.NewAttribute(types.AttributePeriodLockID, utils.Uint64ToString(msg.ID)),
+			sdk.NewAttribute(types.AttributeReceiptHolder, msg.Sender),
+			sdk.NewAttribute(types.AttributeReceiptRewards, rewards.String()),
+		),
+	})
+
+	return &types.MsgClaimReceiptRewardsResponse{Rewards: rewards}, nil
+}
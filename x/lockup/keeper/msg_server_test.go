@@ -266,3 +266,192 @@ func (suite *KeeperTestSuite) TestMsgBeginUnlockingAll() {
 		}
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgExtendLockup() {
+	tests := []struct {
+		name        string
+		owner       sdk.AccAddress
+		newDuration time.Duration
+		expectPass  bool
+	}{
+		{
+			name:        "extend lockup by its owner",
+			owner:       sdk.AccAddress([]byte("addr1---------------")),
+			newDuration: time.Hour,
+			expectPass:  true,
+		},
+		{
+			name:        "extend lockup to a shorter duration",
+			owner:       sdk.AccAddress([]byte("addr1---------------")),
+			newDuration: time.Second / 2,
+			expectPass:  false,
+		},
+		{
+			name:        "extend lockup of another account",
+			owner:       sdk.AccAddress([]byte("addr2---------------")),
+			newDuration: time.Hour,
+			expectPass:  false,
+		},
+	}
+
+	for _, test := range tests {
+		suite.SetupTest()
+
+		lockOwner := sdk.AccAddress([]byte("addr1---------------"))
+		coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+		err := simapp.FundAccount(suite.app.BankKeeper, suite.ctx, lockOwner, coins)
+		suite.Require().NoError(err)
+
+		msgServer := keeper.NewMsgServerImpl(suite.app.LockupKeeper)
+		c := sdk.WrapSDKContext(suite.ctx)
+		resp, err := msgServer.LockTokens(c, types.NewMsgLockTokens(lockOwner, time.Second, coins))
+		suite.Require().NoError(err)
+
+		_, err = msgServer.ExtendLockup(c, types.NewMsgExtendLockup(test.owner, resp.ID, test.newDuration))
+		lock, lockErr := suite.app.LockupKeeper.GetLockByID(suite.ctx, resp.ID)
+		suite.Require().NoError(lockErr)
+
+		if test.expectPass {
+			suite.Require().NoError(err, test.name)
+			suite.Require().Equal(test.newDuration, lock.Duration, test.name)
+		} else {
+			suite.Require().Error(err, test.name)
+			suite.Require().Equal(time.Second, lock.Duration, test.name)
+		}
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgForceUnlock() {
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	defaultLockID, defaultLockAmount := uint64(1), sdk.NewInt(1000000000)
+
+	tests := []struct {
+		name                      string
+		postLockSetup             func()
+		forceUnlockAllowedAddress []string
+		isSyntheticLockup         bool
+		coinsToForceUnlock        sdk.Coins
+		expectPass                bool
+	}{
+		{
+			name:                      "forcefully unlock full amount",
+			forceUnlockAllowedAddress: []string{addr1.String()},
+			coinsToForceUnlock:        sdk.Coins{sdk.NewCoin("stake", defaultLockAmount)},
+			expectPass:                true,
+		},
+		{
+			name:                      "forcefully unlock with empty coins unlocks full amount",
+			forceUnlockAllowedAddress: []string{addr1.String()},
+			coinsToForceUnlock:        sdk.Coins{},
+			expectPass:                true,
+		},
+		{
+			name:                      "forcefully unlock partial amount",
+			forceUnlockAllowedAddress: []string{addr1.String()},
+			coinsToForceUnlock:        sdk.Coins{sdk.NewCoin("stake", defaultLockAmount.Quo(sdk.NewInt(2)))},
+			expectPass:                true,
+		},
+		{
+			name: "forcefully unlock an unlocking lock",
+			postLockSetup: func() {
+				err := suite.app.LockupKeeper.BeginUnlock(suite.ctx, defaultLockID, nil)
+				suite.Require().NoError(err)
+			},
+			forceUnlockAllowedAddress: []string{addr1.String()},
+			coinsToForceUnlock:        sdk.Coins{},
+			expectPass:                true,
+		},
+		{
+			name:                      "address not in the allowed list",
+			forceUnlockAllowedAddress: []string{addr2.String()},
+			coinsToForceUnlock:        sdk.Coins{sdk.NewCoin("stake", defaultLockAmount)},
+			expectPass:                false,
+		},
+		{
+			name:                      "lock with synthetic lockups",
+			forceUnlockAllowedAddress: []string{addr1.String()},
+			isSyntheticLockup:         true,
+			coinsToForceUnlock:        sdk.Coins{sdk.NewCoin("stake", defaultLockAmount)},
+			expectPass:                false,
+		},
+		{
+			name:                      "more than the locked amount",
+			forceUnlockAllowedAddress: []string{addr1.String()},
+			coinsToForceUnlock:        sdk.Coins{sdk.NewCoin("stake", defaultLockAmount.Add(sdk.OneInt()))},
+			expectPass:                false,
+		},
+	}
+
+	for _, test := range tests {
+		suite.SetupTest()
+
+		lockedCoins := sdk.Coins{sdk.NewCoin("stake", defaultLockAmount)}
+		err := simapp.FundAccount(suite.app.BankKeeper, suite.ctx, addr1, lockedCoins)
+		suite.Require().NoError(err)
+
+		msgServer := keeper.NewMsgServerImpl(suite.app.LockupKeeper)
+		c := sdk.WrapSDKContext(suite.ctx)
+		resp, err := msgServer.LockTokens(c, types.NewMsgLockTokens(addr1, time.Second, lockedCoins))
+		suite.Require().NoError(err)
+		suite.Require().Equal(defaultLockID, resp.ID)
+
+		if test.isSyntheticLockup {
+			err = suite.app.LockupKeeper.CreateSyntheticLockup(suite.ctx, resp.ID, "synthetic", time.Second, false)
+			suite.Require().NoError(err)
+		}
+		if test.postLockSetup != nil {
+			test.postLockSetup()
+		}
+
+		params := suite.app.LockupKeeper.GetParams(suite.ctx)
+		params.ForceUnlockAllowedAddresses = test.forceUnlockAllowedAddress
+		suite.app.LockupKeeper.SetParams(suite.ctx, params)
+
+		_, err = msgServer.ForceUnlock(c, types.NewMsgForceUnlock(addr1, resp.ID, test.coinsToForceUnlock))
+		if !test.expectPass {
+			suite.Require().Error(err, test.name)
+			suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, addr1).Empty(), test.name)
+			continue
+		}
+		suite.Require().NoError(err, test.name)
+
+		// the unlocked coins are back in the owner's account right away
+		unlockedCoins := test.coinsToForceUnlock
+		if unlockedCoins.Empty() {
+			unlockedCoins = lockedCoins
+		}
+		suite.Require().Equal(unlockedCoins.String(), suite.app.BankKeeper.GetAllBalances(suite.ctx, addr1).String(), test.name)
+		suite.Require().Equal(lockedCoins.Sub(unlockedCoins).String(), suite.app.LockupKeeper.GetAccountLockedCoins(suite.ctx, addr1).String(), test.name)
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgSplitAndMergeLocks() {
+	suite.SetupTest()
+
+	lockOwner := sdk.AccAddress([]byte("addr1---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+	err := simapp.FundAccount(suite.app.BankKeeper, suite.ctx, lockOwner, coins)
+	suite.Require().NoError(err)
+
+	msgServer := keeper.NewMsgServerImpl(suite.app.LockupKeeper)
+	c := sdk.WrapSDKContext(suite.ctx)
+	lockResp, err := msgServer.LockTokens(c, types.NewMsgLockTokens(lockOwner, time.Second, coins))
+	suite.Require().NoError(err)
+
+	splitResp, err := msgServer.SplitLock(c, types.NewMsgSplitLock(lockOwner, lockResp.ID, sdk.Coins{sdk.NewInt64Coin("stake", 3)}))
+	suite.Require().NoError(err)
+	lock, err := suite.app.LockupKeeper.GetLockByID(suite.ctx, lockResp.ID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 7)}, lock.Coins)
+	splitLock, err := suite.app.LockupKeeper.GetLockByID(suite.ctx, splitResp.ID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 3)}, splitLock.Coins)
+
+	mergeResp, err := msgServer.MergeLocks(c, types.NewMsgMergeLocks(lockOwner, []uint64{splitResp.ID, lockResp.ID}))
+	suite.Require().NoError(err)
+	suite.Require().Equal(splitResp.ID, mergeResp.ID)
+	locks := suite.app.LockupKeeper.GetAccountPeriodLocks(suite.ctx, lockOwner)
+	suite.Require().Len(locks, 1)
+	suite.Require().Equal(coins, locks[0].Coins)
+}
@@ -0,0 +1,171 @@
+package keeper
+
+import (
+	"github.com/osmosis-labs/osmosis/v7/x/lockup/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// TokenizeLock mints the receipt of a lock to its owner. From then on the
+// lock is owned by whoever holds the receipt, which can be sent with bank
+// transfers and IBC. The lock must not be unlocking or have synthetic locks.
+func (k Keeper) TokenizeLock(ctx sdk.Context, lockID uint64, owner sdk.AccAddress) error {
+	lock, err := k.GetLockByID(ctx, lockID)
+	if err != nil {
+		return err
+	}
+	if lock.Owner != owner.String() {
+		return sdkerrors.Wrapf(types.ErrNotLockOwner, "msg sender (%s) and lock owner (%s) does not match", owner, lock.Owner)
+	}
+	if lock.Tokenized {
+		return sdkerrors.Wrapf(types.ErrLockTokenized, "lock %d", lock.ID)
+	}
+	if lock.IsUnlocking() {
+		return sdkerrors.Wrapf(types.ErrLockUnlocking, "lock %d", lock.ID)
+	}
+	if k.HasAnySyntheticLockups(ctx, lock.ID) {
+		return sdkerrors.Wrapf(types.ErrLockHasSyntheticLockups, "lock %d", lock.ID)
+	}
+
+	receipt := types.ReceiptCoins(lock.ID)
+	if err := k.bk.MintCoins(ctx, types.ModuleName, receipt); err != nil {
+		return err
+	}
+	if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, receipt); err != nil {
+		return err
+	}
+
+	// rewards of tokenized locks go to the receipt holder, so they cannot be compounded for the owner
+	lock.Tokenized = true
+	lock.AutoCompound = false
+	err = k.setLock(ctx, *lock)
+	if err != nil {
+		return err
+	}
+	return ctx.EventManager().EmitTypedEvent(&types.EventLockTokenized{Lock: *lock, ReceiptDenom: types.ReceiptDenom(lock.ID)})
+}
+
+// holdsReceipt returns whether addr holds the receipt of a tokenized lock.
+func (k Keeper) holdsReceipt(ctx sdk.Context, addr sdk.AccAddress, lockID uint64) bool {
+	return k.bk.GetBalance(ctx, addr, types.ReceiptDenom(lockID)).IsPositive()
+}
+
+// getTokenizedLock returns a tokenized lock whose receipt holder holds.
+func (k Keeper) getTokenizedLock(ctx sdk.Context, lockID uint64, holder sdk.AccAddress) (*types.PeriodLock, error) {
+	lock, err := k.GetLockByID(ctx, lockID)
+	if err != nil {
+		return nil, err
+	}
+	if !lock.Tokenized {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "lock %d is not tokenized", lock.ID)
+	}
+	if !k.holdsReceipt(ctx, holder, lock.ID) {
+		return nil, sdkerrors.Wrapf(types.ErrNotReceiptHolder, "%s does not hold %s", holder, types.ReceiptDenom(lock.ID))
+	}
+	return lock, nil
+}
+
+// BeginUnlockWithReceipt burns the receipt of a tokenized lock from its
+// holder, transfers the lock to the holder, pays the holder the lock's rewards
+// and begins unlocking coins from it, or all of it if coins is empty.
+func (k Keeper) BeginUnlockWithReceipt(ctx sdk.Context, lockID uint64, holder sdk.AccAddress, coins sdk.Coins) error {
+	lock, err := k.getTokenizedLock(ctx, lockID, holder)
+	if err != nil {
+		return err
+	}
+
+	receipt := types.ReceiptCoins(lock.ID)
+	if err := k.bk.SendCoinsFromAccountToModule(ctx, holder, types.ModuleName, receipt); err != nil {
+		return err
+	}
+	if err := k.bk.BurnCoins(ctx, types.ModuleName, receipt); err != nil {
+		return err
+	}
+	// the lock is transferred while still tokenized, so that rewards settled
+	// by the transfer hooks are held for the holder
+	if lock.Owner != holder.String() {
+		err = k.transferLock(ctx, *lock, holder)
+		if err != nil {
+			return err
+		}
+		lock, err = k.GetLockByID(ctx, lockID)
+		if err != nil {
+			return err
+		}
+	}
+	rewards, err := k.payReceiptRewards(ctx, lock, holder)
+	if err != nil {
+		return err
+	}
+
+	lock.Tokenized = false
+	err = k.setLock(ctx, *lock)
+	if err != nil {
+		return err
+	}
+	err = ctx.EventManager().EmitTypedEvent(&types.EventLockReceiptRedeemed{Lock: *lock, Rewards: rewards})
+	if err != nil {
+		return err
+	}
+
+	return k.BeginUnlock(ctx, lock.ID, coins)
+}
+
+// AddReceiptRewards sends incentive rewards of a tokenized lock from a module
+// account to the receipt rewards module account, to be paid to whoever holds
+// the receipt when the rewards are claimed. They are kept apart from the
+// locked coins, so that the lockup module account holds exactly what its
+// locks record.
+func (k Keeper) AddReceiptRewards(ctx sdk.Context, lockID uint64, senderModule string, rewards sdk.Coins) error {
+	lock, err := k.GetLockByID(ctx, lockID)
+	if err != nil {
+		return err
+	}
+	if !lock.Tokenized {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "lock %d is not tokenized", lock.ID)
+	}
+	if err := k.bk.SendCoinsFromModuleToModule(ctx, senderModule, types.ReceiptRewardsModuleAcctName, rewards); err != nil {
+		return err
+	}
+
+	// the lock refs do not depend on the rewards
+	lock.ReceiptRewards = lock.ReceiptRewards.Add(rewards...)
+	err = k.setLock(ctx, *lock)
+	if err != nil {
+		return err
+	}
+	return ctx.EventManager().EmitTypedEvent(&types.EventReceiptRewardsAdded{Lock: *lock, Rewards: rewards})
+}
+
+// ClaimReceiptRewards pays the rewards of a tokenized lock to the holder of its receipt.
+func (k Keeper) ClaimReceiptRewards(ctx sdk.Context, lockID uint64, holder sdk.AccAddress) (sdk.Coins, error) {
+	lock, err := k.getTokenizedLock(ctx, lockID, holder)
+	if err != nil {
+		return nil, err
+	}
+	rewards, err := k.payReceiptRewards(ctx, lock, holder)
+	if err != nil {
+		return nil, err
+	}
+	err = k.setLock(ctx, *lock)
+	if err != nil {
+		return nil, err
+	}
+	err = ctx.EventManager().EmitTypedEvent(&types.EventReceiptRewardsClaimed{Lock: *lock, Holder: holder.String(), Rewards: rewards})
+	return rewards, err
+}
+
+// payReceiptRewards sends the rewards of a tokenized lock to holder and
+// clears them from the lock. The caller stores the lock.
+func (k Keeper) payReceiptRewards(ctx sdk.Context, lock *types.PeriodLock, holder sdk.AccAddress) (sdk.Coins, error) {
+	rewards := lock.ReceiptRewards
+	if rewards.Empty() {
+		return sdk.Coins{}, nil
+	}
+	if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.ReceiptRewardsModuleAcctName, holder, rewards); err != nil {
+		return nil, err
+	}
+	lock.ReceiptRewards = nil
+	return rewards, nil
+}
@@ -0,0 +1,136 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/lockup/keeper"
+	"github.com/osmosis-labs/osmosis/v7/x/lockup/types"
+)
+
+func (suite *KeeperTestSuite) TestTokenizedLock() {
+	suite.SetupTest()
+
+	owner := sdk.AccAddress([]byte("addr1---------------"))
+	holder := sdk.AccAddress([]byte("addr2---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+	msgServer := keeper.NewMsgServerImpl(suite.app.LockupKeeper)
+	c := sdk.WrapSDKContext(suite.ctx)
+
+	// locking with tokenize mints the receipt to the owner, in a new lock
+	suite.LockTokens(owner, coins, time.Second)
+	err := simapp.FundAccount(suite.app.BankKeeper, suite.ctx, owner, coins)
+	suite.Require().NoError(err)
+	msg := types.NewMsgLockTokens(owner, time.Second, coins)
+	msg.Tokenize = true
+	res, err := msgServer.LockTokens(c, msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), res.ID)
+	suite.Require().Equal(types.ReceiptCoins(res.ID), suite.app.BankKeeper.GetAllBalances(suite.ctx, owner))
+
+	// tokens are not added to a tokenized lock
+	err = simapp.FundAccount(suite.app.BankKeeper, suite.ctx, owner, coins)
+	suite.Require().NoError(err)
+	_, err = msgServer.LockTokens(c, types.NewMsgLockTokens(owner, time.Second, coins))
+	suite.Require().NoError(err)
+	lock, err := suite.app.LockupKeeper.GetLockByID(suite.ctx, res.ID)
+	suite.Require().NoError(err)
+	suite.Require().True(lock.Tokenized)
+	suite.Require().Equal(coins, lock.Coins)
+
+	// the owner cannot act on the lock once the receipt is sent away
+	err = suite.app.BankKeeper.SendCoins(suite.ctx, owner, holder, types.ReceiptCoins(lock.ID))
+	suite.Require().NoError(err)
+	err = suite.app.LockupKeeper.ExtendLockup(suite.ctx, lock.ID, owner, time.Hour)
+	suite.Require().ErrorIs(err, types.ErrLockTokenized)
+	err = suite.app.LockupKeeper.TransferLock(suite.ctx, lock.ID, owner, holder)
+	suite.Require().ErrorIs(err, types.ErrLockTokenized)
+	err = suite.app.LockupKeeper.SetAutoCompound(suite.ctx, lock.ID, owner, true)
+	suite.Require().ErrorIs(err, types.ErrLockTokenized)
+	_, err = suite.app.LockupKeeper.SplitLock(suite.ctx, lock.ID, owner, sdk.Coins{sdk.NewInt64Coin("stake", 1)})
+	suite.Require().ErrorIs(err, types.ErrLockTokenized)
+	err = suite.app.LockupKeeper.CreateSyntheticLockup(suite.ctx, lock.ID, "stake/superbonding", time.Second, false)
+	suite.Require().ErrorIs(err, types.ErrLockTokenized)
+	_, err = msgServer.BeginUnlocking(c, types.NewMsgBeginUnlocking(owner, lock.ID, nil))
+	suite.Require().ErrorIs(err, types.ErrNotReceiptHolder)
+	unlocks, err := suite.app.LockupKeeper.BeginUnlockAllNotUnlockings(suite.ctx, owner)
+	suite.Require().NoError(err)
+	suite.Require().Len(unlocks, 1)
+	suite.Require().NotEqual(lock.ID, unlocks[0].ID)
+
+	// rewards of the lock are held for the receipt holder
+	rewards := sdk.Coins{sdk.NewInt64Coin("reward", 100)}
+	err = simapp.FundModuleAccount(suite.app.BankKeeper, suite.ctx, minttypes.ModuleName, rewards)
+	suite.Require().NoError(err)
+	err = suite.app.LockupKeeper.AddReceiptRewards(suite.ctx, lock.ID, minttypes.ModuleName, rewards)
+	suite.Require().NoError(err)
+	// apart from the locked coins
+	receiptRewardsAddr := suite.app.AccountKeeper.GetModuleAddress(types.ReceiptRewardsModuleAcctName)
+	suite.Require().Equal(coins.Add(coins...).Add(coins...), suite.app.LockupKeeper.GetModuleBalance(suite.ctx))
+	suite.Require().Equal(rewards, suite.app.BankKeeper.GetAllBalances(suite.ctx, receiptRewardsAddr))
+	err = suite.app.LockupKeeper.AddReceiptRewards(suite.ctx, unlocks[0].ID, minttypes.ModuleName, rewards)
+	suite.Require().Error(err)
+	_, err = suite.app.LockupKeeper.ClaimReceiptRewards(suite.ctx, lock.ID, owner)
+	suite.Require().ErrorIs(err, types.ErrNotReceiptHolder)
+
+	// the holder begins unlocking with the receipt, and gets the lock and its rewards
+	_, err = msgServer.BeginUnlocking(c, types.NewMsgBeginUnlocking(holder, lock.ID, nil))
+	suite.Require().NoError(err)
+	suite.Require().Equal(rewards, suite.app.BankKeeper.GetAllBalances(suite.ctx, holder))
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, receiptRewardsAddr).Empty())
+	suite.Require().True(suite.app.BankKeeper.GetSupply(suite.ctx, types.ReceiptDenom(lock.ID)).IsZero())
+	lock, err = suite.app.LockupKeeper.GetLockByID(suite.ctx, lock.ID)
+	suite.Require().NoError(err)
+	suite.Require().False(lock.Tokenized)
+	suite.Require().Empty(lock.ReceiptRewards)
+	suite.Require().Equal(holder.String(), lock.Owner)
+	suite.Require().True(lock.IsUnlocking())
+	suite.Require().Len(suite.app.LockupKeeper.GetAccountPeriodLocks(suite.ctx, holder), 1)
+
+	// and the locked coins once the lock matures
+	err = suite.app.LockupKeeper.Unlock(suite.ctx.WithBlockTime(lock.EndTime), lock.ID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(rewards.Add(coins...), suite.app.BankKeeper.GetAllBalances(suite.ctx, holder))
+}
+
+func (suite *KeeperTestSuite) TestMsgClaimReceiptRewards() {
+	suite.SetupTest()
+
+	owner := sdk.AccAddress([]byte("addr1---------------"))
+	holder := sdk.AccAddress([]byte("addr2---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+	rewards := sdk.Coins{sdk.NewInt64Coin("reward", 100)}
+	msgServer := keeper.NewMsgServerImpl(suite.app.LockupKeeper)
+	c := sdk.WrapSDKContext(suite.ctx)
+
+	// rewards cannot be claimed from locks that are not tokenized
+	suite.LockTokens(owner, coins, time.Second)
+	lock, err := suite.app.LockupKeeper.GetLockByID(suite.ctx, 1)
+	suite.Require().NoError(err)
+	_, err = msgServer.ClaimReceiptRewards(c, types.NewMsgClaimReceiptRewards(owner, lock.ID))
+	suite.Require().Error(err)
+
+	err = suite.app.LockupKeeper.TokenizeLock(suite.ctx, lock.ID, owner)
+	suite.Require().NoError(err)
+	err = suite.app.LockupKeeper.TokenizeLock(suite.ctx, lock.ID, owner)
+	suite.Require().ErrorIs(err, types.ErrLockTokenized)
+	err = suite.app.BankKeeper.SendCoins(suite.ctx, owner, holder, types.ReceiptCoins(lock.ID))
+	suite.Require().NoError(err)
+	err = simapp.FundModuleAccount(suite.app.BankKeeper, suite.ctx, minttypes.ModuleName, rewards)
+	suite.Require().NoError(err)
+	err = suite.app.LockupKeeper.AddReceiptRewards(suite.ctx, lock.ID, minttypes.ModuleName, rewards)
+	suite.Require().NoError(err)
+
+	// whoever holds the receipt claims the rewards, and keeps the receipt
+	res, err := msgServer.ClaimReceiptRewards(c, types.NewMsgClaimReceiptRewards(holder, lock.ID))
+	suite.Require().NoError(err)
+	suite.Require().Equal(rewards, res.Rewards)
+	suite.Require().Equal(rewards.Add(types.ReceiptCoins(lock.ID)...), suite.app.BankKeeper.GetAllBalances(suite.ctx, holder))
+
+	res, err = msgServer.ClaimReceiptRewards(c, types.NewMsgClaimReceiptRewards(holder, lock.ID))
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Rewards)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/osmosis-labs/osmosis/v7/x/lockup/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 func (k Keeper) setSyntheticLockupObject(ctx sdk.Context, synthLock *types.SyntheticLock) error {
@@ -117,6 +118,10 @@ func (k Keeper) CreateSyntheticLockup(ctx sdk.Context, lockID uint64, synthDenom
 	if err != nil {
 		return err
 	}
+	// the owner of a tokenized lock may not hold its receipt
+	if lock.Tokenized {
+		return sdkerrors.Wrapf(types.ErrLockTokenized, "lock %d", lock.ID)
+	}
 
 	endTime := time.Time{}
 	if isUnlocking { // end time is set automatically if it's unlocking lockup
@@ -150,7 +155,7 @@ func (k Keeper) CreateSyntheticLockup(ctx sdk.Context, lockID uint64, synthDenom
 	}
 
 	k.accumulationStore(ctx, synthLock.SynthDenom).Increase(accumulationKey(unlockDuration), coin.Amount)
-	return nil
+	return ctx.EventManager().EmitTypedEvent(&types.EventSyntheticLockCreated{SyntheticLock: synthLock, Lock: *lock})
 }
 
 // DeleteSyntheticLockup delete synthetic lockup with lock id and synthdenom.
@@ -164,6 +169,10 @@ func (k Keeper) DeleteSyntheticLockup(ctx sdk.Context, lockID uint64, synthdenom
 	if err != nil {
 		return err
 	}
+	err = ctx.EventManager().EmitTypedEvent(&types.EventSyntheticLockDeleted{SyntheticLock: *synthLock, Lock: *lock})
+	if err != nil {
+		return err
+	}
 
 	// update lock for synthetic lock
 	lock.EndTime = synthLock.EndTime
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CallbackContractSudoMsg is the sudo message sent to the callback contract of
+// a lock owner. Exactly one of its fields is set, so contracts can parse it as
+// an enum of the lockup hooks.
+type CallbackContractSudoMsg struct {
+	OnTokenLocked   *LockCallback  `json:"on_token_locked,omitempty"`
+	OnStartUnlock   *LockCallback  `json:"on_start_unlock,omitempty"`
+	OnTokenUnlocked *LockCallback  `json:"on_token_unlocked,omitempty"`
+	OnTokenSlashed  *SlashCallback `json:"on_token_slashed,omitempty"`
+}
+
+// LockCallback describes the lock a lockup hook was called for. Integers are
+// encoded as strings, and times as nanoseconds, as CosmWasm's Uint64 and
+// Timestamp types expect.
+type LockCallback struct {
+	Owner  string    `json:"owner"`
+	LockID uint64    `json:"lock_id,string"`
+	Amount sdk.Coins `json:"amount"`
+	// Duration is the lock duration in nanoseconds.
+	Duration int64 `json:"duration,string"`
+	// UnlockTime is the unix time in nanoseconds at which the lock unlocks,
+	// zero for locks that are not unlocking.
+	UnlockTime int64 `json:"unlock_time,string"`
+}
+
+// SlashCallback describes the amount slashed from a lock.
+type SlashCallback struct {
+	Owner  string    `json:"owner"`
+	LockID uint64    `json:"lock_id,string"`
+	Amount sdk.Coins `json:"amount"`
+}
+
+// NewLockCallback returns the description of a lock sent to callback contracts.
+func NewLockCallback(owner sdk.AccAddress, lockID uint64, amount sdk.Coins, duration time.Duration, unlockTime time.Time) *LockCallback {
+	callback := &LockCallback{
+		Owner:    owner.String(),
+		LockID:   lockID,
+		Amount:   amount,
+		Duration: int64(duration),
+	}
+	if !unlockTime.IsZero() {
+		callback.UnlockTime = unlockTime.UnixNano()
+	}
+	return callback
+}
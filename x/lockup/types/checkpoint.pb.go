@@ -0,0 +1,920 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/lockup/checkpoint.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AccumulationCheckpoint is a copy of the lock accumulation store, the amount
+// locked per denom and duration, taken at the end of an epoch.
+type AccumulationCheckpoint struct {
+	// number of the checkpoint, increasing by one per checkpoint
+	Number uint64 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	// height of the block the checkpoint was taken in
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// time of the block the checkpoint was taken in
+	Time time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time" yaml:"time"`
+}
+
+func (m *AccumulationCheckpoint) Reset()         { *m = AccumulationCheckpoint{} }
+func (m *AccumulationCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*AccumulationCheckpoint) ProtoMessage()    {}
+func (*AccumulationCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d18c176b365f548, []int{0}
+}
+func (m *AccumulationCheckpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccumulationCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccumulationCheckpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccumulationCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccumulationCheckpoint.Merge(m, src)
+}
+func (m *AccumulationCheckpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccumulationCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccumulationCheckpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccumulationCheckpoint proto.InternalMessageInfo
+
+func (m *AccumulationCheckpoint) GetNumber() uint64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *AccumulationCheckpoint) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *AccumulationCheckpoint) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+// AccumulationEntry is the amount of a denom locked for a duration.
+type AccumulationEntry struct {
+	Denom    string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Duration time.Duration                          `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration" yaml:"duration"`
+	Amount   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount" yaml:"amount"`
+}
+
+func (m *AccumulationEntry) Reset()         { *m = AccumulationEntry{} }
+func (m *AccumulationEntry) String() string { return proto.CompactTextString(m) }
+func (*AccumulationEntry) ProtoMessage()    {}
+func (*AccumulationEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d18c176b365f548, []int{1}
+}
+func (m *AccumulationEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccumulationEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccumulationEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccumulationEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccumulationEntry.Merge(m, src)
+}
+func (m *AccumulationEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccumulationEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccumulationEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccumulationEntry proto.InternalMessageInfo
+
+func (m *AccumulationEntry) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *AccumulationEntry) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+// AccumulationCheckpointRecord is a checkpoint together with its entries, as
+// kept in genesis.
+type AccumulationCheckpointRecord struct {
+	Checkpoint AccumulationCheckpoint `protobuf:"bytes,1,opt,name=checkpoint,proto3" json:"checkpoint"`
+	Entries    []AccumulationEntry    `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *AccumulationCheckpointRecord) Reset()         { *m = AccumulationCheckpointRecord{} }
+func (m *AccumulationCheckpointRecord) String() string { return proto.CompactTextString(m) }
+func (*AccumulationCheckpointRecord) ProtoMessage()    {}
+func (*AccumulationCheckpointRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d18c176b365f548, []int{2}
+}
+func (m *AccumulationCheckpointRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccumulationCheckpointRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccumulationCheckpointRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccumulationCheckpointRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccumulationCheckpointRecord.Merge(m, src)
+}
+func (m *AccumulationCheckpointRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccumulationCheckpointRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccumulationCheckpointRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccumulationCheckpointRecord proto.InternalMessageInfo
+
+func (m *AccumulationCheckpointRecord) GetCheckpoint() AccumulationCheckpoint {
+	if m != nil {
+		return m.Checkpoint
+	}
+	return AccumulationCheckpoint{}
+}
+
+func (m *AccumulationCheckpointRecord) GetEntries() []AccumulationEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*AccumulationCheckpoint)(nil), "osmosis.lockup.AccumulationCheckpoint")
+	proto.RegisterType((*AccumulationEntry)(nil), "osmosis.lockup.AccumulationEntry")
+	proto.RegisterType((*AccumulationCheckpointRecord)(nil), "osmosis.lockup.AccumulationCheckpointRecord")
+}
+
+func init() { proto.RegisterFile("osmosis/lockup/checkpoint.proto", fileDescriptor_8d18c176b365f548) }
+
+var fileDescriptor_8d18c176b365f548 = []byte{
+	// 450 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0xcf, 0x6e, 0xd3, 0x30,
+	0x18, 0xaf, 0xd7, 0x52, 0x98, 0x23, 0x40, 0x44, 0xd3, 0x28, 0x05, 0x25, 0x25, 0x87, 0xa9, 0x97,
+	0xd9, 0xa2, 0x1c, 0x90, 0xb8, 0xa0, 0x05, 0x10, 0x42, 0x70, 0x8a, 0x90, 0x90, 0xb8, 0x25, 0xae,
+	0x49, 0xa3, 0xc6, 0x76, 0x14, 0xdb, 0x88, 0xbe, 0xc5, 0xb8, 0xf1, 0x16, 0xbc, 0xc6, 0x8e, 0x3b,
+	0x21, 0xc4, 0x21, 0xa0, 0xf6, 0x0d, 0xf6, 0x04, 0x28, 0xb6, 0x33, 0x0a, 0x54, 0x9c, 0xda, 0x2f,
+	0xdf, 0xef, 0xdf, 0xf7, 0xf9, 0x83, 0xa1, 0x90, 0x4c, 0xc8, 0x42, 0xe2, 0x52, 0x90, 0xa5, 0xae,
+	0x30, 0x59, 0x50, 0xb2, 0xac, 0x44, 0xc1, 0x15, 0xaa, 0x6a, 0xa1, 0x84, 0x7f, 0xc3, 0x01, 0x90,
+	0x05, 0x8c, 0x0f, 0x72, 0x91, 0x0b, 0xd3, 0xc2, 0xed, 0x3f, 0x8b, 0x1a, 0x07, 0xb9, 0x10, 0x79,
+	0x49, 0xb1, 0xa9, 0x32, 0xfd, 0x1e, 0xcf, 0x75, 0x9d, 0xaa, 0x42, 0x70, 0xd7, 0x0f, 0xff, 0xee,
+	0xab, 0x82, 0x51, 0xa9, 0x52, 0x56, 0x59, 0x40, 0xf4, 0x09, 0xc0, 0xc3, 0x13, 0x42, 0x34, 0xd3,
+	0xa5, 0xe1, 0x3d, 0xbd, 0xcc, 0xe1, 0x1f, 0xc2, 0x21, 0xd7, 0x2c, 0xa3, 0xf5, 0x08, 0x4c, 0xc0,
+	0x74, 0x90, 0xb8, 0xaa, 0xfd, 0xbe, 0xa0, 0x45, 0xbe, 0x50, 0xa3, 0xbd, 0x09, 0x98, 0xf6, 0x13,
+	0x57, 0xf9, 0x2f, 0xe0, 0xa0, 0x55, 0x1f, 0xf5, 0x27, 0x60, 0xea, 0xcd, 0xc6, 0xc8, 0x5a, 0xa3,
+	0xce, 0x1a, 0xbd, 0xe9, 0xac, 0xe3, 0xdb, 0x67, 0x4d, 0xd8, 0xbb, 0x68, 0x42, 0x6f, 0x95, 0xb2,
+	0xf2, 0x71, 0xd4, 0xb2, 0xa2, 0xd3, 0x1f, 0x21, 0x48, 0x8c, 0x40, 0xf4, 0x15, 0xc0, 0x5b, 0xdb,
+	0x99, 0x9e, 0x73, 0x55, 0xaf, 0xfc, 0x03, 0x78, 0x65, 0x4e, 0xb9, 0x60, 0x26, 0xcd, 0x7e, 0x62,
+	0x0b, 0x3f, 0x81, 0xd7, 0xba, 0x91, 0x4d, 0x1c, 0x6f, 0x76, 0xe7, 0x1f, 0xe3, 0x67, 0x0e, 0x10,
+	0xdf, 0x75, 0xbe, 0x37, 0xad, 0x6f, 0x47, 0x8c, 0x3e, 0xb7, 0xde, 0x97, 0x3a, 0xfe, 0x5b, 0x38,
+	0x4c, 0x99, 0xd0, 0x5c, 0x99, 0x51, 0xf6, 0xe3, 0x27, 0x2d, 0xed, 0x7b, 0x13, 0x1e, 0xe5, 0x85,
+	0x5a, 0xe8, 0x0c, 0x11, 0xc1, 0x30, 0x31, 0xcf, 0xe3, 0x7e, 0x8e, 0xe5, 0x7c, 0x89, 0xd5, 0xaa,
+	0xa2, 0x12, 0xbd, 0xe4, 0xea, 0xa2, 0x09, 0xaf, 0x5b, 0x03, 0xab, 0x12, 0x25, 0x4e, 0x2e, 0xfa,
+	0x02, 0xe0, 0xbd, 0xdd, 0xcb, 0x4e, 0x28, 0x11, 0xf5, 0xdc, 0x7f, 0x0d, 0xe1, 0xef, 0x43, 0x30,
+	0x83, 0x7a, 0xb3, 0x23, 0xf4, 0xe7, 0x25, 0xa0, 0xdd, 0x0a, 0xf1, 0xa0, 0x4d, 0x99, 0x6c, 0xf1,
+	0xfd, 0x13, 0x78, 0x95, 0x72, 0x55, 0x17, 0x54, 0x8e, 0xf6, 0x26, 0xfd, 0xa9, 0x37, 0xbb, 0xff,
+	0x3f, 0x29, 0xb3, 0x65, 0xa7, 0xd2, 0xf1, 0xe2, 0x57, 0x67, 0xeb, 0x00, 0x9c, 0xaf, 0x03, 0xf0,
+	0x73, 0x1d, 0x80, 0xd3, 0x4d, 0xd0, 0x3b, 0xdf, 0x04, 0xbd, 0x6f, 0x9b, 0xa0, 0xf7, 0xee, 0xc1,
+	0xd6, 0x32, 0x9c, 0xea, 0x71, 0x99, 0x66, 0xb2, 0x2b, 0xf0, 0x87, 0x47, 0xf8, 0x63, 0x77, 0xdd,
+	0x66, 0x37, 0xd9, 0xd0, 0xbc, 0xc8, 0xc3, 0x5f, 0x03, 0x00, 0x1a, 0x61, 0xfb, 0xa2, 0xfc, 0x02,
+	0x00, 0x00,
+}
+
+func (m *AccumulationCheckpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccumulationCheckpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccumulationCheckpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintCheckpoint(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintCheckpoint(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Number != 0 {
+		i = encodeVarintCheckpoint(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccumulationEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccumulationEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccumulationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCheckpoint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintCheckpoint(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintCheckpoint(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccumulationCheckpointRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccumulationCheckpointRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccumulationCheckpointRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCheckpoint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Checkpoint.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCheckpoint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCheckpoint(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCheckpoint(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AccumulationCheckpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Number != 0 {
+		n += 1 + sovCheckpoint(uint64(m.Number))
+	}
+	if m.Height != 0 {
+		n += 1 + sovCheckpoint(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovCheckpoint(uint64(l))
+	return n
+}
+
+func (m *AccumulationEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovCheckpoint(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovCheckpoint(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovCheckpoint(uint64(l))
+	return n
+}
+
+func (m *AccumulationCheckpointRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Checkpoint.Size()
+	n += 1 + l + sovCheckpoint(uint64(l))
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovCheckpoint(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovCheckpoint(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCheckpoint(x uint64) (n int) {
+	return sovCheckpoint(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AccumulationCheckpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCheckpoint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccumulationCheckpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccumulationCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCheckpoint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCheckpoint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCheckpoint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCheckpoint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccumulationEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCheckpoint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccumulationEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccumulationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheckpoint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCheckpoint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCheckpoint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCheckpoint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheckpoint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCheckpoint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCheckpoint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCheckpoint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccumulationCheckpointRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCheckpoint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccumulationCheckpointRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccumulationCheckpointRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCheckpoint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCheckpoint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Checkpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCheckpoint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCheckpoint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, AccumulationEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCheckpoint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCheckpoint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCheckpoint(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCheckpoint
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCheckpoint
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCheckpoint
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCheckpoint
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCheckpoint
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCheckpoint
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCheckpoint        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCheckpoint          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCheckpoint = fmt.Errorf("proto: unexpected end of group")
+)
@@ -11,6 +11,14 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgLockTokens{}, "osmosis/lockup/lock-tokens", nil)
 	cdc.RegisterConcrete(&MsgBeginUnlockingAll{}, "osmosis/lockup/begin-unlock-tokens", nil)
 	cdc.RegisterConcrete(&MsgBeginUnlocking{}, "osmosis/lockup/begin-unlock-period-lock", nil)
+	cdc.RegisterConcrete(&MsgExtendLockup{}, "osmosis/lockup/extend-lockup", nil)
+	cdc.RegisterConcrete(&MsgTransferLock{}, "osmosis/lockup/transfer-lock", nil)
+	cdc.RegisterConcrete(&MsgSetAutoCompound{}, "osmosis/lockup/set-auto-compound", nil)
+	cdc.RegisterConcrete(&MsgForceUnlock{}, "osmosis/lockup/force-unlock", nil)
+	cdc.RegisterConcrete(&MsgSetCallbackContract{}, "osmosis/lockup/set-callback-contract", nil)
+	cdc.RegisterConcrete(&MsgSplitLock{}, "osmosis/lockup/split-lock", nil)
+	cdc.RegisterConcrete(&MsgMergeLocks{}, "osmosis/lockup/merge-locks", nil)
+	cdc.RegisterConcrete(&MsgClaimReceiptRewards{}, "osmosis/lockup/claim-receipt-rewards", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -19,6 +27,14 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgLockTokens{},
 		&MsgBeginUnlockingAll{},
 		&MsgBeginUnlocking{},
+		&MsgExtendLockup{},
+		&MsgTransferLock{},
+		&MsgSetAutoCompound{},
+		&MsgForceUnlock{},
+		&MsgSetCallbackContract{},
+		&MsgSplitLock{},
+		&MsgMergeLocks{},
+		&MsgClaimReceiptRewards{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -12,4 +12,15 @@ var (
 	ErrSyntheticLockupAlreadyExists      = sdkerrors.Register(ModuleName, 2, "synthetic lockup already exists for same lock and suffix")
 	ErrSyntheticDurationLongerThanNative = sdkerrors.Register(ModuleName, 3, "synthetic lockup duration should be shorter than native lockup duration")
 	ErrLockupNotFound                    = sdkerrors.Register(ModuleName, 4, "lockup not found")
+	ErrLockUnlocking                     = sdkerrors.Register(ModuleName, 5, "lock has started unlocking")
+	ErrDurationNotLonger                 = sdkerrors.Register(ModuleName, 6, "new lock duration should be longer than the current one")
+	ErrSameLockOwner                     = sdkerrors.Register(ModuleName, 7, "new lock owner is the current owner")
+	ErrForceUnlockNotAllowed             = sdkerrors.Register(ModuleName, 8, "address is not allowed to force-unlock its locks")
+	ErrLockHasSyntheticLockups           = sdkerrors.Register(ModuleName, 9, "lock has synthetic lockups")
+	ErrCallbackContractNotFound          = sdkerrors.Register(ModuleName, 10, "callback contract not found")
+	ErrInvalidSplitCoins                 = sdkerrors.Register(ModuleName, 11, "split coins must be a non-empty part of the lock's coins")
+	ErrLocksNotMergeable                 = sdkerrors.Register(ModuleName, 12, "locks cannot be merged")
+	ErrLockTokenized                     = sdkerrors.Register(ModuleName, 13, "lock is tokenized and owned by its receipt holder")
+	ErrNotReceiptHolder                  = sdkerrors.Register(ModuleName, 14, "msg sender does not hold the receipt of specified lock")
+	ErrLockNotCompoundable               = sdkerrors.Register(ModuleName, 15, "lock does not hold the shares of a single gamm pool")
 )
@@ -2,10 +2,19 @@ package types
 
 // event types.
 const (
-	TypeEvtLockTokens      = "lock_tokens"
-	TypeEvtAddTokensToLock = "add_tokens_to_lock"
-	TypeEvtBeginUnlockAll  = "begin_unlock_all"
-	TypeEvtBeginUnlock     = "begin_unlock"
+	TypeEvtLockTokens          = "lock_tokens"
+	TypeEvtAddTokensToLock     = "add_tokens_to_lock"
+	TypeEvtBeginUnlockAll      = "begin_unlock_all"
+	TypeEvtBeginUnlock         = "begin_unlock"
+	TypeEvtLockExtended        = "lock_extended"
+	TypeEvtLockTransferred     = "lock_transferred"
+	TypeEvtSetAutoCompound     = "set_auto_compound"
+	TypeEvtForceUnlock         = "force_unlock"
+	TypeEvtSetCallbackContract = "set_callback_contract"
+	TypeEvtLockSplit           = "lock_split"
+	TypeEvtLocksMerged         = "locks_merged"
+	TypeEvtLockTokenized       = "lock_tokenized"
+	TypeEvtClaimReceiptRewards = "claim_receipt_rewards"
 
 	AttributePeriodLockID         = "period_lock_id"
 	AttributePeriodLockOwner      = "owner"
@@ -13,4 +22,13 @@ const (
 	AttributePeriodLockDuration   = "duration"
 	AttributePeriodLockUnlockTime = "unlock_time"
 	AttributeUnlockedCoins        = "unlocked_coins"
+	AttributePrevLockDuration     = "prev_duration"
+	AttributeNewLockOwner         = "new_owner"
+	AttributeAutoCompound         = "auto_compound"
+	AttributeCallbackContract     = "contract"
+	AttributeNewPeriodLockID      = "new_period_lock_id"
+	AttributeMergedPeriodLockIDs  = "merged_period_lock_ids"
+	AttributeReceiptDenom         = "receipt_denom"
+	AttributeReceiptHolder        = "receipt_holder"
+	AttributeReceiptRewards       = "rewards"
 )
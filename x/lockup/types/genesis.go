@@ -1,15 +1,29 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // DefaultIndex is the default capability global index.
 const DefaultIndex uint64 = 1
 
 // DefaultGenesis returns the default Capability genesis state.
 func DefaultGenesis() *GenesisState {
-	return &GenesisState{}
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	return nil
+	for _, record := range gs.CallbackContracts {
+		if _, err := sdk.AccAddressFromBech32(record.Owner); err != nil {
+			return err
+		}
+		if _, err := sdk.AccAddressFromBech32(record.Contract); err != nil {
+			return err
+		}
+	}
+	return gs.Params.Validate()
 }
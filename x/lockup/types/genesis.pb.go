@@ -25,9 +25,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the lockup module's genesis state.
 type GenesisState struct {
-	LastLockId     uint64          `protobuf:"varint,1,opt,name=last_lock_id,json=lastLockId,proto3" json:"last_lock_id,omitempty"`
-	Locks          []PeriodLock    `protobuf:"bytes,2,rep,name=locks,proto3" json:"locks"`
-	SyntheticLocks []SyntheticLock `protobuf:"bytes,3,rep,name=synthetic_locks,json=syntheticLocks,proto3" json:"synthetic_locks"`
+	LastLockId              uint64                         `protobuf:"varint,1,opt,name=last_lock_id,json=lastLockId,proto3" json:"last_lock_id,omitempty"`
+	Locks                   []PeriodLock                   `protobuf:"bytes,2,rep,name=locks,proto3" json:"locks"`
+	SyntheticLocks          []SyntheticLock                `protobuf:"bytes,3,rep,name=synthetic_locks,json=syntheticLocks,proto3" json:"synthetic_locks"`
+	Params                  Params                         `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
+	AccumulationCheckpoints []AccumulationCheckpointRecord `protobuf:"bytes,5,rep,name=accumulation_checkpoints,json=accumulationCheckpoints,proto3" json:"accumulation_checkpoints"`
+	CallbackContracts       []CallbackContract             `protobuf:"bytes,6,rep,name=callback_contracts,json=callbackContracts,proto3" json:"callback_contracts"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -84,31 +87,118 @@ func (m *GenesisState) GetSyntheticLocks() []SyntheticLock {
 	return nil
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetAccumulationCheckpoints() []AccumulationCheckpointRecord {
+	if m != nil {
+		return m.AccumulationCheckpoints
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCallbackContracts() []CallbackContract {
+	if m != nil {
+		return m.CallbackContracts
+	}
+	return nil
+}
+
+// CallbackContract is the CosmWasm contract an owner registered to receive
+// the lockup hooks of its locks.
+type CallbackContract struct {
+	Owner    string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty" yaml:"contract"`
+}
+
+func (m *CallbackContract) Reset()         { *m = CallbackContract{} }
+func (m *CallbackContract) String() string { return proto.CompactTextString(m) }
+func (*CallbackContract) ProtoMessage()    {}
+func (*CallbackContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_648db7c6ebb608b0, []int{1}
+}
+func (m *CallbackContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CallbackContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CallbackContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CallbackContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallbackContract.Merge(m, src)
+}
+func (m *CallbackContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *CallbackContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallbackContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallbackContract proto.InternalMessageInfo
+
+func (m *CallbackContract) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *CallbackContract) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.lockup.GenesisState")
+	proto.RegisterType((*CallbackContract)(nil), "osmosis.lockup.CallbackContract")
 }
 
 func init() { proto.RegisterFile("osmosis/lockup/genesis.proto", fileDescriptor_648db7c6ebb608b0) }
 
 var fileDescriptor_648db7c6ebb608b0 = []byte{
-	// 261 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xc9, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0xcf, 0xc9, 0x4f, 0xce, 0x2e, 0x2d, 0xd0, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d,
-	0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x83, 0xca, 0xea, 0x41, 0x64, 0xa5,
-	0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x52, 0xfa, 0x20, 0x16, 0x44, 0x95, 0x94, 0x24, 0x9a, 0x19,
-	0x20, 0x0a, 0x22, 0xa5, 0xb4, 0x8d, 0x91, 0x8b, 0xc7, 0x1d, 0x62, 0x64, 0x70, 0x49, 0x62, 0x49,
-	0xaa, 0x90, 0x02, 0x17, 0x4f, 0x4e, 0x62, 0x71, 0x49, 0x3c, 0x48, 0x4d, 0x7c, 0x66, 0x8a, 0x04,
-	0xa3, 0x02, 0xa3, 0x06, 0x4b, 0x10, 0x17, 0x48, 0xcc, 0x27, 0x3f, 0x39, 0xdb, 0x33, 0x45, 0xc8,
-	0x8c, 0x8b, 0x15, 0x24, 0x59, 0x2c, 0xc1, 0xa4, 0xc0, 0xac, 0xc1, 0x6d, 0x24, 0xa5, 0x87, 0xea,
-	0x06, 0xbd, 0x80, 0xd4, 0xa2, 0xcc, 0xfc, 0x14, 0x90, 0x62, 0x27, 0x96, 0x13, 0xf7, 0xe4, 0x19,
-	0x82, 0x20, 0xca, 0x85, 0x7c, 0xb8, 0xf8, 0x8b, 0x2b, 0xf3, 0x4a, 0x32, 0x52, 0x4b, 0x32, 0x93,
-	0xe3, 0x21, 0x26, 0x30, 0x83, 0x4d, 0x90, 0x45, 0x37, 0x21, 0x18, 0xa6, 0x0c, 0xc9, 0x10, 0xbe,
-	0x62, 0x64, 0xc1, 0x62, 0x27, 0xef, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0,
-	0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88,
-	0x32, 0x4c, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x87, 0x1a, 0xac, 0x9b,
-	0x93, 0x98, 0x54, 0x0c, 0xe3, 0xe8, 0x97, 0x99, 0xeb, 0x57, 0xc0, 0x82, 0xa2, 0xa4, 0xb2, 0x20,
-	0xb5, 0x38, 0x89, 0x0d, 0x1c, 0x18, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf1, 0x7b, 0x29,
-	0xfd, 0x6d, 0x01, 0x00, 0x00,
+	// 438 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x4d, 0x8e, 0xd3, 0x30,
+	0x14, 0xc7, 0x9b, 0xe9, 0x87, 0xc0, 0x53, 0xcd, 0x0c, 0x06, 0x41, 0x28, 0x90, 0x46, 0x59, 0xa0,
+	0x2e, 0x20, 0x11, 0x03, 0x02, 0x89, 0x1d, 0xed, 0x02, 0x21, 0x66, 0x81, 0x32, 0x62, 0xc3, 0x26,
+	0x72, 0x5d, 0xab, 0xb5, 0xf2, 0xe1, 0x28, 0xcf, 0x01, 0x7a, 0x0b, 0xce, 0xc2, 0x29, 0x66, 0x39,
+	0x4b, 0x56, 0x15, 0x6a, 0x6f, 0x30, 0x27, 0x40, 0xb1, 0x9d, 0xa1, 0x98, 0x59, 0x25, 0x79, 0xbf,
+	0x9f, 0xff, 0x7e, 0x2f, 0x36, 0x7a, 0x2c, 0x20, 0x17, 0xc0, 0x21, 0xca, 0x04, 0x4d, 0xeb, 0x32,
+	0x5a, 0xb2, 0x82, 0x01, 0x87, 0xb0, 0xac, 0x84, 0x14, 0xf8, 0xc8, 0xd0, 0x50, 0xd3, 0xd1, 0xbd,
+	0xa5, 0x58, 0x0a, 0x85, 0xa2, 0xe6, 0x4d, 0x5b, 0xa3, 0x87, 0x56, 0x46, 0xf3, 0x30, 0xe8, 0x91,
+	0x85, 0x4a, 0x52, 0x91, 0xdc, 0xa4, 0x8f, 0xc6, 0x16, 0xa4, 0x2b, 0x46, 0xd3, 0x52, 0xf0, 0x42,
+	0x6a, 0x21, 0xf8, 0xd9, 0x45, 0xc3, 0xf7, 0xba, 0xa1, 0x73, 0x49, 0x24, 0xc3, 0x3e, 0x1a, 0x66,
+	0x04, 0x64, 0xd2, 0x2c, 0x48, 0xf8, 0xc2, 0x75, 0x7c, 0x67, 0xd2, 0x8b, 0x51, 0x53, 0x3b, 0x13,
+	0x34, 0xfd, 0xb0, 0xc0, 0xaf, 0x51, 0xbf, 0x81, 0xe0, 0x1e, 0xf8, 0xdd, 0xc9, 0xe1, 0xe9, 0x28,
+	0xfc, 0x77, 0x82, 0xf0, 0x13, 0xab, 0xb8, 0x58, 0x34, 0xf2, 0xb4, 0x77, 0xb1, 0x19, 0x77, 0x62,
+	0xad, 0xe3, 0x33, 0x74, 0x0c, 0xeb, 0x42, 0xae, 0x98, 0xe4, 0x34, 0xd1, 0x09, 0x5d, 0x95, 0xf0,
+	0xc4, 0x4e, 0x38, 0x6f, 0xb5, 0xbd, 0x90, 0x23, 0xd8, 0x2f, 0x02, 0x7e, 0x85, 0x06, 0x7a, 0x52,
+	0xb7, 0xe7, 0x3b, 0x93, 0xc3, 0xd3, 0xfb, 0xff, 0xb5, 0xa1, 0xa8, 0x59, 0x6d, 0x5c, 0x9c, 0x23,
+	0x97, 0x50, 0x5a, 0xe7, 0x75, 0x46, 0x24, 0x17, 0x45, 0xf2, 0xf7, 0x7f, 0x80, 0xdb, 0x57, 0xcd,
+	0x3c, 0xb3, 0x73, 0xde, 0xed, 0xf9, 0xb3, 0x6b, 0x3d, 0x66, 0x54, 0x54, 0x0b, 0x93, 0xfe, 0x80,
+	0xdc, 0xe8, 0x00, 0xfe, 0x8c, 0x30, 0x25, 0x59, 0x36, 0x27, 0x34, 0x4d, 0xa8, 0x28, 0x64, 0x45,
+	0xa8, 0x04, 0x77, 0xa0, 0x36, 0xf2, 0xed, 0x8d, 0x66, 0xc6, 0x9c, 0x19, 0xd1, 0x84, 0xdf, 0xa1,
+	0x56, 0x1d, 0x82, 0x14, 0x9d, 0xd8, 0x32, 0x7e, 0x8a, 0xfa, 0xe2, 0x5b, 0xc1, 0x2a, 0x75, 0x60,
+	0xb7, 0xa7, 0x27, 0x57, 0x9b, 0xf1, 0x70, 0x4d, 0xf2, 0xec, 0x6d, 0xa0, 0xca, 0x41, 0xac, 0x31,
+	0x8e, 0xd0, 0xad, 0xb6, 0x13, 0xf7, 0x40, 0xa9, 0x77, 0xaf, 0x36, 0xe3, 0x63, 0xad, 0xb6, 0x24,
+	0x88, 0xaf, 0xa5, 0xe9, 0xc7, 0x8b, 0xad, 0xe7, 0x5c, 0x6e, 0x3d, 0xe7, 0xf7, 0xd6, 0x73, 0x7e,
+	0xec, 0xbc, 0xce, 0xe5, 0xce, 0xeb, 0xfc, 0xda, 0x79, 0x9d, 0x2f, 0x2f, 0x96, 0x5c, 0xae, 0xea,
+	0x79, 0x48, 0x45, 0x1e, 0x99, 0x59, 0x9e, 0x67, 0x64, 0x0e, 0xed, 0x47, 0xf4, 0xf5, 0x4d, 0xf4,
+	0xbd, 0xbd, 0x79, 0x72, 0x5d, 0x32, 0x98, 0x0f, 0xd4, 0xad, 0x7b, 0xf9, 0x67, 0x00, 0xd5, 0x44,
+	0x59, 0x9f, 0x14, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -131,6 +221,44 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CallbackContracts) > 0 {
+		for iNdEx := len(m.CallbackContracts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CallbackContracts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.AccumulationCheckpoints) > 0 {
+		for iNdEx := len(m.AccumulationCheckpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccumulationCheckpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if len(m.SyntheticLocks) > 0 {
 		for iNdEx := len(m.SyntheticLocks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -167,6 +295,43 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CallbackContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CallbackContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CallbackContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -199,6 +364,37 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.AccumulationCheckpoints) > 0 {
+		for _, e := range m.AccumulationCheckpoints {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CallbackContracts) > 0 {
+		for _, e := range m.CallbackContracts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CallbackContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -324,6 +520,221 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccumulationCheckpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccumulationCheckpoints = append(m.AccumulationCheckpoints, AccumulationCheckpointRecord{})
+			if err := m.AccumulationCheckpoints[len(m.AccumulationCheckpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackContracts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackContracts = append(m.CallbackContracts, CallbackContract{})
+			if err := m.CallbackContracts[len(m.CallbackContracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CallbackContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CallbackContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CallbackContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
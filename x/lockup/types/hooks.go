@@ -12,6 +12,10 @@ type LockupHooks interface {
 	OnStartUnlock(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time)
 	OnTokenUnlocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time)
 	OnTokenSlashed(ctx sdk.Context, lockID uint64, amount sdk.Coins)
+	OnLockupExtend(ctx sdk.Context, lockID uint64, prevDuration, newDuration time.Duration)
+	OnLockupTransfer(ctx sdk.Context, lockID uint64, prevOwner, newOwner sdk.AccAddress)
+	OnLockupSplit(ctx sdk.Context, lockID, newLockID uint64, amount sdk.Coins)
+	OnLockupMerge(ctx sdk.Context, lockID uint64, mergedLockIDs []uint64)
 }
 
 var _ LockupHooks = MultiLockupHooks{}
@@ -52,3 +56,27 @@ func (h MultiLockupHooks) OnTokenSlashed(ctx sdk.Context, lockID uint64, amount
 		h[i].OnTokenSlashed(ctx, lockID, amount)
 	}
 }
+
+func (h MultiLockupHooks) OnLockupExtend(ctx sdk.Context, lockID uint64, prevDuration, newDuration time.Duration) {
+	for i := range h {
+		h[i].OnLockupExtend(ctx, lockID, prevDuration, newDuration)
+	}
+}
+
+func (h MultiLockupHooks) OnLockupTransfer(ctx sdk.Context, lockID uint64, prevOwner, newOwner sdk.AccAddress) {
+	for i := range h {
+		h[i].OnLockupTransfer(ctx, lockID, prevOwner, newOwner)
+	}
+}
+
+func (h MultiLockupHooks) OnLockupSplit(ctx sdk.Context, lockID, newLockID uint64, amount sdk.Coins) {
+	for i := range h {
+		h[i].OnLockupSplit(ctx, lockID, newLockID, amount)
+	}
+}
+
+func (h MultiLockupHooks) OnLockupMerge(ctx sdk.Context, lockID uint64, mergedLockIDs []uint64) {
+	for i := range h {
+		h[i].OnLockupMerge(ctx, lockID, mergedLockIDs)
+	}
+}
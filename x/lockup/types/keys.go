@@ -4,6 +4,10 @@ var (
 	// ModuleName defines the module name.
 	ModuleName = "lockup"
 
+	// ReceiptRewardsModuleAcctName defines the module account holding the
+	// incentive rewards of tokenized locks, apart from the locked coins.
+	ReceiptRewardsModuleAcctName = "lockup_receipt_rewards"
+
 	// StoreKey defines the primary module store key.
 	StoreKey = ModuleName
 
@@ -64,6 +68,15 @@ var (
 	// KeyPrefixLockAccumulation defines prefix for the lock accumulation store.
 	KeyPrefixLockAccumulation = []byte{0x20}
 
+	// KeyPrefixAccumulationCheckpoint defines prefix to store accumulation checkpoints by number.
+	KeyPrefixAccumulationCheckpoint = []byte{0x21}
+
+	// KeyPrefixAccumulationCheckpointStore defines prefix for the copies of the lock accumulation store by checkpoint number.
+	KeyPrefixAccumulationCheckpointStore = []byte{0x22}
+
+	// KeyPrefixCallbackContract defines prefix to store the callback contract of a lock owner.
+	KeyPrefixCallbackContract = []byte{0x23}
+
 	// KeyIndexSeparator defines separator between keys when combine, it should be one that is not used in denom expression.
 	KeyIndexSeparator = []byte{0xFF}
 )
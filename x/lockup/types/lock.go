@@ -19,6 +19,19 @@ func NewPeriodLock(ID uint64, owner sdk.AccAddress, duration time.Duration, endT
 	}
 }
 
+// ReceiptDenomPrefix is the prefix of the receipt denoms of tokenized locks.
+const ReceiptDenomPrefix = "lock/"
+
+// ReceiptDenom returns the denom of the receipt of a tokenized lock.
+func ReceiptDenom(lockID uint64) string {
+	return fmt.Sprintf("%s%d", ReceiptDenomPrefix, lockID)
+}
+
+// ReceiptCoins returns the single receipt coin minted for a tokenized lock.
+func ReceiptCoins(lockID uint64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(ReceiptDenom(lockID), 1))
+}
+
 // IsUnlocking returns lock started unlocking already.
 func (p PeriodLock) IsUnlocking() bool {
 	return !p.EndTime.Equal(time.Time{})
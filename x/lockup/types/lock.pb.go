@@ -66,6 +66,15 @@ type PeriodLock struct {
 	Duration time.Duration                            `protobuf:"bytes,3,opt,name=duration,proto3,stdduration" json:"duration,omitempty" yaml:"duration"`
 	EndTime  time.Time                                `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
 	Coins    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	// auto_compound routes the lock's incentive rewards back into the pool of
+	// its shares and adds the shares to the lock
+	AutoCompound bool `protobuf:"varint,6,opt,name=auto_compound,json=autoCompound,proto3" json:"auto_compound,omitempty" yaml:"auto_compound"`
+	// tokenized locks are owned by the holder of their receipt denom,
+	// "lock/{ID}", rather than by owner
+	Tokenized bool `protobuf:"varint,7,opt,name=tokenized,proto3" json:"tokenized,omitempty" yaml:"tokenized"`
+	// receipt_rewards are the incentive rewards of a tokenized lock, held by
+	// the module until the receipt holder claims them
+	ReceiptRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=receipt_rewards,json=receiptRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"receipt_rewards" yaml:"receipt_rewards"`
 }
 
 func (m *PeriodLock) Reset()         { *m = PeriodLock{} }
@@ -136,6 +145,27 @@ func (m *PeriodLock) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *PeriodLock) GetAutoCompound() bool {
+	if m != nil {
+		return m.AutoCompound
+	}
+	return false
+}
+
+func (m *PeriodLock) GetTokenized() bool {
+	if m != nil {
+		return m.Tokenized
+	}
+	return false
+}
+
+func (m *PeriodLock) GetReceiptRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ReceiptRewards
+	}
+	return nil
+}
+
 type QueryCondition struct {
 	// type of lock query, ByLockDuration | ByLockTime
 	LockQueryType LockQueryType `protobuf:"varint,1,opt,name=lock_query_type,json=lockQueryType,proto3,enum=osmosis.lockup.LockQueryType" json:"lock_query_type,omitempty"`
@@ -303,45 +333,51 @@ func init() {
 func init() { proto.RegisterFile("osmosis/lockup/lock.proto", fileDescriptor_7e9d7527a237b489) }
 
 var fileDescriptor_7e9d7527a237b489 = []byte{
-	// 595 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xb6, 0x9d, 0xa4, 0xb4, 0x57, 0x92, 0x46, 0xa7, 0x0e, 0x69, 0x00, 0x3b, 0xf2, 0x80, 0x22,
-	0xd4, 0xda, 0xa4, 0x0c, 0x48, 0x8c, 0x6e, 0x18, 0x22, 0x18, 0xc0, 0x54, 0x0c, 0x2c, 0x91, 0x7f,
-	0x1c, 0xce, 0x29, 0xb6, 0xcf, 0xf8, 0x47, 0xc1, 0xff, 0x01, 0x63, 0x47, 0x90, 0xd8, 0xd8, 0xf8,
-	0x4b, 0x3a, 0x76, 0x64, 0x4a, 0x51, 0x22, 0x16, 0xc6, 0xfe, 0x05, 0xe8, 0xee, 0x7c, 0x49, 0x5a,
-	0x84, 0xd4, 0x01, 0xa6, 0xf3, 0xbb, 0xef, 0xbd, 0xef, 0xbd, 0xfb, 0xde, 0x27, 0x83, 0x3d, 0x92,
-	0x45, 0x24, 0xc3, 0x99, 0x19, 0x12, 0x6f, 0x5a, 0x24, 0xec, 0x30, 0x92, 0x94, 0xe4, 0x04, 0xb6,
-	0x2a, 0xc8, 0xe0, 0x50, 0x77, 0x37, 0x20, 0x01, 0x61, 0x90, 0x49, 0xbf, 0x78, 0x56, 0x57, 0x0d,
-	0x08, 0x09, 0x42, 0x64, 0xb2, 0xc8, 0x2d, 0xde, 0x9a, 0x7e, 0x91, 0x3a, 0x39, 0x26, 0x71, 0x85,
-	0x6b, 0xd7, 0xf1, 0x1c, 0x47, 0x28, 0xcb, 0x9d, 0x28, 0x11, 0x04, 0x1e, 0xeb, 0x63, 0xba, 0x4e,
-	0x86, 0xcc, 0x93, 0x81, 0x8b, 0x72, 0x67, 0x60, 0x7a, 0x04, 0x57, 0x04, 0xfa, 0x4f, 0x05, 0x80,
-	0x17, 0x28, 0xc5, 0xc4, 0x7f, 0x4e, 0xbc, 0x29, 0x6c, 0x01, 0x65, 0x34, 0xec, 0xc8, 0x3d, 0xb9,
-	0x5f, 0xb7, 0x95, 0xd1, 0x10, 0xde, 0x07, 0x0d, 0xf2, 0x3e, 0x46, 0x69, 0x47, 0xe9, 0xc9, 0xfd,
-	0x2d, 0xab, 0x7d, 0x39, 0xd3, 0x6e, 0x97, 0x4e, 0x14, 0x3e, 0xd1, 0xd9, 0xb5, 0x6e, 0x73, 0x18,
-	0x4e, 0xc0, 0xa6, 0x98, 0xac, 0x53, 0xeb, 0xc9, 0xfd, 0xed, 0xc3, 0x3d, 0x83, 0x8f, 0x66, 0x88,
-	0xd1, 0x8c, 0x61, 0x95, 0x60, 0x0d, 0xce, 0x66, 0x9a, 0xf4, 0x6b, 0xa6, 0x41, 0x51, 0xb2, 0x4f,
-	0x22, 0x9c, 0xa3, 0x28, 0xc9, 0xcb, 0xcb, 0x99, 0xb6, 0xc3, 0xf9, 0x05, 0xa6, 0x7f, 0xba, 0xd0,
-	0x64, 0x7b, 0xc9, 0x0e, 0x6d, 0xb0, 0x89, 0x62, 0x7f, 0x4c, 0xdf, 0xd9, 0xa9, 0xb3, 0x4e, 0xdd,
-	0x3f, 0x3a, 0x1d, 0x0b, 0x11, 0xac, 0x3b, 0xb4, 0xd5, 0x8a, 0x54, 0x54, 0xea, 0xa7, 0x94, 0xf4,
-	0x16, 0x8a, 0x7d, 0x9a, 0x0a, 0x1d, 0xd0, 0xa0, 0x92, 0x64, 0x9d, 0x46, 0xaf, 0xc6, 0x46, 0xe7,
-	0xa2, 0x19, 0x54, 0x34, 0xa3, 0x12, 0xcd, 0x38, 0x22, 0x38, 0xb6, 0x1e, 0x52, 0xbe, 0x6f, 0x17,
-	0x5a, 0x3f, 0xc0, 0xf9, 0xa4, 0x70, 0x0d, 0x8f, 0x44, 0x66, 0xa5, 0x30, 0x3f, 0x0e, 0x32, 0x7f,
-	0x6a, 0xe6, 0x65, 0x82, 0x32, 0x56, 0x90, 0xd9, 0x9c, 0x59, 0xff, 0xac, 0x80, 0xd6, 0xcb, 0x02,
-	0xa5, 0xe5, 0x11, 0x89, 0x7d, 0xcc, 0x5e, 0xf2, 0x14, 0xec, 0xd0, 0xdd, 0x8f, 0xdf, 0xd1, 0xeb,
-	0x31, 0xad, 0x61, 0xc2, 0xb7, 0x0e, 0xef, 0x19, 0x57, 0xbd, 0x61, 0xd0, 0xd5, 0xb0, 0xe2, 0xe3,
-	0x32, 0x41, 0x76, 0x33, 0x5c, 0x0f, 0xe1, 0x2e, 0x68, 0xf8, 0x28, 0x26, 0x11, 0x5f, 0x91, 0xcd,
-	0x03, 0x2a, 0xd3, 0xcd, 0x17, 0x72, 0x4d, 0xa5, 0xbf, 0x49, 0xff, 0x1a, 0x6c, 0x2d, 0xed, 0x75,
-	0x03, 0xed, 0xef, 0x56, 0xac, 0x6d, 0xce, 0xba, 0x2c, 0xe5, 0xe2, 0xaf, 0xa8, 0xf4, 0x2f, 0x0a,
-	0x68, 0xbe, 0x2a, 0xe3, 0x7c, 0x82, 0x72, 0xec, 0x31, 0x1b, 0xee, 0x03, 0x58, 0xc4, 0x3e, 0x4a,
-	0xc3, 0x12, 0xc7, 0xc1, 0x98, 0xa9, 0x84, 0xfd, 0xca, 0x96, 0xed, 0x15, 0x42, 0x73, 0x47, 0x3e,
-	0xd4, 0xc0, 0x76, 0x46, 0xcb, 0xc7, 0xeb, 0x3a, 0x00, 0x76, 0x35, 0x14, 0x62, 0x2c, 0x3d, 0x53,
-	0xfb, 0x47, 0x9e, 0x59, 0x77, 0x7c, 0xfd, 0x7f, 0x3a, 0xfe, 0xc1, 0x00, 0x34, 0xaf, 0x18, 0x00,
-	0xb6, 0x00, 0xb0, 0x4a, 0xc1, 0xdd, 0x96, 0x20, 0x00, 0x1b, 0x56, 0x49, 0x87, 0x6a, 0xcb, 0xdd,
-	0xfa, 0xc7, 0xaf, 0xaa, 0x64, 0x3d, 0x3b, 0x9b, 0xab, 0xf2, 0xf9, 0x5c, 0x95, 0x7f, 0xcc, 0x55,
-	0xf9, 0x74, 0xa1, 0x4a, 0xe7, 0x0b, 0x55, 0xfa, 0xbe, 0x50, 0xa5, 0x37, 0x83, 0x35, 0xe3, 0x56,
-	0x2e, 0x3b, 0x08, 0x1d, 0x37, 0x13, 0x81, 0x79, 0xf2, 0xd8, 0xfc, 0x20, 0x7e, 0x57, 0xcc, 0xc7,
-	0xee, 0x06, 0x7b, 0xcf, 0xa3, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x72, 0x71, 0xec, 0xb5, 0xcd,
-	0x04, 0x00, 0x00,
+	// 692 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x31, 0x6f, 0xd3, 0x4c,
+	0x18, 0x8e, 0xd3, 0xa4, 0x4d, 0xaf, 0x4d, 0x1a, 0x9d, 0xa2, 0x4f, 0x6e, 0xbe, 0xef, 0xb3, 0x23,
+	0x0f, 0x28, 0x42, 0xad, 0x4d, 0xca, 0x80, 0x84, 0xc4, 0xe2, 0x86, 0xa1, 0xc0, 0x00, 0xa6, 0x62,
+	0x60, 0xb1, 0x1c, 0xfb, 0x48, 0x4f, 0x89, 0x7d, 0xc6, 0x3e, 0xb7, 0x98, 0x5f, 0xc0, 0x84, 0x3a,
+	0x82, 0xc4, 0xc6, 0xc6, 0x2f, 0xe9, 0xd8, 0x91, 0x29, 0x45, 0xed, 0x04, 0x63, 0x7e, 0x01, 0xba,
+	0x3b, 0x5f, 0x92, 0x16, 0x21, 0x8a, 0x04, 0x93, 0xf3, 0xfa, 0x79, 0x9f, 0xe7, 0xde, 0x7b, 0xfc,
+	0xbc, 0x01, 0x9b, 0x24, 0x0d, 0x49, 0x8a, 0x53, 0x6b, 0x4c, 0xfc, 0x51, 0x16, 0xf3, 0x87, 0x19,
+	0x27, 0x84, 0x12, 0xd8, 0x28, 0x20, 0x53, 0x40, 0xed, 0xd6, 0x90, 0x0c, 0x09, 0x87, 0x2c, 0xf6,
+	0x4b, 0x74, 0xb5, 0xb5, 0x21, 0x21, 0xc3, 0x31, 0xb2, 0x78, 0x35, 0xc8, 0x5e, 0x58, 0x41, 0x96,
+	0x78, 0x14, 0x93, 0xa8, 0xc0, 0xf5, 0xab, 0x38, 0xc5, 0x21, 0x4a, 0xa9, 0x17, 0xc6, 0x52, 0xc0,
+	0xe7, 0xe7, 0x58, 0x03, 0x2f, 0x45, 0xd6, 0x61, 0x6f, 0x80, 0xa8, 0xd7, 0xb3, 0x7c, 0x82, 0x0b,
+	0x01, 0xe3, 0x6b, 0x05, 0x80, 0xc7, 0x28, 0xc1, 0x24, 0x78, 0x44, 0xfc, 0x11, 0x6c, 0x80, 0xf2,
+	0x5e, 0x5f, 0x55, 0x3a, 0x4a, 0xb7, 0xe2, 0x94, 0xf7, 0xfa, 0xf0, 0x06, 0xa8, 0x92, 0xa3, 0x08,
+	0x25, 0x6a, 0xb9, 0xa3, 0x74, 0x57, 0xed, 0xe6, 0x74, 0xa2, 0xaf, 0xe7, 0x5e, 0x38, 0xbe, 0x6b,
+	0xf0, 0xd7, 0x86, 0x23, 0x60, 0x78, 0x00, 0x6a, 0x72, 0x32, 0x75, 0xa9, 0xa3, 0x74, 0xd7, 0x76,
+	0x36, 0x4d, 0x31, 0x9a, 0x29, 0x47, 0x33, 0xfb, 0x45, 0x83, 0xdd, 0x3b, 0x99, 0xe8, 0xa5, 0x6f,
+	0x13, 0x1d, 0x4a, 0xca, 0x16, 0x09, 0x31, 0x45, 0x61, 0x4c, 0xf3, 0xe9, 0x44, 0xdf, 0x10, 0xfa,
+	0x12, 0x33, 0xde, 0x9d, 0xe9, 0x8a, 0x33, 0x53, 0x87, 0x0e, 0xa8, 0xa1, 0x28, 0x70, 0xd9, 0x3d,
+	0xd5, 0x0a, 0x3f, 0xa9, 0xfd, 0xc3, 0x49, 0xfb, 0xd2, 0x04, 0xfb, 0x5f, 0x76, 0xd4, 0x5c, 0x54,
+	0x32, 0x8d, 0x63, 0x26, 0xba, 0x82, 0xa2, 0x80, 0xb5, 0x42, 0x0f, 0x54, 0x99, 0x25, 0xa9, 0x5a,
+	0xed, 0x2c, 0xf1, 0xd1, 0x85, 0x69, 0x26, 0x33, 0xcd, 0x2c, 0x4c, 0x33, 0x77, 0x09, 0x8e, 0xec,
+	0x5b, 0x4c, 0xef, 0xd3, 0x99, 0xde, 0x1d, 0x62, 0x7a, 0x90, 0x0d, 0x4c, 0x9f, 0x84, 0x56, 0xe1,
+	0xb0, 0x78, 0x6c, 0xa7, 0xc1, 0xc8, 0xa2, 0x79, 0x8c, 0x52, 0x4e, 0x48, 0x1d, 0xa1, 0x0c, 0xef,
+	0x81, 0xba, 0x97, 0x51, 0xe2, 0xfa, 0x24, 0x8c, 0x49, 0x16, 0x05, 0xea, 0x72, 0x47, 0xe9, 0xd6,
+	0x6c, 0x75, 0x3a, 0xd1, 0x5b, 0x62, 0xb6, 0x4b, 0xb0, 0xe1, 0xac, 0xb3, 0x7a, 0xb7, 0x28, 0xe1,
+	0x0e, 0x58, 0xa5, 0x64, 0x84, 0x22, 0xfc, 0x1a, 0x05, 0xea, 0x0a, 0xa7, 0xb6, 0xa6, 0x13, 0xbd,
+	0x29, 0xa8, 0x33, 0xc8, 0x70, 0xe6, 0x6d, 0xf0, 0xad, 0x02, 0x36, 0x12, 0xe4, 0x23, 0x1c, 0x53,
+	0x37, 0x41, 0x47, 0x5e, 0x12, 0xa4, 0x6a, 0xed, 0x57, 0x17, 0x7c, 0x50, 0x18, 0xf6, 0x8f, 0x50,
+	0xbe, 0xc2, 0x37, 0x7e, 0xeb, 0xea, 0x8d, 0x82, 0xed, 0x14, 0xe4, 0xf7, 0x65, 0xd0, 0x78, 0x92,
+	0xa1, 0x24, 0xdf, 0x25, 0x51, 0x80, 0xf9, 0xd7, 0xbc, 0x0f, 0x36, 0x58, 0xfe, 0xdd, 0x97, 0xec,
+	0xb5, 0xcb, 0xc8, 0x3c, 0x7c, 0x8d, 0x9d, 0xff, 0xcd, 0xcb, 0xfb, 0x61, 0xb2, 0x78, 0x72, 0xf2,
+	0x7e, 0x1e, 0x23, 0xa7, 0x3e, 0x5e, 0x2c, 0x61, 0x0b, 0x54, 0x03, 0x14, 0x91, 0x50, 0xc4, 0xd4,
+	0x11, 0x05, 0x8b, 0xca, 0xf5, 0x43, 0x79, 0x25, 0x29, 0x3f, 0x8b, 0xdf, 0x33, 0xb0, 0x3a, 0x5b,
+	0xb1, 0x6b, 0xe4, 0xef, 0xbf, 0x42, 0x55, 0x7e, 0x28, 0x09, 0x88, 0x00, 0xce, 0xa5, 0x8c, 0x0f,
+	0x65, 0x50, 0x7f, 0x9a, 0x47, 0xf4, 0x00, 0x51, 0xec, 0xf3, 0x55, 0xdc, 0x02, 0x30, 0x8b, 0x02,
+	0x94, 0x8c, 0x73, 0x1c, 0x0d, 0x5d, 0xee, 0x12, 0x0e, 0x8a, 0xd5, 0x6c, 0xce, 0x11, 0xd6, 0xbb,
+	0x17, 0x40, 0x1d, 0xac, 0xa5, 0x8c, 0xee, 0x2e, 0xfa, 0x00, 0xf8, 0xab, 0xbe, 0x34, 0x63, 0xb6,
+	0x37, 0x4b, 0x7f, 0x68, 0x6f, 0x16, 0xb7, 0xbe, 0xf2, 0x37, 0xb7, 0xfe, 0x66, 0x0f, 0xd4, 0x2f,
+	0x05, 0x00, 0x36, 0x00, 0xb0, 0x73, 0xa9, 0xdd, 0x2c, 0x41, 0x00, 0x96, 0xed, 0x9c, 0x0d, 0xd5,
+	0x54, 0xda, 0x95, 0x37, 0x1f, 0xb5, 0x92, 0xfd, 0xf0, 0xe4, 0x5c, 0x53, 0x4e, 0xcf, 0x35, 0xe5,
+	0xcb, 0xb9, 0xa6, 0x1c, 0x5f, 0x68, 0xa5, 0xd3, 0x0b, 0xad, 0xf4, 0xf9, 0x42, 0x2b, 0x3d, 0xef,
+	0x2d, 0x24, 0xb8, 0x48, 0xd9, 0xf6, 0xd8, 0x1b, 0xa4, 0xb2, 0xb0, 0x0e, 0xef, 0x58, 0xaf, 0xe4,
+	0x5f, 0x36, 0x0f, 0xf4, 0x60, 0x99, 0xdf, 0xe7, 0xf6, 0xf7, 0x01, 0x00, 0xc7, 0x65, 0x7c, 0x89,
+	0xd1, 0x05, 0x00, 0x00,
 }
 
 func (m *PeriodLock) Marshal() (dAtA []byte, err error) {
@@ -364,6 +400,40 @@ func (m *PeriodLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ReceiptRewards) > 0 {
+		for iNdEx := len(m.ReceiptRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReceiptRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLock(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.Tokenized {
+		i--
+		if m.Tokenized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.AutoCompound {
+		i--
+		if m.AutoCompound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.Coins) > 0 {
 		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -545,6 +615,18 @@ func (m *PeriodLock) Size() (n int) {
 			n += 1 + l + sovLock(uint64(l))
 		}
 	}
+	if m.AutoCompound {
+		n += 2
+	}
+	if m.Tokenized {
+		n += 2
+	}
+	if len(m.ReceiptRewards) > 0 {
+		for _, e := range m.ReceiptRewards {
+			l = e.Size()
+			n += 1 + l + sovLock(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -774,6 +856,80 @@ func (m *PeriodLock) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompound", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoCompound = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokenized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tokenized = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiptRewards = append(m.ReceiptRewards, types.Coin{})
+			if err := m.ReceiptRewards[len(m.ReceiptRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLock(dAtA[iNdEx:])
@@ -9,9 +9,17 @@ import (
 
 // constants.
 const (
-	TypeMsgLockTokens        = "lock_tokens"
-	TypeMsgBeginUnlockingAll = "begin_unlocking_all"
-	TypeMsgBeginUnlocking    = "begin_unlocking"
+	TypeMsgLockTokens          = "lock_tokens"
+	TypeMsgBeginUnlockingAll   = "begin_unlocking_all"
+	TypeMsgBeginUnlocking      = "begin_unlocking"
+	TypeMsgExtendLockup        = "extend_lockup"
+	TypeMsgTransferLock        = "transfer_lock"
+	TypeMsgSetAutoCompound     = "set_auto_compound"
+	TypeMsgForceUnlock         = "force_unlock"
+	TypeMsgSetCallbackContract = "set_callback_contract"
+	TypeMsgSplitLock           = "split_lock"
+	TypeMsgMergeLocks          = "merge_locks"
+	TypeMsgClaimReceiptRewards = "claim_receipt_rewards"
 )
 
 var _ sdk.Msg = &MsgLockTokens{}
@@ -92,3 +100,285 @@ func (m MsgBeginUnlocking) GetSigners() []sdk.AccAddress {
 	owner, _ := sdk.AccAddressFromBech32(m.Owner)
 	return []sdk.AccAddress{owner}
 }
+
+var _ sdk.Msg = &MsgExtendLockup{}
+
+// NewMsgExtendLockup creates a message to extend the duration of a lock.
+func NewMsgExtendLockup(owner sdk.AccAddress, id uint64, duration time.Duration) *MsgExtendLockup {
+	return &MsgExtendLockup{
+		Owner:    owner.String(),
+		ID:       id,
+		Duration: duration,
+	}
+}
+
+func (m MsgExtendLockup) Route() string { return RouterKey }
+func (m MsgExtendLockup) Type() string  { return TypeMsgExtendLockup }
+func (m MsgExtendLockup) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return err
+	}
+	if m.ID == 0 {
+		return fmt.Errorf("id is empty")
+	}
+	if m.Duration <= 0 {
+		return fmt.Errorf("duration should be positive: %d < 0", m.Duration)
+	}
+	return nil
+}
+
+func (m MsgExtendLockup) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgExtendLockup) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+var _ sdk.Msg = &MsgTransferLock{}
+
+// NewMsgTransferLock creates a message to transfer a lock to a new owner.
+func NewMsgTransferLock(owner sdk.AccAddress, id uint64, newOwner sdk.AccAddress) *MsgTransferLock {
+	return &MsgTransferLock{
+		Owner:    owner.String(),
+		ID:       id,
+		NewOwner: newOwner.String(),
+	}
+}
+
+func (m MsgTransferLock) Route() string { return RouterKey }
+func (m MsgTransferLock) Type() string  { return TypeMsgTransferLock }
+func (m MsgTransferLock) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(m.NewOwner); err != nil {
+		return err
+	}
+	if m.Owner == m.NewOwner {
+		return ErrSameLockOwner
+	}
+	if m.ID == 0 {
+		return fmt.Errorf("id is empty")
+	}
+	return nil
+}
+
+func (m MsgTransferLock) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgTransferLock) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+var _ sdk.Msg = &MsgSetAutoCompound{}
+
+// NewMsgSetAutoCompound creates a message to opt a lock in or out of compounding its rewards.
+func NewMsgSetAutoCompound(owner sdk.AccAddress, id uint64, autoCompound bool) *MsgSetAutoCompound {
+	return &MsgSetAutoCompound{
+		Owner:        owner.String(),
+		ID:           id,
+		AutoCompound: autoCompound,
+	}
+}
+
+func (m MsgSetAutoCompound) Route() string { return RouterKey }
+func (m MsgSetAutoCompound) Type() string  { return TypeMsgSetAutoCompound }
+func (m MsgSetAutoCompound) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return err
+	}
+	if m.ID == 0 {
+		return fmt.Errorf("id is empty")
+	}
+	return nil
+}
+
+func (m MsgSetAutoCompound) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetAutoCompound) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+var _ sdk.Msg = &MsgForceUnlock{}
+
+// NewMsgForceUnlock creates a message to instantly unlock a lock.
+func NewMsgForceUnlock(owner sdk.AccAddress, id uint64, coins sdk.Coins) *MsgForceUnlock {
+	return &MsgForceUnlock{
+		Owner: owner.String(),
+		ID:    id,
+		Coins: coins,
+	}
+}
+
+func (m MsgForceUnlock) Route() string { return RouterKey }
+func (m MsgForceUnlock) Type() string  { return TypeMsgForceUnlock }
+func (m MsgForceUnlock) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return err
+	}
+	if m.ID == 0 {
+		return fmt.Errorf("id is empty")
+	}
+	if !m.Coins.IsValid() {
+		return fmt.Errorf("invalid coins %s", m.Coins)
+	}
+	return nil
+}
+
+func (m MsgForceUnlock) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgForceUnlock) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+var _ sdk.Msg = &MsgSetCallbackContract{}
+
+// NewMsgSetCallbackContract creates a message to register the callback contract of an owner's locks.
+// An empty contract removes the registered one.
+func NewMsgSetCallbackContract(owner sdk.AccAddress, contract string) *MsgSetCallbackContract {
+	return &MsgSetCallbackContract{
+		Owner:    owner.String(),
+		Contract: contract,
+	}
+}
+
+func (m MsgSetCallbackContract) Route() string { return RouterKey }
+func (m MsgSetCallbackContract) Type() string  { return TypeMsgSetCallbackContract }
+func (m MsgSetCallbackContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return err
+	}
+	if m.Contract == "" {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Contract); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m MsgSetCallbackContract) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetCallbackContract) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+var _ sdk.Msg = &MsgSplitLock{}
+
+// NewMsgSplitLock creates a message to move coins out of a lock into a new lock.
+func NewMsgSplitLock(owner sdk.AccAddress, id uint64, coins sdk.Coins) *MsgSplitLock {
+	return &MsgSplitLock{
+		Owner: owner.String(),
+		ID:    id,
+		Coins: coins,
+	}
+}
+
+func (m MsgSplitLock) Route() string { return RouterKey }
+func (m MsgSplitLock) Type() string  { return TypeMsgSplitLock }
+func (m MsgSplitLock) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return err
+	}
+	if m.ID == 0 {
+		return fmt.Errorf("id is empty")
+	}
+	if m.Coins.Empty() || !m.Coins.IsValid() {
+		return fmt.Errorf("invalid coins %s", m.Coins)
+	}
+	return nil
+}
+
+func (m MsgSplitLock) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSplitLock) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+var _ sdk.Msg = &MsgMergeLocks{}
+
+// NewMsgMergeLocks creates a message to merge locks into the first of them.
+func NewMsgMergeLocks(owner sdk.AccAddress, ids []uint64) *MsgMergeLocks {
+	return &MsgMergeLocks{
+		Owner: owner.String(),
+		IDs:   ids,
+	}
+}
+
+func (m MsgMergeLocks) Route() string { return RouterKey }
+func (m MsgMergeLocks) Type() string  { return TypeMsgMergeLocks }
+func (m MsgMergeLocks) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return err
+	}
+	if len(m.IDs) < 2 {
+		return fmt.Errorf("at least two locks are needed to merge")
+	}
+	seen := make(map[uint64]bool, len(m.IDs))
+	for _, id := range m.IDs {
+		if id == 0 {
+			return fmt.Errorf("id is empty")
+		}
+		if seen[id] {
+			return fmt.Errorf("lock %d is listed twice", id)
+		}
+		seen[id] = true
+	}
+	return nil
+}
+
+func (m MsgMergeLocks) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgMergeLocks) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+var _ sdk.Msg = &MsgClaimReceiptRewards{}
+
+// NewMsgClaimReceiptRewards creates a message to claim the rewards of a tokenized lock.
+func NewMsgClaimReceiptRewards(sender sdk.AccAddress, id uint64) *MsgClaimReceiptRewards {
+	return &MsgClaimReceiptRewards{
+		Sender: sender.String(),
+		ID:     id,
+	}
+}
+
+func (m MsgClaimReceiptRewards) Route() string { return RouterKey }
+func (m MsgClaimReceiptRewards) Type() string  { return TypeMsgClaimReceiptRewards }
+func (m MsgClaimReceiptRewards) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return err
+	}
+	if m.ID == 0 {
+		return fmt.Errorf("id is empty")
+	}
+	return nil
+}
+
+func (m MsgClaimReceiptRewards) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgClaimReceiptRewards) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
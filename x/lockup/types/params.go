@@ -0,0 +1,119 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	epochstypes "github.com/osmosis-labs/osmosis/v7/x/epochs/types"
+)
+
+// Parameter store keys.
+var (
+	KeyForceUnlockAllowedAddresses           = []byte("ForceUnlockAllowedAddresses")
+	KeyAccumulationCheckpointEpochIdentifier = []byte("AccumulationCheckpointEpochIdentifier")
+	KeyAccumulationCheckpointKeepPeriod      = []byte("AccumulationCheckpointKeepPeriod")
+	KeyContractCallbackGasLimit              = []byte("ContractCallbackGasLimit")
+	defaultCheckpointEpochIdentifier         = "day"
+	// 90 days, so that gauges can be sized on a quarter of history.
+	defaultCheckpointKeepPeriod = 90 * 24 * time.Hour
+	// enough for a contract to update its own state, but not to run away with the gas of an EndBlock.
+	defaultContractCallbackGasLimit uint64 = 400_000
+)
+
+// ParamKeyTable for lockup module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(forceUnlockAllowedAddresses []string, checkpointEpochIdentifier string, checkpointKeepPeriod time.Duration, contractCallbackGasLimit uint64) Params {
+	return Params{
+		ForceUnlockAllowedAddresses:           forceUnlockAllowedAddresses,
+		AccumulationCheckpointEpochIdentifier: checkpointEpochIdentifier,
+		AccumulationCheckpointKeepPeriod:      checkpointKeepPeriod,
+		ContractCallbackGasLimit:              contractCallbackGasLimit,
+	}
+}
+
+// DefaultParams returns default lockup module parameters.
+func DefaultParams() Params {
+	return Params{
+		ForceUnlockAllowedAddresses:           []string{},
+		AccumulationCheckpointEpochIdentifier: defaultCheckpointEpochIdentifier,
+		AccumulationCheckpointKeepPeriod:      defaultCheckpointKeepPeriod,
+		ContractCallbackGasLimit:              defaultContractCallbackGasLimit,
+	}
+}
+
+// validate params.
+func (p Params) Validate() error {
+	if err := validateAddresses(p.ForceUnlockAllowedAddresses); err != nil {
+		return err
+	}
+	if err := epochstypes.ValidateEpochIdentifierInterface(p.AccumulationCheckpointEpochIdentifier); err != nil {
+		return err
+	}
+	if err := validateCheckpointKeepPeriod(p.AccumulationCheckpointKeepPeriod); err != nil {
+		return err
+	}
+	return validateContractCallbackGasLimit(p.ContractCallbackGasLimit)
+}
+
+// IsForceUnlockAllowed returns whether addr may force-unlock its own locks.
+func (p Params) IsForceUnlockAllowed(addr string) bool {
+	for _, allowed := range p.ForceUnlockAllowedAddresses {
+		if allowed == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// Implements params.ParamSet.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyForceUnlockAllowedAddresses, &p.ForceUnlockAllowedAddresses, validateAddresses),
+		paramtypes.NewParamSetPair(KeyAccumulationCheckpointEpochIdentifier, &p.AccumulationCheckpointEpochIdentifier, epochstypes.ValidateEpochIdentifierInterface),
+		paramtypes.NewParamSetPair(KeyAccumulationCheckpointKeepPeriod, &p.AccumulationCheckpointKeepPeriod, validateCheckpointKeepPeriod),
+		paramtypes.NewParamSetPair(KeyContractCallbackGasLimit, &p.ContractCallbackGasLimit, validateContractCallbackGasLimit),
+	}
+}
+
+func validateAddresses(i interface{}) error {
+	addresses, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for _, address := range addresses {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateCheckpointKeepPeriod(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return errors.New("accumulation checkpoint keep period must be positive")
+	}
+
+	return nil
+}
+
+func validateContractCallbackGasLimit(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
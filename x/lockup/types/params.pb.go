@@ -0,0 +1,484 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/lockup/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params holds parameters for the lockup module
+type Params struct {
+	// addresses that may force-unlock their own locks with MsgForceUnlock
+	ForceUnlockAllowedAddresses []string `protobuf:"bytes,1,rep,name=force_unlock_allowed_addresses,json=forceUnlockAllowedAddresses,proto3" json:"force_unlock_allowed_addresses,omitempty" yaml:"force_unlock_allowed_addresses"`
+	// epoch at the end of which the lock accumulation store is checkpointed
+	AccumulationCheckpointEpochIdentifier string `protobuf:"bytes,2,opt,name=accumulation_checkpoint_epoch_identifier,json=accumulationCheckpointEpochIdentifier,proto3" json:"accumulation_checkpoint_epoch_identifier,omitempty" yaml:"accumulation_checkpoint_epoch_identifier"`
+	// how long accumulation checkpoints are kept for. Historical locked amounts
+	// can only be queried within this period.
+	AccumulationCheckpointKeepPeriod time.Duration `protobuf:"bytes,3,opt,name=accumulation_checkpoint_keep_period,json=accumulationCheckpointKeepPeriod,proto3,stdduration" json:"accumulation_checkpoint_keep_period" yaml:"accumulation_checkpoint_keep_period"`
+	// gas limit of each lockup hook sudo call to the callback contract of a
+	// lock owner
+	ContractCallbackGasLimit uint64 `protobuf:"varint,4,opt,name=contract_callback_gas_limit,json=contractCallbackGasLimit,proto3" json:"contract_callback_gas_limit,omitempty" yaml:"contract_callback_gas_limit"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4595e58f5e17053c, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetForceUnlockAllowedAddresses() []string {
+	if m != nil {
+		return m.ForceUnlockAllowedAddresses
+	}
+	return nil
+}
+
+func (m *Params) GetAccumulationCheckpointEpochIdentifier() string {
+	if m != nil {
+		return m.AccumulationCheckpointEpochIdentifier
+	}
+	return ""
+}
+
+func (m *Params) GetAccumulationCheckpointKeepPeriod() time.Duration {
+	if m != nil {
+		return m.AccumulationCheckpointKeepPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetContractCallbackGasLimit() uint64 {
+	if m != nil {
+		return m.ContractCallbackGasLimit
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "osmosis.lockup.Params")
+}
+
+func init() { proto.RegisterFile("osmosis/lockup/params.proto", fileDescriptor_4595e58f5e17053c) }
+
+var fileDescriptor_4595e58f5e17053c = []byte{
+	// 421 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0x6b, 0xd4, 0x40,
+	0x14, 0xc7, 0x77, 0xec, 0x52, 0x68, 0x04, 0x0f, 0xc1, 0x43, 0xec, 0x42, 0x12, 0x22, 0x95, 0x28,
+	0x98, 0x41, 0x0b, 0x0a, 0xde, 0x9a, 0x2a, 0x22, 0xf5, 0x50, 0x16, 0xbc, 0x78, 0x19, 0x26, 0x93,
+	0xb7, 0xd9, 0x61, 0x27, 0x79, 0x43, 0x66, 0xa2, 0xf6, 0x3b, 0x78, 0xd0, 0x9b, 0x47, 0x3f, 0x4e,
+	0x8f, 0x3d, 0x7a, 0x8a, 0xb2, 0xfb, 0x0d, 0xf6, 0x13, 0xc8, 0x26, 0x9b, 0xd2, 0x83, 0x2e, 0xbd,
+	0x65, 0xf8, 0xfd, 0xf2, 0x7f, 0x8f, 0x3f, 0xcf, 0x99, 0xa0, 0x29, 0xd1, 0x48, 0x43, 0x15, 0x8a,
+	0x45, 0xa3, 0xa9, 0xe6, 0x35, 0x2f, 0x4d, 0xa2, 0x6b, 0xb4, 0xe8, 0xde, 0xdb, 0xc2, 0xa4, 0x87,
+	0x87, 0xf7, 0x0b, 0x2c, 0xb0, 0x43, 0x74, 0xf3, 0xd5, 0x5b, 0x87, 0x7e, 0x81, 0x58, 0x28, 0xa0,
+	0xdd, 0x2b, 0x6b, 0x66, 0x34, 0x6f, 0x6a, 0x6e, 0x25, 0x56, 0x3d, 0x8f, 0xbe, 0x8f, 0x9d, 0xfd,
+	0xf3, 0x2e, 0xd6, 0xad, 0x1c, 0x7f, 0x86, 0xb5, 0x00, 0xd6, 0x54, 0x9b, 0x48, 0xc6, 0x95, 0xc2,
+	0xcf, 0x90, 0x33, 0x9e, 0xe7, 0x35, 0x18, 0x03, 0xc6, 0x23, 0xe1, 0x5e, 0x7c, 0x90, 0x3e, 0x5e,
+	0xb7, 0xc1, 0xd1, 0x05, 0x2f, 0xd5, 0xab, 0x68, 0xb7, 0x1f, 0x4d, 0x27, 0x9d, 0xf0, 0xa1, 0xe3,
+	0x27, 0x3d, 0x3e, 0x19, 0xa8, 0xfb, 0x95, 0x38, 0x31, 0x17, 0xa2, 0x29, 0x1b, 0xd5, 0x6d, 0xc4,
+	0xc4, 0x1c, 0xc4, 0x42, 0xa3, 0xac, 0x2c, 0x03, 0x8d, 0x62, 0xce, 0x64, 0x0e, 0x95, 0x95, 0x33,
+	0x09, 0xb5, 0x77, 0x27, 0x24, 0xf1, 0x41, 0x7a, 0xbc, 0x6e, 0x03, 0xda, 0x8f, 0xbe, 0xed, 0x9f,
+	0xd1, 0xf4, 0xe8, 0xa6, 0x7a, 0x7a, 0x6d, 0xbe, 0xd9, 0x88, 0xef, 0xae, 0x3d, 0xf7, 0x27, 0x71,
+	0x1e, 0xfe, 0x2f, 0x74, 0x01, 0xa0, 0x99, 0x86, 0x5a, 0x62, 0xee, 0xed, 0x85, 0x24, 0xbe, 0xfb,
+	0xfc, 0x41, 0xd2, 0x17, 0x9b, 0x0c, 0xc5, 0x26, 0xaf, 0xb7, 0xc5, 0xa6, 0x2f, 0x2e, 0xdb, 0x60,
+	0xb4, 0x6e, 0x83, 0x27, 0xbb, 0x17, 0xbd, 0x91, 0x19, 0xfd, 0xf8, 0x1d, 0x90, 0x69, 0xf8, 0xef,
+	0x3d, 0xcf, 0x00, 0xf4, 0x79, 0xa7, 0xb9, 0xe0, 0x4c, 0x04, 0x56, 0xb6, 0xe6, 0xc2, 0x32, 0xc1,
+	0x95, 0xca, 0xb8, 0x58, 0xb0, 0x82, 0x1b, 0xa6, 0x64, 0x29, 0xad, 0x37, 0x0e, 0x49, 0x3c, 0x4e,
+	0x1f, 0xad, 0xdb, 0x20, 0xea, 0x47, 0xef, 0x90, 0xa3, 0xa9, 0x37, 0xd0, 0xd3, 0x2d, 0x7c, 0xcb,
+	0xcd, 0xfb, 0x0d, 0x4a, 0xcf, 0x2e, 0x97, 0x3e, 0xb9, 0x5a, 0xfa, 0xe4, 0xcf, 0xd2, 0x27, 0xdf,
+	0x56, 0xfe, 0xe8, 0x6a, 0xe5, 0x8f, 0x7e, 0xad, 0xfc, 0xd1, 0xc7, 0x67, 0x85, 0xb4, 0xf3, 0x26,
+	0x4b, 0x04, 0x96, 0x74, 0x7b, 0x7e, 0x4f, 0x15, 0xcf, 0xcc, 0xf0, 0xa0, 0x9f, 0x5e, 0xd2, 0x2f,
+	0xc3, 0xb5, 0xda, 0x0b, 0x0d, 0x26, 0xdb, 0xef, 0x0a, 0x3a, 0xfe, 0x3b, 0x00, 0x0a, 0x09, 0x6e,
+	0x0a, 0xcc, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContractCallbackGasLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ContractCallbackGasLimit))
+		i--
+		dAtA[i] = 0x20
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.AccumulationCheckpointKeepPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.AccumulationCheckpointKeepPeriod):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.AccumulationCheckpointEpochIdentifier) > 0 {
+		i -= len(m.AccumulationCheckpointEpochIdentifier)
+		copy(dAtA[i:], m.AccumulationCheckpointEpochIdentifier)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.AccumulationCheckpointEpochIdentifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ForceUnlockAllowedAddresses) > 0 {
+		for iNdEx := len(m.ForceUnlockAllowedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ForceUnlockAllowedAddresses[iNdEx])
+			copy(dAtA[i:], m.ForceUnlockAllowedAddresses[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.ForceUnlockAllowedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ForceUnlockAllowedAddresses) > 0 {
+		for _, s := range m.ForceUnlockAllowedAddresses {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = len(m.AccumulationCheckpointEpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.AccumulationCheckpointKeepPeriod)
+	n += 1 + l + sovParams(uint64(l))
+	if m.ContractCallbackGasLimit != 0 {
+		n += 1 + sovParams(uint64(m.ContractCallbackGasLimit))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceUnlockAllowedAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForceUnlockAllowedAddresses = append(m.ForceUnlockAllowedAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccumulationCheckpointEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccumulationCheckpointEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccumulationCheckpointKeepPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.AccumulationCheckpointKeepPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractCallbackGasLimit", wireType)
+			}
+			m.ContractCallbackGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractCallbackGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
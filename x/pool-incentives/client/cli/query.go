@@ -27,6 +27,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(
 		GetCmdGaugeIds(),
 		GetCmdDistrInfo(),
+		GetCmdVolumeDistrInfo(),
 		GetCmdParams(),
 		GetCmdLockableDurations(),
 		GetCmdIncentivizedPools(),
@@ -115,6 +116,42 @@ $ %s query pool-incentives distr-info
 	return cmd
 }
 
+// GetCmdVolumeDistrInfo returns the gauge ids and weights the volume weighted share is allocated by at the next epoch.
+func GetCmdVolumeDistrInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "volume-distr-info",
+		Short: "Query the volume weighted distribution info of the next epoch",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the distribution info that the volume weighted share of the pool incentives is allocated by at the next epoch.
+
+Example:
+$ %s query pool-incentives volume-distr-info
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.VolumeDistrInfo(cmd.Context(), &types.QueryVolumeDistrInfoRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdParams returns module params.
 func GetCmdParams() *cobra.Command {
 	cmd := &cobra.Command{
@@ -18,14 +18,28 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState *types.GenesisState)
 	} else {
 		k.SetDistrInfo(ctx, *genState.DistrInfo)
 	}
+	for _, poolVolume := range genState.PoolVolumes {
+		k.SetPoolVolume(ctx, poolVolume)
+	}
+	if genState.VolumeDistrInfo == nil {
+		k.SetVolumeDistrInfo(ctx, types.DistrInfo{
+			TotalWeight: sdk.NewInt(0),
+			Records:     nil,
+		})
+	} else {
+		k.SetVolumeDistrInfo(ctx, *genState.VolumeDistrInfo)
+	}
 }
 
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	distrInfo := k.GetDistrInfo(ctx)
+	volumeDistrInfo := k.GetVolumeDistrInfo(ctx)
 
 	return &types.GenesisState{
 		Params:            k.GetParams(ctx),
 		LockableDurations: k.GetLockableDurations(ctx),
 		DistrInfo:         &distrInfo,
+		PoolVolumes:       k.GetAllPoolVolumes(ctx),
+		VolumeDistrInfo:   &volumeDistrInfo,
 	}
 }
@@ -18,7 +18,9 @@ var (
 	now         = time.Now().UTC()
 	testGenesis = types.GenesisState{
 		Params: types.Params{
-			MintedDenom: "uosmo",
+			MintedDenom:         "uosmo",
+			VolumeWeightedShare: sdk.NewDecWithPrec(5, 1),
+			VolumeWindowEpochs:  7,
 		},
 		LockableDurations: []time.Duration{
 			time.Second,
@@ -34,6 +36,21 @@ var (
 				},
 			},
 		},
+		PoolVolumes: []types.PoolVolume{
+			{
+				PoolId:       1,
+				EpochVolumes: []sdk.Int{sdk.NewInt(100), sdk.NewInt(50)},
+			},
+		},
+		VolumeDistrInfo: &types.DistrInfo{
+			TotalWeight: sdk.NewInt(100),
+			Records: []types.DistrRecord{
+				{
+					GaugeId: 1,
+					Weight:  sdk.NewInt(100),
+				},
+			},
+		},
 	}
 )
 
@@ -75,6 +92,12 @@ func TestInitGenesis(t *testing.T) {
 
 	distrInfo := app.PoolIncentivesKeeper.GetDistrInfo(ctx)
 	require.Equal(t, distrInfo, *genesis.DistrInfo)
+
+	poolVolumes := app.PoolIncentivesKeeper.GetAllPoolVolumes(ctx)
+	require.Equal(t, poolVolumes, genesis.PoolVolumes)
+
+	volumeDistrInfo := app.PoolIncentivesKeeper.GetVolumeDistrInfo(ctx)
+	require.Equal(t, volumeDistrInfo, *genesis.VolumeDistrInfo)
 }
 
 func TestExportGenesis(t *testing.T) {
@@ -98,4 +121,6 @@ func TestExportGenesis(t *testing.T) {
 	require.Equal(t, genesisExported.Params, genesis.Params)
 	require.Equal(t, genesisExported.LockableDurations, durations)
 	require.Equal(t, genesisExported.DistrInfo, genesis.DistrInfo)
+	require.Equal(t, genesisExported.PoolVolumes, genesis.PoolVolumes)
+	require.Equal(t, genesisExported.VolumeDistrInfo, genesis.VolumeDistrInfo)
 }
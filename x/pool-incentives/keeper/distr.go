@@ -23,8 +23,8 @@ func (k Keeper) FundCommunityPoolFromModule(ctx sdk.Context, asset sdk.Coin) err
 }
 
 // AllocateAsset allocates and distributes coin according a gauge’s proportional weight that is recorded in the record.
+// The volume weighted share of the coin is allocated by the records of the volume distr info instead.
 func (k Keeper) AllocateAsset(ctx sdk.Context) error {
-	logger := k.Logger(ctx)
 	params := k.GetParams(ctx)
 	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
 	asset := k.bankKeeper.GetBalance(ctx, moduleAddr, params.MintedDenom)
@@ -33,6 +33,19 @@ func (k Keeper) AllocateAsset(ctx sdk.Context) error {
 		return nil
 	}
 
+	volumeDistrInfo := k.GetVolumeDistrInfo(ctx)
+	if params.VolumeWeightedShare.IsPositive() && volumeDistrInfo.TotalWeight.IsPositive() {
+		volumeAmount := asset.Amount.ToDec().Mul(params.VolumeWeightedShare).TruncateInt()
+		if err := k.allocateToRecords(ctx, sdk.NewCoin(asset.Denom, volumeAmount), volumeDistrInfo); err != nil {
+			return err
+		}
+
+		asset.Amount = asset.Amount.Sub(volumeAmount)
+		if asset.Amount.IsZero() {
+			return nil
+		}
+	}
+
 	distrInfo := k.GetDistrInfo(ctx)
 
 	if distrInfo.TotalWeight.IsZero() {
@@ -40,6 +53,12 @@ func (k Keeper) AllocateAsset(ctx sdk.Context) error {
 		return k.FundCommunityPoolFromModule(ctx, asset)
 	}
 
+	return k.allocateToRecords(ctx, asset, distrInfo)
+}
+
+// allocateToRecords splits asset between the records of distrInfo by their weight.
+func (k Keeper) allocateToRecords(ctx sdk.Context, asset sdk.Coin, distrInfo types.DistrInfo) error {
+	logger := k.Logger(ctx)
 	assetAmountDec := asset.Amount.ToDec()
 	totalWeightDec := distrInfo.TotalWeight.ToDec()
 	for _, record := range distrInfo.Records {
@@ -147,6 +166,9 @@ func (k Keeper) ReplaceDistrRecords(ctx sdk.Context, records ...types.DistrRecor
 	distrInfo.TotalWeight = totalWeight
 
 	k.SetDistrInfo(ctx, distrInfo)
+
+	// reweight the new records by the pools' volume
+	k.SetVolumeDistrInfo(ctx, k.computeVolumeDistrInfo(ctx))
 	return nil
 }
 
@@ -192,5 +214,8 @@ func (k Keeper) UpdateDistrRecords(ctx sdk.Context, records ...types.DistrRecord
 		Records:     newRecords,
 		TotalWeight: totalWeight,
 	})
+
+	// reweight the new records by the pools' volume
+	k.SetVolumeDistrInfo(ctx, k.computeVolumeDistrInfo(ctx))
 	return nil
 }
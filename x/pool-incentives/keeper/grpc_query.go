@@ -54,6 +54,13 @@ func (q Querier) DistrInfo(ctx context.Context, _ *types.QueryDistrInfoRequest)
 	return &types.QueryDistrInfoResponse{DistrInfo: q.Keeper.GetDistrInfo(sdkCtx)}, nil
 }
 
+// VolumeDistrInfo returns the records that the volume weighted share of the pool incentives
+// is allocated by at the next epoch.
+func (q Querier) VolumeDistrInfo(ctx context.Context, _ *types.QueryVolumeDistrInfoRequest) (*types.QueryVolumeDistrInfoResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return &types.QueryVolumeDistrInfoResponse{DistrInfo: q.Keeper.GetVolumeDistrInfo(sdkCtx)}, nil
+}
+
 func (q Querier) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	return &types.QueryParamsResponse{Params: q.Keeper.GetParams(sdkCtx)}, nil
@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	gammtypes "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v7/x/swaprouter/types"
 )
 
 type Hooks struct {
@@ -31,10 +32,26 @@ func (h Hooks) AfterJoinPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint
 func (h Hooks) AfterExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, shareInAmount sdk.Int, exitCoins sdk.Coins) {
 }
 
-// AfterSwap hook is a noop.
+// AfterSwap hook is a noop, swaps are tracked by the swap router hooks so
+// that swaps in pools of every type are.
 func (h Hooks) AfterSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
 }
 
+// SwapRouterHooks wrapper struct for the swap router hooks of the pool incentives keeper.
+type SwapRouterHooks struct {
+	k Keeper
+}
+
+var _ swaproutertypes.SwapRouterHooks = SwapRouterHooks{}
+
+// Create new pool incentives swap router hooks.
+func (k Keeper) SwapRouterHooks() SwapRouterHooks { return SwapRouterHooks{k} }
+
+// AfterSwap adds the swap to the pool's volume.
+func (h SwapRouterHooks) AfterSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins, swapFee sdk.Dec) {
+	h.k.TrackSwapVolume(ctx, poolId, input, output, swapFee)
+}
+
 // Distribute coins after minter module allocate assets to pool-incentives module.
 func (h Hooks) AfterDistributeMintedCoin(ctx sdk.Context, mintedCoin sdk.Coin) {
 	// @Sunny, @Tony, @Dev, what comments should we keep after modifying own BeginBlocker to hooks?
@@ -55,4 +72,8 @@ func (h Hooks) AfterDistributeMintedCoin(ctx sdk.Context, mintedCoin sdk.Coin) {
 	if err != nil {
 		panic(err)
 	}
+
+	// The epoch's volume weights have been used, so compute the weights of the next epoch
+	// from the pools' volume up to now.
+	h.k.UpdateVolumeDistrInfo(ctx)
 }
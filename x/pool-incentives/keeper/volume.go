@@ -0,0 +1,232 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/osmosis-labs/osmosis/v7/x/pool-incentives/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k Keeper) GetVolumeDistrInfo(ctx sdk.Context) types.DistrInfo {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.VolumeDistrInfoKey)
+	if len(bz) == 0 {
+		return types.DistrInfo{TotalWeight: sdk.ZeroInt()}
+	}
+
+	distrInfo := types.DistrInfo{}
+	k.cdc.MustUnmarshal(bz, &distrInfo)
+
+	return distrInfo
+}
+
+func (k Keeper) SetVolumeDistrInfo(ctx sdk.Context, distrInfo types.DistrInfo) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&distrInfo)
+	store.Set(types.VolumeDistrInfoKey, bz)
+}
+
+// GetPoolVolume returns the swap volume of a pool, with no epoch volumes if the
+// pool has not been swapped in over the volume window.
+func (k Keeper) GetPoolVolume(ctx sdk.Context, poolId uint64) types.PoolVolume {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPoolVolumeStoreKey(poolId))
+	poolVolume := types.PoolVolume{PoolId: poolId}
+	if len(bz) == 0 {
+		return poolVolume
+	}
+
+	k.cdc.MustUnmarshal(bz, &poolVolume)
+
+	return poolVolume
+}
+
+func (k Keeper) SetPoolVolume(ctx sdk.Context, poolVolume types.PoolVolume) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&poolVolume)
+	store.Set(types.GetPoolVolumeStoreKey(poolVolume.PoolId), bz)
+}
+
+func (k Keeper) deletePoolVolume(ctx sdk.Context, poolId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPoolVolumeStoreKey(poolId))
+}
+
+func (k Keeper) GetAllPoolVolumes(ctx sdk.Context) []types.PoolVolume {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PoolVolumePrefix)
+	defer iterator.Close()
+
+	poolVolumes := []types.PoolVolume{}
+	for ; iterator.Valid(); iterator.Next() {
+		poolVolume := types.PoolVolume{}
+		k.cdc.MustUnmarshal(iterator.Value(), &poolVolume)
+		poolVolumes = append(poolVolumes, poolVolume)
+	}
+
+	return poolVolumes
+}
+
+// TrackSwapVolume adds the value of a swap to the current epoch volume of the pool, or
+// the value of the swap fee paid on it with the VolumeWeightBySwapFee param.
+// Swaps are not tracked while volume weighting is off.
+func (k Keeper) TrackSwapVolume(ctx sdk.Context, poolId uint64, input sdk.Coins, output sdk.Coins, swapFee sdk.Dec) {
+	params := k.GetParams(ctx)
+	if !params.VolumeWeightedShare.IsPositive() {
+		return
+	}
+
+	coins := append(append(sdk.Coins{}, input...), output...)
+	volume, ok := k.valueInMintedDenom(ctx, params.MintedDenom, coins)
+	if !ok {
+		return
+	}
+	if params.VolumeWeightBySwapFee {
+		volume = swapFee.MulInt(volume).TruncateInt()
+	}
+	if !volume.IsPositive() {
+		return
+	}
+
+	poolVolume := k.GetPoolVolume(ctx, poolId)
+	if len(poolVolume.EpochVolumes) == 0 {
+		poolVolume.EpochVolumes = []sdk.Int{sdk.ZeroInt()}
+	}
+	current := len(poolVolume.EpochVolumes) - 1
+	poolVolume.EpochVolumes[current] = poolVolume.EpochVolumes[current].Add(volume)
+	k.SetPoolVolume(ctx, poolVolume)
+}
+
+// valueInMintedDenom values a swap by the first of its coins that is in the minted denom,
+// or else by the first that the txfees module can convert to the minted denom.
+func (k Keeper) valueInMintedDenom(ctx sdk.Context, mintedDenom string, coins sdk.Coins) (sdk.Int, bool) {
+	for _, coin := range coins {
+		if coin.Denom == mintedDenom {
+			return coin.Amount, true
+		}
+	}
+
+	for _, coin := range coins {
+		value, err := k.txfeesKeeper.ConvertToBaseToken(ctx, coin)
+		if err == nil && value.Denom == mintedDenom {
+			return value.Amount, true
+		}
+	}
+
+	return sdk.Int{}, false
+}
+
+// UpdateVolumeDistrInfo ends the current epoch of the pool volumes, and computes from them
+// the volume distr info that the next epoch's pool incentives are allocated by.
+func (k Keeper) UpdateVolumeDistrInfo(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if !params.VolumeWeightedShare.IsPositive() {
+		// drop the volumes tracked before volume weighting was turned off
+		for _, poolVolume := range k.GetAllPoolVolumes(ctx) {
+			k.deletePoolVolume(ctx, poolVolume.PoolId)
+		}
+		k.SetVolumeDistrInfo(ctx, types.DistrInfo{TotalWeight: sdk.ZeroInt()})
+		return
+	}
+
+	k.rollPoolVolumes(ctx, params.VolumeWindowEpochs)
+	k.SetVolumeDistrInfo(ctx, k.computeVolumeDistrInfo(ctx))
+}
+
+// rollPoolVolumes starts a new epoch volume for every pool, keeping the volumes of the
+// last windowEpochs epochs. Pools with no volume left in the window are deleted.
+func (k Keeper) rollPoolVolumes(ctx sdk.Context, windowEpochs uint64) {
+	for _, poolVolume := range k.GetAllPoolVolumes(ctx) {
+		volumes := append(poolVolume.EpochVolumes, sdk.ZeroInt())
+		if uint64(len(volumes)) > windowEpochs+1 {
+			volumes = volumes[uint64(len(volumes))-windowEpochs-1:]
+		}
+
+		if trailingVolume(volumes).IsZero() {
+			k.deletePoolVolume(ctx, poolVolume.PoolId)
+			continue
+		}
+
+		poolVolume.EpochVolumes = volumes
+		k.SetPoolVolume(ctx, poolVolume)
+	}
+}
+
+// trailingVolume returns the volume of the finished epochs, excluding the current epoch.
+func trailingVolume(epochVolumes []sdk.Int) sdk.Int {
+	volume := sdk.ZeroInt()
+	for i := 0; i < len(epochVolumes)-1; i++ {
+		volume = volume.Add(epochVolumes[i])
+	}
+	return volume
+}
+
+// computeVolumeDistrInfo weights the gauges of the distr records by the trailing volume
+// of their pool. The volume of a pool is split between its gauges in proportion to their
+// distr record weights. Records paying to the community pool are not volume weighted.
+func (k Keeper) computeVolumeDistrInfo(ctx sdk.Context) types.DistrInfo {
+	volumes := make(map[uint64]sdk.Int)
+	for _, poolVolume := range k.GetAllPoolVolumes(ctx) {
+		volume := trailingVolume(poolVolume.EpochVolumes)
+		if volume.IsPositive() {
+			volumes[poolVolume.PoolId] = volume
+		}
+	}
+
+	distrInfo := k.GetDistrInfo(ctx)
+	lockableDurations := k.GetLockableDurations(ctx)
+
+	poolIds := make([]uint64, len(distrInfo.Records))
+	poolWeights := make(map[uint64]sdk.Int)
+	for i, record := range distrInfo.Records {
+		if record.GaugeId == 0 || !record.Weight.IsPositive() {
+			continue
+		}
+
+		poolId, found := k.getPoolIdFromGaugeId(ctx, record.GaugeId, lockableDurations)
+		if !found {
+			continue
+		}
+
+		poolIds[i] = poolId
+		if weight, ok := poolWeights[poolId]; ok {
+			poolWeights[poolId] = weight.Add(record.Weight)
+		} else {
+			poolWeights[poolId] = record.Weight
+		}
+	}
+
+	records := []types.DistrRecord{}
+	totalWeight := sdk.ZeroInt()
+	for i, record := range distrInfo.Records {
+		volume, ok := volumes[poolIds[i]]
+		if poolIds[i] == 0 || !ok {
+			continue
+		}
+
+		weight := volume.Mul(record.Weight).Quo(poolWeights[poolIds[i]])
+		if !weight.IsPositive() {
+			continue
+		}
+
+		records = append(records, types.DistrRecord{GaugeId: record.GaugeId, Weight: weight})
+		totalWeight = totalWeight.Add(weight)
+	}
+
+	return types.DistrInfo{
+		TotalWeight: totalWeight,
+		Records:     records,
+	}
+}
+
+// getPoolIdFromGaugeId returns the pool of a gauge created for one of the lockable durations.
+func (k Keeper) getPoolIdFromGaugeId(ctx sdk.Context, gaugeId uint64, lockableDurations []time.Duration) (uint64, bool) {
+	for _, lockableDuration := range lockableDurations {
+		poolId, err := k.GetPoolIdFromGaugeId(ctx, gaugeId, lockableDuration)
+		if err == nil {
+			return poolId, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,203 @@
+package keeper_test
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/balancer"
+	minttypes "github.com/osmosis-labs/osmosis/v7/x/mint/types"
+	"github.com/osmosis-labs/osmosis/v7/x/pool-incentives/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v7/x/swaprouter/types"
+)
+
+// prepareMintedDenomPool creates a pool of the minted denom and denom, with swapFee.
+func (suite *KeeperTestSuite) prepareMintedDenomPool(denom string, swapFee sdk.Dec) uint64 {
+	mintedDenom := suite.app.PoolIncentivesKeeper.GetParams(suite.ctx).MintedDenom
+	err := simapp.FundAccount(suite.app.BankKeeper, suite.ctx, acc1, sdk.NewCoins(
+		sdk.NewCoin("uosmo", sdk.NewInt(10000000000)),
+		sdk.NewCoin(mintedDenom, sdk.NewInt(10000000)),
+		sdk.NewCoin(denom, sdk.NewInt(10000000)),
+	))
+	suite.Require().NoError(err)
+
+	poolAssets := []balancer.PoolAsset{
+		{
+			Weight: sdk.NewInt(100),
+			Token:  sdk.NewCoin(mintedDenom, sdk.NewInt(5000000)),
+		},
+		{
+			Weight: sdk.NewInt(100),
+			Token:  sdk.NewCoin(denom, sdk.NewInt(5000000)),
+		},
+	}
+	msg := balancer.NewMsgCreateBalancerPool(acc1, balancer.PoolParams{
+		SwapFee: swapFee,
+		ExitFee: sdk.NewDec(0),
+	}, poolAssets, "")
+	poolId, err := suite.app.GAMMKeeper.CreatePool(suite.ctx, msg)
+	suite.Require().NoError(err)
+	return poolId
+}
+
+func (suite *KeeperTestSuite) swap(poolId uint64, tokenIn sdk.Coin, tokenOutDenom string) sdk.Int {
+	routes := []swaproutertypes.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: tokenOutDenom}}
+	tokenOutAmount, err := suite.app.SwapRouterKeeper.RouteExactAmountIn(suite.ctx, acc1, routes, tokenIn, sdk.OneInt())
+	suite.Require().NoError(err)
+	return tokenOutAmount
+}
+
+func (suite *KeeperTestSuite) TestVolumeWeightedAllocation() {
+	keeper := suite.app.PoolIncentivesKeeper
+	mintKeeper := suite.app.MintKeeper
+	mintParams := mintKeeper.GetParams(suite.ctx)
+	mintParams.WeightedDeveloperRewardsReceivers = []minttypes.WeightedAddress{
+		{
+			Address: sdk.AccAddress([]byte("addr1---------------")).String(),
+			Weight:  sdk.NewDec(1),
+		},
+	}
+	mintKeeper.SetParams(suite.ctx, mintParams)
+
+	params := keeper.GetParams(suite.ctx)
+	params.VolumeWeightedShare = sdk.NewDecWithPrec(5, 1)
+	params.VolumeWindowEpochs = 2
+	keeper.SetParams(suite.ctx, params)
+
+	swapFee := sdk.NewDecWithPrec(1, 2)
+	fooPoolId := suite.prepareMintedDenomPool("foo", swapFee)
+	barPoolId := suite.prepareMintedDenomPool("bar", swapFee)
+	noFeePoolId := suite.prepareMintedDenomPool("qux", sdk.ZeroDec())
+	unincentivizedPoolId := suite.prepareMintedDenomPool("baz", swapFee)
+
+	lockableDurations := keeper.GetLockableDurations(suite.ctx)
+	fooGaugeId, err := keeper.GetPoolGaugeId(suite.ctx, fooPoolId, lockableDurations[0])
+	suite.Require().NoError(err)
+	barGaugeId, err := keeper.GetPoolGaugeId(suite.ctx, barPoolId, lockableDurations[0])
+	suite.Require().NoError(err)
+	noFeeGaugeId, err := keeper.GetPoolGaugeId(suite.ctx, noFeePoolId, lockableDurations[0])
+	suite.Require().NoError(err)
+
+	err = keeper.ReplaceDistrRecords(suite.ctx, types.DistrRecord{
+		GaugeId: fooGaugeId,
+		Weight:  sdk.NewInt(100),
+	}, types.DistrRecord{
+		GaugeId: barGaugeId,
+		Weight:  sdk.NewInt(100),
+	}, types.DistrRecord{
+		GaugeId: noFeeGaugeId,
+		Weight:  sdk.NewInt(50),
+	})
+	suite.Require().NoError(err)
+
+	// Swaps are valued by their minted denom side, whether it is swapped in or out, in pools with or without a swap fee.
+	suite.swap(fooPoolId, sdk.NewInt64Coin(params.MintedDenom, 1000), "foo")
+	suite.swap(barPoolId, sdk.NewInt64Coin(params.MintedDenom, 1000), "bar")
+	barOut := suite.swap(barPoolId, sdk.NewInt64Coin("bar", 2000), params.MintedDenom)
+	suite.swap(noFeePoolId, sdk.NewInt64Coin(params.MintedDenom, 1000), "qux")
+	suite.swap(unincentivizedPoolId, sdk.NewInt64Coin(params.MintedDenom, 5000), "baz")
+
+	suite.Equal([]sdk.Int{sdk.NewInt(1000)}, keeper.GetPoolVolume(suite.ctx, fooPoolId).EpochVolumes)
+	suite.Equal([]sdk.Int{barOut.AddRaw(1000)}, keeper.GetPoolVolume(suite.ctx, barPoolId).EpochVolumes)
+	suite.Equal([]sdk.Int{sdk.NewInt(1000)}, keeper.GetPoolVolume(suite.ctx, noFeePoolId).EpochVolumes)
+
+	// The volume of the current epoch is not used until the epoch ends.
+	suite.Equal(sdk.ZeroInt(), keeper.GetVolumeDistrInfo(suite.ctx).TotalWeight)
+
+	keeper.UpdateVolumeDistrInfo(suite.ctx)
+
+	// The weights of the next epoch can be queried before they are used.
+	res, err := suite.queryClient.VolumeDistrInfo(sdk.WrapSDKContext(suite.ctx), &types.QueryVolumeDistrInfoRequest{})
+	suite.Require().NoError(err)
+	suite.Equal(types.DistrInfo{
+		TotalWeight: barOut.AddRaw(3000),
+		Records: []types.DistrRecord{
+			{GaugeId: fooGaugeId, Weight: sdk.NewInt(1000)},
+			{GaugeId: barGaugeId, Weight: barOut.AddRaw(1000)},
+			{GaugeId: noFeeGaugeId, Weight: sdk.NewInt(1000)},
+		},
+	}, res.DistrInfo)
+
+	// Half of the 30000stake of pool incentives are allocated by volume, and the other half by the distr records.
+	mintCoin := sdk.NewCoin(params.MintedDenom, sdk.NewInt(100000))
+	err = mintKeeper.MintCoins(suite.ctx, sdk.Coins{mintCoin})
+	suite.Require().NoError(err)
+	err = mintKeeper.DistributeMintedCoin(suite.ctx, mintCoin) // this calls AllocateAsset via hook
+	suite.Require().NoError(err)
+	distribution.BeginBlocker(suite.ctx, abci.RequestBeginBlock{}, *suite.app.DistrKeeper)
+
+	totalVolume := barOut.AddRaw(3000).ToDec()
+	fooVolumeAmount := sdk.NewDec(15000).Mul(sdk.NewDec(1000).Quo(totalVolume)).TruncateInt()
+	barVolumeAmount := sdk.NewDec(15000).Mul(barOut.AddRaw(1000).ToDec().Quo(totalVolume)).TruncateInt()
+
+	fooGauge, err := suite.app.IncentivesKeeper.GetGaugeByID(suite.ctx, fooGaugeId)
+	suite.Require().NoError(err)
+	suite.Equal(fooVolumeAmount.AddRaw(6000), fooGauge.Coins.AmountOf(params.MintedDenom))
+
+	barGauge, err := suite.app.IncentivesKeeper.GetGaugeByID(suite.ctx, barGaugeId)
+	suite.Require().NoError(err)
+	suite.Equal(barVolumeAmount.AddRaw(6000), barGauge.Coins.AmountOf(params.MintedDenom))
+
+	noFeeGauge, err := suite.app.IncentivesKeeper.GetGaugeByID(suite.ctx, noFeeGaugeId)
+	suite.Require().NoError(err)
+	suite.Equal(fooVolumeAmount.AddRaw(3000), noFeeGauge.Coins.AmountOf(params.MintedDenom))
+
+	// The volume stays in the window for 2 epochs.
+	suite.Equal([]sdk.Int{sdk.NewInt(1000), sdk.ZeroInt(), sdk.ZeroInt()}, keeper.GetPoolVolume(suite.ctx, fooPoolId).EpochVolumes)
+	suite.Equal(sdk.NewInt(1000), keeper.GetVolumeDistrInfo(suite.ctx).Records[0].Weight)
+
+	keeper.UpdateVolumeDistrInfo(suite.ctx)
+	suite.Empty(keeper.GetAllPoolVolumes(suite.ctx))
+	suite.Equal(sdk.ZeroInt(), keeper.GetVolumeDistrInfo(suite.ctx).TotalWeight)
+
+	// Swaps are not tracked while volume weighting is off.
+	params.VolumeWeightedShare = sdk.ZeroDec()
+	keeper.SetParams(suite.ctx, params)
+	suite.swap(fooPoolId, sdk.NewInt64Coin(params.MintedDenom, 1000), "foo")
+	suite.Empty(keeper.GetAllPoolVolumes(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestVolumeWeightBySwapFee() {
+	keeper := suite.app.PoolIncentivesKeeper
+	params := keeper.GetParams(suite.ctx)
+	params.VolumeWeightedShare = sdk.NewDecWithPrec(5, 1)
+	params.VolumeWeightBySwapFee = true
+	keeper.SetParams(suite.ctx, params)
+
+	fooPoolId := suite.prepareMintedDenomPool("foo", sdk.NewDecWithPrec(1, 2))
+	noFeePoolId := suite.prepareMintedDenomPool("qux", sdk.ZeroDec())
+
+	// Swaps are valued by the swap fees paid on them, so swaps in pools without a swap fee are not tracked.
+	suite.swap(fooPoolId, sdk.NewInt64Coin(params.MintedDenom, 100000), "foo")
+	suite.swap(noFeePoolId, sdk.NewInt64Coin(params.MintedDenom, 100000), "qux")
+	suite.Equal([]sdk.Int{sdk.NewInt(1000)}, keeper.GetPoolVolume(suite.ctx, fooPoolId).EpochVolumes)
+	suite.Empty(keeper.GetPoolVolume(suite.ctx, noFeePoolId).EpochVolumes)
+}
+
+func (suite *KeeperTestSuite) TestConcentratedSwapVolume() {
+	keeper := suite.app.PoolIncentivesKeeper
+	params := keeper.GetParams(suite.ctx)
+	params.VolumeWeightedShare = sdk.NewDecWithPrec(5, 1)
+	keeper.SetParams(suite.ctx, params)
+
+	err := simapp.FundAccount(suite.app.BankKeeper, suite.ctx, acc1, sdk.NewCoins(
+		sdk.NewCoin("uosmo", sdk.NewInt(10000000000)),
+		sdk.NewCoin(params.MintedDenom, sdk.NewInt(10000000)),
+		sdk.NewCoin("foo", sdk.NewInt(10000000)),
+	))
+	suite.Require().NoError(err)
+
+	swapFee := sdk.NewDecWithPrec(1, 2)
+	clKeeper := suite.app.ConcentratedLiquidityKeeper
+	poolId, err := clKeeper.CreateConcentratedPool(suite.ctx, acc1, "foo", params.MintedDenom, 10, swapFee)
+	suite.Require().NoError(err)
+	_, _, _, _, err = clKeeper.CreatePosition(suite.ctx, acc1, poolId, -10000, 10000,
+		sdk.NewInt(1000000), sdk.NewInt(1000000), sdk.ZeroInt(), sdk.ZeroInt())
+	suite.Require().NoError(err)
+
+	// Swaps in concentrated-liquidity pools are tracked like swaps in gamm pools.
+	suite.swap(poolId, sdk.NewInt64Coin(params.MintedDenom, 100000), "foo")
+	suite.Equal([]sdk.Int{sdk.NewInt(100000)}, keeper.GetPoolVolume(suite.ctx, poolId).EpochVolumes)
+}
@@ -57,6 +57,18 @@ func ValidateGenesis(data *GenesisState) error {
 		return errors.New("distrinfo weight should not be negative")
 	}
 
+	if data.VolumeDistrInfo != nil && data.VolumeDistrInfo.TotalWeight.LT(sdk.NewInt(0)) {
+		return errors.New("volume distrinfo weight should not be negative")
+	}
+
+	poolIds := make(map[uint64]bool)
+	for _, poolVolume := range data.PoolVolumes {
+		if poolIds[poolVolume.PoolId] {
+			return fmt.Errorf("duplicate volume for pool %d", poolVolume.PoolId)
+		}
+		poolIds[poolVolume.PoolId] = true
+	}
+
 	return validateLockableDurations(data.LockableDurations)
 }
 
@@ -33,6 +33,8 @@ type GenesisState struct {
 	Params            Params          `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	LockableDurations []time.Duration `protobuf:"bytes,2,rep,name=lockable_durations,json=lockableDurations,proto3,stdduration" json:"lockable_durations" yaml:"lockable_durations"`
 	DistrInfo         *DistrInfo      `protobuf:"bytes,3,opt,name=distr_info,json=distrInfo,proto3" json:"distr_info,omitempty" yaml:"distr_info"`
+	PoolVolumes       []PoolVolume    `protobuf:"bytes,4,rep,name=pool_volumes,json=poolVolumes,proto3" json:"pool_volumes" yaml:"pool_volumes"`
+	VolumeDistrInfo   *DistrInfo      `protobuf:"bytes,5,opt,name=volume_distr_info,json=volumeDistrInfo,proto3" json:"volume_distr_info,omitempty" yaml:"volume_distr_info"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -89,6 +91,20 @@ func (m *GenesisState) GetDistrInfo() *DistrInfo {
 	return nil
 }
 
+func (m *GenesisState) GetPoolVolumes() []PoolVolume {
+	if m != nil {
+		return m.PoolVolumes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVolumeDistrInfo() *DistrInfo {
+	if m != nil {
+		return m.VolumeDistrInfo
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.poolincentives.v1beta1.GenesisState")
 }
@@ -98,29 +114,33 @@ func init() {
 }
 
 var fileDescriptor_cc1f078212600632 = []byte{
-	// 351 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xcf, 0x4e, 0xfa, 0x40,
-	0x10, 0xc7, 0xbb, 0xf0, 0x0b, 0xc9, 0xaf, 0x78, 0xa1, 0xf1, 0x00, 0x1c, 0xb6, 0xa4, 0x89, 0x06,
-	0x0f, 0xec, 0x0a, 0x1e, 0x4c, 0x38, 0x12, 0x12, 0xe3, 0xcd, 0xa0, 0x27, 0x2f, 0x64, 0x5b, 0x96,
-	0xba, 0xb1, 0xed, 0x34, 0xdd, 0x85, 0xc8, 0x5b, 0x78, 0xf4, 0x19, 0x7c, 0x12, 0x8e, 0x1c, 0x3d,
-	0x55, 0x43, 0xdf, 0x80, 0x27, 0x30, 0xfd, 0x27, 0x26, 0x24, 0x72, 0xeb, 0x64, 0x3e, 0xdf, 0x99,
-	0xcf, 0x74, 0xf5, 0x1e, 0x48, 0x1f, 0xa4, 0x90, 0x34, 0x04, 0xf0, 0x7a, 0x22, 0x70, 0x78, 0xa0,
-	0xc4, 0x92, 0x4b, 0xba, 0xec, 0xdb, 0x5c, 0xb1, 0x3e, 0x75, 0x79, 0xc0, 0xa5, 0x90, 0x24, 0x8c,
-	0x40, 0x81, 0x81, 0x0b, 0x9c, 0xa4, 0xf8, 0x9e, 0x26, 0x05, 0xdd, 0x3e, 0x75, 0xc1, 0x85, 0x0c,
-	0xa5, 0xe9, 0x57, 0x9e, 0x6a, 0x63, 0x17, 0xc0, 0xf5, 0x38, 0xcd, 0x2a, 0x7b, 0x31, 0xa7, 0xb3,
-	0x45, 0xc4, 0x94, 0x80, 0xa0, 0xe8, 0x5f, 0x1e, 0x93, 0xf8, 0xb5, 0x29, 0x4b, 0x58, 0xef, 0x15,
-	0xfd, 0xe4, 0x26, 0x37, 0xbb, 0x57, 0x4c, 0x71, 0x63, 0xac, 0xd7, 0x42, 0x16, 0x31, 0x5f, 0x36,
-	0x51, 0x07, 0x75, 0xeb, 0x83, 0x73, 0xf2, 0xb7, 0x29, 0xb9, 0xcb, 0xe8, 0xd1, 0xbf, 0x75, 0x6c,
-	0x6a, 0x93, 0x22, 0x6b, 0x80, 0x6e, 0x78, 0xe0, 0x3c, 0x33, 0xdb, 0xe3, 0xd3, 0xd2, 0x51, 0x36,
-	0x2b, 0x9d, 0x6a, 0xb7, 0x3e, 0x68, 0x91, 0xfc, 0x0a, 0x52, 0x5e, 0x41, 0xc6, 0x05, 0x31, 0x3a,
-	0x4b, 0x87, 0xec, 0x62, 0xb3, 0xb5, 0x62, 0xbe, 0x37, 0xb4, 0x0e, 0x47, 0x58, 0x6f, 0x9f, 0x26,
-	0x9a, 0x34, 0xca, 0x46, 0x19, 0x94, 0x86, 0xa3, 0xeb, 0x33, 0x21, 0x55, 0x34, 0x15, 0xc1, 0x1c,
-	0x9a, 0xd5, 0x4c, 0xfd, 0xe2, 0x98, 0xfa, 0x38, 0x4d, 0xdc, 0x06, 0x73, 0x18, 0xb5, 0xd6, 0xb1,
-	0x89, 0x76, 0xb1, 0xd9, 0xc8, 0x17, 0xef, 0x47, 0x59, 0x93, 0xff, 0xb3, 0x1f, 0xea, 0x61, 0xbd,
-	0xc5, 0x68, 0xb3, 0xc5, 0xe8, 0x6b, 0x8b, 0xd1, 0x6b, 0x82, 0xb5, 0x4d, 0x82, 0xb5, 0x8f, 0x04,
-	0x6b, 0x8f, 0x43, 0x57, 0xa8, 0xa7, 0x85, 0x4d, 0x1c, 0xf0, 0x69, 0xb1, 0xb4, 0xe7, 0x31, 0x5b,
-	0x96, 0x05, 0x5d, 0x5e, 0xd3, 0x97, 0x83, 0x57, 0x51, 0xab, 0x90, 0x4b, 0xbb, 0x96, 0xfd, 0x87,
-	0xab, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf9, 0x32, 0x7b, 0x3c, 0x42, 0x02, 0x00, 0x00,
+	// 415 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xc1, 0x8a, 0xd3, 0x40,
+	0x1c, 0xc6, 0x1b, 0xb7, 0x2e, 0x38, 0x5d, 0x90, 0x46, 0x0f, 0xe9, 0x0a, 0x93, 0x12, 0x50, 0x56,
+	0xa1, 0x33, 0xee, 0x7a, 0x10, 0xf6, 0x58, 0x0a, 0xe2, 0x4d, 0xa2, 0x78, 0xf0, 0x52, 0x26, 0xed,
+	0x34, 0x8e, 0x4e, 0xf2, 0x0f, 0x9d, 0x49, 0x74, 0x9f, 0x42, 0x8f, 0x3e, 0x52, 0x8f, 0x7b, 0xf4,
+	0x14, 0xa5, 0x7d, 0x83, 0x3e, 0x81, 0x64, 0x66, 0x62, 0x0b, 0x05, 0x8b, 0xb7, 0x4c, 0xfe, 0xbf,
+	0xef, 0xfb, 0x7f, 0x5f, 0x32, 0x68, 0x04, 0x2a, 0x03, 0x25, 0x14, 0x2d, 0x00, 0xe4, 0x48, 0xe4,
+	0x33, 0x9e, 0x6b, 0x51, 0x71, 0x45, 0xab, 0xcb, 0x84, 0x6b, 0x76, 0x49, 0x53, 0x9e, 0x73, 0x25,
+	0x14, 0x29, 0x96, 0xa0, 0xc1, 0xc7, 0x0e, 0x27, 0x0d, 0xbe, 0xa3, 0x89, 0xa3, 0xcf, 0x1f, 0xa6,
+	0x90, 0x82, 0x41, 0x69, 0xf3, 0x64, 0x55, 0xe7, 0x38, 0x05, 0x48, 0x25, 0xa7, 0xe6, 0x94, 0x94,
+	0x0b, 0x3a, 0x2f, 0x97, 0x4c, 0x0b, 0xc8, 0xdd, 0xfc, 0xf9, 0xb1, 0x10, 0x7b, 0x9b, 0x8c, 0x22,
+	0xfa, 0xd6, 0x45, 0x67, 0xaf, 0x6c, 0xb2, 0xb7, 0x9a, 0x69, 0xee, 0x4f, 0xd0, 0x69, 0xc1, 0x96,
+	0x2c, 0x53, 0x81, 0x37, 0xf4, 0x2e, 0x7a, 0x57, 0x4f, 0xc8, 0xbf, 0x93, 0x92, 0x37, 0x86, 0x1e,
+	0x77, 0x57, 0x75, 0xd8, 0x89, 0x9d, 0xd6, 0x07, 0xe4, 0x4b, 0x98, 0x7d, 0x66, 0x89, 0xe4, 0xd3,
+	0x36, 0xa3, 0x0a, 0xee, 0x0c, 0x4f, 0x2e, 0x7a, 0x57, 0x03, 0x62, 0x5b, 0x90, 0xb6, 0x05, 0x99,
+	0x38, 0x62, 0xfc, 0xb8, 0x31, 0xd9, 0xd6, 0xe1, 0xe0, 0x86, 0x65, 0xf2, 0x3a, 0x3a, 0xb4, 0x88,
+	0x7e, 0xfc, 0x0a, 0xbd, 0xb8, 0xdf, 0x0e, 0x5a, 0xa1, 0xf2, 0x67, 0x08, 0xcd, 0x85, 0xd2, 0xcb,
+	0xa9, 0xc8, 0x17, 0x10, 0x9c, 0x98, 0xe8, 0x4f, 0x8f, 0x45, 0x9f, 0x34, 0x8a, 0xd7, 0xf9, 0x02,
+	0xc6, 0x83, 0x55, 0x1d, 0x7a, 0xdb, 0x3a, 0xec, 0xdb, 0xc5, 0x3b, 0xab, 0x28, 0xbe, 0x37, 0x6f,
+	0x29, 0xff, 0x13, 0x3a, 0x6b, 0x9c, 0xa6, 0x15, 0xc8, 0x32, 0xe3, 0x2a, 0xe8, 0x9a, 0x3e, 0xcf,
+	0x8e, 0x7e, 0x21, 0x00, 0xf9, 0xde, 0x48, 0xc6, 0x8f, 0x5c, 0xc1, 0x07, 0x76, 0xcf, 0xbe, 0x5b,
+	0x14, 0xf7, 0x8a, 0xbf, 0xa0, 0xf2, 0xbf, 0xa0, 0xbe, 0x1d, 0x4c, 0xf7, 0x7a, 0xdd, 0xfd, 0xdf,
+	0x5e, 0x43, 0xd7, 0x2b, 0xb0, 0xfb, 0x0e, 0x1c, 0xa3, 0xf8, 0xbe, 0x7d, 0xb7, 0x93, 0xbc, 0x5b,
+	0xad, 0xb1, 0x77, 0xbb, 0xc6, 0xde, 0xef, 0x35, 0xf6, 0xbe, 0x6f, 0x70, 0xe7, 0x76, 0x83, 0x3b,
+	0x3f, 0x37, 0xb8, 0xf3, 0xe1, 0x3a, 0x15, 0xfa, 0x63, 0x99, 0x90, 0x19, 0x64, 0xd4, 0x25, 0x18,
+	0x49, 0x96, 0xa8, 0xf6, 0x40, 0xab, 0x97, 0xf4, 0xeb, 0xc1, 0xd5, 0xd3, 0x37, 0x05, 0x57, 0xc9,
+	0xa9, 0xf9, 0xd9, 0x2f, 0xfe, 0x0c, 0x00, 0xbe, 0x98, 0xec, 0x15, 0x27, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -143,6 +163,32 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.VolumeDistrInfo != nil {
+		{
+			size, err := m.VolumeDistrInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PoolVolumes) > 0 {
+		for iNdEx := len(m.PoolVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.DistrInfo != nil {
 		{
 			size, err := m.DistrInfo.MarshalToSizedBuffer(dAtA[:i])
@@ -209,6 +255,16 @@ func (m *GenesisState) Size() (n int) {
 		l = m.DistrInfo.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.PoolVolumes) > 0 {
+		for _, e := range m.PoolVolumes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.VolumeDistrInfo != nil {
+		l = m.VolumeDistrInfo.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -350,6 +406,76 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolVolumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolVolumes = append(m.PoolVolumes, PoolVolume{})
+			if err := m.PoolVolumes[len(m.PoolVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeDistrInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VolumeDistrInfo == nil {
+				m.VolumeDistrInfo = &DistrInfo{}
+			}
+			if err := m.VolumeDistrInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
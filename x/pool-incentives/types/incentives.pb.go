@@ -34,6 +34,17 @@ type Params struct {
 	// itself, but rather manages the distribution of coins that matches the
 	// defined minted_denom.
 	MintedDenom string `protobuf:"bytes,1,opt,name=minted_denom,json=mintedDenom,proto3" json:"minted_denom,omitempty" yaml:"minted_denom"`
+	// volume_weighted_share is the proportion of the pool incentives that is
+	// allocated to the incentivized pools by their trailing swap volume instead
+	// of by the distr records. Zero turns volume weighting off.
+	VolumeWeightedShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=volume_weighted_share,json=volumeWeightedShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"volume_weighted_share" yaml:"volume_weighted_share"`
+	// volume_window_epochs is the number of past epochs whose swap volume the
+	// volume weights are computed over.
+	VolumeWindowEpochs uint64 `protobuf:"varint,3,opt,name=volume_window_epochs,json=volumeWindowEpochs,proto3" json:"volume_window_epochs,omitempty" yaml:"volume_window_epochs"`
+	// volume_weight_by_swap_fee tracks the swap fees paid on each swap, the
+	// swap's value times the pool's swap fee, as the volume of its pool instead
+	// of the swap's value, so that pools without a swap fee get no volume.
+	VolumeWeightBySwapFee bool `protobuf:"varint,4,opt,name=volume_weight_by_swap_fee,json=volumeWeightBySwapFee,proto3" json:"volume_weight_by_swap_fee,omitempty" yaml:"volume_weight_by_swap_fee"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -75,6 +86,20 @@ func (m *Params) GetMintedDenom() string {
 	return ""
 }
 
+func (m *Params) GetVolumeWindowEpochs() uint64 {
+	if m != nil {
+		return m.VolumeWindowEpochs
+	}
+	return 0
+}
+
+func (m *Params) GetVolumeWeightBySwapFee() bool {
+	if m != nil {
+		return m.VolumeWeightBySwapFee
+	}
+	return false
+}
+
 type LockableDurationsInfo struct {
 	LockableDurations []time.Duration `protobuf:"bytes,1,rep,name=lockable_durations,json=lockableDurations,proto3,stdduration" json:"lockable_durations" yaml:"lockable_durations"`
 }
@@ -209,11 +234,59 @@ func (m *DistrRecord) GetGaugeId() uint64 {
 	return 0
 }
 
+// PoolVolume is the swap volume of a pool, valued in the minted denom, over
+// the last epochs. The last of epoch_volumes accumulates the current epoch.
+type PoolVolume struct {
+	PoolId       uint64                                   `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	EpochVolumes []github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,rep,name=epoch_volumes,json=epochVolumes,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"epoch_volumes" yaml:"epoch_volumes"`
+}
+
+func (m *PoolVolume) Reset()         { *m = PoolVolume{} }
+func (m *PoolVolume) String() string { return proto.CompactTextString(m) }
+func (*PoolVolume) ProtoMessage()    {}
+func (*PoolVolume) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8153bad03e553d1, []int{4}
+}
+func (m *PoolVolume) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolVolume) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolVolume.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolVolume) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolVolume.Merge(m, src)
+}
+func (m *PoolVolume) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolVolume) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolVolume.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolVolume proto.InternalMessageInfo
+
+func (m *PoolVolume) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.poolincentives.v1beta1.Params")
 	proto.RegisterType((*LockableDurationsInfo)(nil), "osmosis.poolincentives.v1beta1.LockableDurationsInfo")
 	proto.RegisterType((*DistrInfo)(nil), "osmosis.poolincentives.v1beta1.DistrInfo")
 	proto.RegisterType((*DistrRecord)(nil), "osmosis.poolincentives.v1beta1.DistrRecord")
+	proto.RegisterType((*PoolVolume)(nil), "osmosis.poolincentives.v1beta1.PoolVolume")
 }
 
 func init() {
@@ -221,37 +294,48 @@ func init() {
 }
 
 var fileDescriptor_a8153bad03e553d1 = []byte{
-	// 465 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x41, 0x6b, 0xd4, 0x40,
-	0x18, 0xcd, 0xd4, 0x65, 0x6b, 0x67, 0x0b, 0x62, 0xaa, 0xb8, 0xed, 0x21, 0x29, 0x01, 0xa5, 0x20,
-	0x3b, 0x63, 0xf5, 0x20, 0xe4, 0x18, 0x56, 0x61, 0xd5, 0x83, 0x04, 0x41, 0xf0, 0xb2, 0x4c, 0x92,
-	0x69, 0x76, 0x68, 0x92, 0x6f, 0xc9, 0xcc, 0xae, 0xf6, 0x1f, 0x08, 0x5e, 0x3c, 0xf6, 0xd8, 0xdf,
-	0xe2, 0xa9, 0xc7, 0x1e, 0xc5, 0x43, 0x94, 0xdd, 0x8b, 0xe7, 0xfd, 0x05, 0x92, 0xc9, 0x84, 0x06,
-	0x0b, 0x82, 0xa7, 0xcc, 0xcb, 0xcb, 0x7b, 0xdf, 0x7b, 0xf3, 0x05, 0x3f, 0x01, 0x99, 0x83, 0x14,
-	0x92, 0xce, 0x01, 0xb2, 0x91, 0x28, 0x62, 0x5e, 0x28, 0xb1, 0xe4, 0x92, 0x2e, 0x8f, 0x23, 0xae,
-	0xd8, 0x31, 0xbd, 0x7e, 0x45, 0xe6, 0x25, 0x28, 0xb0, 0x1d, 0xa3, 0x20, 0xb5, 0xa2, 0xc3, 0x1a,
-	0xc1, 0xc1, 0xbd, 0x14, 0x52, 0xd0, 0x9f, 0xd2, 0xfa, 0xd4, 0xa8, 0x0e, 0x9c, 0x14, 0x20, 0xcd,
-	0x38, 0xd5, 0x28, 0x5a, 0x9c, 0xd0, 0x64, 0x51, 0x32, 0x25, 0xa0, 0x68, 0x78, 0xef, 0x15, 0xee,
-	0xbf, 0x65, 0x25, 0xcb, 0xa5, 0xed, 0xe3, 0xdd, 0x5c, 0x14, 0x8a, 0x27, 0xd3, 0x84, 0x17, 0x90,
-	0x0f, 0xd1, 0x21, 0x3a, 0xda, 0x09, 0x1e, 0x6c, 0x2a, 0x77, 0xef, 0x8c, 0xe5, 0x99, 0xef, 0x75,
-	0x59, 0x2f, 0x1c, 0x34, 0x70, 0x5c, 0x23, 0xbf, 0x77, 0x7e, 0xe1, 0x5a, 0xde, 0x67, 0x84, 0xef,
-	0xbf, 0x81, 0xf8, 0x94, 0x45, 0x19, 0x1f, 0x9b, 0x31, 0x72, 0x52, 0x9c, 0x80, 0x0d, 0xd8, 0xce,
-	0x0c, 0x31, 0x6d, 0x03, 0xc8, 0x21, 0x3a, 0xbc, 0x75, 0x34, 0x78, 0xba, 0x4f, 0x9a, 0x88, 0xa4,
-	0x8d, 0x48, 0x5a, 0x6d, 0xf0, 0xf0, 0xb2, 0x72, 0xad, 0x4d, 0xe5, 0xee, 0x37, 0x01, 0x6e, 0x5a,
-	0x78, 0xe7, 0x3f, 0x5d, 0x14, 0xde, 0xcd, 0xfe, 0x1e, 0xea, 0x7d, 0x43, 0x78, 0x67, 0x2c, 0xa4,
-	0x2a, 0xf5, 0xf8, 0x19, 0xde, 0x55, 0xa0, 0x58, 0x36, 0xfd, 0xc8, 0x45, 0x3a, 0x53, 0xa6, 0xda,
-	0x8b, 0xda, 0xfd, 0x47, 0xe5, 0x3e, 0x4a, 0x85, 0x9a, 0x2d, 0x22, 0x12, 0x43, 0x4e, 0x63, 0x7d,
-	0xc9, 0xe6, 0x31, 0x92, 0xc9, 0x29, 0x55, 0x67, 0x73, 0x2e, 0xc9, 0xa4, 0x50, 0xd7, 0x17, 0xd1,
-	0xf5, 0xf2, 0xc2, 0x81, 0x86, 0xef, 0x35, 0xb2, 0x5f, 0xe3, 0xed, 0x92, 0xc7, 0x50, 0x26, 0x72,
-	0xb8, 0xa5, 0xdb, 0x3d, 0x26, 0xff, 0x5e, 0x1b, 0xd1, 0x29, 0x43, 0xad, 0x09, 0x7a, 0x75, 0xa2,
-	0xb0, 0x75, 0xf0, 0xbe, 0x20, 0x3c, 0xe8, 0xd0, 0x36, 0xc1, 0xb7, 0x53, 0xb6, 0x48, 0xf9, 0x54,
-	0x24, 0xba, 0x42, 0x2f, 0xd8, 0xdb, 0x54, 0xee, 0x9d, 0x26, 0x54, 0xcb, 0x78, 0xe1, 0xb6, 0x3e,
-	0x4e, 0x12, 0xfb, 0x25, 0xee, 0x9b, 0xc2, 0x5b, 0xba, 0x30, 0xf9, 0xbf, 0xc2, 0xa1, 0x51, 0xfb,
-	0xbd, 0xdf, 0x17, 0x2e, 0x0a, 0xde, 0x5d, 0xae, 0x1c, 0x74, 0xb5, 0x72, 0xd0, 0xaf, 0x95, 0x83,
-	0xbe, 0xae, 0x1d, 0xeb, 0x6a, 0xed, 0x58, 0xdf, 0xd7, 0x8e, 0xf5, 0xc1, 0xef, 0xf8, 0x99, 0xb6,
-	0xa3, 0x8c, 0x45, 0xb2, 0x05, 0x74, 0xf9, 0x9c, 0x7e, 0xba, 0xf1, 0xa3, 0xeb, 0x39, 0x51, 0x5f,
-	0x6f, 0xfd, 0xd9, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd4, 0x92, 0x5d, 0x6d, 0x10, 0x03, 0x00,
-	0x00,
+	// 649 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcf, 0x6b, 0xd4, 0x4e,
+	0x14, 0xdf, 0x69, 0x97, 0x6d, 0x3b, 0xdb, 0xef, 0x57, 0x9c, 0xb6, 0xb8, 0xad, 0x92, 0x59, 0x06,
+	0x95, 0x85, 0xd2, 0xc4, 0xea, 0x41, 0xd8, 0x63, 0xd8, 0x16, 0x16, 0x45, 0x6a, 0x2a, 0x0a, 0x1e,
+	0x0c, 0xd9, 0x64, 0x9a, 0x0d, 0x4d, 0x32, 0x4b, 0x26, 0xbb, 0xeb, 0x5e, 0x7b, 0x12, 0xbc, 0x78,
+	0xec, 0xb1, 0x27, 0xff, 0x10, 0x4f, 0x3d, 0xf6, 0x28, 0x1e, 0xa2, 0xb4, 0x17, 0xcf, 0xf9, 0x0b,
+	0x24, 0x33, 0x13, 0x9a, 0xda, 0x22, 0xf4, 0x94, 0x79, 0xbf, 0x3e, 0xef, 0xf3, 0xde, 0xfb, 0x10,
+	0xf8, 0x84, 0xf1, 0x88, 0xf1, 0x80, 0x1b, 0x23, 0xc6, 0xc2, 0xad, 0x20, 0x76, 0x69, 0x9c, 0x06,
+	0x13, 0xca, 0x8d, 0xc9, 0xf6, 0x80, 0xa6, 0xce, 0xb6, 0x71, 0xe9, 0xd2, 0x47, 0x09, 0x4b, 0x19,
+	0xd2, 0x54, 0x85, 0x5e, 0x54, 0x54, 0xa2, 0xaa, 0x60, 0x63, 0xd5, 0x67, 0x3e, 0x13, 0xa9, 0x46,
+	0xf1, 0x92, 0x55, 0x1b, 0x9a, 0xcf, 0x98, 0x1f, 0x52, 0x43, 0x58, 0x83, 0xf1, 0x81, 0xe1, 0x8d,
+	0x13, 0x27, 0x0d, 0x58, 0x2c, 0xe3, 0xe4, 0x68, 0x1e, 0x36, 0xf6, 0x9c, 0xc4, 0x89, 0x38, 0xea,
+	0xc2, 0xe5, 0x28, 0x88, 0x53, 0xea, 0xd9, 0x1e, 0x8d, 0x59, 0xd4, 0x02, 0x6d, 0xd0, 0x59, 0x32,
+	0xef, 0xe5, 0x19, 0x5e, 0x99, 0x39, 0x51, 0xd8, 0x25, 0xd5, 0x28, 0xb1, 0x9a, 0xd2, 0xec, 0x15,
+	0x16, 0x3a, 0x02, 0x70, 0x6d, 0xc2, 0xc2, 0x71, 0x44, 0xed, 0x29, 0x0d, 0xfc, 0x61, 0x91, 0xc7,
+	0x87, 0x4e, 0x42, 0x5b, 0x73, 0x02, 0xe5, 0xd5, 0x69, 0x86, 0x6b, 0x3f, 0x32, 0xfc, 0xd8, 0x0f,
+	0xd2, 0xe1, 0x78, 0xa0, 0xbb, 0x2c, 0x32, 0x5c, 0x31, 0x90, 0xfa, 0x6c, 0x71, 0xef, 0xd0, 0x48,
+	0x67, 0x23, 0xca, 0xf5, 0x1e, 0x75, 0xf3, 0x0c, 0x3f, 0x90, 0x3d, 0x6f, 0x04, 0x25, 0xd6, 0x8a,
+	0xf4, 0xbf, 0x53, 0xee, 0xfd, 0xc2, 0x8b, 0x5e, 0xc3, 0xd5, 0x32, 0x3d, 0x88, 0x3d, 0x36, 0xb5,
+	0xe9, 0x88, 0xb9, 0x43, 0xde, 0x9a, 0x6f, 0x83, 0x4e, 0xdd, 0xc4, 0x79, 0x86, 0xef, 0x5f, 0x05,
+	0xad, 0x66, 0x11, 0x0b, 0x29, 0x4c, 0xe1, 0xdd, 0x11, 0x4e, 0xf4, 0x01, 0xae, 0x5f, 0x61, 0x60,
+	0x0f, 0x66, 0x36, 0x9f, 0x3a, 0x23, 0xfb, 0x80, 0xd2, 0x56, 0xbd, 0x0d, 0x3a, 0x8b, 0xe6, 0xc3,
+	0x3c, 0xc3, 0xed, 0x1b, 0xc8, 0x56, 0x53, 0x89, 0xb5, 0x56, 0x25, 0x6c, 0xce, 0xf6, 0xa7, 0xce,
+	0x68, 0x97, 0xd2, 0x6e, 0xfd, 0xf8, 0x04, 0xd7, 0xc8, 0x27, 0x00, 0xd7, 0x5e, 0x32, 0xf7, 0xd0,
+	0x19, 0x84, 0xb4, 0xa7, 0xee, 0xc3, 0xfb, 0xf1, 0x01, 0x43, 0x0c, 0xa2, 0x50, 0x05, 0xec, 0xf2,
+	0x72, 0xbc, 0x05, 0xda, 0xf3, 0x9d, 0xe6, 0xd3, 0x75, 0x5d, 0xde, 0x56, 0x2f, 0x6f, 0xab, 0x97,
+	0xb5, 0xe6, 0xa3, 0x62, 0xdd, 0x79, 0x86, 0xd7, 0x25, 0xaf, 0xeb, 0x10, 0xe4, 0xf8, 0x27, 0x06,
+	0xd6, 0xdd, 0xf0, 0xef, 0xa6, 0xe4, 0x1b, 0x80, 0x4b, 0xbd, 0x80, 0xa7, 0x89, 0x68, 0x3f, 0x84,
+	0xcb, 0x29, 0x4b, 0x9d, 0x50, 0x8d, 0xa4, 0x24, 0xb1, 0x73, 0x8b, 0x63, 0xf6, 0xe3, 0xf4, 0x52,
+	0x40, 0x55, 0x2c, 0x62, 0x35, 0x85, 0x29, 0x37, 0x82, 0x5e, 0xc0, 0x85, 0x84, 0xba, 0x2c, 0xf1,
+	0x78, 0x6b, 0x4e, 0x4c, 0xb7, 0xa9, 0xff, 0x5b, 0xef, 0xba, 0x60, 0x69, 0x89, 0x1a, 0xb3, 0x5e,
+	0x30, 0xb2, 0x4a, 0x04, 0xf2, 0x19, 0xc0, 0x66, 0x25, 0x8c, 0x74, 0xb8, 0xe8, 0x3b, 0x63, 0x9f,
+	0xda, 0x81, 0x27, 0x46, 0xa8, 0x9b, 0x2b, 0x79, 0x86, 0xef, 0x48, 0x52, 0x65, 0x84, 0x58, 0x0b,
+	0xe2, 0xd9, 0xf7, 0xd0, 0x2e, 0x6c, 0xa8, 0x81, 0xa5, 0x7a, 0xf5, 0xdb, 0x0d, 0x6c, 0xa9, 0xea,
+	0x6e, 0xfd, 0xf7, 0x09, 0x06, 0xe4, 0x2b, 0x80, 0x70, 0x8f, 0xb1, 0xf0, 0xad, 0x50, 0x00, 0xda,
+	0x84, 0x0b, 0xc5, 0x44, 0x97, 0x5c, 0x50, 0x9e, 0xe1, 0xff, 0x25, 0x17, 0x15, 0x20, 0x56, 0xa3,
+	0x78, 0xf5, 0x3d, 0x74, 0x08, 0xff, 0x13, 0xf2, 0xb4, 0xa5, 0x7c, 0xe4, 0x72, 0x96, 0xcc, 0xdd,
+	0x5b, 0x5f, 0x60, 0x55, 0x36, 0xb8, 0x02, 0x46, 0xac, 0x65, 0x61, 0x4b, 0x62, 0xdc, 0x7c, 0x73,
+	0x7a, 0xae, 0x81, 0xb3, 0x73, 0x0d, 0xfc, 0x3a, 0xd7, 0xc0, 0x97, 0x0b, 0xad, 0x76, 0x76, 0xa1,
+	0xd5, 0xbe, 0x5f, 0x68, 0xb5, 0xf7, 0xdd, 0x4a, 0x1f, 0x75, 0x96, 0xad, 0xd0, 0x19, 0xf0, 0xd2,
+	0x30, 0x26, 0xcf, 0x8d, 0x8f, 0xd7, 0x7e, 0x65, 0xa2, 0xff, 0xa0, 0x21, 0xe4, 0xf9, 0xec, 0xcf,
+	0x00, 0xf3, 0x72, 0x31, 0xd7, 0xf2, 0x04, 0x00, 0x00,
 }
 
 func (this *DistrRecord) Equal(that interface{}) bool {
@@ -301,6 +385,31 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.VolumeWeightBySwapFee {
+		i--
+		if m.VolumeWeightBySwapFee {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.VolumeWindowEpochs != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.VolumeWindowEpochs))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.VolumeWeightedShare.Size()
+		i -= size
+		if _, err := m.VolumeWeightedShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIncentives(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.MintedDenom) > 0 {
 		i -= len(m.MintedDenom)
 		copy(dAtA[i:], m.MintedDenom)
@@ -431,6 +540,48 @@ func (m *DistrRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PoolVolume) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolVolume) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EpochVolumes) > 0 {
+		for iNdEx := len(m.EpochVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.EpochVolumes[iNdEx].Size()
+				i -= size
+				if _, err := m.EpochVolumes[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintIncentives(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintIncentives(dAtA []byte, offset int, v uint64) int {
 	offset -= sovIncentives(v)
 	base := offset
@@ -452,6 +603,14 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovIncentives(uint64(l))
 	}
+	l = m.VolumeWeightedShare.Size()
+	n += 1 + l + sovIncentives(uint64(l))
+	if m.VolumeWindowEpochs != 0 {
+		n += 1 + sovIncentives(uint64(m.VolumeWindowEpochs))
+	}
+	if m.VolumeWeightBySwapFee {
+		n += 2
+	}
 	return n
 }
 
@@ -501,6 +660,24 @@ func (m *DistrRecord) Size() (n int) {
 	return n
 }
 
+func (m *PoolVolume) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovIncentives(uint64(m.PoolId))
+	}
+	if len(m.EpochVolumes) > 0 {
+		for _, e := range m.EpochVolumes {
+			l = e.Size()
+			n += 1 + l + sovIncentives(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovIncentives(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -568,6 +745,79 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.MintedDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeWeightedShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VolumeWeightedShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeWindowEpochs", wireType)
+			}
+			m.VolumeWindowEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VolumeWindowEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeWeightBySwapFee", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.VolumeWeightBySwapFee = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIncentives(dAtA[iNdEx:])
@@ -894,6 +1144,111 @@ func (m *DistrRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PoolVolume) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncentives
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolVolume: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolVolume: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochVolumes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.EpochVolumes = append(m.EpochVolumes, v)
+			if err := m.EpochVolumes[len(m.EpochVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentives(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipIncentives(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -18,6 +18,8 @@ const (
 var (
 	LockableDurationsKey = []byte("lockable_durations")
 	DistrInfoKey         = []byte("distr_info")
+	VolumeDistrInfoKey   = []byte("volume_distr_info")
+	PoolVolumePrefix     = []byte("pool-volume/")
 )
 
 func GetPoolGaugeIdStoreKey(poolId uint64, duration time.Duration) []byte {
@@ -27,3 +29,7 @@ func GetPoolGaugeIdStoreKey(poolId uint64, duration time.Duration) []byte {
 func GetPoolIdFromGaugeIdStoreKey(gaugeId uint64, duration time.Duration) []byte {
 	return []byte(fmt.Sprintf("pool-incentives-pool-id/%d/%s", gaugeId, duration.String()))
 }
+
+func GetPoolVolumeStoreKey(poolId uint64) []byte {
+	return []byte(fmt.Sprintf("%s%d", PoolVolumePrefix, poolId))
+}
@@ -12,27 +12,44 @@ import (
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
-var KeyMintedDenom = []byte("MintedDenom")
+var (
+	KeyMintedDenom           = []byte("MintedDenom")
+	KeyVolumeWeightedShare   = []byte("VolumeWeightedShare")
+	KeyVolumeWindowEpochs    = []byte("VolumeWindowEpochs")
+	KeyVolumeWeightBySwapFee = []byte("VolumeWeightBySwapFee")
+)
 
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(mintedDenom string) Params {
+func NewParams(mintedDenom string, volumeWeightedShare sdk.Dec, volumeWindowEpochs uint64, volumeWeightBySwapFee bool) Params {
 	return Params{
-		MintedDenom: mintedDenom,
+		MintedDenom:           mintedDenom,
+		VolumeWeightedShare:   volumeWeightedShare,
+		VolumeWindowEpochs:    volumeWindowEpochs,
+		VolumeWeightBySwapFee: volumeWeightBySwapFee,
 	}
 }
 
 // DefaultParams is the default parameter configuration for the pool-incentives module.
 func DefaultParams() Params {
-	return NewParams(sdk.DefaultBondDenom)
+	return NewParams(sdk.DefaultBondDenom, sdk.ZeroDec(), 7, false)
 }
 
 func (p Params) Validate() error {
 	if err := validateMintedDenom(p.MintedDenom); err != nil {
 		return err
 	}
+	if err := validateVolumeWeightedShare(p.VolumeWeightedShare); err != nil {
+		return err
+	}
+	if err := validateVolumeWindowEpochs(p.VolumeWindowEpochs); err != nil {
+		return err
+	}
+	if err := validateVolumeWeightBySwapFee(p.VolumeWeightBySwapFee); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -58,8 +75,49 @@ func validateMintedDenom(i interface{}) error {
 	return nil
 }
 
+func validateVolumeWeightedShare(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return errors.New("volume weighted share cannot be negative")
+	}
+	if v.GT(sdk.OneDec()) {
+		return errors.New("volume weighted share cannot be greater than one")
+	}
+
+	return nil
+}
+
+func validateVolumeWindowEpochs(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return errors.New("volume window epochs must be positive")
+	}
+
+	return nil
+}
+
+func validateVolumeWeightBySwapFee(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyMintedDenom, &p.MintedDenom, validateMintedDenom),
+		paramtypes.NewParamSetPair(KeyVolumeWeightedShare, &p.VolumeWeightedShare, validateVolumeWeightedShare),
+		paramtypes.NewParamSetPair(KeyVolumeWindowEpochs, &p.VolumeWindowEpochs, validateVolumeWindowEpochs),
+		paramtypes.NewParamSetPair(KeyVolumeWeightBySwapFee, &p.VolumeWeightBySwapFee, validateVolumeWeightBySwapFee),
 	}
 }
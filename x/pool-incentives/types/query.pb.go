@@ -258,6 +258,86 @@ func (m *QueryDistrInfoResponse) GetDistrInfo() DistrInfo {
 	return DistrInfo{}
 }
 
+type QueryVolumeDistrInfoRequest struct {
+}
+
+func (m *QueryVolumeDistrInfoRequest) Reset()         { *m = QueryVolumeDistrInfoRequest{} }
+func (m *QueryVolumeDistrInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVolumeDistrInfoRequest) ProtoMessage()    {}
+func (*QueryVolumeDistrInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_302873ecccbc7636, []int{4}
+}
+func (m *QueryVolumeDistrInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVolumeDistrInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVolumeDistrInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVolumeDistrInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVolumeDistrInfoRequest.Merge(m, src)
+}
+func (m *QueryVolumeDistrInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVolumeDistrInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVolumeDistrInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVolumeDistrInfoRequest proto.InternalMessageInfo
+
+type QueryVolumeDistrInfoResponse struct {
+	DistrInfo DistrInfo `protobuf:"bytes,1,opt,name=distr_info,json=distrInfo,proto3" json:"distr_info" yaml:"distr_info"`
+}
+
+func (m *QueryVolumeDistrInfoResponse) Reset()         { *m = QueryVolumeDistrInfoResponse{} }
+func (m *QueryVolumeDistrInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVolumeDistrInfoResponse) ProtoMessage()    {}
+func (*QueryVolumeDistrInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_302873ecccbc7636, []int{5}
+}
+func (m *QueryVolumeDistrInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVolumeDistrInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVolumeDistrInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVolumeDistrInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVolumeDistrInfoResponse.Merge(m, src)
+}
+func (m *QueryVolumeDistrInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVolumeDistrInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVolumeDistrInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVolumeDistrInfoResponse proto.InternalMessageInfo
+
+func (m *QueryVolumeDistrInfoResponse) GetDistrInfo() DistrInfo {
+	if m != nil {
+		return m.DistrInfo
+	}
+	return DistrInfo{}
+}
+
 type QueryParamsRequest struct {
 }
 
@@ -265,7 +345,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_302873ecccbc7636, []int{4}
+	return fileDescriptor_302873ecccbc7636, []int{6}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -302,7 +382,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_302873ecccbc7636, []int{5}
+	return fileDescriptor_302873ecccbc7636, []int{7}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -345,7 +425,7 @@ func (m *QueryLockableDurationsRequest) Reset()         { *m = QueryLockableDura
 func (m *QueryLockableDurationsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryLockableDurationsRequest) ProtoMessage()    {}
 func (*QueryLockableDurationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_302873ecccbc7636, []int{6}
+	return fileDescriptor_302873ecccbc7636, []int{8}
 }
 func (m *QueryLockableDurationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -382,7 +462,7 @@ func (m *QueryLockableDurationsResponse) Reset()         { *m = QueryLockableDur
 func (m *QueryLockableDurationsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryLockableDurationsResponse) ProtoMessage()    {}
 func (*QueryLockableDurationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_302873ecccbc7636, []int{7}
+	return fileDescriptor_302873ecccbc7636, []int{9}
 }
 func (m *QueryLockableDurationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -425,7 +505,7 @@ func (m *QueryIncentivizedPoolsRequest) Reset()         { *m = QueryIncentivized
 func (m *QueryIncentivizedPoolsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryIncentivizedPoolsRequest) ProtoMessage()    {}
 func (*QueryIncentivizedPoolsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_302873ecccbc7636, []int{8}
+	return fileDescriptor_302873ecccbc7636, []int{10}
 }
 func (m *QueryIncentivizedPoolsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -464,7 +544,7 @@ func (m *IncentivizedPool) Reset()         { *m = IncentivizedPool{} }
 func (m *IncentivizedPool) String() string { return proto.CompactTextString(m) }
 func (*IncentivizedPool) ProtoMessage()    {}
 func (*IncentivizedPool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_302873ecccbc7636, []int{9}
+	return fileDescriptor_302873ecccbc7636, []int{11}
 }
 func (m *IncentivizedPool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -522,7 +602,7 @@ func (m *QueryIncentivizedPoolsResponse) Reset()         { *m = QueryIncentivize
 func (m *QueryIncentivizedPoolsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryIncentivizedPoolsResponse) ProtoMessage()    {}
 func (*QueryIncentivizedPoolsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_302873ecccbc7636, []int{10}
+	return fileDescriptor_302873ecccbc7636, []int{12}
 }
 func (m *QueryIncentivizedPoolsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -565,7 +645,7 @@ func (m *QueryExternalIncentiveGaugesRequest) Reset()         { *m = QueryExtern
 func (m *QueryExternalIncentiveGaugesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryExternalIncentiveGaugesRequest) ProtoMessage()    {}
 func (*QueryExternalIncentiveGaugesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_302873ecccbc7636, []int{11}
+	return fileDescriptor_302873ecccbc7636, []int{13}
 }
 func (m *QueryExternalIncentiveGaugesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -602,7 +682,7 @@ func (m *QueryExternalIncentiveGaugesResponse) Reset()         { *m = QueryExter
 func (m *QueryExternalIncentiveGaugesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryExternalIncentiveGaugesResponse) ProtoMessage()    {}
 func (*QueryExternalIncentiveGaugesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_302873ecccbc7636, []int{12}
+	return fileDescriptor_302873ecccbc7636, []int{14}
 }
 func (m *QueryExternalIncentiveGaugesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -644,6 +724,8 @@ func init() {
 	proto.RegisterType((*QueryGaugeIdsResponse_GaugeIdWithDuration)(nil), "osmosis.poolincentives.v1beta1.QueryGaugeIdsResponse.GaugeIdWithDuration")
 	proto.RegisterType((*QueryDistrInfoRequest)(nil), "osmosis.poolincentives.v1beta1.QueryDistrInfoRequest")
 	proto.RegisterType((*QueryDistrInfoResponse)(nil), "osmosis.poolincentives.v1beta1.QueryDistrInfoResponse")
+	proto.RegisterType((*QueryVolumeDistrInfoRequest)(nil), "osmosis.poolincentives.v1beta1.QueryVolumeDistrInfoRequest")
+	proto.RegisterType((*QueryVolumeDistrInfoResponse)(nil), "osmosis.poolincentives.v1beta1.QueryVolumeDistrInfoResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.poolincentives.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.poolincentives.v1beta1.QueryParamsResponse")
 	proto.RegisterType((*QueryLockableDurationsRequest)(nil), "osmosis.poolincentives.v1beta1.QueryLockableDurationsRequest")
@@ -660,63 +742,67 @@ func init() {
 }
 
 var fileDescriptor_302873ecccbc7636 = []byte{
-	// 894 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x41, 0x6f, 0xe3, 0x44,
-	0x14, 0xce, 0x74, 0x4b, 0x36, 0x9d, 0x4a, 0xb0, 0x99, 0x06, 0xda, 0x5a, 0xe0, 0x94, 0x61, 0x17,
-	0xba, 0xaa, 0x62, 0xef, 0x26, 0xbb, 0x8b, 0x54, 0x16, 0x90, 0xb2, 0x41, 0x28, 0x12, 0x87, 0xc5,
-	0x42, 0x42, 0x82, 0x83, 0xe5, 0xc4, 0xae, 0x3b, 0xc2, 0xf1, 0x64, 0x33, 0x4e, 0xd9, 0x82, 0xf6,
-	0xb2, 0x07, 0xce, 0x20, 0x2e, 0x9c, 0x11, 0x7b, 0xe6, 0xc4, 0x6f, 0x60, 0x6f, 0x54, 0xe2, 0xc2,
-	0x85, 0x80, 0x5a, 0x0e, 0x9c, 0xf3, 0x0b, 0x90, 0xc7, 0xcf, 0x6e, 0x62, 0x37, 0x71, 0x52, 0x6e,
-	0x89, 0xdf, 0x7b, 0xdf, 0xfb, 0xbe, 0xf7, 0x9e, 0x3f, 0xe3, 0x3d, 0x2e, 0x7a, 0x5c, 0x30, 0xa1,
-	0xf7, 0x39, 0xf7, 0x6a, 0xcc, 0xef, 0x3a, 0x7e, 0xc0, 0x8e, 0x1c, 0xa1, 0x1f, 0xdd, 0xee, 0x38,
-	0x81, 0x75, 0x5b, 0x7f, 0x34, 0x74, 0x06, 0xc7, 0x5a, 0x7f, 0xc0, 0x03, 0x4e, 0x54, 0x48, 0xd6,
-	0xc2, 0xe4, 0xf3, 0x5c, 0x0d, 0x72, 0x95, 0x8a, 0xcb, 0x5d, 0x2e, 0x53, 0xf5, 0xf0, 0x57, 0x54,
-	0xa5, 0xbc, 0xea, 0x72, 0xee, 0x7a, 0x8e, 0x6e, 0xf5, 0x99, 0x6e, 0xf9, 0x3e, 0x0f, 0xac, 0x80,
-	0x71, 0x5f, 0x40, 0x54, 0x85, 0xa8, 0xfc, 0xd7, 0x19, 0x1e, 0xe8, 0xf6, 0x70, 0x20, 0x13, 0xe2,
-	0x78, 0x4c, 0x70, 0x82, 0x9b, 0x6b, 0x0d, 0x5d, 0x07, 0xe2, 0xb7, 0xf2, 0x04, 0x4c, 0xf0, 0x94,
-	0x15, 0xf4, 0x01, 0xae, 0x7c, 0x1c, 0x8a, 0xfa, 0x30, 0x44, 0x69, 0xdb, 0xc2, 0x70, 0x1e, 0x0d,
-	0x1d, 0x11, 0x90, 0x3d, 0x7c, 0x35, 0xc4, 0x30, 0x99, 0xbd, 0x85, 0x76, 0xd0, 0xee, 0x6a, 0x93,
-	0x8c, 0x47, 0xd5, 0x17, 0x8f, 0xad, 0x9e, 0xb7, 0x4f, 0x21, 0x40, 0x8d, 0x62, 0xf8, 0xab, 0x6d,
-	0xd3, 0x5f, 0x57, 0xf0, 0xcb, 0x29, 0x14, 0xd1, 0xe7, 0xbe, 0x70, 0xc8, 0x4f, 0x08, 0x6f, 0x4a,
-	0x82, 0x26, 0xb3, 0x85, 0xf9, 0x25, 0x0b, 0x0e, 0xcd, 0x58, 0xd2, 0x16, 0xda, 0xb9, 0xb2, 0xbb,
-	0x5e, 0x6f, 0x6b, 0xf3, 0xe7, 0xa8, 0x5d, 0x08, 0xac, 0xc1, 0x83, 0x4f, 0x59, 0x70, 0xd8, 0x02,
-	0xc0, 0x26, 0x1d, 0x8f, 0xaa, 0x6a, 0x44, 0x71, 0x46, 0x4f, 0x6a, 0x54, 0x5c, 0x40, 0x9a, 0xac,
-	0x54, 0xbe, 0x41, 0x78, 0xe3, 0x02, 0x44, 0xa2, 0xe1, 0x52, 0x8c, 0x04, 0x63, 0xd8, 0x18, 0x8f,
-	0xaa, 0x2f, 0x4d, 0xf7, 0xa0, 0xc6, 0x55, 0x00, 0x25, 0xef, 0xe3, 0x52, 0x22, 0x6f, 0x65, 0x07,
-	0xed, 0xae, 0xd7, 0xb7, 0xb5, 0x68, 0xa5, 0x5a, 0xbc, 0x52, 0x2d, 0xa1, 0x5b, 0x7a, 0x3e, 0xaa,
-	0x16, 0x7e, 0xf8, 0xab, 0x8a, 0x8c, 0xa4, 0x88, 0x6e, 0xc2, 0x20, 0x5b, 0x4c, 0x04, 0x83, 0xb6,
-	0x7f, 0xc0, 0x61, 0x1f, 0xf4, 0x09, 0x7e, 0x25, 0x1d, 0x80, 0x11, 0x77, 0x31, 0xb6, 0xc3, 0x87,
-	0x26, 0xf3, 0x0f, 0xb8, 0x64, 0xb9, 0x5e, 0xbf, 0x99, 0x37, 0xd4, 0x04, 0xa6, 0xb9, 0x1d, 0xb2,
-	0x18, 0x8f, 0xaa, 0xe5, 0x48, 0xd4, 0x39, 0x14, 0x35, 0xd6, 0xec, 0x38, 0x8b, 0x56, 0x30, 0x91,
-	0xed, 0x1f, 0x5a, 0x03, 0xab, 0x17, 0x1f, 0x09, 0xfd, 0x1c, 0x6f, 0x4c, 0x3d, 0x05, 0x46, 0x2d,
-	0x5c, 0xec, 0xcb, 0x27, 0xc0, 0xe6, 0xcd, 0x3c, 0x36, 0x51, 0x7d, 0x73, 0x35, 0xa4, 0x62, 0x40,
-	0x2d, 0xad, 0xe2, 0xd7, 0x24, 0xf8, 0x47, 0xbc, 0xfb, 0x85, 0xd5, 0xf1, 0x9c, 0x78, 0x6e, 0x49,
-	0xf7, 0xef, 0x10, 0x56, 0x67, 0x65, 0x00, 0x13, 0x8e, 0x89, 0x07, 0xc1, 0xe4, 0x06, 0x04, 0x1c,
-	0xde, 0x9c, 0xcd, 0xdc, 0x80, 0x99, 0x6c, 0x47, 0x33, 0xc9, 0x42, 0x50, 0xb9, 0xb6, 0xb2, 0x97,
-	0x6e, 0x9c, 0x90, 0x6e, 0x83, 0x48, 0xf6, 0x95, 0x63, 0x3f, 0xe4, 0xdc, 0x4b, 0x48, 0xff, 0x89,
-	0xf0, 0xb5, 0x74, 0x70, 0xa9, 0x97, 0x8d, 0x78, 0xb8, 0x9c, 0x21, 0x94, 0x7f, 0x6c, 0xd7, 0x41,
-	0xd2, 0xd6, 0x0c, 0x49, 0x91, 0xa2, 0x6b, 0x69, 0x45, 0x53, 0x6f, 0xc0, 0x95, 0xfc, 0x37, 0x80,
-	0x3e, 0x8b, 0x97, 0x72, 0xc1, 0x04, 0x60, 0x29, 0x4f, 0x11, 0x26, 0x6c, 0x22, 0x6a, 0x86, 0xc2,
-	0xe2, 0xad, 0xdc, 0xca, 0xbb, 0x95, 0x34, 0x6e, 0xf3, 0xf5, 0xe9, 0x65, 0x65, 0x91, 0xa9, 0x51,
-	0x66, 0x69, 0x32, 0xf4, 0x06, 0x7e, 0x43, 0xd2, 0xfc, 0xe0, 0x71, 0xe0, 0x0c, 0x7c, 0xcb, 0x8b,
-	0x61, 0x1d, 0x69, 0x03, 0x13, 0x17, 0x7e, 0x7d, 0x7e, 0x1a, 0x68, 0x6a, 0xe0, 0x55, 0xdb, 0x0a,
-	0xac, 0xe4, 0xb4, 0x62, 0x11, 0x13, 0x02, 0x64, 0x05, 0xdc, 0xb8, 0x4c, 0xae, 0x3f, 0x5b, 0xc3,
-	0x2f, 0x48, 0x74, 0xf2, 0x0b, 0xc2, 0xa5, 0xd8, 0xe2, 0xc8, 0x9d, 0x25, 0x1d, 0x51, 0x32, 0x55,
-	0xee, 0x5e, 0xca, 0x47, 0xe9, 0xfd, 0xa7, 0xbf, 0xff, 0xf3, 0xfd, 0xca, 0x3d, 0x72, 0x47, 0xcf,
-	0xfb, 0x74, 0xc8, 0x0d, 0xd7, 0x98, 0x2d, 0xf4, 0xaf, 0xe1, 0x26, 0x9f, 0x90, 0x9f, 0x11, 0x5e,
-	0x4b, 0xac, 0x84, 0x2c, 0x46, 0x21, 0x6d, 0x6d, 0xca, 0xbd, 0x65, 0xcb, 0x80, 0x7a, 0x43, 0x52,
-	0xaf, 0x91, 0xbd, 0x5c, 0xea, 0xe7, 0xa6, 0x46, 0x7e, 0x44, 0xb8, 0x18, 0xd9, 0x0d, 0xa9, 0x2f,
-	0xd4, 0x77, 0xca, 0xf1, 0x94, 0xc6, 0x52, 0x35, 0x40, 0x54, 0x97, 0x44, 0x6f, 0x92, 0xb7, 0x72,
-	0x89, 0x46, 0xd6, 0x47, 0x7e, 0x43, 0xb8, 0x9c, 0x31, 0x35, 0xf2, 0xee, 0x42, 0xbd, 0x67, 0xd9,
-	0xa5, 0xf2, 0xde, 0x65, 0xcb, 0x41, 0xc5, 0x3b, 0x52, 0xc5, 0x5d, 0xd2, 0xc8, 0x55, 0x91, 0xf5,
-	0x4b, 0xa9, 0x28, 0xe3, 0x08, 0x0b, 0x2a, 0x9a, 0xe5, 0xa5, 0x0b, 0x2a, 0x9a, 0x69, 0x44, 0x4b,
-	0x28, 0xca, 0x9a, 0x0a, 0xf9, 0x17, 0xe1, 0xcd, 0x19, 0xae, 0x40, 0x1e, 0x2c, 0x44, 0x6c, 0xbe,
-	0xf5, 0x28, 0xad, 0xff, 0x07, 0x02, 0x1a, 0x9b, 0x52, 0xe3, 0x7d, 0xb2, 0x9f, 0xab, 0xd1, 0x01,
-	0x24, 0x33, 0x89, 0x99, 0xf2, 0x95, 0x17, 0xcd, 0x4f, 0x9e, 0x9f, 0xaa, 0xe8, 0xe4, 0x54, 0x45,
-	0x7f, 0x9f, 0xaa, 0xe8, 0xdb, 0x33, 0xb5, 0x70, 0x72, 0xa6, 0x16, 0xfe, 0x38, 0x53, 0x0b, 0x9f,
-	0xed, 0xbb, 0x2c, 0x38, 0x1c, 0x76, 0xb4, 0x2e, 0xef, 0xc5, 0xf8, 0x35, 0xcf, 0xea, 0x88, 0xa4,
-	0xd9, 0xd1, 0xdb, 0xfa, 0xe3, 0x4c, 0xc7, 0xe0, 0xb8, 0xef, 0x88, 0x4e, 0x51, 0x7e, 0xa4, 0x1a,
-	0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x50, 0x0b, 0x40, 0x35, 0x75, 0x0b, 0x00, 0x00,
+	// 945 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x41, 0x6f, 0x1b, 0x55,
+	0x10, 0xce, 0x4b, 0x43, 0x9a, 0x4e, 0x24, 0x5a, 0xbf, 0x04, 0x92, 0x2c, 0xed, 0x3a, 0x3c, 0x5a,
+	0x48, 0x15, 0x65, 0xb7, 0xb5, 0xd3, 0x22, 0xa5, 0xa1, 0x48, 0x6e, 0x10, 0xb2, 0xc4, 0xa1, 0x58,
+	0x08, 0x24, 0x38, 0xac, 0xd6, 0xd9, 0x8d, 0xf3, 0xc4, 0x7a, 0x9f, 0xeb, 0xb7, 0x0e, 0x0d, 0xa8,
+	0x97, 0x22, 0x71, 0x06, 0x71, 0xe1, 0x8c, 0xe0, 0xcc, 0x89, 0xdf, 0x40, 0x6e, 0x54, 0xe2, 0xc2,
+	0x05, 0x83, 0x12, 0x0e, 0x1c, 0x51, 0x7e, 0x01, 0xda, 0xb7, 0xb3, 0x1b, 0x7b, 0xd7, 0xce, 0xda,
+	0x46, 0xea, 0xcd, 0xde, 0x99, 0xf9, 0xe6, 0xfb, 0x66, 0x66, 0xbf, 0x85, 0x75, 0x21, 0x9b, 0x42,
+	0x72, 0x69, 0xb6, 0x84, 0xf0, 0x36, 0xb8, 0xbf, 0xeb, 0xfa, 0x01, 0x3f, 0x70, 0xa5, 0x79, 0x70,
+	0xbb, 0xee, 0x06, 0xf6, 0x6d, 0xf3, 0x51, 0xc7, 0x6d, 0x1f, 0x1a, 0xad, 0xb6, 0x08, 0x04, 0xd5,
+	0x31, 0xd9, 0x08, 0x93, 0xcf, 0x72, 0x0d, 0xcc, 0xd5, 0x16, 0x1b, 0xa2, 0x21, 0x54, 0xaa, 0x19,
+	0xfe, 0x8a, 0xaa, 0xb4, 0xab, 0x0d, 0x21, 0x1a, 0x9e, 0x6b, 0xda, 0x2d, 0x6e, 0xda, 0xbe, 0x2f,
+	0x02, 0x3b, 0xe0, 0xc2, 0x97, 0x18, 0xd5, 0x31, 0xaa, 0xfe, 0xd5, 0x3b, 0x7b, 0xa6, 0xd3, 0x69,
+	0xab, 0x84, 0x38, 0x1e, 0x13, 0xec, 0xe1, 0xd6, 0xb0, 0x3b, 0x0d, 0x17, 0xe3, 0xb7, 0xf2, 0x04,
+	0xf4, 0xf0, 0x54, 0x15, 0xec, 0x01, 0x2c, 0xbe, 0x1f, 0x8a, 0x7a, 0x37, 0x44, 0xa9, 0x3a, 0xb2,
+	0xe6, 0x3e, 0xea, 0xb8, 0x32, 0xa0, 0xeb, 0x70, 0x31, 0xc4, 0xb0, 0xb8, 0xb3, 0x4c, 0x56, 0xc9,
+	0xda, 0x4c, 0x85, 0x9e, 0x76, 0x8b, 0x2f, 0x1e, 0xda, 0x4d, 0x6f, 0x8b, 0x61, 0x80, 0xd5, 0x66,
+	0xc3, 0x5f, 0x55, 0x87, 0xfd, 0x32, 0x0d, 0x2f, 0xa5, 0x50, 0x64, 0x4b, 0xf8, 0xd2, 0xa5, 0x3f,
+	0x10, 0x58, 0x52, 0x04, 0x2d, 0xee, 0x48, 0xeb, 0x33, 0x1e, 0xec, 0x5b, 0xb1, 0xa4, 0x65, 0xb2,
+	0x7a, 0x61, 0x6d, 0xbe, 0x54, 0x35, 0xce, 0x9f, 0xa3, 0x31, 0x10, 0xd8, 0xc0, 0x07, 0x1f, 0xf1,
+	0x60, 0x7f, 0x07, 0x01, 0x2b, 0xec, 0xb4, 0x5b, 0xd4, 0x23, 0x8a, 0x43, 0x7a, 0xb2, 0xda, 0x62,
+	0x03, 0x91, 0x7a, 0x2b, 0xb5, 0xaf, 0x08, 0x2c, 0x0c, 0x40, 0xa4, 0x06, 0xcc, 0xc5, 0x48, 0x38,
+	0x86, 0x85, 0xd3, 0x6e, 0xf1, 0x72, 0x7f, 0x0f, 0x56, 0xbb, 0x88, 0xa0, 0xf4, 0x6d, 0x98, 0x4b,
+	0xe4, 0x4d, 0xaf, 0x92, 0xb5, 0xf9, 0xd2, 0x8a, 0x11, 0xad, 0xd4, 0x88, 0x57, 0x6a, 0x24, 0x74,
+	0xe7, 0x8e, 0xba, 0xc5, 0xa9, 0xef, 0xfe, 0x2c, 0x92, 0x5a, 0x52, 0xc4, 0x96, 0x70, 0x90, 0x3b,
+	0x5c, 0x06, 0xed, 0xaa, 0xbf, 0x27, 0x70, 0x1f, 0xec, 0x09, 0xbc, 0x9c, 0x0e, 0xe0, 0x88, 0x77,
+	0x01, 0x9c, 0xf0, 0xa1, 0xc5, 0xfd, 0x3d, 0xa1, 0x58, 0xce, 0x97, 0x6e, 0xe6, 0x0d, 0x35, 0x81,
+	0xa9, 0xac, 0x84, 0x2c, 0x4e, 0xbb, 0xc5, 0x42, 0x24, 0xea, 0x0c, 0x8a, 0xd5, 0x2e, 0x39, 0x71,
+	0x16, 0xbb, 0x06, 0xaf, 0xa8, 0xf6, 0x1f, 0x0a, 0xaf, 0xd3, 0x74, 0x33, 0xec, 0xbe, 0x24, 0x70,
+	0x75, 0x70, 0xfc, 0x79, 0x92, 0x5c, 0x04, 0xaa, 0x48, 0x3c, 0xb4, 0xdb, 0x76, 0x33, 0xbe, 0x64,
+	0xf6, 0x09, 0x2c, 0xf4, 0x3d, 0x45, 0x46, 0x3b, 0x30, 0xdb, 0x52, 0x4f, 0x90, 0xcd, 0xeb, 0x79,
+	0x6c, 0xa2, 0xfa, 0xca, 0x4c, 0x48, 0xa5, 0x86, 0xb5, 0xac, 0x08, 0xd7, 0x14, 0xf8, 0x7b, 0x62,
+	0xf7, 0x53, 0xbb, 0xee, 0xb9, 0xf1, 0x72, 0x93, 0xee, 0xdf, 0x10, 0xd0, 0x87, 0x65, 0x20, 0x13,
+	0x01, 0xd4, 0xc3, 0x60, 0x72, 0xa8, 0x12, 0xdf, 0x8e, 0x73, 0xce, 0xe7, 0x06, 0xce, 0x64, 0x25,
+	0x9a, 0x49, 0x16, 0x82, 0xa9, 0xdb, 0x2a, 0x78, 0xe9, 0xc6, 0x09, 0xe9, 0x2a, 0x8a, 0xe4, 0x9f,
+	0xbb, 0xce, 0x43, 0x21, 0xbc, 0x84, 0xf4, 0x1f, 0x04, 0xae, 0xa4, 0x83, 0x63, 0x39, 0x02, 0xf5,
+	0xa0, 0x90, 0x21, 0x94, 0xff, 0x46, 0x5c, 0x47, 0x49, 0xcb, 0x43, 0x24, 0x45, 0x8a, 0xae, 0xa4,
+	0x15, 0xf5, 0xbd, 0xa6, 0x17, 0xf2, 0x5f, 0x53, 0xf6, 0x63, 0xbc, 0x94, 0x01, 0x13, 0xc0, 0xa5,
+	0x3c, 0x25, 0x40, 0x79, 0x4f, 0xd4, 0x0a, 0x85, 0xc5, 0x5b, 0xb9, 0x95, 0x77, 0x2b, 0x69, 0xdc,
+	0xca, 0xab, 0xfd, 0xcb, 0xca, 0x22, 0xb3, 0x5a, 0x81, 0xa7, 0xc9, 0xb0, 0x1b, 0xf0, 0x9a, 0xa2,
+	0xf9, 0xce, 0xe3, 0xc0, 0x6d, 0xfb, 0xb6, 0x17, 0xc3, 0xba, 0xca, 0xab, 0x7a, 0x2e, 0xfc, 0xfa,
+	0xf9, 0x69, 0xa8, 0xa9, 0x0c, 0x33, 0x8e, 0x1d, 0xd8, 0xc9, 0x69, 0xc5, 0x22, 0x7a, 0x04, 0xa8,
+	0x0a, 0xbc, 0x71, 0x95, 0x5c, 0xfa, 0x17, 0xe0, 0x05, 0x85, 0x4e, 0x7f, 0x26, 0x30, 0x17, 0xfb,
+	0x30, 0xdd, 0x1c, 0xd3, 0xb6, 0x15, 0x53, 0xed, 0xce, 0x44, 0x66, 0xcf, 0xb6, 0x9f, 0xfe, 0xf6,
+	0xf7, 0xb7, 0xd3, 0x77, 0xe9, 0xa6, 0x99, 0xf7, 0x7d, 0x53, 0x1b, 0xde, 0xe0, 0x8e, 0x34, 0xbf,
+	0xc0, 0x9b, 0x7c, 0x42, 0x7f, 0x22, 0x70, 0x29, 0xb1, 0x12, 0x3a, 0x1a, 0x85, 0xb4, 0xc3, 0x69,
+	0x77, 0xc7, 0x2d, 0x43, 0xea, 0x65, 0x45, 0x7d, 0x83, 0xae, 0xe7, 0x52, 0x3f, 0x33, 0x35, 0x7a,
+	0x44, 0xe0, 0x72, 0xca, 0x49, 0xe9, 0xbd, 0x91, 0x08, 0x0c, 0xf6, 0x67, 0x6d, 0x7b, 0xb2, 0x62,
+	0xd4, 0xb0, 0xa5, 0x34, 0x6c, 0xd2, 0x52, 0xae, 0x86, 0x03, 0x85, 0x60, 0xf5, 0x48, 0xf9, 0x9e,
+	0xc0, 0x6c, 0xe4, 0x9c, 0xb4, 0x34, 0x12, 0x89, 0x3e, 0xf3, 0xd6, 0xca, 0x63, 0xd5, 0x20, 0x5f,
+	0x53, 0xf1, 0xbd, 0x49, 0xdf, 0xc8, 0xe5, 0x1b, 0xb9, 0x38, 0xfd, 0x95, 0x40, 0x21, 0xe3, 0xcf,
+	0xf4, 0xad, 0x91, 0x7a, 0x0f, 0x73, 0x7e, 0xed, 0xfe, 0xa4, 0xe5, 0xa8, 0xe2, 0x9e, 0x52, 0x71,
+	0x87, 0x96, 0x73, 0x55, 0x64, 0xad, 0x5f, 0x29, 0xca, 0x98, 0xdb, 0x88, 0x8a, 0x86, 0x7d, 0x16,
+	0xb4, 0xfb, 0x93, 0x96, 0x8f, 0xad, 0x28, 0xeb, 0x8f, 0xf4, 0x1f, 0x02, 0x4b, 0x43, 0x0c, 0x8e,
+	0x3e, 0x18, 0x89, 0xd8, 0xf9, 0x2e, 0xaa, 0xed, 0xfc, 0x3f, 0x10, 0xd4, 0x58, 0x51, 0x1a, 0xb7,
+	0xe9, 0x56, 0xae, 0x46, 0x17, 0x91, 0xac, 0x24, 0x66, 0x29, 0xf7, 0x92, 0x95, 0x0f, 0x8e, 0x8e,
+	0x75, 0xf2, 0xec, 0x58, 0x27, 0x7f, 0x1d, 0xeb, 0xe4, 0xeb, 0x13, 0x7d, 0xea, 0xd9, 0x89, 0x3e,
+	0xf5, 0xfb, 0x89, 0x3e, 0xf5, 0xf1, 0x56, 0x83, 0x07, 0xfb, 0x9d, 0xba, 0xb1, 0x2b, 0x9a, 0x31,
+	0xfe, 0x86, 0x67, 0xd7, 0x65, 0xd2, 0xec, 0xe0, 0x4d, 0xf3, 0x71, 0xa6, 0x63, 0x70, 0xd8, 0x72,
+	0x65, 0x7d, 0x56, 0x7d, 0x6f, 0xcb, 0xff, 0x0d, 0x00, 0xb4, 0x2e, 0xd3, 0x04, 0xe5, 0x0c, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -734,6 +820,9 @@ type QueryClient interface {
 	// GaugeIds takes the pool id and returns the matching gauge ids and durations
 	GaugeIds(ctx context.Context, in *QueryGaugeIdsRequest, opts ...grpc.CallOption) (*QueryGaugeIdsResponse, error)
 	DistrInfo(ctx context.Context, in *QueryDistrInfoRequest, opts ...grpc.CallOption) (*QueryDistrInfoResponse, error)
+	// VolumeDistrInfo returns the records the volume weighted share of the pool
+	// incentives is allocated by at the next epoch
+	VolumeDistrInfo(ctx context.Context, in *QueryVolumeDistrInfoRequest, opts ...grpc.CallOption) (*QueryVolumeDistrInfoResponse, error)
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	LockableDurations(ctx context.Context, in *QueryLockableDurationsRequest, opts ...grpc.CallOption) (*QueryLockableDurationsResponse, error)
 	IncentivizedPools(ctx context.Context, in *QueryIncentivizedPoolsRequest, opts ...grpc.CallOption) (*QueryIncentivizedPoolsResponse, error)
@@ -766,6 +855,15 @@ func (c *queryClient) DistrInfo(ctx context.Context, in *QueryDistrInfoRequest,
 	return out, nil
 }
 
+func (c *queryClient) VolumeDistrInfo(ctx context.Context, in *QueryVolumeDistrInfoRequest, opts ...grpc.CallOption) (*QueryVolumeDistrInfoResponse, error) {
+	out := new(QueryVolumeDistrInfoResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolincentives.v1beta1.Query/VolumeDistrInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/osmosis.poolincentives.v1beta1.Query/Params", in, out, opts...)
@@ -807,6 +905,9 @@ type QueryServer interface {
 	// GaugeIds takes the pool id and returns the matching gauge ids and durations
 	GaugeIds(context.Context, *QueryGaugeIdsRequest) (*QueryGaugeIdsResponse, error)
 	DistrInfo(context.Context, *QueryDistrInfoRequest) (*QueryDistrInfoResponse, error)
+	// VolumeDistrInfo returns the records the volume weighted share of the pool
+	// incentives is allocated by at the next epoch
+	VolumeDistrInfo(context.Context, *QueryVolumeDistrInfoRequest) (*QueryVolumeDistrInfoResponse, error)
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	LockableDurations(context.Context, *QueryLockableDurationsRequest) (*QueryLockableDurationsResponse, error)
 	IncentivizedPools(context.Context, *QueryIncentivizedPoolsRequest) (*QueryIncentivizedPoolsResponse, error)
@@ -823,6 +924,9 @@ func (*UnimplementedQueryServer) GaugeIds(ctx context.Context, req *QueryGaugeId
 func (*UnimplementedQueryServer) DistrInfo(ctx context.Context, req *QueryDistrInfoRequest) (*QueryDistrInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DistrInfo not implemented")
 }
+func (*UnimplementedQueryServer) VolumeDistrInfo(ctx context.Context, req *QueryVolumeDistrInfoRequest) (*QueryVolumeDistrInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VolumeDistrInfo not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -876,6 +980,24 @@ func _Query_DistrInfo_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_VolumeDistrInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVolumeDistrInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VolumeDistrInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolincentives.v1beta1.Query/VolumeDistrInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VolumeDistrInfo(ctx, req.(*QueryVolumeDistrInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -960,6 +1082,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DistrInfo",
 			Handler:    _Query_DistrInfo_Handler,
 		},
+		{
+			MethodName: "VolumeDistrInfo",
+			Handler:    _Query_VolumeDistrInfo_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -1138,6 +1264,62 @@ func (m *QueryDistrInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryVolumeDistrInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVolumeDistrInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVolumeDistrInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVolumeDistrInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVolumeDistrInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVolumeDistrInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DistrInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1300,12 +1482,12 @@ func (m *IncentivizedPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x18
 	}
-	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.LockableDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.LockableDuration):])
-	if err4 != nil {
-		return 0, err4
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.LockableDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.LockableDuration):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n4
-	i = encodeVarintQuery(dAtA, i, uint64(n4))
+	i -= n5
+	i = encodeVarintQuery(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x12
 	if m.PoolId != 0 {
@@ -1485,6 +1667,26 @@ func (m *QueryDistrInfoResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryVolumeDistrInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryVolumeDistrInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DistrInfo.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1988,6 +2190,139 @@ func (m *QueryDistrInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryVolumeDistrInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVolumeDistrInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVolumeDistrInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVolumeDistrInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVolumeDistrInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVolumeDistrInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistrInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DistrInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -64,6 +64,43 @@ func (h Hooks) OnTokenUnlocked(ctx sdk.Context, address sdk.AccAddress, lockID u
 func (h Hooks) OnTokenSlashed(ctx sdk.Context, lockID uint64, amount sdk.Coins) {
 }
 
+// Superfluid delegations only need the lock to be bonded for at least the unbonding
+// time, which a longer duration keeps satisfied, so nothing happens superfluid side.
+func (h Hooks) OnLockupExtend(ctx sdk.Context, lockID uint64, prevDuration, newDuration time.Duration) {
+}
+
+// A superfluid staked lock keeps its delegation through its intermediary account when it is
+// transferred. Its synthetic locks move with it, so the new owner earns its rewards and is
+// the one who can superfluid undelegate it.
+func (h Hooks) OnLockupTransfer(ctx sdk.Context, lockID uint64, prevOwner, newOwner sdk.AccAddress) {
+	intermediaryAccAddr := h.k.GetLockIdIntermediaryAccountConnection(ctx, lockID)
+	if !intermediaryAccAddr.Empty() {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.TypeEvtSuperfluidLockTransferred,
+			sdk.NewAttribute(types.AttributeLockId, fmt.Sprintf("%d", lockID)),
+			sdk.NewAttribute(types.AttributeNewOwner, newOwner.String()),
+		))
+	}
+}
+
+// A lock split out of a superfluid staked lock gets a copy of its synthetic locks,
+// so it is connected to the same intermediary account. The delegation does not change,
+// since the locks add up to the same amount.
+func (h Hooks) OnLockupSplit(ctx sdk.Context, lockID, newLockID uint64, amount sdk.Coins) {
+	intermediaryAcc, found := h.k.GetIntermediaryAccountFromLockId(ctx, lockID)
+	if found {
+		h.k.SetLockIdIntermediaryAccountConnection(ctx, newLockID, intermediaryAcc)
+	}
+}
+
+// Only locks with the same synthetic locks are merged, so the merged locks were connected
+// to the same intermediary account as the lock they are merged into, if any.
+func (h Hooks) OnLockupMerge(ctx sdk.Context, lockID uint64, mergedLockIDs []uint64) {
+	for _, mergedLockID := range mergedLockIDs {
+		h.k.DeleteLockIdIntermediaryAccountConnection(ctx, mergedLockID)
+	}
+}
+
 // staking hooks.
 func (h Hooks) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress)   {}
 func (h Hooks) BeforeValidatorModified(ctx sdk.Context, valAddr sdk.ValAddress) {}
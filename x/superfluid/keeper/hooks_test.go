@@ -3,6 +3,9 @@ package keeper_test
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	lockuptypes "github.com/osmosis-labs/osmosis/v7/x/lockup/types"
+	"github.com/osmosis-labs/osmosis/v7/x/superfluid/keeper"
 )
 
 func (suite *KeeperTestSuite) TestSuperfluidAfterEpochEnd() {
@@ -307,3 +310,61 @@ func (suite *KeeperTestSuite) TestBeforeSlashingUnbondingDelegationHook() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestOnLockupTransferHook() {
+	suite.SetupTest()
+
+	delAddrs := CreateRandomAccounts(2)
+	valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+	denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20), sdk.NewDec(20)})
+	intermediaryAccs, locks := suite.SetupSuperfluidDelegations(delAddrs[:1], valAddrs, []superfluidDelegation{{0, 0, 0, 1000000}}, denoms)
+	lock := locks[0]
+
+	err := suite.App.LockupKeeper.TransferLock(suite.Ctx, lock.ID, delAddrs[0], delAddrs[1])
+	suite.Require().NoError(err)
+
+	// the delegation stays with the intermediary account
+	suite.Require().Equal(intermediaryAccs[0].GetAccAddress(), suite.App.SuperfluidKeeper.GetLockIdIntermediaryAccountConnection(suite.Ctx, lock.ID))
+	suite.checkIntermediaryAccountDelegations(intermediaryAccs)
+
+	// only the new owner can undelegate
+	err = suite.App.SuperfluidKeeper.SuperfluidUndelegate(suite.Ctx, delAddrs[0].String(), lock.ID)
+	suite.Require().Error(err)
+	err = suite.App.SuperfluidKeeper.SuperfluidUndelegate(suite.Ctx, delAddrs[1].String(), lock.ID)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestOnLockupSplitAndMergeHooks() {
+	suite.SetupTest()
+
+	delAddrs := CreateRandomAccounts(1)
+	valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+	denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20), sdk.NewDec(20)})
+	intermediaryAccs, locks := suite.SetupSuperfluidDelegations(delAddrs, valAddrs, []superfluidDelegation{{0, 0, 0, 1000000}}, denoms)
+	lock := locks[0]
+
+	// the split lock is connected to the same intermediary account
+	splitLock, err := suite.App.LockupKeeper.SplitLock(suite.Ctx, lock.ID, delAddrs[0], sdk.Coins{sdk.NewInt64Coin(denoms[0], 400000)})
+	suite.Require().NoError(err)
+	suite.Require().Equal(intermediaryAccs[0].GetAccAddress(), suite.App.SuperfluidKeeper.GetLockIdIntermediaryAccountConnection(suite.Ctx, splitLock.ID))
+	suite.checkIntermediaryAccountDelegations(intermediaryAccs)
+	_, broken := keeper.AllInvariants(*suite.App.SuperfluidKeeper)(suite.Ctx)
+	suite.Require().False(broken)
+
+	// merging them back removes the connection of the merged lock
+	mergedLock, err := suite.App.LockupKeeper.MergeLocks(suite.Ctx, []uint64{lock.ID, splitLock.ID}, delAddrs[0])
+	suite.Require().NoError(err)
+	suite.Require().Equal(lock.Coins, mergedLock.Coins)
+	suite.Require().True(suite.App.SuperfluidKeeper.GetLockIdIntermediaryAccountConnection(suite.Ctx, splitLock.ID).Empty())
+	suite.Require().Equal(intermediaryAccs[0].GetAccAddress(), suite.App.SuperfluidKeeper.GetLockIdIntermediaryAccountConnection(suite.Ctx, lock.ID))
+	_, broken = keeper.AllInvariants(*suite.App.SuperfluidKeeper)(suite.Ctx)
+	suite.Require().False(broken)
+
+	// a superfluid staked lock does not merge with a lock that is not staked
+	unstakedLockID := suite.LockTokens(delAddrs[0], sdk.Coins{sdk.NewInt64Coin(denoms[0], 1000000)}, lock.Duration)
+	_, err = suite.App.LockupKeeper.MergeLocks(suite.Ctx, []uint64{lock.ID, unstakedLockID}, delAddrs[0])
+	suite.Require().ErrorIs(err, lockuptypes.ErrLocksNotMergeable)
+
+	err = suite.App.SuperfluidKeeper.SuperfluidUndelegate(suite.Ctx, delAddrs[0].String(), lock.ID)
+	suite.Require().NoError(err)
+}
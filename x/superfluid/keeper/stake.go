@@ -254,6 +254,34 @@ func (k Keeper) SuperfluidUnbondLock(ctx sdk.Context, underlyingLockId uint64, s
 	return k.lk.BeginForceUnlock(ctx, underlyingLockId, sdk.Coins{})
 }
 
+// ForceUndelegateLock ends the superfluid staking of a lock at once, for modules
+// that have to unlock it. Its delegation is undelegated and burnt, and all of its
+// synthetic lockups, bonded or unbonding, are deleted.
+func (k Keeper) ForceUndelegateLock(ctx sdk.Context, lockID uint64) error {
+	lock, err := k.lk.GetLockByID(ctx, lockID)
+	if err != nil {
+		return err
+	}
+
+	intermediaryAcc, found := k.GetIntermediaryAccountFromLockId(ctx, lockID)
+	if found {
+		k.DeleteLockIdIntermediaryAccountConnection(ctx, lockID)
+		amount := k.GetSuperfluidOSMOTokens(ctx, intermediaryAcc.Denom, lock.Coins.AmountOf(intermediaryAcc.Denom))
+		err = k.forceUndelegateAndBurnOsmoTokens(ctx, amount, intermediaryAcc)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, synthLock := range k.lk.GetAllSyntheticLockupsByLockup(ctx, lockID) {
+		err = k.lk.DeleteSyntheticLockup(ctx, lockID, synthLock.SynthDenom)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (k Keeper) alreadySuperfluidStaking(ctx sdk.Context, lockID uint64) bool {
 	// We need to catch two cases:
 	// (1) lockID has another superfluid bond
@@ -9,6 +9,7 @@ import (
 	"github.com/osmosis-labs/osmosis/v7/x/superfluid/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -895,3 +896,45 @@ func (suite *KeeperTestSuite) TestRefreshIntermediaryDelegationAmounts() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestCleanupPoolWithSuperfluidLock() {
+	suite.SetupTest()
+
+	delAddrs := CreateRandomAccounts(1)
+	valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+	denoms, poolIds := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20)})
+
+	// the delegator joins the pool, so that its shares add up, and superfluid
+	// delegates its locked shares.
+	err := simapp.FundAccount(suite.App.BankKeeper, suite.Ctx, delAddrs[0], sdk.NewCoins(
+		sdk.NewInt64Coin(sdk.DefaultBondDenom, 100000000), sdk.NewInt64Coin("token0", 100)))
+	suite.Require().NoError(err)
+	shares := sdk.NewCoins(sdk.NewInt64Coin(denoms[0], 1000000))
+	err = suite.App.GAMMKeeper.JoinPoolNoSwap(suite.Ctx, delAddrs[0], poolIds[0], shares[0].Amount, sdk.Coins{})
+	suite.Require().NoError(err)
+	unbondingDuration := suite.App.StakingKeeper.GetParams(suite.Ctx).UnbondingTime
+	lock, err := suite.App.LockupKeeper.LockTokens(suite.Ctx, delAddrs[0], shares, unbondingDuration)
+	suite.Require().NoError(err)
+	err = suite.App.SuperfluidKeeper.SuperfluidDelegate(suite.Ctx, lock.Owner, lock.ID, valAddrs[0].String())
+	suite.Require().NoError(err)
+	suite.Require().True(suite.App.LockupKeeper.HasAnySyntheticLockups(suite.Ctx, lock.ID))
+
+	// the superfluid staked lock no longer keeps the pool from being cleaned up.
+	cleanups, err := suite.App.GAMMKeeper.GetPoolCleanups(suite.Ctx, poolIds)
+	suite.Require().NoError(err)
+	suite.Require().Len(cleanups, 1)
+	err = suite.App.GAMMKeeper.CleanupPools(suite.Ctx, poolIds)
+	suite.Require().NoError(err)
+
+	// the lock, its synthetic lockups and its intermediary account connection
+	// are gone, and the owner is paid out.
+	_, err = suite.App.LockupKeeper.GetLockByID(suite.Ctx, lock.ID)
+	suite.Require().Error(err)
+	suite.Require().False(suite.App.LockupKeeper.HasAnySyntheticLockups(suite.Ctx, lock.ID))
+	suite.Require().True(suite.App.SuperfluidKeeper.GetLockIdIntermediaryAccountConnection(suite.Ctx, lock.ID).Empty())
+	suite.Require().True(suite.App.BankKeeper.GetBalance(suite.Ctx, delAddrs[0], denoms[0]).IsZero())
+	suite.Require().False(suite.App.BankKeeper.GetBalance(suite.Ctx, delAddrs[0], sdk.DefaultBondDenom).IsZero())
+
+	_, broken := keeper.AllInvariants(*suite.App.SuperfluidKeeper)(suite.Ctx)
+	suite.Require().False(broken)
+}
@@ -10,8 +10,9 @@ import (
 )
 
 // This function calculates the osmo equivalent worth of an LP share.
-// It is intended to eventually use the TWAP of the worth of an LP share
-// once that is exposed from the gamm module.
+// It is intended to eventually use the TWAP of the worth of an LP share.
+// x/twap only tracks the prices of a pool's asset pairs, not the worth of its
+// shares, so this still uses the pool's current osmo reserves.
 func (k Keeper) calculateOsmoBackingPerShare(pool gammtypes.PoolI, osmoInPool sdk.Int) sdk.Dec {
 	twap := osmoInPool.ToDec().Quo(pool.GetTotalShares().ToDec())
 	return twap
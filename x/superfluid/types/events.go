@@ -8,10 +8,12 @@ const (
 	TypeEvtSuperfluidIncreaseDelegation = "superfluid_increase_delegation"
 	TypeEvtSuperfluidUndelegate         = "superfluid_undelegate"
 	TypeEvtSuperfluidUnbondLock         = "superfluid_unbond_lock"
+	TypeEvtSuperfluidLockTransferred    = "superfluid_lock_transferred"
 
 	AttributeDenom               = "denom"
 	AttributeSuperfluidAssetType = "superfluid_asset_type"
 	AttributeLockId              = "lock_id"
 	AttributeValidator           = "validator"
 	AttributeAmount              = "amount"
+	AttributeNewOwner            = "new_owner"
 )
@@ -0,0 +1,40 @@
+package cli
+
+import (
+	flag "github.com/spf13/pflag"
+)
+
+const (
+	// Will be parsed to uint64.
+	FlagSwapRoutePoolIds = "swap-route-pool-ids"
+	// Will be parsed to []string.
+	FlagSwapRouteDenoms = "swap-route-denoms"
+	// Will be parsed to uint32.
+	FlagMaxHops = "max-hops"
+	// Will be parsed to uint32.
+	FlagMaxRoutes = "max-routes"
+)
+
+func FlagSetSwapAmountInRoutes() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.StringArray(FlagSwapRoutePoolIds, []string{""}, "swap route pool ids")
+	fs.StringArray(FlagSwapRouteDenoms, []string{""}, "swap route token out denoms")
+	return fs
+}
+
+func FlagSetSwapAmountOutRoutes() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.StringArray(FlagSwapRoutePoolIds, []string{""}, "swap route pool ids")
+	fs.StringArray(FlagSwapRouteDenoms, []string{""}, "swap route token in denoms")
+	return fs
+}
+
+func FlagSetBestRoutes() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.Uint32(FlagMaxHops, 0, "most hops of a route (defaults to 3)")
+	fs.Uint32(FlagMaxRoutes, 0, "most routes returned (defaults to 3)")
+	return fs
+}
@@ -0,0 +1,256 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/osmosis-labs/osmosis/v7/x/swaprouter/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module.
+func GetQueryCmd() *cobra.Command {
+	// Group swaprouter queries under a subcommand
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetCmdNumPools(),
+		GetCmdPoolType(),
+		GetCmdEstimateSwapExactAmountIn(),
+		GetCmdEstimateSwapExactAmountOut(),
+		GetCmdEstimateBestRoutes(),
+	)
+
+	return cmd
+}
+
+// GetCmdNumPools returns the number of pools created, of every type.
+func GetCmdNumPools() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "num-pools",
+		Short: "Query the number of pools, of every type",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the number of pools, of every type.
+Example:
+$ %s query swaprouter num-pools
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.NumPools(cmd.Context(), &types.QueryNumPoolsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdPoolType returns the type of a pool.
+func GetCmdPoolType() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool-type <poolID>",
+		Short: "Query the type of a pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the type of a pool.
+Example:
+$ %s query swaprouter pool-type 1
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PoolType(cmd.Context(), &types.QueryPoolTypeRequest{PoolId: poolID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdEstimateSwapExactAmountIn returns estimation of output coin when amount of x token input.
+func GetCmdEstimateSwapExactAmountIn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-swap-exact-amount-in <sender> <tokenIn>",
+		Short: "Query estimate-swap-exact-amount-in",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query estimate-swap-exact-amount-in.
+Example:
+$ %s query swaprouter estimate-swap-exact-amount-in osm11vmx8jtggpd9u7qr0t8vxclycz85u925sazglr7 1000stake --swap-route-pool-ids=2 --swap-route-denoms=uosmo
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			routes, err := SwapAmountInRoutes(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.EstimateSwapExactAmountIn(cmd.Context(), &types.QueryEstimateSwapExactAmountInRequest{
+				Sender:  args[0],
+				TokenIn: args[1],
+				Routes:  routes,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetSwapAmountInRoutes())
+	flags.AddQueryFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(FlagSwapRoutePoolIds)
+	_ = cmd.MarkFlagRequired(FlagSwapRouteDenoms)
+
+	return cmd
+}
+
+// GetCmdEstimateSwapExactAmountOut returns estimation of input coin to get exact amount of x token output.
+func GetCmdEstimateSwapExactAmountOut() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-swap-exact-amount-out <sender> <tokenOut>",
+		Short: "Query estimate-swap-exact-amount-out",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query estimate-swap-exact-amount-out.
+Example:
+$ %s query swaprouter estimate-swap-exact-amount-out osm11vmx8jtggpd9u7qr0t8vxclycz85u925sazglr7 1000stake --swap-route-pool-ids=2 --swap-route-denoms=uosmo
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			routes, err := SwapAmountOutRoutes(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.EstimateSwapExactAmountOut(cmd.Context(), &types.QueryEstimateSwapExactAmountOutRequest{
+				Sender:   args[0],
+				Routes:   routes,
+				TokenOut: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetSwapAmountOutRoutes())
+	flags.AddQueryFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(FlagSwapRoutePoolIds)
+	_ = cmd.MarkFlagRequired(FlagSwapRouteDenoms)
+
+	return cmd
+}
+
+// GetCmdEstimateBestRoutes returns the routes through active pools swapping
+// the most tokens out for the token in.
+func GetCmdEstimateBestRoutes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-best-routes <tokenIn> <tokenOutDenom>",
+		Short: "Query the best routes to swap a token for a denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the routes through active pools of every type that swap the most tokens out for the token in, with per-hop amounts, effective price and price impact.
+Example:
+$ %s query swaprouter estimate-best-routes 1000stake uosmo --max-hops=2 --max-routes=5
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			maxHops, err := cmd.Flags().GetUint32(FlagMaxHops)
+			if err != nil {
+				return err
+			}
+
+			maxRoutes, err := cmd.Flags().GetUint32(FlagMaxRoutes)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.EstimateBestRoutes(cmd.Context(), &types.QueryEstimateBestRoutesRequest{
+				TokenIn:       args[0],
+				TokenOutDenom: args[1],
+				MaxHops:       maxHops,
+				MaxRoutes:     maxRoutes,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetBestRoutes())
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
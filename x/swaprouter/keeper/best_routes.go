@@ -0,0 +1,174 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/swaprouter/types"
+)
+
+// routablePool is an active pool that a route can go through, with the
+// module owning it.
+type routablePool struct {
+	swapModule types.SwapI
+	pool       types.PoolI
+	denoms     []string
+}
+
+// routeSearch holds the state of a search for routes from one token to a
+// denom.
+type routeSearch struct {
+	ctx           sdk.Context
+	keeper        Keeper
+	tokenOutDenom string
+	maxHops       int
+	// poolsByDenom lists the pools that can swap each denom, by pool ID.
+	poolsByDenom map[string][]routablePool
+
+	hops      []types.SwapHopEstimate
+	usedPools map[uint64]bool
+	// usedDenoms are the denoms the hops so far swapped in, which routes do
+	// not come back to.
+	usedDenoms map[string]bool
+	found      [][]types.SwapHopEstimate
+}
+
+// FindBestRoutes searches the active pools of every type for the routes
+// of at most maxHops hops swapping tokenIn for tokenOutDenom, and returns the
+// maxRoutes routes with the most tokens out, most first. Routes don't go
+// through a pool, or come back to a denom, twice.
+//
+// Routes are estimated with the modules' CalcOutAmtGivenIn, taker fee
+// included, against the current state of each pool, and priced against the pools' spot prices.
+func (k Keeper) FindBestRoutes(ctx sdk.Context, tokenIn sdk.Coin, tokenOutDenom string, maxHops, maxRoutes int) ([]types.SwapRouteEstimate, error) {
+	poolsByDenom, err := k.getRoutablePoolsByDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	search := &routeSearch{
+		ctx:           ctx,
+		keeper:        k,
+		tokenOutDenom: tokenOutDenom,
+		maxHops:       maxHops,
+		poolsByDenom:  poolsByDenom,
+		usedPools:     map[uint64]bool{},
+		usedDenoms:    map[string]bool{},
+	}
+	search.searchFrom(tokenIn)
+
+	estimates := make([]types.SwapRouteEstimate, len(search.found))
+	for i, hops := range search.found {
+		estimates[i] = newSwapRouteEstimate(tokenIn, hops)
+	}
+	sort.SliceStable(estimates, func(i, j int) bool {
+		if !estimates[i].TokenOutAmount.Equal(estimates[j].TokenOutAmount) {
+			return estimates[i].TokenOutAmount.GT(estimates[j].TokenOutAmount)
+		}
+		return len(estimates[i].Hops) < len(estimates[j].Hops)
+	})
+	if len(estimates) > maxRoutes {
+		estimates = estimates[:maxRoutes]
+	}
+	return estimates, nil
+}
+
+// getRoutablePoolsByDenom returns the active pools of every type, by the
+// denoms they can swap, in the order of their IDs.
+func (k Keeper) getRoutablePoolsByDenom(ctx sdk.Context) (map[string][]routablePool, error) {
+	poolRoutes, err := k.GetAllPoolRoutes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	poolsByDenom := map[string][]routablePool{}
+	for _, poolRoute := range poolRoutes {
+		swapModule, pool, err := k.getPoolForSwap(ctx, poolRoute.PoolId)
+		if err != nil {
+			// inactive pools, and pools of types without a module, are not
+			// routed through.
+			continue
+		}
+
+		routable := routablePool{swapModule: swapModule, pool: pool, denoms: pool.GetPoolDenoms(ctx)}
+		for _, denom := range routable.denoms {
+			poolsByDenom[denom] = append(poolsByDenom[denom], routable)
+		}
+	}
+	return poolsByDenom, nil
+}
+
+// searchFrom extends the current route with every hop swapping tokenIn, and
+// records the routes reaching the out denom.
+func (s *routeSearch) searchFrom(tokenIn sdk.Coin) {
+	s.usedDenoms[tokenIn.Denom] = true
+	defer delete(s.usedDenoms, tokenIn.Denom)
+
+	for _, routable := range s.poolsByDenom[tokenIn.Denom] {
+		poolId := routable.pool.GetId()
+		if s.usedPools[poolId] {
+			continue
+		}
+
+		for _, denom := range routable.denoms {
+			if s.usedDenoms[denom] {
+				continue
+			}
+			hop, ok := s.estimateHop(routable, tokenIn, denom)
+			if !ok {
+				continue
+			}
+
+			s.hops = append(s.hops, hop)
+			if denom == s.tokenOutDenom {
+				s.found = append(s.found, append([]types.SwapHopEstimate{}, s.hops...))
+			} else if len(s.hops) < s.maxHops {
+				s.usedPools[poolId] = true
+				s.searchFrom(hop.TokenOut)
+				delete(s.usedPools, poolId)
+			}
+			s.hops = s.hops[:len(s.hops)-1]
+		}
+	}
+}
+
+// estimateHop returns what swapping tokenIn for tokenOutDenom in a pool would
+// give, or false if the swap isn't possible.
+func (s *routeSearch) estimateHop(routable routablePool, tokenIn sdk.Coin, tokenOutDenom string) (types.SwapHopEstimate, bool) {
+	pool := routable.pool
+	tokenOut, err := s.keeper.calcOutAmtGivenIn(s.ctx, routable.swapModule, pool, tokenIn, tokenOutDenom)
+	if err != nil || !tokenOut.IsPositive() {
+		return types.SwapHopEstimate{}, false
+	}
+
+	spotPrice, err := pool.SpotPrice(s.ctx, tokenIn.Denom, tokenOutDenom)
+	if err != nil || !spotPrice.IsPositive() {
+		return types.SwapHopEstimate{}, false
+	}
+
+	return types.SwapHopEstimate{
+		PoolId:    pool.GetId(),
+		TokenIn:   tokenIn,
+		TokenOut:  tokenOut,
+		SpotPrice: spotPrice,
+	}, true
+}
+
+// newSwapRouteEstimate prices a route found swapping tokenIn through hops.
+func newSwapRouteEstimate(tokenIn sdk.Coin, hops []types.SwapHopEstimate) types.SwapRouteEstimate {
+	tokenOutAmount := hops[len(hops)-1].TokenOut.Amount
+	spotPrice := sdk.OneDec()
+	for _, hop := range hops {
+		spotPrice = spotPrice.Mul(hop.SpotPrice)
+	}
+	effectivePrice := tokenIn.Amount.ToDec().Quo(tokenOutAmount.ToDec())
+
+	return types.SwapRouteEstimate{
+		Hops:           hops,
+		TokenOutAmount: tokenOutAmount,
+		EffectivePrice: effectivePrice,
+		SpotPrice:      spotPrice,
+		PriceImpact:    effectivePrice.Quo(spotPrice).Sub(sdk.OneDec()),
+	}
+}
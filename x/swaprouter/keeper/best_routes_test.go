@@ -0,0 +1,107 @@
+package keeper_test
+
+import (
+	gocontext "context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/swaprouter/types"
+)
+
+func (suite *KeeperTestSuite) TestFindBestRoutes() {
+	tests := []struct {
+		name              string
+		maxHops           int
+		maxRoutes         int
+		expectedPoolPaths [][]uint64
+	}{
+		{"direct and through the stableswap pool", 3, 3, [][]uint64{{1}, {1, 2}}},
+		{"single hop", 1, 3, [][]uint64{{1}}},
+		{"best route only", 3, 1, nil},
+	}
+
+	for _, test := range tests {
+		suite.Run(test.name, func() {
+			suite.SetupTest()
+			suite.prepareBalancerPool()
+			suite.prepareStableswapPool()
+			tokenIn := sdk.NewInt64Coin("foo", 1000)
+			poolBefore, err := suite.App.GAMMKeeper.GetPool(suite.Ctx, 1)
+			suite.Require().NoError(err)
+
+			routes, err := suite.App.SwapRouterKeeper.FindBestRoutes(suite.Ctx, tokenIn, "baz", test.maxHops, test.maxRoutes)
+			suite.Require().NoError(err)
+			if test.expectedPoolPaths != nil {
+				suite.Require().Len(routes, len(test.expectedPoolPaths))
+			} else {
+				suite.Require().Len(routes, test.maxRoutes)
+			}
+
+			for i, route := range routes {
+				if i > 0 {
+					suite.Require().True(route.TokenOutAmount.LTE(routes[i-1].TokenOutAmount))
+				}
+				poolPath := []uint64{}
+				for _, hop := range route.Hops {
+					poolPath = append(poolPath, hop.PoolId)
+				}
+				if test.expectedPoolPaths != nil {
+					suite.Require().Contains(test.expectedPoolPaths, poolPath)
+				}
+
+				// the estimate is what swapping through the route gives.
+				cacheCtx, _ := suite.Ctx.CacheContext()
+				tokenOutAmount, err := suite.App.SwapRouterKeeper.RouteExactAmountIn(cacheCtx, acc1, route.SwapAmountInRoutes(), tokenIn, sdk.OneInt())
+				suite.Require().NoError(err)
+				suite.Require().Equal(tokenOutAmount, route.TokenOutAmount)
+				suite.Require().Equal(tokenIn, route.Hops[0].TokenIn)
+				suite.Require().Equal("baz", route.Hops[len(route.Hops)-1].TokenOut.Denom)
+
+				// swapping a little costs about the 1% swap fee of each hop
+				// over the spot price.
+				minImpact := sdk.NewDecWithPrec(99, 4).MulInt64(int64(len(route.Hops)))
+				maxImpact := sdk.NewDecWithPrec(15, 3).MulInt64(int64(len(route.Hops)))
+				suite.Require().True(route.PriceImpact.GTE(minImpact), "price impact %s", route.PriceImpact)
+				suite.Require().True(route.PriceImpact.LTE(maxImpact), "price impact %s", route.PriceImpact)
+				suite.Require().Equal(tokenIn.Amount.ToDec().Quo(route.TokenOutAmount.ToDec()), route.EffectivePrice)
+			}
+
+			// searching doesn't change the pools.
+			poolAfter, err := suite.App.GAMMKeeper.GetPool(suite.Ctx, 1)
+			suite.Require().NoError(err)
+			suite.Require().Equal(poolBefore, poolAfter)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestFindBestRoutesNoRoute() {
+	suite.prepareStableswapPool()
+
+	// foo is in no pool.
+	routes, err := suite.App.SwapRouterKeeper.FindBestRoutes(suite.Ctx, sdk.NewInt64Coin("foo", 1000), "baz", 3, 3)
+	suite.Require().NoError(err)
+	suite.Require().Len(routes, 0)
+}
+
+func (suite *KeeperTestSuite) TestQueryEstimateBestRoutes() {
+	suite.prepareBalancerPool()
+	suite.prepareStableswapPool()
+
+	res, err := suite.queryClient.EstimateBestRoutes(gocontext.Background(), &types.QueryEstimateBestRoutesRequest{
+		TokenIn:       "1000foo",
+		TokenOutDenom: "baz",
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Routes, 2)
+
+	invalidRequests := []*types.QueryEstimateBestRoutesRequest{
+		{TokenIn: "", TokenOutDenom: "baz"},
+		{TokenIn: "1000foo", TokenOutDenom: "foo"},
+		{TokenIn: "1000foo", TokenOutDenom: "baz", MaxHops: types.MaxRouteHops + 1},
+		{TokenIn: "1000foo", TokenOutDenom: "baz", MaxRoutes: types.MaxRoutes + 1},
+	}
+	for _, req := range invalidRequests {
+		_, err := suite.queryClient.EstimateBestRoutes(gocontext.Background(), req)
+		suite.Require().Error(err)
+	}
+}
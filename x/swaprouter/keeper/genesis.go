@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/swaprouter/types"
+)
+
+// InitGenesis initializes the swaprouter module's state from a provided genesis
+// state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	if err := genState.Validate(); err != nil {
+		panic(err)
+	}
+
+	k.SetNextPoolId(ctx, genState.NextPoolId)
+	for _, route := range genState.PoolRoutes {
+		k.SetPoolRoute(ctx, route.PoolId, route.PoolType)
+	}
+}
+
+// ExportGenesis returns the swaprouter module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	routes, err := k.GetAllPoolRoutes(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return &types.GenesisState{
+		NextPoolId: k.GetNextPoolId(ctx),
+		PoolRoutes: routes,
+	}
+}
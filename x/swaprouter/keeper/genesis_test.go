@@ -0,0 +1,25 @@
+package keeper_test
+
+import (
+	"github.com/osmosis-labs/osmosis/v7/app"
+	"github.com/osmosis-labs/osmosis/v7/x/swaprouter/types"
+)
+
+func (suite *KeeperTestSuite) TestGenesisRoundTrip() {
+	suite.prepareBalancerPool()
+	suite.prepareStableswapPool()
+
+	genesis := suite.App.SwapRouterKeeper.ExportGenesis(suite.Ctx)
+	suite.Require().Equal(&types.GenesisState{
+		NextPoolId: 3,
+		PoolRoutes: []types.ModuleRoute{
+			{PoolType: types.Balancer, PoolId: 1},
+			{PoolType: types.Stableswap, PoolId: 2},
+		},
+	}, genesis)
+
+	newApp := app.Setup(false)
+	ctx := newApp.BaseApp.NewContext(false, suite.Ctx.BlockHeader())
+	newApp.SwapRouterKeeper.InitGenesis(ctx, genesis)
+	suite.Require().Equal(genesis, newApp.SwapRouterKeeper.ExportGenesis(ctx))
+}
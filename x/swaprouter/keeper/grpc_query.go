@@ -0,0 +1,189 @@
+package keeper
+
+import (
+	"context"
+	"math/big"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/swaprouter/types"
+)
+
+var sdkIntMaxValue = sdk.NewInt(0)
+
+func init() {
+	maxInt := big.NewInt(2)
+	maxInt = maxInt.Exp(maxInt, big.NewInt(256), nil)
+
+	_sdkIntMaxValue, ok := sdk.NewIntFromString(maxInt.Sub(maxInt, big.NewInt(1)).String())
+	if !ok {
+		panic("Failed to calculate the max value of sdk.Int")
+	}
+
+	sdkIntMaxValue = _sdkIntMaxValue
+}
+
+var _ types.QueryServer = Querier{}
+
+// Querier defines a wrapper around the x/swaprouter keeper providing gRPC
+// method handlers.
+type Querier struct {
+	Keeper
+}
+
+func NewQuerier(k Keeper) Querier {
+	return Querier{Keeper: k}
+}
+
+func (q Querier) NumPools(ctx context.Context, _ *types.QueryNumPoolsRequest) (*types.QueryNumPoolsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	return &types.QueryNumPoolsResponse{
+		NumPools: q.Keeper.GetNextPoolId(sdkCtx) - 1,
+	}, nil
+}
+
+func (q Querier) PoolType(ctx context.Context, req *types.QueryPoolTypeRequest) (*types.QueryPoolTypeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	poolType, err := q.Keeper.GetPoolType(sdkCtx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryPoolTypeResponse{PoolType: poolType}, nil
+}
+
+func (q Querier) EstimateSwapExactAmountIn(ctx context.Context, req *types.QueryEstimateSwapExactAmountInRequest) (*types.QueryEstimateSwapExactAmountInResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Sender == "" {
+		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+
+	if req.TokenIn == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid token")
+	}
+
+	if err := types.SwapAmountInRoutes(req.Routes).Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	tokenIn, err := sdk.ParseCoinNormalized(req.TokenIn)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token: %s", err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	tokenOutAmount, err := q.Keeper.RouteExactAmountIn(sdkCtx, sender, req.Routes, tokenIn, sdk.NewInt(1))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEstimateSwapExactAmountInResponse{
+		TokenOutAmount: tokenOutAmount,
+	}, nil
+}
+
+func (q Querier) EstimateSwapExactAmountOut(ctx context.Context, req *types.QueryEstimateSwapExactAmountOutRequest) (*types.QueryEstimateSwapExactAmountOutResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Sender == "" {
+		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+
+	if req.TokenOut == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid token")
+	}
+
+	if err := types.SwapAmountOutRoutes(req.Routes).Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	tokenOut, err := sdk.ParseCoinNormalized(req.TokenOut)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token: %s", err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	tokenInAmount, err := q.Keeper.RouteExactAmountOut(sdkCtx, sender, req.Routes, sdkIntMaxValue, tokenOut)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEstimateSwapExactAmountOutResponse{
+		TokenInAmount: tokenInAmount,
+	}, nil
+}
+
+func (q Querier) EstimateBestRoutes(ctx context.Context, req *types.QueryEstimateBestRoutesRequest) (*types.QueryEstimateBestRoutesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.TokenIn == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid token")
+	}
+
+	tokenIn, err := sdk.ParseCoinNormalized(req.TokenIn)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token: %s", err.Error())
+	}
+
+	if err := sdk.ValidateDenom(req.TokenOutDenom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token out denom: %s", err.Error())
+	}
+
+	if req.TokenOutDenom == tokenIn.Denom {
+		return nil, status.Error(codes.InvalidArgument, "token in and token out denoms must differ")
+	}
+
+	maxHops := int(req.MaxHops)
+	if maxHops == 0 {
+		maxHops = types.DefaultMaxRouteHops
+	}
+	if maxHops > types.MaxRouteHops {
+		return nil, status.Errorf(codes.InvalidArgument, "max hops cannot exceed %d", types.MaxRouteHops)
+	}
+
+	maxRoutes := int(req.MaxRoutes)
+	if maxRoutes == 0 {
+		maxRoutes = types.DefaultMaxRoutes
+	}
+	if maxRoutes > types.MaxRoutes {
+		return nil, status.Errorf(codes.InvalidArgument, "max routes cannot exceed %d", types.MaxRoutes)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	routes, err := q.Keeper.FindBestRoutes(sdkCtx, tokenIn, req.TokenOutDenom, maxHops, maxRoutes)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEstimateBestRoutesResponse{
+		Routes: routes,
+	}, nil
+}
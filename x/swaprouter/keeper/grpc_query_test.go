@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	gocontext "context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/swaprouter/types"
+)
+
+func (suite *KeeperTestSuite) TestQueryNumPools() {
+	res, err := suite.queryClient.NumPools(gocontext.Background(), &types.QueryNumPoolsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(0), res.NumPools)
+
+	suite.prepareBalancerPool()
+	suite.prepareStableswapPool()
+
+	res, err = suite.queryClient.NumPools(gocontext.Background(), &types.QueryNumPoolsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), res.NumPools)
+}
+
+func (suite *KeeperTestSuite) TestQueryPoolType() {
+	suite.prepareBalancerPool()
+	suite.prepareStableswapPool()
+
+	res, err := suite.queryClient.PoolType(gocontext.Background(), &types.QueryPoolTypeRequest{PoolId: 1})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.Balancer, res.PoolType)
+
+	res, err = suite.queryClient.PoolType(gocontext.Background(), &types.QueryPoolTypeRequest{PoolId: 2})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.Stableswap, res.PoolType)
+
+	_, err = suite.queryClient.PoolType(gocontext.Background(), &types.QueryPoolTypeRequest{PoolId: 3})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestQueryEstimateSwapExactAmountIn() {
+	suite.prepareBalancerPool()
+	suite.prepareStableswapPool()
+	routes := []types.SwapAmountInRoute{
+		{PoolId: 1, TokenOutDenom: "bar"},
+		{PoolId: 2, TokenOutDenom: "baz"},
+	}
+	tokenIn := sdk.NewInt64Coin("foo", 100000)
+
+	cacheCtx, _ := suite.Ctx.CacheContext()
+	expectedOut, err := suite.App.SwapRouterKeeper.RouteExactAmountIn(cacheCtx, acc1, routes, tokenIn, sdk.NewInt(1))
+	suite.Require().NoError(err)
+
+	res, err := suite.queryClient.EstimateSwapExactAmountIn(gocontext.Background(), &types.QueryEstimateSwapExactAmountInRequest{
+		Sender:  acc1.String(),
+		TokenIn: tokenIn.String(),
+		Routes:  routes,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedOut, res.TokenOutAmount)
+
+	_, err = suite.queryClient.EstimateSwapExactAmountIn(gocontext.Background(), &types.QueryEstimateSwapExactAmountInRequest{
+		Sender:  acc1.String(),
+		TokenIn: tokenIn.String(),
+	})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestQueryEstimateSwapExactAmountOut() {
+	suite.prepareBalancerPool()
+	suite.prepareStableswapPool()
+	routes := []types.SwapAmountOutRoute{
+		{PoolId: 1, TokenInDenom: "foo"},
+		{PoolId: 2, TokenInDenom: "bar"},
+	}
+	tokenOut := sdk.NewInt64Coin("baz", 100000)
+
+	cacheCtx, _ := suite.Ctx.CacheContext()
+	expectedIn, err := suite.App.SwapRouterKeeper.RouteExactAmountOut(cacheCtx, acc1, routes, sdk.NewInt(90000000), tokenOut)
+	suite.Require().NoError(err)
+
+	res, err := suite.queryClient.EstimateSwapExactAmountOut(gocontext.Background(), &types.QueryEstimateSwapExactAmountOutRequest{
+		Sender:   acc1.String(),
+		Routes:   routes,
+		TokenOut: tokenOut.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedIn, res.TokenInAmount)
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/swaprouter/types"
+)
+
+// Keeper routes swaps to the modules owning the pools they go through, and
+// assigns pool IDs to the pools of every module.
+type Keeper struct {
+	storeKey sdk.StoreKey
+
+	// routes maps each pool type to the module that owns pools of that type.
+	routes map[types.PoolType]types.SwapI
+
+	takerFeeKeeper types.TakerFeeKeeper
+
+	hooks types.SwapRouterHooks
+}
+
+// NewKeeper returns an instance of Keeper, routing swaps in gamm pools to
+// gammKeeper and swaps in concentrated-liquidity pools to
+// concentratedKeeper, and charging every hop takerFeeKeeper's taker fee.
+func NewKeeper(storeKey sdk.StoreKey, gammKeeper types.SwapI, concentratedKeeper types.SwapI, takerFeeKeeper types.TakerFeeKeeper) *Keeper {
+	routes := map[types.PoolType]types.SwapI{
+		types.Balancer:     gammKeeper,
+		types.Stableswap:   gammKeeper,
+		types.Concentrated: concentratedKeeper,
+	}
+
+	return &Keeper{
+		storeKey:       storeKey,
+		routes:         routes,
+		takerFeeKeeper: takerFeeKeeper,
+	}
+}
+
+// SetHooks sets the swap router hooks.
+func (k *Keeper) SetHooks(hooks types.SwapRouterHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set swaprouter hooks twice")
+	}
+
+	k.hooks = hooks
+
+	return k
+}
+
+// Logger returns a logger instance.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/app"
+	"github.com/osmosis-labs/osmosis/v7/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/stableswap"
+	gammtypes "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v7/x/swaprouter/keeper"
+	"github.com/osmosis-labs/osmosis/v7/x/swaprouter/types"
+)
+
+var acc1 = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address().Bytes())
+
+type KeeperTestSuite struct {
+	apptesting.KeeperTestHelper
+
+	queryClient types.QueryClient
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.App = app.Setup(false)
+	suite.Ctx = suite.App.BaseApp.NewContext(false, tmproto.Header{})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.Ctx, suite.App.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, keeper.NewQuerier(*suite.App.SwapRouterKeeper))
+	suite.queryClient = types.NewQueryClient(queryHelper)
+
+	suite.App.GAMMKeeper.SetParams(suite.Ctx, gammtypes.NewParams(sdk.Coins{}))
+	err := simapp.FundAccount(suite.App.BankKeeper, suite.Ctx, acc1, sdk.NewCoins(
+		sdk.NewInt64Coin("foo", 100000000),
+		sdk.NewInt64Coin("bar", 100000000),
+		sdk.NewInt64Coin("baz", 100000000),
+	))
+	suite.Require().NoError(err)
+}
+
+// prepareBalancerPool creates a balancer pool of foo, bar and baz with a 1% swap fee.
+func (suite *KeeperTestSuite) prepareBalancerPool() uint64 {
+	poolAssets := []balancer.PoolAsset{
+		{Weight: sdk.NewInt(100), Token: sdk.NewInt64Coin("foo", 5000000)},
+		{Weight: sdk.NewInt(200), Token: sdk.NewInt64Coin("bar", 5000000)},
+		{Weight: sdk.NewInt(300), Token: sdk.NewInt64Coin("baz", 5000000)},
+	}
+	poolParams := balancer.PoolParams{SwapFee: sdk.NewDecWithPrec(1, 2), ExitFee: sdk.ZeroDec()}
+	poolId, err := suite.App.GAMMKeeper.CreatePool(suite.Ctx, balancer.NewMsgCreateBalancerPool(acc1, poolParams, poolAssets, ""))
+	suite.Require().NoError(err)
+	return poolId
+}
+
+// prepareStableswapPool creates a stableswap pool of bar and baz with a 1% swap fee.
+func (suite *KeeperTestSuite) prepareStableswapPool() uint64 {
+	liquidity := sdk.NewCoins(sdk.NewInt64Coin("bar", 1000000), sdk.NewInt64Coin("baz", 1000000))
+	poolParams := stableswap.PoolParams{SwapFee: sdk.NewDecWithPrec(1, 2), ExitFee: sdk.ZeroDec()}
+	poolId, err := suite.App.GAMMKeeper.CreatePool(suite.Ctx, stableswap.NewMsgCreateStableswapPool(acc1, poolParams, liquidity, nil, ""))
+	suite.Require().NoError(err)
+	return poolId
+}
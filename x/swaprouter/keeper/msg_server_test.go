@@ -0,0 +1,44 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/swaprouter/keeper"
+	"github.com/osmosis-labs/osmosis/v7/x/swaprouter/types"
+)
+
+func (suite *KeeperTestSuite) TestMsgSwapExactAmountIn() {
+	suite.prepareBalancerPool()
+	msgServer := keeper.NewMsgServerImpl(suite.App.SwapRouterKeeper)
+
+	balanceBefore := suite.App.BankKeeper.GetAllBalances(suite.Ctx, acc1)
+	res, err := msgServer.SwapExactAmountIn(sdk.WrapSDKContext(suite.Ctx), &types.MsgSwapExactAmountIn{
+		Sender:            acc1.String(),
+		Routes:            []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "bar"}},
+		TokenIn:           sdk.NewInt64Coin("foo", 100000),
+		TokenOutMinAmount: sdk.NewInt(1),
+	})
+	suite.Require().NoError(err)
+
+	balanceAfter := suite.App.BankKeeper.GetAllBalances(suite.Ctx, acc1)
+	suite.Require().Equal(balanceBefore.AmountOf("foo").SubRaw(100000), balanceAfter.AmountOf("foo"))
+	suite.Require().Equal(balanceBefore.AmountOf("bar").Add(res.TokenOutAmount), balanceAfter.AmountOf("bar"))
+}
+
+func (suite *KeeperTestSuite) TestMsgSwapExactAmountOut() {
+	suite.prepareBalancerPool()
+	msgServer := keeper.NewMsgServerImpl(suite.App.SwapRouterKeeper)
+
+	balanceBefore := suite.App.BankKeeper.GetAllBalances(suite.Ctx, acc1)
+	res, err := msgServer.SwapExactAmountOut(sdk.WrapSDKContext(suite.Ctx), &types.MsgSwapExactAmountOut{
+		Sender:           acc1.String(),
+		Routes:           []types.SwapAmountOutRoute{{PoolId: 1, TokenInDenom: "foo"}},
+		TokenInMaxAmount: sdk.NewInt(1000000),
+		TokenOut:         sdk.NewInt64Coin("bar", 100000),
+	})
+	suite.Require().NoError(err)
+
+	balanceAfter := suite.App.BankKeeper.GetAllBalances(suite.Ctx, acc1)
+	suite.Require().Equal(balanceBefore.AmountOf("foo").Sub(res.TokenInAmount), balanceAfter.AmountOf("foo"))
+	suite.Require().Equal(balanceBefore.AmountOf("bar").AddRaw(100000), balanceAfter.AmountOf("bar"))
+}
@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v7/x/swaprouter/types"
+)
+
+// GetNextPoolId returns the ID that the next created pool gets.
+func (k Keeper) GetNextPoolId(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyNextGlobalPoolId)
+	if bz == nil {
+		panic(fmt.Errorf("next pool id has not been initialized -- Should have been done in InitGenesis"))
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextPoolId sets the ID that the next created pool gets.
+func (k Keeper) SetNextPoolId(ctx sdk.Context, poolId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyNextGlobalPoolId, sdk.Uint64ToBigEndian(poolId))
+}
+
+// GetNextPoolIdAndIncrement returns the next pool ID, and increments the
+// corresponding state entry. Every module creating pools gets their IDs from
+// here, so that pool IDs are unique across pool types.
+func (k Keeper) GetNextPoolIdAndIncrement(ctx sdk.Context) uint64 {
+	poolId := k.GetNextPoolId(ctx)
+	k.SetNextPoolId(ctx, poolId+1)
+	return poolId
+}
+
+// SetPoolRoute records the type of a pool, so that swaps in it are routed to
+// the module owning pools of that type. Modules creating pools must call it
+// for every pool they create.
+func (k Keeper) SetPoolRoute(ctx sdk.Context, poolId uint64, poolType types.PoolType) {
+	store := ctx.KVStore(k.storeKey)
+	route := types.ModuleRoute{PoolType: poolType, PoolId: poolId}
+	bz, err := proto.Marshal(&route)
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.FormatModuleRouteKey(poolId), bz)
+}
+
+// DeletePoolRoute forgets the type of a pool. Modules deleting pools must call
+// it for every pool they delete, so that no swap is routed to them.
+func (k Keeper) DeletePoolRoute(ctx sdk.Context, poolId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.FormatModuleRouteKey(poolId))
+}
+
+// GetPoolType returns the type of a pool.
+func (k Keeper) GetPoolType(ctx sdk.Context, poolId uint64) (types.PoolType, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.FormatModuleRouteKey(poolId))
+	if bz == nil {
+		return 0, sdkerrors.Wrapf(types.ErrPoolRouteNotFound, "pool %d", poolId)
+	}
+
+	route := types.ModuleRoute{}
+	if err := proto.Unmarshal(bz, &route); err != nil {
+		return 0, err
+	}
+	return route.PoolType, nil
+}
+
+// GetAllPoolRoutes returns the module routes of every pool, by pool ID.
+func (k Keeper) GetAllPoolRoutes(ctx sdk.Context) ([]types.ModuleRoute, error) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.KeyPrefixPoolRoutes)
+	defer iter.Close()
+
+	routes := []types.ModuleRoute{}
+	for ; iter.Valid(); iter.Next() {
+		route := types.ModuleRoute{}
+		if err := proto.Unmarshal(iter.Value(), &route); err != nil {
+			return nil, err
+		}
+		routes = append(routes, route)
+	}
+	return routes, nil
+}
+
+// GetPoolModule returns the module owning a pool.
+func (k Keeper) GetPoolModule(ctx sdk.Context, poolId uint64) (types.SwapI, error) {
+	poolType, err := k.GetPoolType(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+
+	swapModule, ok := k.routes[poolType]
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrNoPoolModule, "pool %d has type %s", poolId, poolType)
+	}
+	return swapModule, nil
+}
+
+// getPoolForSwap returns a pool and the module owning it, and checks that the
+// pool is active.
+func (k Keeper) getPoolForSwap(ctx sdk.Context, poolId uint64) (types.SwapI, types.PoolI, error) {
+	swapModule, err := k.GetPoolModule(ctx, poolId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pool, err := swapModule.GetPool(ctx, poolId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !pool.IsActive(ctx) {
+		return nil, nil, sdkerrors.Wrapf(types.ErrPoolLocked, "swap on inactive pool %d", poolId)
+	}
+	return swapModule, pool, nil
+}
@@ -0,0 +1,46 @@
+package keeper_test
+
+import (
+	"github.com/osmosis-labs/osmosis/v7/x/swaprouter/types"
+)
+
+func (suite *KeeperTestSuite) TestPoolIdsAreSharedAcrossPoolTypes() {
+	suite.Require().Equal(uint64(1), suite.App.SwapRouterKeeper.GetNextPoolId(suite.Ctx))
+
+	balancerPoolId := suite.prepareBalancerPool()
+	stableswapPoolId := suite.prepareStableswapPool()
+	suite.Require().Equal(uint64(1), balancerPoolId)
+	suite.Require().Equal(uint64(2), stableswapPoolId)
+	suite.Require().Equal(uint64(3), suite.App.SwapRouterKeeper.GetNextPoolId(suite.Ctx))
+
+	poolType, err := suite.App.SwapRouterKeeper.GetPoolType(suite.Ctx, balancerPoolId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.Balancer, poolType)
+
+	poolType, err = suite.App.SwapRouterKeeper.GetPoolType(suite.Ctx, stableswapPoolId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.Stableswap, poolType)
+
+	routes, err := suite.App.SwapRouterKeeper.GetAllPoolRoutes(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.ModuleRoute{
+		{PoolType: types.Balancer, PoolId: balancerPoolId},
+		{PoolType: types.Stableswap, PoolId: stableswapPoolId},
+	}, routes)
+}
+
+func (suite *KeeperTestSuite) TestGetPoolModule() {
+	poolId := suite.prepareBalancerPool()
+
+	swapModule, err := suite.App.SwapRouterKeeper.GetPoolModule(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.App.GAMMKeeper, swapModule)
+
+	_, err = suite.App.SwapRouterKeeper.GetPoolModule(suite.Ctx, poolId+1)
+	suite.Require().ErrorIs(err, types.ErrPoolRouteNotFound)
+
+	// a pool type no module has registered for.
+	suite.App.SwapRouterKeeper.SetPoolRoute(suite.Ctx, poolId+1, types.PoolType(100))
+	_, err = suite.App.SwapRouterKeeper.GetPoolModule(suite.Ctx, poolId+1)
+	suite.Require().ErrorIs(err, types.ErrNoPoolModule)
+}
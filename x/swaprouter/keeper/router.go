@@ -0,0 +1,194 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v7/x/swaprouter/types"
+)
+
+// RouteExactAmountIn defines the input denom and input amount for the first pool,
+// the output of the first pool is chained as the input for the next routed pool
+// transaction succeeds when final amount out is greater than tokenOutMinAmount defined.
+//
+// Each hop is swapped by the module owning its pool, with the pool's swap fee,
+// and is charged the taker fee.
+func (k Keeper) RouteExactAmountIn(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	routes []types.SwapAmountInRoute,
+	tokenIn sdk.Coin,
+	tokenOutMinAmount sdk.Int,
+) (tokenOutAmount sdk.Int, err error) {
+	for i, route := range routes {
+		_outMinAmount := sdk.NewInt(1)
+		if len(routes)-1 == i {
+			_outMinAmount = tokenOutMinAmount
+		}
+
+		tokenOutAmount, err = k.SwapExactAmountIn(ctx, sender, route.PoolId, tokenIn, route.TokenOutDenom, _outMinAmount)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		tokenIn = sdk.NewCoin(route.TokenOutDenom, tokenOutAmount)
+	}
+	return tokenOutAmount, nil
+}
+
+// RouteExactAmountOut defines the output denom and output amount for the last pool.
+// Calculation starts by providing the tokenOutAmount of the final pool to calculate the required tokenInAmount
+// the calculated tokenInAmount is used as defined tokenOutAmount of the previous pool, calculating in reverse order of the swap
+// Transaction succeeds if the calculated tokenInAmount of the first pool is less than the defined tokenInMaxAmount defined.
+//
+// Each hop is swapped by the module owning its pool, with the pool's swap fee,
+// and is charged the taker fee.
+func (k Keeper) RouteExactAmountOut(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	routes []types.SwapAmountOutRoute,
+	tokenInMaxAmount sdk.Int,
+	tokenOut sdk.Coin,
+) (tokenInAmount sdk.Int, err error) {
+	insExpected, err := k.createMultihopExpectedSwapOuts(ctx, routes, tokenOut)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	insExpected[0] = tokenInMaxAmount
+
+	for i, route := range routes {
+		_tokenOut := tokenOut
+		if i != len(routes)-1 {
+			_tokenOut = sdk.NewCoin(routes[i+1].TokenInDenom, insExpected[i+1])
+		}
+
+		_tokenInAmount, err := k.swapExactAmountOut(ctx, sender, route.PoolId, route.TokenInDenom, insExpected[i], _tokenOut)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+
+		if i == 0 {
+			tokenInAmount = _tokenInAmount
+		}
+	}
+
+	return tokenInAmount, nil
+}
+
+// createMultihopExpectedSwapOuts returns the amount of tokens that has to go
+// into each hop of an exact amount out swap, taker fee included, for tokenOut
+// to come out of the last one. It goes through the hops backwards, since the amount going into a
+// hop is what has to come out of the hop before it.
+func (k Keeper) createMultihopExpectedSwapOuts(ctx sdk.Context, routes []types.SwapAmountOutRoute, tokenOut sdk.Coin) ([]sdk.Int, error) {
+	insExpected := make([]sdk.Int, len(routes))
+	for i := len(routes) - 1; i >= 0; i-- {
+		route := routes[i]
+
+		swapModule, pool, err := k.getPoolForSwap(ctx, route.PoolId)
+		if err != nil {
+			return nil, err
+		}
+
+		tokenIn, err := swapModule.CalcInAmtGivenOut(ctx, pool, tokenOut, route.TokenInDenom, pool.GetSwapFee(ctx))
+		if err != nil {
+			return nil, err
+		}
+		tokenIn = tokenIn.Add(k.takerFeeKeeper.TakerFeeForAmountOut(ctx, tokenIn, tokenOut.Denom))
+
+		insExpected[i] = tokenIn.Amount
+		tokenOut = tokenIn
+	}
+
+	return insExpected, nil
+}
+
+// SwapExactAmountIn swaps tokenIn for tokenOutDenom in a single pool, and
+// fails if less than tokenOutMinAmount comes out. The taker fee is taken out of
+// tokenIn before the module owning the pool swaps the rest, with the pool's
+// swap fee.
+func (k Keeper) SwapExactAmountIn(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolId uint64,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	tokenOutMinAmount sdk.Int,
+) (tokenOutAmount sdk.Int, err error) {
+	swapModule, pool, err := k.getPoolForSwap(ctx, poolId)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	takerFee := k.takerFeeKeeper.TakerFeeForAmountIn(ctx, tokenIn, tokenOutDenom)
+	poolTokenIn := tokenIn.Sub(takerFee)
+	swapFee := pool.GetSwapFee(ctx)
+	tokenOutAmount, err = swapModule.SwapExactAmountIn(ctx, sender, pool, poolTokenIn, tokenOutDenom, tokenOutMinAmount, swapFee)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	if err := k.takerFeeKeeper.ChargeTakerFee(ctx, sender, poolId, takerFee); err != nil {
+		return sdk.Int{}, err
+	}
+	k.afterSwap(ctx, sender, poolId, poolTokenIn, sdk.NewCoin(tokenOutDenom, tokenOutAmount), swapFee)
+	return tokenOutAmount, nil
+}
+
+// swapExactAmountOut swaps tokenInDenom for tokenOut in a single pool, and
+// fails if more than tokenInMaxAmount goes in, taker fee included. The taker
+// fee is taken on top of what the module owning the pool swaps in, with the
+// pool's swap fee.
+func (k Keeper) swapExactAmountOut(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolId uint64,
+	tokenInDenom string,
+	tokenInMaxAmount sdk.Int,
+	tokenOut sdk.Coin,
+) (tokenInAmount sdk.Int, err error) {
+	swapModule, pool, err := k.getPoolForSwap(ctx, poolId)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	swapFee := pool.GetSwapFee(ctx)
+	poolTokenInAmount, err := swapModule.SwapExactAmountOut(ctx, sender, pool, tokenInDenom, tokenInMaxAmount, tokenOut, swapFee)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	takerFee := k.takerFeeKeeper.TakerFeeForAmountOut(ctx, sdk.NewCoin(tokenInDenom, poolTokenInAmount), tokenOut.Denom)
+	tokenInAmount = poolTokenInAmount.Add(takerFee.Amount)
+	if tokenInAmount.GT(tokenInMaxAmount) {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrLimitMaxAmount, "%s token required is larger than max amount", tokenInDenom)
+	}
+	if err := k.takerFeeKeeper.ChargeTakerFee(ctx, sender, poolId, takerFee); err != nil {
+		return sdk.Int{}, err
+	}
+	k.afterSwap(ctx, sender, poolId, sdk.NewCoin(tokenInDenom, poolTokenInAmount), tokenOut, swapFee)
+	return tokenInAmount, nil
+}
+
+// afterSwap calls the AfterSwap hook, if hooks are set, with what went in and
+// out of the pool, taker fee excluded.
+func (k Keeper) afterSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, tokenIn sdk.Coin, tokenOut sdk.Coin, swapFee sdk.Dec) {
+	if k.hooks != nil {
+		k.hooks.AfterSwap(ctx, sender, poolId, sdk.Coins{tokenIn}, sdk.Coins{tokenOut}, swapFee)
+	}
+}
+
+// CalcOutAmtGivenIn returns the amount of tokens SwapExactAmountIn would
+// return on these arguments, taker fee included, without changing the pool.
+func (k Keeper) CalcOutAmtGivenIn(ctx sdk.Context, poolId uint64, tokenIn sdk.Coin, tokenOutDenom string) (sdk.Coin, error) {
+	swapModule, pool, err := k.getPoolForSwap(ctx, poolId)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	return k.calcOutAmtGivenIn(ctx, swapModule, pool, tokenIn, tokenOutDenom)
+}
+
+// calcOutAmtGivenIn returns what swapping tokenIn for tokenOutDenom in a pool
+// would give, with the pool's swap fee and the taker fee.
+func (k Keeper) calcOutAmtGivenIn(ctx sdk.Context, swapModule types.SwapI, pool types.PoolI, tokenIn sdk.Coin, tokenOutDenom string) (sdk.Coin, error) {
+	tokenIn = tokenIn.Sub(k.takerFeeKeeper.TakerFeeForAmountIn(ctx, tokenIn, tokenOutDenom))
+	return swapModule.CalcOutAmtGivenIn(ctx, pool, tokenIn, tokenOutDenom, pool.GetSwapFee(ctx))
+}
@@ -0,0 +1,212 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/swaprouter/types"
+)
+
+// chainedSpotPrice returns the product of the spot prices of tokenInDenom in
+// the denoms on the path, across the pools on the path.
+func (suite *KeeperTestSuite) chainedSpotPrice(poolIds []uint64, denomsOnPath []string) sdk.Dec {
+	dec := sdk.OneDec()
+	for i, poolId := range poolIds {
+		pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+		suite.Require().NoError(err)
+
+		sp, err := pool.SpotPrice(suite.Ctx, denomsOnPath[i], denomsOnPath[i+1])
+		suite.Require().NoError(err)
+		dec = dec.Mul(sp)
+	}
+	return dec
+}
+
+func (suite *KeeperTestSuite) TestRouteExactAmountIn() {
+	tests := []struct {
+		name              string
+		stableswapHop     bool
+		routes            []types.SwapAmountInRoute
+		tokenIn           sdk.Coin
+		tokenOutMinAmount sdk.Int
+		expectedErr       error
+	}{
+		{
+			name: "foo -> bar (pool 1), bar -> baz (pool 2)",
+			routes: []types.SwapAmountInRoute{
+				{PoolId: 1, TokenOutDenom: "bar"},
+				{PoolId: 2, TokenOutDenom: "baz"},
+			},
+			tokenIn:           sdk.NewInt64Coin("foo", 100000),
+			tokenOutMinAmount: sdk.NewInt(1),
+		},
+		{
+			name:          "foo -> bar (balancer pool 1), bar -> baz (stableswap pool 2)",
+			stableswapHop: true,
+			routes: []types.SwapAmountInRoute{
+				{PoolId: 1, TokenOutDenom: "bar"},
+				{PoolId: 2, TokenOutDenom: "baz"},
+			},
+			tokenIn:           sdk.NewInt64Coin("foo", 100000),
+			tokenOutMinAmount: sdk.NewInt(1),
+		},
+		{
+			name: "unknown pool",
+			routes: []types.SwapAmountInRoute{
+				{PoolId: 1, TokenOutDenom: "bar"},
+				{PoolId: 3, TokenOutDenom: "baz"},
+			},
+			tokenIn:           sdk.NewInt64Coin("foo", 100000),
+			tokenOutMinAmount: sdk.NewInt(1),
+			expectedErr:       types.ErrPoolRouteNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		suite.Run(test.name, func() {
+			suite.SetupTest()
+			suite.prepareBalancerPool()
+			if test.stableswapHop {
+				suite.prepareStableswapPool()
+			} else {
+				suite.prepareBalancerPool()
+			}
+
+			if test.expectedErr != nil {
+				_, err := suite.App.SwapRouterKeeper.RouteExactAmountIn(suite.Ctx, acc1, test.routes, test.tokenIn, test.tokenOutMinAmount)
+				suite.Require().ErrorIs(err, test.expectedErr)
+				return
+			}
+
+			// swapping hop by hop in the pools directly must give the same result.
+			cacheCtx, _ := suite.Ctx.CacheContext()
+			expectedOut := test.tokenIn
+			for _, route := range test.routes {
+				pool, err := suite.App.GAMMKeeper.GetPool(cacheCtx, route.PoolId)
+				suite.Require().NoError(err)
+				outAmount, err := suite.App.GAMMKeeper.SwapExactAmountIn(cacheCtx, acc1, pool, expectedOut, route.TokenOutDenom, sdk.OneInt(), pool.GetSwapFee(cacheCtx))
+				suite.Require().NoError(err)
+				expectedOut = sdk.NewCoin(route.TokenOutDenom, outAmount)
+			}
+
+			poolIds := []uint64{test.routes[0].PoolId, test.routes[1].PoolId}
+			denomsOnPath := []string{test.tokenIn.Denom, test.routes[0].TokenOutDenom, test.routes[1].TokenOutDenom}
+			spotPriceBefore := suite.chainedSpotPrice(poolIds, denomsOnPath)
+
+			tokenOutAmount, err := suite.App.SwapRouterKeeper.RouteExactAmountIn(suite.Ctx, acc1, test.routes, test.tokenIn, test.tokenOutMinAmount)
+			suite.Require().NoError(err)
+			suite.Require().Equal(expectedOut.Amount, tokenOutAmount)
+			if test.stableswapHop {
+				// the swap fee outweighs the price impact of the stableswap hop,
+				// so the trade price can be past the price after the swap.
+				return
+			}
+
+			spotPriceAfter := suite.chainedSpotPrice(poolIds, denomsOnPath)
+
+			// Ratio of the token out should be between the before spot price and after spot price.
+			sp := test.tokenIn.Amount.ToDec().Quo(tokenOutAmount.ToDec())
+			suite.Require().True(sp.GT(spotPriceBefore) && sp.LT(spotPriceAfter), "before %s, actual %s, after %s", spotPriceBefore, sp, spotPriceAfter)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRouteExactAmountOut() {
+	tests := []struct {
+		name             string
+		stableswapHop    bool
+		routes           []types.SwapAmountOutRoute
+		tokenInMaxAmount sdk.Int
+		tokenOut         sdk.Coin
+		expectedErr      error
+	}{
+		{
+			name: "foo -> bar (pool 1), bar -> baz (pool 2)",
+			routes: []types.SwapAmountOutRoute{
+				{PoolId: 1, TokenInDenom: "foo"},
+				{PoolId: 2, TokenInDenom: "bar"},
+			},
+			tokenInMaxAmount: sdk.NewInt(90000000),
+			tokenOut:         sdk.NewInt64Coin("baz", 100000),
+		},
+		{
+			name:          "foo -> bar (balancer pool 1), bar -> baz (stableswap pool 2)",
+			stableswapHop: true,
+			routes: []types.SwapAmountOutRoute{
+				{PoolId: 1, TokenInDenom: "foo"},
+				{PoolId: 2, TokenInDenom: "bar"},
+			},
+			tokenInMaxAmount: sdk.NewInt(90000000),
+			tokenOut:         sdk.NewInt64Coin("baz", 100000),
+		},
+		{
+			name: "unknown pool",
+			routes: []types.SwapAmountOutRoute{
+				{PoolId: 3, TokenInDenom: "foo"},
+				{PoolId: 2, TokenInDenom: "bar"},
+			},
+			tokenInMaxAmount: sdk.NewInt(90000000),
+			tokenOut:         sdk.NewInt64Coin("baz", 100000),
+			expectedErr:      types.ErrPoolRouteNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		suite.Run(test.name, func() {
+			suite.SetupTest()
+			suite.prepareBalancerPool()
+			if test.stableswapHop {
+				suite.prepareStableswapPool()
+			} else {
+				suite.prepareBalancerPool()
+			}
+
+			if test.expectedErr != nil {
+				_, err := suite.App.SwapRouterKeeper.RouteExactAmountOut(suite.Ctx, acc1, test.routes, test.tokenInMaxAmount, test.tokenOut)
+				suite.Require().ErrorIs(err, test.expectedErr)
+				return
+			}
+
+			poolIds := []uint64{test.routes[0].PoolId, test.routes[1].PoolId}
+			denomsOnPath := []string{test.routes[0].TokenInDenom, test.routes[1].TokenInDenom, test.tokenOut.Denom}
+			spotPriceBefore := suite.chainedSpotPrice(poolIds, denomsOnPath)
+
+			tokenInAmount, err := suite.App.SwapRouterKeeper.RouteExactAmountOut(suite.Ctx, acc1, test.routes, test.tokenInMaxAmount, test.tokenOut)
+			suite.Require().NoError(err)
+			if test.stableswapHop {
+				// the swap fee outweighs the price impact of the stableswap hop,
+				// so the trade price can be past the price after the swap.
+				suite.Require().True(tokenInAmount.ToDec().Quo(test.tokenOut.Amount.ToDec()).GT(spotPriceBefore))
+				return
+			}
+
+			spotPriceAfter := suite.chainedSpotPrice(poolIds, denomsOnPath)
+
+			// Ratio of the token in should be between the before spot price and after spot price.
+			// This is because the swap increases the spot price
+			sp := tokenInAmount.ToDec().Quo(test.tokenOut.Amount.ToDec())
+			suite.Require().True(sp.GT(spotPriceBefore) && sp.LT(spotPriceAfter), "before %s, actual %s, after %s", spotPriceBefore, sp, spotPriceAfter)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRouteChargesPoolSwapFee() {
+	poolId := suite.prepareBalancerPool()
+	tokenIn := sdk.NewInt64Coin("foo", 100000)
+
+	pool, err := suite.App.GAMMKeeper.GetPool(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	expectedOut, err := suite.App.GAMMKeeper.CalcOutAmtGivenIn(suite.Ctx, pool, tokenIn, "bar", pool.GetSwapFee(suite.Ctx))
+	suite.Require().NoError(err)
+	outWithoutFee, err := suite.App.GAMMKeeper.CalcOutAmtGivenIn(suite.Ctx, pool, tokenIn, "bar", sdk.ZeroDec())
+	suite.Require().NoError(err)
+	suite.Require().True(expectedOut.Amount.LT(outWithoutFee.Amount))
+
+	routes := []types.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: "bar"}}
+	tokenOutAmount, err := suite.App.SwapRouterKeeper.RouteExactAmountIn(suite.Ctx, acc1, routes, tokenIn, sdk.NewInt(1))
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedOut.Amount, tokenOutAmount)
+
+	// a minimum above what the route returns fails the swap.
+	_, err = suite.App.SwapRouterKeeper.RouteExactAmountIn(suite.Ctx, acc1, routes, tokenIn, outWithoutFee.Amount)
+	suite.Require().Error(err)
+}
@@ -0,0 +1,13 @@
+package types
+
+import sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+// x/swaprouter module sentinel errors.
+var (
+	ErrEmptyRoutes         = sdkerrors.Register(ModuleName, 2, "routes not defined")
+	ErrPoolRouteNotFound   = sdkerrors.Register(ModuleName, 3, "pool route not found")
+	ErrNoPoolModule        = sdkerrors.Register(ModuleName, 4, "no module registered for pool type")
+	ErrPoolLocked          = sdkerrors.Register(ModuleName, 5, "pool is locked")
+	ErrNotPositiveCriteria = sdkerrors.Register(ModuleName, 6, "min out amount or max in amount should be positive")
+	ErrLimitMaxAmount      = sdkerrors.Register(ModuleName, 7, "calculated amount is larger than max amount")
+)
@@ -0,0 +1,73 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SwapI defines the interface that the module owning a pool type implements,
+// for the swap router to route swaps in pools of that type to it.
+//
+// The swap router is responsible for finding the pool and checking that it is
+// active, and for the swap fee the swap is charged. The module is responsible
+// for the swap itself: updating the pool and moving the tokens.
+type SwapI interface {
+	// GetPool returns the pool with the given ID.
+	GetPool(ctx sdk.Context, poolId uint64) (PoolI, error)
+
+	// SwapExactAmountIn swaps tokenIn in the pool for tokenOutDenom, charging
+	// swapFee, and fails if less than tokenOutMinAmount comes out.
+	SwapExactAmountIn(
+		ctx sdk.Context,
+		sender sdk.AccAddress,
+		pool PoolI,
+		tokenIn sdk.Coin,
+		tokenOutDenom string,
+		tokenOutMinAmount sdk.Int,
+		swapFee sdk.Dec,
+	) (tokenOutAmount sdk.Int, err error)
+	// CalcOutAmtGivenIn returns how many tokens SwapExactAmountIn would return
+	// on these arguments, without changing the pool.
+	CalcOutAmtGivenIn(
+		ctx sdk.Context,
+		pool PoolI,
+		tokenIn sdk.Coin,
+		tokenOutDenom string,
+		swapFee sdk.Dec,
+	) (tokenOut sdk.Coin, err error)
+
+	// SwapExactAmountOut swaps tokenInDenom in the pool for tokenOut,
+	// charging swapFee, and fails if more than tokenInMaxAmount goes in.
+	SwapExactAmountOut(
+		ctx sdk.Context,
+		sender sdk.AccAddress,
+		pool PoolI,
+		tokenInDenom string,
+		tokenInMaxAmount sdk.Int,
+		tokenOut sdk.Coin,
+		swapFee sdk.Dec,
+	) (tokenInAmount sdk.Int, err error)
+	// CalcInAmtGivenOut returns how many tokens SwapExactAmountOut would take
+	// on these arguments, without changing the pool.
+	CalcInAmtGivenOut(
+		ctx sdk.Context,
+		pool PoolI,
+		tokenOut sdk.Coin,
+		tokenInDenom string,
+		swapFee sdk.Dec,
+	) (tokenIn sdk.Coin, err error)
+}
+
+// TakerFeeKeeper defines the contract needed to charge the protocol's taker
+// fee, which the swap router takes on every hop of a swap, in pools of every
+// type.
+type TakerFeeKeeper interface {
+	// TakerFeeForAmountIn returns the taker fee taken out of tokenIn before it
+	// is swapped for tokenOutDenom.
+	TakerFeeForAmountIn(ctx sdk.Context, tokenIn sdk.Coin, tokenOutDenom string) sdk.Coin
+	// TakerFeeForAmountOut returns the taker fee taken on top of the tokenIn a
+	// pool swaps in for tokenOutDenom.
+	TakerFeeForAmountOut(ctx sdk.Context, tokenIn sdk.Coin, tokenOutDenom string) sdk.Coin
+	// ChargeTakerFee sends the taker fee of a swap in a pool from the sender
+	// to where taker fees go.
+	ChargeTakerFee(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, takerFee sdk.Coin) error
+}
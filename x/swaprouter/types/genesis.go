@@ -0,0 +1,34 @@
+package types
+
+import "fmt"
+
+// DefaultGenesis returns the default swaprouter genesis state.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		NextPoolId: 1,
+		PoolRoutes: []ModuleRoute{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure. It does not verify that the routed pools exist.
+func (gs GenesisState) Validate() error {
+	if gs.NextPoolId == 0 {
+		return fmt.Errorf("next pool id must be positive")
+	}
+
+	seenPoolIds := make(map[uint64]bool, len(gs.PoolRoutes))
+	for _, route := range gs.PoolRoutes {
+		if route.PoolId == 0 || route.PoolId >= gs.NextPoolId {
+			return fmt.Errorf("pool route of pool %d is outside of the created pool ids [1, %d)", route.PoolId, gs.NextPoolId)
+		}
+		if seenPoolIds[route.PoolId] {
+			return fmt.Errorf("duplicate pool route for pool %d", route.PoolId)
+		}
+		if !route.PoolType.IsValid() {
+			return fmt.Errorf("pool route of pool %d has invalid pool type %d", route.PoolId, route.PoolType)
+		}
+		seenPoolIds[route.PoolId] = true
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v7/x/swaprouter/types"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	validRoutes := []types.ModuleRoute{
+		{PoolType: types.Balancer, PoolId: 1},
+		{PoolType: types.Stableswap, PoolId: 2},
+	}
+
+	tests := map[string]struct {
+		genesis   types.GenesisState
+		expectErr bool
+	}{
+		"default genesis": {
+			genesis: *types.DefaultGenesis(),
+		},
+		"routes of created pools": {
+			genesis: types.GenesisState{NextPoolId: 3, PoolRoutes: validRoutes},
+		},
+		"zero next pool id": {
+			genesis:   types.GenesisState{NextPoolId: 0},
+			expectErr: true,
+		},
+		"route of a pool that isn't created yet": {
+			genesis:   types.GenesisState{NextPoolId: 2, PoolRoutes: validRoutes},
+			expectErr: true,
+		},
+		"route of pool 0": {
+			genesis:   types.GenesisState{NextPoolId: 3, PoolRoutes: []types.ModuleRoute{{PoolType: types.Balancer, PoolId: 0}}},
+			expectErr: true,
+		},
+		"duplicate route": {
+			genesis:   types.GenesisState{NextPoolId: 3, PoolRoutes: append(validRoutes, validRoutes[0])},
+			expectErr: true,
+		},
+		"invalid pool type": {
+			genesis:   types.GenesisState{NextPoolId: 3, PoolRoutes: []types.ModuleRoute{{PoolType: types.PoolType(100), PoolId: 1}}},
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.genesis.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
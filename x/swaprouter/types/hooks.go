@@ -0,0 +1,25 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+type SwapRouterHooks interface {
+	// AfterSwap is called after each hop the swap router swaps in a pool, of
+	// any pool type, with the swap fee the pool charged on it
+	AfterSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins, swapFee sdk.Dec)
+}
+
+var _ SwapRouterHooks = MultiSwapRouterHooks{}
+
+// combine multiple swap router hooks, all hook functions are run in array sequence.
+type MultiSwapRouterHooks []SwapRouterHooks
+
+// Creates hooks for the SwapRouter Module.
+func NewMultiSwapRouterHooks(hooks ...SwapRouterHooks) MultiSwapRouterHooks {
+	return hooks
+}
+
+func (h MultiSwapRouterHooks) AfterSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins, swapFee sdk.Dec) {
+	for i := range h {
+		h[i].AfterSwap(ctx, sender, poolId, input, output, swapFee)
+	}
+}
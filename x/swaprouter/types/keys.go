@@ -0,0 +1,28 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	ModuleName = "swaprouter"
+
+	StoreKey = ModuleName
+
+	RouterKey = ModuleName
+
+	QuerierRoute = ModuleName
+)
+
+var (
+	// KeyNextGlobalPoolId defines key to store the next Pool ID to be used.
+	KeyNextGlobalPoolId = []byte{0x01}
+
+	// KeyPrefixPoolRoutes defines the key prefix of the type of each pool.
+	KeyPrefixPoolRoutes = []byte{0x02}
+)
+
+// FormatModuleRouteKey returns the key of the module route of a pool.
+func FormatModuleRouteKey(poolId uint64) []byte {
+	return append(KeyPrefixPoolRoutes, sdk.Uint64ToBigEndian(poolId)...)
+}
@@ -0,0 +1,382 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/swaprouter/v1beta1/module_route.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PoolType is the type of a pool. Each pool type is owned by a single module,
+// which the swap router routes swaps in pools of that type to.
+type PoolType int32
+
+const (
+	// Balancer is the gamm balancer pool type.
+	Balancer PoolType = 0
+	// Stableswap is the gamm stableswap pool type.
+	Stableswap PoolType = 1
+	// Concentrated is the concentrated-liquidity pool type.
+	Concentrated PoolType = 2
+)
+
+var PoolType_name = map[int32]string{
+	0: "Balancer",
+	1: "Stableswap",
+	2: "Concentrated",
+}
+
+var PoolType_value = map[string]int32{
+	"Balancer":     0,
+	"Stableswap":   1,
+	"Concentrated": 2,
+}
+
+func (x PoolType) String() string {
+	return proto.EnumName(PoolType_name, int32(x))
+}
+
+func (PoolType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c26575d86edff56b, []int{0}
+}
+
+// ModuleRoute records the type of a pool, and therefore the module that owns
+// it.
+type ModuleRoute struct {
+	PoolType PoolType `protobuf:"varint,1,opt,name=pool_type,json=poolType,proto3,enum=osmosis.swaprouter.v1beta1.PoolType" json:"pool_type,omitempty" yaml:"pool_type"`
+	PoolId   uint64   `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+}
+
+func (m *ModuleRoute) Reset()         { *m = ModuleRoute{} }
+func (m *ModuleRoute) String() string { return proto.CompactTextString(m) }
+func (*ModuleRoute) ProtoMessage()    {}
+func (*ModuleRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c26575d86edff56b, []int{0}
+}
+func (m *ModuleRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ModuleRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleRoute.Merge(m, src)
+}
+func (m *ModuleRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModuleRoute proto.InternalMessageInfo
+
+func (m *ModuleRoute) GetPoolType() PoolType {
+	if m != nil {
+		return m.PoolType
+	}
+	return Balancer
+}
+
+func (m *ModuleRoute) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("osmosis.swaprouter.v1beta1.PoolType", PoolType_name, PoolType_value)
+	proto.RegisterType((*ModuleRoute)(nil), "osmosis.swaprouter.v1beta1.ModuleRoute")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/swaprouter/v1beta1/module_route.proto", fileDescriptor_c26575d86edff56b)
+}
+
+var fileDescriptor_c26575d86edff56b = []byte{
+	// 312 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0x41, 0x4b, 0x02, 0x41,
+	0x14, 0xc7, 0x77, 0x44, 0xcc, 0x26, 0x91, 0x65, 0xf0, 0x20, 0x1e, 0x46, 0x59, 0x3a, 0x48, 0xe1,
+	0x0c, 0x16, 0x11, 0x74, 0x8a, 0xed, 0xd4, 0x21, 0x0a, 0x0b, 0x82, 0x2e, 0x32, 0xeb, 0x0e, 0xb6,
+	0x30, 0xeb, 0x1b, 0x76, 0x46, 0xcb, 0x6f, 0xd0, 0x31, 0xe8, 0x23, 0xf4, 0x65, 0x3a, 0x7a, 0xec,
+	0x24, 0xe1, 0x7e, 0x03, 0x3f, 0x41, 0xec, 0xb6, 0x96, 0x97, 0x6e, 0x6f, 0xde, 0xfc, 0xde, 0x8f,
+	0xf7, 0xfe, 0xb8, 0x07, 0x26, 0x06, 0x13, 0x19, 0x6e, 0x9e, 0x84, 0x4e, 0x60, 0x6a, 0x65, 0xc2,
+	0x67, 0xfd, 0x40, 0x5a, 0xd1, 0xe7, 0x31, 0x84, 0x53, 0x25, 0x87, 0x79, 0x97, 0xe9, 0x04, 0x2c,
+	0x90, 0x56, 0x81, 0xb3, 0x3f, 0x9c, 0x15, 0x78, 0xab, 0x31, 0x86, 0x31, 0xe4, 0x18, 0xcf, 0xaa,
+	0x9f, 0x09, 0xef, 0x0d, 0xe1, 0xbd, 0xab, 0x5c, 0x34, 0xc8, 0x70, 0x72, 0x8f, 0x77, 0x35, 0x80,
+	0x1a, 0xda, 0xb9, 0x96, 0x4d, 0xd4, 0x41, 0xdd, 0xfa, 0xd1, 0x3e, 0xfb, 0xdf, 0xca, 0x6e, 0x00,
+	0xd4, 0xdd, 0x5c, 0x4b, 0xbf, 0xb1, 0x5e, 0xb6, 0xdd, 0xb9, 0x88, 0xd5, 0x99, 0xf7, 0x2b, 0xf0,
+	0x06, 0x55, 0x5d, 0xfc, 0x93, 0x43, 0xbc, 0x93, 0xf7, 0xa3, 0xb0, 0x59, 0xea, 0xa0, 0x6e, 0xd9,
+	0x27, 0xeb, 0x65, 0xbb, 0xbe, 0x35, 0x10, 0x85, 0xde, 0xa0, 0x92, 0x55, 0x97, 0xe1, 0xc1, 0x39,
+	0xae, 0x6e, 0xc4, 0xa4, 0x86, 0xab, 0xbe, 0x50, 0x62, 0x32, 0x92, 0x89, 0xeb, 0x90, 0x3a, 0xc6,
+	0xb7, 0x56, 0x04, 0x4a, 0x66, 0xbb, 0xb8, 0x88, 0xb8, 0xb8, 0x76, 0x01, 0x93, 0x91, 0x9c, 0xd8,
+	0x44, 0x58, 0x19, 0xba, 0xa5, 0x56, 0xf9, 0xe5, 0x9d, 0x3a, 0xfe, 0xf5, 0xc7, 0x8a, 0xa2, 0xc5,
+	0x8a, 0xa2, 0xaf, 0x15, 0x45, 0xaf, 0x29, 0x75, 0x16, 0x29, 0x75, 0x3e, 0x53, 0xea, 0x3c, 0x9c,
+	0x8c, 0x23, 0xfb, 0x38, 0x0d, 0xd8, 0x08, 0x62, 0x5e, 0x1c, 0xd6, 0x53, 0x22, 0x30, 0x9b, 0x07,
+	0x9f, 0x9d, 0xf2, 0xe7, 0xed, 0xbc, 0xb3, 0x4b, 0x4c, 0x50, 0xc9, 0xf3, 0x3a, 0xfe, 0x1e, 0x00,
+	0xa4, 0x39, 0xe9, 0x97, 0x92, 0x01, 0x00, 0x00,
+}
+
+func (m *ModuleRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintModuleRoute(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolType != 0 {
+		i = encodeVarintModuleRoute(dAtA, i, uint64(m.PoolType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintModuleRoute(dAtA []byte, offset int, v uint64) int {
+	offset -= sovModuleRoute(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ModuleRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolType != 0 {
+		n += 1 + sovModuleRoute(uint64(m.PoolType))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovModuleRoute(uint64(m.PoolId))
+	}
+	return n
+}
+
+func sovModuleRoute(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozModuleRoute(x uint64) (n int) {
+	return sovModuleRoute(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ModuleRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModuleRoute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolType", wireType)
+			}
+			m.PoolType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModuleRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolType |= PoolType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModuleRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModuleRoute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModuleRoute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipModuleRoute(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowModuleRoute
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowModuleRoute
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowModuleRoute
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthModuleRoute
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupModuleRoute
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthModuleRoute
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthModuleRoute        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowModuleRoute          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupModuleRoute = fmt.Errorf("proto: unexpected end of group")
+)
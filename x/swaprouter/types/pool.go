@@ -0,0 +1,41 @@
+package types
+
+import (
+	proto "github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PoolI defines the interface that pools of every type implement, for the
+// swap router to route swaps to them.
+type PoolI interface {
+	proto.Message
+
+	GetAddress() sdk.AccAddress
+	String() string
+	GetId() uint64
+	// GetSwapFee returns the pool's swap fee, based on the current state.
+	// Pools may choose to make their swap fees dependent upon state
+	// (prior TWAPs, network downtime, other pool states, etc.)
+	// hence Context is provided as an argument.
+	GetSwapFee(ctx sdk.Context) sdk.Dec
+	// Returns whether the pool has swaps enabled at the moment
+	IsActive(ctx sdk.Context) bool
+	// GetPoolDenoms returns the denoms of the tokens the pool can swap
+	// between, sorted.
+	GetPoolDenoms(ctx sdk.Context) []string
+	// Returns how many of the 'base asset' one 'quote asset' is worth in the
+	// pool, errors if either baseAssetDenom, or quoteAssetDenom does not exist.
+	// For example, if this was a UniV2 50-50 pool, with 2 ETH, and 8000 UST
+	// pool.SpotPrice(ctx, "ust", "eth") = 4000.00
+	SpotPrice(ctx sdk.Context, baseAssetDenom string, quoteAssetDenom string) (sdk.Dec, error)
+	// GetType returns the type of the pool, which determines the module that
+	// owns it.
+	GetType() PoolType
+}
+
+// IsValid returns whether the pool type is a known one.
+func (poolType PoolType) IsValid() bool {
+	_, ok := PoolType_name[int32(poolType)]
+	return ok
+}
@@ -0,0 +1,58 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+type SwapAmountInRoutes []SwapAmountInRoute
+
+func (routes SwapAmountInRoutes) Validate() error {
+	if len(routes) == 0 {
+		return ErrEmptyRoutes
+	}
+
+	for _, route := range routes {
+		err := sdk.ValidateDenom(route.TokenOutDenom)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type SwapAmountOutRoutes []SwapAmountOutRoute
+
+func (routes SwapAmountOutRoutes) Validate() error {
+	if len(routes) == 0 {
+		return ErrEmptyRoutes
+	}
+
+	for _, route := range routes {
+		err := sdk.ValidateDenom(route.TokenInDenom)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+const (
+	// DefaultMaxRouteHops and MaxRouteHops are the default and the highest
+	// number of hops of the routes FindBestRoutes searches.
+	DefaultMaxRouteHops = 3
+	MaxRouteHops        = 4
+	// DefaultMaxRoutes and MaxRoutes are the default and the highest number
+	// of routes EstimateBestRoutes returns.
+	DefaultMaxRoutes = 3
+	MaxRoutes        = 10
+)
+
+// SwapAmountInRoutes returns the routes to swap through the hops of the
+// estimate with an exact amount in.
+func (estimate SwapRouteEstimate) SwapAmountInRoutes() SwapAmountInRoutes {
+	routes := make(SwapAmountInRoutes, len(estimate.Hops))
+	for i, hop := range estimate.Hops {
+		routes[i] = SwapAmountInRoute{PoolId: hop.PoolId, TokenOutDenom: hop.TokenOut.Denom}
+	}
+	return routes
+}
@@ -0,0 +1,200 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/osmosis-labs/osmosis/v7/x/twamm/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module.
+func GetQueryCmd() *cobra.Command {
+	// Group twamm queries under a subcommand
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetCmdParams(),
+		GetCmdOrder(),
+		GetCmdOrdersByOwner(),
+		GetCmdOrdersByPool(),
+	)
+
+	return cmd
+}
+
+// GetCmdParams returns the module's params.
+func GetCmdParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the module's params",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the module's params.
+Example:
+$ %s query twamm params
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdOrder returns a long-term order.
+func GetCmdOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "order <orderID>",
+		Short: "Query a long-term order",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a long-term order, with what it bought up to the last block.
+Example:
+$ %s query twamm order 1
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			orderId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Order(cmd.Context(), &types.QueryOrderRequest{OrderId: orderId})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdOrdersByOwner returns the long-term orders of an address.
+func GetCmdOrdersByOwner() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "orders-by-owner <address>",
+		Short: "Query the long-term orders of an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the long-term orders of an address, in every pool.
+Example:
+$ %s query twamm orders-by-owner osmo1...
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.OrdersByOwner(cmd.Context(), &types.QueryOrdersByOwnerRequest{
+				Owner:      args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "orders-by-owner")
+	return cmd
+}
+
+// GetCmdOrdersByPool returns the long-term orders against a pool.
+func GetCmdOrdersByPool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "orders-by-pool <poolID>",
+		Short: "Query the long-term orders against a pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the long-term orders against a pool.
+Example:
+$ %s query twamm orders-by-pool 1
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.OrdersByPool(cmd.Context(), &types.QueryOrdersByPoolRequest{
+				PoolId:     poolId,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "orders-by-pool")
+	return cmd
+}
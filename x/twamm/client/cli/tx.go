@@ -0,0 +1,166 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/osmosis-labs/osmosis/v7/x/twamm/types"
+)
+
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Long-term order transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewPlaceLongTermOrderCmd(),
+		NewCancelLongTermOrderCmd(),
+		NewWithdrawProceedsCmd(),
+	)
+
+	return txCmd
+}
+
+func NewPlaceLongTermOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "place-long-term-order [pool-id] [token-in] [token-out-denom] [duration]",
+		Short: "sell tokens against a pool evenly over a duration",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`place a long-term order, which sells token-in for token-out-denom against a
+balancer pool evenly over the duration, starting from the next block.
+
+Example:
+$ %s tx twamm place-long-term-order 1 1000000000uosmo uatom 168h --from mykey
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			tokenIn, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			duration, err := time.ParseDuration(args[3])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgPlaceLongTermOrder{
+				Sender:        clientCtx.GetFromAddress().String(),
+				PoolId:        poolId,
+				TokenIn:       tokenIn,
+				TokenOutDenom: args[2],
+				Duration:      duration,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCancelLongTermOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-long-term-order [order-id]",
+		Short: "cancel a long-term order",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`cancel a long-term order before it ends. What it has not sold yet is
+refunded, and what it bought is paid out.
+
+Example:
+$ %s tx twamm cancel-long-term-order 1 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			orderId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCancelLongTermOrder{
+				Sender:  clientCtx.GetFromAddress().String(),
+				OrderId: orderId,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewWithdrawProceedsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-proceeds [order-id]",
+		Short: "withdraw what a long-term order bought",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`withdraw what a long-term order bought since the last withdrawal. Once the
+order has ended, this closes it.
+
+Example:
+$ %s tx twamm withdraw-proceeds 1 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			orderId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgWithdrawProceeds{
+				Sender:  clientCtx.GetFromAddress().String(),
+				OrderId: orderId,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v7/x/twamm/types"
+)
+
+// BeginBlock executes every order pool up to the block time.
+func (k Keeper) BeginBlock(ctx sdk.Context) {
+	for _, op := range k.GetAllOrderPools(ctx) {
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.executeOrderPool(cacheCtx, &op); err != nil {
+			k.Logger(ctx).Error("failed to execute long-term orders", "pool_id", op.PoolId,
+				"token_in_denom", op.TokenInDenom, "token_out_denom", op.TokenOutDenom, "error", err.Error())
+			continue
+		}
+		k.setOrderPool(cacheCtx, op)
+		write()
+	}
+}
+
+// executeOrderPool sells what the open orders of the order pool were due to
+// sell since it was last executed, up to the block time. Orders that end in
+// the meantime are settled at their end time and leave the order pool. The
+// caller must store the order pool.
+func (k Keeper) executeOrderPool(ctx sdk.Context, op *types.OrderPool) error {
+	now := ctx.BlockTime()
+	if !now.After(op.LastExecutionTime) {
+		return nil
+	}
+
+	endingOrders, err := k.getOrdersEndingUntil(ctx, *op, now)
+	if err != nil {
+		return err
+	}
+	for _, order := range endingOrders {
+		k.sellUntil(ctx, op, order.EndTime)
+		k.removeFromOrderPool(op, &order)
+		k.setLongTermOrder(ctx, order)
+		ctx.KVStore(k.storeKey).Delete(types.GetKeyOrderExpiry(order))
+	}
+	k.sellUntil(ctx, op, now)
+	return nil
+}
+
+// sellUntil swaps what the order pool was due to sell from its last execution
+// up to endTime against the pool, and accrues what it bought to its orders.
+// When the swap fails, because the pool was paused or removed, what could not
+// be sold is refunded to the orders instead.
+func (k Keeper) sellUntil(ctx sdk.Context, op *types.OrderPool, endTime time.Time) {
+	elapsed := endTime.Sub(op.LastExecutionTime)
+	if elapsed <= 0 || !op.SellRate.IsPositive() {
+		return
+	}
+	op.LastExecutionTime = endTime
+
+	amount := op.Unsold.Add(types.AmountSold(op.SellRate, elapsed))
+	amountIn := amount.TruncateInt()
+	if amountIn.IsZero() {
+		op.Unsold = amount
+		return
+	}
+
+	tokenOutAmount, err := k.swap(ctx, *op, sdk.NewCoin(op.TokenInDenom, amountIn))
+	if err != nil {
+		k.Logger(ctx).Debug("failed to execute long-term orders, refunding them", "pool_id", op.PoolId,
+			"token_in", amountIn.String()+op.TokenInDenom, "error", err.Error())
+		op.Unsold = amount.Sub(amountIn.ToDec())
+		op.RefundPerRate = op.RefundPerRate.Add(amountIn.ToDec().QuoTruncate(op.SellRate))
+		return
+	}
+	if tokenOutAmount.IsZero() {
+		// Too little to buy anything yet, so sell it with the next swap.
+		op.Unsold = amount
+		return
+	}
+	op.Unsold = amount.Sub(amountIn.ToDec())
+	op.ProceedsPerRate = op.ProceedsPerRate.Add(tokenOutAmount.ToDec().QuoTruncate(op.SellRate))
+}
+
+// swap sells tokenIn from the module account for the order pool's token_out
+// through the swap router, at the price its CalcOutAmtGivenIn quotes. It returns a zero amount
+// without swapping when tokenIn is too little to buy any token_out.
+func (k Keeper) swap(ctx sdk.Context, op types.OrderPool, tokenIn sdk.Coin) (sdk.Int, error) {
+	pool, err := k.gammKeeper.GetPool(ctx, op.PoolId)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	if !pool.IsActive(ctx) {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrPoolInactive, "pool %d", op.PoolId)
+	}
+
+	tokenOut, err := k.swapRouterKeeper.CalcOutAmtGivenIn(ctx, op.PoolId, tokenIn, op.TokenOutDenom)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	if !tokenOut.Amount.IsPositive() {
+		return sdk.ZeroInt(), nil
+	}
+
+	// Swap in a cache context, so that a failed swap leaves no partial
+	// changes behind.
+	cacheCtx, write := ctx.CacheContext()
+	tokenOutAmount, err := k.swapRouterKeeper.SwapExactAmountIn(
+		cacheCtx, k.accountKeeper.GetModuleAddress(types.ModuleName), op.PoolId, tokenIn, op.TokenOutDenom, tokenOut.Amount)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	write()
+	return tokenOutAmount, nil
+}
+
+// removeFromOrderPool settles an open order and removes its sell rate from
+// the order pool. When it is the last open order, it is also refunded what
+// the order pool carried unsold, down to dust below one token.
+func (k Keeper) removeFromOrderPool(op *types.OrderPool, order *types.LongTermOrder) {
+	if op.SellRate.Equal(order.SellRate) {
+		unsold := op.Unsold.TruncateInt().ToDec()
+		op.Unsold = op.Unsold.Sub(unsold)
+		op.RefundPerRate = op.RefundPerRate.Add(unsold.QuoTruncate(op.SellRate))
+	}
+	order.Settle(*op)
+	op.SellRate = op.SellRate.Sub(order.SellRate)
+}
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/twamm/types"
+)
+
+// InitGenesis initializes the twamm module's state from a provided genesis
+// state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	if err := genState.Validate(); err != nil {
+		panic(err)
+	}
+
+	k.SetParams(ctx, genState.Params)
+	k.SetNextOrderId(ctx, genState.NextOrderId)
+
+	for _, op := range genState.OrderPools {
+		k.setOrderPool(ctx, op)
+	}
+	store := ctx.KVStore(k.storeKey)
+	for _, order := range genState.Orders {
+		k.setLongTermOrder(ctx, order)
+		if op, found := k.getOrderPool(ctx, order.PoolId, order.TokenInDenom, order.TokenOutDenom); found && order.IsOpen(op) {
+			store.Set(types.GetKeyOrderExpiry(order), []byte{})
+		}
+	}
+}
+
+// ExportGenesis returns the twamm module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return &types.GenesisState{
+		Params:      k.GetParams(ctx),
+		OrderPools:  k.GetAllOrderPools(ctx),
+		Orders:      k.GetAllLongTermOrders(ctx),
+		NextOrderId: k.GetNextOrderId(ctx),
+	}
+}
@@ -0,0 +1,42 @@
+package keeper_test
+
+import (
+	"time"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/app"
+)
+
+func (suite *KeeperTestSuite) TestGenesisRoundTrip() {
+	poolId := suite.prepareFooBarPool()
+	twammKeeper := suite.App.TwammKeeper
+
+	_, err := twammKeeper.PlaceLongTermOrder(suite.Ctx, acc1, poolId, sdk.NewInt64Coin("foo", 3600000), "bar", time.Hour)
+	suite.Require().NoError(err)
+	endedOrderId, err := twammKeeper.PlaceLongTermOrder(suite.Ctx, acc2, poolId, sdk.NewInt64Coin("foo", 600000), "bar", 10*time.Minute)
+	suite.Require().NoError(err)
+	suite.executeAt(startTime.Add(30 * time.Minute))
+
+	genesis := twammKeeper.ExportGenesis(suite.Ctx)
+	suite.Require().NoError(genesis.Validate())
+	suite.Require().Len(genesis.OrderPools, 1)
+	suite.Require().Len(genesis.Orders, 2)
+	suite.Require().Equal(uint64(3), genesis.NextOrderId)
+
+	app2 := app.Setup(false)
+	ctx2 := app2.BaseApp.NewContext(false, tmproto.Header{Time: suite.Ctx.BlockTime()})
+	app2.TwammKeeper.InitGenesis(ctx2, genesis)
+	suite.Require().Equal(genesis, app2.TwammKeeper.ExportGenesis(ctx2))
+
+	// Only the order still open is expired when the order pool is executed
+	// past its end.
+	ctx2 = ctx2.WithBlockTime(startTime.Add(2 * time.Hour))
+	app2.TwammKeeper.BeginBlock(ctx2)
+	suite.Require().Empty(app2.TwammKeeper.GetAllOrderPools(ctx2))
+	endedOrder, err := app2.TwammKeeper.GetLongTermOrder(ctx2, endedOrderId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(genesis.Orders[1], endedOrder)
+}
@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/osmosis-labs/osmosis/v7/x/twamm/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+// Querier defines a wrapper around the x/twamm keeper providing gRPC method
+// handlers. Orders are returned with what they bought and could not sell up
+// to the last execution of their order pool.
+type Querier struct {
+	Keeper
+}
+
+func NewQuerier(k Keeper) Querier {
+	return Querier{Keeper: k}
+}
+
+func (q Querier) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return &types.QueryParamsResponse{Params: q.Keeper.GetParams(sdkCtx)}, nil
+}
+
+func (q Querier) Order(ctx context.Context, req *types.QueryOrderRequest) (*types.QueryOrderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	order, err := q.Keeper.GetLongTermOrder(sdkCtx, req.OrderId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryOrderResponse{Order: q.Keeper.settledOrder(sdkCtx, order)}, nil
+}
+
+func (q Querier) OrdersByOwner(ctx context.Context, req *types.QueryOrdersByOwnerRequest) (*types.QueryOrdersByOwnerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	orders, pageRes, err := q.Keeper.paginateOrderIndex(sdkCtx, types.GetKeyPrefixOwnerOrders(owner), req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryOrdersByOwnerResponse{Orders: orders, Pagination: pageRes}, nil
+}
+
+func (q Querier) OrdersByPool(ctx context.Context, req *types.QueryOrdersByPoolRequest) (*types.QueryOrdersByPoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	orders, pageRes, err := q.Keeper.paginateOrderIndex(sdkCtx, types.GetKeyPrefixPoolOrders(req.PoolId), req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryOrdersByPoolResponse{Orders: orders, Pagination: pageRes}, nil
+}
+
+// paginateOrderIndex returns a page of the orders in an index of orders,
+// whose keys end with the order IDs.
+func (k Keeper) paginateOrderIndex(ctx sdk.Context, indexPrefix []byte, pageReq *query.PageRequest) ([]types.LongTermOrder, *query.PageResponse, error) {
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), indexPrefix)
+
+	orders := []types.LongTermOrder{}
+	pageRes, err := query.Paginate(indexStore, pageReq, func(key, _ []byte) error {
+		order, err := k.GetLongTermOrder(ctx, sdk.BigEndianToUint64(key))
+		if err != nil {
+			return err
+		}
+		orders = append(orders, k.settledOrder(ctx, order))
+		return nil
+	})
+	return orders, pageRes, err
+}
+
+// settledOrder returns an order settled up to the last execution of its
+// order pool, without storing it.
+func (k Keeper) settledOrder(ctx sdk.Context, order types.LongTermOrder) types.LongTermOrder {
+	op, found := k.getOrderPool(ctx, order.PoolId, order.TokenInDenom, order.TokenOutDenom)
+	if found && order.IsOpen(op) {
+		order.Settle(op)
+	}
+	return order
+}
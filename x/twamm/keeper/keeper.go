@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/twamm/types"
+)
+
+// Keeper manages long-term orders, which sell a deposit evenly over time
+// against a gamm pool.
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      codec.BinaryCodec
+
+	paramSpace paramtypes.Subspace
+
+	// keepers
+	accountKeeper    types.AccountKeeper
+	bankKeeper       types.BankKeeper
+	gammKeeper       types.GammKeeper
+	swapRouterKeeper types.SwapRouterKeeper
+}
+
+// NewKeeper returns an instance of Keeper.
+func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper, gammKeeper types.GammKeeper, swapRouterKeeper types.SwapRouterKeeper) Keeper {
+	// ensure the module account is set
+	if addr := accountKeeper.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
+	}
+
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		storeKey:   storeKey,
+		cdc:        cdc,
+		paramSpace: paramSpace,
+		// keepers
+		accountKeeper:    accountKeeper,
+		bankKeeper:       bankKeeper,
+		gammKeeper:       gammKeeper,
+		swapRouterKeeper: swapRouterKeeper,
+	}
+}
+
+// Logger returns a logger instance.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetParams returns the total set of params.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of params.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/app"
+	"github.com/osmosis-labs/osmosis/v7/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v7/x/twamm/keeper"
+	"github.com/osmosis-labs/osmosis/v7/x/twamm/types"
+)
+
+var (
+	acc1 = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address().Bytes())
+	acc2 = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address().Bytes())
+
+	startTime = time.Unix(1_650_000_000, 0).UTC()
+)
+
+type KeeperTestSuite struct {
+	apptesting.KeeperTestHelper
+
+	queryClient types.QueryClient
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.App = app.Setup(false)
+	suite.Ctx = suite.App.BaseApp.NewContext(false, tmproto.Header{Time: startTime})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.Ctx, suite.App.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, keeper.NewQuerier(*suite.App.TwammKeeper))
+	suite.queryClient = types.NewQueryClient(queryHelper)
+
+	for _, acc := range []sdk.AccAddress{acc1, acc2} {
+		err := simapp.FundAccount(suite.App.BankKeeper, suite.Ctx, acc, sdk.NewCoins(
+			sdk.NewInt64Coin("bar", 100000000),
+			sdk.NewInt64Coin("foo", 100000000),
+		))
+		suite.Require().NoError(err)
+	}
+}
+
+// prepareFooBarPool creates a balancer pool of 1000000000 foo and 1000000000
+// bar, without swap fee.
+func (suite *KeeperTestSuite) prepareFooBarPool() uint64 {
+	return suite.PrepareUni2PoolWithAssets(sdk.NewInt64Coin("foo", 1000000000), sdk.NewInt64Coin("bar", 1000000000))
+}
+
+// executeAt moves the block time to blockTime, and executes the long-term
+// orders up to it.
+func (suite *KeeperTestSuite) executeAt(blockTime time.Time) {
+	suite.Ctx = suite.Ctx.WithBlockTime(blockTime)
+	suite.App.TwammKeeper.BeginBlock(suite.Ctx)
+}
+
+func (suite *KeeperTestSuite) moduleBalance(denom string) sdk.Int {
+	moduleAddr := suite.App.AccountKeeper.GetModuleAddress(types.ModuleName)
+	return suite.App.BankKeeper.GetBalance(suite.Ctx, moduleAddr, denom).Amount
+}
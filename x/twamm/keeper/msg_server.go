@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/twamm/types"
+)
+
+type msgServer struct {
+	keeper *Keeper
+}
+
+func NewMsgServerImpl(keeper *Keeper) types.MsgServer {
+	return &msgServer{
+		keeper: keeper,
+	}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (server msgServer) PlaceLongTermOrder(goCtx context.Context, msg *types.MsgPlaceLongTermOrder) (*types.MsgPlaceLongTermOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	orderId, err := server.keeper.PlaceLongTermOrder(ctx, sender, msg.PoolId, msg.TokenIn, msg.TokenOutDenom, msg.Duration)
+	if err != nil {
+		return nil, err
+	}
+
+	server.emitMessageEvent(ctx, msg.Sender)
+
+	return &types.MsgPlaceLongTermOrderResponse{OrderId: orderId}, nil
+}
+
+func (server msgServer) CancelLongTermOrder(goCtx context.Context, msg *types.MsgCancelLongTermOrder) (*types.MsgCancelLongTermOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	refund, proceeds, err := server.keeper.CancelLongTermOrder(ctx, sender, msg.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	server.emitMessageEvent(ctx, msg.Sender)
+
+	return &types.MsgCancelLongTermOrderResponse{Refund: refund, Proceeds: proceeds}, nil
+}
+
+func (server msgServer) WithdrawProceeds(goCtx context.Context, msg *types.MsgWithdrawProceeds) (*types.MsgWithdrawProceedsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	proceeds, err := server.keeper.WithdrawProceeds(ctx, sender, msg.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	server.emitMessageEvent(ctx, msg.Sender)
+
+	return &types.MsgWithdrawProceedsResponse{Proceeds: proceeds}, nil
+}
+
+func (server msgServer) emitMessageEvent(ctx sdk.Context, sender string) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender),
+		),
+	})
+}
@@ -0,0 +1,209 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	swaproutertypes "github.com/osmosis-labs/osmosis/v7/x/swaprouter/types"
+	"github.com/osmosis-labs/osmosis/v7/x/twamm/types"
+)
+
+// PlaceLongTermOrder places an order selling tokenIn for tokenOutDenom
+// against a balancer pool, evenly over duration from now on. The order starts
+// selling from the next block on.
+func (k Keeper) PlaceLongTermOrder(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolId uint64,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	duration time.Duration,
+) (uint64, error) {
+	maxDuration := k.GetParams(ctx).MaxOrderDuration
+	if duration < time.Second || duration > maxDuration {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidDuration, "%s is not between a second and %s", duration, maxDuration)
+	}
+	if err := k.validateOrderPool(ctx, poolId, tokenIn.Denom, tokenOutDenom); err != nil {
+		return 0, err
+	}
+
+	sellRate := types.SellRateForDeposit(tokenIn.Amount, duration)
+	if !sellRate.IsPositive() {
+		return 0, sdkerrors.Wrapf(types.ErrSellRateTooSmall, "%s over %s", tokenIn, duration)
+	}
+
+	op, found := k.getOrderPool(ctx, poolId, tokenIn.Denom, tokenOutDenom)
+	if !found {
+		op = types.NewOrderPool(poolId, tokenIn.Denom, tokenOutDenom, ctx.BlockTime())
+	}
+	if err := k.executeOrderPool(ctx, &op); err != nil {
+		return 0, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.Coins{tokenIn}); err != nil {
+		return 0, err
+	}
+
+	now := ctx.BlockTime()
+	order := types.LongTermOrder{
+		Id:                k.getNextOrderIdAndIncrement(ctx),
+		Owner:             sender.String(),
+		PoolId:            poolId,
+		TokenInDenom:      tokenIn.Denom,
+		TokenOutDenom:     tokenOutDenom,
+		Deposit:           tokenIn.Amount,
+		SellRate:          sellRate,
+		StartTime:         now,
+		EndTime:           now.Add(duration),
+		ProceedsPerRate:   op.ProceedsPerRate,
+		RefundPerRate:     op.RefundPerRate,
+		UnclaimedProceeds: sdk.ZeroDec(),
+		UnclaimedRefund:   sdk.ZeroDec(),
+	}
+	op.SellRate = op.SellRate.Add(sellRate)
+
+	k.setLongTermOrder(ctx, order)
+	ctx.KVStore(k.storeKey).Set(types.GetKeyOrderExpiry(order), []byte{})
+	k.setOrderPool(ctx, op)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtLongTermOrderPlaced,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(types.AttributeKeyOrderId, sdk.NewUint(order.Id).String()),
+		sdk.NewAttribute(types.AttributeKeyPoolId, sdk.NewUint(poolId).String()),
+		sdk.NewAttribute(types.AttributeKeyTokenIn, tokenIn.String()),
+		sdk.NewAttribute(types.AttributeKeyTokenOut, tokenOutDenom),
+		sdk.NewAttribute(types.AttributeKeyEndTime, order.EndTime.String()),
+	))
+
+	return order.Id, nil
+}
+
+// validateOrderPool checks that long-term orders can sell tokenInDenom for
+// tokenOutDenom against a pool.
+func (k Keeper) validateOrderPool(ctx sdk.Context, poolId uint64, tokenInDenom, tokenOutDenom string) error {
+	pool, err := k.gammKeeper.GetPool(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	if pool.GetType() != swaproutertypes.Balancer {
+		return sdkerrors.Wrapf(types.ErrNotBalancerPool, "pool %d is a %s pool", poolId, pool.GetType())
+	}
+	if !pool.IsActive(ctx) {
+		return sdkerrors.Wrapf(types.ErrPoolInactive, "pool %d", poolId)
+	}
+
+	denoms := map[string]bool{}
+	for _, denom := range pool.GetPoolDenoms(ctx) {
+		denoms[denom] = true
+	}
+	for _, denom := range []string{tokenInDenom, tokenOutDenom} {
+		if !denoms[denom] {
+			return sdkerrors.Wrapf(types.ErrInvalidDenom, "%s is not in pool %d", denom, poolId)
+		}
+	}
+	return nil
+}
+
+// CancelLongTermOrder closes an open order before it ends. Its owner is
+// refunded the part of the deposit that was not sold yet, and is paid what
+// the order bought and was not withdrawn yet.
+func (k Keeper) CancelLongTermOrder(ctx sdk.Context, sender sdk.AccAddress, orderId uint64) (refund sdk.Coins, proceeds sdk.Coins, err error) {
+	order, err := k.getOwnedOrder(ctx, sender, orderId)
+	if err != nil {
+		return nil, nil, err
+	}
+	op, err := k.getExecutedOrderPool(ctx, order)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !order.IsOpen(op) {
+		return nil, nil, sdkerrors.Wrapf(types.ErrOrderEnded, "order %d ended at %s, withdraw its proceeds instead", orderId, order.EndTime)
+	}
+
+	k.removeFromOrderPool(&op, &order)
+	k.setOrderPool(ctx, op)
+	k.deleteLongTermOrder(ctx, order)
+
+	unsold := order.Deposit.ToDec().Sub(types.AmountSold(order.SellRate, ctx.BlockTime().Sub(order.StartTime)))
+	refund = sdk.NewCoins(sdk.NewCoin(order.TokenInDenom, unsold.Add(order.UnclaimedRefund).TruncateInt()))
+	proceeds = sdk.NewCoins(sdk.NewCoin(order.TokenOutDenom, order.UnclaimedProceeds.TruncateInt()))
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, refund.Add(proceeds...)); err != nil {
+		return nil, nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtLongTermOrderCancelled,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(types.AttributeKeyOrderId, sdk.NewUint(orderId).String()),
+		sdk.NewAttribute(types.AttributeKeyRefund, refund.String()),
+		sdk.NewAttribute(types.AttributeKeyProceeds, proceeds.String()),
+	))
+
+	return refund, proceeds, nil
+}
+
+// WithdrawProceeds pays the owner of an order what it bought since the last
+// withdrawal, and what it could not sell, if any. Once the order has ended,
+// this closes it.
+func (k Keeper) WithdrawProceeds(ctx sdk.Context, sender sdk.AccAddress, orderId uint64) (sdk.Coins, error) {
+	order, err := k.getOwnedOrder(ctx, sender, orderId)
+	if err != nil {
+		return nil, err
+	}
+	op, err := k.getExecutedOrderPool(ctx, order)
+	if err != nil {
+		return nil, err
+	}
+	isOpen := order.IsOpen(op)
+	if isOpen {
+		order.Settle(op)
+	}
+	k.setOrderPool(ctx, op)
+
+	proceedsAmount := order.UnclaimedProceeds.TruncateInt()
+	refundAmount := order.UnclaimedRefund.TruncateInt()
+	order.UnclaimedProceeds = order.UnclaimedProceeds.Sub(proceedsAmount.ToDec())
+	order.UnclaimedRefund = order.UnclaimedRefund.Sub(refundAmount.ToDec())
+	if isOpen {
+		k.setLongTermOrder(ctx, order)
+	} else {
+		k.deleteLongTermOrder(ctx, order)
+	}
+
+	proceeds := sdk.NewCoins(
+		sdk.NewCoin(order.TokenOutDenom, proceedsAmount),
+		sdk.NewCoin(order.TokenInDenom, refundAmount),
+	)
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, proceeds); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtProceedsWithdrawn,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(types.AttributeKeyOrderId, sdk.NewUint(orderId).String()),
+		sdk.NewAttribute(types.AttributeKeyProceeds, proceeds.String()),
+	))
+
+	return proceeds, nil
+}
+
+// getExecutedOrderPool returns the order pool of an order, executed up to the
+// block time. The caller must store it. Once the order pool has no open
+// orders left, an empty one executed up to the block time is returned.
+func (k Keeper) getExecutedOrderPool(ctx sdk.Context, order types.LongTermOrder) (types.OrderPool, error) {
+	op, found := k.getOrderPool(ctx, order.PoolId, order.TokenInDenom, order.TokenOutDenom)
+	if !found {
+		return types.NewOrderPool(order.PoolId, order.TokenInDenom, order.TokenOutDenom, ctx.BlockTime()), nil
+	}
+	if err := k.executeOrderPool(ctx, &op); err != nil {
+		return types.OrderPool{}, err
+	}
+	return op, nil
+}
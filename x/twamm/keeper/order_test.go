@@ -0,0 +1,230 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gammtypes "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v7/x/twamm/types"
+)
+
+func (suite *KeeperTestSuite) TestLongTermOrder() {
+	poolId := suite.prepareFooBarPool()
+	twammKeeper := suite.App.TwammKeeper
+	tokenIn := sdk.NewInt64Coin("foo", 3600000)
+
+	// Selling over time gets what selling everything at once would, as no
+	// one else trades against the pool in the meantime.
+	pool, err := suite.App.GAMMKeeper.GetPool(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	oneShotOut, err := suite.App.GAMMKeeper.CalcOutAmtGivenIn(suite.Ctx, pool, tokenIn, "bar", pool.GetSwapFee(suite.Ctx))
+	suite.Require().NoError(err)
+
+	orderId, err := twammKeeper.PlaceLongTermOrder(suite.Ctx, acc1, poolId, tokenIn, "bar", time.Hour)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), orderId)
+	suite.Require().Equal(sdk.NewInt(96400000), suite.App.BankKeeper.GetBalance(suite.Ctx, acc1, "foo").Amount)
+
+	order, err := twammKeeper.GetLongTermOrder(suite.Ctx, orderId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(1000), order.SellRate)
+	suite.Require().Equal(startTime.Add(time.Hour), order.EndTime)
+
+	// Half way, half of the deposit has been sold.
+	suite.executeAt(startTime.Add(30 * time.Minute))
+	suite.Require().Equal(sdk.NewInt(1800000), suite.moduleBalance("foo"))
+	boughtHalfWay := suite.moduleBalance("bar")
+	suite.Require().True(boughtHalfWay.IsPositive())
+
+	res, err := suite.queryClient.Order(sdk.WrapSDKContext(suite.Ctx), &types.QueryOrderRequest{OrderId: orderId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(boughtHalfWay, res.Order.UnclaimedProceeds.TruncateInt())
+
+	proceeds, err := twammKeeper.WithdrawProceeds(suite.Ctx, acc1, orderId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("bar", boughtHalfWay)), proceeds)
+	_, err = twammKeeper.GetLongTermOrder(suite.Ctx, orderId)
+	suite.Require().NoError(err)
+
+	// Once the order has ended, it has sold all of its deposit, and its order
+	// pool is gone.
+	suite.executeAt(startTime.Add(2 * time.Hour))
+	suite.Require().True(suite.moduleBalance("foo").IsZero())
+	suite.Require().Empty(twammKeeper.GetAllOrderPools(suite.Ctx))
+
+	proceeds, err = twammKeeper.WithdrawProceeds(suite.Ctx, acc1, orderId)
+	suite.Require().NoError(err)
+	totalOut := boughtHalfWay.Add(proceeds.AmountOf("bar"))
+	suite.Require().True(totalOut.Sub(oneShotOut.Amount).Abs().LTE(sdk.OneInt()), "%s != %s", totalOut, oneShotOut.Amount)
+	suite.Require().True(suite.moduleBalance("bar").LTE(sdk.OneInt()))
+
+	// Withdrawing after the end closes the order.
+	_, err = twammKeeper.GetLongTermOrder(suite.Ctx, orderId)
+	suite.Require().ErrorIs(err, types.ErrOrderNotFound)
+	_, err = twammKeeper.WithdrawProceeds(suite.Ctx, acc1, orderId)
+	suite.Require().ErrorIs(err, types.ErrOrderNotFound)
+}
+
+func (suite *KeeperTestSuite) TestLongTermOrdersSharingOrderPool() {
+	poolId := suite.prepareFooBarPool()
+	twammKeeper := suite.App.TwammKeeper
+
+	// acc1 sells twice as fast as acc2, until acc2's order ends.
+	orderId1, err := twammKeeper.PlaceLongTermOrder(suite.Ctx, acc1, poolId, sdk.NewInt64Coin("foo", 7200000), "bar", time.Hour)
+	suite.Require().NoError(err)
+	orderId2, err := twammKeeper.PlaceLongTermOrder(suite.Ctx, acc2, poolId, sdk.NewInt64Coin("foo", 1800000), "bar", 30*time.Minute)
+	suite.Require().NoError(err)
+
+	suite.executeAt(startTime.Add(30 * time.Minute))
+	op := twammKeeper.GetAllOrderPools(suite.Ctx)
+	suite.Require().Len(op, 1)
+	suite.Require().Equal(sdk.NewDec(2000), op[0].SellRate)
+
+	proceeds1, err := twammKeeper.WithdrawProceeds(suite.Ctx, acc1, orderId1)
+	suite.Require().NoError(err)
+	proceeds2, err := twammKeeper.WithdrawProceeds(suite.Ctx, acc2, orderId2)
+	suite.Require().NoError(err)
+	suite.Require().True(proceeds2.AmountOf("bar").IsPositive())
+	suite.Require().True(proceeds1.AmountOf("bar").Sub(proceeds2.AmountOf("bar").MulRaw(2)).Abs().LTE(sdk.NewInt(2)))
+
+	// acc2's order has ended and is closed.
+	_, err = twammKeeper.GetLongTermOrder(suite.Ctx, orderId2)
+	suite.Require().ErrorIs(err, types.ErrOrderNotFound)
+}
+
+func (suite *KeeperTestSuite) TestCancelLongTermOrder() {
+	poolId := suite.prepareFooBarPool()
+	twammKeeper := suite.App.TwammKeeper
+
+	orderId, err := twammKeeper.PlaceLongTermOrder(suite.Ctx, acc1, poolId, sdk.NewInt64Coin("foo", 3600000), "bar", time.Hour)
+	suite.Require().NoError(err)
+
+	suite.executeAt(startTime.Add(15 * time.Minute))
+	bought := suite.moduleBalance("bar")
+
+	_, _, err = twammKeeper.CancelLongTermOrder(suite.Ctx, acc2, orderId)
+	suite.Require().ErrorIs(err, types.ErrNotOrderOwner)
+
+	// A quarter of the deposit was sold, the rest is refunded.
+	refund, proceeds, err := twammKeeper.CancelLongTermOrder(suite.Ctx, acc1, orderId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("foo", 2700000)), refund)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("bar", bought)), proceeds)
+	suite.Require().Equal(sdk.NewInt(99100000), suite.App.BankKeeper.GetBalance(suite.Ctx, acc1, "foo").Amount)
+	suite.Require().True(suite.moduleBalance("foo").IsZero())
+	suite.Require().True(suite.moduleBalance("bar").IsZero())
+
+	_, err = twammKeeper.GetLongTermOrder(suite.Ctx, orderId)
+	suite.Require().ErrorIs(err, types.ErrOrderNotFound)
+	suite.Require().Empty(twammKeeper.GetAllOrderPools(suite.Ctx))
+
+	// The order pool no longer sells.
+	suite.executeAt(startTime.Add(30 * time.Minute))
+	suite.Require().True(suite.moduleBalance("bar").IsZero())
+}
+
+func (suite *KeeperTestSuite) TestCancelEndedLongTermOrder() {
+	poolId := suite.prepareFooBarPool()
+	twammKeeper := suite.App.TwammKeeper
+
+	orderId, err := twammKeeper.PlaceLongTermOrder(suite.Ctx, acc1, poolId, sdk.NewInt64Coin("foo", 3600000), "bar", time.Hour)
+	suite.Require().NoError(err)
+
+	suite.executeAt(startTime.Add(time.Hour))
+	_, _, err = twammKeeper.CancelLongTermOrder(suite.Ctx, acc1, orderId)
+	suite.Require().ErrorIs(err, types.ErrOrderEnded)
+}
+
+func (suite *KeeperTestSuite) TestLongTermOrderAgainstPausedPool() {
+	poolId := suite.prepareFooBarPool()
+	twammKeeper := suite.App.TwammKeeper
+
+	orderId, err := twammKeeper.PlaceLongTermOrder(suite.Ctx, acc1, poolId, sdk.NewInt64Coin("foo", 3600000), "bar", time.Hour)
+	suite.Require().NoError(err)
+
+	pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	pool.(gammtypes.PoolGovernorExtension).SetActive(false)
+	suite.Require().NoError(suite.App.GAMMKeeper.SetPool(suite.Ctx, pool))
+
+	// What could not be sold while the pool was paused is refunded.
+	suite.executeAt(startTime.Add(30 * time.Minute))
+	proceeds, err := twammKeeper.WithdrawProceeds(suite.Ctx, acc1, orderId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("foo", 1800000)), proceeds)
+
+	// New orders can't be placed against the paused pool.
+	_, err = twammKeeper.PlaceLongTermOrder(suite.Ctx, acc2, poolId, sdk.NewInt64Coin("foo", 3600000), "bar", time.Hour)
+	suite.Require().ErrorIs(err, types.ErrPoolInactive)
+}
+
+func (suite *KeeperTestSuite) TestPlaceLongTermOrderInvalid() {
+	poolId := suite.prepareFooBarPool()
+
+	tests := []struct {
+		name          string
+		poolId        uint64
+		tokenIn       sdk.Coin
+		tokenOutDenom string
+		duration      time.Duration
+		expectedErr   error
+	}{
+		{
+			name:          "duration over the max",
+			poolId:        poolId,
+			tokenIn:       sdk.NewInt64Coin("foo", 1000),
+			tokenOutDenom: "bar",
+			duration:      types.DefaultParams().MaxOrderDuration + time.Second,
+			expectedErr:   types.ErrInvalidDuration,
+		},
+		{
+			name:          "token out not in pool",
+			poolId:        poolId,
+			tokenIn:       sdk.NewInt64Coin("foo", 1000),
+			tokenOutDenom: "baz",
+			duration:      time.Hour,
+			expectedErr:   types.ErrInvalidDenom,
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			_, err := suite.App.TwammKeeper.PlaceLongTermOrder(suite.Ctx, acc1, tc.poolId, tc.tokenIn, tc.tokenOutDenom, tc.duration)
+			suite.Require().ErrorIs(err, tc.expectedErr)
+		})
+	}
+
+	_, err := suite.App.TwammKeeper.PlaceLongTermOrder(suite.Ctx, acc1, poolId+1, sdk.NewInt64Coin("foo", 1000), "bar", time.Hour)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestOrdersQueries() {
+	poolId1 := suite.prepareFooBarPool()
+	poolId2 := suite.prepareFooBarPool()
+	twammKeeper := suite.App.TwammKeeper
+
+	for _, order := range []struct {
+		owner  sdk.AccAddress
+		poolId uint64
+	}{{acc1, poolId1}, {acc1, poolId2}, {acc2, poolId1}} {
+		_, err := twammKeeper.PlaceLongTermOrder(suite.Ctx, order.owner, order.poolId, sdk.NewInt64Coin("foo", 3600000), "bar", time.Hour)
+		suite.Require().NoError(err)
+	}
+	suite.executeAt(startTime.Add(time.Minute))
+
+	ctx := sdk.WrapSDKContext(suite.Ctx)
+	byOwner, err := suite.queryClient.OrdersByOwner(ctx, &types.QueryOrdersByOwnerRequest{Owner: acc1.String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(byOwner.Orders, 2)
+	suite.Require().Equal(uint64(1), byOwner.Orders[0].Id)
+	suite.Require().Equal(uint64(2), byOwner.Orders[1].Id)
+	suite.Require().True(byOwner.Orders[0].UnclaimedProceeds.IsPositive())
+
+	byPool, err := suite.queryClient.OrdersByPool(ctx, &types.QueryOrdersByPoolRequest{PoolId: poolId1})
+	suite.Require().NoError(err)
+	suite.Require().Len(byPool.Orders, 2)
+	suite.Require().Equal(uint64(1), byPool.Orders[0].Id)
+	suite.Require().Equal(uint64(3), byPool.Orders[1].Id)
+	suite.Require().Equal(acc2.String(), byPool.Orders[1].Owner)
+}
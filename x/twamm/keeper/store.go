@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	"time"
+
+	gogotypes "github.com/gogo/protobuf/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v7/x/twamm/types"
+)
+
+// GetLongTermOrder returns a long-term order, as last settled.
+func (k Keeper) GetLongTermOrder(ctx sdk.Context, orderId uint64) (types.LongTermOrder, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetKeyOrder(orderId))
+	if bz == nil {
+		return types.LongTermOrder{}, sdkerrors.Wrapf(types.ErrOrderNotFound, "order %d", orderId)
+	}
+
+	var order types.LongTermOrder
+	k.cdc.MustUnmarshal(bz, &order)
+	return order, nil
+}
+
+// GetAllLongTermOrders returns every long-term order, by increasing ID.
+func (k Keeper) GetAllLongTermOrders(ctx sdk.Context) []types.LongTermOrder {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.KeyPrefixOrders)
+	defer iter.Close()
+
+	orders := []types.LongTermOrder{}
+	for ; iter.Valid(); iter.Next() {
+		var order types.LongTermOrder
+		k.cdc.MustUnmarshal(iter.Value(), &order)
+		orders = append(orders, order)
+	}
+	return orders
+}
+
+// GetNextOrderId returns the ID the next order will have.
+func (k Keeper) GetNextOrderId(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyNextOrderId)
+	if bz == nil {
+		panic("next order id has not been set")
+	}
+
+	nextOrderId := gogotypes.UInt64Value{}
+	k.cdc.MustUnmarshal(bz, &nextOrderId)
+	return nextOrderId.Value
+}
+
+// SetNextOrderId sets the ID the next order will have.
+func (k Keeper) SetNextOrderId(ctx sdk.Context, orderId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: orderId})
+	store.Set(types.KeyNextOrderId, bz)
+}
+
+func (k Keeper) getNextOrderIdAndIncrement(ctx sdk.Context) uint64 {
+	nextOrderId := k.GetNextOrderId(ctx)
+	k.SetNextOrderId(ctx, nextOrderId+1)
+	return nextOrderId
+}
+
+func (k Keeper) setLongTermOrder(ctx sdk.Context, order types.LongTermOrder) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetKeyOrder(order.Id), k.cdc.MustMarshal(&order))
+	store.Set(types.GetKeyOwnerOrder(order.GetOwnerAddress(), order.Id), []byte{})
+	store.Set(types.GetKeyPoolOrder(order.PoolId, order.Id), []byte{})
+}
+
+func (k Keeper) deleteLongTermOrder(ctx sdk.Context, order types.LongTermOrder) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetKeyOrder(order.Id))
+	store.Delete(types.GetKeyOwnerOrder(order.GetOwnerAddress(), order.Id))
+	store.Delete(types.GetKeyPoolOrder(order.PoolId, order.Id))
+	store.Delete(types.GetKeyOrderExpiry(order))
+}
+
+// getOwnedOrder returns an order, checking that sender owns it.
+func (k Keeper) getOwnedOrder(ctx sdk.Context, sender sdk.AccAddress, orderId uint64) (types.LongTermOrder, error) {
+	order, err := k.GetLongTermOrder(ctx, orderId)
+	if err != nil {
+		return types.LongTermOrder{}, err
+	}
+	if order.Owner != sender.String() {
+		return types.LongTermOrder{}, sdkerrors.Wrapf(types.ErrNotOrderOwner, "order %d is owned by %s", orderId, order.Owner)
+	}
+	return order, nil
+}
+
+// getOrderPool returns the order pool selling tokenInDenom for tokenOutDenom
+// against a pool, and whether it has open orders.
+func (k Keeper) getOrderPool(ctx sdk.Context, poolId uint64, tokenInDenom, tokenOutDenom string) (types.OrderPool, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetKeyOrderPool(poolId, tokenInDenom, tokenOutDenom))
+	if bz == nil {
+		return types.OrderPool{}, false
+	}
+
+	var op types.OrderPool
+	k.cdc.MustUnmarshal(bz, &op)
+	return op, true
+}
+
+// GetAllOrderPools returns the order pools with open orders.
+func (k Keeper) GetAllOrderPools(ctx sdk.Context) []types.OrderPool {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.KeyPrefixOrderPools)
+	defer iter.Close()
+
+	orderPools := []types.OrderPool{}
+	for ; iter.Valid(); iter.Next() {
+		var op types.OrderPool
+		k.cdc.MustUnmarshal(iter.Value(), &op)
+		orderPools = append(orderPools, op)
+	}
+	return orderPools
+}
+
+// setOrderPool stores an order pool, or deletes it once it has no open
+// orders left. Whatever it could not sell of its last orders is dust below
+// one token, and is left in the module account.
+func (k Keeper) setOrderPool(ctx sdk.Context, op types.OrderPool) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetKeyOrderPool(op.PoolId, op.TokenInDenom, op.TokenOutDenom)
+	if !op.SellRate.IsPositive() {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, k.cdc.MustMarshal(&op))
+}
+
+// getOrdersEndingUntil returns the open orders of an order pool that end at
+// endTime or before, by increasing end time.
+func (k Keeper) getOrdersEndingUntil(ctx sdk.Context, op types.OrderPool, endTime time.Time) ([]types.LongTermOrder, error) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetKeyPrefixOrderExpiries(op.PoolId, op.TokenInDenom, op.TokenOutDenom)
+	end := sdk.PrefixEndBytes(types.GetKeyPrefixOrderExpiriesUntil(op.PoolId, op.TokenInDenom, op.TokenOutDenom, endTime))
+	iter := store.Iterator(prefix, end)
+	defer iter.Close()
+
+	orders := []types.LongTermOrder{}
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		order, err := k.GetLongTermOrder(ctx, sdk.BigEndianToUint64(key[len(key)-8:]))
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, order)
+	}
+	return orders, nil
+}
@@ -0,0 +1,15 @@
+package types
+
+import sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+// x/twamm module sentinel errors.
+var (
+	ErrOrderNotFound    = sdkerrors.Register(ModuleName, 2, "long-term order not found")
+	ErrNotOrderOwner    = sdkerrors.Register(ModuleName, 3, "sender does not own the order")
+	ErrNotBalancerPool  = sdkerrors.Register(ModuleName, 4, "long-term orders can only be placed against balancer pools")
+	ErrPoolInactive     = sdkerrors.Register(ModuleName, 5, "pool does not allow swaps")
+	ErrInvalidDenom     = sdkerrors.Register(ModuleName, 6, "denom is not one of the pool's tokens")
+	ErrInvalidDuration  = sdkerrors.Register(ModuleName, 7, "invalid order duration")
+	ErrSellRateTooSmall = sdkerrors.Register(ModuleName, 8, "order sells too little per second")
+	ErrOrderEnded       = sdkerrors.Register(ModuleName, 9, "order has already ended")
+)
@@ -0,0 +1,16 @@
+package types
+
+const (
+	TypeEvtLongTermOrderPlaced    = "long_term_order_placed"
+	TypeEvtLongTermOrderCancelled = "long_term_order_cancelled"
+	TypeEvtProceedsWithdrawn      = "long_term_order_proceeds_withdrawn"
+
+	AttributeValueCategory = ModuleName
+	AttributeKeyOrderId    = "order_id"
+	AttributeKeyPoolId     = "pool_id"
+	AttributeKeyTokenIn    = "token_in"
+	AttributeKeyTokenOut   = "token_out_denom"
+	AttributeKeyEndTime    = "end_time"
+	AttributeKeyRefund     = "refund"
+	AttributeKeyProceeds   = "proceeds"
+)
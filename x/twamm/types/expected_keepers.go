@@ -0,0 +1,36 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	swaproutertypes "github.com/osmosis-labs/osmosis/v7/x/swaprouter/types"
+)
+
+// AccountKeeper defines the account contract that must be fulfilled when
+// creating a x/twamm keeper.
+type AccountKeeper interface {
+	GetModuleAddress(moduleName string) sdk.AccAddress
+	GetModuleAccount(ctx sdk.Context, moduleName string) authtypes.ModuleAccountI
+}
+
+// BankKeeper defines the banking contract that must be fulfilled when
+// creating a x/twamm keeper.
+type BankKeeper interface {
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// GammKeeper defines the contract needed to be fulfilled for the gamm
+// keeper, whose pools long-term orders are placed on.
+type GammKeeper interface {
+	GetPool(ctx sdk.Context, poolId uint64) (swaproutertypes.PoolI, error)
+}
+
+// SwapRouterKeeper defines the contract needed to be fulfilled for the swap
+// router, which long-term orders are executed through, so that they are
+// charged the taker fee like other swaps.
+type SwapRouterKeeper interface {
+	CalcOutAmtGivenIn(ctx sdk.Context, poolId uint64, tokenIn sdk.Coin, tokenOutDenom string) (sdk.Coin, error)
+	SwapExactAmountIn(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, tokenIn sdk.Coin, tokenOutDenom string, tokenOutMinAmount sdk.Int) (sdk.Int, error)
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultGenesis returns the default twamm genesis state.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params:      DefaultParams(),
+		OrderPools:  []OrderPool{},
+		Orders:      []LongTermOrder{},
+		NextOrderId: 1,
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure. It checks that the sell rate of each order pool is that of its
+// open orders.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	if gs.NextOrderId == 0 {
+		return fmt.Errorf("next order id must be positive")
+	}
+
+	orderPools := make(map[string]OrderPool, len(gs.OrderPools))
+	sellRates := make(map[string]sdk.Dec, len(gs.OrderPools))
+	for _, op := range gs.OrderPools {
+		key := string(GetKeyOrderPool(op.PoolId, op.TokenInDenom, op.TokenOutDenom))
+		if _, ok := orderPools[key]; ok {
+			return fmt.Errorf("duplicate order pool selling %s for %s in pool %d", op.TokenInDenom, op.TokenOutDenom, op.PoolId)
+		}
+		if err := op.Validate(); err != nil {
+			return fmt.Errorf("invalid order pool selling %s for %s in pool %d: %w", op.TokenInDenom, op.TokenOutDenom, op.PoolId, err)
+		}
+		orderPools[key] = op
+		sellRates[key] = sdk.ZeroDec()
+	}
+
+	orders := make(map[uint64]bool, len(gs.Orders))
+	for _, order := range gs.Orders {
+		if orders[order.Id] {
+			return fmt.Errorf("duplicate order %d", order.Id)
+		}
+		if order.Id >= gs.NextOrderId {
+			return fmt.Errorf("order %d is not below the next order id %d", order.Id, gs.NextOrderId)
+		}
+		if err := order.Validate(); err != nil {
+			return fmt.Errorf("invalid order %d: %w", order.Id, err)
+		}
+		orders[order.Id] = true
+
+		key := string(order.OrderPoolKey())
+		if op, ok := orderPools[key]; ok && order.IsOpen(op) {
+			sellRates[key] = sellRates[key].Add(order.SellRate)
+		}
+	}
+
+	for key, op := range orderPools {
+		if !sellRates[key].Equal(op.SellRate) {
+			return fmt.Errorf("order pool selling %s for %s in pool %d has sell rate %s, but its open orders sell %s",
+				op.TokenInDenom, op.TokenOutDenom, op.PoolId, op.SellRate, sellRates[key])
+		}
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	ModuleName = "twamm"
+
+	StoreKey = ModuleName
+
+	RouterKey = ModuleName
+
+	QuerierRoute = ModuleName
+)
+
+var (
+	// KeyNextOrderId defines the key to store the next order ID to be used.
+	KeyNextOrderId = []byte{0x01}
+
+	// KeyPrefixOrders defines the key prefix of long-term orders.
+	KeyPrefixOrders = []byte{0x02}
+
+	// KeyPrefixOwnerOrders defines the key prefix of the index of orders by
+	// owner.
+	KeyPrefixOwnerOrders = []byte{0x03}
+
+	// KeyPrefixPoolOrders defines the key prefix of the index of orders by
+	// pool.
+	KeyPrefixPoolOrders = []byte{0x04}
+
+	// KeyPrefixOrderPools defines the key prefix of order pools.
+	KeyPrefixOrderPools = []byte{0x05}
+
+	// KeyPrefixOrderExpiries defines the key prefix of the index of open
+	// orders by the time they end.
+	KeyPrefixOrderExpiries = []byte{0x06}
+)
+
+// GetKeyOrder returns the key of an order.
+func GetKeyOrder(orderId uint64) []byte {
+	return append(KeyPrefixOrders, sdk.Uint64ToBigEndian(orderId)...)
+}
+
+// GetKeyPrefixOwnerOrders returns the key prefix of the index of the orders
+// of an owner.
+func GetKeyPrefixOwnerOrders(owner sdk.AccAddress) []byte {
+	return append(KeyPrefixOwnerOrders, address.MustLengthPrefix(owner)...)
+}
+
+// GetKeyOwnerOrder returns the key of an order in the index of the orders of
+// its owner.
+func GetKeyOwnerOrder(owner sdk.AccAddress, orderId uint64) []byte {
+	return append(GetKeyPrefixOwnerOrders(owner), sdk.Uint64ToBigEndian(orderId)...)
+}
+
+// GetKeyPrefixPoolOrders returns the key prefix of the index of the orders
+// against a pool.
+func GetKeyPrefixPoolOrders(poolId uint64) []byte {
+	return append(KeyPrefixPoolOrders, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// GetKeyPoolOrder returns the key of an order in the index of the orders
+// against its pool.
+func GetKeyPoolOrder(poolId uint64, orderId uint64) []byte {
+	return append(GetKeyPrefixPoolOrders(poolId), sdk.Uint64ToBigEndian(orderId)...)
+}
+
+// orderPoolSuffix identifies the order pool selling tokenInDenom for
+// tokenOutDenom against a pool.
+func orderPoolSuffix(poolId uint64, tokenInDenom, tokenOutDenom string) []byte {
+	bz := sdk.Uint64ToBigEndian(poolId)
+	bz = append(bz, address.MustLengthPrefix([]byte(tokenInDenom))...)
+	return append(bz, address.MustLengthPrefix([]byte(tokenOutDenom))...)
+}
+
+// GetKeyOrderPool returns the key of an order pool.
+func GetKeyOrderPool(poolId uint64, tokenInDenom, tokenOutDenom string) []byte {
+	return append(KeyPrefixOrderPools, orderPoolSuffix(poolId, tokenInDenom, tokenOutDenom)...)
+}
+
+// GetKeyPrefixOrderExpiries returns the key prefix of the index of the open
+// orders of an order pool by end time.
+func GetKeyPrefixOrderExpiries(poolId uint64, tokenInDenom, tokenOutDenom string) []byte {
+	return append(KeyPrefixOrderExpiries, orderPoolSuffix(poolId, tokenInDenom, tokenOutDenom)...)
+}
+
+// GetKeyPrefixOrderExpiriesUntil returns the key prefix of the orders of an
+// order pool that end at endTime. As times are encoded to sort in time order,
+// it also bounds the iteration over the orders ending at endTime or before.
+func GetKeyPrefixOrderExpiriesUntil(poolId uint64, tokenInDenom, tokenOutDenom string, endTime time.Time) []byte {
+	return append(GetKeyPrefixOrderExpiries(poolId, tokenInDenom, tokenOutDenom), sdk.FormatTimeBytes(endTime)...)
+}
+
+// GetKeyOrderExpiry returns the key of an order in the index of the open
+// orders of its order pool by end time.
+func GetKeyOrderExpiry(order LongTermOrder) []byte {
+	prefix := GetKeyPrefixOrderExpiriesUntil(order.PoolId, order.TokenInDenom, order.TokenOutDenom, order.EndTime)
+	return append(prefix, sdk.Uint64ToBigEndian(order.Id)...)
+}
@@ -0,0 +1,107 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// constants.
+const (
+	TypeMsgPlaceLongTermOrder  = "place_long_term_order"
+	TypeMsgCancelLongTermOrder = "cancel_long_term_order"
+	TypeMsgWithdrawProceeds    = "withdraw_proceeds"
+)
+
+var _ sdk.Msg = &MsgPlaceLongTermOrder{}
+
+func (msg MsgPlaceLongTermOrder) Route() string { return RouterKey }
+func (msg MsgPlaceLongTermOrder) Type() string  { return TypeMsgPlaceLongTermOrder }
+func (msg MsgPlaceLongTermOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if !msg.TokenIn.IsValid() || !msg.TokenIn.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.TokenIn.String())
+	}
+
+	if err := sdk.ValidateDenom(msg.TokenOutDenom); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if msg.TokenIn.Denom == msg.TokenOutDenom {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cannot sell and buy the same denom")
+	}
+
+	if msg.Duration < time.Second {
+		return sdkerrors.Wrap(ErrInvalidDuration, fmt.Sprintf("order must last at least a second: %s", msg.Duration))
+	}
+
+	return nil
+}
+
+func (msg MsgPlaceLongTermOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgPlaceLongTermOrder) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgCancelLongTermOrder{}
+
+func (msg MsgCancelLongTermOrder) Route() string { return RouterKey }
+func (msg MsgCancelLongTermOrder) Type() string  { return TypeMsgCancelLongTermOrder }
+func (msg MsgCancelLongTermOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	return nil
+}
+
+func (msg MsgCancelLongTermOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCancelLongTermOrder) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgWithdrawProceeds{}
+
+func (msg MsgWithdrawProceeds) Route() string { return RouterKey }
+func (msg MsgWithdrawProceeds) Type() string  { return TypeMsgWithdrawProceeds }
+func (msg MsgWithdrawProceeds) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	return nil
+}
+
+func (msg MsgWithdrawProceeds) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgWithdrawProceeds) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
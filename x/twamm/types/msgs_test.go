@@ -0,0 +1,106 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	appParams "github.com/osmosis-labs/osmosis/v7/app/params"
+)
+
+func TestMsgPlaceLongTermOrder(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg MsgPlaceLongTermOrder) MsgPlaceLongTermOrder) MsgPlaceLongTermOrder {
+		properMsg := MsgPlaceLongTermOrder{
+			Sender:        addr1,
+			PoolId:        1,
+			TokenIn:       sdk.NewInt64Coin("foo", 1000000),
+			TokenOutDenom: "bar",
+			Duration:      time.Hour,
+		}
+
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg MsgPlaceLongTermOrder) MsgPlaceLongTermOrder {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "place_long_term_order")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        MsgPlaceLongTermOrder
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg MsgPlaceLongTermOrder) MsgPlaceLongTermOrder {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg MsgPlaceLongTermOrder) MsgPlaceLongTermOrder {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero token in",
+			msg: createMsg(func(msg MsgPlaceLongTermOrder) MsgPlaceLongTermOrder {
+				msg.TokenIn = sdk.NewInt64Coin("foo", 0)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid token out denom",
+			msg: createMsg(func(msg MsgPlaceLongTermOrder) MsgPlaceLongTermOrder {
+				msg.TokenOutDenom = "1"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "same denom in and out",
+			msg: createMsg(func(msg MsgPlaceLongTermOrder) MsgPlaceLongTermOrder {
+				msg.TokenOutDenom = "foo"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "duration under a second",
+			msg: createMsg(func(msg MsgPlaceLongTermOrder) MsgPlaceLongTermOrder {
+				msg.Duration = time.Millisecond
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
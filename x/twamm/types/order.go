@@ -0,0 +1,119 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewOrderPool returns an order pool without orders, executed up to now.
+func NewOrderPool(poolId uint64, tokenInDenom, tokenOutDenom string, now time.Time) OrderPool {
+	return OrderPool{
+		PoolId:            poolId,
+		TokenInDenom:      tokenInDenom,
+		TokenOutDenom:     tokenOutDenom,
+		SellRate:          sdk.ZeroDec(),
+		Unsold:            sdk.ZeroDec(),
+		ProceedsPerRate:   sdk.ZeroDec(),
+		RefundPerRate:     sdk.ZeroDec(),
+		LastExecutionTime: now,
+	}
+}
+
+// SellRateForDeposit returns how much of deposit to sell per second, for
+// deposit to be sold over duration. It is rounded down, so that orders never
+// sell more than their deposit.
+func SellRateForDeposit(deposit sdk.Int, duration time.Duration) sdk.Dec {
+	return deposit.ToDec().MulInt64(int64(time.Second)).QuoInt64(duration.Nanoseconds())
+}
+
+// AmountSold returns how much is sold at sellRate over elapsed.
+func AmountSold(sellRate sdk.Dec, elapsed time.Duration) sdk.Dec {
+	return sellRate.MulInt64(elapsed.Nanoseconds()).QuoInt64(int64(time.Second))
+}
+
+// Validate performs basic validation of the order pool.
+func (op OrderPool) Validate() error {
+	if err := sdk.ValidateDenom(op.TokenInDenom); err != nil {
+		return err
+	}
+	if err := sdk.ValidateDenom(op.TokenOutDenom); err != nil {
+		return err
+	}
+	if op.TokenInDenom == op.TokenOutDenom {
+		return errors.New("order pool sells and buys the same denom")
+	}
+	for _, dec := range []sdk.Dec{op.SellRate, op.Unsold, op.ProceedsPerRate, op.RefundPerRate} {
+		if dec.IsNil() || dec.IsNegative() {
+			return errors.New("order pool amounts must not be negative")
+		}
+	}
+	if !op.SellRate.IsPositive() {
+		return errors.New("order pool has no open orders")
+	}
+	return nil
+}
+
+// GetOwnerAddress returns the address owning the order.
+func (order LongTermOrder) GetOwnerAddress() sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(order.Owner)
+	if err != nil {
+		panic(fmt.Sprintf("could not bech32 decode owner of order with id: %d", order.Id))
+	}
+	return owner
+}
+
+// OrderPoolKey returns the key of the order pool the order is executed in.
+func (order LongTermOrder) OrderPoolKey() []byte {
+	return GetKeyOrderPool(order.PoolId, order.TokenInDenom, order.TokenOutDenom)
+}
+
+// IsOpen returns whether the order is still selling in the order pool, that
+// is whether the order pool has not been executed up to its end time yet.
+func (order LongTermOrder) IsOpen(op OrderPool) bool {
+	return order.EndTime.After(op.LastExecutionTime)
+}
+
+// Settle accrues to the order what it bought, and what could not be sold, in
+// the order pool since it was last settled. The order must be open.
+func (order *LongTermOrder) Settle(op OrderPool) {
+	proceeds := op.ProceedsPerRate.Sub(order.ProceedsPerRate).MulTruncate(order.SellRate)
+	refund := op.RefundPerRate.Sub(order.RefundPerRate).MulTruncate(order.SellRate)
+	order.UnclaimedProceeds = order.UnclaimedProceeds.Add(proceeds)
+	order.UnclaimedRefund = order.UnclaimedRefund.Add(refund)
+	order.ProceedsPerRate = op.ProceedsPerRate
+	order.RefundPerRate = op.RefundPerRate
+}
+
+// Validate performs basic validation of the order.
+func (order LongTermOrder) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(order.Owner); err != nil {
+		return err
+	}
+	if err := sdk.ValidateDenom(order.TokenInDenom); err != nil {
+		return err
+	}
+	if err := sdk.ValidateDenom(order.TokenOutDenom); err != nil {
+		return err
+	}
+	if order.TokenInDenom == order.TokenOutDenom {
+		return errors.New("order sells and buys the same denom")
+	}
+	if order.Deposit.IsNil() || !order.Deposit.IsPositive() {
+		return errors.New("order deposit must be positive")
+	}
+	if order.SellRate.IsNil() || !order.SellRate.IsPositive() {
+		return errors.New("order sell rate must be positive")
+	}
+	if !order.EndTime.After(order.StartTime) {
+		return fmt.Errorf("order ends at %s, not after it starts at %s", order.EndTime, order.StartTime)
+	}
+	for _, dec := range []sdk.Dec{order.ProceedsPerRate, order.RefundPerRate, order.UnclaimedProceeds, order.UnclaimedRefund} {
+		if dec.IsNil() || dec.IsNegative() {
+			return errors.New("order amounts must not be negative")
+		}
+	}
+	return nil
+}
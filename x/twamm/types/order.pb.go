@@ -0,0 +1,1424 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/twamm/v1beta1/order.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// LongTermOrder sells a deposit of token_in for token_out against a pool at a
+// constant rate, from start_time to end_time.
+type LongTermOrder struct {
+	Id            uint64                                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" yaml:"id"`
+	Owner         string                                 `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	PoolId        uint64                                 `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	TokenInDenom  string                                 `protobuf:"bytes,4,opt,name=token_in_denom,json=tokenInDenom,proto3" json:"token_in_denom,omitempty" yaml:"token_in_denom"`
+	TokenOutDenom string                                 `protobuf:"bytes,5,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty" yaml:"token_out_denom"`
+	Deposit       github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=deposit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"deposit" yaml:"deposit"`
+	// sell_rate is the amount of token_in sold per second.
+	SellRate  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=sell_rate,json=sellRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"sell_rate" yaml:"sell_rate"`
+	StartTime time.Time                              `protobuf:"bytes,8,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	EndTime   time.Time                              `protobuf:"bytes,9,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
+	// proceeds_per_rate and refund_per_rate are the order pool's accumulators
+	// when the order's proceeds were last settled.
+	ProceedsPerRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=proceeds_per_rate,json=proceedsPerRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"proceeds_per_rate" yaml:"proceeds_per_rate"`
+	RefundPerRate   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=refund_per_rate,json=refundPerRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"refund_per_rate" yaml:"refund_per_rate"`
+	// unclaimed_proceeds is the token_out the order has bought and its owner
+	// has not withdrawn yet.
+	UnclaimedProceeds github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=unclaimed_proceeds,json=unclaimedProceeds,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"unclaimed_proceeds" yaml:"unclaimed_proceeds"`
+	// unclaimed_refund is the token_in the order could not sell, because swaps
+	// against the pool failed, and its owner has not withdrawn yet.
+	UnclaimedRefund github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=unclaimed_refund,json=unclaimedRefund,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"unclaimed_refund" yaml:"unclaimed_refund"`
+}
+
+func (m *LongTermOrder) Reset()         { *m = LongTermOrder{} }
+func (m *LongTermOrder) String() string { return proto.CompactTextString(m) }
+func (*LongTermOrder) ProtoMessage()    {}
+func (*LongTermOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4fc0361924028ec, []int{0}
+}
+func (m *LongTermOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LongTermOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LongTermOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LongTermOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LongTermOrder.Merge(m, src)
+}
+func (m *LongTermOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *LongTermOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_LongTermOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LongTermOrder proto.InternalMessageInfo
+
+func (m *LongTermOrder) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *LongTermOrder) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *LongTermOrder) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *LongTermOrder) GetTokenInDenom() string {
+	if m != nil {
+		return m.TokenInDenom
+	}
+	return ""
+}
+
+func (m *LongTermOrder) GetTokenOutDenom() string {
+	if m != nil {
+		return m.TokenOutDenom
+	}
+	return ""
+}
+
+func (m *LongTermOrder) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *LongTermOrder) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+// OrderPool aggregates the open long-term orders selling token_in for
+// token_out against a pool, which are executed together.
+type OrderPool struct {
+	PoolId        uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	TokenInDenom  string `protobuf:"bytes,2,opt,name=token_in_denom,json=tokenInDenom,proto3" json:"token_in_denom,omitempty" yaml:"token_in_denom"`
+	TokenOutDenom string `protobuf:"bytes,3,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty" yaml:"token_out_denom"`
+	// sell_rate is the sum of the sell rates of the open orders.
+	SellRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=sell_rate,json=sellRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"sell_rate" yaml:"sell_rate"`
+	// unsold is the fraction of a token_in that was due to be sold but could
+	// not be, as swaps take whole tokens. It is sold with the next swap.
+	Unsold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=unsold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"unsold" yaml:"unsold"`
+	// proceeds_per_rate is the token_out bought since the order pool was
+	// created, per unit of sell rate.
+	ProceedsPerRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=proceeds_per_rate,json=proceedsPerRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"proceeds_per_rate" yaml:"proceeds_per_rate"`
+	// refund_per_rate is the token_in that could not be sold since the order
+	// pool was created, per unit of sell rate.
+	RefundPerRate     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=refund_per_rate,json=refundPerRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"refund_per_rate" yaml:"refund_per_rate"`
+	LastExecutionTime time.Time                              `protobuf:"bytes,8,opt,name=last_execution_time,json=lastExecutionTime,proto3,stdtime" json:"last_execution_time" yaml:"last_execution_time"`
+}
+
+func (m *OrderPool) Reset()         { *m = OrderPool{} }
+func (m *OrderPool) String() string { return proto.CompactTextString(m) }
+func (*OrderPool) ProtoMessage()    {}
+func (*OrderPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4fc0361924028ec, []int{1}
+}
+func (m *OrderPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrderPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderPool.Merge(m, src)
+}
+func (m *OrderPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderPool proto.InternalMessageInfo
+
+func (m *OrderPool) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *OrderPool) GetTokenInDenom() string {
+	if m != nil {
+		return m.TokenInDenom
+	}
+	return ""
+}
+
+func (m *OrderPool) GetTokenOutDenom() string {
+	if m != nil {
+		return m.TokenOutDenom
+	}
+	return ""
+}
+
+func (m *OrderPool) GetLastExecutionTime() time.Time {
+	if m != nil {
+		return m.LastExecutionTime
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*LongTermOrder)(nil), "osmosis.twamm.v1beta1.LongTermOrder")
+	proto.RegisterType((*OrderPool)(nil), "osmosis.twamm.v1beta1.OrderPool")
+}
+
+func init() { proto.RegisterFile("osmosis/twamm/v1beta1/order.proto", fileDescriptor_f4fc0361924028ec) }
+
+var fileDescriptor_f4fc0361924028ec = []byte{
+	// 714 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x95, 0x4d, 0x6f, 0xd3, 0x30,
+	0x1c, 0xc6, 0x9b, 0x6e, 0xeb, 0x8b, 0xb7, 0xae, 0x6b, 0x60, 0x90, 0x15, 0xad, 0x19, 0x3e, 0x4c,
+	0x93, 0xd0, 0x12, 0x06, 0x07, 0x24, 0x2e, 0x43, 0xd5, 0x90, 0xe8, 0x40, 0xda, 0x64, 0x4d, 0x02,
+	0xed, 0x12, 0xa5, 0xb5, 0x57, 0xa2, 0x25, 0x71, 0x14, 0x3b, 0x7b, 0xe1, 0x53, 0xec, 0x63, 0xed,
+	0xc6, 0x2e, 0x48, 0x88, 0x43, 0x40, 0xdb, 0x37, 0xe8, 0x27, 0x40, 0xb1, 0x9d, 0xee, 0x85, 0x49,
+	0x10, 0xd0, 0xc4, 0xa9, 0xf6, 0xe3, 0xc7, 0xbf, 0xc7, 0x2f, 0xf5, 0x3f, 0xe0, 0x31, 0x65, 0x01,
+	0x65, 0x1e, 0xb3, 0xf9, 0xa1, 0x1b, 0x04, 0xf6, 0xc1, 0x5a, 0x9f, 0x70, 0x77, 0xcd, 0xa6, 0x31,
+	0x26, 0xb1, 0x15, 0xc5, 0x94, 0x53, 0x7d, 0x5e, 0x59, 0x2c, 0x61, 0xb1, 0x94, 0xa5, 0x7d, 0x7f,
+	0x48, 0x87, 0x54, 0x38, 0xec, 0xac, 0x25, 0xcd, 0x6d, 0x73, 0x48, 0xe9, 0xd0, 0x27, 0xb6, 0xe8,
+	0xf5, 0x93, 0x3d, 0x9b, 0x7b, 0x01, 0x61, 0xdc, 0x0d, 0x22, 0x69, 0x80, 0x9f, 0x6b, 0xa0, 0xf1,
+	0x8e, 0x86, 0xc3, 0x1d, 0x12, 0x07, 0x5b, 0x59, 0x8a, 0xbe, 0x08, 0xca, 0x1e, 0x36, 0xb4, 0x25,
+	0x6d, 0x65, 0xb2, 0xdb, 0x18, 0xa5, 0x66, 0xfd, 0xd8, 0x0d, 0xfc, 0x97, 0xd0, 0xc3, 0x10, 0x95,
+	0x3d, 0xac, 0x2f, 0x83, 0x29, 0x7a, 0x18, 0x92, 0xd8, 0x28, 0x2f, 0x69, 0x2b, 0xf5, 0xee, 0xdc,
+	0x28, 0x35, 0x67, 0xa4, 0x43, 0xc8, 0x10, 0xc9, 0x61, 0xfd, 0x09, 0xa8, 0x46, 0x94, 0xfa, 0x8e,
+	0x87, 0x8d, 0x09, 0xc1, 0xd2, 0x47, 0xa9, 0x39, 0x2b, 0x9d, 0x6a, 0x00, 0xa2, 0x4a, 0xd6, 0xea,
+	0x61, 0x7d, 0x1d, 0xcc, 0x72, 0xba, 0x4f, 0x42, 0xc7, 0x0b, 0x1d, 0x4c, 0x42, 0x1a, 0x18, 0x93,
+	0x82, 0xbe, 0x30, 0x4a, 0xcd, 0x79, 0x39, 0xe7, 0xfa, 0x38, 0x44, 0x33, 0x42, 0xe8, 0x85, 0x1b,
+	0x59, 0x57, 0xef, 0x82, 0xa6, 0x34, 0xd0, 0x84, 0x2b, 0xc2, 0x94, 0x20, 0xb4, 0x47, 0xa9, 0xf9,
+	0xe0, 0x2a, 0x61, 0x6c, 0x80, 0xa8, 0x21, 0x94, 0xad, 0x84, 0x4b, 0xc6, 0x2e, 0xa8, 0x62, 0x12,
+	0x51, 0xe6, 0x71, 0xa3, 0x22, 0xe6, 0xbe, 0x3a, 0x4d, 0xcd, 0xd2, 0xb7, 0xd4, 0x5c, 0x1e, 0x7a,
+	0xfc, 0x63, 0xd2, 0xb7, 0x06, 0x34, 0xb0, 0x07, 0xe2, 0xf4, 0xd5, 0xcf, 0x2a, 0xc3, 0xfb, 0x36,
+	0x3f, 0x8e, 0x08, 0xb3, 0x7a, 0x21, 0xbf, 0xdc, 0x9f, 0xc2, 0x40, 0x94, 0x03, 0x75, 0x07, 0xd4,
+	0x19, 0xf1, 0x7d, 0x27, 0x76, 0x39, 0x31, 0xaa, 0x82, 0xde, 0x2d, 0x40, 0xdf, 0x20, 0x83, 0x51,
+	0x6a, 0xce, 0x49, 0xfa, 0x18, 0x04, 0x51, 0x2d, 0x6b, 0x23, 0x97, 0x13, 0xfd, 0x03, 0x00, 0x8c,
+	0xbb, 0x31, 0x77, 0xb2, 0x0b, 0x36, 0x6a, 0x4b, 0xda, 0xca, 0xf4, 0xb3, 0xb6, 0x25, 0x6f, 0xdf,
+	0xca, 0x6f, 0xdf, 0xda, 0xc9, 0x6f, 0xbf, 0xbb, 0x98, 0xa5, 0x8f, 0x52, 0xb3, 0xa5, 0x98, 0xe3,
+	0xb9, 0xf0, 0xe4, 0xbb, 0xa9, 0xa1, 0xba, 0x10, 0x32, 0xbb, 0x8e, 0x40, 0x8d, 0x84, 0x58, 0x72,
+	0xeb, 0xbf, 0xe5, 0x3e, 0x52, 0xdc, 0xa6, 0xe4, 0xe6, 0x33, 0x25, 0xb5, 0x4a, 0x42, 0x2c, 0x98,
+	0x07, 0xa0, 0x15, 0xc5, 0x74, 0x40, 0x08, 0x66, 0x4e, 0x44, 0x62, 0x79, 0x2c, 0x40, 0x1c, 0xcb,
+	0x66, 0xe1, 0x63, 0x31, 0xd4, 0x9f, 0xea, 0x26, 0x10, 0xa2, 0x66, 0xae, 0x6d, 0x93, 0x58, 0x9c,
+	0x52, 0x04, 0x9a, 0x31, 0xd9, 0x4b, 0x42, 0x7c, 0x99, 0x3a, 0x2d, 0x52, 0xdf, 0x14, 0x4e, 0x55,
+	0x7f, 0xaa, 0x1b, 0x38, 0x88, 0x1a, 0x52, 0xc9, 0x13, 0x3f, 0x01, 0x3d, 0x09, 0x07, 0xbe, 0xeb,
+	0x05, 0x04, 0x3b, 0xf9, 0x72, 0x8c, 0x19, 0x11, 0xfa, 0xb6, 0x70, 0xe8, 0x82, 0x0c, 0xfd, 0x95,
+	0x08, 0x51, 0x6b, 0x2c, 0x6e, 0x2b, 0x4d, 0xe7, 0x60, 0xee, 0xd2, 0x29, 0x97, 0x65, 0x34, 0x44,
+	0x72, 0xaf, 0x70, 0xf2, 0xc3, 0x9b, 0xc9, 0x92, 0x07, 0x51, 0x73, 0x2c, 0x21, 0xa9, 0x7c, 0x99,
+	0x02, 0x75, 0x51, 0x49, 0xb6, 0x29, 0xf5, 0xaf, 0x96, 0x01, 0xed, 0x2f, 0xca, 0x40, 0xf9, 0x9f,
+	0xcb, 0xc0, 0x44, 0xd1, 0x32, 0x70, 0xed, 0xa9, 0x4e, 0xde, 0xc1, 0x53, 0x7d, 0x0f, 0x2a, 0x49,
+	0xc8, 0xa8, 0x8f, 0x55, 0x89, 0x5a, 0x2f, 0x4c, 0x6f, 0xe4, 0x97, 0x91, 0x51, 0x20, 0x52, 0xb8,
+	0xdb, 0x5f, 0x55, 0xe5, 0xbf, 0xbc, 0xaa, 0xea, 0xdd, 0xbe, 0xaa, 0x18, 0xdc, 0xf3, 0x5d, 0xc6,
+	0x1d, 0x72, 0x44, 0x06, 0x09, 0xf7, 0x68, 0xf8, 0xa7, 0x65, 0x6f, 0x59, 0x95, 0xa7, 0xb6, 0xcc,
+	0xb9, 0x05, 0x22, 0x2b, 0x55, 0x2b, 0x1b, 0x79, 0x9d, 0x0f, 0x64, 0xf3, 0xbb, 0x9b, 0xa7, 0xe7,
+	0x1d, 0xed, 0xec, 0xbc, 0xa3, 0xfd, 0x38, 0xef, 0x68, 0x27, 0x17, 0x9d, 0xd2, 0xd9, 0x45, 0xa7,
+	0xf4, 0xf5, 0xa2, 0x53, 0xda, 0x7d, 0x7a, 0x65, 0x7b, 0xea, 0xe3, 0xbc, 0xea, 0xbb, 0x7d, 0x96,
+	0x77, 0xec, 0x83, 0x17, 0xf6, 0x91, 0xfa, 0xa2, 0x8b, 0xcd, 0xf6, 0x2b, 0x62, 0x69, 0xcf, 0x7f,
+	0x0e, 0x00, 0xfd, 0x12, 0x74, 0xd7, 0xef, 0x07, 0x00, 0x00,
+}
+
+func (m *LongTermOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LongTermOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LongTermOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.UnclaimedRefund.Size()
+		i -= size
+		if _, err := m.UnclaimedRefund.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.UnclaimedProceeds.Size()
+		i -= size
+		if _, err := m.UnclaimedProceeds.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.RefundPerRate.Size()
+		i -= size
+		if _, err := m.RefundPerRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.ProceedsPerRate.Size()
+		i -= size
+		if _, err := m.ProceedsPerRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintOrder(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x4a
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintOrder(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.SellRate.Size()
+		i -= size
+		if _, err := m.SellRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Deposit.Size()
+		i -= size
+		if _, err := m.Deposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TokenInDenom) > 0 {
+		i -= len(m.TokenInDenom)
+		copy(dAtA[i:], m.TokenInDenom)
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.TokenInDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastExecutionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastExecutionTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintOrder(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.RefundPerRate.Size()
+		i -= size
+		if _, err := m.RefundPerRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.ProceedsPerRate.Size()
+		i -= size
+		if _, err := m.ProceedsPerRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Unsold.Size()
+		i -= size
+		if _, err := m.Unsold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.SellRate.Size()
+		i -= size
+		if _, err := m.SellRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TokenInDenom) > 0 {
+		i -= len(m.TokenInDenom)
+		copy(dAtA[i:], m.TokenInDenom)
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.TokenInDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOrder(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOrder(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *LongTermOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovOrder(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovOrder(uint64(m.PoolId))
+	}
+	l = len(m.TokenInDenom)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	l = m.SellRate.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovOrder(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovOrder(uint64(l))
+	l = m.ProceedsPerRate.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	l = m.RefundPerRate.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	l = m.UnclaimedProceeds.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	l = m.UnclaimedRefund.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	return n
+}
+
+func (m *OrderPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovOrder(uint64(m.PoolId))
+	}
+	l = len(m.TokenInDenom)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	l = m.SellRate.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	l = m.Unsold.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	l = m.ProceedsPerRate.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	l = m.RefundPerRate.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastExecutionTime)
+	n += 1 + l + sovOrder(uint64(l))
+	return n
+}
+
+func sovOrder(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOrder(x uint64) (n int) {
+	return sovOrder(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *LongTermOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LongTermOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LongTermOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SellRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProceedsPerRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProceedsPerRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundPerRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RefundPerRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnclaimedProceeds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnclaimedProceeds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnclaimedRefund", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnclaimedRefund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SellRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unsold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Unsold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProceedsPerRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProceedsPerRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundPerRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RefundPerRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastExecutionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastExecutionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipOrder(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthOrder
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupOrder
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthOrder
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthOrder        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowOrder          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupOrder = fmt.Errorf("proto: unexpected end of group")
+)
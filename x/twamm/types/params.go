@@ -0,0 +1,56 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store keys.
+var (
+	KeyMaxOrderDuration = []byte("MaxOrderDuration")
+)
+
+// ParamKeyTable for the twamm module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(maxOrderDuration time.Duration) Params {
+	return Params{
+		MaxOrderDuration: maxOrderDuration,
+	}
+}
+
+// DefaultParams returns the default twamm module parameters.
+func DefaultParams() Params {
+	return Params{
+		MaxOrderDuration: 30 * 24 * time.Hour,
+	}
+}
+
+// Validate validates params.
+func (p Params) Validate() error {
+	return validateMaxOrderDuration(p.MaxOrderDuration)
+}
+
+// Implements params.ParamSet.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMaxOrderDuration, &p.MaxOrderDuration, validateMaxOrderDuration),
+	}
+}
+
+func validateMaxOrderDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < time.Second {
+		return fmt.Errorf("max order duration must be at least a second: %s", v)
+	}
+
+	return nil
+}
@@ -0,0 +1,1693 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/twamm/v1beta1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ===================== MsgPlaceLongTermOrder
+type MsgPlaceLongTermOrder struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// token_in is sold evenly over the duration.
+	TokenIn       types.Coin    `protobuf:"bytes,3,opt,name=token_in,json=tokenIn,proto3" json:"token_in" yaml:"token_in"`
+	TokenOutDenom string        `protobuf:"bytes,4,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty" yaml:"token_out_denom"`
+	Duration      time.Duration `protobuf:"bytes,5,opt,name=duration,proto3,stdduration" json:"duration" yaml:"duration"`
+}
+
+func (m *MsgPlaceLongTermOrder) Reset()         { *m = MsgPlaceLongTermOrder{} }
+func (m *MsgPlaceLongTermOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgPlaceLongTermOrder) ProtoMessage()    {}
+func (*MsgPlaceLongTermOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7214a07bb3d787, []int{0}
+}
+func (m *MsgPlaceLongTermOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPlaceLongTermOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPlaceLongTermOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPlaceLongTermOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPlaceLongTermOrder.Merge(m, src)
+}
+func (m *MsgPlaceLongTermOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPlaceLongTermOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPlaceLongTermOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPlaceLongTermOrder proto.InternalMessageInfo
+
+func (m *MsgPlaceLongTermOrder) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgPlaceLongTermOrder) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *MsgPlaceLongTermOrder) GetTokenIn() types.Coin {
+	if m != nil {
+		return m.TokenIn
+	}
+	return types.Coin{}
+}
+
+func (m *MsgPlaceLongTermOrder) GetTokenOutDenom() string {
+	if m != nil {
+		return m.TokenOutDenom
+	}
+	return ""
+}
+
+func (m *MsgPlaceLongTermOrder) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+type MsgPlaceLongTermOrderResponse struct {
+	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty" yaml:"order_id"`
+}
+
+func (m *MsgPlaceLongTermOrderResponse) Reset()         { *m = MsgPlaceLongTermOrderResponse{} }
+func (m *MsgPlaceLongTermOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPlaceLongTermOrderResponse) ProtoMessage()    {}
+func (*MsgPlaceLongTermOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7214a07bb3d787, []int{1}
+}
+func (m *MsgPlaceLongTermOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPlaceLongTermOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPlaceLongTermOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPlaceLongTermOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPlaceLongTermOrderResponse.Merge(m, src)
+}
+func (m *MsgPlaceLongTermOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPlaceLongTermOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPlaceLongTermOrderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPlaceLongTermOrderResponse proto.InternalMessageInfo
+
+func (m *MsgPlaceLongTermOrderResponse) GetOrderId() uint64 {
+	if m != nil {
+		return m.OrderId
+	}
+	return 0
+}
+
+// ===================== MsgCancelLongTermOrder
+type MsgCancelLongTermOrder struct {
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	OrderId uint64 `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty" yaml:"order_id"`
+}
+
+func (m *MsgCancelLongTermOrder) Reset()         { *m = MsgCancelLongTermOrder{} }
+func (m *MsgCancelLongTermOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelLongTermOrder) ProtoMessage()    {}
+func (*MsgCancelLongTermOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7214a07bb3d787, []int{2}
+}
+func (m *MsgCancelLongTermOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelLongTermOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelLongTermOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelLongTermOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelLongTermOrder.Merge(m, src)
+}
+func (m *MsgCancelLongTermOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelLongTermOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelLongTermOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelLongTermOrder proto.InternalMessageInfo
+
+func (m *MsgCancelLongTermOrder) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgCancelLongTermOrder) GetOrderId() uint64 {
+	if m != nil {
+		return m.OrderId
+	}
+	return 0
+}
+
+type MsgCancelLongTermOrderResponse struct {
+	// refund is the part of the deposit that was not sold.
+	Refund github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=refund,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"refund" yaml:"refund"`
+	// proceeds are the tokens bought and not withdrawn before.
+	Proceeds github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=proceeds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"proceeds" yaml:"proceeds"`
+}
+
+func (m *MsgCancelLongTermOrderResponse) Reset()         { *m = MsgCancelLongTermOrderResponse{} }
+func (m *MsgCancelLongTermOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelLongTermOrderResponse) ProtoMessage()    {}
+func (*MsgCancelLongTermOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7214a07bb3d787, []int{3}
+}
+func (m *MsgCancelLongTermOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelLongTermOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelLongTermOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelLongTermOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelLongTermOrderResponse.Merge(m, src)
+}
+func (m *MsgCancelLongTermOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelLongTermOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelLongTermOrderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelLongTermOrderResponse proto.InternalMessageInfo
+
+func (m *MsgCancelLongTermOrderResponse) GetRefund() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Refund
+	}
+	return nil
+}
+
+func (m *MsgCancelLongTermOrderResponse) GetProceeds() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Proceeds
+	}
+	return nil
+}
+
+// ===================== MsgWithdrawProceeds
+type MsgWithdrawProceeds struct {
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	OrderId uint64 `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty" yaml:"order_id"`
+}
+
+func (m *MsgWithdrawProceeds) Reset()         { *m = MsgWithdrawProceeds{} }
+func (m *MsgWithdrawProceeds) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawProceeds) ProtoMessage()    {}
+func (*MsgWithdrawProceeds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7214a07bb3d787, []int{4}
+}
+func (m *MsgWithdrawProceeds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawProceeds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawProceeds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawProceeds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawProceeds.Merge(m, src)
+}
+func (m *MsgWithdrawProceeds) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawProceeds) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawProceeds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawProceeds proto.InternalMessageInfo
+
+func (m *MsgWithdrawProceeds) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgWithdrawProceeds) GetOrderId() uint64 {
+	if m != nil {
+		return m.OrderId
+	}
+	return 0
+}
+
+type MsgWithdrawProceedsResponse struct {
+	// proceeds are the tokens bought since the last withdrawal, and the tokens
+	// that could not be sold, if any.
+	Proceeds github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=proceeds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"proceeds" yaml:"proceeds"`
+}
+
+func (m *MsgWithdrawProceedsResponse) Reset()         { *m = MsgWithdrawProceedsResponse{} }
+func (m *MsgWithdrawProceedsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawProceedsResponse) ProtoMessage()    {}
+func (*MsgWithdrawProceedsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7214a07bb3d787, []int{5}
+}
+func (m *MsgWithdrawProceedsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawProceedsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawProceedsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawProceedsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawProceedsResponse.Merge(m, src)
+}
+func (m *MsgWithdrawProceedsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawProceedsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawProceedsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawProceedsResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawProceedsResponse) GetProceeds() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Proceeds
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MsgPlaceLongTermOrder)(nil), "osmosis.twamm.v1beta1.MsgPlaceLongTermOrder")
+	proto.RegisterType((*MsgPlaceLongTermOrderResponse)(nil), "osmosis.twamm.v1beta1.MsgPlaceLongTermOrderResponse")
+	proto.RegisterType((*MsgCancelLongTermOrder)(nil), "osmosis.twamm.v1beta1.MsgCancelLongTermOrder")
+	proto.RegisterType((*MsgCancelLongTermOrderResponse)(nil), "osmosis.twamm.v1beta1.MsgCancelLongTermOrderResponse")
+	proto.RegisterType((*MsgWithdrawProceeds)(nil), "osmosis.twamm.v1beta1.MsgWithdrawProceeds")
+	proto.RegisterType((*MsgWithdrawProceedsResponse)(nil), "osmosis.twamm.v1beta1.MsgWithdrawProceedsResponse")
+}
+
+func init() { proto.RegisterFile("osmosis/twamm/v1beta1/tx.proto", fileDescriptor_fe7214a07bb3d787) }
+
+var fileDescriptor_fe7214a07bb3d787 = []byte{
+	// 623 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xdd, 0x4e, 0xd4, 0x40,
+	0x14, 0xde, 0x16, 0x5c, 0xd6, 0x31, 0x88, 0x16, 0xc1, 0x5a, 0x62, 0x4b, 0xe6, 0x6a, 0xfd, 0x61,
+	0x2a, 0xa8, 0x31, 0xf1, 0xce, 0xc2, 0x0d, 0xc6, 0x0d, 0xa4, 0x31, 0x31, 0xf1, 0x86, 0xf4, 0x67,
+	0x28, 0x0d, 0x6d, 0xa7, 0xe9, 0x4c, 0xf9, 0xd1, 0x97, 0x30, 0x5e, 0xe9, 0x2b, 0xf8, 0x24, 0x5c,
+	0x72, 0x65, 0xbc, 0x5a, 0x0c, 0xfb, 0x02, 0x66, 0x9f, 0xc0, 0x74, 0x3a, 0xad, 0x8b, 0x16, 0x65,
+	0x4d, 0xf4, 0x6a, 0x67, 0xce, 0xf9, 0xce, 0xf7, 0x9d, 0xf9, 0x4e, 0x67, 0x16, 0xe8, 0x84, 0xc6,
+	0x84, 0x86, 0xd4, 0x64, 0xfb, 0x4e, 0x1c, 0x9b, 0x7b, 0xcb, 0x2e, 0x66, 0xce, 0xb2, 0xc9, 0x0e,
+	0x50, 0x9a, 0x11, 0x46, 0x94, 0x39, 0x91, 0x47, 0x3c, 0x8f, 0x44, 0x5e, 0xbb, 0x11, 0x90, 0x80,
+	0x70, 0x84, 0x59, 0xac, 0x4a, 0xb0, 0xa6, 0x07, 0x84, 0x04, 0x11, 0x36, 0xf9, 0xce, 0xcd, 0xb7,
+	0x4d, 0x3f, 0xcf, 0x1c, 0x16, 0x92, 0xa4, 0xca, 0x7b, 0x9c, 0xcd, 0x74, 0x1d, 0x8a, 0x6b, 0x29,
+	0x8f, 0x84, 0x22, 0x0f, 0x3f, 0xcb, 0x60, 0xae, 0x47, 0x83, 0xcd, 0xc8, 0xf1, 0xf0, 0x0b, 0x92,
+	0x04, 0x2f, 0x71, 0x16, 0x6f, 0x64, 0x3e, 0xce, 0x94, 0x3b, 0xa0, 0x4d, 0x71, 0xe2, 0xe3, 0x4c,
+	0x95, 0x16, 0xa5, 0xee, 0x65, 0xeb, 0xfa, 0xb0, 0x6f, 0x4c, 0x1f, 0x3a, 0x71, 0xf4, 0x14, 0x96,
+	0x71, 0x68, 0x0b, 0x80, 0x72, 0x0f, 0x4c, 0xa5, 0x84, 0x44, 0x5b, 0xa1, 0xaf, 0xca, 0x8b, 0x52,
+	0x77, 0xd2, 0x52, 0x86, 0x7d, 0xe3, 0x6a, 0x89, 0x15, 0x09, 0x68, 0xb7, 0x8b, 0xd5, 0xba, 0xaf,
+	0xf4, 0x40, 0x87, 0x91, 0x5d, 0x9c, 0x6c, 0x85, 0x89, 0x3a, 0xb1, 0x28, 0x75, 0xaf, 0xac, 0xdc,
+	0x42, 0x65, 0x93, 0xa8, 0x68, 0xb2, 0x3a, 0x2f, 0x5a, 0x25, 0x61, 0x62, 0xdd, 0x3c, 0xea, 0x1b,
+	0xad, 0x61, 0xdf, 0x98, 0x29, 0xc9, 0xaa, 0x42, 0x68, 0x4f, 0xf1, 0xe5, 0x7a, 0xa2, 0x58, 0x60,
+	0xa6, 0x8c, 0x92, 0x9c, 0x6d, 0xf9, 0x38, 0x21, 0xb1, 0x3a, 0xc9, 0xfb, 0xd5, 0x86, 0x7d, 0x63,
+	0x7e, 0xb4, 0xac, 0x06, 0x40, 0x7b, 0x9a, 0x47, 0x36, 0x72, 0xb6, 0x56, 0xec, 0x15, 0x1b, 0x74,
+	0x2a, 0xdb, 0xd4, 0x4b, 0xa2, 0xa5, 0xd2, 0x57, 0x54, 0xf9, 0x8a, 0xd6, 0x04, 0xc0, 0x5a, 0x38,
+	0xdb, 0x52, 0x55, 0x08, 0x3f, 0x9c, 0x18, 0x92, 0x5d, 0xf3, 0xc0, 0x0d, 0x70, 0xbb, 0xd1, 0x57,
+	0x1b, 0xd3, 0x94, 0x24, 0x14, 0x2b, 0x08, 0x74, 0x48, 0x11, 0x28, 0x5c, 0x93, 0xb8, 0x6b, 0xb3,
+	0x3f, 0x58, 0xab, 0x0c, 0xb4, 0xa7, 0xf8, 0x72, 0xdd, 0x87, 0x14, 0xcc, 0xf7, 0x68, 0xb0, 0xea,
+	0x24, 0x1e, 0x8e, 0xfe, 0x7a, 0x52, 0xa3, 0xa2, 0xf2, 0x05, 0x44, 0xdf, 0xcb, 0x40, 0x6f, 0x56,
+	0xad, 0xcf, 0xc1, 0x40, 0x3b, 0xc3, 0xdb, 0x79, 0x52, 0x9c, 0x62, 0xe2, 0xf7, 0xd3, 0x7c, 0x26,
+	0xac, 0x13, 0xcd, 0x95, 0x65, 0xf0, 0xd3, 0x89, 0xd1, 0x0d, 0x42, 0xb6, 0x93, 0xbb, 0xc8, 0x23,
+	0xb1, 0x29, 0x3e, 0xd8, 0xf2, 0x67, 0x89, 0xfa, 0xbb, 0x26, 0x3b, 0x4c, 0x31, 0xe5, 0x0c, 0xd4,
+	0x16, 0x5a, 0xca, 0x1b, 0xd0, 0x49, 0x33, 0xe2, 0x61, 0xec, 0x53, 0x55, 0xfe, 0x93, 0xee, 0xea,
+	0xd9, 0x91, 0x55, 0x85, 0xe3, 0x29, 0xd7, 0x7a, 0x30, 0x05, 0xb3, 0x3d, 0x1a, 0xbc, 0x0a, 0xd9,
+	0x8e, 0x9f, 0x39, 0xfb, 0x9b, 0x22, 0xfc, 0x2f, 0xc7, 0xf0, 0x51, 0x02, 0x0b, 0x0d, 0x92, 0xf5,
+	0x0c, 0x46, 0xdd, 0x90, 0xfe, 0xaf, 0x1b, 0x2b, 0xdf, 0x64, 0x30, 0xd1, 0xa3, 0x81, 0x72, 0x00,
+	0x94, 0x86, 0x57, 0xe4, 0x3e, 0x6a, 0x7c, 0xcd, 0x50, 0xe3, 0xdd, 0xd0, 0x1e, 0x8d, 0x83, 0xae,
+	0x4f, 0xff, 0x16, 0xcc, 0x36, 0x5d, 0x8b, 0xa5, 0xf3, 0xc9, 0x1a, 0xe0, 0xda, 0xe3, 0xb1, 0xe0,
+	0xb5, 0x78, 0x06, 0xae, 0xfd, 0xf2, 0x25, 0xdc, 0x3d, 0x9f, 0xea, 0x67, 0xac, 0xb6, 0x72, 0x71,
+	0x6c, 0xa5, 0x69, 0x3d, 0x3f, 0x3a, 0xd5, 0xa5, 0xe3, 0x53, 0x5d, 0xfa, 0x7a, 0xaa, 0x4b, 0xef,
+	0x06, 0x7a, 0xeb, 0x78, 0xa0, 0xb7, 0xbe, 0x0c, 0xf4, 0xd6, 0xeb, 0x07, 0x23, 0x03, 0x14, 0xbc,
+	0x4b, 0x91, 0xe3, 0xd2, 0x6a, 0x63, 0xee, 0x3d, 0x31, 0x0f, 0xc4, 0x1f, 0x0f, 0x1f, 0xa7, 0xdb,
+	0xe6, 0x2f, 0xdc, 0xc3, 0xef, 0x03, 0x00, 0x7d, 0x0a, 0xb7, 0x22, 0x96, 0x06, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	PlaceLongTermOrder(ctx context.Context, in *MsgPlaceLongTermOrder, opts ...grpc.CallOption) (*MsgPlaceLongTermOrderResponse, error)
+	CancelLongTermOrder(ctx context.Context, in *MsgCancelLongTermOrder, opts ...grpc.CallOption) (*MsgCancelLongTermOrderResponse, error)
+	WithdrawProceeds(ctx context.Context, in *MsgWithdrawProceeds, opts ...grpc.CallOption) (*MsgWithdrawProceedsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) PlaceLongTermOrder(ctx context.Context, in *MsgPlaceLongTermOrder, opts ...grpc.CallOption) (*MsgPlaceLongTermOrderResponse, error) {
+	out := new(MsgPlaceLongTermOrderResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.twamm.v1beta1.Msg/PlaceLongTermOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelLongTermOrder(ctx context.Context, in *MsgCancelLongTermOrder, opts ...grpc.CallOption) (*MsgCancelLongTermOrderResponse, error) {
+	out := new(MsgCancelLongTermOrderResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.twamm.v1beta1.Msg/CancelLongTermOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawProceeds(ctx context.Context, in *MsgWithdrawProceeds, opts ...grpc.CallOption) (*MsgWithdrawProceedsResponse, error) {
+	out := new(MsgWithdrawProceedsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.twamm.v1beta1.Msg/WithdrawProceeds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	PlaceLongTermOrder(context.Context, *MsgPlaceLongTermOrder) (*MsgPlaceLongTermOrderResponse, error)
+	CancelLongTermOrder(context.Context, *MsgCancelLongTermOrder) (*MsgCancelLongTermOrderResponse, error)
+	WithdrawProceeds(context.Context, *MsgWithdrawProceeds) (*MsgWithdrawProceedsResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) PlaceLongTermOrder(ctx context.Context, req *MsgPlaceLongTermOrder) (*MsgPlaceLongTermOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceLongTermOrder not implemented")
+}
+func (*UnimplementedMsgServer) CancelLongTermOrder(ctx context.Context, req *MsgCancelLongTermOrder) (*MsgCancelLongTermOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelLongTermOrder not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawProceeds(ctx context.Context, req *MsgWithdrawProceeds) (*MsgWithdrawProceedsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawProceeds not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_PlaceLongTermOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPlaceLongTermOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PlaceLongTermOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.twamm.v1beta1.Msg/PlaceLongTermOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PlaceLongTermOrder(ctx, req.(*MsgPlaceLongTermOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelLongTermOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelLongTermOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelLongTermOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.twamm.v1beta1.Msg/CancelLongTermOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelLongTermOrder(ctx, req.(*MsgCancelLongTermOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawProceeds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawProceeds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawProceeds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.twamm.v1beta1.Msg/WithdrawProceeds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawProceeds(ctx, req.(*MsgWithdrawProceeds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "osmosis.twamm.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PlaceLongTermOrder",
+			Handler:    _Msg_PlaceLongTermOrder_Handler,
+		},
+		{
+			MethodName: "CancelLongTermOrder",
+			Handler:    _Msg_CancelLongTermOrder_Handler,
+		},
+		{
+			MethodName: "WithdrawProceeds",
+			Handler:    _Msg_WithdrawProceeds_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "osmosis/twamm/v1beta1/tx.proto",
+}
+
+func (m *MsgPlaceLongTermOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceLongTermOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceLongTermOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTx(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlaceLongTermOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceLongTermOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceLongTermOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelLongTermOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelLongTermOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelLongTermOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelLongTermOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelLongTermOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelLongTermOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proceeds) > 0 {
+		for iNdEx := len(m.Proceeds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proceeds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Refund) > 0 {
+		for iNdEx := len(m.Refund) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Refund[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawProceeds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawProceeds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawProceeds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawProceedsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawProceedsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawProceedsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proceeds) > 0 {
+		for iNdEx := len(m.Proceeds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proceeds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgPlaceLongTermOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = m.TokenIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgPlaceLongTermOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovTx(uint64(m.OrderId))
+	}
+	return n
+}
+
+func (m *MsgCancelLongTermOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.OrderId != 0 {
+		n += 1 + sovTx(uint64(m.OrderId))
+	}
+	return n
+}
+
+func (m *MsgCancelLongTermOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Refund) > 0 {
+		for _, e := range m.Refund {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Proceeds) > 0 {
+		for _, e := range m.Proceeds {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgWithdrawProceeds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.OrderId != 0 {
+		n += 1 + sovTx(uint64(m.OrderId))
+	}
+	return n
+}
+
+func (m *MsgWithdrawProceedsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proceeds) > 0 {
+		for _, e := range m.Proceeds {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgPlaceLongTermOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceLongTermOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceLongTermOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlaceLongTermOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceLongTermOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceLongTermOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelLongTermOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelLongTermOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelLongTermOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelLongTermOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelLongTermOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelLongTermOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refund", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Refund = append(m.Refund, types.Coin{})
+			if err := m.Refund[len(m.Refund)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proceeds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proceeds = append(m.Proceeds, types.Coin{})
+			if err := m.Proceeds[len(m.Proceeds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawProceeds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawProceeds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawProceeds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawProceedsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawProceedsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawProceedsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proceeds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proceeds = append(m.Proceeds, types.Coin{})
+			if err := m.Proceeds[len(m.Proceeds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
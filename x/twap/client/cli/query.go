@@ -0,0 +1,155 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/osmosis-labs/osmosis/v7/x/twap/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module.
+func GetQueryCmd() *cobra.Command {
+	// Group queries under a subcommand
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetCmdArithmeticTwap(),
+		GetCmdParams(),
+	)
+
+	return cmd
+}
+
+// GetCmdArithmeticTwap returns the arithmetic TWAP of a pool's asset pair.
+func GetCmdArithmeticTwap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "arithmetic [pool-id] [base-denom] [quote-denom] [start-time] [end-time]",
+		Short: "Query the arithmetic TWAP of a pool's spot price",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the arithmetic time weighted average of a pool's spot price for a
+base and quote denom, between a start time and an end time. If the end time is
+omitted, the current block time is used. Times are unix timestamps in seconds,
+or RFC3339 strings.
+
+Example:
+$ %s query twap arithmetic 1 uosmo uatom 1656000000
+$ %s query twap arithmetic 1 uosmo uatom 2022-06-23T16:00:00Z 2022-06-24T16:00:00Z
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		Args: cobra.RangeArgs(4, 5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			startTime, err := parseTime(args[3])
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 4 {
+				res, err := queryClient.ArithmeticTwapToNow(cmd.Context(), &types.QueryArithmeticTwapToNowRequest{
+					PoolId:     poolId,
+					BaseAsset:  args[1],
+					QuoteAsset: args[2],
+					StartTime:  startTime,
+				})
+				if err != nil {
+					return err
+				}
+				return clientCtx.PrintProto(res)
+			}
+
+			endTime, err := parseTime(args[4])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ArithmeticTwap(cmd.Context(), &types.QueryArithmeticTwapRequest{
+				PoolId:     poolId,
+				BaseAsset:  args[1],
+				QuoteAsset: args[2],
+				StartTime:  startTime,
+				EndTime:    endTime,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdParams returns the twap module's params.
+func GetCmdParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the twap module's params",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the twap module's params.
+
+Example:
+$ %s query twap params
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func parseTime(timeStr string) (time.Time, error) {
+	if timeUnix, err := strconv.ParseInt(timeStr, 10, 64); err == nil { // unix time
+		return time.Unix(timeUnix, 0).UTC(), nil
+	} else if timeRFC, err := time.Parse(time.RFC3339, timeStr); err == nil { // RFC time
+		return timeRFC, nil
+	}
+	return time.Time{}, errors.New("invalid time format, expected a unix timestamp or RFC3339 time")
+}
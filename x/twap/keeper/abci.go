@@ -0,0 +1,14 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlock updates the records of every pool that changed during the block.
+func (k Keeper) EndBlock(ctx sdk.Context) {
+	for _, poolId := range k.getChangedPools(ctx) {
+		if err := k.updateRecords(ctx, poolId); err != nil {
+			k.Logger(ctx).Error("failed to update twap records", "pool_id", poolId, "error", err.Error())
+		}
+	}
+}
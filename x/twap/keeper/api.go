@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v7/x/twap/types"
+)
+
+// GetArithmeticTwap returns the arithmetic time weighted average of the pool's
+// spot price for (baseAssetDenom, quoteAssetDenom), between startTime and
+// endTime. The spot price is the one gamm's CalculateSpotPrice returns for the
+// same denoms.
+//
+// startTime must be before endTime, endTime can't be after the current block
+// time, and the pool must have price history at or before startTime. Records
+// older than the record history keep period are pruned, so startTime should
+// be within that period.
+func (k Keeper) GetArithmeticTwap(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+) (sdk.Dec, error) {
+	if !startTime.Before(endTime) {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidTimeRange,
+			"start time %s must be before end time %s", startTime, endTime)
+	}
+	if endTime.After(ctx.BlockTime()) {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidTimeRange,
+			"end time %s is after the current block time %s", endTime, ctx.BlockTime())
+	}
+
+	denom0, denom1, err := types.LexicographicalOrderDenoms(baseAssetDenom, quoteAssetDenom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	// error out early, with a clearer error, if the pair isn't tracked at all.
+	if _, err := k.getMostRecentRecord(ctx, poolId, denom0, denom1); err != nil {
+		return sdk.Dec{}, err
+	}
+
+	startRecord, err := k.getInterpolatedRecord(ctx, poolId, startTime, denom0, denom1)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	endRecord, err := k.getInterpolatedRecord(ctx, poolId, endTime, denom0, denom1)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return computeArithmeticTwap(startRecord, endRecord, quoteAssetDenom)
+}
+
+// GetArithmeticTwapToNow returns GetArithmeticTwap, with the current block
+// time as the end time.
+func (k Keeper) GetArithmeticTwapToNow(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+) (sdk.Dec, error) {
+	return k.GetArithmeticTwap(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, ctx.BlockTime())
+}
+
+// InitializeRecordsForExistingPools creates the first records of every pool
+// that doesn't have any yet. It is meant to be run when the module is added
+// to a chain that already has pools.
+func (k Keeper) InitializeRecordsForExistingPools(ctx sdk.Context) error {
+	pools, err := k.ammkeeper.GetPoolsAndPoke(ctx)
+	if err != nil {
+		return err
+	}
+	for _, pool := range pools {
+		records, err := k.getAllMostRecentRecordsForPool(ctx, pool.GetId())
+		if err != nil {
+			return err
+		}
+		if len(records) != 0 {
+			continue
+		}
+		if err := k.afterCreatePool(ctx, pool.GetId()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,223 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/twap/types"
+)
+
+func (suite *KeeperTestSuite) TestGetArithmeticTwap() {
+	poolId := suite.createPool()
+	poolCreationTime := suite.Ctx.BlockTime()
+	initialPrice, err := suite.App.GAMMKeeper.CalculateSpotPrice(suite.Ctx, poolId, "foo", "bar")
+	suite.Require().NoError(err)
+
+	// the price stays at initialPrice for 10 seconds, then at swappedPrice for 30 seconds.
+	suite.advanceBlock(10 * time.Second)
+	swappedPrice := suite.swapAndEndBlock(poolId, sdk.NewInt64Coin("bar", 100000), "foo", "foo", "bar")
+	suite.Require().NotEqual(initialPrice, swappedPrice)
+	swapTime := suite.Ctx.BlockTime()
+	suite.advanceBlock(30 * time.Second)
+
+	tests := map[string]struct {
+		base, quote string
+		start, end  time.Time
+		expTwap     sdk.Dec
+	}{
+		"before the swap": {
+			base: "foo", quote: "bar",
+			start: poolCreationTime, end: swapTime,
+			expTwap: initialPrice,
+		},
+		"after the swap": {
+			base: "foo", quote: "bar",
+			start: swapTime, end: suite.Ctx.BlockTime(),
+			expTwap: swappedPrice,
+		},
+		"across the swap": {
+			base: "foo", quote: "bar",
+			start: poolCreationTime, end: suite.Ctx.BlockTime(),
+			expTwap: initialPrice.MulInt64(10000).Add(swappedPrice.MulInt64(30000)).QuoInt64(40000),
+		},
+		"part of the range before the swap": {
+			base: "foo", quote: "bar",
+			start: poolCreationTime.Add(5 * time.Second), end: swapTime.Add(5 * time.Second),
+			expTwap: initialPrice.MulInt64(5000).Add(swappedPrice.MulInt64(5000)).QuoInt64(10000),
+		},
+		"another pair of the pool": {
+			base: "baz", quote: "foo",
+			start: swapTime, end: suite.Ctx.BlockTime(),
+			expTwap: suite.spotPrice(poolId, "baz", "foo"),
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			twap, err := suite.App.TwapKeeper.GetArithmeticTwap(suite.Ctx, poolId, tc.base, tc.quote, tc.start, tc.end)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expTwap, twap)
+		})
+	}
+
+	suite.Run("reversed pair", func() {
+		twap, err := suite.App.TwapKeeper.GetArithmeticTwap(suite.Ctx, poolId, "bar", "foo", swapTime, suite.Ctx.BlockTime())
+		suite.Require().NoError(err)
+		suite.Require().Equal(suite.spotPrice(poolId, "bar", "foo"), twap)
+	})
+
+	suite.Run("to now", func() {
+		twap, err := suite.App.TwapKeeper.GetArithmeticTwapToNow(suite.Ctx, poolId, "foo", "bar", swapTime)
+		suite.Require().NoError(err)
+		suite.Require().Equal(swappedPrice, twap)
+	})
+}
+
+func (suite *KeeperTestSuite) TestGetArithmeticTwapErrors() {
+	poolId := suite.createPool()
+	poolCreationTime := suite.Ctx.BlockTime()
+	suite.advanceBlock(time.Minute)
+	now := suite.Ctx.BlockTime()
+
+	tests := map[string]struct {
+		poolId      uint64
+		base, quote string
+		start, end  time.Time
+		expErr      error
+	}{
+		"start equals end": {
+			poolId: poolId, base: "foo", quote: "bar",
+			start: now, end: now,
+			expErr: types.ErrInvalidTimeRange,
+		},
+		"start after end": {
+			poolId: poolId, base: "foo", quote: "bar",
+			start: now, end: poolCreationTime,
+			expErr: types.ErrInvalidTimeRange,
+		},
+		"end after the block time": {
+			poolId: poolId, base: "foo", quote: "bar",
+			start: poolCreationTime, end: now.Add(time.Second),
+			expErr: types.ErrInvalidTimeRange,
+		},
+		"same base and quote": {
+			poolId: poolId, base: "foo", quote: "foo",
+			start: poolCreationTime, end: now,
+			expErr: types.ErrInvalidDenomPair,
+		},
+		"denom not in the pool": {
+			poolId: poolId, base: "foo", quote: "qux",
+			start: poolCreationTime, end: now,
+			expErr: types.ErrRecordNotFound,
+		},
+		"unknown pool": {
+			poolId: poolId + 1, base: "foo", quote: "bar",
+			start: poolCreationTime, end: now,
+			expErr: types.ErrRecordNotFound,
+		},
+		"start before the pool was created": {
+			poolId: poolId, base: "foo", quote: "bar",
+			start: poolCreationTime.Add(-time.Second), end: now,
+			expErr: types.ErrStartTimeBeforeRecords,
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			_, err := suite.App.TwapKeeper.GetArithmeticTwap(suite.Ctx, tc.poolId, tc.base, tc.quote, tc.start, tc.end)
+			suite.Require().ErrorIs(err, tc.expErr)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPruneRecords() {
+	poolId := suite.createPool()
+	poolCreationTime := suite.Ctx.BlockTime()
+
+	suite.advanceBlock(time.Hour)
+	suite.swapAndEndBlock(poolId, sdk.NewInt64Coin("bar", 100000), "foo", "foo", "bar")
+	firstSwapTime := suite.Ctx.BlockTime()
+
+	suite.advanceBlock(time.Hour)
+	suite.swapAndEndBlock(poolId, sdk.NewInt64Coin("foo", 100000), "bar", "foo", "bar")
+	secondSwapTime := suite.Ctx.BlockTime()
+
+	// 3 pairs, with records at pool creation and after each swap.
+	records, err := suite.App.TwapKeeper.GetAllHistoricalTimeIndexedTWAPs(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(records, 9)
+
+	// pruning after the first swap keeps the first swap's records, since
+	// they're the last ones at or before the cutoff.
+	err = suite.App.TwapKeeper.PruneRecordsBeforeTime(suite.Ctx, firstSwapTime.Add(time.Minute))
+	suite.Require().NoError(err)
+
+	records, err = suite.App.TwapKeeper.GetAllHistoricalTimeIndexedTWAPs(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(records, 6)
+	for _, record := range records {
+		suite.Require().NotEqual(poolCreationTime, record.Time)
+	}
+
+	// TWAPs from the cutoff onwards can still be computed.
+	_, err = suite.App.TwapKeeper.GetArithmeticTwap(suite.Ctx, poolId, "foo", "bar", firstSwapTime.Add(time.Minute), secondSwapTime)
+	suite.Require().NoError(err)
+	_, err = suite.App.TwapKeeper.GetArithmeticTwap(suite.Ctx, poolId, "foo", "bar", poolCreationTime, secondSwapTime)
+	suite.Require().ErrorIs(err, types.ErrStartTimeBeforeRecords)
+
+	// the most recent records are never pruned.
+	err = suite.App.TwapKeeper.PruneRecordsBeforeTime(suite.Ctx, secondSwapTime.Add(time.Hour))
+	suite.Require().NoError(err)
+	records, err = suite.App.TwapKeeper.GetAllHistoricalTimeIndexedTWAPs(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(records, 3)
+	record, err := suite.App.TwapKeeper.GetMostRecentRecord(suite.Ctx, poolId, "bar", "foo")
+	suite.Require().NoError(err)
+	suite.Require().Equal(secondSwapTime, record.Time)
+}
+
+func (suite *KeeperTestSuite) TestPruneOnEpochEnd() {
+	params := suite.App.TwapKeeper.GetParams(suite.Ctx)
+	suite.createPool()
+
+	suite.advanceBlock(time.Hour)
+	suite.swapAndEndBlock(1, sdk.NewInt64Coin("bar", 100000), "foo", "foo", "bar")
+	suite.advanceBlock(params.RecordHistoryKeepPeriod + time.Hour)
+
+	// other epochs don't prune.
+	suite.App.TwapKeeper.Hooks().AfterEpochEnd(suite.Ctx, "week", 1)
+	records, err := suite.App.TwapKeeper.GetAllHistoricalTimeIndexedTWAPs(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(records, 6)
+
+	// the swap's records are the last ones before the cutoff, so only the pool creation ones are pruned.
+	suite.App.TwapKeeper.Hooks().AfterEpochEnd(suite.Ctx, params.PruneEpochIdentifier, 1)
+	records, err = suite.App.TwapKeeper.GetAllHistoricalTimeIndexedTWAPs(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(records, 3)
+}
+
+func (suite *KeeperTestSuite) TestInitializeRecordsForExistingPools() {
+	poolId := suite.createPool()
+	records, err := suite.App.TwapKeeper.GetAllHistoricalTimeIndexedTWAPs(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(records, 3)
+
+	// pools that already have records are left alone.
+	suite.advanceBlock(time.Minute)
+	err = suite.App.TwapKeeper.InitializeRecordsForExistingPools(suite.Ctx)
+	suite.Require().NoError(err)
+	newRecords, err := suite.App.TwapKeeper.GetAllHistoricalTimeIndexedTWAPs(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(records, newRecords)
+
+	_, err = suite.App.TwapKeeper.GetArithmeticTwapToNow(suite.Ctx, poolId, "foo", "bar", records[0].Time)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) spotPrice(poolId uint64, base, quote string) sdk.Dec {
+	spotPrice, err := suite.App.GAMMKeeper.CalculateSpotPrice(suite.Ctx, poolId, base, quote)
+	suite.Require().NoError(err)
+	return spotPrice
+}
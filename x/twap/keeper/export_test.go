@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/twap/types"
+)
+
+func (k Keeper) GetAllHistoricalTimeIndexedTWAPs(ctx sdk.Context) ([]types.TwapRecord, error) {
+	return k.getAllHistoricalTimeIndexedTWAPs(ctx)
+}
+
+func (k Keeper) GetMostRecentRecord(ctx sdk.Context, poolId uint64, denom0, denom1 string) (types.TwapRecord, error) {
+	return k.getMostRecentRecord(ctx, poolId, denom0, denom1)
+}
+
+func (k Keeper) PruneRecordsBeforeTime(ctx sdk.Context, lastKeptTime time.Time) error {
+	return k.pruneRecordsBeforeTime(ctx, lastKeptTime)
+}
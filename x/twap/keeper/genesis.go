@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/twap/types"
+)
+
+// InitGenesis initializes the twap module's state from a provided genesis
+// state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	if err := genState.Validate(); err != nil {
+		panic(err)
+	}
+
+	k.SetParams(ctx, genState.Params)
+
+	for _, record := range genState.Twaps {
+		k.storeHistoricalTWAP(ctx, record)
+
+		mostRecentRecord, err := k.getMostRecentRecord(ctx, record.PoolId, record.Asset0Denom, record.Asset1Denom)
+		if err != nil || record.Time.After(mostRecentRecord.Time) {
+			k.storeNewRecord(ctx, record)
+		}
+	}
+}
+
+// ExportGenesis returns the twap module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	records, err := k.getAllHistoricalTimeIndexedTWAPs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return types.NewGenesisState(k.GetParams(ctx), records)
+}
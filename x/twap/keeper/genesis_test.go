@@ -0,0 +1,41 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/app"
+	"github.com/osmosis-labs/osmosis/v7/x/twap/types"
+)
+
+func (suite *KeeperTestSuite) TestGenesisRoundTrip() {
+	poolId := suite.createPool()
+	poolCreationTime := suite.Ctx.BlockTime()
+	suite.advanceBlock(time.Minute)
+	suite.swapAndEndBlock(poolId, sdk.NewInt64Coin("bar", 100000), "foo", "foo", "bar")
+	suite.advanceBlock(time.Minute)
+
+	params := types.NewParams("week", 24*time.Hour)
+	suite.App.TwapKeeper.SetParams(suite.Ctx, params)
+	expTwap, err := suite.App.TwapKeeper.GetArithmeticTwapToNow(suite.Ctx, poolId, "foo", "bar", poolCreationTime)
+	suite.Require().NoError(err)
+
+	genesis := suite.App.TwapKeeper.ExportGenesis(suite.Ctx)
+	suite.Require().Equal(params, genesis.Params)
+	suite.Require().Len(genesis.Twaps, 6)
+
+	// import into a fresh app, at the same block time.
+	newApp := app.Setup(false)
+	ctx := newApp.BaseApp.NewContext(false, suite.Ctx.BlockHeader())
+	newApp.TwapKeeper.InitGenesis(ctx, genesis)
+
+	suite.Require().Equal(genesis, newApp.TwapKeeper.ExportGenesis(ctx))
+	record, err := newApp.TwapKeeper.GetMostRecentRecord(ctx, poolId, "bar", "foo")
+	suite.Require().NoError(err)
+	suite.Require().Equal(poolCreationTime.Add(time.Minute), record.Time)
+
+	twap, err := newApp.TwapKeeper.GetArithmeticTwapToNow(ctx, poolId, "foo", "bar", poolCreationTime)
+	suite.Require().NoError(err)
+	suite.Require().Equal(expTwap, twap)
+}
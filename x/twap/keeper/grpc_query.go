@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/twap/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+// Querier defines a wrapper around the x/twap keeper providing gRPC method
+// handlers.
+type Querier struct {
+	Keeper
+}
+
+func NewQuerier(k Keeper) Querier {
+	return Querier{Keeper: k}
+}
+
+func (q Querier) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return &types.QueryParamsResponse{Params: q.Keeper.GetParams(sdkCtx)}, nil
+}
+
+func (q Querier) ArithmeticTwap(ctx context.Context, req *types.QueryArithmeticTwapRequest) (*types.QueryArithmeticTwapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	twap, err := q.Keeper.GetArithmeticTwap(sdkCtx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryArithmeticTwapResponse{ArithmeticTwap: twap}, nil
+}
+
+func (q Querier) ArithmeticTwapToNow(ctx context.Context, req *types.QueryArithmeticTwapToNowRequest) (*types.QueryArithmeticTwapToNowResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	twap, err := q.Keeper.GetArithmeticTwapToNow(sdkCtx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryArithmeticTwapToNowResponse{ArithmeticTwap: twap}, nil
+}
@@ -0,0 +1,57 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/twap/types"
+)
+
+func (suite *KeeperTestSuite) TestQueryParams() {
+	res, err := suite.queryClient.Params(gocontext.Background(), &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DefaultParams(), res.Params)
+}
+
+func (suite *KeeperTestSuite) TestQueryArithmeticTwap() {
+	poolId := suite.createPool()
+	poolCreationTime := suite.Ctx.BlockTime()
+	suite.advanceBlock(time.Minute)
+	suite.swapAndEndBlock(poolId, sdk.NewInt64Coin("bar", 100000), "foo", "foo", "bar")
+	suite.advanceBlock(time.Minute)
+	// the query client was built with the setup context, so query through a new one.
+	suite.SetupQueryClient()
+
+	expTwap, err := suite.App.TwapKeeper.GetArithmeticTwapToNow(suite.Ctx, poolId, "foo", "bar", poolCreationTime)
+	suite.Require().NoError(err)
+
+	res, err := suite.queryClient.ArithmeticTwap(gocontext.Background(), &types.QueryArithmeticTwapRequest{
+		PoolId:     poolId,
+		BaseAsset:  "foo",
+		QuoteAsset: "bar",
+		StartTime:  poolCreationTime,
+		EndTime:    suite.Ctx.BlockTime(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expTwap, res.ArithmeticTwap)
+
+	toNowRes, err := suite.queryClient.ArithmeticTwapToNow(gocontext.Background(), &types.QueryArithmeticTwapToNowRequest{
+		PoolId:     poolId,
+		BaseAsset:  "foo",
+		QuoteAsset: "bar",
+		StartTime:  poolCreationTime,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expTwap, toNowRes.ArithmeticTwap)
+
+	_, err = suite.queryClient.ArithmeticTwap(gocontext.Background(), &types.QueryArithmeticTwapRequest{
+		PoolId:     poolId,
+		BaseAsset:  "foo",
+		QuoteAsset: "bar",
+		StartTime:  suite.Ctx.BlockTime(),
+		EndTime:    poolCreationTime,
+	})
+	suite.Require().Error(err)
+}
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochstypes "github.com/osmosis-labs/osmosis/v7/x/epochs/types"
+	gammtypes "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+// Hooks wrapper struct for twap keeper.
+type Hooks struct {
+	k Keeper
+}
+
+var (
+	_ gammtypes.GammHooks    = Hooks{}
+	_ epochstypes.EpochHooks = Hooks{}
+)
+
+// Return the wrapper struct.
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// epochs hooks
+// Don't do anything pre epoch start.
+func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+}
+
+// AfterEpochEnd prunes the records that are older than the record history
+// keep period, at the end of every prune epoch.
+func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	params := h.k.GetParams(ctx)
+	if epochIdentifier != params.PruneEpochIdentifier {
+		return
+	}
+	lastKeptTime := ctx.BlockTime().Add(-params.RecordHistoryKeepPeriod)
+	if err := h.k.pruneRecordsBeforeTime(ctx, lastKeptTime); err != nil {
+		h.k.Logger(ctx).Error("failed to prune twap records", "error", err.Error())
+	}
+}
+
+// gamm hooks
+func (h Hooks) AfterPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
+	if err := h.k.afterCreatePool(ctx, poolId); err != nil {
+		h.k.Logger(ctx).Error("failed to create twap records", "pool_id", poolId, "error", err.Error())
+	}
+}
+
+// Swaps, joins and exits only mark the pool as changed. Its records are
+// updated once, with its end of block spot prices, in EndBlock.
+func (h Hooks) AfterJoinPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, enterCoins sdk.Coins, shareOutAmount sdk.Int) {
+	h.k.trackChangedPool(ctx, poolId)
+}
+
+func (h Hooks) AfterExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, shareInAmount sdk.Int, exitCoins sdk.Coins) {
+	h.k.trackChangedPool(ctx, poolId)
+}
+
+func (h Hooks) AfterSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
+	h.k.trackChangedPool(ctx, poolId)
+}
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/twap/types"
+)
+
+// Keeper provides a way to manage the twap module's storage.
+type Keeper struct {
+	storeKey     sdk.StoreKey
+	transientKey sdk.StoreKey
+	paramSpace   paramtypes.Subspace
+
+	ammkeeper types.AmmInterface
+}
+
+// NewKeeper returns an instance of Keeper.
+func NewKeeper(storeKey sdk.StoreKey, transientKey sdk.StoreKey, paramSpace paramtypes.Subspace, ammKeeper types.AmmInterface) *Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return &Keeper{
+		storeKey:     storeKey,
+		transientKey: transientKey,
+		paramSpace:   paramSpace,
+		ammkeeper:    ammKeeper,
+	}
+}
+
+// Logger returns a logger instance.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetParams returns the total set of params.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of params.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/app"
+	"github.com/osmosis-labs/osmosis/v7/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/balancer"
+	gammtypes "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v7/x/swaprouter/types"
+	"github.com/osmosis-labs/osmosis/v7/x/twap/keeper"
+	"github.com/osmosis-labs/osmosis/v7/x/twap/types"
+)
+
+var (
+	acc1      = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address().Bytes())
+	startTime = time.Unix(1645580000, 0).UTC()
+)
+
+type KeeperTestSuite struct {
+	apptesting.KeeperTestHelper
+
+	queryClient types.QueryClient
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.App = app.Setup(false)
+	suite.Ctx = suite.App.BaseApp.NewContext(false, tmproto.Header{Height: 1, ChainID: "osmosis-1", Time: startTime})
+
+	suite.SetupQueryClient()
+
+	suite.App.GAMMKeeper.SetParams(suite.Ctx, gammtypes.NewParams(sdk.Coins{}))
+	err := simapp.FundAccount(suite.App.BankKeeper, suite.Ctx, acc1, sdk.NewCoins(
+		sdk.NewInt64Coin("foo", 1000000000000),
+		sdk.NewInt64Coin("bar", 1000000000000),
+		sdk.NewInt64Coin("baz", 1000000000000),
+	))
+	suite.Require().NoError(err)
+}
+
+// SetupQueryClient builds a query client that queries at the suite's current context.
+func (suite *KeeperTestSuite) SetupQueryClient() {
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.Ctx, suite.App.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, keeper.NewQuerier(*suite.App.TwapKeeper))
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+// createPool creates a balancer pool of foo, bar and baz, with 1 foo priced at 2 bar and at 3 baz.
+func (suite *KeeperTestSuite) createPool() uint64 {
+	poolAssets := []balancer.PoolAsset{
+		{Weight: sdk.NewInt(100), Token: sdk.NewInt64Coin("foo", 1000000)},
+		{Weight: sdk.NewInt(100), Token: sdk.NewInt64Coin("bar", 2000000)},
+		{Weight: sdk.NewInt(100), Token: sdk.NewInt64Coin("baz", 3000000)},
+	}
+	poolParams := balancer.PoolParams{SwapFee: sdk.ZeroDec(), ExitFee: sdk.ZeroDec()}
+	poolId, err := suite.App.GAMMKeeper.CreatePool(suite.Ctx, balancer.NewMsgCreateBalancerPool(acc1, poolParams, poolAssets, ""))
+	suite.Require().NoError(err)
+	return poolId
+}
+
+// advanceBlock moves the context to a new block, the given duration after the current one.
+func (suite *KeeperTestSuite) advanceBlock(duration time.Duration) {
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(duration)).WithBlockHeight(suite.Ctx.BlockHeight() + 1)
+}
+
+// swapAndEndBlock swaps in the pool, runs the twap EndBlock, and returns the pool's new spot price.
+func (suite *KeeperTestSuite) swapAndEndBlock(poolId uint64, tokenIn sdk.Coin, tokenOutDenom, baseDenom, quoteDenom string) sdk.Dec {
+	routes := []swaproutertypes.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: tokenOutDenom}}
+	_, err := suite.App.SwapRouterKeeper.RouteExactAmountIn(suite.Ctx, acc1, routes, tokenIn, sdk.OneInt())
+	suite.Require().NoError(err)
+	suite.App.TwapKeeper.EndBlock(suite.Ctx)
+
+	spotPrice, err := suite.App.GAMMKeeper.CalculateSpotPrice(suite.Ctx, poolId, baseDenom, quoteDenom)
+	suite.Require().NoError(err)
+	return spotPrice
+}
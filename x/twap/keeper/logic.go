@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v7/x/twap/types"
+)
+
+// afterCreatePool creates a record for every asset pair of a new pool, with
+// zero accumulators and the pool's current spot prices.
+func (k Keeper) afterCreatePool(ctx sdk.Context, poolId uint64) error {
+	pool, err := k.ammkeeper.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	denoms := []string{}
+	for _, coin := range pool.GetTotalPoolLiquidity(ctx) {
+		denoms = append(denoms, coin.Denom)
+	}
+
+	denoms0, denoms1 := types.GetAllUniqueDenomPairs(denoms)
+	for i := range denoms0 {
+		p0, p1 := k.getSpotPrices(ctx, poolId, denoms0[i], denoms1[i], sdk.ZeroDec(), sdk.ZeroDec())
+		k.storeNewRecord(ctx, types.TwapRecord{
+			PoolId:                      poolId,
+			Asset0Denom:                 denoms0[i],
+			Asset1Denom:                 denoms1[i],
+			Height:                      ctx.BlockHeight(),
+			Time:                        ctx.BlockTime(),
+			P0LastSpotPrice:             p0,
+			P1LastSpotPrice:             p1,
+			P0ArithmeticTwapAccumulator: sdk.ZeroDec(),
+			P1ArithmeticTwapAccumulator: sdk.ZeroDec(),
+		})
+	}
+	return nil
+}
+
+// updateRecords brings the accumulators of every asset pair of a pool up to
+// the current block time, and records the pool's new spot prices. Pools that
+// have no records yet, e.g. because they were created before this module
+// existed, get their first records instead.
+func (k Keeper) updateRecords(ctx sdk.Context, poolId uint64) error {
+	records, err := k.getAllMostRecentRecordsForPool(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	if len(records) == 0 {
+		return k.afterCreatePool(ctx, poolId)
+	}
+
+	for _, record := range records {
+		k.storeNewRecord(ctx, k.updateRecord(ctx, record))
+	}
+	return nil
+}
+
+func (k Keeper) updateRecord(ctx sdk.Context, record types.TwapRecord) types.TwapRecord {
+	newRecord := recordWithUpdatedAccumulators(record, ctx.BlockTime())
+	newRecord.Height = ctx.BlockHeight()
+	newRecord.P0LastSpotPrice, newRecord.P1LastSpotPrice = k.getSpotPrices(
+		ctx, record.PoolId, record.Asset0Denom, record.Asset1Denom, record.P0LastSpotPrice, record.P1LastSpotPrice)
+	return newRecord
+}
+
+// getSpotPrices returns the pool's spot prices of the asset pair. If either
+// can't be computed, the previous prices are kept, so that a pool in a state
+// gamm can't price doesn't prevent swaps against it.
+func (k Keeper) getSpotPrices(ctx sdk.Context, poolId uint64, denom0, denom1 string, prevP0, prevP1 sdk.Dec) (p0 sdk.Dec, p1 sdk.Dec) {
+	p0, err0 := k.ammkeeper.CalculateSpotPrice(ctx, poolId, denom0, denom1)
+	p1, err1 := k.ammkeeper.CalculateSpotPrice(ctx, poolId, denom1, denom0)
+	if err0 != nil || err1 != nil {
+		k.Logger(ctx).Error("failed to compute spot prices for twap record",
+			"pool_id", poolId, "asset0", denom0, "asset1", denom1)
+		return prevP0, prevP1
+	}
+	return p0, p1
+}
+
+// recordWithUpdatedAccumulators returns the record, with its accumulators
+// interpolated to newTime using its last spot prices.
+func recordWithUpdatedAccumulators(record types.TwapRecord, newTime time.Time) types.TwapRecord {
+	timeDelta := sdk.NewDec(newTime.Sub(record.Time).Milliseconds())
+	record.P0ArithmeticTwapAccumulator = record.P0ArithmeticTwapAccumulator.Add(record.P0LastSpotPrice.Mul(timeDelta))
+	record.P1ArithmeticTwapAccumulator = record.P1ArithmeticTwapAccumulator.Add(record.P1LastSpotPrice.Mul(timeDelta))
+	record.Time = newTime
+	return record
+}
+
+// getInterpolatedRecord returns a record of the pool's asset pair with its
+// accumulators as of time t. The denoms must be sorted.
+func (k Keeper) getInterpolatedRecord(ctx sdk.Context, poolId uint64, t time.Time, denom0, denom1 string) (types.TwapRecord, error) {
+	record, err := k.getRecordAtOrBeforeTime(ctx, poolId, t, denom0, denom1)
+	if err != nil {
+		return types.TwapRecord{}, err
+	}
+	return recordWithUpdatedAccumulators(record, t), nil
+}
+
+// computeArithmeticTwap returns the arithmetic TWAP between two records of the
+// same asset pair, with quoteAsset as the quote asset.
+func computeArithmeticTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) (sdk.Dec, error) {
+	timeDelta := endRecord.Time.Sub(startRecord.Time).Milliseconds()
+	if timeDelta <= 0 {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidTimeRange,
+			"twap time range must span at least a millisecond, got %s to %s", startRecord.Time, endRecord.Time)
+	}
+
+	var accumDelta sdk.Dec
+	switch quoteAsset {
+	case startRecord.Asset1Denom:
+		accumDelta = endRecord.P0ArithmeticTwapAccumulator.Sub(startRecord.P0ArithmeticTwapAccumulator)
+	case startRecord.Asset0Denom:
+		accumDelta = endRecord.P1ArithmeticTwapAccumulator.Sub(startRecord.P1ArithmeticTwapAccumulator)
+	default:
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidDenomPair, "%s is not in the record's asset pair", quoteAsset)
+	}
+	return accumDelta.QuoInt64(timeDelta), nil
+}
@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v7/x/twap/types"
+)
+
+// storeNewRecord stores a record as the most recent record of its pool's asset
+// pair, and indexes it as a historical record.
+func (k Keeper) storeNewRecord(ctx sdk.Context, record types.TwapRecord) {
+	store := ctx.KVStore(k.storeKey)
+	bz := mustMarshalRecord(record)
+	store.Set(types.FormatMostRecentTWAPKey(record.PoolId, record.Asset0Denom, record.Asset1Denom), bz)
+	k.storeHistoricalTWAP(ctx, record)
+}
+
+// storeHistoricalTWAP writes a record to both historical indexes. Records for
+// the same pool, asset pair and time overwrite each other.
+func (k Keeper) storeHistoricalTWAP(ctx sdk.Context, record types.TwapRecord) {
+	store := ctx.KVStore(k.storeKey)
+	bz := mustMarshalRecord(record)
+	store.Set(types.FormatHistoricalPoolIndexTWAPKey(record.PoolId, record.Asset0Denom, record.Asset1Denom, record.Time), bz)
+	store.Set(types.FormatHistoricalTimeIndexTWAPKey(record.Time, record.PoolId, record.Asset0Denom, record.Asset1Denom), bz)
+}
+
+func (k Keeper) deleteHistoricalRecord(ctx sdk.Context, record types.TwapRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.FormatHistoricalPoolIndexTWAPKey(record.PoolId, record.Asset0Denom, record.Asset1Denom, record.Time))
+	store.Delete(types.FormatHistoricalTimeIndexTWAPKey(record.Time, record.PoolId, record.Asset0Denom, record.Asset1Denom))
+}
+
+// getMostRecentRecord returns the most recent record of a pool's asset pair.
+// The denoms must be sorted.
+func (k Keeper) getMostRecentRecord(ctx sdk.Context, poolId uint64, denom0, denom1 string) (types.TwapRecord, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.FormatMostRecentTWAPKey(poolId, denom0, denom1))
+	if bz == nil {
+		return types.TwapRecord{}, sdkerrors.Wrapf(types.ErrRecordNotFound,
+			"pool %d has no records for denoms (%s, %s)", poolId, denom0, denom1)
+	}
+	return unmarshalRecord(bz)
+}
+
+// getAllMostRecentRecordsForPool returns the most recent record of every
+// asset pair in a pool.
+func (k Keeper) getAllMostRecentRecordsForPool(ctx sdk.Context, poolId uint64) ([]types.TwapRecord, error) {
+	poolPrefix := append(types.KeyPrefixMostRecentRecord, sdk.Uint64ToBigEndian(poolId)...)
+	return k.getRecordsFromIterator(sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), poolPrefix))
+}
+
+// getAllHistoricalTimeIndexedTWAPs returns every stored historical record,
+// sorted by time.
+func (k Keeper) getAllHistoricalTimeIndexedTWAPs(ctx sdk.Context) ([]types.TwapRecord, error) {
+	return k.getRecordsFromIterator(sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixHistoricalTimeIndex))
+}
+
+// getRecordAtOrBeforeTime returns the latest record of a pool's asset pair
+// written at or before the given time. The denoms must be sorted.
+func (k Keeper) getRecordAtOrBeforeTime(ctx sdk.Context, poolId uint64, t time.Time, denom0, denom1 string) (types.TwapRecord, error) {
+	store := ctx.KVStore(k.storeKey)
+	startKey := types.FormatHistoricalPoolIndexTWAPKeyPrefix(poolId, denom0, denom1)
+	endKey := storetypes.PrefixEndBytes(types.FormatHistoricalPoolIndexTWAPKey(poolId, denom0, denom1, t))
+
+	iter := store.ReverseIterator(startKey, endKey)
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return types.TwapRecord{}, sdkerrors.Wrapf(types.ErrStartTimeBeforeRecords,
+			"pool %d has no records for denoms (%s, %s) at or before %s", poolId, denom0, denom1, t)
+	}
+	return unmarshalRecord(iter.Value())
+}
+
+// pruneRecordsBeforeTime deletes every historical record written before
+// lastKeptTime, except for the latest such record of each pool's asset pair.
+// Those are kept so that a TWAP can still be computed for any start time after
+// lastKeptTime.
+func (k Keeper) pruneRecordsBeforeTime(ctx sdk.Context, lastKeptTime time.Time) error {
+	store := ctx.KVStore(k.storeKey)
+	timeIndexStore := prefix.NewStore(store, types.KeyPrefixHistoricalTimeIndex)
+	end := types.FormatHistoricalTimeIndexTWAPKeyPrefix(lastKeptTime)[len(types.KeyPrefixHistoricalTimeIndex):]
+
+	// Iterate from the newest prunable record to the oldest, so the first record
+	// seen for each pool's asset pair is the one to keep.
+	iter := timeIndexStore.ReverseIterator(nil, end)
+	defer iter.Close()
+
+	seenPairs := map[string]bool{}
+	recordsToDelete := []types.TwapRecord{}
+	for ; iter.Valid(); iter.Next() {
+		record, err := unmarshalRecord(iter.Value())
+		if err != nil {
+			return err
+		}
+		pairKey := string(types.FormatMostRecentTWAPKey(record.PoolId, record.Asset0Denom, record.Asset1Denom))
+		if !seenPairs[pairKey] {
+			seenPairs[pairKey] = true
+			continue
+		}
+		recordsToDelete = append(recordsToDelete, record)
+	}
+
+	for _, record := range recordsToDelete {
+		k.deleteHistoricalRecord(ctx, record)
+	}
+	return nil
+}
+
+// trackChangedPool marks a pool as changed during the current block, so that
+// its records get updated at the end of the block.
+func (k Keeper) trackChangedPool(ctx sdk.Context, poolId uint64) {
+	store := ctx.TransientStore(k.transientKey)
+	store.Set(types.FormatAlteredPoolKey(poolId), []byte{})
+}
+
+// getChangedPools returns the IDs of the pools that changed during the
+// current block, in ascending order.
+func (k Keeper) getChangedPools(ctx sdk.Context) []uint64 {
+	store := ctx.TransientStore(k.transientKey)
+	iter := sdk.KVStorePrefixIterator(store, types.KeyPrefixAlteredPool)
+	defer iter.Close()
+
+	poolIds := []uint64{}
+	for ; iter.Valid(); iter.Next() {
+		poolIds = append(poolIds, sdk.BigEndianToUint64(iter.Key()[len(types.KeyPrefixAlteredPool):]))
+	}
+	return poolIds
+}
+
+func (k Keeper) getRecordsFromIterator(iter sdk.Iterator) ([]types.TwapRecord, error) {
+	defer iter.Close()
+
+	records := []types.TwapRecord{}
+	for ; iter.Valid(); iter.Next() {
+		record, err := unmarshalRecord(iter.Value())
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+func mustMarshalRecord(record types.TwapRecord) []byte {
+	bz, err := proto.Marshal(&record)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+func unmarshalRecord(bz []byte) (types.TwapRecord, error) {
+	record := types.TwapRecord{}
+	err := proto.Unmarshal(bz, &record)
+	return record, err
+}
@@ -0,0 +1,16 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/twap module errors.
+var (
+	ErrInvalidRecord          = sdkerrors.Register(ModuleName, 2, "invalid twap record")
+	ErrRecordNotFound         = sdkerrors.Register(ModuleName, 3, "twap record not found")
+	ErrInvalidTimeRange       = sdkerrors.Register(ModuleName, 4, "invalid twap time range")
+	ErrStartTimeBeforeRecords = sdkerrors.Register(ModuleName, 5, "twap start time is before the earliest available record")
+	ErrInvalidDenomPair       = sdkerrors.Register(ModuleName, 6, "invalid twap denom pair")
+)
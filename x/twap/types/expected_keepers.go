@@ -0,0 +1,15 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gammtypes "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+// AmmInterface defines the contract that must be fulfilled by the pools a
+// TWAP is tracked for. The x/gamm keeper is expected to satisfy this interface.
+type AmmInterface interface {
+	GetPoolAndPoke(ctx sdk.Context, poolId uint64) (gammtypes.PoolI, error)
+	GetPoolsAndPoke(ctx sdk.Context) ([]gammtypes.PoolI, error)
+	CalculateSpotPrice(ctx sdk.Context, poolId uint64, baseAssetDenom string, quoteAssetDenom string) (sdk.Dec, error)
+}
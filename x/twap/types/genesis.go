@@ -0,0 +1,33 @@
+package types
+
+import "fmt"
+
+// DefaultGenesis returns the default twap genesis state.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Twaps:  []TwapRecord{},
+		Params: DefaultParams(),
+	}
+}
+
+// NewGenesisState returns a new twap genesis state.
+func NewGenesisState(params Params, twapRecords []TwapRecord) *GenesisState {
+	return &GenesisState{
+		Params: params,
+		Twaps:  twapRecords,
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure. It does not verify that the corresponding pools actually exist.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	for _, record := range gs.Twaps {
+		if err := record.Validate(); err != nil {
+			return fmt.Errorf("invalid twap record for pool %d: %w", record.PoolId, err)
+		}
+	}
+	return nil
+}
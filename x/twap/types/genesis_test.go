@@ -0,0 +1,86 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/twap/types"
+)
+
+func newValidRecord() types.TwapRecord {
+	return types.TwapRecord{
+		PoolId:                      1,
+		Asset0Denom:                 "bar",
+		Asset1Denom:                 "foo",
+		Height:                      1,
+		Time:                        time.Unix(1645580000, 0).UTC(),
+		P0LastSpotPrice:             sdk.NewDec(2),
+		P1LastSpotPrice:             sdk.MustNewDecFromStr("0.5"),
+		P0ArithmeticTwapAccumulator: sdk.ZeroDec(),
+		P1ArithmeticTwapAccumulator: sdk.ZeroDec(),
+	}
+}
+
+func TestGenesisStateValidate(t *testing.T) {
+	tests := map[string]struct {
+		modify    func(*types.GenesisState)
+		expectErr bool
+	}{
+		"valid": {
+			modify: func(*types.GenesisState) {},
+		},
+		"invalid prune epoch identifier": {
+			modify:    func(gs *types.GenesisState) { gs.Params.PruneEpochIdentifier = "" },
+			expectErr: true,
+		},
+		"non-positive keep period": {
+			modify:    func(gs *types.GenesisState) { gs.Params.RecordHistoryKeepPeriod = 0 },
+			expectErr: true,
+		},
+		"zero pool id": {
+			modify:    func(gs *types.GenesisState) { gs.Twaps[0].PoolId = 0 },
+			expectErr: true,
+		},
+		"unsorted denoms": {
+			modify: func(gs *types.GenesisState) {
+				gs.Twaps[0].Asset0Denom, gs.Twaps[0].Asset1Denom = "foo", "bar"
+			},
+			expectErr: true,
+		},
+		"same denoms": {
+			modify:    func(gs *types.GenesisState) { gs.Twaps[0].Asset1Denom = "bar" },
+			expectErr: true,
+		},
+		"unset time": {
+			modify:    func(gs *types.GenesisState) { gs.Twaps[0].Time = time.Time{} },
+			expectErr: true,
+		},
+		"negative accumulator": {
+			modify:    func(gs *types.GenesisState) { gs.Twaps[0].P1ArithmeticTwapAccumulator = sdk.NewDec(-1) },
+			expectErr: true,
+		},
+		"nil spot price": {
+			modify:    func(gs *types.GenesisState) { gs.Twaps[0].P0LastSpotPrice = sdk.Dec{} },
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			gs := types.NewGenesisState(types.DefaultParams(), []types.TwapRecord{newValidRecord()})
+			tc.modify(gs)
+			err := gs.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	require.NoError(t, types.DefaultGenesis().Validate())
+}
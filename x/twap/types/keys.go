@@ -0,0 +1,83 @@
+package types
+
+import (
+	"bytes"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name.
+	ModuleName = "twap"
+
+	// StoreKey defines the primary module store key.
+	StoreKey = ModuleName
+
+	// TransientStoreKey defines the module's transient store key, used to
+	// track the pools that changed during a block.
+	TransientStoreKey = "transient_" + ModuleName
+
+	// RouterKey is the message route for twap.
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key.
+	QuerierRoute = ModuleName
+)
+
+var (
+	// KeyPrefixMostRecentRecord defines prefix to store the most recent record of every pool asset pair.
+	KeyPrefixMostRecentRecord = []byte{0x01}
+
+	// KeyPrefixHistoricalPoolIndex defines prefix to store historical records by pool ID, asset pair and time.
+	KeyPrefixHistoricalPoolIndex = []byte{0x02}
+
+	// KeyPrefixHistoricalTimeIndex defines prefix to store historical records by time, pool ID and asset pair.
+	KeyPrefixHistoricalTimeIndex = []byte{0x03}
+
+	// KeyPrefixAlteredPool defines prefix of the transient store's set of pools that changed during the block.
+	KeyPrefixAlteredPool = []byte{0x04}
+
+	// KeyIndexSeparator defines separator between keys when combine, it should be one that is not used in denom expression.
+	KeyIndexSeparator = []byte{0xFF}
+)
+
+func combineKeys(keys ...[]byte) []byte {
+	return bytes.Join(keys, KeyIndexSeparator)
+}
+
+func poolPairKey(poolId uint64, denom0, denom1 string) []byte {
+	return combineKeys(append(sdk.Uint64ToBigEndian(poolId), []byte(denom0)...), []byte(denom1))
+}
+
+// FormatMostRecentTWAPKey returns the key for the most recent record of a pool's asset pair.
+func FormatMostRecentTWAPKey(poolId uint64, denom0, denom1 string) []byte {
+	return append(KeyPrefixMostRecentRecord, poolPairKey(poolId, denom0, denom1)...)
+}
+
+// FormatHistoricalPoolIndexTWAPKeyPrefix returns the prefix under which all
+// historical records of a pool's asset pair are stored, sorted by time.
+func FormatHistoricalPoolIndexTWAPKeyPrefix(poolId uint64, denom0, denom1 string) []byte {
+	return append(append(KeyPrefixHistoricalPoolIndex, poolPairKey(poolId, denom0, denom1)...), KeyIndexSeparator...)
+}
+
+// FormatHistoricalPoolIndexTWAPKey returns the pool indexed key of a historical record.
+func FormatHistoricalPoolIndexTWAPKey(poolId uint64, denom0, denom1 string, accumulatorWriteTime time.Time) []byte {
+	return append(FormatHistoricalPoolIndexTWAPKeyPrefix(poolId, denom0, denom1), sdk.FormatTimeBytes(accumulatorWriteTime)...)
+}
+
+// FormatHistoricalTimeIndexTWAPKey returns the time indexed key of a historical record.
+func FormatHistoricalTimeIndexTWAPKey(accumulatorWriteTime time.Time, poolId uint64, denom0, denom1 string) []byte {
+	return append(FormatHistoricalTimeIndexTWAPKeyPrefix(accumulatorWriteTime), poolPairKey(poolId, denom0, denom1)...)
+}
+
+// FormatHistoricalTimeIndexTWAPKeyPrefix returns the prefix of all time
+// indexed historical records written at the given time.
+func FormatHistoricalTimeIndexTWAPKeyPrefix(accumulatorWriteTime time.Time) []byte {
+	return combineKeys(append(KeyPrefixHistoricalTimeIndex, sdk.FormatTimeBytes(accumulatorWriteTime)...), nil)
+}
+
+// FormatAlteredPoolKey returns the transient store key marking a pool as changed during the block.
+func FormatAlteredPoolKey(poolId uint64) []byte {
+	return append(KeyPrefixAlteredPool, sdk.Uint64ToBigEndian(poolId)...)
+}
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	epochstypes "github.com/osmosis-labs/osmosis/v7/x/epochs/types"
+)
+
+// Parameter store keys.
+var (
+	KeyPruneEpochIdentifier     = []byte("PruneEpochIdentifier")
+	KeyRecordHistoryKeepPeriod  = []byte("RecordHistoryKeepPeriod")
+	defaultPruneEpochIdentifier = "day"
+	// 48 hours, so that a TWAP over the last day can always be computed.
+	defaultRecordHistoryKeepPeriod = 48 * time.Hour
+)
+
+// ParamKeyTable for the twap module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(pruneEpochIdentifier string, recordHistoryKeepPeriod time.Duration) Params {
+	return Params{
+		PruneEpochIdentifier:    pruneEpochIdentifier,
+		RecordHistoryKeepPeriod: recordHistoryKeepPeriod,
+	}
+}
+
+// DefaultParams returns the default twap module parameters.
+func DefaultParams() Params {
+	return Params{
+		PruneEpochIdentifier:    defaultPruneEpochIdentifier,
+		RecordHistoryKeepPeriod: defaultRecordHistoryKeepPeriod,
+	}
+}
+
+// Validate validates params.
+func (p Params) Validate() error {
+	if err := epochstypes.ValidateEpochIdentifierInterface(p.PruneEpochIdentifier); err != nil {
+		return err
+	}
+	return validateRecordHistoryKeepPeriod(p.RecordHistoryKeepPeriod)
+}
+
+// Implements params.ParamSet.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyPruneEpochIdentifier, &p.PruneEpochIdentifier, epochstypes.ValidateEpochIdentifierInterface),
+		paramtypes.NewParamSetPair(KeyRecordHistoryKeepPeriod, &p.RecordHistoryKeepPeriod, validateRecordHistoryKeepPeriod),
+	}
+}
+
+func validateRecordHistoryKeepPeriod(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return errors.New("record history keep period must be positive")
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Validate performs basic validation of a TWAP record. It does not check that
+// the pool exists.
+func (record TwapRecord) Validate() error {
+	if record.PoolId == 0 {
+		return errors.New("twap record pool id must be positive")
+	}
+	if err := sdk.ValidateDenom(record.Asset0Denom); err != nil {
+		return err
+	}
+	if err := sdk.ValidateDenom(record.Asset1Denom); err != nil {
+		return err
+	}
+	if record.Asset0Denom >= record.Asset1Denom {
+		return fmt.Errorf("twap record denoms must be sorted and distinct, got (%s, %s)", record.Asset0Denom, record.Asset1Denom)
+	}
+	if record.Height < 0 {
+		return errors.New("twap record height must be non-negative")
+	}
+	if record.Time.IsZero() {
+		return errors.New("twap record time must be set")
+	}
+	for _, dec := range []sdk.Dec{
+		record.P0LastSpotPrice, record.P1LastSpotPrice,
+		record.P0ArithmeticTwapAccumulator, record.P1ArithmeticTwapAccumulator,
+	} {
+		if dec.IsNil() || dec.IsNegative() {
+			return errors.New("twap record spot prices and accumulators must be non-negative")
+		}
+	}
+	return nil
+}
+
+// GetAllUniqueDenomPairs returns every pair of distinct denoms, each pair
+// sorted lexicographically. The input denoms are expected to be sorted, as
+// the denoms of sdk.Coins are.
+func GetAllUniqueDenomPairs(denoms []string) (denoms0, denoms1 []string) {
+	for i := 0; i < len(denoms); i++ {
+		for j := i + 1; j < len(denoms); j++ {
+			denoms0 = append(denoms0, denoms[i])
+			denoms1 = append(denoms1, denoms[j])
+		}
+	}
+	return denoms0, denoms1
+}
+
+// LexicographicalOrderDenoms returns the two denoms sorted lexicographically,
+// or an error if they are the same.
+func LexicographicalOrderDenoms(denom0, denom1 string) (string, string, error) {
+	if denom0 == denom1 {
+		return "", "", sdkerrors.Wrapf(ErrInvalidDenomPair, "both assets are %s", denom0)
+	}
+	if denom0 > denom1 {
+		denom0, denom1 = denom1, denom0
+	}
+	return denom0, denom1, nil
+}
@@ -32,6 +32,9 @@ func (k Keeper) ConvertToBaseToken(ctx sdk.Context, inputFee sdk.Coin) (sdk.Coin
 	return sdk.NewCoin(baseDenom, spotPrice.MulInt(inputFee.Amount).RoundInt()), nil
 }
 
+// CalcFeeSpotPrice returns the price of a whitelisted fee token in the base denom.
+// It is the arithmetic TWAP of the fee token's pool over the last FeeTokenTwapWindow,
+// falling back to the pool's spot price when the pool has less price history than that.
 func (k Keeper) CalcFeeSpotPrice(ctx sdk.Context, inputDenom string) (sdk.Dec, error) {
 	baseDenom, err := k.GetBaseDenom(ctx)
 	if err != nil {
@@ -43,6 +46,11 @@ func (k Keeper) CalcFeeSpotPrice(ctx sdk.Context, inputDenom string) (sdk.Dec, e
 		return sdk.Dec{}, err
 	}
 
+	twap, err := k.twapKeeper.GetArithmeticTwapToNow(ctx, feeToken.PoolID, baseDenom, feeToken.Denom, ctx.BlockTime().Add(-types.FeeTokenTwapWindow))
+	if err == nil {
+		return twap, nil
+	}
+
 	spotPrice, err := k.spotPriceCalculator.CalculateSpotPrice(ctx, feeToken.PoolID, baseDenom, feeToken.Denom)
 	if err != nil {
 		return sdk.Dec{}, err
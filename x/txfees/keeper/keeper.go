@@ -18,6 +18,7 @@ type (
 		storeKey sdk.StoreKey
 
 		spotPriceCalculator types.SpotPriceCalculator
+		twapKeeper          types.TwapKeeper
 	}
 )
 
@@ -25,11 +26,13 @@ func NewKeeper(
 	cdc codec.Codec,
 	storeKey sdk.StoreKey,
 	spotPriceCalculator types.SpotPriceCalculator,
+	twapKeeper types.TwapKeeper,
 ) Keeper {
 	return Keeper{
 		cdc:                 cdc,
 		storeKey:            storeKey,
 		spotPriceCalculator: spotPriceCalculator,
+		twapKeeper:          twapKeeper,
 	}
 }
 
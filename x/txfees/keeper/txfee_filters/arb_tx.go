@@ -2,6 +2,7 @@ package txfee_filters
 
 import (
 	gammtypes "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v7/x/swaprouter/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -31,7 +32,7 @@ func IsArbTxLoose(tx sdk.Tx) bool {
 			}
 		}
 
-		swapMsg, isSwapMsg := m.(gammtypes.SwapMsgRoute)
+		swapMsg, isSwapMsg := m.(swaproutertypes.SwapMsgRoute)
 		if !isSwapMsg {
 			continue
 		}
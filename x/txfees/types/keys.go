@@ -1,5 +1,7 @@
 package types
 
+import "time"
+
 const (
 	// ModuleName defines the module name.
 	ModuleName = "txfees"
@@ -14,6 +16,10 @@ const (
 	QuerierRoute = ModuleName
 )
 
+// FeeTokenTwapWindow is the time range over which fee tokens are priced in
+// the base denom.
+const FeeTokenTwapWindow = time.Hour
+
 var (
 	BaseDenomKey         = []byte("base_denom")
 	FeeTokensStorePrefix = []byte("fee_tokens")